@@ -718,6 +718,7 @@ func New(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	app.QuarantineKeeper = quarantinekeeper.NewKeeper(appCodec, keys[quarantine.StoreKey], app.BankKeeper, authtypes.NewModuleAddress(quarantine.ModuleName))
+	app.MarkerKeeper.SetCapTableKeepers(app.HoldKeeper, app.QuarantineKeeper)
 
 	/****  Module Options ****/
 
@@ -772,13 +772,20 @@ func (s *UpgradeTestSuite) TestMetadataMigration() { // TODO[viridian]: Delete t
 		"INF Progress update: module=x/metadata scopes=300000 value owners=257143",
 		"INF Done moving scope value owners into bank module. module=x/metadata scopes=300005 value owners=257147",
 		"INF Done migrating x/metadata from 3 to 4. module=x/metadata",
-		"INF Module migrations completed.",
+		"INF Starting migration of x/metadata from 4 to 5. module=x/metadata",
+	}
+	for i := 10_000; i <= 300_000; i += 10_000 {
+		expLogs = append(expLogs, fmt.Sprintf("INF Progress update: module=x/metadata scopes=%d", i))
 	}
+	expLogs = append(expLogs,
+		"INF Done migrating x/metadata from 4 to 5. module=x/metadata records=0 scopes=300005",
+		"INF Module migrations completed.",
+	)
 
 	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
 	s.Require().NoError(err, "GetModuleVersionMap")
-	s.Require().Equal(4, int(vm[metadatatypes.ModuleName]), "%s module version", metadatatypes.ModuleName)
-	// Drop it back to 3 so the migration runs.
+	s.Require().Equal(5, int(vm[metadatatypes.ModuleName]), "%s module version", metadatatypes.ModuleName)
+	// Drop it back to 3 so the migrations run.
 	vm[metadatatypes.ModuleName] = 3
 
 	runner := func() {
@@ -788,7 +795,7 @@ func (s *UpgradeTestSuite) TestMetadataMigration() { // TODO[viridian]: Delete t
 	}
 	s.ExecuteAndAssertLogs(runner, expLogs, nil, true, "runModuleMigrations")
 	s.Assert().NoError(err, "error from runModuleMigrations")
-	s.Assert().Equal(4, int(vm[metadatatypes.ModuleName]), "vm[metadatatypes.ModuleName]")
+	s.Assert().Equal(5, int(vm[metadatatypes.ModuleName]), "vm[metadatatypes.ModuleName]")
 	s.T().Logf("runModuleMigrations took %s", t2.Sub(t1))
 
 	for _, scopeID := range expCoin {
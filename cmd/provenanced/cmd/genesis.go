@@ -9,6 +9,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -25,10 +27,12 @@ import (
 
 	"github.com/provenance-io/provenance/x/exchange"
 	exchangecli "github.com/provenance-io/provenance/x/exchange/client/cli"
+	"github.com/provenance-io/provenance/x/hold"
 	markercli "github.com/provenance-io/provenance/x/marker/client/cli"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	msgfeetypes "github.com/provenance-io/provenance/x/msgfees/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
+	"github.com/provenance-io/provenance/x/quarantine"
 )
 
 const (
@@ -71,6 +75,7 @@ func GenesisCmd(txConfig client.TxConfig, moduleBasics module.BasicManager, defa
 		AddGenesisCustomFloorPriceDenomCmd(defaultNodeHome),
 		AddGenesisDefaultMarketCmd(defaultNodeHome),
 		AddGenesisCustomMarketCmd(defaultNodeHome),
+		GenesisCapTableCmd(),
 	)
 
 	return cmd
@@ -794,3 +799,86 @@ func getNextAvailableMarketID(exGenState exchange.GenesisState) uint32 {
 	}
 	return rv
 }
+
+// GenesisCapTableCmd returns a command that builds a cap table for a denom from an exported state file.
+func GenesisCapTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cap-table <denom> <exported state file>",
+		Short: "Get a snapshot of all accounts holding a denom in an exported state file",
+		Long: `Get a snapshot of all accounts holding a denom in an exported state file, including held and quarantined funds.
+The snapshot is as of the height the state was exported at. No connection to a node is needed.`,
+		Example: fmt.Sprintf(`$ %[1]s cap-table hotdogcoin exported-state.json
+$ %[1]s cap-table hotdogcoin exported-state.json --csv`, genCmdStart),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			denom := strings.TrimSpace(args[0])
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read exported state from %s: %w", args[1], err)
+			}
+			var appState map[string]json.RawMessage
+			if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			capTable, err := buildGenesisCapTable(clientCtx, appState, denom, appGenesis.InitialHeight-1)
+			if err != nil {
+				return err
+			}
+			if asCSV, _ := cmd.Flags().GetBool(markercli.FlagCSV); asCSV {
+				return markercli.WriteCapTableCSV(cmd.OutOrStdout(), *capTable)
+			}
+			return clientCtx.WithOutput(cmd.OutOrStdout()).PrintProto(capTable)
+		},
+	}
+	markercli.AddCapTableFlags(cmd)
+	return cmd
+}
+
+// buildGenesisCapTable builds the cap table for a denom from the bank, hold, and quarantine genesis states.
+func buildGenesisCapTable(clientCtx client.Context, appState map[string]json.RawMessage, denom string, height int64) (*markertypes.CapTable, error) {
+	var bankGen banktypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[banktypes.ModuleName], &bankGen); err != nil {
+		return nil, fmt.Errorf("could not extract bank genesis state: %w", err)
+	}
+	var holdGen hold.GenesisState
+	if raw, ok := appState[hold.ModuleName]; ok {
+		if err := clientCtx.Codec.UnmarshalJSON(raw, &holdGen); err != nil {
+			return nil, fmt.Errorf("could not extract hold genesis state: %w", err)
+		}
+	}
+	var quarantineGen quarantine.GenesisState
+	if raw, ok := appState[quarantine.ModuleName]; ok {
+		if err := clientCtx.Codec.UnmarshalJSON(raw, &quarantineGen); err != nil {
+			return nil, fmt.Errorf("could not extract quarantine genesis state: %w", err)
+		}
+	}
+
+	builder := markertypes.NewCapTableBuilder(denom, authtypes.NewModuleAddress(quarantine.ModuleName).String())
+	balanceTotal := sdkmath.ZeroInt()
+	for _, balance := range bankGen.Balances {
+		amount := balance.Coins.AmountOf(denom)
+		builder.AddBalance(balance.Address, amount)
+		balanceTotal = balanceTotal.Add(amount)
+	}
+	for _, ah := range holdGen.Holds {
+		builder.AddHeld(ah.Address, ah.Amount.AmountOf(denom))
+	}
+	for _, qf := range quarantineGen.QuarantinedFunds {
+		builder.AddQuarantined(qf.ToAddress, qf.Coins.AmountOf(denom))
+	}
+
+	// The supply can be left out of a genesis file, in which case it's the sum of the balances.
+	supply := bankGen.Supply.AmountOf(denom)
+	if supply.IsZero() {
+		supply = balanceTotal
+	}
+	capTable := builder.Build(height, supply)
+	return &capTable, nil
+}
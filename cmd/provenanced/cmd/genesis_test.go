@@ -1,10 +1,12 @@
 package cmd_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -33,6 +36,8 @@ import (
 	"github.com/provenance-io/provenance/testutil/assertions"
 	"github.com/provenance-io/provenance/testutil/mocks"
 	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/quarantine"
 )
 
 var testMbm = module.NewBasicManager(genutil.AppModuleBasic{})
@@ -919,3 +924,93 @@ func TestGetNextAvailableMarketID(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisCapTableCmd(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	pioconfig.SetProvenanceConfig("", 0)
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	carol := sdk.AccAddress("carol_______________").String()
+	fundsHolder := authtypes.NewModuleAddress(quarantine.ModuleName).String()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("fundshare", amount), sdk.NewInt64Coin("nhash", 5))
+	}
+
+	appState := map[string]json.RawMessage{}
+	var err error
+	appState[banktypes.ModuleName], err = cdc.MarshalJSON(&banktypes.GenesisState{
+		Balances: []banktypes.Balance{
+			{Address: alice, Coins: coins(600)},
+			{Address: bob, Coins: coins(250)},
+			{Address: fundsHolder, Coins: coins(150)},
+		},
+	})
+	require.NoError(t, err, "setup: MarshalJSON bank genesis state")
+	appState[hold.ModuleName], err = cdc.MarshalJSON(&hold.GenesisState{
+		Holds: []*hold.AccountHold{{Address: alice, Amount: coins(100)}},
+	})
+	require.NoError(t, err, "setup: MarshalJSON hold genesis state")
+	appState[quarantine.ModuleName], err = cdc.MarshalJSON(&quarantine.GenesisState{
+		QuarantinedAddresses: []string{carol},
+		QuarantinedFunds:     []*quarantine.QuarantinedFunds{{ToAddress: carol, UnacceptedFromAddresses: []string{bob}, Coins: coins(150)}},
+	})
+	require.NoError(t, err, "setup: MarshalJSON quarantine genesis state")
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("testchain", nil)
+	appGenesis.InitialHeight = 101
+	appGenesis.AppState, err = json.Marshal(appState)
+	require.NoError(t, err, "setup: Marshal app state")
+	genFile := filepath.Join(t.TempDir(), "exported.json")
+	require.NoError(t, appGenesis.SaveAs(genFile), "setup: SaveAs")
+
+	tests := []struct {
+		name   string
+		args   []string
+		expErr string
+		expOut string
+	}{
+		{
+			name:   "invalid denom",
+			args:   []string{"x", genFile},
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "missing file",
+			args:   []string{"fundshare", genFile + ".nope"},
+			expErr: "failed to read exported state from " + genFile + ".nope",
+		},
+		{
+			name: "csv",
+			args: []string{"fundshare", genFile, "--csv"},
+			expOut: "address,balance,held,quarantined,total,percentage\n" +
+				alice + ",600,100,0,600,60.000000000000000000\n" +
+				bob + ",250,0,0,250,25.000000000000000000\n" +
+				carol + ",0,0,150,150,15.000000000000000000\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientCtx := client.Context{}.WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd := provenancecmd.GenesisCapTableCmd()
+			cmd.SetArgs(tc.args)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(io.Discard)
+
+			err := cmd.ExecuteContext(ctx)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "%q ExecuteContext", cmd.Name())
+				return
+			}
+			require.NoError(t, err, "%q ExecuteContext", cmd.Name())
+			assert.Equal(t, tc.expOut, out.String(), "output")
+		})
+	}
+}
@@ -0,0 +1,526 @@
+// Package jsonschema validates JSON documents against a subset of JSON schema that is small enough to evaluate
+// deterministically on chain.
+//
+// The supported keywords are: type, properties, required, additionalProperties, items, enum, const, minLength,
+// maxLength, pattern, format (date and date-time), minimum, maximum, exclusiveMinimum, exclusiveMaximum, minItems,
+// and maxItems. The annotations $schema, $id, $comment, title, description, default, and examples are allowed
+// and ignored.
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxNumberExponent is the largest exponent magnitude allowed in a number that is compared against a schema.
+// It keeps the exact (rational) number comparisons cheap.
+const maxNumberExponent = 100
+
+// schemaTypes are the JSON types that a schema's "type" keyword can have.
+var schemaTypes = map[string]bool{
+	"array": true, "boolean": true, "integer": true, "null": true, "number": true, "object": true, "string": true,
+}
+
+// schemaFormats are the values of a schema's "format" keyword that are validated.
+var schemaFormats = map[string]func(string) error{
+	"date": func(s string) error {
+		_, err := time.Parse(time.DateOnly, s)
+		return err
+	},
+	"date-time": func(s string) error {
+		_, err := time.Parse(time.RFC3339, s)
+		return err
+	},
+}
+
+// ValidateSchema returns an error if the schema is not a JSON object that only uses the supported keywords.
+func ValidateSchema(schema string) error {
+	node, err := decodeSchema(schema)
+	if err != nil {
+		return err
+	}
+	return CheckSchema(node)
+}
+
+// Validate returns an error if the value is not valid JSON or does not conform to the schema.
+// The schema is expected to have already been validated using ValidateSchema.
+func Validate(schema, value string) error {
+	node, err := decodeSchema(schema)
+	if err != nil {
+		return err
+	}
+	data, err := Decode(value)
+	if err != nil {
+		return fmt.Errorf("value is not valid JSON: %w", err)
+	}
+	return ValidateValue(node, data)
+}
+
+// CheckSchema returns an error if the decoded schema uses an unsupported keyword or has an invalid keyword value.
+func CheckSchema(node map[string]interface{}) error {
+	return checkSchema(node, "$")
+}
+
+// ValidateValue returns an error if the decoded value does not conform to the decoded schema.
+func ValidateValue(node map[string]interface{}, val interface{}) error {
+	return validateAgainstSchema(node, val, "$")
+}
+
+// decodeSchema decodes a schema that must be a JSON object.
+func decodeSchema(schema string) (map[string]interface{}, error) {
+	doc, err := Decode(schema)
+	if err != nil {
+		return nil, err
+	}
+	node, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("must be a JSON object")
+	}
+	return node, nil
+}
+
+// Decode decodes a single JSON value, keeping numbers as json.Number.
+func Decode(data string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	var rv interface{}
+	if err := dec.Decode(&rv); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return rv, nil
+}
+
+// sortedKeys returns the keys of a JSON object in sorted order so that errors are deterministic.
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkSchema returns an error if the schema node uses an unsupported keyword or has an invalid keyword value.
+func checkSchema(node map[string]interface{}, path string) error {
+	for _, key := range sortedKeys(node) {
+		val := node[key]
+		switch key {
+		case "$schema", "$id", "$comment", "title", "description", "default", "examples":
+		case "type":
+			if _, err := schemaTypeList(val); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		case "properties":
+			props, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s: %q must be an object", path, key)
+			}
+			for _, name := range sortedKeys(props) {
+				sub, ok := props[name].(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("%s.%s: schema must be an object", path, name)
+				}
+				if err := checkSchema(sub, path+"."+name); err != nil {
+					return err
+				}
+			}
+		case "required":
+			list, ok := val.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s: %q must be an array of strings", path, key)
+			}
+			for _, entry := range list {
+				if _, ok = entry.(string); !ok {
+					return fmt.Errorf("%s: %q must be an array of strings", path, key)
+				}
+			}
+		case "additionalProperties":
+			if _, ok := val.(bool); ok {
+				continue
+			}
+			sub, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s: %q must be a boolean or an object", path, key)
+			}
+			if err := checkSchema(sub, path+".*"); err != nil {
+				return err
+			}
+		case "items":
+			sub, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s: %q must be an object", path, key)
+			}
+			if err := checkSchema(sub, path+"[]"); err != nil {
+				return err
+			}
+		case "enum":
+			list, ok := val.([]interface{})
+			if !ok || len(list) == 0 {
+				return fmt.Errorf("%s: %q must be a non-empty array", path, key)
+			}
+		case "const":
+		case "minLength", "maxLength", "minItems", "maxItems":
+			if _, err := schemaCount(val); err != nil {
+				return fmt.Errorf("%s: %q %w", path, key, err)
+			}
+		case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+			num, ok := val.(json.Number)
+			if !ok {
+				return fmt.Errorf("%s: %q must be a number", path, key)
+			}
+			if _, err := numberRat(num); err != nil {
+				return fmt.Errorf("%s: %q %w", path, key, err)
+			}
+		case "pattern":
+			str, ok := val.(string)
+			if !ok {
+				return fmt.Errorf("%s: %q must be a string", path, key)
+			}
+			if _, err := regexp.Compile(str); err != nil {
+				return fmt.Errorf("%s: invalid %q: %w", path, key, err)
+			}
+		case "format":
+			str, ok := val.(string)
+			if !ok || schemaFormats[str] == nil {
+				return fmt.Errorf("%s: unsupported %q %v", path, key, val)
+			}
+		default:
+			return fmt.Errorf("%s: unsupported keyword %q", path, key)
+		}
+	}
+	return nil
+}
+
+// schemaTypeList returns the types of a "type" keyword, which can be a string or an array of strings.
+func schemaTypeList(val interface{}) ([]string, error) {
+	var rv []string
+	switch v := val.(type) {
+	case string:
+		rv = []string{v}
+	case []interface{}:
+		for _, entry := range v {
+			str, ok := entry.(string)
+			if !ok {
+				return nil, errors.New(`"type" must be a string or an array of strings`)
+			}
+			rv = append(rv, str)
+		}
+	default:
+		return nil, errors.New(`"type" must be a string or an array of strings`)
+	}
+	if len(rv) == 0 {
+		return nil, errors.New(`"type" cannot be empty`)
+	}
+	for _, t := range rv {
+		if !schemaTypes[t] {
+			return nil, fmt.Errorf("unknown type %q", t)
+		}
+	}
+	return rv, nil
+}
+
+// schemaCount returns the value of a keyword that must be a non-negative integer.
+func schemaCount(val interface{}) (int, error) {
+	num, ok := val.(json.Number)
+	if !ok {
+		return 0, errors.New("must be a non-negative integer")
+	}
+	rv, err := strconv.Atoi(num.String())
+	if err != nil || rv < 0 {
+		return 0, errors.New("must be a non-negative integer")
+	}
+	return rv, nil
+}
+
+// numberRat returns the exact value of a JSON number.
+func numberRat(num json.Number) (*big.Rat, error) {
+	str := num.String()
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxNumberExponent || exp < -maxNumberExponent {
+			return nil, fmt.Errorf("number %s is out of range", str)
+		}
+	}
+	rv, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", str)
+	}
+	return rv, nil
+}
+
+// jsonType returns the JSON type of a decoded value. Numbers are "number" even if they are integers.
+func jsonType(val interface{}) string {
+	switch val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+// hasType returns true if the decoded value is of the given JSON schema type.
+func hasType(val interface{}, t string) (bool, error) {
+	actual := jsonType(val)
+	if t != "integer" {
+		return actual == t, nil
+	}
+	if actual != "number" {
+		return false, nil
+	}
+	num, err := numberRat(val.(json.Number))
+	if err != nil {
+		return false, err
+	}
+	return num.IsInt(), nil
+}
+
+// jsonEqual returns true if two decoded values are equal. Numbers are compared by value.
+func jsonEqual(a, b interface{}) (bool, error) {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false, nil
+		}
+		ar, err := numberRat(av)
+		if err != nil {
+			return false, err
+		}
+		br, err := numberRat(bv)
+		if err != nil {
+			return false, err
+		}
+		return ar.Cmp(br) == 0, nil
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false, nil
+		}
+		for i := range av {
+			if eq, err := jsonEqual(av[i], bv[i]); err != nil || !eq {
+				return false, err
+			}
+		}
+		return true, nil
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false, nil
+		}
+		for _, key := range sortedKeys(av) {
+			bval, found := bv[key]
+			if !found {
+				return false, nil
+			}
+			if eq, err := jsonEqual(av[key], bval); err != nil || !eq {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	return a == b, nil
+}
+
+// validateAgainstSchema returns an error if the decoded value does not conform to the schema node.
+func validateAgainstSchema(node map[string]interface{}, val interface{}, path string) error {
+	if raw, ok := node["type"]; ok {
+		typeList, err := schemaTypeList(raw)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		matched := false
+		for _, t := range typeList {
+			if matched, err = hasType(val, t); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if matched {
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(typeList, " or "), jsonType(val))
+		}
+	}
+
+	if raw, ok := node["enum"]; ok {
+		options, _ := raw.([]interface{})
+		found := false
+		for _, option := range options {
+			eq, err := jsonEqual(option, val)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if eq {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+	if raw, ok := node["const"]; ok {
+		eq, err := jsonEqual(raw, val)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if !eq {
+			return fmt.Errorf("%s: value does not equal the required constant", path)
+		}
+	}
+
+	switch v := val.(type) {
+	case string:
+		return validateString(node, v, path)
+	case json.Number:
+		return validateNumber(node, v, path)
+	case []interface{}:
+		return validateArray(node, v, path)
+	case map[string]interface{}:
+		return validateObject(node, v, path)
+	}
+	return nil
+}
+
+// validateString checks the string keywords of a schema node.
+func validateString(node map[string]interface{}, val string, path string) error {
+	length := utf8.RuneCountInString(val)
+	if raw, ok := node["minLength"]; ok {
+		if limit, _ := schemaCount(raw); length < limit {
+			return fmt.Errorf("%s: length %d is less than the minimum %d", path, length, limit)
+		}
+	}
+	if raw, ok := node["maxLength"]; ok {
+		if limit, _ := schemaCount(raw); length > limit {
+			return fmt.Errorf("%s: length %d is more than the maximum %d", path, length, limit)
+		}
+	}
+	if raw, ok := node["pattern"]; ok {
+		str, _ := raw.(string)
+		re, err := regexp.Compile(str)
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern: %w", path, err)
+		}
+		if !re.MatchString(val) {
+			return fmt.Errorf("%s: value does not match pattern %q", path, str)
+		}
+	}
+	if raw, ok := node["format"]; ok {
+		str, _ := raw.(string)
+		if check := schemaFormats[str]; check != nil {
+			if err := check(val); err != nil {
+				return fmt.Errorf("%s: value is not a valid %s", path, str)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNumber checks the number keywords of a schema node.
+func validateNumber(node map[string]interface{}, val json.Number, path string) error {
+	bounds := []struct {
+		key   string
+		fails func(cmp int) bool
+		desc  string
+	}{
+		{key: "minimum", fails: func(cmp int) bool { return cmp < 0 }, desc: "less than the minimum"},
+		{key: "maximum", fails: func(cmp int) bool { return cmp > 0 }, desc: "more than the maximum"},
+		{key: "exclusiveMinimum", fails: func(cmp int) bool { return cmp <= 0 }, desc: "not more than the exclusive minimum"},
+		{key: "exclusiveMaximum", fails: func(cmp int) bool { return cmp >= 0 }, desc: "not less than the exclusive maximum"},
+	}
+	var num *big.Rat
+	for _, bound := range bounds {
+		raw, ok := node[bound.key]
+		if !ok {
+			continue
+		}
+		limit, err := numberRat(raw.(json.Number))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if num == nil {
+			if num, err = numberRat(val); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		if bound.fails(num.Cmp(limit)) {
+			return fmt.Errorf("%s: %s is %s %s", path, val, bound.desc, raw)
+		}
+	}
+	return nil
+}
+
+// validateArray checks the array keywords of a schema node.
+func validateArray(node map[string]interface{}, val []interface{}, path string) error {
+	if raw, ok := node["minItems"]; ok {
+		if limit, _ := schemaCount(raw); len(val) < limit {
+			return fmt.Errorf("%s: %d items is less than the minimum %d", path, len(val), limit)
+		}
+	}
+	if raw, ok := node["maxItems"]; ok {
+		if limit, _ := schemaCount(raw); len(val) > limit {
+			return fmt.Errorf("%s: %d items is more than the maximum %d", path, len(val), limit)
+		}
+	}
+	if items, ok := node["items"].(map[string]interface{}); ok {
+		for i, item := range val {
+			if err := validateAgainstSchema(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateObject checks the object keywords of a schema node.
+func validateObject(node map[string]interface{}, val map[string]interface{}, path string) error {
+	if required, ok := node["required"].([]interface{}); ok {
+		for _, entry := range required {
+			name, _ := entry.(string)
+			if _, found := val[name]; !found {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+	}
+	props, _ := node["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(val) {
+		if sub, ok := props[name].(map[string]interface{}); ok {
+			if err := validateAgainstSchema(sub, val[name], path+"."+name); err != nil {
+				return err
+			}
+			continue
+		}
+		switch additional := node["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				return fmt.Errorf("%s: unknown property %q", path, name)
+			}
+		case map[string]interface{}:
+			if err := validateAgainstSchema(additional, val[name], path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,399 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		expErr string
+	}{
+		{
+			name:   "empty object",
+			schema: `{}`,
+		},
+		{
+			name: "all supported keywords",
+			schema: `{"$schema":"https://json-schema.org/draft/2020-12/schema","$id":"id","$comment":"c","title":"t",` +
+				`"description":"d","default":{},"examples":[],"type":"object","required":["a"],` +
+				`"additionalProperties":{"type":"string"},"properties":{` +
+				`"a":{"type":["string","null"],"minLength":1,"maxLength":5,"pattern":"^[a-z]+$","format":"date"},` +
+				`"b":{"type":"number","minimum":0,"maximum":1.5,"exclusiveMinimum":-1,"exclusiveMaximum":2e1},` +
+				`"c":{"type":"array","items":{"enum":[1,"two",null]},"minItems":0,"maxItems":3},` +
+				`"d":{"const":{"x":[true]},"format":"date-time"}}}`,
+		},
+		{
+			name:   "not json",
+			schema: `{"type":`,
+			expErr: "unexpected EOF",
+		},
+		{
+			name:   "trailing data",
+			schema: `{} {}`,
+			expErr: "unexpected data after JSON value",
+		},
+		{
+			name:   "not an object",
+			schema: `["type"]`,
+			expErr: "must be a JSON object",
+		},
+		{
+			name:   "unsupported keyword",
+			schema: `{"type":"object","oneOf":[]}`,
+			expErr: `$: unsupported keyword "oneOf"`,
+		},
+		{
+			name:   "unsupported keyword in nested schema",
+			schema: `{"properties":{"a":{"items":{"$ref":"#"}}}}`,
+			expErr: `$.a[]: unsupported keyword "$ref"`,
+		},
+		{
+			name:   "unsupported keyword in additional properties",
+			schema: `{"additionalProperties":{"anyOf":[]}}`,
+			expErr: `$.*: unsupported keyword "anyOf"`,
+		},
+		{
+			name:   "unknown type",
+			schema: `{"type":"float"}`,
+			expErr: `$: unknown type "float"`,
+		},
+		{
+			name:   "empty type list",
+			schema: `{"type":[]}`,
+			expErr: `$: "type" cannot be empty`,
+		},
+		{
+			name:   "type not a string",
+			schema: `{"type":1}`,
+			expErr: `$: "type" must be a string or an array of strings`,
+		},
+		{
+			name:   "property schema not an object",
+			schema: `{"properties":{"a":true}}`,
+			expErr: "$.a: schema must be an object",
+		},
+		{
+			name:   "required not strings",
+			schema: `{"required":["a",1]}`,
+			expErr: `$: "required" must be an array of strings`,
+		},
+		{
+			name:   "empty enum",
+			schema: `{"enum":[]}`,
+			expErr: `$: "enum" must be a non-empty array`,
+		},
+		{
+			name:   "negative count",
+			schema: `{"minLength":-1}`,
+			expErr: `$: "minLength" must be a non-negative integer`,
+		},
+		{
+			name:   "fractional count",
+			schema: `{"maxItems":1.5}`,
+			expErr: `$: "maxItems" must be a non-negative integer`,
+		},
+		{
+			name:   "bound not a number",
+			schema: `{"minimum":"1"}`,
+			expErr: `$: "minimum" must be a number`,
+		},
+		{
+			name:   "bound exponent too large",
+			schema: `{"maximum":1e101}`,
+			expErr: `$: "maximum" number 1e101 is out of range`,
+		},
+		{
+			name:   "bound exponent too small",
+			schema: `{"exclusiveMinimum":1E-101}`,
+			expErr: `$: "exclusiveMinimum" number 1E-101 is out of range`,
+		},
+		{
+			name:   "pattern not a string",
+			schema: `{"pattern":1}`,
+			expErr: `$: "pattern" must be a string`,
+		},
+		{
+			name:   "pattern with unsupported backreference",
+			schema: `{"pattern":"(a)\\1"}`,
+			expErr: `$: invalid "pattern": error parsing regexp: invalid escape sequence: ` + "`\\1`",
+		},
+		{
+			name:   "pattern with lookahead",
+			schema: `{"pattern":"a(?=b)"}`,
+			expErr: `$: invalid "pattern": error parsing regexp: invalid or unsupported Perl syntax: ` + "`(?=`",
+		},
+		{
+			name:   "unsupported format",
+			schema: `{"format":"email"}`,
+			expErr: `$: unsupported "format" email`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSchema(tc.schema)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateSchema")
+			} else {
+				assert.NoError(t, err, "ValidateSchema")
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		value  string
+		expErr string
+	}{
+		{
+			name:   "invalid value json",
+			schema: `{}`,
+			value:  `{"a":`,
+			expErr: "value is not valid JSON: unexpected EOF",
+		},
+		{
+			name:   "value with trailing data",
+			schema: `{}`,
+			value:  `1 2`,
+			expErr: "value is not valid JSON: unexpected data after JSON value",
+		},
+
+		// Types
+		{name: "type null", schema: `{"type":"null"}`, value: `null`},
+		{name: "type boolean", schema: `{"type":"boolean"}`, value: `false`},
+		{name: "type string", schema: `{"type":"string"}`, value: `""`},
+		{name: "type number with fraction", schema: `{"type":"number"}`, value: `1.5`},
+		{name: "type array", schema: `{"type":"array"}`, value: `[]`},
+		{name: "type object", schema: `{"type":"object"}`, value: `{}`},
+		{name: "type list", schema: `{"type":["string","null"]}`, value: `null`},
+		{
+			name:   "type mismatch",
+			schema: `{"type":"string"}`,
+			value:  `1`,
+			expErr: "$: expected string, got number",
+		},
+		{
+			name:   "type list mismatch",
+			schema: `{"type":["string","null"]}`,
+			value:  `[]`,
+			expErr: "$: expected string or null, got array",
+		},
+		{name: "integer", schema: `{"type":"integer"}`, value: `-12`},
+		{name: "integer with zero fraction", schema: `{"type":"integer"}`, value: `3.0`},
+		{name: "integer with exponent", schema: `{"type":"integer"}`, value: `1.5e1`},
+		{name: "integer beyond float64 precision", schema: `{"type":"integer"}`, value: `123456789012345678901234567890`},
+		{
+			name:   "integer with fraction",
+			schema: `{"type":"integer"}`,
+			value:  `2.5`,
+			expErr: "$: expected integer, got number",
+		},
+		{
+			name:   "integer with fraction lost in float64",
+			schema: `{"type":"integer"}`,
+			value:  `9007199254740993.5`,
+			expErr: "$: expected integer, got number",
+		},
+		{
+			name:   "integer with exponent out of range",
+			schema: `{"type":"integer"}`,
+			value:  `1e1000`,
+			expErr: "$: number 1e1000 is out of range",
+		},
+
+		// Numbers
+		{name: "minimum equal", schema: `{"minimum":0.1}`, value: `0.1`},
+		{name: "maximum equal with different notation", schema: `{"maximum":100}`, value: `1e2`},
+		{name: "exclusive bounds", schema: `{"exclusiveMinimum":0,"exclusiveMaximum":1}`, value: `0.5`},
+		{name: "number bounds ignored for strings", schema: `{"minimum":5}`, value: `"1"`},
+		{
+			name:   "less than minimum",
+			schema: `{"minimum":0}`,
+			value:  `-0.000000000000000000001`,
+			expErr: "$: -0.000000000000000000001 is less than the minimum 0",
+		},
+		{
+			name:   "more than maximum by less than float64 precision",
+			schema: `{"maximum":0.3}`,
+			value:  `0.30000000000000001`,
+			expErr: "$: 0.30000000000000001 is more than the maximum 0.3",
+		},
+		{
+			name:   "large integer more than maximum by one",
+			schema: `{"maximum":9007199254740992}`,
+			value:  `9007199254740993`,
+			expErr: "$: 9007199254740993 is more than the maximum 9007199254740992",
+		},
+		{
+			name:   "equal to exclusive minimum",
+			schema: `{"exclusiveMinimum":1}`,
+			value:  `1.000`,
+			expErr: "$: 1.000 is not more than the exclusive minimum 1",
+		},
+		{
+			name:   "equal to exclusive maximum",
+			schema: `{"exclusiveMaximum":10}`,
+			value:  `1e1`,
+			expErr: "$: 1e1 is not less than the exclusive maximum 10",
+		},
+		{
+			name:   "bounded value with exponent out of range",
+			schema: `{"maximum":10}`,
+			value:  `1e-1000`,
+			expErr: "$: number 1e-1000 is out of range",
+		},
+
+		// Enum and const
+		{name: "enum number by value", schema: `{"enum":["a",1.0]}`, value: `1`},
+		{name: "enum object", schema: `{"enum":[{"a":[1,2]}]}`, value: `{"a":[1,2.00]}`},
+		{name: "const null", schema: `{"const":null}`, value: `null`},
+		{
+			name:   "not in enum",
+			schema: `{"enum":["a","b"]}`,
+			value:  `"c"`,
+			expErr: "$: value is not one of the allowed values",
+		},
+		{
+			name:   "enum number compared to string",
+			schema: `{"enum":["1"]}`,
+			value:  `1`,
+			expErr: "$: value is not one of the allowed values",
+		},
+		{
+			name:   "const large integers that are equal as float64",
+			schema: `{"const":9007199254740992}`,
+			value:  `9007199254740993`,
+			expErr: "$: value does not equal the required constant",
+		},
+		{
+			name:   "const array order",
+			schema: `{"const":[1,2]}`,
+			value:  `[2,1]`,
+			expErr: "$: value does not equal the required constant",
+		},
+
+		// Strings
+		{name: "length counts characters", schema: `{"minLength":3,"maxLength":3}`, value: `"日本語"`},
+		{name: "pattern is not anchored", schema: `{"pattern":"b"}`, value: `"abc"`},
+		{name: "anchored pattern", schema: `{"pattern":"^[0-9]{3}-[0-9]{4}$"}`, value: `"555-1234"`},
+		{name: "pattern with escaped characters", schema: `{"pattern":"^\\d+\\.\\d+$"}`, value: `"1.25"`},
+		{name: "date", schema: `{"format":"date"}`, value: `"2024-02-29"`},
+		{name: "date-time", schema: `{"format":"date-time"}`, value: `"2024-02-29T13:14:15.5-06:00"`},
+		{
+			name:   "too short",
+			schema: `{"minLength":2}`,
+			value:  `"é"`,
+			expErr: "$: length 1 is less than the minimum 2",
+		},
+		{
+			name:   "too long",
+			schema: `{"maxLength":2}`,
+			value:  `"abc"`,
+			expErr: "$: length 3 is more than the maximum 2",
+		},
+		{
+			name:   "anchored pattern does not match extra characters",
+			schema: `{"pattern":"^[0-9]{3}$"}`,
+			value:  `"1234"`,
+			expErr: `$: value does not match pattern "^[0-9]{3}$"`,
+		},
+		{
+			name:   "pattern dot does not match newline",
+			schema: `{"pattern":"^a.b$"}`,
+			value:  `"a\nb"`,
+			expErr: `$: value does not match pattern "^a.b$"`,
+		},
+		{
+			name:   "invalid date",
+			schema: `{"format":"date"}`,
+			value:  `"2023-02-29"`,
+			expErr: "$: value is not a valid date",
+		},
+		{
+			name:   "date-time without zone",
+			schema: `{"format":"date-time"}`,
+			value:  `"2024-02-29T13:14:15"`,
+			expErr: "$: value is not a valid date-time",
+		},
+
+		// Arrays
+		{name: "items", schema: `{"items":{"type":"integer"},"minItems":1,"maxItems":2}`, value: `[1,2]`},
+		{
+			name:   "too few items",
+			schema: `{"minItems":1}`,
+			value:  `[]`,
+			expErr: "$: 0 items is less than the minimum 1",
+		},
+		{
+			name:   "too many items",
+			schema: `{"maxItems":1}`,
+			value:  `[1,2]`,
+			expErr: "$: 2 items is more than the maximum 1",
+		},
+		{
+			name:   "invalid item",
+			schema: `{"items":{"type":"integer"}}`,
+			value:  `[1,2,"3"]`,
+			expErr: "$[2]: expected integer, got string",
+		},
+
+		// Objects
+		{
+			name:   "properties and additional properties",
+			schema: `{"required":["a"],"properties":{"a":{"type":"string"}},"additionalProperties":{"type":"number"}}`,
+			value:  `{"a":"x","b":1,"c":2}`,
+		},
+		{name: "additional properties allowed by default", schema: `{"properties":{"a":{}}}`, value: `{"b":1}`},
+		{
+			name:   "missing required property",
+			schema: `{"required":["a","b"]}`,
+			value:  `{"a":1}`,
+			expErr: `$: missing required property "b"`,
+		},
+		{
+			name:   "unknown property",
+			schema: `{"properties":{"a":{}},"additionalProperties":false}`,
+			value:  `{"a":1,"b":2}`,
+			expErr: `$: unknown property "b"`,
+		},
+		{
+			name:   "invalid additional property",
+			schema: `{"additionalProperties":{"type":"number"}}`,
+			value:  `{"a":1,"b":"2"}`,
+			expErr: "$.b: expected number, got string",
+		},
+		{
+			name:   "nested error path",
+			schema: `{"properties":{"a":{"items":{"properties":{"b":{"type":"boolean"}}}}}}`,
+			value:  `{"a":[{"b":true},{"b":null}]}`,
+			expErr: "$.a[1].b: expected boolean, got null",
+		},
+		{
+			name:   "first invalid property in sorted order",
+			schema: `{"additionalProperties":{"type":"string"}}`,
+			value:  `{"z":1,"m":2,"a":3}`,
+			expErr: "$.a: expected string, got number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !assert.NoError(t, ValidateSchema(tc.schema), "ValidateSchema") {
+				return
+			}
+			err := Validate(tc.schema, tc.value)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
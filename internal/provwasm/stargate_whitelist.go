@@ -255,6 +255,22 @@ func init() {
 	setWhitelistedQuery("/provenance.marker.v1.Query/DenomMetadata", &markertypes.QueryDenomMetadataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/AccountData", &markertypes.QueryAccountDataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValues", &markertypes.QueryNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Distribution", &markertypes.QueryDistributionResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Distributions", &markertypes.QueryDistributionsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/SupplyAllowanceUsages", &markertypes.QuerySupplyAllowanceUsagesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Lockup", &markertypes.QueryLockupResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Lockups", &markertypes.QueryLockupsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/RedemptionConfig", &markertypes.QueryRedemptionConfigResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/PendingRedemptions", &markertypes.QueryPendingRedemptionsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/TransferLimit", &markertypes.QueryTransferLimitResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/TransferLimits", &markertypes.QueryTransferLimitsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/FilteredMarkers", &markertypes.QueryFilteredMarkersResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/SendDenyList", &markertypes.QuerySendDenyListResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Recovery", &markertypes.QueryRecoveryResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Recoveries", &markertypes.QueryRecoveriesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/CapTable", &markertypes.QueryCapTableResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/HolderLimit", &markertypes.QueryHolderLimitResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/IbcChannelPolicy", &markertypes.QueryIbcChannelPolicyResponse{})
 
 	// metadata
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Params", &metadatatypes.QueryParamsResponse{})
@@ -279,8 +295,19 @@ func init() {
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorsByURI", &metadatatypes.OSLocatorsByURIResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorsByScope", &metadatatypes.OSLocatorsByScopeResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSAllLocators", &metadatatypes.OSAllLocatorsResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorsByOwner", &metadatatypes.OSLocatorsByOwnerResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/AccountData", &metadatatypes.AccountDataResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeNetAssetValues", &metadatatypes.QueryScopeNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeHistory", &metadatatypes.ScopeHistoryResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeChildren", &metadatatypes.ScopeChildrenResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeAncestors", &metadatatypes.ScopeAncestorsResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopesBySpecification", &metadatatypes.ScopesBySpecificationResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopesByPartyRole", &metadatatypes.ScopesByPartyRoleResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopesByDataAccess", &metadatatypes.ScopesByDataAccessResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/RecordsByOutputHash", &metadatatypes.RecordsByOutputHashResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeLien", &metadatatypes.ScopeLienResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeLiensByLienholder", &metadatatypes.ScopeLiensByLienholderResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeValueHolders", &metadatatypes.ScopeValueHoldersResponse{})
 
 	// msg fee
 	setWhitelistedQuery("/provenance.msgfees.v1.Query/Params", &msgfeestypes.QueryParamsResponse{})
@@ -367,6 +367,7 @@ func (s *CmdTestSuite) TestCmdTxFillAsks() {
 			},
 			args: []string{"fill-asks", "--from", s.addr4.String(), "--market", "5",
 				"--price", "2500peach", "--settlement-fee", "75peach", "--creation-fee", "10peach"},
+			gas:          300_000,
 			expectedCode: 0,
 		},
 	}
@@ -447,7 +448,7 @@ func (s *CmdTestSuite) TestCmdTxMarketSettle() {
 				return args, s.assertBalancesFollowup(expBals)
 			},
 			args:         []string{"settle", "--from", s.addr1.String(), "--market", "5"},
-			gas:          300_000,
+			gas:          350_000,
 			expectedCode: 0,
 		},
 	}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1340,6 +1342,9 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 	// These tests all assume that the fee denom is nhash.
 	s.Require().Equal("nhash", pioconfig.GetProvenanceConfig().FeeDenom, "pioconfig.GetProvenanceConfig().FeeDenom")
 
+	// splitScopeID is a scope with a nav of 400cherry for the whole scope, that gets its value split into 4 units.
+	splitScopeID := metadatatypes.ScopeMetadataAddress(uuid.MustParse("5d7e8c0e-3b4f-4a53-9d3c-6c1f0a9e2b71"))
+
 	tests := []struct {
 		name         string
 		setup        func()
@@ -1766,6 +1771,36 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				ToFeeNav: &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("31nhash")},
 			},
 		},
+		{
+			name: "units of a split scope",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 2,
+					CommitmentSettlementBips: 100,
+					IntermediaryDenom:        "cherry",
+				})
+				nav := metadatatypes.NewNetAssetValue(s.coin("400cherry"), 1)
+				s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(s.ctx, splitScopeID, nav, "test"), "SetNetAssetValue")
+				s.Require().NoError(s.app.MetadataKeeper.SplitScopeValue(s.ctx, splitScopeID, 4, s.addr2), "SplitScopeValue")
+			},
+			markerKeeper: NewMockMarkerKeeper().WithGetNetAssetValueResult(s.coin("10cherry"), s.coin("30nhash")),
+			expGetNav:    []*GetNetAssetValueArgs{{markerDenom: "cherry", priceDenom: "nhash"}},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 2,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(splitScopeID.Denom(), 3))}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(splitScopeID.Denom(), 3))}},
+			},
+			expResp: &exchange.QueryCommitmentSettlementFeeCalcResponse{
+				InputTotal: sdk.NewCoins(sdk.NewInt64Coin(splitScopeID.Denom(), 3)),
+				// 3 units*400cherry/4 units = 300cherry
+				ConvertedTotal: s.coins("300cherry"),
+				// 300cherry*30nhash/10cherry = 900nhash
+				// 900nhash * 100/20000 = 4.5nhash => 5nhash
+				ExchangeFees:   s.coins("5nhash"),
+				ConversionNavs: []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin(splitScopeID.Denom(), 4), Price: s.coin("400cherry")}},
+				ToFeeNav:       &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("30nhash")},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -54,6 +54,9 @@ func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, p
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	if err := h.markerHooks.ValidateIbcChannelPolicy(ctx, packet); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
+	}
 	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper); err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
 	}
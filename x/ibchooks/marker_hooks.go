@@ -66,6 +66,12 @@ func (h MarkerHooks) AddUpdateMarker(ctx sdktypes.Context, packet exported.Packe
 	return h.createNewIbcMarker(ctx, data, ibcDenom, coinType, transferAuthAddrs, allowForceTransfer, packet, ibcKeeper)
 }
 
+// ValidateIbcChannelPolicy returns an error if the IBC channel policy of the received denom's marker does not allow
+// it to be received over the packet's channel.
+func (h MarkerHooks) ValidateIbcChannelPolicy(ctx sdktypes.Context, packet exported.PacketI) error {
+	return h.MarkerKeeper.ValidateIbcReceive(ctx, MustExtractDenomFromPacketOnRecv(packet), packet.GetDestChannel())
+}
+
 func (h MarkerHooks) updateMarkerProperties(ctx sdktypes.Context, transferAuthAddrs []sdktypes.AccAddress, marker markertypes.MarkerAccountI, allowForceTransfer bool) error {
 	if marker.GetMarkerType() != markertypes.MarkerType_RestrictedCoin {
 		return nil
@@ -213,6 +219,9 @@ func (h MarkerHooks) SetupMarkerMemoFn(
 	if err != nil {
 		return nil, err
 	}
+	if marker != nil {
+		marker = marker.WithoutExpiredAccess(ctx.BlockTime())
+	}
 	memoAsJSON["marker"], err = CreateMarkerMemo(marker)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "ics20data marshall error")
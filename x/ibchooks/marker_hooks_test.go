@@ -169,6 +169,34 @@ func (suite *MarkerHooksTestSuite) TestAddUpdateMarker() {
 	}
 }
 
+func (suite *MarkerHooksTestSuite) TestValidateIbcChannelPolicy() {
+	app := suite.chainA.GetProvenanceApp()
+	ctx := suite.chainA.GetContext()
+	markerHooks := ibchooks.NewMarkerHooks(&app.MarkerKeeper)
+	address1 := sdk.AccAddress("address1")
+	memo := fmt.Sprintf(`{"marker":{"transfer-auths":["%s"]}}`, address1.String())
+	packet := suite.makeMockPacket("fiftyfivepolicies", "", memo, 0)
+	ibcDenom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+	channel := suite.path.EndpointA.ChannelID
+
+	suite.Require().NoError(markerHooks.ValidateIbcChannelPolicy(ctx, packet), "ValidateIbcChannelPolicy before the marker exists")
+	suite.Require().NoError(markerHooks.AddUpdateMarker(ctx, packet, app.IBCKeeper), "AddUpdateMarker")
+
+	allowOther := markertypes.NewIbcChannelPolicy(ibcDenom, markertypes.IbcChannelPolicyMode_Allowlist, []string{"channel-99"}, nil)
+	suite.Require().NoError(app.MarkerKeeper.SetIbcChannelPolicy(ctx, allowOther), "SetIbcChannelPolicy allowlist")
+	suite.Require().EqualError(markerHooks.ValidateIbcChannelPolicy(ctx, packet),
+		fmt.Sprintf("%s cannot be received over ibc channel %s", ibcDenom, channel), "ValidateIbcChannelPolicy channel not in allowlist")
+
+	allowChannel := markertypes.NewIbcChannelPolicy(ibcDenom, markertypes.IbcChannelPolicyMode_Allowlist, []string{channel}, nil)
+	suite.Require().NoError(app.MarkerKeeper.SetIbcChannelPolicy(ctx, allowChannel), "SetIbcChannelPolicy allowlist with channel")
+	suite.Require().NoError(markerHooks.ValidateIbcChannelPolicy(ctx, packet), "ValidateIbcChannelPolicy channel in allowlist")
+
+	denyChannel := markertypes.NewIbcChannelPolicy(ibcDenom, markertypes.IbcChannelPolicyMode_Denylist, []string{channel}, nil)
+	suite.Require().NoError(app.MarkerKeeper.SetIbcChannelPolicy(ctx, denyChannel), "SetIbcChannelPolicy denylist")
+	suite.Require().EqualError(markerHooks.ValidateIbcChannelPolicy(ctx, packet),
+		fmt.Sprintf("%s cannot be received over ibc channel %s", ibcDenom, channel), "ValidateIbcChannelPolicy channel in denylist")
+}
+
 func (suite *MarkerHooksTestSuite) TestProcessMarkerMemo() {
 	address1 := sdk.AccAddress("address1")
 	address2 := sdk.AccAddress("address2")
@@ -31,6 +31,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 			}
 			// else supply is equal, nothing to do here.
 		}
+		// Remove any access grants that have expired.
+		if rerr := k.RemoveExpiredAccessGrants(ctx, record); rerr != nil {
+			ctx.Logger().Error("could not remove expired access grants", "denom", record.GetDenom(), "err", rerr)
+		}
 		// Clear out markers that are in the destroyed status
 		if record.GetStatus() == types.StatusDestroyed {
 			k.RemoveMarker(ctx, record)
@@ -49,4 +53,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Lift the deny send entries that have reached their expiration.
+	if err = k.RemoveExpiredSendDenies(ctx); err != nil {
+		ctx.Logger().Error("could not remove expired deny send entries", "err", err)
+	}
+
+	// Pay the next batch of holders awaiting payment from marker distributions.
+	k.ProcessDistributions(ctx, types.DistributionPaymentsPerBlock)
 }
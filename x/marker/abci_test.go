@@ -2,6 +2,7 @@ package marker_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -67,3 +68,74 @@ func TestBeginBlocker(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, deleted)
 }
+
+func TestBeginBlockerRemovesExpiredAccess(t *testing.T) {
+	app := piosimapp.Setup(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+
+	admin := sdk.AccAddress("admin_______________")
+	minter := sdk.AccAddress("minter______________")
+	denom := "expiringcoin"
+	testMarker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin})},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, testMarker), "AddFinalizeAndActivateMarker")
+
+	grant := types.NewAccessGrant(minter, types.AccessList{types.Access_Mint})
+	past := now.Add(-time.Second)
+	grant.Expiration = &past
+	require.ErrorContains(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, grant),
+		"must be after the current block time", "AddAccess with an expiration in the past")
+
+	expiration := now.Add(time.Hour)
+	grant.Expiration = &expiration
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, grant), "AddAccess with an expiration")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 10)), "MintCoin before expiration")
+
+	ctx = ctx.WithBlockTime(expiration)
+	require.ErrorContains(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 10)),
+		"does not have ACCESS_MINT", "MintCoin at expiration")
+	accessResp, err := app.MarkerKeeper.Access(ctx, &types.QueryAccessRequest{Id: denom})
+	require.NoError(t, err, "Access query")
+	require.Len(t, accessResp.Accounts, 1, "Access query grants after expiration")
+	stored, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Len(t, stored.GetAccessList(), 2, "GetMarkerByDenom grants after expiration")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	marker.BeginBlocker(ctx, app.MarkerKeeper, app.BankKeeper)
+
+	acc := app.AccountKeeper.GetAccount(ctx, types.MustGetMarkerAddress(denom))
+	require.NotNil(t, acc, "marker account")
+	require.Len(t, acc.(types.MarkerAccountI).GetAccessList(), 1, "stored grants after BeginBlocker")
+
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerAccessExpired(*grant, denom))
+	require.NoError(t, err, "TypedEventToEvent")
+	require.Contains(t, ctx.EventManager().Events(), expEvent, "BeginBlocker events")
+
+	// Adding access for an address with an expired grant should not bring back the expired permissions,
+	// and the new permissions should not pick up the old expiration.
+	expiration = expiration.Add(time.Hour)
+	grant.Expiration = &expiration
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, grant), "AddAccess mint again")
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, types.NewAccessGrant(minter, types.AccessList{types.Access_Burn})),
+		"AddAccess burn after mint expired")
+	expEvent, err = sdk.TypedEventToEvent(types.NewEventMarkerAccessExpired(*grant, denom))
+	require.NoError(t, err, "TypedEventToEvent")
+	require.Contains(t, ctx.EventManager().Events(), expEvent, "AddAccess events")
+	stored, err = app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, *types.NewAccessGrant(minter, types.AccessList{types.Access_Burn}),
+		types.GrantsForAddress(minter, stored.GetAccessList()...), "minter grant")
+}
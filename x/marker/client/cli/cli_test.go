@@ -458,7 +458,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","recovery_challenge_period":"604800s"}`,
 		},
 		{
 			"get testcoin marker json",
@@ -564,7 +564,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			name:           "account data",
 			cmd:            markercli.AccountDataCmd(),
 			args:           []string{s.holderDenom},
-			expectedOutput: "value: Do not sell this coin.",
+			expectedOutput: "document: null\nschema: \"\"\nvalue: Do not sell this coin.",
 		},
 		{
 			name:           "marker net asset value query",
@@ -2,10 +2,14 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -14,6 +18,13 @@ import (
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
+const (
+	FlagGrantAddress      = "grant-address"
+	FlagAccess            = "access"
+	FlagStatus            = "status"
+	FlagRequiredAttribute = "required-attribute"
+)
+
 // GetQueryCmd returns the top-level command for marker CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -33,6 +44,22 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		DistributionCmd(),
+		DistributionsCmd(),
+		SupplyAllowanceUsagesCmd(),
+		LockupCmd(),
+		LockupsCmd(),
+		RedemptionConfigCmd(),
+		PendingRedemptionsCmd(),
+		TransferLimitCmd(),
+		TransferLimitsCmd(),
+		FilteredMarkersCmd(),
+		SendDenyListCmd(),
+		RecoveryCmd(),
+		RecoveriesCmd(),
+		CapTableCmd(),
+		HolderLimitCmd(),
+		IbcChannelPolicyCmd(),
 	)
 	return queryCmd
 }
@@ -334,3 +361,644 @@ func NetAssetValuesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// DistributionCmd is the CLI command for querying the status of a marker distribution.
+func DistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution <distribution id>",
+		Aliases: []string{"dist"},
+		Short:   "Get the status of a marker distribution",
+		Example: fmt.Sprintf(`$ %s query marker distribution 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(strings.TrimSpace(args[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryDistributionResponse
+			if response, err = queryClient.Distribution(
+				context.Background(),
+				&types.QueryDistributionRequest{DistributionId: id},
+			); err != nil {
+				fmt.Printf("failed to query distribution %d: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionsCmd is the CLI command for listing a marker's distributions.
+func DistributionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distributions [address|denom]",
+		Aliases: []string{"dists"},
+		Short:   "List the distributions for a marker",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker distributions "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionsResponse
+			if response, err = queryClient.Distributions(
+				context.Background(),
+				&types.QueryDistributionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q distributions: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "distributions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SupplyAllowanceUsagesCmd is the CLI command for querying an address's mint and burn allowance usage on a marker.
+func SupplyAllowanceUsagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance-usage [address|denom] <grantee address>",
+		Aliases: []string{"allowances", "au"},
+		Short:   "Get the mint and burn allowances granted to an address on a marker and the amounts used",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker allowance-usage "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			addr := strings.TrimSpace(args[1])
+
+			var response *types.QuerySupplyAllowanceUsagesResponse
+			if response, err = queryClient.SupplyAllowanceUsages(
+				context.Background(),
+				&types.QuerySupplyAllowanceUsagesRequest{Id: id, Address: addr},
+			); err != nil {
+				fmt.Printf("failed to query marker %q allowance usage for %s: %v\n", id, addr, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// LockupCmd is the CLI command for querying an account's lockup of a marker's denom.
+func LockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lockup [address|denom] <account address>",
+		Short:   "Get an account's lockup of a marker's denom and the amount currently locked",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker lockup "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			addr := strings.TrimSpace(args[1])
+
+			var response *types.QueryLockupResponse
+			if response, err = queryClient.Lockup(
+				context.Background(),
+				&types.QueryLockupRequest{Id: id, Address: addr},
+			); err != nil {
+				fmt.Printf("failed to query marker %q lockup for %s: %v\n", id, addr, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// LockupsCmd is the CLI command for listing the lockups of a marker's denom.
+func LockupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lockups [address|denom]",
+		Short:   "List the account lockups of a marker's denom",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker lockups "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryLockupsResponse
+			if response, err = queryClient.Lockups(
+				context.Background(),
+				&types.QueryLockupsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q lockups: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "lockups")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RedemptionConfigCmd is the CLI command for querying how holders can redeem a marker's denom.
+func RedemptionConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redemption-config [address|denom]",
+		Short:   "Get how holders can redeem a marker's denom",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker redemption-config "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryRedemptionConfigResponse
+			if response, err = queryClient.RedemptionConfig(
+				context.Background(),
+				&types.QueryRedemptionConfigRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q redemption config: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingRedemptionsCmd is the CLI command for querying the redemptions of a marker's denom awaiting approval.
+func PendingRedemptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-redemptions [address|denom]",
+		Short:   "List the redemptions of a marker's denom that are awaiting approval",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker pending-redemptions "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryPendingRedemptionsResponse
+			if response, err = queryClient.PendingRedemptions(
+				context.Background(),
+				&types.QueryPendingRedemptionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q pending redemptions: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "pending redemptions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferLimitCmd is the CLI command for querying the transfer limit that applies to an account.
+func TransferLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-limit [address|denom] [account address]",
+		Short:   "Get the transfer limit that applies to an account along with how much of it is used and left",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker transfer-limit "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			address := strings.TrimSpace(args[1])
+
+			var response *types.QueryTransferLimitResponse
+			if response, err = queryClient.TransferLimit(
+				context.Background(),
+				&types.QueryTransferLimitRequest{Id: id, Address: address},
+			); err != nil {
+				fmt.Printf("failed to query marker %q transfer limit for %s: %v\n", id, address, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferLimitsCmd is the CLI command for querying a marker's default and account transfer limits.
+func TransferLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-limits [address|denom]",
+		Short:   "List a marker's default transfer limit and the transfer limits of specific accounts",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker transfer-limits "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryTransferLimitsResponse
+			if response, err = queryClient.TransferLimits(
+				context.Background(),
+				&types.QueryTransferLimitsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q transfer limits: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "transfer limits")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FilteredMarkersCmd is the CLI command for listing the markers that match a set of filters.
+func FilteredMarkersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filtered",
+		Short: "List the markers that match all of the provided filters",
+		Example: strings.TrimSpace(fmt.Sprintf(`$ %[1]s query marker filtered --%[2]s pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --%[3]s admin
+$ %[1]s query marker filtered --%[4]s restricted --%[5]s active --%[6]s true
+$ %[1]s query marker filtered --%[7]s kyc.provenance.io --%[8]s false`,
+			version.AppName, FlagGrantAddress, FlagAccess, FlagType, FlagStatus, FlagAllowForceTransfer,
+			FlagRequiredAttribute, FlagSupplyFixed)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req, err := readFilteredMarkersRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags()); err != nil {
+				return err
+			}
+
+			var response *types.QueryFilteredMarkersResponse
+			if response, err = queryClient.FilteredMarkers(context.Background(), req); err != nil {
+				fmt.Printf("failed to query markers: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(FlagGrantAddress, "", "Only list markers where this address has an access grant")
+	cmd.Flags().String(FlagAccess, "", "Only list markers where the grant address has this access, e.g. admin, mint, transfer")
+	cmd.Flags().String(FlagType, "", "Only list markers of this type (coin or restricted)")
+	cmd.Flags().String(FlagStatus, "", "Only list markers with this status, e.g. proposed, active")
+	cmd.Flags().String(FlagSupplyFixed, "", "Only list markers that do (true) or do not (false) have a fixed supply")
+	cmd.Flags().String(FlagAllowForceTransfer, "", "Only list markers that do (true) or do not (false) allow forced transfers")
+	cmd.Flags().String(FlagRequiredAttribute, "", "Only list markers that require this attribute name, on its own or in an expression")
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readFilteredMarkersRequest builds a filtered markers request (without pagination) from the provided flags.
+func readFilteredMarkersRequest(flagSet *pflag.FlagSet) (*types.QueryFilteredMarkersRequest, error) {
+	req := &types.QueryFilteredMarkersRequest{}
+	var err error
+	if req.GrantAddress, err = flagSet.GetString(FlagGrantAddress); err != nil {
+		return nil, err
+	}
+	accessName, err := flagSet.GetString(FlagAccess)
+	if err != nil {
+		return nil, err
+	}
+	if len(accessName) > 0 {
+		if req.Access = types.AccessByName(accessName); req.Access == types.Access_Unknown {
+			return nil, fmt.Errorf("invalid access %q", accessName)
+		}
+	}
+	markerType, err := flagSet.GetString(FlagType)
+	if err != nil {
+		return nil, err
+	}
+	if len(markerType) > 0 {
+		if req.MarkerType, err = types.MarkerTypeFromString(markerType); err != nil {
+			return nil, err
+		}
+	}
+	markerStatus, err := flagSet.GetString(FlagStatus)
+	if err != nil {
+		return nil, err
+	}
+	if len(markerStatus) > 0 {
+		if req.Status, err = types.MarkerStatusFromString(markerStatus); err != nil {
+			return nil, err
+		}
+	}
+	if req.SupplyFixed, err = readFlagFilter(flagSet, FlagSupplyFixed); err != nil {
+		return nil, err
+	}
+	if req.AllowForcedTransfer, err = readFlagFilter(flagSet, FlagAllowForceTransfer); err != nil {
+		return nil, err
+	}
+	if req.RequiredAttribute, err = flagSet.GetString(FlagRequiredAttribute); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// readFlagFilter reads a flag that is either empty (no filter), true, or false.
+func readFlagFilter(flagSet *pflag.FlagSet, name string) (types.FlagFilter, error) {
+	value, err := flagSet.GetString(name)
+	if err != nil || len(value) == 0 {
+		return types.FlagFilterAny, err
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return types.FlagFilterAny, fmt.Errorf("invalid --%s value %q: %w", name, value, err)
+	}
+	if b {
+		return types.FlagFilterTrue, nil
+	}
+	return types.FlagFilterFalse, nil
+}
+
+// SendDenyListCmd is the CLI command for querying the entries of a marker's deny send list.
+func SendDenyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deny-list [address|denom]",
+		Aliases: []string{"send-deny-list"},
+		Short:   "Get the entries of a restricted marker's deny send list",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker deny-list "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QuerySendDenyListResponse
+			if response, err = queryClient.SendDenyList(
+				context.Background(),
+				&types.QuerySendDenyListRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q deny send list: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "deny send entries")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RecoveryCmd is the CLI command for querying a proposed recovery of a marker's denom.
+func RecoveryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recovery [address|denom] [recovery id]",
+		Short:   "Get a proposed recovery of a marker's denom",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker recovery "hotdogcoin" 3`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			recoveryID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid recovery id %q: %w", args[1], err)
+			}
+
+			var response *types.QueryRecoveryResponse
+			if response, err = queryClient.Recovery(
+				context.Background(),
+				&types.QueryRecoveryRequest{Id: id, RecoveryId: recoveryID},
+			); err != nil {
+				fmt.Printf("failed to query marker %q recovery %d: %v\n", id, recoveryID, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// RecoveriesCmd is the CLI command for querying the proposed recoveries of a marker's denom.
+func RecoveriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recoveries [address|denom] [<account address>]",
+		Short: "List the proposed recoveries of a marker's denom, optionally only those of funds from an account",
+		Long:  `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %[1]s query marker recoveries "hotdogcoin"
+$ %[1]s query marker recoveries "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRecoveriesRequest{Id: id, Pagination: pageReq}
+			if len(args) > 1 {
+				req.Address = strings.TrimSpace(args[1])
+			}
+
+			var response *types.QueryRecoveriesResponse
+			if response, err = queryClient.Recoveries(context.Background(), req); err != nil {
+				fmt.Printf("failed to query marker %q recoveries: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "recoveries")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CapTableCmd is the CLI command for querying a snapshot of all holders of a marker's denom.
+func CapTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cap-table [address|denom]",
+		Short: "Get a snapshot of all accounts holding a marker's denom, including held and quarantined funds",
+		Long: `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name.
+Use --height to get the cap table as of a specific block height.`,
+		Example: fmt.Sprintf(`$ %[1]s query marker cap-table "hotdogcoin"
+$ %[1]s query marker cap-table "hotdogcoin" --height 1000 --csv`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryCapTableResponse
+			if response, err = queryClient.CapTable(context.Background(), &types.QueryCapTableRequest{Id: id}); err != nil {
+				fmt.Printf("failed to query marker %q cap table: %v\n", id, err)
+				return nil
+			}
+			if asCSV, _ := cmd.Flags().GetBool(FlagCSV); asCSV {
+				return WriteCapTableCSV(cmd.OutOrStdout(), response.CapTable)
+			}
+			return clientCtx.PrintProto(&response.CapTable)
+		},
+	}
+	AddCapTableFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddCapTableFlags adds the flags used by the cap table commands.
+func AddCapTableFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagCSV, false, "Output the cap table as CSV")
+}
+
+// WriteCapTableCSV writes a cap table as CSV with a header row followed by one row per holder.
+func WriteCapTableCSV(w io.Writer, capTable types.CapTable) error {
+	cw := csv.NewWriter(w)
+	records := [][]string{{"address", "balance", "held", "quarantined", "total", "percentage"}}
+	for _, e := range capTable.Entries {
+		records = append(records, []string{
+			e.Address, e.Balance.String(), e.Held.String(), e.Quarantined.String(), e.Total.String(), e.Percentage.String(),
+		})
+	}
+	return cw.WriteAll(records)
+}
+
+// HolderLimitCmd is the CLI command for querying a marker's holder limit and holder count.
+func HolderLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-limit [address|denom]",
+		Short:   "Get a marker's holder limit and the number of accounts currently holding its denom",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker holder-limit "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryHolderLimitResponse
+			if response, err = queryClient.HolderLimit(context.Background(), &types.QueryHolderLimitRequest{Id: id}); err != nil {
+				fmt.Printf("failed to query marker %q holder limit: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IbcChannelPolicyCmd is the CLI command for querying a marker's IBC channel policy.
+func IbcChannelPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-channel-policy [address|denom]",
+		Short:   "Get a marker's IBC channel policy and how much of its denom is escrowed for each capped channel",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker ibc-channel-policy "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryIbcChannelPolicyResponse
+			if response, err = queryClient.IbcChannelPolicy(context.Background(), &types.QueryIbcChannelPolicyRequest{Id: id}); err != nil {
+				fmt.Printf("failed to query marker %q ibc channel policy: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -56,6 +57,21 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagMintAllowance          = "mint-allowance"
+	FlagBurnAllowance          = "burn-allowance"
+	FlagAllowancePeriod        = "allowance-period"
+	FlagRedemptionPrice        = "price"
+	FlagUseNetAssetValue       = "use-nav"
+	FlagRedemptionWindow       = "window"
+	FlagRequiresApproval       = "requires-approval"
+	FlagDailyLimit             = "daily"
+	FlagWeeklyLimit            = "weekly"
+	FlagReason                 = "reason"
+	FlagRecoveryPeriod         = "recovery-challenge-period"
+	FlagCSV                    = "csv"
+	FlagMaxHolders             = "max-holders"
+	FlagMinHolding             = "min-holding"
+	FlagCaps                   = "caps"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -88,6 +104,7 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateRequiredAttributes(),
 		GetCmdUpdateForcedTransfer(),
 		GetCmdSetAccountData(),
+		GetCmdSetAccountDataSchema(),
 		GetCmdUpdateSendDenyListRequest(),
 		GetCmdAddNetAssetValues(),
 		GetCmdSupplyDecreaseProposal(),
@@ -97,6 +114,23 @@ func NewTxCmd() *cobra.Command {
 		GetCmdChangeStatusProposal(),
 		GetCmdWithdrawEscrowProposal(),
 		GetUpdateMarkerParamsCmd(),
+		GetCmdCreateDistribution(),
+		GetCmdSetLockup(),
+		GetCmdRemoveLockup(),
+		GetCmdSplitMarker(),
+		GetCmdSetRedemptionConfig(),
+		GetCmdRedeem(),
+		GetCmdApproveRedemption(),
+		GetCmdRejectRedemption(),
+		GetCmdSetTransferLimit(),
+		GetCmdRemoveTransferLimit(),
+		GetCmdProposeRecovery(),
+		GetCmdCancelRecovery(),
+		GetCmdExecuteRecovery(),
+		GetCmdSetHolderLimit(),
+		GetCmdRemoveHolderLimit(),
+		GetCmdSetIbcChannelPolicy(),
+		GetCmdRemoveIbcChannelPolicy(),
 	)
 	return txCmd
 }
@@ -342,8 +376,14 @@ func GetCmdAddAccess() *cobra.Command {
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
-		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer].
+A mint and/or burn allowance can be provided to limit the amount the address can mint and/or burn.
+If an allowance period is provided, the amount used against the allowances resets after each period.
+If an expiration is provided, the grant is removed from the marker once that time is reached.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom mint --%[2]s 1000 --%[3]s 24h --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom withdraw --%[4]s 2030-01-01T00:00:00Z --from mykey`,
+			version.AppName, FlagMintAllowance, FlagAllowancePeriod, FlagExpiration),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -355,6 +395,25 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 				return cerrs.Wrapf(err, "grant for invalid address %s", args[0])
 			}
 			grant := types.NewAccessGrant(targetAddr, types.AccessListByNames(args[2]))
+			grant.MintAllowance, err = readSupplyAllowanceFlags(cmd.Flags(), FlagMintAllowance)
+			if err != nil {
+				return err
+			}
+			grant.BurnAllowance, err = readSupplyAllowanceFlags(cmd.Flags(), FlagBurnAllowance)
+			if err != nil {
+				return err
+			}
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != "" {
+				expiresAt, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return fmt.Errorf("invalid %s value %q: %w", FlagExpiration, exp, err)
+				}
+				grant.Expiration = &expiresAt
+			}
 			if err = grant.Validate(); err != nil {
 				return cerrs.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
@@ -363,10 +422,35 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagMintAllowance, "", "the maximum amount the address can mint (per period, if provided)")
+	cmd.Flags().String(FlagBurnAllowance, "", "the maximum amount the address can burn (per period, if provided)")
+	cmd.Flags().Duration(FlagAllowancePeriod, 0, "the length of time after which the amounts used against the allowances reset")
+	cmd.Flags().String(FlagExpiration, "", "the RFC 3339 timestamp at which the grant expires")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// readSupplyAllowanceFlags reads a mint or burn allowance limit from the given flag along with the allowance period.
+// Returns nil if the limit flag was not provided.
+func readSupplyAllowanceFlags(flagSet *pflag.FlagSet, limitFlag string) (*types.SupplyAllowance, error) {
+	limitStr, err := flagSet.GetString(limitFlag)
+	if err != nil {
+		return nil, err
+	}
+	if len(limitStr) == 0 {
+		return nil, nil
+	}
+	limit, ok := sdkmath.NewIntFromString(limitStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value %q: must be an integer", limitFlag, limitStr)
+	}
+	period, err := flagSet.GetDuration(FlagAllowancePeriod)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewSupplyAllowance(limit, period), nil
+}
+
 // GetCmdDeleteAccess implements the revoke administrative access for a marker command.
 func GetCmdDeleteAccess() *cobra.Command {
 	cmd := &cobra.Command{
@@ -973,10 +1057,13 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 		Short:   "Update list of addresses for a restricted marker that are allowed to execute transfers",
 		Long: strings.TrimSpace(`Update list of addresses for a restricted marker that are allowed to execute transfers.
 `),
-		Example: fmt.Sprintf(`$ %s tx marker update-deny-list hotdogcoin --%s=bech32addr1,bech32addrs2,... --%s=bech32addr1,bech32addrs2,...`,
+		Example: fmt.Sprintf(`$ %[1]s tx marker update-deny-list hotdogcoin --%[2]s=bech32addr1,bech32addrs2,... --%[3]s=bech32addr1,bech32addrs2,...
+$ %[1]s tx marker update-deny-list hotdogcoin --%[2]s=bech32addr1 --%[4]s "sanctions screening" --%[5]s 2026-01-01T00:00:00Z`,
 			version.AppName,
 			FlagAdd,
 			FlagRemove,
+			FlagReason,
+			FlagExpiration,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -997,6 +1084,22 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagRemove, err)
 			}
 
+			msg.Reason, err = flagSet.GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			exp, err := flagSet.GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != "" {
+				expiresAt, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return fmt.Errorf("invalid %s value %q: %w", FlagExpiration, exp, err)
+				}
+				msg.Expiration = &expiresAt
+			}
+
 			authSetter := func(authority string) {
 				msg.Authority = authority
 			}
@@ -1006,6 +1109,8 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 	}
 	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of bech32 addresses to be added to restricted marker transfer deny list")
 	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of bech32 addresses to be removed removed from restricted marker deny list")
+	cmd.Flags().String(FlagReason, "", "why the added addresses are denied")
+	cmd.Flags().String(FlagExpiration, "", "the RFC 3339 timestamp at which the added addresses are removed from the deny list")
 	addOptGovPropFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -1050,6 +1155,56 @@ $ %[1]s tx marker account-data hotdogcoin --%[4]s`,
 	return cmd
 }
 
+// GetCmdSetAccountDataSchema returns a CLI command for setting or removing a marker's account data schema.
+func GetCmdSetAccountDataSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-data-schema <denom> {<schema file>|--" + attrcli.FlagDelete + "}",
+		Aliases: []string{"accountdataschema", "ads"},
+		Short:   "Set or remove the JSON schema that a marker's account data must conform to",
+		Example: fmt.Sprintf(`$ %[1]s tx marker account-data-schema hotdogcoin hotdogcoin-schema.json
+$ %[1]s tx marker account-data-schema hotdogcoin --%[2]s`,
+			version.AppName, attrcli.FlagDelete),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgSetAccountDataSchemaRequest{Denom: strings.TrimSpace(args[0])}
+
+			remove, err := flagSet.GetBool(attrcli.FlagDelete)
+			if err != nil {
+				return err
+			}
+			switch {
+			case remove && len(args) > 1:
+				return fmt.Errorf("a schema file cannot be provided with --%s", attrcli.FlagDelete)
+			case !remove && len(args) < 2:
+				return fmt.Errorf("a schema file or --%s is required", attrcli.FlagDelete)
+			case !remove:
+				bz, err := os.ReadFile(args[1])
+				if err != nil {
+					return fmt.Errorf("could not read schema file: %w", err)
+				}
+				msg.Schema = string(bz)
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setSigner, msg)
+		},
+	}
+
+	cmd.Flags().Bool(attrcli.FlagDelete, false, "Remove the marker's account data schema")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdAddNetAssetValues returns a CLI command for adding/updating marker net asset values.
 func GetCmdAddNetAssetValues() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1619,19 +1774,838 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			recoveryPeriod, err := flagSet.GetDuration(FlagRecoveryPeriod)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				recoveryPeriod,
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().Duration(FlagRecoveryPeriod, types.DefaultRecoveryChallengePeriod, "How long a proposed recovery must wait before it can be executed")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// GetCmdCreateDistribution returns a CLI command for distributing marker escrow funds to the marker's holders.
+func GetCmdCreateDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-distribution <denom> <amount>",
+		Aliases: []string{"distribute", "cd"},
+		Short:   "Distribute funds from a marker's escrow to the holders of the marker, pro-rata",
+		Long: strings.TrimSpace(`Distribute funds from a marker's escrow to the holders of the marker, pro-rata.
+The holders of the marker are recorded when the distribution is created and are paid over the following blocks.
+Must be called by a user with withdraw access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker create-distribution hotdogcoin 1000000nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.ErrInvalidCoins.Wrapf("invalid amount %s", args[1])
+			}
+
+			msg := types.NewMsgCreateDistributionRequest(strings.TrimSpace(args[0]), amount, "")
+
+			setAdmin := func(admin string) {
+				msg.Administrator = admin
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, setAdmin, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetLockup returns a CLI command for setting an account's lockup of a marker's denom.
+func GetCmdSetLockup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-lockup <denom> <address> <amount>,<release time>[;<amount>,<release time>...]",
+		Aliases: []string{"lockup", "lock"},
+		Short:   "Set the amounts of a marker's denom that an account cannot send until their release times",
+		Long: strings.TrimSpace(`Set the amounts of a marker's denom that an account cannot send until their release times.
+Release times are RFC 3339 timestamps. Any existing lockup for the account is replaced.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-lockup hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj "500,2026-01-01T00:00:00Z;500,2027-01-01T00:00:00Z" --from mykey`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid address %s: %w", args[1], err)
+			}
+			releases, err := ParseLockupReleasesString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLockupRequest(strings.TrimSpace(args[0]), addr, releases, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveLockup returns a CLI command for removing an account's lockup of a marker's denom.
+func GetCmdRemoveLockup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-lockup <denom> <address>",
+		Aliases: []string{"unlock"},
+		Short:   "Remove an account's lockup of a marker's denom",
+		Long: strings.TrimSpace(`Remove an account's lockup of a marker's denom.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker remove-lockup hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid address %s: %w", args[1], err)
+			}
+
+			msg := types.NewMsgRemoveLockupRequest(strings.TrimSpace(args[0]), addr, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSplitMarker returns a CLI command for splitting a marker's denom.
+func GetCmdSplitMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split <denom> <numerator>:<denominator>",
+		Short: "Split (or reverse split) a marker's denom",
+		Long: strings.TrimSpace(`Multiply every balance of a marker's denom, and the marker's supply, by numerator/denominator.
+Each resulting balance is rounded down. A denominator larger than the numerator is a reverse split.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker split hotdogcoin 2:1 --from mykey
+$ %[1]s tx marker split hotdogcoin 1:10 --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			parts := strings.Split(args[1], ":")
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid split ratio %q: expected <numerator>:<denominator>", args[1])
+			}
+			numerator, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid split numerator %q: %w", parts[0], err)
+			}
+			denominator, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid split denominator %q: %w", parts[1], err)
+			}
+
+			msg := types.NewMsgSplitMarkerRequest(strings.TrimSpace(args[0]), numerator, denominator, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetRedemptionConfig returns a CLI command for setting or removing a marker's redemption configuration.
+func GetCmdSetRedemptionConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-redemption-config <denom> [<redeem denom>]",
+		Short: "Set or remove how holders can redeem a marker's denom for funds in its escrow",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set how holders can redeem a marker's denom for the redeem denom held in the marker's escrow.
+The price is either provided with --%[1]s as <amount>:<units> (amount of the redeem denom paid per units of the marker's denom),
+or taken from the marker's net asset value in the redeem denom using --%[2]s.
+Redemptions can be limited to one or more windows using --%[3]s <RFC 3339 start>,<RFC 3339 end> (can be repeated).
+Use --%[4]s to queue redemptions until they are approved or rejected by an account with withdraw access.
+Use --%[5]s (without a redeem denom) to remove the marker's redemption configuration.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`,
+			FlagRedemptionPrice, FlagUseNetAssetValue, FlagRedemptionWindow, FlagRequiresApproval, FlagRemove)),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-redemption-config fundshare usdcoin --%[2]s 105:100 --from mykey
+$ %[1]s tx marker set-redemption-config fundshare usdcoin --%[3]s --%[4]s --%[5]s 2025-01-01T00:00:00Z,2025-01-08T00:00:00Z --from mykey
+$ %[1]s tx marker set-redemption-config fundshare --%[6]s --from mykey`,
+			version.AppName, FlagRedemptionPrice, FlagUseNetAssetValue, FlagRequiresApproval, FlagRedemptionWindow, FlagRemove),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			denom := strings.TrimSpace(args[0])
+			config, err := parseRedemptionConfig(flagSet, denom, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRedemptionConfigRequest(denom, config, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRedemptionPrice, "", "the fixed redemption price as <amount>:<units>")
+	cmd.Flags().Bool(FlagUseNetAssetValue, false, "use the marker's net asset value in the redeem denom as the price")
+	cmd.Flags().StringArray(FlagRedemptionWindow, nil, "a period in which redemptions are allowed as <RFC 3339 start>,<RFC 3339 end>")
+	cmd.Flags().Bool(FlagRequiresApproval, false, "queue redemptions until they are approved")
+	cmd.Flags().Bool(FlagRemove, false, "remove the marker's redemption configuration")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseRedemptionConfig builds a redemption config from the set-redemption-config arguments and flags.
+// A nil config is returned when the config is being removed.
+func parseRedemptionConfig(flagSet *pflag.FlagSet, denom string, args []string) (*types.RedemptionConfig, error) {
+	remove, err := flagSet.GetBool(FlagRemove)
+	if err != nil {
+		return nil, err
+	}
+	if remove {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("a redeem denom cannot be provided with --%s", FlagRemove)
+		}
+		return nil, nil
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("a redeem denom is required unless --%s is provided", FlagRemove)
+	}
+
+	config := &types.RedemptionConfig{
+		Denom:       denom,
+		RedeemDenom: strings.TrimSpace(args[0]),
+		Units:       sdkmath.ZeroInt(),
+		Amount:      sdkmath.ZeroInt(),
+	}
+	if config.UseNetAssetValue, err = flagSet.GetBool(FlagUseNetAssetValue); err != nil {
+		return nil, err
+	}
+	if config.RequiresApproval, err = flagSet.GetBool(FlagRequiresApproval); err != nil {
+		return nil, err
+	}
+
+	price, err := flagSet.GetString(FlagRedemptionPrice)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(price) > 0 && config.UseNetAssetValue:
+		return nil, fmt.Errorf("only one of --%s or --%s can be provided", FlagRedemptionPrice, FlagUseNetAssetValue)
+	case len(price) > 0:
+		parts := strings.Split(price, ":")
+		var ok bool
+		if len(parts) == 2 {
+			config.Amount, ok = sdkmath.NewIntFromString(strings.TrimSpace(parts[0]))
+			if ok {
+				config.Units, ok = sdkmath.NewIntFromString(strings.TrimSpace(parts[1]))
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid redemption price %q: expected <amount>:<units>", price)
+		}
+	case !config.UseNetAssetValue:
+		return nil, fmt.Errorf("one of --%s or --%s is required", FlagRedemptionPrice, FlagUseNetAssetValue)
+	}
+
+	windows, err := flagSet.GetStringArray(FlagRedemptionWindow)
+	if err != nil {
+		return nil, err
+	}
+	for _, window := range windows {
+		parts := strings.Split(window, ",")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid redemption window %q: expected <start>,<end>", window)
+		}
+		start, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid redemption window start %q: %w", parts[0], err)
+		}
+		end, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid redemption window end %q: %w", parts[1], err)
+		}
+		config.Windows = append(config.Windows, types.NewRedemptionWindow(start, end))
+	}
+
+	return config, nil
+}
+
+// GetCmdRedeem returns a CLI command for redeeming units of a marker's denom.
+func GetCmdRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem <amount>",
+		Short: "Redeem units of a marker's denom for funds in the marker's escrow",
+		Long: strings.TrimSpace(`Redeem units of a marker's denom for funds in the marker's escrow.
+The units are burned and the redeemer is paid according to the marker's redemption config.
+If the marker's redemptions require approval, the units are held until the redemption is approved or rejected.`),
+		Example: fmt.Sprintf(`$ %s tx marker redeem 100fundshare --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %s: %w", args[0], err)
+			}
+			msg := types.NewMsgRedeemRequest(amount, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveRedemption returns a CLI command for approving a redemption that is awaiting approval.
+func GetCmdApproveRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-redemption <denom> <redemption id>",
+		Short: "Approve a redemption that is awaiting approval",
+		Long: strings.TrimSpace(`Approve a redemption of a marker's denom that is awaiting approval.
+Must be called by a user with withdraw access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker approve-redemption fundshare 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgApproveRedemptionRequest(strings.TrimSpace(args[0]), id, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRejectRedemption returns a CLI command for rejecting a redemption that is awaiting approval.
+func GetCmdRejectRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-redemption <denom> <redemption id>",
+		Short: "Reject a redemption that is awaiting approval",
+		Long: strings.TrimSpace(`Reject a redemption of a marker's denom that is awaiting approval. The units are returned to the redeemer.
+Must be called by a user with withdraw access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker reject-redemption fundshare 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgRejectRedemptionRequest(strings.TrimSpace(args[0]), id, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseLockupReleasesString parses a semicolon delimited list of <amount>,<RFC 3339 release time> entries.
+func ParseLockupReleasesString(releasesString string) ([]types.LockupRelease, error) {
+	entries := strings.Split(releasesString, ";")
+	releases := make([]types.LockupRelease, len(entries))
+	for i, entry := range entries {
+		parts := strings.Split(entry, ",")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid lockup release %q, expected amount,release time", entry)
+		}
+		amount, ok := sdkmath.NewIntFromString(strings.TrimSpace(parts[0]))
+		if !ok {
+			return nil, fmt.Errorf("invalid lockup release amount %q", parts[0])
+		}
+		releaseTime, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid lockup release time %q: %w", parts[1], err)
+		}
+		releases[i] = types.NewLockupRelease(releaseTime, amount)
+	}
+	return releases, nil
+}
+
+// GetCmdSetTransferLimit returns a CLI command for setting a marker's default transfer limit or an account's limit.
+func GetCmdSetTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-limit <denom> [<address>] {--" + FlagDailyLimit + " <amount>|--" + FlagWeeklyLimit + " <amount>}",
+		Short: "Set how much of a restricted marker's denom can be sent per rolling day and week",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set how much of a restricted marker's denom can be sent per rolling day and week.
+If an address is provided, the limit only applies to that account (replacing the default for it).
+Otherwise, the marker's default limit is set, which applies to every account without its own limit.
+At least one of --%[1]s or --%[2]s must be provided.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`, FlagDailyLimit, FlagWeeklyLimit)),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-limit hotdogcoin --%[2]s 1000 --%[3]s 5000 --from mykey
+$ %[1]s tx marker set-transfer-limit hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --%[2]s 10000 --from mykey`,
+			version.AppName, FlagDailyLimit, FlagWeeklyLimit),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			var addr sdk.AccAddress
+			if len(args) > 1 {
+				addr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid address %s: %w", args[1], err)
+				}
+			}
+			daily, err := readTransferLimitFlag(flagSet, FlagDailyLimit)
+			if err != nil {
+				return err
+			}
+			weekly, err := readTransferLimitFlag(flagSet, FlagWeeklyLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferLimitRequest(strings.TrimSpace(args[0]), addr, daily, weekly, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDailyLimit, "", "the most that can be sent in any 24 hour period")
+	cmd.Flags().String(FlagWeeklyLimit, "", "the most that can be sent in any 7 day period")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveTransferLimit returns a CLI command for removing a marker's default transfer limit or an account's limit.
+func GetCmdRemoveTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-transfer-limit <denom> [<address>]",
+		Short: "Remove a marker's default transfer limit or an account's transfer limit",
+		Long: strings.TrimSpace(`Remove a marker's default transfer limit or, if an address is provided, an account's transfer limit.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker remove-transfer-limit hotdogcoin --from mykey
+$ %[1]s tx marker remove-transfer-limit hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			var addr sdk.AccAddress
+			if len(args) > 1 {
+				addr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid address %s: %w", args[1], err)
+				}
+			}
+
+			msg := types.NewMsgRemoveTransferLimitRequest(strings.TrimSpace(args[0]), addr, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeRecovery returns a CLI command for proposing a recovery of funds from an account.
+func GetCmdProposeRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-recovery <amount> <from> <to>",
+		Short: "Propose a forced transfer of restricted coin funds that can be executed after a challenge period",
+		Long: strings.TrimSpace(`Propose a forced transfer of restricted coin funds out of an account, e.g. one whose keys were lost.
+The recovery can be executed once the marker module's recovery challenge period has ended.
+Until then, the owner of the funds can cancel it.
+Must be called by a user with force transfer access on a marker that allows forced transfers.`),
+		Example: fmt.Sprintf(`$ %s tx marker propose-recovery 1000hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --reason "lost keys" --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %s: %w", args[0], err)
+			}
+			from, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid from address %s: %w", args[1], err)
+			}
+			to, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid to address %s: %w", args[2], err)
+			}
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgProposeRecoveryRequest(amount, from, to, reason, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Why the funds are being recovered")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelRecovery returns a CLI command for cancelling a proposed recovery.
+func GetCmdCancelRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recovery <denom> <recovery id>",
+		Short: "Cancel a proposed recovery",
+		Long: strings.TrimSpace(`Cancel a proposed recovery of a marker's denom before it is executed.
+Must be called by the account the funds would be taken from, or the account that proposed the recovery.`),
+		Example: fmt.Sprintf(`$ %s tx marker cancel-recovery hotdogcoin 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid recovery id %q: %w", args[1], err)
+			}
+			msg := types.NewMsgCancelRecoveryRequest(strings.TrimSpace(args[0]), id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExecuteRecovery returns a CLI command for executing a proposed recovery.
+func GetCmdExecuteRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-recovery <denom> <recovery id>",
+		Short: "Execute a proposed recovery whose challenge period has ended",
+		Long: strings.TrimSpace(`Execute a proposed recovery of a marker's denom whose challenge period has ended.
+Must be called by a user with force transfer access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker execute-recovery hotdogcoin 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid recovery id %q: %w", args[1], err)
+			}
+			msg := types.NewMsgExecuteRecoveryRequest(strings.TrimSpace(args[0]), id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetHolderLimit returns a CLI command for setting a marker's maximum holder count and minimum holding.
+func GetCmdSetHolderLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-holder-limit <denom> {--" + FlagMaxHolders + " <count>|--" + FlagMinHolding + " <amount>}",
+		Short: "Set how many accounts can hold a restricted marker's denom and the least they can hold",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set how many accounts can hold a restricted marker's denom and the least they can hold.
+Accounts that already hold the denom are not affected until they send or receive more of it.
+At least one of --%[1]s or --%[2]s must be provided.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`, FlagMaxHolders, FlagMinHolding)),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-holder-limit hotdogcoin --%[2]s 99 --%[3]s 25000 --from mykey`,
+			version.AppName, FlagMaxHolders, FlagMinHolding),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			maxHolders, err := flagSet.GetUint64(FlagMaxHolders)
+			if err != nil {
+				return err
+			}
+			minHolding, err := readTransferLimitFlag(flagSet, FlagMinHolding)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetHolderLimitRequest(strings.TrimSpace(args[0]), maxHolders, minHolding, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxHolders, 0, "the most accounts that can hold the denom at once")
+	cmd.Flags().String(FlagMinHolding, "", "the least an account can hold without holding none at all")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveHolderLimit returns a CLI command for removing a marker's holder limit.
+func GetCmdRemoveHolderLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-holder-limit <denom>",
+		Short: "Remove a marker's holder limit",
+		Long: strings.TrimSpace(`Remove a marker's maximum holder count and minimum holding.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker remove-holder-limit hotdogcoin --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveHolderLimitRequest(strings.TrimSpace(args[0]), "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetIbcChannelPolicy returns a CLI command for setting a restricted marker's IBC channel policy.
+func GetCmdSetIbcChannelPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ibc-channel-policy <denom> {allowlist|denylist} [<channel>[,<channel>...]]",
+		Short: "Set the IBC channels that a restricted marker's denom can be transferred over",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set the IBC channels that a restricted marker's denom can be transferred over.
+With an allowlist, the denom can only be sent and received over the listed channels.
+With a denylist, the denom can be sent and received over every channel except the listed ones.
+The optional --%[1]s limit how much of the denom can be out over an allowed channel at once,
+i.e. held in the channel's escrow account.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`, FlagCaps)),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-ibc-channel-policy hotdogcoin allowlist channel-0,channel-4 --%[2]s channel-4=50000 --from mykey
+$ %[1]s tx marker set-ibc-channel-policy hotdogcoin denylist channel-7 --from mykey`,
+			version.AppName, FlagCaps),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			mode, err := parseIbcChannelPolicyMode(args[1])
+			if err != nil {
+				return err
+			}
+			var channels []string
+			if len(args) > 2 {
+				for _, channel := range strings.Split(args[2], ",") {
+					channels = append(channels, strings.TrimSpace(channel))
+				}
+			}
+			caps, err := readIbcChannelCapsFlag(flagSet)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetIbcChannelPolicyRequest(
+				types.NewIbcChannelPolicy(strings.TrimSpace(args[0]), mode, channels, caps), "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagCaps, nil, "caps of allowed channels, each as <channel>=<amount>")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveIbcChannelPolicy returns a CLI command for removing a marker's IBC channel policy.
+func GetCmdRemoveIbcChannelPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ibc-channel-policy <denom>",
+		Short: "Remove a marker's IBC channel policy",
+		Long: strings.TrimSpace(`Remove a marker's IBC channel policy so its denom can be transferred over any channel.
+Must be called by a user with admin access on the marker, or submitted as a gov proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker remove-ibc-channel-policy hotdogcoin --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveIbcChannelPolicyRequest(strings.TrimSpace(args[0]), "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseIbcChannelPolicyMode parses an IBC channel policy mode, e.g. "allowlist" or "IBC_CHANNEL_POLICY_MODE_ALLOWLIST".
+func parseIbcChannelPolicyMode(arg string) (types.IbcChannelPolicyMode, error) {
+	name := strings.ToUpper(strings.TrimSpace(arg))
+	if !strings.HasPrefix(name, "IBC_CHANNEL_POLICY_MODE_") {
+		name = "IBC_CHANNEL_POLICY_MODE_" + name
+	}
+	mode, ok := types.IbcChannelPolicyMode_value[name]
+	if !ok || mode == int32(types.IbcChannelPolicyMode_Unspecified) {
+		return types.IbcChannelPolicyMode_Unspecified, fmt.Errorf("invalid ibc channel policy mode %q: must be allowlist or denylist", arg)
+	}
+	return types.IbcChannelPolicyMode(mode), nil
+}
+
+// readIbcChannelCapsFlag reads the <channel>=<amount> entries of the caps flag.
+func readIbcChannelCapsFlag(flagSet *pflag.FlagSet) ([]types.IbcChannelCap, error) {
+	entries, err := flagSet.GetStringSlice(FlagCaps)
+	if err != nil {
+		return nil, err
+	}
+	var rv []types.IbcChannelCap
+	for _, entry := range entries {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s entry %q: expected <channel>=<amount>", FlagCaps, entry)
+		}
+		amount, ok := sdkmath.NewIntFromString(strings.TrimSpace(parts[1]))
+		if !ok {
+			return nil, fmt.Errorf("invalid %s entry %q: amount must be an integer", FlagCaps, entry)
+		}
+		rv = append(rv, types.NewIbcChannelCap(strings.TrimSpace(parts[0]), amount))
+	}
+	return rv, nil
+}
+
+// readTransferLimitFlag reads an amount from the given flag. Zero is returned if the flag was not provided.
+func readTransferLimitFlag(flagSet *pflag.FlagSet, limitFlag string) (sdkmath.Int, error) {
+	limitStr, err := flagSet.GetString(limitFlag)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if len(limitStr) == 0 {
+		return sdkmath.ZeroInt(), nil
+	}
+	limit, ok := sdkmath.NewIntFromString(limitStr)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("invalid %s value %q: must be an integer", limitFlag, limitStr)
+	}
+	return limit, nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMarkerAccountDataSchema sets a marker's account data schema, or removes it if the schema is empty.
+// The marker's current account data (if it has any) must conform to a new schema.
+func (k Keeper) SetMarkerAccountDataSchema(ctx sdk.Context, marker types.MarkerAccountI, schema string, signer string) error {
+	markerAddr := marker.GetAddress()
+	if len(schema) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		key := types.AccountDataSchemaKey(markerAddr)
+		if !store.Has(key) {
+			return fmt.Errorf("%s marker does not have an account data schema", marker.GetDenom())
+		}
+		store.Delete(key)
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccountDataSchemaRemoved(marker.GetDenom(), signer))
+	}
+
+	if maxLen := int(k.attrKeeper.GetMaxValueLength(ctx)); len(schema) > maxLen {
+		return fmt.Errorf("account data schema length %d exceeds maximum length of %d", len(schema), maxLen)
+	}
+	value, err := k.attrKeeper.GetAccountData(ctx, markerAddr.String())
+	if err != nil {
+		return fmt.Errorf("could not get %s account data: %w", marker.GetDenom(), err)
+	}
+	if len(value) > 0 {
+		if err = types.ValidateAccountData(schema, value); err != nil {
+			return fmt.Errorf("current %s account data: %w", marker.GetDenom(), err)
+		}
+	}
+	if err = k.SetAccountDataSchema(ctx, types.NewAccountDataSchema(marker.GetDenom(), schema)); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccountDataSchemaSet(marker.GetDenom(), signer))
+}
+
+// SetMarkerAccountData sets a marker's account data after making sure it conforms to the marker's account data
+// schema (if it has one). Account data can always be deleted.
+func (k Keeper) SetMarkerAccountData(ctx sdk.Context, marker types.MarkerAccountI, value string) error {
+	if len(value) > 0 {
+		schema, err := k.GetAccountDataSchema(ctx, marker.GetAddress())
+		if err != nil {
+			return err
+		}
+		if len(schema) > 0 {
+			if err = types.ValidateAccountData(schema, value); err != nil {
+				return err
+			}
+		}
+	}
+	return k.attrKeeper.SetAccountData(ctx, marker.GetAddress().String(), value)
+}
+
+// GetAccountDataSchema returns a marker's account data schema, or an empty string if it doesn't have one.
+func (k Keeper) GetAccountDataSchema(ctx sdk.Context, markerAddr sdk.AccAddress) (string, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountDataSchemaKey(markerAddr))
+	if len(bz) == 0 {
+		return "", nil
+	}
+	var schema types.AccountDataSchema
+	if err := k.cdc.Unmarshal(bz, &schema); err != nil {
+		return "", fmt.Errorf("could not read account data schema: %w", err)
+	}
+	return schema.Schema, nil
+}
+
+// SetAccountDataSchema stores an account data schema. It does not check the marker's current account data.
+func (k Keeper) SetAccountDataSchema(ctx sdk.Context, schema types.AccountDataSchema) error {
+	if err := schema.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(schema.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&schema)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.AccountDataSchemaKey(markerAddr), bz)
+	return nil
+}
+
+// IterateAccountDataSchemas iterates all account data schemas with the given handler function.
+func (k Keeper) IterateAccountDataSchemas(ctx sdk.Context, handler func(schema types.AccountDataSchema) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AccountDataSchemaKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var schema types.AccountDataSchema
+		if err := k.cdc.Unmarshal(it.Value(), &schema); err != nil {
+			return err
+		}
+		if handler(schema) {
+			break
+		}
+	}
+	return nil
+}
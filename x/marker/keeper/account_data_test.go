@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAccountDataSchema(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	denom := "prospectuscoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Deposit})},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	schema := `{"type":"object","required":["isin","maturity"],"properties":{` +
+		`"isin":{"type":"string","pattern":"^[A-Z]{2}[A-Z0-9]{9}[0-9]$"},"maturity":{"type":"string","format":"date"}}}`
+	setData := func(value string) error {
+		_, err := msgServer.SetAccountData(ctx, types.NewMsgSetAccountDataRequest(denom, value, admin))
+		return err
+	}
+	setSchema := func(signer sdk.AccAddress, schema string) error {
+		_, err := msgServer.SetAccountDataSchema(ctx, types.NewMsgSetAccountDataSchemaRequest(denom, schema, signer))
+		return err
+	}
+	query := func() *types.QueryAccountDataResponse {
+		resp, err := app.MarkerKeeper.AccountData(ctx, &types.QueryAccountDataRequest{Denom: denom})
+		require.NoError(t, err, "AccountData query")
+		return resp
+	}
+
+	// Without a schema, any value can be set, and it's only parsed if it's a JSON object.
+	require.NoError(t, setData("This is prospectuscoin's marker data."), "SetAccountData plain text")
+	resp := query()
+	require.Nil(t, resp.Document, "document of plain text account data")
+	require.Empty(t, resp.Schema, "schema before one is set")
+
+	require.ErrorContains(t, setSchema(other, schema), "does not have ACCESS_DEPOSIT", "SetAccountDataSchema without deposit access")
+	require.ErrorContains(t, setSchema(admin, `{"type":"object","properties":{"a":{"$ref":"#"}}}`),
+		`unsupported keyword "$ref"`, "SetAccountDataSchema unsupported schema")
+	require.ErrorContains(t, setSchema(admin, schema),
+		"current prospectuscoin account data: account data is not valid JSON", "SetAccountDataSchema with non-conforming data")
+
+	require.NoError(t, setData(""), "SetAccountData delete")
+	require.NoError(t, setSchema(admin, schema), "SetAccountDataSchema")
+	require.Equal(t, schema, query().Schema, "schema after it is set")
+
+	require.ErrorContains(t, setData(`{"isin":"US0378331005"}`), `missing required property "maturity"`, "SetAccountData missing maturity")
+	require.ErrorContains(t, setData(`{"isin":"US037833100","maturity":"2030-06-30"}`), "$.isin: value does not match pattern", "SetAccountData bad isin")
+	require.NoError(t, setData(`{"isin":"US0378331005","maturity":"2030-06-30"}`), "SetAccountData conforming")
+	resp = query()
+	require.NotNil(t, resp.Document, "document of conforming account data")
+	require.Equal(t, "US0378331005", resp.Document.Fields["isin"].GetStringValue(), "document isin")
+	require.Equal(t, "2030-06-30", resp.Document.Fields["maturity"].GetStringValue(), "document maturity")
+
+	genState := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.AccountDataSchema{types.NewAccountDataSchema(denom, schema)}, genState.AccountDataSchemas, "exported schemas")
+
+	require.NoError(t, setSchema(admin, ""), "SetAccountDataSchema remove")
+	require.Empty(t, query().Schema, "schema after it is removed")
+	require.ErrorContains(t, setSchema(admin, ""), "does not have an account data schema", "SetAccountDataSchema remove again")
+	require.NoError(t, setData("free text again"), "SetAccountData after schema removed")
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// useSupplyAllowance records the use of an amount against the caller's mint or burn allowance on a marker.
+// An error is returned if the amount exceeds what remains of the allowance for the current period.
+// Nothing is recorded if the caller's grant does not have an allowance for the access.
+func (k Keeper) useSupplyAllowance(ctx sdk.Context, marker types.MarkerAccountI, caller sdk.AccAddress, access types.Access, amount sdkmath.Int) error {
+	allowance := types.GrantsForAddress(caller, marker.GetAccessList()...).GetSupplyAllowance(access)
+	if allowance == nil {
+		return nil
+	}
+
+	usage := k.GetSupplyAllowanceUsage(ctx, marker.GetAddress(), caller, access)
+	blockTime := ctx.BlockTime().UTC()
+	if usage.Used.IsZero() || (allowance.Period > 0 && !blockTime.Before(usage.PeriodStart.Add(allowance.Period))) {
+		usage.Used = sdkmath.ZeroInt()
+		usage.PeriodStart = blockTime
+	}
+
+	used := usage.Used.Add(amount)
+	if used.GT(allowance.Limit) {
+		return fmt.Errorf("%s of %s%s by %s exceeds remaining allowance of %s%s", access, amount, marker.GetDenom(),
+			caller, allowance.Limit.Sub(usage.Used), marker.GetDenom())
+	}
+	usage.Used = used
+
+	return k.SetSupplyAllowanceUsage(ctx, usage)
+}
+
+// GetSupplyAllowanceUsage returns the amount an address has used of its mint or burn allowance on a marker.
+func (k Keeper) GetSupplyAllowanceUsage(ctx sdk.Context, markerAddr, addr sdk.AccAddress, access types.Access) types.SupplyAllowanceUsage {
+	usage := types.SupplyAllowanceUsage{
+		MarkerAddress: markerAddr.String(),
+		Address:       addr.String(),
+		Access:        access,
+		Used:          sdkmath.ZeroInt(),
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyAllowanceUsageKey(markerAddr, addr, access))
+	if len(bz) > 0 {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
+
+// SetSupplyAllowanceUsage stores the amount an address has used of its mint or burn allowance on a marker.
+func (k Keeper) SetSupplyAllowanceUsage(ctx sdk.Context, usage types.SupplyAllowanceUsage) error {
+	if err := usage.Validate(); err != nil {
+		return err
+	}
+	markerAddr := sdk.MustAccAddressFromBech32(usage.MarkerAddress)
+	addr := sdk.MustAccAddressFromBech32(usage.Address)
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.SupplyAllowanceUsageKey(markerAddr, addr, usage.Access), bz)
+	return nil
+}
+
+// GetSupplyAllowanceUsages returns the recorded mint and burn allowance usages for an address on a marker.
+func (k Keeper) GetSupplyAllowanceUsages(ctx sdk.Context, markerAddr, addr sdk.AccAddress) []types.SupplyAllowanceUsage {
+	var usages []types.SupplyAllowanceUsage
+	k.iterateSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageAddressPrefix(markerAddr, addr), func(usage types.SupplyAllowanceUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
+	return usages
+}
+
+// IterateSupplyAllowanceUsages iterates all recorded mint and burn allowance usages.
+func (k Keeper) IterateSupplyAllowanceUsages(ctx sdk.Context, handler func(usage types.SupplyAllowanceUsage) (stop bool)) {
+	k.iterateSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageKeyPrefix, handler)
+}
+
+// iterateSupplyAllowanceUsages iterates the allowance usages under the given key prefix.
+func (k Keeper) iterateSupplyAllowanceUsages(ctx sdk.Context, pre []byte, handler func(usage types.SupplyAllowanceUsage) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var usage types.SupplyAllowanceUsage
+		k.cdc.MustUnmarshal(it.Value(), &usage)
+		if handler(usage) {
+			break
+		}
+	}
+}
+
+// clearSupplyAllowanceUsages removes the recorded allowance usages under the given key prefix.
+func (k Keeper) clearSupplyAllowanceUsages(ctx sdk.Context, pre []byte) {
+	k.deletePrefix(ctx, pre)
+}
+
+// deletePrefix removes all entries under the given key prefix.
+func (k Keeper) deletePrefix(ctx sdk.Context, pre []byte) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, pre)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
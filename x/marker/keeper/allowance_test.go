@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSupplyAllowances(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	admin := sdk.AccAddress("admin_______________")
+	minter := sdk.AccAddress("minter______________")
+	burner := sdk.AccAddress("burner______________")
+
+	denom := "allowancecoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Burn})},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	mintGrant := types.NewAccessGrant(minter, types.AccessList{types.Access_Mint})
+	mintGrant.MintAllowance = types.NewSupplyAllowance(sdkmath.NewInt(100), 24*time.Hour)
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, mintGrant), "AddAccess minter")
+	burnGrant := types.NewAccessGrant(burner, types.AccessList{types.Access_Burn})
+	burnGrant.BurnAllowance = types.NewSupplyAllowance(sdkmath.NewInt(50), 0)
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, burnGrant), "AddAccess burner")
+
+	// Adding another permission keeps the existing allowance.
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, denom, types.NewAccessGrant(minter, types.AccessList{types.Access_Deposit})), "AddAccess deposit")
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, mintGrant.MintAllowance, types.GrantsForAddress(minter, m.GetAccessList()...).MintAllowance, "minter allowance after adding access")
+
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 60)), "first mint")
+	require.EqualError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 41)),
+		"ACCESS_MINT of 41allowancecoin by "+minter.String()+" exceeds remaining allowance of 40allowancecoin", "mint over allowance")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 40)), "mint remaining allowance")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin(denom, 500)), "mint without an allowance")
+
+	resp, err := app.MarkerKeeper.SupplyAllowanceUsages(ctx, &types.QuerySupplyAllowanceUsagesRequest{Id: denom, Address: minter.String()})
+	require.NoError(t, err, "SupplyAllowanceUsages")
+	require.Len(t, resp.Usages, 1, "usages")
+	require.Equal(t, sdkmath.NewInt(100), resp.Usages[0].Used, "used amount")
+
+	// The mint allowance resets once the period has passed.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 100)), "mint in next period")
+
+	// The burn allowance never resets.
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, burner, sdk.NewInt64Coin(denom, 50)), "burn allowance")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	require.ErrorContains(t, app.MarkerKeeper.BurnCoin(ctx, burner, sdk.NewInt64Coin(denom, 1)), "exceeds remaining allowance of 0allowancecoin", "burn after allowance used")
+
+	// Removing access clears the usage.
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, admin, denom, burner), "RemoveAccess burner")
+	require.Empty(t, app.MarkerKeeper.GetSupplyAllowanceUsages(ctx, markerAddr, burner), "burner usages after access removed")
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// GetCapTable returns a snapshot of every account holding the denom, including held and quarantined amounts.
+func (k Keeper) GetCapTable(ctx sdk.Context, denom string) (*types.CapTable, error) {
+	fundsHolder := ""
+	if k.quarantineKeeper != nil {
+		fundsHolder = k.quarantineKeeper.GetFundsHolder().String()
+	}
+	builder := types.NewCapTableBuilder(denom, fundsHolder)
+
+	var holdErr error
+	err := k.iterateDenomOwners(ctx, denom, func(owner sdk.AccAddress, balance sdkmath.Int) bool {
+		builder.AddBalance(owner.String(), balance)
+		if k.holdKeeper == nil {
+			return false
+		}
+		held, err := k.holdKeeper.GetHoldCoin(ctx, owner, denom)
+		if err != nil {
+			holdErr = err
+			return true
+		}
+		builder.AddHeld(owner.String(), held.Amount)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if holdErr != nil {
+		return nil, holdErr
+	}
+
+	if k.quarantineKeeper != nil {
+		k.quarantineKeeper.IterateQuarantineRecords(ctx, nil, func(toAddr, _ sdk.AccAddress, record *quarantine.QuarantineRecord) bool {
+			builder.AddQuarantined(toAddr.String(), record.Coins.AmountOf(denom))
+			return false
+		})
+	}
+
+	capTable := builder.Build(ctx.BlockHeight(), k.bankKeeper.GetSupply(ctx, denom).Amount)
+	return &capTable, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestCapTable(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(42)
+
+	admin := sdk.AccAddress("admin_______________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+
+	denom := "fundshare"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 0),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint})},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, alice, sdk.NewCoins(sdk.NewInt64Coin(denom, 600))), "FundAccount alice")
+	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, bob, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))), "FundAccount bob")
+	require.NoError(t, app.HoldKeeper.AddHold(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), "testing"), "AddHold")
+	require.NoError(t, app.QuarantineKeeper.SetOptIn(ctx, carol), "SetOptIn carol")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, bob, carol, sdk.NewCoins(sdk.NewInt64Coin(denom, 150))), "SendCoins to carol")
+
+	_, err := app.MarkerKeeper.CapTable(ctx, nil)
+	require.ErrorContains(t, err, "invalid request", "CapTable with nil request")
+	_, err = app.MarkerKeeper.CapTable(ctx, &types.QueryCapTableRequest{Id: "nosuchcoin"})
+	require.Error(t, err, "CapTable for unknown marker")
+
+	resp, err := app.MarkerKeeper.CapTable(ctx, &types.QueryCapTableRequest{Id: denom})
+	require.NoError(t, err, "CapTable")
+	capTable := resp.CapTable
+	require.Equal(t, int64(42), capTable.Height, "height")
+	require.Equal(t, "1000", capTable.Supply.String(), "supply")
+
+	type row struct {
+		addr, balance, held, quarantined, total, percentage string
+	}
+	var rows []row
+	for _, e := range capTable.Entries {
+		rows = append(rows, row{e.Address, e.Balance.String(), e.Held.String(), e.Quarantined.String(), e.Total.String(), e.Percentage.String()})
+	}
+	require.Equal(t, []row{
+		{alice.String(), "600", "100", "0", "600", "60.000000000000000000"},
+		{bob.String(), "250", "0", "0", "250", "25.000000000000000000"},
+		{carol.String(), "0", "0", "150", "150", "15.000000000000000000"},
+	}, rows, "entries")
+}
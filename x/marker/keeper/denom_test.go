@@ -448,7 +448,7 @@ func (s *DenomTestSuite) TestValidateDenomMetadataExtended() {
 
 	for _, tc := range tests {
 		s.T().Run(tc.name, func(t *testing.T) {
-			s.app.MarkerKeeper.SetParams(s.ctx, types.Params{UnrestrictedDenomRegex: tc.denomValidationExpression})
+			s.app.MarkerKeeper.SetParams(s.ctx, types.Params{UnrestrictedDenomRegex: tc.denomValidationExpression, RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod})
 
 			err := s.app.MarkerKeeper.ValidateDenomMetadata(s.ctx, tc.proposed, tc.existing, tc.markerStatus)
 			if len(tc.wantInErr) > 0 {
@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// CreateDistribution moves the provided amount out of the marker's escrow, records the current holders of
+// the marker's denom, and schedules each holder to be paid their pro-rata share of the amount.
+// Payments are made in batches during BeginBlock; see ProcessDistributions.
+func (k Keeper) CreateDistribution(ctx sdk.Context, marker types.MarkerAccountI, amount sdk.Coins, administrator string) (*types.Distribution, error) {
+	return k.createDistribution(ctx, marker, amount, administrator, types.DistributionMaxHolders)
+}
+
+// createDistribution is CreateDistribution, but with the most holders that can be recorded provided.
+func (k Keeper) createDistribution(ctx sdk.Context, marker types.MarkerAccountI, amount sdk.Coins, administrator string, maxHolders uint64) (*types.Distribution, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "create_distribution")
+
+	if marker.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("cannot create a distribution for a marker that is not active: status %s", marker.GetStatus())
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("distribution amount cannot be zero")
+	}
+	if escrow := k.GetEscrow(ctx, marker); !escrow.IsAllGTE(amount) {
+		return nil, fmt.Errorf("marker %s escrow %s is insufficient for distribution of %s", marker.GetDenom(), escrow, amount)
+	}
+
+	dist := types.Distribution{
+		Id:            k.nextDistributionID(ctx),
+		Denom:         marker.GetDenom(),
+		Amount:        amount,
+		Administrator: administrator,
+		TotalHolding:  sdkmath.ZeroInt(),
+		RecordHeight:  ctx.BlockHeight(),
+		Distributed:   sdk.NewCoins(),
+		Status:        types.DistributionStatus_InProgress,
+	}
+
+	// The holders are all recorded in this one transaction, so there's a limit to how many there can be.
+	store := ctx.KVStore(k.storeKey)
+	err := k.iterateDenomOwners(ctx, marker.GetDenom(), func(owner sdk.AccAddress, balance sdkmath.Int) bool {
+		if owner.Equals(marker.GetAddress()) || owner.Equals(k.markerModuleAddr) || k.bankKeeper.BlockedAddr(owner) {
+			return false
+		}
+		if dist.HolderCount >= maxHolders {
+			dist.HolderCount++
+			return true
+		}
+		store.Set(types.DistributionHolderKey(dist.Id, owner), mustMarshalInt(balance))
+		dist.TotalHolding = dist.TotalHolding.Add(balance)
+		dist.HolderCount++
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not record holders of %s: %w", marker.GetDenom(), err)
+	}
+	if dist.HolderCount > maxHolders {
+		return nil, fmt.Errorf("marker %s has more than %d holders, the most a distribution can be made to", marker.GetDenom(), maxHolders)
+	}
+	if dist.HolderCount == 0 {
+		return nil, fmt.Errorf("marker %s has no holders to distribute to", marker.GetDenom())
+	}
+	if err = dist.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(types.WithBypass(ctx), marker.GetAddress(), types.CoinPoolName, amount); err != nil {
+		return nil, fmt.Errorf("could not move distribution funds out of marker %s escrow: %w", marker.GetDenom(), err)
+	}
+
+	if err = k.SetDistribution(ctx, dist); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionCreated(dist)); err != nil {
+		return nil, err
+	}
+	return &dist, nil
+}
+
+// iterateDenomOwners calls the provided handler with each account holding a positive balance of the denom.
+// The owners are looked up one page at a time, and no more pages are looked up once the handler returns true.
+func (k Keeper) iterateDenomOwners(ctx sdk.Context, denom string, handler func(owner sdk.AccAddress, balance sdkmath.Int) (stop bool)) error {
+	pageReq := &query.PageRequest{Limit: query.DefaultLimit}
+	for {
+		resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return err
+		}
+		for _, owner := range resp.DenomOwners {
+			if !owner.Balance.IsPositive() {
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return err
+			}
+			if handler(addr, owner.Balance.Amount) {
+				return nil
+			}
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return nil
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: query.DefaultLimit}
+	}
+}
+
+// ProcessDistributions pays up to limit recorded holders across all in-progress distributions, oldest first.
+// Distributions that have no more holders awaiting payment are completed, returning any remainder
+// left over from rounding to the marker's escrow.
+func (k Keeper) ProcessDistributions(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(k.storeKey)
+
+	type payment struct {
+		key     []byte
+		holder  sdk.AccAddress
+		balance sdkmath.Int
+	}
+	var ids []uint64
+	payments := make(map[uint64][]payment)
+
+	it := storetypes.KVStorePrefixIterator(store, types.DistributionHolderKeyPrefix)
+	for count := 0; it.Valid() && count < limit; it.Next() {
+		id, holder := types.GetDistributionHolderFromKey(it.Key())
+		if _, found := payments[id]; !found {
+			ids = append(ids, id)
+		}
+		payments[id] = append(payments[id], payment{key: it.Key(), holder: holder, balance: mustUnmarshalInt(it.Value())})
+		count++
+	}
+	it.Close()
+
+	for _, id := range ids {
+		dist, err := k.GetDistribution(ctx, id)
+		if err != nil {
+			k.Logger(ctx).Error("could not read distribution", "distribution_id", id, "err", err)
+			continue
+		}
+
+		for _, p := range payments[id] {
+			store.Delete(p.key)
+			dist.HoldersPaid++
+			share := dist.ShareFor(p.balance)
+			if share.IsZero() {
+				continue
+			}
+			// Each payment is made in its own cache context so that a single failure leaves the others intact.
+			// A share that cannot be paid is returned to the marker along with the rounding remainder.
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithBypass(cacheCtx), types.CoinPoolName, p.holder, share); err != nil {
+				k.Logger(ctx).Error("could not pay distribution holder",
+					"distribution_id", id, "holder", p.holder.String(), "amount", share.String(), "err", err)
+				continue
+			}
+			writeCache()
+			dist.Distributed = dist.Distributed.Add(share...)
+		}
+
+		if !k.hasDistributionHolders(ctx, id) {
+			if err = k.completeDistribution(ctx, dist); err != nil {
+				k.Logger(ctx).Error("could not complete distribution", "distribution_id", id, "err", err)
+			}
+			continue
+		}
+
+		if err = k.SetDistribution(ctx, *dist); err != nil {
+			k.Logger(ctx).Error("could not update distribution", "distribution_id", id, "err", err)
+			continue
+		}
+		_ = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionProgress(*dist))
+	}
+}
+
+// completeDistribution marks a distribution as completed and returns any undistributed funds to the marker's escrow.
+func (k Keeper) completeDistribution(ctx sdk.Context, dist *types.Distribution) error {
+	remainder := dist.Amount.Sub(dist.Distributed...)
+	if !remainder.IsZero() {
+		markerAddr, err := types.MarkerAddress(dist.Denom)
+		if err != nil {
+			return err
+		}
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(types.WithBypass(ctx), types.CoinPoolName, markerAddr, remainder); err != nil {
+			return fmt.Errorf("could not return distribution remainder %s to marker %s: %w", remainder, dist.Denom, err)
+		}
+	}
+
+	dist.Status = types.DistributionStatus_Completed
+	if err := k.SetDistribution(ctx, *dist); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDistributionCompleted(*dist, remainder))
+}
+
+// hasDistributionHolders returns true if there are still holders awaiting payment from the distribution.
+func (k Keeper) hasDistributionHolders(ctx sdk.Context, id uint64) bool {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionHolderKeyPrefixFor(id))
+	defer it.Close()
+	return it.Valid()
+}
+
+// GetDistribution returns the distribution with the provided id.
+func (k Keeper) GetDistribution(ctx sdk.Context, id uint64) (*types.Distribution, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionKey(id))
+	if len(bz) == 0 {
+		return nil, types.ErrDistributionNotFound.Wrapf("id %d", id)
+	}
+	var dist types.Distribution
+	if err := k.cdc.Unmarshal(bz, &dist); err != nil {
+		return nil, fmt.Errorf("could not read distribution %d: %w", id, err)
+	}
+	return &dist, nil
+}
+
+// SetDistribution stores a distribution.
+func (k Keeper) SetDistribution(ctx sdk.Context, dist types.Distribution) error {
+	bz, err := k.cdc.Marshal(&dist)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DistributionKey(dist.Id), bz)
+	return nil
+}
+
+// IterateDistributions iterates all distributions with the given handler function.
+func (k Keeper) IterateDistributions(ctx sdk.Context, handler func(dist types.Distribution) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var dist types.Distribution
+		if err := k.cdc.Unmarshal(it.Value(), &dist); err != nil {
+			return err
+		}
+		if handler(dist) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateDistributionHolders iterates all holders awaiting payment from a distribution.
+func (k Keeper) IterateDistributionHolders(ctx sdk.Context, handler func(holder types.DistributionHolder) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionHolderKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		id, addr := types.GetDistributionHolderFromKey(it.Key())
+		holder := types.DistributionHolder{DistributionId: id, Address: addr.String(), Balance: mustUnmarshalInt(it.Value())}
+		if handler(holder) {
+			break
+		}
+	}
+}
+
+// SetDistributionHolder records a holder balance awaiting payment from a distribution.
+func (k Keeper) SetDistributionHolder(ctx sdk.Context, holder types.DistributionHolder) error {
+	addr, err := sdk.AccAddressFromBech32(holder.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DistributionHolderKey(holder.DistributionId, addr), mustMarshalInt(holder.Balance))
+	return nil
+}
+
+// nextDistributionID increments and returns the distribution sequence.
+func (k Keeper) nextDistributionID(ctx sdk.Context) uint64 {
+	id := k.getLastDistributionID(ctx) + 1
+	k.setLastDistributionID(ctx, id)
+	return id
+}
+
+// getLastDistributionID returns the last distribution id that was assigned.
+func (k Keeper) getLastDistributionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionSequenceKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastDistributionID records the last distribution id that was assigned.
+func (k Keeper) setLastDistributionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.DistributionSequenceKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// mustMarshalInt marshals an Int for storage, panicking on error.
+func mustMarshalInt(amt sdkmath.Int) []byte {
+	bz, err := amt.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// mustUnmarshalInt unmarshals a stored Int, panicking on error.
+func mustUnmarshalInt(bz []byte) sdkmath.Int {
+	var amt sdkmath.Int
+	if err := amt.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amt
+}
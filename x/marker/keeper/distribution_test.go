@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestCreateAndProcessDistribution(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	noWithdraw := sdk.AccAddress("noWithdraw__________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	holder3 := sdk.AccAddress("holder3_____________")
+
+	denom := "fundshare"
+	payDenom := "usdcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := &types.MarkerAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(markerAddr),
+		AccessControl: []types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Withdraw}),
+			*types.NewAccessGrant(noWithdraw, types.AccessList{types.Access_Mint}),
+		},
+		Status:     types.StatusActive,
+		Denom:      denom,
+		Supply:     sdkmath.NewInt(1000),
+		MarkerType: types.MarkerType_Coin,
+	}
+	app.MarkerKeeper.SetNewMarker(ctx, marker)
+
+	fundAcct := func(addr sdk.AccAddress, amount sdk.Coins) {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addr, amount), "FundAccount(%s, %s)", addr, amount)
+	}
+	fundAcct(holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 500)))
+	fundAcct(holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 300)))
+	fundAcct(holder3, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)))
+	fundAcct(markerAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(payDenom, 2000)))
+
+	payout := sdk.NewCoins(sdk.NewInt64Coin(payDenom, 1001))
+
+	_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, payout, noWithdraw.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_WITHDRAW", "CreateDistribution without withdraw access")
+
+	_, err = msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, sdk.NewCoins(sdk.NewInt64Coin(payDenom, 5000)), admin.String()))
+	require.ErrorContains(t, err, "is insufficient for distribution", "CreateDistribution more than escrow")
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.MarkerKeeper.CreateDistributionWithMaxHolders(cacheCtx, marker, payout, admin.String(), 2)
+	require.EqualError(t, err, "marker fundshare has more than 2 holders, the most a distribution can be made to", "CreateDistribution with too many holders")
+
+	// Shares of an amount this large would overflow while being calculated.
+	cacheCtx, _ = ctx.CacheContext()
+	huge := sdk.NewCoins(sdk.NewCoin("hugecoin", sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))))
+	require.NoError(t, testutil.FundAccount(types.WithBypass(cacheCtx), app.BankKeeper, markerAddr, huge), "FundAccount huge")
+	_, err = app.MarkerKeeper.CreateDistribution(cacheCtx, marker, huge, admin.String())
+	require.ErrorContains(t, err, "is too large to share among a total holding of 1000", "CreateDistribution of an amount too large to share")
+
+	resp, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, payout, admin.String()))
+	require.NoError(t, err, "CreateDistribution")
+	require.Equal(t, uint64(1), resp.DistributionId, "distribution id")
+
+	dist, err := app.MarkerKeeper.GetDistribution(ctx, resp.DistributionId)
+	require.NoError(t, err, "GetDistribution")
+	require.Equal(t, uint64(3), dist.HolderCount, "holder count: the marker's own balance should not be included")
+	require.Equal(t, sdkmath.NewInt(1000), dist.TotalHolding, "total holding")
+	require.Equal(t, types.DistributionStatus_InProgress, dist.Status, "status after create")
+	require.Equal(t, "999"+payDenom, app.BankKeeper.GetBalance(ctx, markerAddr, payDenom).String(), "marker escrow after create")
+
+	// Transfers after the distribution is created do not change who gets paid.
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder3, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))), "SendCoins")
+
+	app.MarkerKeeper.ProcessDistributions(ctx, 2)
+	dist, err = app.MarkerKeeper.GetDistribution(ctx, resp.DistributionId)
+	require.NoError(t, err, "GetDistribution after first batch")
+	require.Equal(t, uint64(2), dist.HoldersPaid, "holders paid after first batch")
+	require.Equal(t, types.DistributionStatus_InProgress, dist.Status, "status after first batch")
+
+	app.MarkerKeeper.ProcessDistributions(ctx, 2)
+	dist, err = app.MarkerKeeper.GetDistribution(ctx, resp.DistributionId)
+	require.NoError(t, err, "GetDistribution after second batch")
+	require.Equal(t, uint64(3), dist.HoldersPaid, "holders paid after second batch")
+	require.Equal(t, types.DistributionStatus_Completed, dist.Status, "status after second batch")
+	require.Equal(t, "1000"+payDenom, dist.Distributed.String(), "distributed")
+
+	require.Equal(t, "500"+payDenom, app.BankKeeper.GetBalance(ctx, holder1, payDenom).String(), "holder1 payout")
+	require.Equal(t, "300"+payDenom, app.BankKeeper.GetBalance(ctx, holder2, payDenom).String(), "holder2 payout")
+	require.Equal(t, "200"+payDenom, app.BankKeeper.GetBalance(ctx, holder3, payDenom).String(), "holder3 payout")
+	require.Equal(t, "1000"+payDenom, app.BankKeeper.GetBalance(ctx, markerAddr, payDenom).String(), "marker escrow after remainder returned")
+
+	queryResp, err := app.MarkerKeeper.Distributions(ctx, &types.QueryDistributionsRequest{Id: denom})
+	require.NoError(t, err, "Distributions query")
+	require.Len(t, queryResp.Distributions, 1, "distributions for marker")
+	require.Equal(t, *dist, queryResp.Distributions[0], "queried distribution")
+
+	_, err = app.MarkerKeeper.Distribution(ctx, &types.QueryDistributionRequest{DistributionId: 2})
+	require.ErrorIs(t, err, types.ErrDistributionNotFound, "Distribution query for unknown id")
+}
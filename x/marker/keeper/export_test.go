@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,6 +54,22 @@ func (k Keeper) WithAttrKeeper(attrKeeper types.AttrKeeper) Keeper {
 	return k
 }
 
+// WithIbcTransferServer is a TEST ONLY func that returns a copy of this marker keeper but with the provided ibc transfer server instead.
+func (k Keeper) WithIbcTransferServer(ibcTransferServer types.IbcTransferMsgServer) Keeper {
+	k.ibcTransferServer = ibcTransferServer
+	return k
+}
+
+// CreateDistributionWithMaxHolders is a TEST ONLY exposure of createDistribution.
+func (k Keeper) CreateDistributionWithMaxHolders(ctx sdk.Context, marker types.MarkerAccountI, amount sdk.Coins, administrator string, maxHolders uint64) (*types.Distribution, error) {
+	return k.createDistribution(ctx, marker, amount, administrator, maxHolders)
+}
+
+// SplitMarkerWithMaxHolders is a TEST ONLY exposure of splitMarker.
+func (k Keeper) SplitMarkerWithMaxHolders(ctx sdk.Context, marker types.MarkerAccountI, numerator, denominator uint64, administrator string, maxHolders uint64) (sdkmath.Int, error) {
+	return k.splitMarker(ctx, marker, numerator, denominator, administrator, maxHolders)
+}
+
 // SetNewMarker is a TEST ONLY function that calls NewMarker, then SetMarker.
 func (k Keeper) SetNewMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.SetMarker(ctx, k.NewMarker(ctx, marker))
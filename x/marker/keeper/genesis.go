@@ -45,9 +45,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 
 	for _, denyAddress := range data.DenySendAddresses {
-		markerAddr := sdk.MustAccAddressFromBech32(denyAddress.MarkerAddress)
-		denyAddress := sdk.MustAccAddressFromBech32(denyAddress.DenyAddress)
-		k.AddSendDeny(ctx, markerAddr, denyAddress)
+		if err := k.SetSendDenyEntry(ctx, denyAddress); err != nil {
+			panic(err)
+		}
 	}
 	for _, mNavs := range data.NetAssetValues {
 		for _, nav := range mNavs.NetAssetValues {
@@ -60,6 +60,77 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+
+	var lastDistributionID uint64
+	for _, dist := range data.Distributions {
+		if err := k.SetDistribution(ctx, dist); err != nil {
+			panic(err)
+		}
+		if dist.Id > lastDistributionID {
+			lastDistributionID = dist.Id
+		}
+	}
+	k.setLastDistributionID(ctx, lastDistributionID)
+	for _, holder := range data.DistributionHolders {
+		if err := k.SetDistributionHolder(ctx, holder); err != nil {
+			panic(err)
+		}
+	}
+	for _, usage := range data.SupplyAllowanceUsages {
+		if err := k.SetSupplyAllowanceUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
+	for _, lockup := range data.Lockups {
+		if err := k.SetLockup(ctx, lockup); err != nil {
+			panic(err)
+		}
+	}
+	for _, config := range data.RedemptionConfigs {
+		if err := k.SetRedemptionConfig(ctx, config); err != nil {
+			panic(err)
+		}
+	}
+	for _, redemption := range data.PendingRedemptions {
+		if err := k.SetPendingRedemption(ctx, redemption); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastRedemptionID(ctx, data.LastRedemptionId)
+	for _, limit := range data.TransferLimits {
+		if err := k.SetTransferLimit(ctx, limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, usage := range data.TransferUsages {
+		if err := k.SetTransferUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
+	for _, recovery := range data.Recoveries {
+		if err := k.SetRecovery(ctx, recovery); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastRecoveryID(ctx, data.LastRecoveryId)
+	for _, limit := range data.HolderLimits {
+		if err := k.SetHolderLimit(ctx, limit); err != nil {
+			panic(err)
+		}
+		if err := k.buildHolderIndex(ctx, types.MustGetMarkerAddress(limit.Denom), limit.Denom); err != nil {
+			panic(err)
+		}
+	}
+	for _, schema := range data.AccountDataSchemas {
+		if err := k.SetAccountDataSchema(ctx, schema); err != nil {
+			panic(err)
+		}
+	}
+	for _, policy := range data.IbcChannelPolicies {
+		if err := k.SetIbcChannelPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -91,12 +162,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	k.IterateMarkers(ctx, appendToMarkers)
 
 	var denyAddresses []types.DenySendAddress
-	handleDenyList := func(key []byte) bool {
-		markerAddr, denyAddr := types.GetDenySendAddresses(key)
-		denyAddresses = append(denyAddresses, types.DenySendAddress{MarkerAddress: markerAddr.String(), DenyAddress: denyAddr.String()})
+	handleDenyList := func(entry types.DenySendAddress) bool {
+		denyAddresses = append(denyAddresses, entry)
 		return false
 	}
-	k.IterateSendDeny(ctx, handleDenyList)
+	if err := k.IterateSendDenyEntries(ctx, handleDenyList); err != nil {
+		panic(err)
+	}
 
 	markerNetAssetValues := make([]types.MarkerNetAssetValues, len(markers))
 	for i := range markers {
@@ -114,5 +186,122 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var distributions []types.Distribution
+	err := k.IterateDistributions(ctx, func(dist types.Distribution) bool {
+		distributions = append(distributions, dist)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var distributionHolders []types.DistributionHolder
+	k.IterateDistributionHolders(ctx, func(holder types.DistributionHolder) bool {
+		distributionHolders = append(distributionHolders, holder)
+		return false
+	})
+
+	var supplyAllowanceUsages []types.SupplyAllowanceUsage
+	k.IterateSupplyAllowanceUsages(ctx, func(usage types.SupplyAllowanceUsage) bool {
+		supplyAllowanceUsages = append(supplyAllowanceUsages, usage)
+		return false
+	})
+
+	var lockups []types.Lockup
+	err = k.IterateLockups(ctx, func(lockup types.Lockup) bool {
+		lockups = append(lockups, lockup)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var redemptionConfigs []types.RedemptionConfig
+	err = k.IterateRedemptionConfigs(ctx, func(config types.RedemptionConfig) bool {
+		redemptionConfigs = append(redemptionConfigs, config)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingRedemptions []types.PendingRedemption
+	err = k.IteratePendingRedemptions(ctx, func(redemption types.PendingRedemption) bool {
+		pendingRedemptions = append(pendingRedemptions, redemption)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var transferLimits []types.TransferLimit
+	err = k.IterateTransferLimits(ctx, func(limit types.TransferLimit) bool {
+		transferLimits = append(transferLimits, limit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var transferUsages []types.TransferUsage
+	err = k.IterateTransferUsages(ctx, func(usage types.TransferUsage) bool {
+		transferUsages = append(transferUsages, usage)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var recoveries []types.Recovery
+	err = k.IterateRecoveries(ctx, func(recovery types.Recovery) bool {
+		recoveries = append(recoveries, recovery)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var holderLimits []types.HolderLimit
+	err = k.IterateHolderLimits(ctx, func(limit types.HolderLimit) bool {
+		holderLimits = append(holderLimits, limit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var accountDataSchemas []types.AccountDataSchema
+	err = k.IterateAccountDataSchemas(ctx, func(schema types.AccountDataSchema) bool {
+		accountDataSchemas = append(accountDataSchemas, schema)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var ibcChannelPolicies []types.IbcChannelPolicy
+	err = k.IterateIbcChannelPolicies(ctx, func(policy types.IbcChannelPolicy) bool {
+		ibcChannelPolicies = append(ibcChannelPolicies, policy)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	genState.Distributions = distributions
+	genState.DistributionHolders = distributionHolders
+	genState.SupplyAllowanceUsages = supplyAllowanceUsages
+	genState.Lockups = lockups
+	genState.RedemptionConfigs = redemptionConfigs
+	genState.PendingRedemptions = pendingRedemptions
+	genState.LastRedemptionId = k.GetLastRedemptionID(ctx)
+	genState.TransferLimits = transferLimits
+	genState.TransferUsages = transferUsages
+	genState.Recoveries = recoveries
+	genState.LastRecoveryId = k.GetLastRecoveryID(ctx)
+	genState.HolderLimits = holderLimits
+	genState.AccountDataSchemas = accountDataSchemas
+	genState.IbcChannelPolicies = ibcChannelPolicies
+	return genState
 }
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMarkerHolderLimit sets a marker's holder limit. If the marker didn't already have one, the index of the accounts
+// holding its denom is built from their balances. Accounts already holding the denom are not affected by the new limit
+// until they send or receive more of it.
+func (k Keeper) SetMarkerHolderLimit(ctx sdk.Context, marker types.MarkerAccountI, limit types.HolderLimit, administrator string) error {
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("holder limits can only be set on %s markers", types.MarkerType_RestrictedCoin)
+	}
+	if limit.Denom != marker.GetDenom() {
+		return fmt.Errorf("holder limit denom %q does not match marker denom %q", limit.Denom, marker.GetDenom())
+	}
+	existing, err := k.GetHolderLimit(ctx, marker.GetAddress())
+	if err != nil {
+		return err
+	}
+	if err = k.SetHolderLimit(ctx, limit); err != nil {
+		return err
+	}
+	if existing == nil {
+		if err = k.buildHolderIndex(ctx, marker.GetAddress(), marker.GetDenom()); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerHolderLimitSet(limit, administrator))
+}
+
+// RemoveMarkerHolderLimit removes a marker's holder limit along with the index of the accounts holding its denom.
+func (k Keeper) RemoveMarkerHolderLimit(ctx sdk.Context, marker types.MarkerAccountI, administrator string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.HolderLimitKey(marker.GetAddress())
+	if !store.Has(key) {
+		return types.ErrHolderLimitNotFound.Wrap(marker.GetDenom())
+	}
+	store.Delete(key)
+	k.clearHolderIndex(ctx, marker.GetAddress())
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerHolderLimitRemoved(marker.GetDenom(), administrator))
+}
+
+// GetHolderLimit returns a marker's holder limit, or nil if it doesn't have one.
+func (k Keeper) GetHolderLimit(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.HolderLimit, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HolderLimitKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var limit types.HolderLimit
+	if err := k.cdc.Unmarshal(bz, &limit); err != nil {
+		return nil, fmt.Errorf("could not read holder limit: %w", err)
+	}
+	return &limit, nil
+}
+
+// SetHolderLimit stores a holder limit. It does not build the index of the accounts holding the denom.
+func (k Keeper) SetHolderLimit(ctx sdk.Context, limit types.HolderLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(limit.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&limit)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.HolderLimitKey(markerAddr), bz)
+	return nil
+}
+
+// IterateHolderLimits iterates all holder limits with the given handler function.
+func (k Keeper) IterateHolderLimits(ctx sdk.Context, handler func(limit types.HolderLimit) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.HolderLimitKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limit types.HolderLimit
+		if err := k.cdc.Unmarshal(it.Value(), &limit); err != nil {
+			return err
+		}
+		if handler(limit) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetHolderCount returns the number of accounts holding the denom of a marker with a holder limit.
+func (k Keeper) GetHolderCount(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.HolderCountKey(markerAddr))
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setHolderCount records the number of accounts holding the denom of a marker with a holder limit.
+func (k Keeper) setHolderCount(ctx sdk.Context, markerAddr sdk.AccAddress, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.HolderCountKey(markerAddr), binary.BigEndian.AppendUint64(nil, count))
+}
+
+// buildHolderIndex indexes every account holding a positive balance of a marker's denom and records how many there are.
+func (k Keeper) buildHolderIndex(ctx sdk.Context, markerAddr sdk.AccAddress, denom string) error {
+	k.clearHolderIndex(ctx, markerAddr)
+	store := ctx.KVStore(k.storeKey)
+	count := uint64(0)
+	err := k.iterateDenomOwners(ctx, denom, func(owner sdk.AccAddress, _ sdkmath.Int) bool {
+		if k.isHolderExempt(ctx, markerAddr, owner) {
+			return false
+		}
+		store.Set(types.HolderKey(markerAddr, owner), []byte{})
+		count++
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	k.setHolderCount(ctx, markerAddr, count)
+	return nil
+}
+
+// clearHolderIndex removes the index of the accounts holding a marker's denom and their count.
+func (k Keeper) clearHolderIndex(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	k.deletePrefix(ctx, types.HolderMarkerPrefix(markerAddr))
+	ctx.KVStore(k.storeKey).Delete(types.HolderCountKey(markerAddr))
+}
+
+// isHolderExempt returns true if the address is not counted as a holder of a marker's denom. That's the marker
+// itself, the marker module, and the quarantine funds holder (whose funds are waiting to go to other accounts).
+func (k Keeper) isHolderExempt(ctx sdk.Context, markerAddr, addr sdk.AccAddress) bool {
+	if addr.Equals(markerAddr) || addr.Equals(k.markerModuleAddr) {
+		return true
+	}
+	return addr.Equals(k.quarantineFundsHolder)
+}
+
+// updateHolders updates the holder index of each coin's marker that has a holder limit for funds being sent.
+// The amount has already been removed from fromAddr, but not yet added to toAddr.
+// If enforce is true, an error is returned if either account would be left with less than the minimum holding,
+// or if toAddr would be a new holder beyond the maximum.
+func (k Keeper) updateHolders(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, enforce bool) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range amt {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		limit, err := k.GetHolderLimit(ctx, markerAddr)
+		if err != nil {
+			return err
+		}
+		if limit == nil {
+			continue
+		}
+
+		count := k.GetHolderCount(ctx, markerAddr)
+		if !k.isHolderExempt(ctx, markerAddr, fromAddr) {
+			remaining := k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).Amount
+			if remaining.IsZero() {
+				key := types.HolderKey(markerAddr, fromAddr)
+				if store.Has(key) {
+					store.Delete(key)
+					if count > 0 {
+						count--
+					}
+				}
+			} else if enforce {
+				if err = limit.CheckHolding(fromAddr, remaining); err != nil {
+					return err
+				}
+			}
+		}
+
+		if coin.Amount.IsPositive() && !k.isHolderExempt(ctx, markerAddr, toAddr) {
+			if enforce {
+				balance := k.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).Amount
+				if err = limit.CheckHolding(toAddr, balance.Add(coin.Amount)); err != nil {
+					return err
+				}
+			}
+			key := types.HolderKey(markerAddr, toAddr)
+			if !store.Has(key) {
+				store.Set(key, []byte{})
+				count++
+				if enforce {
+					if err = limit.CheckHolderCount(count); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		k.setHolderCount(ctx, markerAddr, count)
+	}
+	return nil
+}
+
+// validateHolderLimits returns an error if receiving the coins would leave the recipient with less than the minimum
+// holding of a coin's marker, or would make the recipient a new holder beyond the maximum. This is for the sends out
+// of a marker account that bypass the send restrictions.
+func (k Keeper) validateHolderLimits(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		limit, err := k.GetHolderLimit(ctx, markerAddr)
+		if err != nil {
+			return err
+		}
+		if limit == nil || !coin.Amount.IsPositive() || k.isHolderExempt(ctx, markerAddr, recipient) {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom).Amount
+		if err = limit.CheckHolding(recipient, balance.Add(coin.Amount)); err != nil {
+			return err
+		}
+		if !store.Has(types.HolderKey(markerAddr, recipient)) {
+			if err = limit.CheckHolderCount(k.GetHolderCount(ctx, markerAddr) + 1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// splitHolderLimit scales the minimum holding of a marker's holder limit.
+func (k Keeper) splitHolderLimit(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	limit, err := k.GetHolderLimit(ctx, markerAddr)
+	if err != nil || limit == nil {
+		return err
+	}
+	return k.SetHolderLimit(ctx, limit.Split(numerator, denominator))
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestHolderLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holders := make([]sdk.AccAddress, 6)
+	grants := []types.AccessGrant{
+		*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw}),
+	}
+	for i := range holders {
+		holders[i] = sdk.AccAddress("holder" + string(rune('1'+i)) + "_____________")
+		grants = append(grants, *types.NewAccessGrant(holders[i], types.AccessList{types.Access_Transfer}))
+	}
+
+	denom := "placement"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		grants,
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	for _, holder := range holders[:2] {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount %s", holder)
+	}
+
+	// send uses a cache context so that, like a failed tx, a failed send leaves no changes behind.
+	send := func(from, to sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := app.BankKeeper.SendCoins(cacheCtx, from, to, sdk.NewCoins(sdk.NewInt64Coin(denom, amt)))
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+	withdraw := func(to sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := app.MarkerKeeper.WithdrawCoins(cacheCtx, admin, to, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, amt)))
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+	ibcKeeper := app.MarkerKeeper.WithIbcTransferServer(NewMockIbcTransferServer(app.BankKeeper))
+	ibcTransfer := func(from sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := ibcKeeper.IbcTransferCoin(cacheCtx, ibctypes.PortID, "channel-0", sdk.NewInt64Coin(denom, amt), from, from,
+			admin.String(), clienttypes.NewHeight(1, 1000), 0, "")
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+	holderCount := func() uint64 {
+		resp, err := app.MarkerKeeper.HolderLimit(ctx, &types.QueryHolderLimitRequest{Id: denom})
+		require.NoError(t, err, "HolderLimit query")
+		return resp.HolderCount
+	}
+
+	_, err := msgServer.SetHolderLimit(ctx, types.NewMsgSetHolderLimitRequest(denom, 3, sdkmath.NewInt(100), holders[0].String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SetHolderLimit without admin access")
+	_, err = msgServer.SetHolderLimit(ctx, types.NewMsgSetHolderLimitRequest(denom, 3, sdkmath.NewInt(100), admin.String()))
+	require.NoError(t, err, "SetHolderLimit")
+	require.Equal(t, uint64(2), holderCount(), "holder count after setting the limit")
+
+	require.ErrorContains(t, send(holders[0], holders[2], 50), holders[2].String()+" would hold 50placement, less than the minimum holding of 100placement", "send below the minimum")
+	require.NoError(t, send(holders[0], holders[2], 150), "send to a third holder")
+	require.Equal(t, uint64(3), holderCount(), "holder count after a third holder")
+	require.ErrorContains(t, send(holders[0], holders[3], 150), "placement cannot have more than 3 holders", "send to a fourth holder")
+	require.ErrorContains(t, send(holders[0], holders[1], 800), holders[0].String()+" would hold 50placement", "send leaving less than the minimum")
+	require.NoError(t, send(holders[0], holders[1], 850), "send everything")
+	require.Equal(t, uint64(2), holderCount(), "holder count after a holder sent everything")
+	require.NoError(t, send(holders[2], holders[3], 150), "send everything to a new holder")
+	require.Equal(t, uint64(2), holderCount(), "holder count after a holder was replaced")
+
+	require.ErrorContains(t, withdraw(holders[4], 50), "less than the minimum holding of 100placement", "withdraw below the minimum")
+	require.NoError(t, withdraw(holders[4], 200), "withdraw to a new holder")
+	require.Equal(t, uint64(3), holderCount(), "holder count after a withdraw")
+	require.ErrorContains(t, withdraw(holders[5], 200), "placement cannot have more than 3 holders", "withdraw to a fourth holder")
+
+	// A holder that sends everything over ibc is no longer a holder, but the channel's escrow account is.
+	escrow := ibctypes.GetEscrowAddress(ibctypes.PortID, "channel-0")
+	require.ErrorContains(t, ibcTransfer(holders[1], 1800), holders[1].String()+" would hold 50placement", "ibc transfer leaving less than the minimum")
+	require.NoError(t, ibcTransfer(holders[1], 1850), "ibc transfer of everything")
+	require.False(t, app.MarkerKeeper.GetStore(ctx).Has(types.HolderKey(markerAddr, holders[1])), "holder index has the ibc sender")
+	require.True(t, app.MarkerKeeper.GetStore(ctx).Has(types.HolderKey(markerAddr, escrow)), "holder index has the escrow account")
+	require.Equal(t, uint64(3), holderCount(), "holder count after an ibc transfer of everything")
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.HolderLimit{types.NewHolderLimit(denom, 3, sdkmath.NewInt(100))}, genesis.HolderLimits, "exported holder limits")
+
+	_, err = msgServer.RemoveHolderLimit(ctx, types.NewMsgRemoveHolderLimitRequest(denom, admin.String()))
+	require.NoError(t, err, "RemoveHolderLimit")
+	_, err = app.MarkerKeeper.HolderLimit(ctx, &types.QueryHolderLimitRequest{Id: denom})
+	require.ErrorIs(t, err, types.ErrHolderLimitNotFound, "HolderLimit query after removal")
+	_, err = msgServer.RemoveHolderLimit(ctx, types.NewMsgRemoveHolderLimitRequest(denom, admin.String()))
+	require.ErrorIs(t, err, types.ErrHolderLimitNotFound, "RemoveHolderLimit again")
+	require.NoError(t, send(holders[3], holders[5], 10), "send below the old minimum after removal")
+}
+
+func TestHolderLimitsQuarantine(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	quarantined := sdk.AccAddress("quarantined_________")
+
+	denom := "placement"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw}),
+			*types.NewAccessGrant(holder1, types.AccessList{types.Access_Transfer}),
+			*types.NewAccessGrant(holder2, types.AccessList{types.Access_Transfer}),
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	for _, holder := range []sdk.AccAddress{holder1, holder2} {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))), "FundAccount %s", holder)
+	}
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, quarantined, sdk.NewCoins(sdk.NewInt64Coin(denom, 150))), "send before quarantine")
+
+	_, err := msgServer.SetHolderLimit(ctx, types.NewMsgSetHolderLimitRequest(denom, 3, sdkmath.NewInt(100), admin.String()))
+	require.NoError(t, err, "SetHolderLimit")
+	require.NoError(t, app.QuarantineKeeper.SetOptIn(ctx, quarantined), "SetOptIn")
+
+	fundsHolder := app.QuarantineKeeper.GetFundsHolder()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, quarantined, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))), "quarantined send from holder1")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder2, quarantined, sdk.NewCoins(sdk.NewInt64Coin(denom, 60))), "quarantined send from holder2")
+	require.Equal(t, "110"+denom, app.BankKeeper.GetBalance(ctx, fundsHolder, denom).String(), "funds holder balance")
+
+	// The funds holder is left with less than the minimum holding, which is fine since it isn't a holder.
+	_, err = app.QuarantineKeeper.AcceptQuarantinedFunds(ctx, quarantined, holder1)
+	require.NoError(t, err, "AcceptQuarantinedFunds from holder1")
+	require.Equal(t, "200"+denom, app.BankKeeper.GetBalance(ctx, quarantined, denom).String(), "quarantined account balance")
+
+	resp, err := app.MarkerKeeper.HolderLimit(ctx, &types.QueryHolderLimitRequest{Id: denom})
+	require.NoError(t, err, "HolderLimit query")
+	require.Equal(t, uint64(3), resp.HolderCount, "holder count")
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMarkerIbcChannelPolicy sets a restricted marker's IBC channel policy.
+func (k Keeper) SetMarkerIbcChannelPolicy(ctx sdk.Context, marker types.MarkerAccountI, policy types.IbcChannelPolicy, administrator string) error {
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("ibc channel policies can only be set on %s markers", types.MarkerType_RestrictedCoin)
+	}
+	if policy.Denom != marker.GetDenom() {
+		return fmt.Errorf("ibc channel policy denom %q does not match marker denom %q", policy.Denom, marker.GetDenom())
+	}
+	if err := k.SetIbcChannelPolicy(ctx, policy); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerIbcChannelPolicySet(policy, administrator))
+}
+
+// RemoveMarkerIbcChannelPolicy removes a marker's IBC channel policy.
+func (k Keeper) RemoveMarkerIbcChannelPolicy(ctx sdk.Context, marker types.MarkerAccountI, administrator string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.IbcChannelPolicyKey(marker.GetAddress())
+	if !store.Has(key) {
+		return types.ErrIbcChannelPolicyNotFound.Wrap(marker.GetDenom())
+	}
+	store.Delete(key)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerIbcChannelPolicyRemoved(marker.GetDenom(), administrator))
+}
+
+// GetIbcChannelPolicy returns a marker's IBC channel policy, or nil if it doesn't have one.
+func (k Keeper) GetIbcChannelPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.IbcChannelPolicy, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.IbcChannelPolicyKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var policy types.IbcChannelPolicy
+	if err := k.cdc.Unmarshal(bz, &policy); err != nil {
+		return nil, fmt.Errorf("could not read ibc channel policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// SetIbcChannelPolicy stores an IBC channel policy.
+func (k Keeper) SetIbcChannelPolicy(ctx sdk.Context, policy types.IbcChannelPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(policy.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.IbcChannelPolicyKey(markerAddr), bz)
+	return nil
+}
+
+// IterateIbcChannelPolicies iterates all IBC channel policies with the given handler function.
+func (k Keeper) IterateIbcChannelPolicies(ctx sdk.Context, handler func(policy types.IbcChannelPolicy) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.IbcChannelPolicyKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var policy types.IbcChannelPolicy
+		if err := k.cdc.Unmarshal(it.Value(), &policy); err != nil {
+			return err
+		}
+		if handler(policy) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetIbcChannelEscrow returns how much of a denom is held in the escrow account of a port's channel.
+func (k Keeper) GetIbcChannelEscrow(ctx sdk.Context, portID, channelID, denom string) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, ibctypes.GetEscrowAddress(portID, channelID), denom)
+}
+
+// ValidateIbcTransfer returns an error if the marker's IBC channel policy does not allow the token to be sent out
+// over the channel, or if doing so would exceed the channel's cap. Policies only cover channels on the transfer port.
+func (k Keeper) ValidateIbcTransfer(ctx sdk.Context, marker types.MarkerAccountI, sourcePort, sourceChannel string, token sdk.Coin) error {
+	policy, err := k.GetIbcChannelPolicy(ctx, marker.GetAddress())
+	if err != nil || policy == nil {
+		return err
+	}
+	if sourcePort != ibctypes.PortID {
+		return fmt.Errorf("%s can only be transferred over the %s port", policy.Denom, ibctypes.PortID)
+	}
+	escrowed := k.GetIbcChannelEscrow(ctx, sourcePort, sourceChannel, token.Denom).Amount
+	return policy.CheckTransfer(sourceChannel, escrowed, token.Amount)
+}
+
+// ValidateIbcReceive returns an error if the IBC channel policy of the denom's marker does not allow the denom
+// to be received over the channel. Denoms without a marker or policy can be received over any channel.
+func (k Keeper) ValidateIbcReceive(ctx sdk.Context, denom, destChannel string) error {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	policy, err := k.GetIbcChannelPolicy(ctx, markerAddr)
+	if err != nil || policy == nil {
+		return err
+	}
+	return policy.CheckReceive(destChannel)
+}
+
+// splitIbcChannelPolicy scales the caps of a marker's IBC channel policy.
+func (k Keeper) splitIbcChannelPolicy(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	policy, err := k.GetIbcChannelPolicy(ctx, markerAddr)
+	if err != nil || policy == nil {
+		return err
+	}
+	return k.SetIbcChannelPolicy(ctx, policy.Split(numerator, denominator))
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestIbcChannelPolicy(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	sender := sdk.AccAddress("sender______________")
+	denom := "fundshare"
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 10_000),
+		admin,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw}),
+			*types.NewAccessGrant(sender, types.AccessList{types.Access_Transfer}),
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount sender")
+	escrow := ibctypes.GetEscrowAddress(ibctypes.PortID, "channel-4")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))), "FundAccount escrow")
+
+	transfer := func(channel string, amt int64) error {
+		return app.MarkerKeeper.IbcTransferCoin(ctx, ibctypes.PortID, channel, sdk.NewInt64Coin(denom, amt), sender, sender,
+			sdk.AccAddress("receiver____________").String(), clienttypes.NewHeight(1, 1000), 0, "")
+	}
+
+	policy := types.NewIbcChannelPolicy(denom, types.IbcChannelPolicyMode_Allowlist, []string{"channel-0", "channel-4"},
+		[]types.IbcChannelCap{types.NewIbcChannelCap("channel-4", sdkmath.NewInt(500))})
+	_, err := msgServer.SetIbcChannelPolicy(ctx, types.NewMsgSetIbcChannelPolicyRequest(policy, sender.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SetIbcChannelPolicy without admin access")
+	_, err = msgServer.SetIbcChannelPolicy(ctx, types.NewMsgSetIbcChannelPolicyRequest(policy, admin.String()))
+	require.NoError(t, err, "SetIbcChannelPolicy")
+
+	resp, err := app.MarkerKeeper.IbcChannelPolicy(ctx, &types.QueryIbcChannelPolicyRequest{Id: denom})
+	require.NoError(t, err, "IbcChannelPolicy query")
+	require.Equal(t, policy, resp.Policy, "queried policy")
+	require.Equal(t, []types.IbcChannelEscrow{{ChannelId: "channel-4", Amount: sdkmath.NewInt(400)}}, resp.Escrows, "queried escrows")
+
+	require.EqualError(t, app.MarkerKeeper.IbcTransferCoin(ctx, "otherport", "channel-4", sdk.NewInt64Coin(denom, 10), sender, sender,
+		sdk.AccAddress("receiver____________").String(), clienttypes.NewHeight(1, 1000), 0, ""),
+		"fundshare can only be transferred over the transfer port", "transfer over another port")
+	require.EqualError(t, transfer("channel-1", 10), "fundshare cannot be transferred over ibc channel channel-1", "transfer over channel not in allowlist")
+	require.EqualError(t, transfer("channel-4", 101),
+		"cannot transfer 101fundshare over ibc channel channel-4: it would exceed the channel cap of 500fundshare (400fundshare already out)",
+		"transfer over the channel cap")
+	err = transfer("channel-4", 100)
+	require.Error(t, err, "transfer up to the channel cap over a channel that doesn't exist")
+	require.NotContains(t, err.Error(), "channel cap", "transfer up to the channel cap error")
+
+	require.NoError(t, app.MarkerKeeper.ValidateIbcReceive(ctx, denom, "channel-0"), "ValidateIbcReceive allowed channel")
+	require.EqualError(t, app.MarkerKeeper.ValidateIbcReceive(ctx, denom, "channel-1"),
+		"fundshare cannot be received over ibc channel channel-1", "ValidateIbcReceive channel not in allowlist")
+	require.NoError(t, app.MarkerKeeper.ValidateIbcReceive(ctx, "otherdenom", "channel-1"), "ValidateIbcReceive denom without a policy")
+
+	genState := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.IbcChannelPolicy{policy}, genState.IbcChannelPolicies, "exported policies")
+
+	_, err = msgServer.RemoveIbcChannelPolicy(ctx, types.NewMsgRemoveIbcChannelPolicyRequest(denom, admin.String()))
+	require.NoError(t, err, "RemoveIbcChannelPolicy")
+	_, err = app.MarkerKeeper.IbcChannelPolicy(ctx, &types.QueryIbcChannelPolicyRequest{Id: denom})
+	require.ErrorIs(t, err, types.ErrIbcChannelPolicyNotFound, "IbcChannelPolicy query after removal")
+	_, err = msgServer.RemoveIbcChannelPolicy(ctx, types.NewMsgRemoveIbcChannelPolicyRequest(denom, admin.String()))
+	require.ErrorIs(t, err, types.ErrIbcChannelPolicyNotFound, "RemoveIbcChannelPolicy again")
+	require.NoError(t, app.MarkerKeeper.ValidateIbcReceive(ctx, denom, "channel-1"), "ValidateIbcReceive after removal")
+}
@@ -15,6 +15,7 @@ import (
 	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
 )
 
 // Handler is a handler function for use with IterateRecords.
@@ -75,6 +76,9 @@ type Keeper struct {
 
 	feeCollectorAddr sdk.AccAddress
 
+	// quarantineFundsHolder holds funds that are waiting to go to quarantined accounts.
+	quarantineFundsHolder sdk.AccAddress
+
 	// Used to transfer the ibc marker
 	ibcTransferServer types.IbcTransferMsgServer
 
@@ -87,6 +91,10 @@ type Keeper struct {
 
 	// groupChecker provides a way to check if an account is in a group.
 	groupChecker types.GroupChecker
+
+	// To include held and quarantined funds in cap tables.
+	holdKeeper       types.HoldKeeper
+	quarantineKeeper types.QuarantineKeeper
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -120,6 +128,7 @@ func NewKeeper(
 		markerModuleAddr:      authtypes.NewModuleAddress(types.CoinPoolName),
 		ibcTransferModuleAddr: authtypes.NewModuleAddress(ibctypes.ModuleName),
 		feeCollectorAddr:      authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+		quarantineFundsHolder: authtypes.NewModuleAddress(quarantine.ModuleName),
 		ibcTransferServer:     ibcTransferServer,
 		reqAttrBypassAddrs:    types.NewImmutableAccAddresses(reqAttrBypassAddrs),
 		groupChecker:          checker,
@@ -135,6 +144,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 var _ MarkerKeeperI = &Keeper{}
 
+// SetCapTableKeepers sets the hold and quarantine keepers used to include held and quarantined funds in cap tables.
+func (k *Keeper) SetCapTableKeepers(holdKeeper types.HoldKeeper, quarantineKeeper types.QuarantineKeeper) {
+	k.holdKeeper = holdKeeper
+	k.quarantineKeeper = quarantineKeeper
+}
+
 // NewMarker returns a new marker instance with the address and baseaccount assigned.  Does not save to auth store
 func (k Keeper) NewMarker(ctx sdk.Context, marker types.MarkerAccountI) types.MarkerAccountI {
 	return k.authKeeper.NewAccount(ctx, marker).(types.MarkerAccountI)
@@ -161,18 +176,33 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
+	k.removeMarkerIndexes(ctx, marker.GetAddress())
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	k.setMarkerIndexes(ctx, marker)
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
 // likely cause an invariant constraint violation for the coin supply
 func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
+	k.removeMarkerIndexes(ctx, marker.GetAddress())
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
+	k.clearSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageMarkerPrefix(marker.GetAddress()))
+	k.deletePrefix(ctx, types.LockupMarkerPrefix(marker.GetAddress()))
+	k.deletePrefix(ctx, types.PendingRedemptionMarkerPrefix(marker.GetAddress()))
+	k.deletePrefix(ctx, types.RecoveryMarkerPrefix(marker.GetAddress()))
+	store.Delete(types.RedemptionConfigKey(marker.GetAddress()))
+	store.Delete(types.DefaultTransferLimitKey(marker.GetAddress()))
+	k.deletePrefix(ctx, types.TransferLimitMarkerPrefix(marker.GetAddress()))
+	k.deletePrefix(ctx, types.TransferUsageMarkerPrefix(marker.GetAddress()))
+	store.Delete(types.HolderLimitKey(marker.GetAddress()))
+	k.clearHolderIndex(ctx, marker.GetAddress())
+	store.Delete(types.AccountDataSchemaKey(marker.GetAddress()))
+	store.Delete(types.IbcChannelPolicyKey(marker.GetAddress()))
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
 }
 
@@ -217,60 +247,6 @@ func (k Keeper) ValidateAuthority(addr string) error {
 	return nil
 }
 
-// IsSendDeny returns true if sender address is denied for marker
-func (k Keeper) IsSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.DenySendKey(markerAddr, senderAddr))
-}
-
-// AddSendDeny set sender address to denied for marker
-func (k Keeper) AddSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.DenySendKey(markerAddr, senderAddr), []byte{})
-}
-
-// RemoveSendDeny removes sender address from marker deny list
-func (k Keeper) RemoveSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.DenySendKey(markerAddr, senderAddr))
-}
-
-// ClearSendDeny removes all entries of a marker from a send deny list
-func (k Keeper) ClearSendDeny(ctx sdk.Context, markerAddr sdk.AccAddress) {
-	list := k.GetSendDenyList(ctx, markerAddr)
-	for _, sender := range list {
-		k.RemoveSendDeny(ctx, markerAddr, sender)
-	}
-}
-
-// IterateMarkers  iterates all markers with the given handler function.
-func (k Keeper) IterateSendDeny(ctx sdk.Context, handler func(key []byte) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.DenySendKeyPrefix)
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		if handler(iterator.Key()) {
-			break
-		}
-	}
-}
-
-// GetSendDenyList gets the list of sender addresses from the marker's deny list
-func (k Keeper) GetSendDenyList(ctx sdk.Context, markerAddr sdk.AccAddress) []sdk.AccAddress {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.DenySendMarkerPrefix(markerAddr))
-	list := []sdk.AccAddress{}
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		_, denied := types.GetDenySendAddresses(iterator.Key())
-		list = append(list, denied)
-	}
-
-	return list
-}
-
 // AddSetNetAssetValues adds a set of net asset values to a marker
 func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, source string) error {
 	var errs []error
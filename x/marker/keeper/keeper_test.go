@@ -159,7 +159,7 @@ func TestUnrestrictedDenoms(t *testing.T) {
 	user := testUserAddress("test")
 
 	// Require a long unrestricted denom
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}"})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}", RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod})
 	_, err := server.AddMarker(ctx, types.NewMsgAddMarkerRequest("tooshort", sdkmath.NewInt(30), user, user, types.MarkerType_Coin, true, true, false, []string{}, 0, 0))
 	require.Error(t, err, "fails with unrestricted denom length fault")
 	require.Equal(t, fmt.Errorf("invalid denom [tooshort] (fails unrestricted marker denom validation [a-z]{12,20})"), err, "should fail with denom restriction")
@@ -168,7 +168,7 @@ func TestUnrestrictedDenoms(t *testing.T) {
 	require.NoError(t, err, "should allow a marker with a sufficiently long denom")
 
 	// Set to an empty string (returns to default expression)
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: ""})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "", RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod})
 	_, err = server.AddMarker(ctx, types.NewMsgAddMarkerRequest("short", sdkmath.NewInt(30), user, user, types.MarkerType_Coin, true, true, false, []string{}, 0, 0))
 	// succeeds now as the default unrestricted denom expression allows any valid denom (minimum length is 2)
 	require.NoError(t, err, "should allow any valid denom with a min length of two")
@@ -1832,7 +1832,7 @@ func TestAddFinalizeActivateMarkerUnrestrictedDenoms(t *testing.T) {
 	user := testUserAddress("test")
 
 	// Require a long unrestricted denom
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}"})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}", RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod})
 
 	_, err := server.AddFinalizeActivateMarker(ctx,
 		types.NewMsgAddFinalizeActivateMarkerRequest(
@@ -1869,7 +1869,7 @@ func TestAddFinalizeActivateMarkerUnrestrictedDenoms(t *testing.T) {
 	require.NoError(t, err, "should allow a marker with a sufficiently long denom")
 
 	// Set to an empty string (returns to default expression)
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: ""})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "", RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod})
 	_, err = server.AddFinalizeActivateMarker(ctx, types.NewMsgAddFinalizeActivateMarkerRequest(
 		"short",
 		sdkmath.NewInt(30),
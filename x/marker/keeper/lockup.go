@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetAccountLockup records a schedule of amounts of the marker's denom that the address cannot send until their
+// release times. Any existing lockup for the address is replaced.
+func (k Keeper) SetAccountLockup(ctx sdk.Context, marker types.MarkerAccountI, addr sdk.AccAddress, releases []types.LockupRelease, administrator string) error {
+	lockup := types.NewLockup(marker.GetDenom(), addr, releases)
+	if err := lockup.Validate(); err != nil {
+		return err
+	}
+	for _, release := range lockup.Releases {
+		if !release.ReleaseTime.After(ctx.BlockTime()) {
+			return fmt.Errorf("release time %s must be after the current block time %s",
+				release.ReleaseTime.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+		}
+	}
+	if err := k.SetLockup(ctx, lockup); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerLockupSet(lockup, lockup.LockedAt(ctx.BlockTime()), administrator))
+}
+
+// RemoveAccountLockup removes the address's lockup of the marker's denom.
+func (k Keeper) RemoveAccountLockup(ctx sdk.Context, marker types.MarkerAccountI, addr sdk.AccAddress, administrator string) error {
+	key := types.LockupKey(marker.GetAddress(), addr)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return types.ErrLockupNotFound.Wrapf("%s lockup for %s", marker.GetDenom(), addr)
+	}
+	store.Delete(key)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerLockupRemoved(marker.GetDenom(), addr.String(), administrator))
+}
+
+// validateLockupsHeld returns an error if the address's balance of any of the denoms in the provided amount is
+// less than the amount of that denom that is still locked. It should be called after amt has been removed
+// from the address's balance (e.g. from the SendRestrictionFn) so that the error can describe the send.
+func (k Keeper) validateLockupsHeld(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		lockup, err := k.GetLockup(ctx, markerAddr, addr)
+		if err != nil {
+			return err
+		}
+		if lockup == nil {
+			continue
+		}
+		locked := lockup.LockedAt(ctx.BlockTime())
+		if locked.IsZero() {
+			continue
+		}
+		remaining := k.bankKeeper.GetBalance(ctx, addr, coin.Denom)
+		if remaining.Amount.LT(locked) {
+			return fmt.Errorf("cannot send %s from %s: it would leave %s which is less than the %s%s that is locked",
+				coin, addr, remaining, locked, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// GetLockup returns the address's lockup of a marker's denom, or nil if there isn't one.
+func (k Keeper) GetLockup(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.Lockup, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LockupKey(markerAddr, addr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var lockup types.Lockup
+	if err := k.cdc.Unmarshal(bz, &lockup); err != nil {
+		return nil, fmt.Errorf("could not read lockup for %s: %w", addr, err)
+	}
+	return &lockup, nil
+}
+
+// SetLockup stores a lockup.
+func (k Keeper) SetLockup(ctx sdk.Context, lockup types.Lockup) error {
+	markerAddr, err := types.MarkerAddress(lockup.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&lockup)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.LockupKey(markerAddr, lockup.GetAddress()), bz)
+	return nil
+}
+
+// IterateLockups iterates all account lockups with the given handler function.
+func (k Keeper) IterateLockups(ctx sdk.Context, handler func(lockup types.Lockup) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LockupKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var lockup types.Lockup
+		if err := k.cdc.Unmarshal(it.Value(), &lockup); err != nil {
+			return err
+		}
+		if handler(lockup) {
+			break
+		}
+	}
+	return nil
+}
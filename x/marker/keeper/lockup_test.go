@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestLockups(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	transferer := sdk.AccAddress("transferer__________")
+	investor := sdk.AccAddress("investor____________")
+	other := sdk.AccAddress("other_______________")
+
+	denom := "lockedcoin"
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 10000),
+		admin,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin}),
+			*types.NewAccessGrant(transferer, types.AccessList{types.Access_Transfer}),
+			*types.NewAccessGrant(investor, types.AccessList{types.Access_Transfer}),
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, investor, sdk.NewCoins(sdk.NewInt64Coin(denom, 1500))), "FundAccount")
+
+	first := now.Add(24 * time.Hour)
+	second := now.Add(48 * time.Hour)
+	releases := []types.LockupRelease{
+		types.NewLockupRelease(second, sdkmath.NewInt(400)),
+		types.NewLockupRelease(first, sdkmath.NewInt(600)),
+	}
+
+	_, err := msgServer.SetLockup(ctx, types.NewMsgSetLockupRequest(denom, investor, releases, transferer.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SetLockup without admin access")
+	_, err = msgServer.SetLockup(ctx, types.NewMsgSetLockupRequest(denom, investor,
+		[]types.LockupRelease{types.NewLockupRelease(now, sdkmath.NewInt(1))}, admin.String()))
+	require.ErrorContains(t, err, "must be after the current block time", "SetLockup with a release in the past")
+	_, err = msgServer.SetLockup(ctx, types.NewMsgSetLockupRequest(denom, investor, releases, admin.String()))
+	require.NoError(t, err, "SetLockup")
+
+	resp, err := app.MarkerKeeper.Lockup(ctx, &types.QueryLockupRequest{Id: denom, Address: investor.String()})
+	require.NoError(t, err, "Lockup query")
+	require.Equal(t, "1000"+denom, resp.Locked.String(), "locked amount")
+	require.Equal(t, first, resp.Lockup.Releases[0].ReleaseTime, "releases are ordered by release time")
+
+	// send uses a cache context so that, like a failed tx, a failed send leaves no changes behind.
+	send := func(ctx sdk.Context, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := app.BankKeeper.SendCoins(cacheCtx, investor, other, sdk.NewCoins(sdk.NewInt64Coin(denom, amt))); err != nil {
+			return err
+		}
+		writeCache()
+		return nil
+	}
+	require.EqualError(t, send(ctx, 501), "cannot send 501"+denom+" from "+investor.String()+": it would leave 999"+denom+" which is less than the 1000"+denom+" that is locked",
+		"send more than the unlocked balance")
+	require.NoError(t, send(ctx, 500), "send the unlocked balance")
+	require.Error(t, send(ctx, 1), "send after the unlocked balance is used")
+
+	// The account's own transfer access cannot be used to get around the lockup.
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorContains(t, app.MarkerKeeper.TransferCoin(cacheCtx, investor, other, investor, sdk.NewInt64Coin(denom, 1)),
+		"that is locked", "TransferCoin of locked funds by the account itself")
+
+	// Neither can someone the account has granted transfer authorization to.
+	cacheCtx, _ = ctx.CacheContext()
+	auth := types.NewMarkerTransferAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), nil)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(cacheCtx, transferer, investor, auth, nil), "SaveGrant")
+	require.ErrorContains(t, app.MarkerKeeper.TransferCoin(cacheCtx, investor, other, transferer, sdk.NewInt64Coin(denom, 1)),
+		"that is locked", "TransferCoin of locked funds by an authz grantee")
+
+	// Nor can the funds be sent out over ibc.
+	ibcKeeper := app.MarkerKeeper.WithIbcTransferServer(NewMockIbcTransferServer(app.BankKeeper))
+	ibcTransfer := func(ctx sdk.Context, amt int64) error {
+		cacheCtx, _ := ctx.CacheContext()
+		return ibcKeeper.IbcTransferCoin(cacheCtx, ibctypes.PortID, "channel-0", sdk.NewInt64Coin(denom, amt), investor, investor,
+			other.String(), clienttypes.NewHeight(1, 1000), 0, "")
+	}
+	require.ErrorContains(t, ibcTransfer(ctx, 1), "that is locked", "IbcTransferCoin of locked funds")
+
+	ctx = ctx.WithBlockTime(first)
+	require.NoError(t, send(ctx, 600), "send after the first release")
+	require.Error(t, send(ctx, 1), "send of funds still locked after the first release")
+
+	ctx = ctx.WithBlockTime(second)
+	require.NoError(t, ibcTransfer(ctx, 400), "IbcTransferCoin after the last release")
+	require.NoError(t, send(ctx, 400), "send after the last release")
+
+	lockups, err := app.MarkerKeeper.Lockups(ctx, &types.QueryLockupsRequest{Id: denom})
+	require.NoError(t, err, "Lockups query")
+	require.Len(t, lockups.Lockups, 1, "lockups for marker")
+
+	_, err = msgServer.RemoveLockup(ctx, types.NewMsgRemoveLockupRequest(denom, investor, admin.String()))
+	require.NoError(t, err, "RemoveLockup")
+	_, err = msgServer.RemoveLockup(ctx, types.NewMsgRemoveLockupRequest(denom, investor, admin.String()))
+	require.ErrorIs(t, err, types.ErrLockupNotFound, "RemoveLockup without a lockup")
+	_, err = app.MarkerKeeper.Lockup(ctx, &types.QueryLockupRequest{Id: denom, Address: investor.String()})
+	require.ErrorIs(t, err, types.ErrLockupNotFound, "Lockup query after removal")
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -40,6 +41,9 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 	if err := marker.Validate(); err != nil {
 		return err
 	}
+	if err := validateGrantExpirations(ctx, marker.GetAccessList()...); err != nil {
+		return err
+	}
 	markerAddress := types.MustGetMarkerAddress(marker.GetDenom())
 
 	if !marker.GetAddress().Equals(markerAddress) {
@@ -93,7 +97,7 @@ func (k Keeper) AddAccess(
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.WithoutExpiredAccess(ctx.BlockTime()).AddressHasAccess(caller, types.Access_Admin) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -105,6 +109,13 @@ func (k Keeper) AddAccess(
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
+		if err = validateGrantExpiration(ctx, grant); err != nil {
+			return fmt.Errorf("access grant failed: %w", err)
+		}
+		// An expired grant is removed first so that its permissions aren't merged into the new one.
+		if err = k.RemoveExpiredAccessGrants(ctx, m); err != nil {
+			return fmt.Errorf("access grant failed: %w", err)
+		}
 		if err = m.GrantAccess(grant); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -122,6 +133,44 @@ func (k Keeper) AddAccess(
 	return ctx.EventManager().EmitTypedEvent(markerAddAccessEvent)
 }
 
+// RemoveExpiredAccessGrants removes any access grants on the marker that have expired as of the current block time.
+func (k Keeper) RemoveExpiredAccessGrants(ctx sdk.Context, marker types.MarkerAccountI) error {
+	expired := marker.RemoveExpiredAccess(ctx.BlockTime())
+	if len(expired) == 0 {
+		return nil
+	}
+	if err := marker.Validate(); err != nil {
+		return fmt.Errorf("could not remove expired access from %s marker: %w", marker.GetDenom(), err)
+	}
+	k.SetMarker(ctx, marker)
+	for _, grant := range expired {
+		k.clearSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageAddressPrefix(marker.GetAddress(), grant.GetAddress()))
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessExpired(grant, marker.GetDenom())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGrantExpirations returns an error if any of the provided grants has an expiration that is not in the future.
+func validateGrantExpirations(ctx sdk.Context, grants ...types.AccessGrant) error {
+	for i := range grants {
+		if err := validateGrantExpiration(ctx, &grants[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGrantExpiration returns an error if the grant has an expiration that is not in the future.
+func validateGrantExpiration(ctx sdk.Context, grant types.AccessGrantI) error {
+	if grant.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("expiration %s for %s must be after the current block time %s",
+			grant.GetExpiration().UTC().Format(time.RFC3339), grant.GetAddress(), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 // RemoveAccess delete the AccessGrant for the specified user from the marker if the caller is allowed to make changes
 func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom string, remove sdk.AccAddress) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "remove_access")
@@ -135,7 +184,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.WithoutExpiredAccess(ctx.BlockTime()).AddressHasAccess(caller, types.Access_Admin) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -154,6 +203,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 			return err
 		}
 		k.SetMarker(ctx, m)
+		k.clearSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageAddressPrefix(m.GetAddress(), remove))
 	// Undefined, Cancelled, Destroyed -- no modifications are supported in these states
 	default:
 		return fmt.Errorf("marker in %s state can not be modified", m.GetStatus())
@@ -176,7 +226,7 @@ func (k Keeper) WithdrawCoins(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Withdraw); err != nil {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Withdraw); err != nil {
 		return err
 	}
 
@@ -199,6 +249,10 @@ func (k Keeper) WithdrawCoins(
 		return fmt.Errorf("%s is not allowed to receive funds", recipient)
 	}
 
+	if err := k.validateHolderLimits(ctx, recipient, coins); err != nil {
+		return err
+	}
+
 	if err := k.bankKeeper.SendCoins(types.WithBypass(ctx), m.GetAddress(), recipient, coins); err != nil {
 		return err
 	}
@@ -219,7 +273,10 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", coin.Denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
+		return err
+	}
+	if err = k.useSupplyAllowance(ctx, m, caller, types.Access_Mint, coin.Amount); err != nil {
 		return err
 	}
 
@@ -259,7 +316,10 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", coin.Denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Burn); err != nil {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Burn); err != nil {
+		return err
+	}
+	if err = k.useSupplyAllowance(ctx, m, caller, types.Access_Burn, coin.Amount); err != nil {
 		return err
 	}
 
@@ -526,7 +586,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	switch m.GetStatus() {
 	case types.StatusFinalized, types.StatusActive:
 		// for active or finalized markers the caller must be assigned permission to perform this action.
-		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil {
+		if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Delete); err != nil {
 			return err
 		}
 		// for finalized/active we need to ensure the full coin supply has been recalled as it will all be burned.
@@ -540,7 +600,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		}
 	case types.StatusProposed:
 		// for a proposed marker either the manager or someone assigned `delete` can perform this action
-		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
+		if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
 			return err
 		}
 	case types.StatusCancelled:
@@ -572,7 +632,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	}
 
 	// either the manager [set if a proposed marker was cancelled] or someone assigned `delete` can perform this action
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
 		return err
 	}
 
@@ -637,8 +697,9 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
 
-	adminCanForceTransfer := m.AddressHasAccess(admin, types.Access_ForceTransfer)
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil && !adminCanForceTransfer {
+	active := m.WithoutExpiredAccess(ctx.BlockTime())
+	adminCanForceTransfer := active.AddressHasAccess(admin, types.Access_ForceTransfer)
+	if err = active.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil && !adminCanForceTransfer {
 		return err
 	}
 
@@ -647,9 +708,11 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return err
 	}
 
+	// A transfer is only forced when the admin moves someone else's funds using force transfer access.
+	forced := !admin.Equals(from) && m.AllowsForcedTransfer() && adminCanForceTransfer
 	if !admin.Equals(from) {
 		switch {
-		case !m.AllowsForcedTransfer() || !adminCanForceTransfer:
+		case !forced:
 			// Either force transfers of this denom aren't allowed, or the admin does not have
 			// permission to do forced transfers. Only allow this if there's an authz grant.
 			// We assume that a marker account cannot issue an authz grant.
@@ -674,6 +737,17 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return err
 	}
 
+	// Forced transfers can move funds that are locked up and are not subject to transfer limits.
+	// All other transfers can only move funds that aren't locked up, and count against the from account's limit.
+	if !forced {
+		if err = k.validateLockupsHeld(ctx, from, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+		if err = k.useTransferLimits(ctx, from, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+	}
+
 	markerTransferEvent := types.NewEventMarkerTransfer(
 		amount.Amount.String(),
 		amount.Denom,
@@ -742,7 +816,14 @@ func (k Keeper) IbcTransferCoin(
 	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil {
+		return err
+	}
+	// The transfer bypasses the send restrictions, so the send-deny list is checked here like it is for redemptions.
+	if k.IsSendDeny(ctx, m.GetAddress(), sender) {
+		return fmt.Errorf("%s is on deny list for sending %s", sender, token.Denom)
+	}
+	if err = k.ValidateIbcTransfer(ctx, m, sourcePort, sourceChannel, token); err != nil {
 		return err
 	}
 	to, err := sdk.AccAddressFromBech32(receiver)
@@ -756,9 +837,14 @@ func (k Keeper) IbcTransferCoin(
 		}
 	}
 
+	// The transfer bypasses the send restrictions, so it's counted against the sender's transfer limits here.
+	if err = k.useTransferLimits(ctx, sender, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
 	// checking if escrow account has transfer auth, if not add it
 	escrowAccount := ibctypes.GetEscrowAddress(sourcePort, sourceChannel)
-	if !m.AddressHasAccess(escrowAccount, types.Access_Transfer) {
+	if !m.WithoutExpiredAccess(ctx.BlockTime()).AddressHasAccess(escrowAccount, types.Access_Transfer) {
 		err = m.GrantAccess(types.NewAccessGrant(escrowAccount, []types.Access{types.Access_Transfer}))
 		if err != nil {
 			return err
@@ -777,6 +863,15 @@ func (k Keeper) IbcTransferCoin(
 		return err
 	}
 
+	// The transfer bypasses the send restrictions, so the lockup is checked here like it is for TransferCoin.
+	if err = k.validateLockupsHeld(ctx, sender, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+	// The bypassed send restrictions only keep track of the holders, so the holder limits are enforced here too.
+	if err = k.updateHolders(ctx, sender, escrowAccount, sdk.NewCoins(token), true); err != nil {
+		return err
+	}
+
 	markerIbcTransferEvent := types.NewEventMarkerIbcTransfer(
 		token.Amount.String(),
 		token.Denom,
@@ -818,7 +913,7 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	if markerErr != nil {
 		return fmt.Errorf("marker not found for %s: %w", metadata.Base, markerErr)
 	}
-	if err := marker.ValidateAddressHasAccess(caller, types.Access_Admin); err != nil && !marker.GetManager().Equals(caller) {
+	if err := marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(caller, types.Access_Admin); err != nil && !marker.GetManager().Equals(caller) {
 		return err
 	}
 
@@ -883,5 +978,5 @@ func (k Keeper) validateSendToMarker(ctx sdk.Context, toAddr, admin sdk.AccAddre
 	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return nil
 	}
-	return marker.ValidateAddressHasAccess(admin, types.Access_Deposit)
+	return marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(admin, types.Access_Deposit)
 }
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// markerIndexKeys returns the secondary index keys of a marker: one for each address with an access grant,
+// one for its marker type, and one for each attribute name used in its required attributes.
+func markerIndexKeys(marker types.MarkerAccountI) [][]byte {
+	markerAddr := marker.GetAddress()
+	attrNames := requiredAttributeNames(marker)
+	rv := make([][]byte, 0, len(marker.GetAccessList())+1+len(attrNames))
+	for _, grant := range marker.GetAccessList() {
+		rv = append(rv, types.MarkerGrantIndexKey(grant.GetAddress(), markerAddr))
+	}
+	rv = append(rv, types.MarkerTypeIndexKey(marker.GetMarkerType(), markerAddr))
+	for _, name := range attrNames {
+		rv = append(rv, types.MarkerRequiredAttributeIndexKey(name, markerAddr))
+	}
+	return rv
+}
+
+// requiredAttributeNames returns the distinct, lowercased attribute names used in a marker's required attributes.
+// Each name in a required attribute expression is included on its own, so a marker with the requirement
+// "expr:kyc.pb or accredited.pb" is found by a filter on either name.
+func requiredAttributeNames(marker types.MarkerAccountI) []string {
+	var rv []string
+	seen := make(map[string]bool)
+	for _, reqAttr := range marker.GetRequiredAttributes() {
+		names := []string{reqAttr}
+		if expr, err := types.ParseRequiredAttribute(reqAttr); err == nil {
+			names = expr.Names()
+		}
+		for _, name := range names {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if len(name) > 0 && !seen[name] {
+				seen[name] = true
+				rv = append(rv, name)
+			}
+		}
+	}
+	return rv
+}
+
+// setMarkerIndexes adds the marker to the secondary indexes. Each index entry's value is the marker address.
+func (k Keeper) setMarkerIndexes(ctx sdk.Context, marker types.MarkerAccountI) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range markerIndexKeys(marker) {
+		store.Set(key, marker.GetAddress())
+	}
+}
+
+// removeMarkerIndexes removes the marker currently stored at the address from the secondary indexes.
+// The stored marker is used (instead of one provided by the caller) since that's what the entries were made from.
+func (k Keeper) removeMarkerIndexes(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	marker, ok := k.authKeeper.GetAccount(ctx, markerAddr).(types.MarkerAccountI)
+	if !ok {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range markerIndexKeys(marker) {
+		store.Delete(key)
+	}
+}
+
+// indexMarkers adds all existing markers to the secondary indexes.
+func (k Keeper) indexMarkers(ctx sdk.Context) {
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		k.setMarkerIndexes(ctx, marker)
+		return false
+	})
+}
+
+// markerFilterIndexPrefix returns the key prefix of the most selective index that can be used to find the markers
+// matching the request. If no index applies, the prefix of the full list of markers is returned.
+func markerFilterIndexPrefix(req *types.QueryFilteredMarkersRequest, grantee sdk.AccAddress) []byte {
+	switch {
+	case len(grantee) > 0:
+		return types.MarkerGrantIndexPrefix(grantee)
+	case len(strings.TrimSpace(req.RequiredAttribute)) > 0:
+		return types.MarkerRequiredAttributeIndexPrefix(req.RequiredAttribute)
+	case req.MarkerType != types.MarkerType_Unknown:
+		return types.MarkerTypeIndexPrefix(req.MarkerType)
+	}
+	return types.MarkerStoreKeyPrefix
+}
+
+// markerMatchesFilter returns true if the marker matches every filter in the request.
+func markerMatchesFilter(marker types.MarkerAccountI, req *types.QueryFilteredMarkersRequest, grantee sdk.AccAddress) bool {
+	if len(grantee) > 0 {
+		if req.Access != types.Access_Unknown {
+			if !marker.AddressHasAccess(grantee, req.Access) {
+				return false
+			}
+		} else if len(types.GrantsForAddress(grantee, marker.GetAccessList()...).GetAccessList()) == 0 {
+			return false
+		}
+	}
+	if req.MarkerType != types.MarkerType_Unknown && marker.GetMarkerType() != req.MarkerType {
+		return false
+	}
+	if req.Status != types.StatusUndefined && marker.GetStatus() != req.Status {
+		return false
+	}
+	if !flagFilterMatches(req.SupplyFixed, marker.HasFixedSupply()) ||
+		!flagFilterMatches(req.AllowForcedTransfer, marker.AllowsForcedTransfer()) {
+		return false
+	}
+	if reqAttr := strings.ToLower(strings.TrimSpace(req.RequiredAttribute)); len(reqAttr) > 0 {
+		for _, name := range requiredAttributeNames(marker) {
+			if name == reqAttr {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// flagFilterMatches returns true if a marker flag with the given value passes the filter.
+func flagFilterMatches(filter types.FlagFilter, value bool) bool {
+	switch filter {
+	case types.FlagFilterTrue:
+		return value
+	case types.FlagFilterFalse:
+		return !value
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestFilteredMarkers(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addrA := sdk.AccAddress("addrA_______________")
+	addrB := sdk.AccAddress("addrB_______________")
+
+	newMarker := func(denom string, markerType types.MarkerType, supplyFixed, allowForcedTransfer bool, reqAttrs []string, grants ...types.AccessGrant) types.MarkerAccountI {
+		return types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 1000),
+			nil,
+			grants,
+			types.StatusActive,
+			markerType,
+			supplyFixed,
+			false,
+			allowForcedTransfer,
+			reqAttrs,
+		)
+	}
+	markerA := newMarker("indexcoina", types.MarkerType_Coin, true, false, nil,
+		*types.NewAccessGrant(addrA, types.AccessList{types.Access_Admin, types.Access_Mint}))
+	markerB := newMarker("indexcoinb", types.MarkerType_RestrictedCoin, false, true, []string{"kyc.pb"},
+		*types.NewAccessGrant(addrA, types.AccessList{types.Access_Transfer}),
+		*types.NewAccessGrant(addrB, types.AccessList{types.Access_Admin}))
+	markerC := newMarker("indexcoinc", types.MarkerType_RestrictedCoin, false, false,
+		[]string{"expr:kyc.pb and not (accredited.pb or KYC.pb)"},
+		*types.NewAccessGrant(addrB, types.AccessList{types.Access_Admin}))
+	for _, marker := range []types.MarkerAccountI{markerA, markerB, markerC} {
+		app.MarkerKeeper.SetMarker(ctx, app.MarkerKeeper.NewMarker(ctx, marker))
+	}
+
+	denoms := func(req *types.QueryFilteredMarkersRequest) []string {
+		resp, err := app.MarkerKeeper.FilteredMarkers(ctx, req)
+		require.NoError(t, err, "FilteredMarkers(%v)", req)
+		var rv []string
+		for _, m := range resp.Markers {
+			var marker types.MarkerAccountI
+			require.NoError(t, app.InterfaceRegistry().UnpackAny(m, &marker), "UnpackAny")
+			rv = append(rv, marker.GetDenom())
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name string
+		req  *types.QueryFilteredMarkersRequest
+		exp  []string
+	}{
+		{name: "no filters", req: &types.QueryFilteredMarkersRequest{}, exp: []string{"indexcoina", "indexcoinb", "indexcoinc"}},
+		{name: "any grant", req: &types.QueryFilteredMarkersRequest{GrantAddress: addrA.String()}, exp: []string{"indexcoina", "indexcoinb"}},
+		{
+			name: "specific access",
+			req:  &types.QueryFilteredMarkersRequest{GrantAddress: addrA.String(), Access: types.Access_Admin},
+			exp:  []string{"indexcoina"},
+		},
+		{
+			name: "marker type",
+			req:  &types.QueryFilteredMarkersRequest{MarkerType: types.MarkerType_RestrictedCoin},
+			exp:  []string{"indexcoinb", "indexcoinc"},
+		},
+		{
+			name: "required attribute",
+			req:  &types.QueryFilteredMarkersRequest{RequiredAttribute: " KYC.pb"},
+			exp:  []string{"indexcoinb", "indexcoinc"},
+		},
+		{
+			name: "required attribute only in an expression",
+			req:  &types.QueryFilteredMarkersRequest{RequiredAttribute: "accredited.pb"},
+			exp:  []string{"indexcoinc"},
+		},
+		{
+			name: "required attribute expression",
+			req:  &types.QueryFilteredMarkersRequest{RequiredAttribute: "expr:kyc.pb and not (accredited.pb or KYC.pb)"},
+			exp:  nil,
+		},
+		{name: "supply fixed", req: &types.QueryFilteredMarkersRequest{SupplyFixed: types.FlagFilterTrue}, exp: []string{"indexcoina"}},
+		{
+			name: "type and no forced transfers",
+			req:  &types.QueryFilteredMarkersRequest{MarkerType: types.MarkerType_RestrictedCoin, AllowForcedTransfer: types.FlagFilterFalse},
+			exp:  []string{"indexcoinc"},
+		},
+		{name: "status", req: &types.QueryFilteredMarkersRequest{Status: types.StatusProposed}, exp: nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ElementsMatch(t, tc.exp, denoms(tc.req), "FilteredMarkers denoms")
+		})
+	}
+
+	_, err := app.MarkerKeeper.FilteredMarkers(ctx, &types.QueryFilteredMarkersRequest{Access: types.Access_Admin})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = an access filter requires a grant address", "access without a grant address")
+
+	// Filtered results are paginated.
+	req := &types.QueryFilteredMarkersRequest{GrantAddress: addrB.String(), Access: types.Access_Admin, Pagination: &query.PageRequest{Limit: 1}}
+	resp, err := app.MarkerKeeper.FilteredMarkers(ctx, req)
+	require.NoError(t, err, "FilteredMarkers first page")
+	require.Len(t, resp.Markers, 1, "first page markers")
+	require.NotEmpty(t, resp.Pagination.NextKey, "first page next key")
+	req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey}
+	resp, err = app.MarkerKeeper.FilteredMarkers(ctx, req)
+	require.NoError(t, err, "FilteredMarkers second page")
+	require.Len(t, resp.Markers, 1, "second page markers")
+
+	// The indexes follow changes to the markers.
+	markerB, err = app.MarkerKeeper.GetMarker(ctx, markerB.GetAddress())
+	require.NoError(t, err, "GetMarker")
+	require.NoError(t, markerB.RevokeAccess(addrA), "RevokeAccess")
+	markerB.SetRequiredAttributes(nil)
+	app.MarkerKeeper.SetMarker(ctx, markerB)
+	require.Equal(t, []string{"indexcoina"}, denoms(&types.QueryFilteredMarkersRequest{GrantAddress: addrA.String()}), "markers after revoking access")
+	require.Equal(t, []string{"indexcoinc"}, denoms(&types.QueryFilteredMarkersRequest{RequiredAttribute: "kyc.pb"}), "markers after removing required attributes")
+
+	app.MarkerKeeper.RemoveMarker(ctx, markerA)
+	require.Empty(t, denoms(&types.QueryFilteredMarkersRequest{GrantAddress: addrA.String()}), "markers after removing a marker")
+
+	// The migration rebuilds the indexes of existing markers.
+	store := app.MarkerKeeper.GetStore(ctx)
+	for _, pre := range [][]byte{types.MarkerGrantIndexKeyPrefix, types.MarkerTypeIndexKeyPrefix, types.MarkerRequiredAttributeIndexKeyPrefix} {
+		it := storetypes.KVStorePrefixIterator(store, pre)
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	require.Empty(t, denoms(&types.QueryFilteredMarkersRequest{GrantAddress: addrB.String()}), "markers without indexes")
+	require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate2To3(ctx), "Migrate2To3")
+	require.ElementsMatch(t, []string{"indexcoinb", "indexcoinc"}, denoms(&types.QueryFilteredMarkersRequest{GrantAddress: addrB.String()}), "markers after migration")
+}
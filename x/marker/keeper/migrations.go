@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,16 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2To3 adds the existing markers to the secondary indexes used to look up markers by
+// access grant address, marker type, and required attribute. It also sets the recovery
+// challenge period param, which did not exist before, to its default.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	m.keeper.indexMarkers(ctx)
+	params := m.keeper.GetParams(ctx)
+	if params.RecoveryChallengePeriod <= 0 {
+		params.RecoveryChallengePeriod = types.DefaultRecoveryChallengePeriod
+		m.keeper.SetParams(ctx, params)
+	}
+	return nil
+}
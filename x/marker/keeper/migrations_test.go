@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMigrate2To3RecoveryChallengePeriod(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	store := app.MarkerKeeper.GetStore(ctx)
+
+	// Params stored before the migration don't have a recovery challenge period.
+	oldParams := types.DefaultParams()
+	oldParams.RecoveryChallengePeriod = 0
+	store.Set(types.MarkerParamStoreKey, app.AppCodec().MustMarshal(&oldParams))
+	require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate2To3(ctx), "Migrate2To3 without a period")
+	require.Equal(t, types.DefaultRecoveryChallengePeriod, app.MarkerKeeper.GetParams(ctx).RecoveryChallengePeriod, "recovery challenge period after migration")
+
+	// A period that's already set is left alone.
+	setParams := types.DefaultParams()
+	setParams.RecoveryChallengePeriod = 36 * time.Hour
+	app.MarkerKeeper.SetParams(ctx, setParams)
+	require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate2To3(ctx), "Migrate2To3 with a period")
+	require.Equal(t, 36*time.Hour, app.MarkerKeeper.GetParams(ctx).RecoveryChallengePeriod, "recovery challenge period after migration with a period")
+}
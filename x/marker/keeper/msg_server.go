@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -45,7 +46,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *types.MsgGrantAllo
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+	if err = m.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
 	allowance, err := msg.GetFeeAllowanceI()
@@ -594,7 +595,7 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 		if !m.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
-	case !m.AddressHasAccess(caller, types.Access_Transfer):
+	case !m.WithoutExpiredAccess(ctx.BlockTime()).AddressHasAccess(caller, types.Access_Transfer):
 		return nil, fmt.Errorf("caller does not have authority to update required attributes %s", msg.TransferAuthority)
 	}
 
@@ -666,12 +667,12 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
 	} else {
-		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Deposit); err != nil {
+		if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Signer, types.Access_Deposit); err != nil {
 			return nil, err
 		}
 	}
 
-	err = k.attrKeeper.SetAccountData(ctx, marker.GetAddress().String(), msg.Value)
+	err = k.SetMarkerAccountData(ctx, marker, msg.Value)
 	if err != nil {
 		return nil, fmt.Errorf("error setting %s account data: %w", msg.Denom, err)
 	}
@@ -679,6 +680,37 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 	return &types.MsgSetAccountDataResponse{}, nil
 }
 
+// SetAccountDataSchema sets or removes the schema that a denom's accountdata must conform to.
+// Signer must have deposit authority.
+func (k msgServer) SetAccountDataSchema(goCtx context.Context, msg *types.MsgSetAccountDataSchemaRequest) (*types.MsgSetAccountDataSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Signer, types.Access_Deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.SetMarkerAccountDataSchema(ctx, marker, msg.Schema, msg.Signer); err != nil {
+		return nil, fmt.Errorf("error setting %s account data schema: %w", msg.Denom, err)
+	}
+
+	return &types.MsgSetAccountDataSchemaResponse{}, nil
+}
+
 // UpdateSendDenyList updates the deny send list for restricted marker. Signer must be admin or gov proposal.
 func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdateSendDenyListRequest) (*types.MsgUpdateSendDenyListResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -696,7 +728,7 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 		if !marker.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
-	} else if err = marker.ValidateHasAccess(msg.Authority, types.Access_Transfer); err != nil {
+	} else if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Authority, types.Access_Transfer); err != nil {
 		return nil, err
 	}
 
@@ -710,8 +742,14 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 			return nil, fmt.Errorf("%s is not on deny list cannot remove address", addr)
 		}
 		k.RemoveSendDeny(ctx, markerAddr, denyAddr)
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSendDenyRemoved(marker.GetDenom(), addr, msg.Authority)); err != nil {
+			return nil, err
+		}
 	}
 
+	if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("deny send expiration %s must be after the block time %s", msg.Expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
 	for _, addr := range msg.AddDeniedAddresses {
 		denyAddr, err := sdk.AccAddressFromBech32(addr)
 		if err != nil {
@@ -720,7 +758,13 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 		if k.IsSendDeny(ctx, markerAddr, denyAddr) {
 			return nil, fmt.Errorf("%s is already on deny list cannot add address", addr)
 		}
-		k.AddSendDeny(ctx, markerAddr, denyAddr)
+		entry := types.NewDenySendAddress(markerAddr, denyAddr, msg.Reason, msg.Authority, ctx.BlockTime(), msg.Expiration)
+		if err = k.SetSendDenyEntry(ctx, entry); err != nil {
+			return nil, err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSendDenyAdded(marker.GetDenom(), entry, msg.Authority)); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgUpdateSendDenyListResponse{}, nil
@@ -739,7 +783,7 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	if !isGovProp {
 		admin := sdk.MustAccAddressFromBech32(msg.Administrator)
-		hasGrants := types.GrantsForAddress(admin, marker.GetAccessList()...).GetAccessList()
+		hasGrants := types.GrantsForAddress(admin, marker.WithoutExpiredAccess(ctx.BlockTime()).GetAccessList()...).GetAccessList()
 		if len(hasGrants) == 0 {
 			return nil, fmt.Errorf("signer %v does not have permission to add net asset value for %q", msg.Administrator, marker.GetDenom())
 		}
@@ -848,3 +892,455 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CreateDistribution pays funds held in a marker's escrow to the holders of the marker's denom, pro-rata.
+func (k msgServer) CreateDistribution(goCtx context.Context, msg *types.MsgCreateDistributionRequest) (*types.MsgCreateDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	isGovProp := marker.HasGovernanceEnabled() && msg.Administrator == k.GetAuthority()
+	if !isGovProp {
+		if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Administrator, types.Access_Withdraw); err != nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+	}
+
+	dist, err := k.Keeper.CreateDistribution(ctx, marker, msg.Amount, msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCreateDistributionResponse{DistributionId: dist.Id}, nil
+}
+
+// SetLockup sets the schedule of amounts of a marker's denom that an account cannot send until their release times.
+func (k msgServer) SetLockup(goCtx context.Context, msg *types.MsgSetLockupRequest) (*types.MsgSetLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	if err = k.SetAccountLockup(ctx, marker, addr, msg.Releases, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetLockupResponse{}, nil
+}
+
+// RemoveLockup removes an account's lockup of a marker's denom.
+func (k msgServer) RemoveLockup(goCtx context.Context, msg *types.MsgRemoveLockupRequest) (*types.MsgRemoveLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	if err = k.RemoveAccountLockup(ctx, marker, addr, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLockupResponse{}, nil
+}
+
+// validateAdminAuthority returns an error if the authority is neither the governance authority (when allowed) nor a marker admin.
+func (k msgServer) validateAdminAuthority(ctx sdk.Context, marker types.MarkerAccountI, authority string) error {
+	if marker.HasGovernanceEnabled() && authority == k.GetAuthority() {
+		return nil
+	}
+	if err := marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(authority, types.Access_Admin); err != nil {
+		return sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	return nil
+}
+
+// SplitMarker multiplies every balance of a marker's denom, and the marker's supply, by a ratio.
+func (k msgServer) SplitMarker(goCtx context.Context, msg *types.MsgSplitMarkerRequest) (*types.MsgSplitMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	supply, err := k.Keeper.SplitMarker(ctx, marker, msg.Numerator, msg.Denominator, msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSplitMarkerResponse{Supply: supply}, nil
+}
+
+// SetRedemptionConfig sets or removes how holders can redeem a marker's denom for funds in the marker's escrow.
+func (k msgServer) SetRedemptionConfig(goCtx context.Context, msg *types.MsgSetRedemptionConfigRequest) (*types.MsgSetRedemptionConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetMarkerRedemptionConfig(ctx, marker, msg.Config, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetRedemptionConfigResponse{}, nil
+}
+
+// Redeem burns units of a marker's denom and pays the redeemer from the marker's escrow.
+func (k msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeemRequest) (*types.MsgRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	redeemer := sdk.MustAccAddressFromBech32(msg.Redeemer)
+	id, payout, err := k.Keeper.Redeem(ctx, marker, redeemer, msg.Amount.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgRedeemResponse{RedemptionId: id, Payout: payout}, nil
+}
+
+// ApproveRedemption completes a redemption that is awaiting approval.
+func (k msgServer) ApproveRedemption(goCtx context.Context, msg *types.MsgApproveRedemptionRequest) (*types.MsgApproveRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateRedemptionAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	payout, err := k.Keeper.ApproveRedemption(ctx, marker, msg.RedemptionId, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveRedemptionResponse{Payout: payout}, nil
+}
+
+// RejectRedemption cancels a redemption that is awaiting approval, returning the units to the redeemer.
+func (k msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemptionRequest) (*types.MsgRejectRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateRedemptionAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.RejectRedemption(ctx, marker, msg.RedemptionId, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectRedemptionResponse{}, nil
+}
+
+// validateRedemptionAuthority returns an error if the authority is not allowed to approve or reject redemptions of the marker's denom.
+func (k msgServer) validateRedemptionAuthority(ctx sdk.Context, marker types.MarkerAccountI, authority string) error {
+	if marker.HasGovernanceEnabled() && authority == k.GetAuthority() {
+		return nil
+	}
+	if err := marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(authority, types.Access_Withdraw); err != nil {
+		return sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	return nil
+}
+
+// SetTransferLimit sets how much of a marker's denom can be sent per rolling day and week, either by default or by a
+// specific account.
+func (k msgServer) SetTransferLimit(goCtx context.Context, msg *types.MsgSetTransferLimitRequest) (*types.MsgSetTransferLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Limit.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetMarkerTransferLimit(ctx, marker, msg.Limit, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetTransferLimitResponse{}, nil
+}
+
+// RemoveTransferLimit removes a marker's default transfer limit or an account's transfer limit.
+func (k msgServer) RemoveTransferLimit(goCtx context.Context, msg *types.MsgRemoveTransferLimitRequest) (*types.MsgRemoveTransferLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	var addr sdk.AccAddress
+	if len(msg.Address) > 0 {
+		addr = sdk.MustAccAddressFromBech32(msg.Address)
+	}
+	if err = k.RemoveMarkerTransferLimit(ctx, marker, addr, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveTransferLimitResponse{}, nil
+}
+
+// ProposeRecovery proposes a forced transfer that can be executed once its challenge period ends.
+func (k msgServer) ProposeRecovery(goCtx context.Context, msg *types.MsgProposeRecoveryRequest) (*types.MsgProposeRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Administrator, types.Access_ForceTransfer); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	from := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	id, err := k.Keeper.ProposeRecovery(ctx, marker, from, to, msg.Amount.Amount, msg.Reason, admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgProposeRecoveryResponse{RecoveryId: id}, nil
+}
+
+// CancelRecovery cancels a proposed recovery. It can be signed by the owner of the funds or the proposer.
+func (k msgServer) CancelRecovery(goCtx context.Context, msg *types.MsgCancelRecoveryRequest) (*types.MsgCancelRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	recovery, err := k.GetRecovery(ctx, marker.GetAddress(), msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Signer != recovery.FromAddress && msg.Signer != recovery.Proposer {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s cannot cancel recovery %d", msg.Signer, msg.RecoveryId)
+	}
+
+	if err = k.Keeper.CancelRecovery(ctx, marker, msg.RecoveryId, sdk.MustAccAddressFromBech32(msg.Signer)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelRecoveryResponse{}, nil
+}
+
+// ExecuteRecovery completes a proposed recovery whose challenge period has ended.
+func (k msgServer) ExecuteRecovery(goCtx context.Context, msg *types.MsgExecuteRecoveryRequest) (*types.MsgExecuteRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateHasAccess(msg.Administrator, types.Access_ForceTransfer); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	if err = k.Keeper.ExecuteRecovery(ctx, marker, msg.RecoveryId, sdk.MustAccAddressFromBech32(msg.Administrator)); err != nil {
+		if types.ErrRecoveryNotFound.Is(err) {
+			return nil, err
+		}
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgExecuteRecoveryResponse{}, nil
+}
+
+// SetHolderLimit sets a marker's maximum holder count and minimum holding.
+func (k msgServer) SetHolderLimit(goCtx context.Context, msg *types.MsgSetHolderLimitRequest) (*types.MsgSetHolderLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Limit.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetMarkerHolderLimit(ctx, marker, msg.Limit, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetHolderLimitResponse{}, nil
+}
+
+// RemoveHolderLimit removes a marker's holder limit.
+func (k msgServer) RemoveHolderLimit(goCtx context.Context, msg *types.MsgRemoveHolderLimitRequest) (*types.MsgRemoveHolderLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.RemoveMarkerHolderLimit(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveHolderLimitResponse{}, nil
+}
+
+// SetIbcChannelPolicy sets the IBC channels that a restricted marker's denom can be transferred over.
+func (k msgServer) SetIbcChannelPolicy(goCtx context.Context, msg *types.MsgSetIbcChannelPolicyRequest) (*types.MsgSetIbcChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Policy.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetMarkerIbcChannelPolicy(ctx, marker, msg.Policy, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetIbcChannelPolicyResponse{}, nil
+}
+
+// RemoveIbcChannelPolicy removes a marker's IBC channel policy.
+func (k msgServer) RemoveIbcChannelPolicy(goCtx context.Context, msg *types.MsgRemoveIbcChannelPolicyRequest) (*types.MsgRemoveIbcChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.validateAdminAuthority(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err = k.RemoveMarkerIbcChannelPolicy(ctx, marker, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveIbcChannelPolicyResponse{}, nil
+}
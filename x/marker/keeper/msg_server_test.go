@@ -1839,6 +1839,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					types.DefaultRecoveryChallengePeriod,
 				),
 			},
 		},
@@ -1850,6 +1851,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					types.DefaultRecoveryChallengePeriod,
 				),
 			},
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalidAuthority": expected gov account as only signer for proposal message`,
@@ -43,9 +43,10 @@ func (s *ParamTestSuite) TestGetSetParams() {
 	newMaxSupply := "3000000"
 
 	newParams := types.Params{
-		EnableGovernance:       newEnableGovernance,
-		UnrestrictedDenomRegex: newUnrestrictedDenomRegex,
-		MaxSupply:              types.StringToBigInt(newMaxSupply),
+		EnableGovernance:        newEnableGovernance,
+		UnrestrictedDenomRegex:  newUnrestrictedDenomRegex,
+		MaxSupply:               types.StringToBigInt(newMaxSupply),
+		RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod,
 	}
 
 	s.app.MarkerKeeper.SetParams(s.ctx, newParams)
@@ -54,6 +54,9 @@ func (k Keeper) HandleSupplyIncreaseProposal(ctx sdk.Context, amount sdk.Coin, t
 		if err != nil {
 			return err
 		}
+		if err := k.validateHolderLimits(ctx, recipient, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
 		if err := k.bankKeeper.SendCoins(types.WithBypass(ctx), addr, recipient, sdk.NewCoins(amount)); err != nil {
 			return err
 		}
@@ -107,8 +110,16 @@ func (k Keeper) HandleSetAdministratorProposal(ctx sdk.Context, denom string, ac
 	if !m.HasGovernanceEnabled() {
 		return fmt.Errorf("%s marker does not allow governance control", denom)
 	}
+	// Expired grants are removed first so that their permissions aren't merged into the new ones.
+	if err = k.RemoveExpiredAccessGrants(ctx, m); err != nil {
+		return err
+	}
 	for _, a := range accessGrants {
-		if err := m.GrantAccess(types.NewAccessGrant(a.GetAddress(), a.Permissions)); err != nil {
+		grant := a
+		if err := validateGrantExpiration(ctx, &grant); err != nil {
+			return err
+		}
+		if err := m.GrantAccess(&grant); err != nil {
 			return err
 		}
 		logger := k.Logger(ctx)
@@ -147,6 +158,7 @@ func (k Keeper) HandleRemoveAdministratorProposal(ctx sdk.Context, denom string,
 		if err = m.RevokeAccess(addr); err != nil {
 			return err
 		}
+		k.clearSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageAddressPrefix(m.GetAddress(), addr))
 	}
 
 	if err := m.Validate(); err != nil {
@@ -238,6 +250,9 @@ func (k Keeper) HandleWithdrawEscrowProposal(ctx sdk.Context, denom, targetAddre
 	if err != nil {
 		return err
 	}
+	if err := k.validateHolderLimits(ctx, recipient, amount); err != nil {
+		return err
+	}
 	if err := k.bankKeeper.SendCoins(types.WithBypass(ctx), addr, recipient, amount); err != nil {
 		return err
 	}
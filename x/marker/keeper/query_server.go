@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -101,6 +102,24 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	}, nil
 }
 
+// CapTable query for a snapshot of all holders of a marker's denom, including held and quarantined funds.
+func (k Keeper) CapTable(c context.Context, req *types.QueryCapTableRequest) (*types.QueryCapTableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	capTable, err := k.GetCapTable(ctx, marker.GetDenom())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryCapTableResponse{CapTable: *capTable}, nil
+}
+
 // Supply query for supply of coin on a marker account
 func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
 	if req == nil {
@@ -139,7 +158,7 @@ func (k Keeper) Access(c context.Context, req *types.QueryAccessRequest) (*types
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryAccessResponse{Accounts: marker.GetAccessList()}, nil
+	return &types.QueryAccessResponse{Accounts: marker.WithoutExpiredAccess(ctx.BlockTime()).GetAccessList()}, nil
 }
 
 // DenomMetadata query for metadata on denom
@@ -175,8 +194,16 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "could not get %q account data: %v", req.Denom, err)
 	}
+	schema, err := k.GetAccountDataSchema(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryAccountDataResponse{Value: value}, nil
+	return &types.QueryAccountDataResponse{
+		Value:    value,
+		Document: types.ParseAccountDataDocument(value),
+		Schema:   schema,
+	}, nil
 }
 
 // NetAssetValues query for returning net asset values for a marker
@@ -203,6 +230,323 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// Distribution returns the status of a marker distribution.
+func (k Keeper) Distribution(c context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dist, err := k.GetDistribution(ctx, req.DistributionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionResponse{Distribution: *dist}, nil
+}
+
+// Distributions returns the distributions for a marker.
+func (k Keeper) Distributions(c context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var dists []types.Distribution
+	distStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix)
+	pageRes, err := query.FilteredPaginate(distStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var dist types.Distribution
+		if err := k.cdc.Unmarshal(value, &dist); err != nil {
+			return false, err
+		}
+		if dist.Denom != marker.GetDenom() {
+			return false, nil
+		}
+		if accumulate {
+			dists = append(dists, dist)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: dists, Pagination: pageRes}, nil
+}
+
+// SupplyAllowanceUsages returns the amounts an address has used of its mint and burn allowances on a marker.
+func (k Keeper) SupplyAllowanceUsages(c context.Context, req *types.QuerySupplyAllowanceUsagesRequest) (*types.QuerySupplyAllowanceUsagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	return &types.QuerySupplyAllowanceUsagesResponse{
+		Grant:  types.GrantsForAddress(addr, marker.GetAccessList()...),
+		Usages: k.GetSupplyAllowanceUsages(ctx, marker.GetAddress(), addr),
+	}, nil
+}
+
+// Lockup returns an account's lockup of a marker's denom along with the amount currently locked.
+func (k Keeper) Lockup(c context.Context, req *types.QueryLockupRequest) (*types.QueryLockupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	lockup, err := k.GetLockup(ctx, marker.GetAddress(), addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if lockup == nil {
+		return nil, types.ErrLockupNotFound.Wrapf("%s lockup for %s", marker.GetDenom(), addr)
+	}
+
+	return &types.QueryLockupResponse{
+		Lockup: *lockup,
+		Locked: sdk.NewCoin(lockup.Denom, lockup.LockedAt(ctx.BlockTime())),
+	}, nil
+}
+
+// Lockups returns all lockups of a marker's denom.
+func (k Keeper) Lockups(c context.Context, req *types.QueryLockupsRequest) (*types.QueryLockupsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockups []types.Lockup
+	lockupStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LockupMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(lockupStore, req.Pagination, func(_ []byte, value []byte) error {
+		var lockup types.Lockup
+		if err := k.cdc.Unmarshal(value, &lockup); err != nil {
+			return err
+		}
+		lockups = append(lockups, lockup)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLockupsResponse{Lockups: lockups, Pagination: pageRes}, nil
+}
+
+// RedemptionConfig returns how holders can redeem a marker's denom.
+func (k Keeper) RedemptionConfig(c context.Context, req *types.QueryRedemptionConfigRequest) (*types.QueryRedemptionConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := k.GetRedemptionConfig(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if config == nil {
+		return nil, status.Errorf(codes.NotFound, "marker %s does not have a redemption config", marker.GetDenom())
+	}
+
+	return &types.QueryRedemptionConfigResponse{Config: *config}, nil
+}
+
+// PendingRedemptions returns the redemptions of a marker's denom that are awaiting approval.
+func (k Keeper) PendingRedemptions(c context.Context, req *types.QueryPendingRedemptionsRequest) (*types.QueryPendingRedemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var redemptions []types.PendingRedemption
+	redemptionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRedemptionMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(redemptionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var redemption types.PendingRedemption
+		if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+			return err
+		}
+		redemptions = append(redemptions, redemption)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRedemptionsResponse{Redemptions: redemptions, Pagination: pageRes}, nil
+}
+
+// TransferLimit returns the transfer limit that applies to an account along with how much of it is used and left.
+func (k Keeper) TransferLimit(c context.Context, req *types.QueryTransferLimitRequest) (*types.QueryTransferLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	limit, err := k.GetEffectiveTransferLimit(ctx, marker.GetAddress(), addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if limit == nil {
+		return nil, types.ErrTransferLimitNotFound.Wrapf("%s transfer limit for %s", marker.GetDenom(), addr)
+	}
+
+	resp, err := k.transferLimitStatus(ctx, marker.GetAddress(), addr, *limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// TransferLimits returns a marker's default transfer limit and the limits of specific accounts.
+func (k Keeper) TransferLimits(c context.Context, req *types.QueryTransferLimitsRequest) (*types.QueryTransferLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultLimit, err := k.GetDefaultTransferLimit(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var limits []types.TransferLimit
+	limitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferLimitMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(limitStore, req.Pagination, func(_ []byte, value []byte) error {
+		var limit types.TransferLimit
+		if err := k.cdc.Unmarshal(value, &limit); err != nil {
+			return err
+		}
+		limits = append(limits, limit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferLimitsResponse{DefaultLimit: defaultLimit, Limits: limits, Pagination: pageRes}, nil
+}
+
+// FilteredMarkers returns the markers that match all of the provided filters, using the secondary marker indexes.
+func (k Keeper) FilteredMarkers(c context.Context, req *types.QueryFilteredMarkersRequest) (*types.QueryFilteredMarkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var grantee sdk.AccAddress
+	if len(req.GrantAddress) > 0 {
+		var err error
+		grantee, err = sdk.AccAddressFromBech32(req.GrantAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid grant address: %v", err)
+		}
+	} else if req.Access != types.Access_Unknown {
+		return nil, status.Error(codes.InvalidArgument, "an access filter requires a grant address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	markers := make([]*codectypes.Any, 0)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), markerFilterIndexPrefix(req, grantee))
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		if marker == nil || !markerMatchesFilter(marker.WithoutExpiredAccess(ctx.BlockTime()), req, grantee) {
+			return false, nil
+		}
+		if accumulate {
+			anyMsg, anyErr := codectypes.NewAnyWithValue(marker)
+			if anyErr != nil {
+				return false, status.Error(codes.Internal, anyErr.Error())
+			}
+			markers = append(markers, anyMsg)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFilteredMarkersResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// SendDenyList returns the entries of a marker's deny send list.
+func (k Keeper) SendDenyList(c context.Context, req *types.QuerySendDenyListRequest) (*types.QuerySendDenyListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	markerAddr := marker.GetAddress()
+	entries := make([]types.DenySendAddress, 0)
+	denyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenySendMarkerPrefix(markerAddr))
+	pageRes, err := query.Paginate(denyStore, req.Pagination, func(key []byte, value []byte) error {
+		entry, err := k.readSendDenyEntry(markerAddr, sdk.AccAddress(key[1:1+key[0]]), value)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySendDenyListResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
 func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (types.MarkerAccountI, error) {
 	var addrErr, err error
@@ -220,3 +564,117 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	}
 	return account, nil
 }
+
+// Recovery returns a proposed recovery of a marker's denom.
+func (k Keeper) Recovery(c context.Context, req *types.QueryRecoveryRequest) (*types.QueryRecoveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := k.GetRecovery(ctx, marker.GetAddress(), req.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecoveryResponse{
+		Recovery:   *recovery,
+		Executable: recovery.CanExecute(ctx.BlockTime()),
+	}, nil
+}
+
+// Recoveries returns the proposed recoveries of a marker's denom, optionally only those of funds from an account.
+func (k Keeper) Recoveries(c context.Context, req *types.QueryRecoveriesRequest) (*types.QueryRecoveriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Address) > 0 {
+		if _, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+		}
+	}
+
+	var recoveries []types.Recovery
+	recoveryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecoveryMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.FilteredPaginate(recoveryStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var recovery types.Recovery
+		if err := k.cdc.Unmarshal(value, &recovery); err != nil {
+			return false, err
+		}
+		if len(req.Address) > 0 && recovery.FromAddress != req.Address {
+			return false, nil
+		}
+		if accumulate {
+			recoveries = append(recoveries, recovery)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecoveriesResponse{Recoveries: recoveries, Pagination: pageRes}, nil
+}
+
+// HolderLimit query for a marker's holder limit and the number of accounts holding its denom.
+func (k Keeper) HolderLimit(c context.Context, req *types.QueryHolderLimitRequest) (*types.QueryHolderLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := k.GetHolderLimit(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if limit == nil {
+		return nil, types.ErrHolderLimitNotFound.Wrap(marker.GetDenom())
+	}
+	return &types.QueryHolderLimitResponse{
+		Limit:       *limit,
+		HolderCount: k.GetHolderCount(ctx, marker.GetAddress()),
+	}, nil
+}
+
+// IbcChannelPolicy returns a marker's IBC channel policy and how much of its denom is escrowed for each capped channel.
+func (k Keeper) IbcChannelPolicy(c context.Context, req *types.QueryIbcChannelPolicyRequest) (*types.QueryIbcChannelPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := k.GetIbcChannelPolicy(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if policy == nil {
+		return nil, types.ErrIbcChannelPolicyNotFound.Wrap(marker.GetDenom())
+	}
+	resp := &types.QueryIbcChannelPolicyResponse{Policy: *policy}
+	for _, channelCap := range policy.Caps {
+		resp.Escrows = append(resp.Escrows, types.IbcChannelEscrow{
+			ChannelId: channelCap.ChannelId,
+			Amount:    k.GetIbcChannelEscrow(ctx, ibctypes.PortID, channelCap.ChannelId, policy.Denom).Amount,
+		})
+	}
+	return resp, nil
+}
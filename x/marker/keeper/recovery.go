@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ProposeRecovery records a forced transfer of a marker's denom out of an account that can only be executed once the
+// recovery challenge period has ended. Until then, the owner of the funds can cancel it.
+// The recovery's id is returned.
+func (k Keeper) ProposeRecovery(ctx sdk.Context, marker types.MarkerAccountI, from, to sdk.AccAddress, amount sdkmath.Int, reason string, admin sdk.AccAddress) (uint64, error) {
+	if err := k.validateRecoveryAllowed(ctx, marker, from, admin); err != nil {
+		return 0, err
+	}
+	if from.Equals(to) {
+		return 0, fmt.Errorf("recovery from and to addresses cannot be the same: %s", from)
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return 0, fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+	if !amount.IsPositive() {
+		return 0, fmt.Errorf("recovery amount must be positive")
+	}
+
+	recovery := types.Recovery{
+		Id:           k.nextRecoveryID(ctx),
+		Denom:        marker.GetDenom(),
+		FromAddress:  from.String(),
+		ToAddress:    to.String(),
+		Amount:       amount,
+		Reason:       reason,
+		Proposer:     admin.String(),
+		ProposedAt:   ctx.BlockTime(),
+		ChallengeEnd: ctx.BlockTime().Add(k.GetParams(ctx).RecoveryChallengePeriod),
+	}
+	if err := k.SetRecovery(ctx, recovery); err != nil {
+		return 0, err
+	}
+	return recovery.Id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRecoveryProposed(recovery))
+}
+
+// CancelRecovery removes a proposed recovery. Only the owner of the funds or the recovery's proposer can cancel it.
+func (k Keeper) CancelRecovery(ctx sdk.Context, marker types.MarkerAccountI, id uint64, signer sdk.AccAddress) error {
+	recovery, err := k.GetRecovery(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return err
+	}
+	if signer.String() != recovery.FromAddress && signer.String() != recovery.Proposer {
+		return fmt.Errorf("%s cannot cancel recovery %d: only %s or %s can", signer, id, recovery.FromAddress, recovery.Proposer)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.RecoveryKey(marker.GetAddress(), id))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRecoveryCancelled(*recovery, signer.String()))
+}
+
+// ExecuteRecovery force transfers the funds of a proposed recovery whose challenge period has ended.
+func (k Keeper) ExecuteRecovery(ctx sdk.Context, marker types.MarkerAccountI, id uint64, admin sdk.AccAddress) error {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "execute_recovery")
+
+	recovery, err := k.GetRecovery(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return err
+	}
+	if !recovery.CanExecute(ctx.BlockTime()) {
+		return fmt.Errorf("recovery %d cannot be executed until its challenge period ends at %s",
+			id, recovery.ChallengeEnd.UTC().Format(time.RFC3339))
+	}
+	from := recovery.GetFromAddress()
+	if err = k.validateRecoveryAllowed(ctx, marker, from, admin); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.RecoveryKey(marker.GetAddress(), id))
+	if err = k.TransferCoin(ctx, from, recovery.GetToAddress(), admin, sdk.NewCoin(recovery.Denom, recovery.Amount)); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRecoveryExecuted(*recovery, admin.String()))
+}
+
+// validateRecoveryAllowed returns an error if the admin cannot force transfer the marker's denom out of the from account.
+func (k Keeper) validateRecoveryAllowed(ctx sdk.Context, marker types.MarkerAccountI, from, admin sdk.AccAddress) error {
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker status (%s) is not active, funds cannot be moved", marker.GetStatus())
+	}
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, recovery not supported")
+	}
+	if !marker.AllowsForcedTransfer() {
+		return fmt.Errorf("marker %s does not allow forced transfers", marker.GetDenom())
+	}
+	if err := marker.WithoutExpiredAccess(ctx.BlockTime()).ValidateAddressHasAccess(admin, types.Access_ForceTransfer); err != nil {
+		return err
+	}
+	if admin.Equals(from) {
+		return fmt.Errorf("cannot recover funds from the administrator's own account")
+	}
+	if marker.GetAddress().Equals(from) {
+		return fmt.Errorf("cannot recover funds from the marker account")
+	}
+	if !k.canForceTransferFrom(ctx, from) {
+		return fmt.Errorf("funds are not allowed to be removed from %s", from)
+	}
+	return nil
+}
+
+// GetRecovery returns a proposed recovery of a marker's denom.
+func (k Keeper) GetRecovery(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) (*types.Recovery, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RecoveryKey(markerAddr, id))
+	if len(bz) == 0 {
+		return nil, types.ErrRecoveryNotFound.Wrapf("id %d", id)
+	}
+	var recovery types.Recovery
+	if err := k.cdc.Unmarshal(bz, &recovery); err != nil {
+		return nil, fmt.Errorf("could not read recovery %d: %w", id, err)
+	}
+	return &recovery, nil
+}
+
+// SetRecovery stores a proposed recovery.
+func (k Keeper) SetRecovery(ctx sdk.Context, recovery types.Recovery) error {
+	markerAddr, err := types.MarkerAddress(recovery.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&recovery)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.RecoveryKey(markerAddr, recovery.Id), bz)
+	return nil
+}
+
+// IterateRecoveries iterates all proposed recoveries with the given handler function.
+func (k Keeper) IterateRecoveries(ctx sdk.Context, handler func(recovery types.Recovery) (stop bool)) error {
+	return k.iterateRecoveries(ctx, types.RecoveryKeyPrefix, handler)
+}
+
+// IterateMarkerRecoveries iterates the proposed recoveries of a marker's denom with the given handler function.
+func (k Keeper) IterateMarkerRecoveries(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(recovery types.Recovery) (stop bool)) error {
+	return k.iterateRecoveries(ctx, types.RecoveryMarkerPrefix(markerAddr), handler)
+}
+
+// iterateRecoveries iterates the proposed recoveries under a key prefix with the given handler function.
+func (k Keeper) iterateRecoveries(ctx sdk.Context, pre []byte, handler func(recovery types.Recovery) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var recovery types.Recovery
+		if err := k.cdc.Unmarshal(it.Value(), &recovery); err != nil {
+			return err
+		}
+		if handler(recovery) {
+			break
+		}
+	}
+	return nil
+}
+
+// splitRecoveries multiplies the amounts of a marker's proposed recoveries by numerator/denominator, rounding down.
+// Recoveries whose amount rounds down to zero are cancelled.
+func (k Keeper) splitRecoveries(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64, administrator string) error {
+	var recoveries []types.Recovery
+	err := k.IterateMarkerRecoveries(ctx, markerAddr, func(recovery types.Recovery) bool {
+		recoveries = append(recoveries, recovery)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recovery := range recoveries {
+		split := recovery.Split(numerator, denominator)
+		if !split.Amount.IsPositive() {
+			ctx.KVStore(k.storeKey).Delete(types.RecoveryKey(markerAddr, recovery.Id))
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRecoveryCancelled(recovery, administrator)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = k.SetRecovery(ctx, split); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextRecoveryID increments and returns the recovery sequence.
+func (k Keeper) nextRecoveryID(ctx sdk.Context) uint64 {
+	id := k.GetLastRecoveryID(ctx) + 1
+	k.SetLastRecoveryID(ctx, id)
+	return id
+}
+
+// GetLastRecoveryID returns the last recovery id that was assigned.
+func (k Keeper) GetLastRecoveryID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.RecoverySequenceKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastRecoveryID records the last recovery id that was assigned.
+func (k Keeper) SetLastRecoveryID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.RecoverySequenceKey, binary.BigEndian.AppendUint64(nil, id))
+}
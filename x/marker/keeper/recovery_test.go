@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestRecoveries(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	lost := sdk.AccAddress("lostkeys____________")
+	recovered := sdk.AccAddress("newkeys_____________")
+	other := sdk.AccAddress("other_______________")
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, lost)
+	require.NoError(t, acc.SetSequence(1), "SetSequence")
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	denom := "fundshare"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 0),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Transfer, types.Access_ForceTransfer})},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		true,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, lost, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount lost")
+
+	balance := func(addr sdk.AccAddress) string {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).String()
+	}
+	amount := sdk.NewInt64Coin(denom, 400)
+
+	_, err := msgServer.ProposeRecovery(ctx, types.NewMsgProposeRecoveryRequest(amount, lost, recovered, "lost keys", other))
+	require.ErrorContains(t, err, "does not have ACCESS_FORCE_TRANSFER", "ProposeRecovery without force transfer access")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.ProposeRecovery(ctx, types.NewMsgProposeRecoveryRequest(amount, lost, recovered, "lost keys", admin))
+	require.NoError(t, err, "ProposeRecovery")
+	require.Equal(t, uint64(1), resp.RecoveryId, "recovery id")
+	challengeEnd := now.Add(types.DefaultRecoveryChallengePeriod)
+	expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerRecoveryProposed(types.Recovery{
+		Id: 1, Denom: denom, FromAddress: lost.String(), ToAddress: recovered.String(), Amount: amount.Amount,
+		Reason: "lost keys", Proposer: admin.String(), ProposedAt: now, ChallengeEnd: challengeEnd,
+	}))
+	require.NoError(t, err, "TypedEventToEvent")
+	require.Contains(t, ctx.EventManager().Events(), expEvent, "proposed event")
+	require.Equal(t, "1000"+denom, balance(lost), "lost balance after proposal")
+
+	recResp, err := app.MarkerKeeper.Recovery(ctx, &types.QueryRecoveryRequest{Id: denom, RecoveryId: 1})
+	require.NoError(t, err, "Recovery query")
+	require.False(t, recResp.Executable, "executable before the challenge period ends")
+	require.Equal(t, challengeEnd, recResp.Recovery.ChallengeEnd, "challenge end")
+
+	recsResp, err := app.MarkerKeeper.Recoveries(ctx, &types.QueryRecoveriesRequest{Id: denom, Address: lost.String()})
+	require.NoError(t, err, "Recoveries query for lost")
+	require.Len(t, recsResp.Recoveries, 1, "recoveries of lost")
+	recsResp, err = app.MarkerKeeper.Recoveries(ctx, &types.QueryRecoveriesRequest{Id: denom, Address: other.String()})
+	require.NoError(t, err, "Recoveries query for other")
+	require.Empty(t, recsResp.Recoveries, "recoveries of other")
+
+	_, err = msgServer.ExecuteRecovery(ctx.WithBlockTime(challengeEnd.Add(-time.Second)), types.NewMsgExecuteRecoveryRequest(denom, 1, admin.String()))
+	require.ErrorContains(t, err, "recovery 1 cannot be executed until its challenge period ends at 2025-01-08T00:00:00Z", "ExecuteRecovery early")
+
+	_, err = msgServer.CancelRecovery(ctx, types.NewMsgCancelRecoveryRequest(denom, 1, other.String()))
+	require.ErrorContains(t, err, "cannot cancel recovery 1", "CancelRecovery by other")
+	_, err = msgServer.CancelRecovery(ctx, types.NewMsgCancelRecoveryRequest(denom, 1, lost.String()))
+	require.NoError(t, err, "CancelRecovery by owner")
+	_, err = app.MarkerKeeper.Recovery(ctx, &types.QueryRecoveryRequest{Id: denom, RecoveryId: 1})
+	require.ErrorIs(t, err, types.ErrRecoveryNotFound, "Recovery query after cancel")
+	_, err = msgServer.ExecuteRecovery(ctx.WithBlockTime(challengeEnd), types.NewMsgExecuteRecoveryRequest(denom, 1, admin.String()))
+	require.ErrorIs(t, err, types.ErrRecoveryNotFound, "ExecuteRecovery after cancel")
+
+	resp, err = msgServer.ProposeRecovery(ctx, types.NewMsgProposeRecoveryRequest(amount, lost, recovered, "lost keys", admin))
+	require.NoError(t, err, "ProposeRecovery again")
+	require.Equal(t, uint64(2), resp.RecoveryId, "second recovery id")
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.Recoveries, 1, "exported recoveries")
+	require.Equal(t, uint64(2), genesis.LastRecoveryId, "exported last recovery id")
+
+	execCtx := ctx.WithBlockTime(challengeEnd).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ExecuteRecovery(execCtx, types.NewMsgExecuteRecoveryRequest(denom, 2, other.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_FORCE_TRANSFER", "ExecuteRecovery without force transfer access")
+	_, err = msgServer.ExecuteRecovery(execCtx, types.NewMsgExecuteRecoveryRequest(denom, 2, admin.String()))
+	require.NoError(t, err, "ExecuteRecovery")
+	require.Equal(t, "600"+denom, balance(lost), "lost balance after recovery")
+	require.Equal(t, "400"+denom, balance(recovered), "recovered balance after recovery")
+	expEvent, err = sdk.TypedEventToEvent(&types.EventMarkerRecoveryExecuted{
+		RecoveryId: "2", Denom: denom, FromAddress: lost.String(), ToAddress: recovered.String(),
+		Amount: amount.String(), Administrator: admin.String(),
+	})
+	require.NoError(t, err, "TypedEventToEvent")
+	require.Contains(t, execCtx.EventManager().Events(), expEvent, "executed event")
+	_, err = app.MarkerKeeper.GetRecovery(ctx, markerAddr, 2)
+	require.ErrorIs(t, err, types.ErrRecoveryNotFound, "GetRecovery after execute")
+
+	_, err = msgServer.ProposeRecovery(ctx, types.NewMsgProposeRecoveryRequest(amount, other, recovered, "", admin))
+	require.NoError(t, err, "ProposeRecovery from an account without funds")
+	_, err = msgServer.ProposeRecovery(ctx, types.NewMsgProposeRecoveryRequest(amount, markerAddr, recovered, "", admin))
+	require.ErrorContains(t, err, "cannot recover funds from the marker account", "ProposeRecovery from the marker")
+}
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// SetMarkerRedemptionConfig sets how holders can redeem a marker's denom. A nil config removes the
+// marker's redemption configuration, which stops new redemptions (but not the approval of pending ones).
+func (k Keeper) SetMarkerRedemptionConfig(ctx sdk.Context, marker types.MarkerAccountI, config *types.RedemptionConfig, administrator string) error {
+	markerAddr := marker.GetAddress()
+	if config == nil {
+		existing, err := k.GetRedemptionConfig(ctx, markerAddr)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return fmt.Errorf("marker %s does not have a redemption config", marker.GetDenom())
+		}
+		ctx.KVStore(k.storeKey).Delete(types.RedemptionConfigKey(markerAddr))
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionConfigRemoved(marker.GetDenom(), administrator))
+	}
+
+	if config.Denom != marker.GetDenom() {
+		return fmt.Errorf("redemption config denom %q does not match marker denom %q", config.Denom, marker.GetDenom())
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if err := k.SetRedemptionConfig(ctx, *config); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionConfigSet(*config, administrator))
+}
+
+// Redeem takes units of a marker's denom from the redeemer and, unless the marker's redemptions require approval,
+// burns them and pays the redeemer from the marker's escrow. Redemptions that require approval are queued with
+// the units held by the marker module until they are approved or rejected.
+// The redemption's id and the payout (empty if the redemption was queued) are returned.
+func (k Keeper) Redeem(ctx sdk.Context, marker types.MarkerAccountI, redeemer sdk.AccAddress, amount sdkmath.Int) (uint64, sdk.Coins, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "redeem")
+
+	config, err := k.GetRedemptionConfig(ctx, marker.GetAddress())
+	if err != nil {
+		return 0, nil, err
+	}
+	if config == nil {
+		return 0, nil, fmt.Errorf("marker %s is not redeemable", marker.GetDenom())
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return 0, nil, fmt.Errorf("cannot redeem a marker that is not active: status %s", marker.GetStatus())
+	}
+	if !config.IsOpen(ctx.BlockTime()) {
+		return 0, nil, fmt.Errorf("redemptions of %s are not open at %s", marker.GetDenom(), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+
+	// Redeeming does not require transfer access, but the units are sent using a bypass, so the other send
+	// restrictions are applied here: an account on the send-deny list cannot redeem, redemptions count
+	// against the redeemer's transfer limits, and funds that are locked up cannot be redeemed.
+	if k.IsSendDeny(ctx, marker.GetAddress(), redeemer) {
+		return 0, nil, fmt.Errorf("%s is on deny list for sending %s", redeemer, marker.GetDenom())
+	}
+	units := sdk.NewCoins(sdk.NewCoin(marker.GetDenom(), amount))
+	if err = k.useTransferLimits(ctx, redeemer, units); err != nil {
+		return 0, nil, err
+	}
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(types.WithBypass(ctx), redeemer, types.CoinPoolName, units); err != nil {
+		return 0, nil, fmt.Errorf("could not take %s from %s: %w", units, redeemer, err)
+	}
+	if err = k.validateLockupsHeld(ctx, redeemer, units); err != nil {
+		return 0, nil, err
+	}
+
+	redemption := types.PendingRedemption{
+		Id:          k.nextRedemptionID(ctx),
+		Denom:       marker.GetDenom(),
+		Redeemer:    redeemer.String(),
+		Amount:      amount,
+		RequestTime: ctx.BlockTime(),
+	}
+	if config.RequiresApproval {
+		if err = k.SetPendingRedemption(ctx, redemption); err != nil {
+			return 0, nil, err
+		}
+		return redemption.Id, sdk.Coins{}, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionQueued(redemption))
+	}
+
+	payout, err := k.completeRedemption(ctx, marker, *config, redemption, "")
+	if err != nil {
+		return 0, nil, err
+	}
+	return redemption.Id, payout, nil
+}
+
+// ApproveRedemption completes a redemption that is awaiting approval. The payout is determined using the
+// marker's redemption config at the time of approval.
+func (k Keeper) ApproveRedemption(ctx sdk.Context, marker types.MarkerAccountI, id uint64, administrator string) (sdk.Coins, error) {
+	redemption, err := k.GetPendingRedemption(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return nil, err
+	}
+	config, err := k.GetRedemptionConfig(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, fmt.Errorf("marker %s is not redeemable", marker.GetDenom())
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.PendingRedemptionKey(marker.GetAddress(), id))
+	return k.completeRedemption(ctx, marker, *config, *redemption, administrator)
+}
+
+// RejectRedemption cancels a redemption that is awaiting approval and returns the units to the redeemer.
+func (k Keeper) RejectRedemption(ctx sdk.Context, marker types.MarkerAccountI, id uint64, administrator string) error {
+	redemption, err := k.GetPendingRedemption(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.PendingRedemptionKey(marker.GetAddress(), id))
+	units := sdk.NewCoins(sdk.NewCoin(redemption.Denom, redemption.Amount))
+	sendCtx := quarantine.WithBypass(types.WithBypass(ctx))
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(sendCtx, types.CoinPoolName, redemption.GetRedeemer(), units); err != nil {
+		return fmt.Errorf("could not return %s to %s: %w", units, redemption.Redeemer, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionRejected(*redemption, administrator))
+}
+
+// completeRedemption burns the redeemed units (held by the marker module) and pays the redeemer from the marker's escrow.
+func (k Keeper) completeRedemption(ctx sdk.Context, marker types.MarkerAccountI, config types.RedemptionConfig, redemption types.PendingRedemption, administrator string) (sdk.Coins, error) {
+	price, perUnits, err := k.redemptionPrice(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	payoutAmt := types.RedemptionPayout(redemption.Amount, price, perUnits)
+	if !payoutAmt.IsPositive() {
+		return nil, fmt.Errorf("redemption of %s%s would not pay any %s", redemption.Amount, redemption.Denom, config.RedeemDenom)
+	}
+	payout := sdk.NewCoins(sdk.NewCoin(config.RedeemDenom, payoutAmt))
+	if escrow := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), config.RedeemDenom); escrow.Amount.LT(payoutAmt) {
+		return nil, fmt.Errorf("marker %s escrow %s is insufficient for redemption payout of %s", marker.GetDenom(), escrow, payout)
+	}
+
+	units := sdk.NewCoins(sdk.NewCoin(redemption.Denom, redemption.Amount))
+	if err = k.bankKeeper.BurnCoins(types.WithBypass(ctx), types.CoinPoolName, units); err != nil {
+		return nil, fmt.Errorf("could not burn redeemed %s: %w", units, err)
+	}
+	if marker.HasFixedSupply() {
+		if err = marker.SetSupply(k.bankKeeper.GetSupply(ctx, marker.GetDenom())); err != nil {
+			return nil, err
+		}
+		if err = marker.Validate(); err != nil {
+			return nil, err
+		}
+		k.SetMarker(ctx, marker)
+	}
+
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), marker.GetAddress(), redemption.GetRedeemer(), payout); err != nil {
+		return nil, fmt.Errorf("could not pay %s to %s: %w", payout, redemption.Redeemer, err)
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedeemed(redemption, payout, administrator)); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+// redemptionPrice returns the amount of the config's redeem denom paid for each perUnits units of the marker's denom.
+func (k Keeper) redemptionPrice(ctx sdk.Context, config types.RedemptionConfig) (price sdkmath.Int, perUnits sdkmath.Int, err error) {
+	if !config.UseNetAssetValue {
+		return config.Amount, config.Units, nil
+	}
+	nav, err := k.GetNetAssetValue(ctx, config.Denom, config.RedeemDenom)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	if nav == nil || nav.Volume == 0 {
+		return sdkmath.Int{}, sdkmath.Int{}, fmt.Errorf("marker %s does not have a net asset value in %s", config.Denom, config.RedeemDenom)
+	}
+	return nav.Price.Amount, sdkmath.NewIntFromUint64(nav.Volume), nil
+}
+
+// GetRedemptionConfig returns a marker's redemption configuration, or nil if it does not have one.
+func (k Keeper) GetRedemptionConfig(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.RedemptionConfig, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RedemptionConfigKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var config types.RedemptionConfig
+	if err := k.cdc.Unmarshal(bz, &config); err != nil {
+		return nil, fmt.Errorf("could not read redemption config for %s: %w", markerAddr, err)
+	}
+	return &config, nil
+}
+
+// SetRedemptionConfig stores a redemption configuration.
+func (k Keeper) SetRedemptionConfig(ctx sdk.Context, config types.RedemptionConfig) error {
+	markerAddr, err := types.MarkerAddress(config.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.RedemptionConfigKey(markerAddr), bz)
+	return nil
+}
+
+// IterateRedemptionConfigs iterates all redemption configurations with the given handler function.
+func (k Keeper) IterateRedemptionConfigs(ctx sdk.Context, handler func(config types.RedemptionConfig) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RedemptionConfigKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var config types.RedemptionConfig
+		if err := k.cdc.Unmarshal(it.Value(), &config); err != nil {
+			return err
+		}
+		if handler(config) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetPendingRedemption returns a redemption of a marker's denom that is awaiting approval.
+func (k Keeper) GetPendingRedemption(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) (*types.PendingRedemption, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingRedemptionKey(markerAddr, id))
+	if len(bz) == 0 {
+		return nil, types.ErrRedemptionNotFound.Wrapf("id %d", id)
+	}
+	var redemption types.PendingRedemption
+	if err := k.cdc.Unmarshal(bz, &redemption); err != nil {
+		return nil, fmt.Errorf("could not read redemption %d: %w", id, err)
+	}
+	return &redemption, nil
+}
+
+// SetPendingRedemption stores a redemption that is awaiting approval.
+func (k Keeper) SetPendingRedemption(ctx sdk.Context, redemption types.PendingRedemption) error {
+	markerAddr, err := types.MarkerAddress(redemption.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&redemption)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.PendingRedemptionKey(markerAddr, redemption.Id), bz)
+	return nil
+}
+
+// IteratePendingRedemptions iterates all redemptions awaiting approval with the given handler function.
+func (k Keeper) IteratePendingRedemptions(ctx sdk.Context, handler func(redemption types.PendingRedemption) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingRedemptionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var redemption types.PendingRedemption
+		if err := k.cdc.Unmarshal(it.Value(), &redemption); err != nil {
+			return err
+		}
+		if handler(redemption) {
+			break
+		}
+	}
+	return nil
+}
+
+// hasPendingRedemptions returns true if any redemptions of the marker's denom are awaiting approval.
+func (k Keeper) hasPendingRedemptions(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingRedemptionMarkerPrefix(markerAddr))
+	defer it.Close()
+	return it.Valid()
+}
+
+// nextRedemptionID increments and returns the redemption sequence.
+func (k Keeper) nextRedemptionID(ctx sdk.Context) uint64 {
+	id := k.GetLastRedemptionID(ctx) + 1
+	k.SetLastRedemptionID(ctx, id)
+	return id
+}
+
+// GetLastRedemptionID returns the last redemption id that was assigned.
+func (k Keeper) GetLastRedemptionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.RedemptionSequenceKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastRedemptionID records the last redemption id that was assigned.
+func (k Keeper) SetLastRedemptionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.RedemptionSequenceKey, binary.BigEndian.AppendUint64(nil, id))
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestRedemptions(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder := sdk.AccAddress("holder______________")
+
+	denom := "fundshare"
+	payDenom := "usdcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 0),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw})},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount holder")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, markerAddr, sdk.NewCoins(sdk.NewInt64Coin(payDenom, 5000))), "FundAccount marker")
+
+	balance := func(addr sdk.AccAddress, d string) string {
+		return app.BankKeeper.GetBalance(ctx, addr, d).String()
+	}
+	// redeem uses a cache context so that, like a failed tx, a failed redemption leaves no changes behind.
+	redeem := func(ctx sdk.Context, amt int64) (*types.MsgRedeemResponse, error) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		resp, err := msgServer.Redeem(cacheCtx, types.NewMsgRedeemRequest(sdk.NewInt64Coin(denom, amt), holder))
+		if err == nil {
+			writeCache()
+		}
+		return resp, err
+	}
+
+	_, err := redeem(ctx, 100)
+	require.ErrorContains(t, err, "marker fundshare is not redeemable", "Redeem without a redemption config")
+
+	config := &types.RedemptionConfig{
+		Denom:       denom,
+		RedeemDenom: payDenom,
+		Amount:      sdkmath.NewInt(105),
+		Units:       sdkmath.NewInt(100),
+		Windows:     []types.RedemptionWindow{types.NewRedemptionWindow(now, now.Add(24*time.Hour))},
+	}
+	_, err = msgServer.SetRedemptionConfig(ctx, types.NewMsgSetRedemptionConfigRequest(denom, config, holder.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SetRedemptionConfig without admin access")
+	_, err = msgServer.SetRedemptionConfig(ctx, types.NewMsgSetRedemptionConfigRequest(denom, config, admin.String()))
+	require.NoError(t, err, "SetRedemptionConfig")
+
+	resp, err := redeem(ctx, 100)
+	require.NoError(t, err, "Redeem")
+	require.Equal(t, "105"+payDenom, resp.Payout.String(), "payout")
+	require.Equal(t, "900"+denom, balance(holder, denom), "holder units after redeem")
+	require.Equal(t, "105"+payDenom, balance(holder, payDenom), "holder payout after redeem")
+	require.Equal(t, "4895"+payDenom, balance(markerAddr, payDenom), "escrow after redeem")
+	require.Equal(t, "900"+denom, app.BankKeeper.GetSupply(ctx, denom).String(), "supply after redeem")
+	updated, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, "900"+denom, updated.GetSupply().String(), "fixed marker supply after redeem")
+
+	_, err = redeem(ctx.WithBlockTime(now.Add(24*time.Hour)), 100)
+	require.ErrorContains(t, err, "redemptions of fundshare are not open at 2025-01-02T00:00:00Z", "Redeem outside the window")
+
+	require.NoError(t, app.MarkerKeeper.SetAccountLockup(ctx, updated, holder,
+		[]types.LockupRelease{types.NewLockupRelease(now.Add(time.Hour), sdkmath.NewInt(850))}, admin.String()), "SetAccountLockup")
+	_, err = redeem(ctx, 100)
+	require.ErrorContains(t, err, "that is locked", "Redeem locked units")
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	_, err = redeem(ctx, 100)
+	require.NoError(t, err, "Redeem after lockup release")
+	require.Equal(t, "800"+denom, balance(holder, denom), "holder units after second redeem")
+
+	// Queued redemptions priced with the net asset value.
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, updated, types.NewNetAssetValue(sdk.NewInt64Coin(payDenom, 2), 1), "test"), "SetNetAssetValue")
+	config = &types.RedemptionConfig{
+		Denom:            denom,
+		RedeemDenom:      payDenom,
+		UseNetAssetValue: true,
+		RequiresApproval: true,
+	}
+	_, err = msgServer.SetRedemptionConfig(ctx, types.NewMsgSetRedemptionConfigRequest(denom, config, admin.String()))
+	require.NoError(t, err, "SetRedemptionConfig with approval")
+
+	resp, err = redeem(ctx, 50)
+	require.NoError(t, err, "Redeem requiring approval")
+	require.Empty(t, resp.Payout, "payout of a queued redemption")
+	approveID := resp.RedemptionId
+	resp, err = redeem(ctx, 10)
+	require.NoError(t, err, "second Redeem requiring approval")
+	rejectID := resp.RedemptionId
+	require.Equal(t, "740"+denom, balance(holder, denom), "holder units while redemptions are pending")
+
+	pending, err := app.MarkerKeeper.PendingRedemptions(ctx, &types.QueryPendingRedemptionsRequest{Id: denom})
+	require.NoError(t, err, "PendingRedemptions")
+	require.Len(t, pending.Redemptions, 2, "pending redemptions")
+
+	_, err = app.MarkerKeeper.SplitMarker(ctx, updated, 2, 1, admin.String())
+	require.ErrorContains(t, err, "cannot split fundshare while redemptions are awaiting approval", "SplitMarker with pending redemptions")
+
+	_, err = msgServer.ApproveRedemption(ctx, types.NewMsgApproveRedemptionRequest(denom, approveID, holder.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_WITHDRAW", "ApproveRedemption without withdraw access")
+	approved, err := msgServer.ApproveRedemption(ctx, types.NewMsgApproveRedemptionRequest(denom, approveID, admin.String()))
+	require.NoError(t, err, "ApproveRedemption")
+	require.Equal(t, "100"+payDenom, approved.Payout.String(), "approved payout")
+	require.Equal(t, "310"+payDenom, balance(holder, payDenom), "holder payout after approval")
+
+	_, err = msgServer.RejectRedemption(ctx, types.NewMsgRejectRedemptionRequest(denom, rejectID, admin.String()))
+	require.NoError(t, err, "RejectRedemption")
+	require.Equal(t, "750"+denom, balance(holder, denom), "holder units after rejection")
+	require.Equal(t, "750"+denom, app.BankKeeper.GetSupply(ctx, denom).String(), "supply after approval and rejection")
+
+	_, err = msgServer.RejectRedemption(ctx, types.NewMsgRejectRedemptionRequest(denom, rejectID, admin.String()))
+	require.ErrorIs(t, err, types.ErrRedemptionNotFound, "RejectRedemption of a resolved redemption")
+
+	_, err = msgServer.SetRedemptionConfig(ctx, types.NewMsgSetRedemptionConfigRequest(denom, nil, admin.String()))
+	require.NoError(t, err, "SetRedemptionConfig removal")
+	_, err = app.MarkerKeeper.RedemptionConfig(ctx, &types.QueryRedemptionConfigRequest{Id: denom})
+	require.ErrorContains(t, err, "marker fundshare does not have a redemption config", "RedemptionConfig after removal")
+}
+
+func TestRedemptionSendRestrictions(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder := sdk.AccAddress("holder______________")
+
+	denom := "restrictedshare"
+	payDenom := "usdcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 0),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount holder")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, markerAddr, sdk.NewCoins(sdk.NewInt64Coin(payDenom, 5000))), "FundAccount marker")
+
+	config := &types.RedemptionConfig{
+		Denom:       denom,
+		RedeemDenom: payDenom,
+		Amount:      sdkmath.NewInt(1),
+		Units:       sdkmath.NewInt(1),
+	}
+	_, err := msgServer.SetRedemptionConfig(ctx, types.NewMsgSetRedemptionConfigRequest(denom, config, admin.String()))
+	require.NoError(t, err, "SetRedemptionConfig")
+
+	redeem := func(ctx sdk.Context, amt int64) error {
+		_, err := msgServer.Redeem(ctx, types.NewMsgRedeemRequest(sdk.NewInt64Coin(denom, amt), holder))
+		return err
+	}
+
+	// The units are sent using a bypass, but the send-deny list and transfer limits still apply.
+	denyCtx, _ := ctx.CacheContext()
+	app.MarkerKeeper.AddSendDeny(denyCtx, markerAddr, holder)
+	require.ErrorContains(t, redeem(denyCtx, 100), holder.String()+" is on deny list for sending "+denom, "Redeem while on the deny list")
+
+	_, err = msgServer.SetTransferLimit(ctx, types.NewMsgSetTransferLimitRequest(denom, holder, sdkmath.NewInt(150), sdkmath.ZeroInt(), admin.String()))
+	require.NoError(t, err, "SetTransferLimit")
+	require.NoError(t, redeem(ctx, 100), "Redeem within the transfer limit")
+	require.ErrorContains(t, redeem(ctx, 100), "cannot send 100"+denom+" from "+holder.String()+": it exceeds the remaining daily transfer limit of 50"+denom,
+		"Redeem over the transfer limit")
+	require.Equal(t, "900"+denom, app.BankKeeper.GetBalance(ctx, holder, denom).String(), "holder units after redemptions")
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsSendDeny returns true if sender address is denied for marker
+func (k Keeper) IsSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DenySendKey(markerAddr, senderAddr))
+}
+
+// AddSendDeny set sender address to denied for marker without a reason or expiration
+func (k Keeper) AddSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
+	entry := types.NewDenySendAddress(markerAddr, senderAddr, "", "", ctx.BlockTime(), nil)
+	if err := k.SetSendDenyEntry(ctx, entry); err != nil {
+		panic(err)
+	}
+}
+
+// SetSendDenyEntry adds an entry to a marker's deny send list, replacing any existing entry for the address.
+func (k Keeper) SetSendDenyEntry(ctx sdk.Context, entry types.DenySendAddress) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	markerAddr, denyAddr := entry.GetMarkerAddress(), entry.GetDenyAddress()
+	k.removeSendDenyExpiration(ctx, markerAddr, denyAddr)
+
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenySendKey(markerAddr, denyAddr), bz)
+	if entry.Expiration != nil {
+		store.Set(types.SendDenyExpirationKey(*entry.Expiration, markerAddr, denyAddr), []byte{})
+	}
+	return nil
+}
+
+// GetSendDenyEntry returns the entry of a marker's deny send list for an address, or nil if it isn't denied.
+func (k Keeper) GetSendDenyEntry(ctx sdk.Context, markerAddr, denyAddr sdk.AccAddress) (*types.DenySendAddress, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenySendKey(markerAddr, denyAddr))
+	if bz == nil {
+		return nil, nil
+	}
+	entry, err := k.readSendDenyEntry(markerAddr, denyAddr, bz)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// readSendDenyEntry reads a stored deny send entry. Entries added before reasons were recorded have no value, so
+// those only contain the addresses.
+func (k Keeper) readSendDenyEntry(markerAddr, denyAddr sdk.AccAddress, bz []byte) (types.DenySendAddress, error) {
+	entry := types.DenySendAddress{MarkerAddress: markerAddr.String(), DenyAddress: denyAddr.String()}
+	if len(bz) > 0 {
+		if err := k.cdc.Unmarshal(bz, &entry); err != nil {
+			return entry, fmt.Errorf("could not read deny send entry of %s: %w", denyAddr, err)
+		}
+	}
+	return entry, nil
+}
+
+// RemoveSendDeny removes sender address from marker deny list
+func (k Keeper) RemoveSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
+	k.removeSendDenyExpiration(ctx, markerAddr, senderAddr)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenySendKey(markerAddr, senderAddr))
+}
+
+// removeSendDenyExpiration removes the expiration index entry of an address's deny send entry, if it has one.
+func (k Keeper) removeSendDenyExpiration(ctx sdk.Context, markerAddr, denyAddr sdk.AccAddress) {
+	entry, err := k.GetSendDenyEntry(ctx, markerAddr, denyAddr)
+	if err != nil || entry == nil || entry.Expiration == nil {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.SendDenyExpirationKey(*entry.Expiration, markerAddr, denyAddr))
+}
+
+// ClearSendDeny removes all entries of a marker from a send deny list
+func (k Keeper) ClearSendDeny(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	list := k.GetSendDenyList(ctx, markerAddr)
+	for _, sender := range list {
+		k.RemoveSendDeny(ctx, markerAddr, sender)
+	}
+}
+
+// IterateSendDeny iterates the keys of all deny send entries with the given handler function.
+func (k Keeper) IterateSendDeny(ctx sdk.Context, handler func(key []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenySendKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if handler(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// IterateSendDenyEntries iterates all deny send entries with the given handler function.
+func (k Keeper) IterateSendDenyEntries(ctx sdk.Context, handler func(entry types.DenySendAddress) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenySendKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		markerAddr, denyAddr := types.GetDenySendAddresses(it.Key())
+		entry, err := k.readSendDenyEntry(markerAddr, denyAddr, it.Value())
+		if err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetSendDenyList gets the list of sender addresses from the marker's deny list
+func (k Keeper) GetSendDenyList(ctx sdk.Context, markerAddr sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenySendMarkerPrefix(markerAddr))
+	list := []sdk.AccAddress{}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, denied := types.GetDenySendAddresses(iterator.Key())
+		list = append(list, denied)
+	}
+
+	return list
+}
+
+// RemoveExpiredSendDenies removes the deny send entries that have expired as of the block time.
+func (k Keeper) RemoveExpiredSendDenies(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(types.SendDenyExpirationPrefix(ctx.BlockTime()))
+	it := store.Iterator(types.SendDenyExpirationKeyPrefix, end)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	var errs []error
+	for _, key := range keys {
+		markerAddr, denyAddr := types.ParseSendDenyExpirationKey(key)
+		entry, err := k.GetSendDenyEntry(ctx, markerAddr, denyAddr)
+		store.Delete(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if entry == nil || !entry.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		k.RemoveSendDeny(ctx, markerAddr, denyAddr)
+
+		denom := ""
+		if marker, _ := k.GetMarker(ctx, markerAddr); marker != nil {
+			denom = marker.GetDenom()
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSendDenyExpired(denom, *entry)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSendDenyEntries(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	denied1 := sdk.AccAddress("denied1_____________")
+	denied2 := sdk.AccAddress("denied2_____________")
+	legacy := sdk.AccAddress("legacy______________")
+
+	denom := "denycoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Transfer})},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	past := now.Add(-time.Hour)
+	msg := types.NewMsgUpdateSendDenyListRequest(denom, admin, nil, []string{denied1.String()})
+	msg.Expiration = &past
+	_, err := msgServer.UpdateSendDenyList(ctx, msg)
+	require.EqualError(t, err, "deny send expiration 2025-01-01T11:00:00Z must be after the block time 2025-01-01T12:00:00Z", "UpdateSendDenyList expired")
+
+	expiration := now.Add(24 * time.Hour)
+	msg = types.NewMsgUpdateSendDenyListRequest(denom, admin, nil, []string{denied1.String()})
+	msg.Reason = "sanctions screening"
+	msg.Expiration = &expiration
+	_, err = msgServer.UpdateSendDenyList(ctx, msg)
+	require.NoError(t, err, "UpdateSendDenyList with expiration")
+	msg = types.NewMsgUpdateSendDenyListRequest(denom, admin, nil, []string{denied2.String()})
+	msg.Reason = "court order"
+	_, err = msgServer.UpdateSendDenyList(ctx, msg)
+	require.NoError(t, err, "UpdateSendDenyList without expiration")
+	// Entries stored without any metadata are still read.
+	app.MarkerKeeper.GetStore(ctx).Set(types.DenySendKey(markerAddr, legacy), []byte{})
+
+	entry, err := app.MarkerKeeper.GetSendDenyEntry(ctx, markerAddr, denied1)
+	require.NoError(t, err, "GetSendDenyEntry denied1")
+	require.NotNil(t, entry, "denied1 entry")
+	require.Equal(t, "sanctions screening", entry.Reason, "denied1 reason")
+	require.Equal(t, admin.String(), entry.AddedBy, "denied1 added by")
+	require.Equal(t, now, *entry.AddedAt, "denied1 added at")
+	require.Equal(t, expiration, *entry.Expiration, "denied1 expiration")
+
+	resp, err := app.MarkerKeeper.SendDenyList(ctx, &types.QuerySendDenyListRequest{Id: denom})
+	require.NoError(t, err, "SendDenyList")
+	require.Len(t, resp.Entries, 3, "deny list entries")
+	for _, e := range resp.Entries {
+		switch e.DenyAddress {
+		case denied2.String():
+			require.Equal(t, "court order", e.Reason, "denied2 reason")
+			require.Nil(t, e.Expiration, "denied2 expiration")
+		case legacy.String():
+			require.Empty(t, e.Reason, "legacy reason")
+			require.Nil(t, e.AddedAt, "legacy added at")
+		}
+	}
+	resp, err = app.MarkerKeeper.SendDenyList(ctx, &types.QuerySendDenyListRequest{Id: markerAddr.String(), Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err, "SendDenyList first page")
+	require.Len(t, resp.Entries, 2, "first page entries")
+	require.NotEmpty(t, resp.Pagination.NextKey, "first page next key")
+
+	// An account on the deny list cannot send the denom over ibc either, even with transfer access.
+	ibcKeeper := app.MarkerKeeper.WithIbcTransferServer(NewMockIbcTransferServer(app.BankKeeper))
+	ibcTransfer := func(sender sdk.AccAddress) error {
+		cacheCtx, _ := ctx.CacheContext()
+		return ibcKeeper.IbcTransferCoin(cacheCtx, ibctypes.PortID, "channel-0", sdk.NewInt64Coin(denom, 10), sender, admin,
+			admin.String(), clienttypes.NewHeight(1, 1000), 0, "")
+	}
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "FundAccount admin")
+	require.NoError(t, ibcTransfer(admin), "IbcTransferCoin from an account not on the deny list")
+	_, err = msgServer.UpdateSendDenyList(ctx, types.NewMsgUpdateSendDenyListRequest(denom, admin, nil, []string{admin.String()}))
+	require.NoError(t, err, "UpdateSendDenyList add admin")
+	require.EqualError(t, ibcTransfer(admin), admin.String()+" is on deny list for sending "+denom, "IbcTransferCoin from an account on the deny list")
+	_, err = msgServer.UpdateSendDenyList(ctx, types.NewMsgUpdateSendDenyListRequest(denom, admin, []string{admin.String()}, nil))
+	require.NoError(t, err, "UpdateSendDenyList remove admin")
+
+	// Nothing has expired yet.
+	require.NoError(t, app.MarkerKeeper.RemoveExpiredSendDenies(ctx.WithBlockTime(expiration.Add(-time.Second))), "RemoveExpiredSendDenies before expiration")
+	require.True(t, app.MarkerKeeper.IsSendDeny(ctx, markerAddr, denied1), "denied1 before expiration")
+
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.RemoveExpiredSendDenies(ctx), "RemoveExpiredSendDenies at expiration")
+	require.False(t, app.MarkerKeeper.IsSendDeny(ctx, markerAddr, denied1), "denied1 after expiration")
+	require.True(t, app.MarkerKeeper.IsSendDeny(ctx, markerAddr, denied2), "denied2 after expiration")
+	require.True(t, app.MarkerKeeper.IsSendDeny(ctx, markerAddr, legacy), "legacy after expiration")
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1, "events")
+	require.Equal(t, "provenance.marker.v1.EventMarkerSendDenyExpired", events[0].Type, "event type")
+
+	// Removing an entry with an expiration also removes it from the expiration index.
+	msg = types.NewMsgUpdateSendDenyListRequest(denom, admin, nil, []string{denied1.String()})
+	later := expiration.Add(time.Hour)
+	msg.Expiration = &later
+	_, err = msgServer.UpdateSendDenyList(ctx, msg)
+	require.NoError(t, err, "UpdateSendDenyList re-add denied1")
+	_, err = msgServer.UpdateSendDenyList(ctx, types.NewMsgUpdateSendDenyListRequest(denom, admin, []string{denied1.String()}, nil))
+	require.NoError(t, err, "UpdateSendDenyList remove denied1")
+	require.False(t, app.MarkerKeeper.GetStore(ctx).Has(types.SendDenyExpirationKey(later, markerAddr, denied1)), "expiration index entry after removal")
+}
@@ -33,6 +33,10 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 				}
 			}
 		}
+		// The holder limits aren't enforced here, but the holders still need to be kept track of.
+		if err := k.updateHolders(ctx, fromAddr, toAddr, amt, false); err != nil {
+			return nil, err
+		}
 		return toAddr, nil
 	}
 
@@ -53,7 +57,7 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 			}
 
 			// Need at least one admin that can make withdrawals.
-			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw); err != nil {
+			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker.WithoutExpiredAccess(ctx.BlockTime()), admins, types.Access_Withdraw); err != nil {
 				return nil, err
 			}
 		}
@@ -73,17 +77,23 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	// fromAddr (if there isn't an admin) must have deposit access on that marker.
 	toMarker, _ := k.GetMarker(ctx, toAddr)
 	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
+		active := toMarker.WithoutExpiredAccess(ctx.BlockTime())
 		if len(admins) > 0 {
-			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit); err != nil {
+			if err := types.ValidateAtLeastOneAddrHasAccess(active, admins, types.Access_Deposit); err != nil {
 				return nil, err
 			}
 		} else {
-			if err := toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit); err != nil {
+			if err := active.ValidateAddressHasAccess(fromAddr, types.Access_Deposit); err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	// Only funds that are not locked up can be sent. The amount has already been removed from fromAddr at this point.
+	if err := k.validateLockupsHeld(ctx, fromAddr, amt); err != nil {
+		return nil, err
+	}
+
 	// Check the ability to send each denom involved.
 	for _, coin := range amt {
 		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
@@ -91,6 +101,16 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		}
 	}
 
+	// Once the send is otherwise allowed, count it against any transfer limits of the sender.
+	if err := k.useTransferLimits(ctx, fromAddr, amt); err != nil {
+		return nil, err
+	}
+
+	// Lastly, make sure the send keeps both accounts within any holder limits, and keep track of the holders.
+	if err := k.updateHolders(ctx, fromAddr, toAddr, amt, true); err != nil {
+		return nil, err
+	}
+
 	return toAddr, nil
 }
 
@@ -119,7 +139,8 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	}
 
 	// If there's an admin that has transfer access, it's not a normal bank send and there's nothing more to do here.
-	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(marker, admins, types.Access_Transfer) {
+	active := marker.WithoutExpiredAccess(ctx.BlockTime())
+	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(active, admins, types.Access_Transfer) {
 		return nil
 	}
 
@@ -132,7 +153,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	}
 
 	// If the fromAddr has transfer access, there's nothing left to check.
-	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
+	if active.AddressHasAccess(fromAddr, types.Access_Transfer) {
 		return nil
 	}
 
@@ -176,7 +197,10 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	if err != nil {
 		return fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err)
 	}
-	missing := findMissingAttributes(reqAttr, attributes)
+	missing, unsatisfied, err := findMissingAttributes(reqAttr, attributes)
+	if err != nil {
+		return err
+	}
 	if len(missing) != 0 {
 		pl := ""
 		if len(missing) != 1 {
@@ -184,27 +208,48 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		}
 		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
 	}
+	if len(unsatisfied) != 0 {
+		pl := ""
+		if len(unsatisfied) != 1 {
+			pl = "s"
+		}
+		return fmt.Errorf("address %s does not satisfy the %q required attribute expression%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(unsatisfied, `", "`))
+	}
 
 	return nil
 }
 
-// findMissingAttributes returns all entries in required that don't pass
-// MatchAttribute on at least one of the provided attribute names.
-func findMissingAttributes(required []string, attributes []attrTypes.Attribute) []string {
-	var rv []string
-reqLoop:
-	for _, req := range required {
+// findMissingAttributes returns all single-name entries in required that don't pass MatchAttribute on at least
+// one of the provided attribute names, and all expression entries that are not satisfied by the provided attributes.
+func findMissingAttributes(required []string, attributes []attrTypes.Attribute) (missing []string, unsatisfied []string, err error) {
+	hasAttr := func(req string) bool {
 		for _, attr := range attributes {
 			if MatchAttribute(req, attr.Name) {
-				continue reqLoop
+				return true
 			}
 		}
-		rv = append(rv, req)
+		return false
 	}
-	return rv
+	for _, req := range required {
+		if !types.IsRequiredAttributeExpression(req) {
+			if !hasAttr(req) {
+				missing = append(missing, req)
+			}
+			continue
+		}
+		expr, err := types.ParseRequiredAttribute(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !expr.Evaluate(hasAttr) {
+			unsatisfied = append(unsatisfied, req)
+		}
+	}
+	return missing, unsatisfied, nil
 }
 
-// NormalizeRequiredAttributes normalizes the required attribute names using name module's Normalize method
+// NormalizeRequiredAttributes normalizes the required attribute names using name module's Normalize method.
+// Entries that are expressions have each of their names normalized and are returned in canonical form.
 func (k Keeper) NormalizeRequiredAttributes(ctx sdk.Context, requiredAttributes []string) ([]string, error) {
 	maxLength := int(k.attrKeeper.GetMaxValueLength(ctx))
 	result := make([]string, len(requiredAttributes))
@@ -213,21 +258,45 @@ func (k Keeper) NormalizeRequiredAttributes(ctx sdk.Context, requiredAttributes
 			return nil, fmt.Errorf("required attribute %v length is too long %v : %v ", attr, len(attr), maxLength)
 		}
 
-		// for now just check if required attribute starts with a *.
-		var prefix string
-		if strings.HasPrefix(attr, "*.") {
-			prefix = attr[:2]
-			attr = attr[2:]
+		if !types.IsRequiredAttributeExpression(attr) {
+			normalizedAttr, err := k.normalizeRequiredAttributeName(ctx, attr)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = normalizedAttr
+			continue
 		}
-		normalizedAttr, err := k.nameKeeper.Normalize(ctx, attr)
+
+		expr, err := types.ParseRequiredAttribute(attr)
 		if err != nil {
 			return nil, err
 		}
-		result[i] = fmt.Sprintf("%s%s", prefix, normalizedAttr)
+		expr, err = expr.MapNames(func(name string) (string, error) {
+			return k.normalizeRequiredAttributeName(ctx, name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		result[i] = expr.Entry()
 	}
 	return result, nil
 }
 
+// normalizeRequiredAttributeName normalizes a single required attribute name, which may start with a "*." wildcard.
+func (k Keeper) normalizeRequiredAttributeName(ctx sdk.Context, attr string) (string, error) {
+	// for now just check if required attribute starts with a *.
+	var prefix string
+	if strings.HasPrefix(attr, "*.") {
+		prefix = attr[:2]
+		attr = attr[2:]
+	}
+	normalizedAttr, err := k.nameKeeper.Normalize(ctx, attr)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s", prefix, normalizedAttr), nil
+}
+
 // MatchAttribute returns true if the provided attr satisfies the reqAttr.
 func MatchAttribute(reqAttr string, attr string) bool {
 	if len(reqAttr) < 1 {
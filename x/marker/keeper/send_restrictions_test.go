@@ -192,6 +192,12 @@ func TestSendRestrictionFn(t *testing.T) {
 	rDenom3Attrs := "restrictedmarkerreqattributes5" // cosmos15jcvmcvecjxysdemd2t9qvy5wp8ak28z8sgcnn
 	newMarker(rDenom3Attrs, restricted, []string{"kyc.provenance.io", "not-kyc.provenance.io", "foo.provenance.io"})
 
+	rDenomExprOr := "restrictedmarkerreqattrexpror"
+	newMarker(rDenomExprOr, restricted, []string{"expr:foo.provenance.io OR kyc.provenance.io"})
+
+	rDenomExprNot := "restrictedmarkerreqattrexprnot"
+	newMarker(rDenomExprNot, restricted, []string{"expr:(foo.provenance.io OR kyc.provenance.io) AND NOT not-kyc.provenance.io"})
+
 	rDenomProposed := "stillproposed" // cosmos1cjq467qkvef5gu4nczt42fl3q8pgmrnesx4647
 	rMarkerProposed := newProposedMarker(rDenomProposed, restricted, nil)
 
@@ -366,6 +372,29 @@ func TestSendRestrictionFn(t *testing.T) {
 				"\"kyc.provenance.io\", \"not-kyc.provenance.io\", \"foo.provenance.io\"",
 				addrWithoutAttrs, rDenom3Attrs),
 		},
+		{
+			name:   "account satisfies required attribute expression with OR",
+			from:   owner,
+			to:     addrWithAttrs,
+			amt:    cz(c(1, rDenomExprOr)),
+			expErr: "",
+		},
+		{
+			name: "account has no attributes, required attribute expression with OR",
+			from: owner,
+			to:   addrWithoutAttrs,
+			amt:  cz(c(1, rDenomExprOr)),
+			expErr: fmt.Sprintf("address %s does not satisfy the %q required attribute expression: "+
+				"\"expr:foo.provenance.io OR kyc.provenance.io\"", addrWithoutAttrs, rDenomExprOr),
+		},
+		{
+			name: "account has attribute excluded by required attribute expression with NOT",
+			from: owner,
+			to:   addrWithAttrs,
+			amt:  cz(c(1, rDenomExprNot)),
+			expErr: fmt.Sprintf("address %s does not satisfy the %q required attribute expression: "+
+				"\"expr:(foo.provenance.io OR kyc.provenance.io) AND NOT not-kyc.provenance.io\"", addrWithAttrsStr, rDenomExprNot),
+		},
 		{
 			name:   "account has no attributes, denom not restricted",
 			from:   addrWithTransfer,
@@ -1089,6 +1118,30 @@ func TestNormalizeRequiredAttributes(t *testing.T) {
 			expectedNormalized: []string{"*.provenance.io"},
 			expectedError:      "",
 		},
+		{
+			name:               "should succeed - expression is normalized",
+			requiredAttributes: []string{"expr:(KYC.US.provenance.io or *.EU.provenance.io) and not Frozen.provenance.io", "kyc.provenance.io"},
+			expectedNormalized: []string{"expr:(kyc.us.provenance.io OR *.eu.provenance.io) AND NOT frozen.provenance.io", "kyc.provenance.io"},
+			expectedError:      "",
+		},
+		{
+			name:               "should succeed - name starting with an operator is not an expression",
+			requiredAttributes: []string{"AND.provenance.io"},
+			expectedNormalized: []string{"and.provenance.io"},
+			expectedError:      "",
+		},
+		{
+			name:               "should fail - expression with invalid name",
+			requiredAttributes: []string{"expr:kyc.provenance.io OR *b.provenance.io"},
+			expectedNormalized: []string{},
+			expectedError:      "value provided for name is invalid",
+		},
+		{
+			name:               "should fail - invalid expression",
+			requiredAttributes: []string{"expr:(kyc.provenance.io OR"},
+			expectedNormalized: []string{},
+			expectedError:      "invalid required attribute expression \"expr:(kyc.provenance.io OR\": unexpected end of expression",
+		},
 	}
 
 	for _, tc := range testCases {
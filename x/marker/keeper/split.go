@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+	"github.com/provenance-io/provenance/x/sanction"
+)
+
+// SplitMarker multiplies every balance of the marker's denom by numerator/denominator (rounding down), and updates
+// the marker's supply, net asset values, lockups, supply allowances, redemption price, transfer limits and proposed
+// recoveries to match.
+// All balances are updated together, so the split either applies to every holder or fails without changing anything.
+// Funds held by the marker module account (e.g. undistributed payouts) are not split.
+// The marker's supply after the split is returned.
+func (k Keeper) SplitMarker(ctx sdk.Context, marker types.MarkerAccountI, numerator, denominator uint64, administrator string) (sdkmath.Int, error) {
+	return k.splitMarker(ctx, marker, numerator, denominator, administrator, types.SplitMaxHolders)
+}
+
+// splitMarker is SplitMarker, but with the most holders that can be split provided.
+func (k Keeper) splitMarker(ctx sdk.Context, marker types.MarkerAccountI, numerator, denominator uint64, administrator string, maxHolders uint64) (sdkmath.Int, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "split")
+
+	if err := types.ValidateSplitRatio(numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return sdkmath.Int{}, fmt.Errorf("cannot split a marker that is not active: status %s", marker.GetStatus())
+	}
+	if k.hasPendingRedemptions(ctx, marker.GetAddress()) {
+		return sdkmath.Int{}, fmt.Errorf("cannot split %s while redemptions are awaiting approval", marker.GetDenom())
+	}
+
+	denom := marker.GetDenom()
+	oldSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if maxSupply := k.GetMaxSupply(ctx); types.SplitAmount(oldSupply, numerator, denominator).GT(maxSupply) {
+		return sdkmath.Int{}, fmt.Errorf("split supply %s exceeds maximum allowed value %s",
+			types.SplitAmount(oldSupply, numerator, denominator), maxSupply)
+	}
+
+	type adjustment struct {
+		holder sdk.AccAddress
+		amount sdk.Coins
+	}
+	var increases, decreases []adjustment
+	totalIncrease, totalDecrease := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	var holderCount uint64
+	err := k.iterateDenomOwners(ctx, denom, func(owner sdk.AccAddress, balance sdkmath.Int) bool {
+		if owner.Equals(k.markerModuleAddr) {
+			return false
+		}
+		holderCount++
+		if holderCount > maxHolders {
+			return true
+		}
+		newBalance := types.SplitAmount(balance, numerator, denominator)
+		switch {
+		case newBalance.GT(balance):
+			delta := newBalance.Sub(balance)
+			increases = append(increases, adjustment{holder: owner, amount: sdk.NewCoins(sdk.NewCoin(denom, delta))})
+			totalIncrease = totalIncrease.Add(delta)
+		case newBalance.LT(balance):
+			delta := balance.Sub(newBalance)
+			decreases = append(decreases, adjustment{holder: owner, amount: sdk.NewCoins(sdk.NewCoin(denom, delta))})
+			totalDecrease = totalDecrease.Add(delta)
+		}
+		return false
+	})
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("could not look up holders of %s: %w", denom, err)
+	}
+	if holderCount > maxHolders {
+		return sdkmath.Int{}, fmt.Errorf("marker %s has more than %d holders, the most a split can be applied to", denom, maxHolders)
+	}
+
+	// The split is a corporate action, so it is not subject to the send restrictions that apply to holders.
+	// Funds that are locked (e.g. on hold or vesting) cannot be taken, though, which causes the split to fail.
+	sendCtx := quarantine.WithBypass(sanction.WithBypass(types.WithBypass(ctx)))
+	for _, dec := range decreases {
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(sendCtx, dec.holder, types.CoinPoolName, dec.amount); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("could not reduce balance of %s by %s: %w", dec.holder, dec.amount, err)
+		}
+	}
+	if totalDecrease.IsPositive() {
+		if err = k.bankKeeper.BurnCoins(sendCtx, types.CoinPoolName, sdk.NewCoins(sdk.NewCoin(denom, totalDecrease))); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("could not burn %s%s: %w", totalDecrease, denom, err)
+		}
+	}
+	if totalIncrease.IsPositive() {
+		if err = k.bankKeeper.MintCoins(sendCtx, types.CoinPoolName, sdk.NewCoins(sdk.NewCoin(denom, totalIncrease))); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("could not mint %s%s: %w", totalIncrease, denom, err)
+		}
+	}
+	for _, inc := range increases {
+		// SendCoins is used (instead of SendCoinsFromModuleToAccount) so that blocked addresses are split too.
+		if err = k.bankKeeper.SendCoins(sendCtx, k.markerModuleAddr, inc.holder, inc.amount); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("could not increase balance of %s by %s: %w", inc.holder, inc.amount, err)
+		}
+	}
+
+	newSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if marker.HasFixedSupply() {
+		if err = marker.SetSupply(sdk.NewCoin(denom, newSupply)); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+	if err = splitSupplyAllowances(marker, numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = marker.Validate(); err != nil {
+		return sdkmath.Int{}, err
+	}
+	k.SetMarker(ctx, marker)
+
+	if err = k.splitSupplyAllowanceUsages(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitLockups(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitNetAssetValues(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitRedemptionConfig(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitTransferLimits(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitHolderLimit(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitIbcChannelPolicy(ctx, marker.GetAddress(), numerator, denominator); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = k.splitRecoveries(ctx, marker.GetAddress(), numerator, denominator, administrator); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	event := types.NewEventMarkerSplit(denom, numerator, denominator, oldSupply, newSupply, holderCount, administrator)
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return newSupply, nil
+}
+
+// splitSupplyAllowances scales the limits of the mint and burn allowances in the marker's access grants.
+func splitSupplyAllowances(marker types.MarkerAccountI, numerator, denominator uint64) error {
+	for _, grant := range marker.GetAccessList() {
+		if grant.MintAllowance == nil && grant.BurnAllowance == nil {
+			continue
+		}
+		if grant.MintAllowance != nil {
+			grant.MintAllowance = types.NewSupplyAllowance(types.SplitAmount(grant.MintAllowance.Limit, numerator, denominator), grant.MintAllowance.Period)
+		}
+		if grant.BurnAllowance != nil {
+			grant.BurnAllowance = types.NewSupplyAllowance(types.SplitAmount(grant.BurnAllowance.Limit, numerator, denominator), grant.BurnAllowance.Period)
+		}
+		if err := marker.RevokeAccess(grant.GetAddress()); err != nil {
+			return err
+		}
+		if err := marker.GrantAccess(&grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitSupplyAllowanceUsages scales the recorded amounts used against mint and burn allowances on a marker.
+func (k Keeper) splitSupplyAllowanceUsages(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	var usages []types.SupplyAllowanceUsage
+	k.iterateSupplyAllowanceUsages(ctx, types.SupplyAllowanceUsageMarkerPrefix(markerAddr), func(usage types.SupplyAllowanceUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
+	for _, usage := range usages {
+		usage.Used = types.SplitAmount(usage.Used, numerator, denominator)
+		if err := k.SetSupplyAllowanceUsage(ctx, usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitLockups scales the release amounts of the lockups of a marker's denom.
+// Lockups that no longer have anything to release are removed.
+func (k Keeper) splitLockups(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	var lockups []types.Lockup
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.LockupMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		var lockup types.Lockup
+		if err := k.cdc.Unmarshal(it.Value(), &lockup); err != nil {
+			it.Close()
+			return err
+		}
+		lockups = append(lockups, lockup)
+	}
+	it.Close()
+
+	for _, lockup := range lockups {
+		split := lockup.Split(numerator, denominator)
+		if len(split.Releases) == 0 {
+			store.Delete(types.LockupKey(markerAddr, lockup.GetAddress()))
+			continue
+		}
+		if err := k.SetLockup(ctx, split); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitNetAssetValues rescales a marker's net asset values so they price the same value in split units.
+func (k Keeper) splitNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) bool {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, nav := range navs {
+		split, err := nav.Split(numerator, denominator)
+		if err != nil {
+			return fmt.Errorf("could not split %s net asset value: %w", nav.Price.Denom, err)
+		}
+		split.UpdatedBlockHeight = uint64(ctx.BlockHeight())
+		bz, err := k.cdc.Marshal(&split)
+		if err != nil {
+			return err
+		}
+		store.Set(types.NetAssetValueKey(markerAddr, split.Price.Denom), bz)
+	}
+	return nil
+}
+
+// splitRedemptionConfig rescales a marker's fixed redemption price so that it pays the same value in split units.
+func (k Keeper) splitRedemptionConfig(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	config, err := k.GetRedemptionConfig(ctx, markerAddr)
+	if err != nil || config == nil || config.UseNetAssetValue {
+		return err
+	}
+	return k.SetRedemptionConfig(ctx, config.Split(numerator, denominator))
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSplitMarker(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	minter := sdk.AccAddress("minter______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+
+	denom := "splitcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	minterGrant := types.NewAccessGrant(minter, types.AccessList{types.Access_Mint})
+	minterGrant.MintAllowance = types.NewSupplyAllowance(sdkmath.NewInt(100), 0)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Transfer}),
+			*minterGrant,
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 301))), "FundAccount holder1")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 99))), "FundAccount holder2")
+
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 150), 10), "test"), "SetNetAssetValue")
+	require.NoError(t, app.MarkerKeeper.SetAccountLockup(ctx, marker, holder1,
+		[]types.LockupRelease{types.NewLockupRelease(now.Add(time.Hour), sdkmath.NewInt(200))}, admin.String()), "SetAccountLockup")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 40)), "MintCoin")
+
+	balance := func(addr sdk.AccAddress) string {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).String()
+	}
+
+	_, err := msgServer.SplitMarker(ctx, types.NewMsgSplitMarkerRequest(denom, 3, 1, minter.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SplitMarker without admin access")
+	_, err = msgServer.SplitMarker(ctx, types.NewMsgSplitMarkerRequest(denom, 2, 2, admin.String()))
+	require.ErrorContains(t, err, "split ratio 2:2 would not change any balances", "SplitMarker with a 1:1 ratio")
+
+	// The marker's escrow and both holders are counted.
+	current, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.MarkerKeeper.SplitMarkerWithMaxHolders(cacheCtx, current, 3, 1, admin.String(), 2)
+	require.EqualError(t, err, "marker splitcoin has more than 2 holders, the most a split can be applied to", "SplitMarker with too many holders")
+	require.Equal(t, "301"+denom, app.BankKeeper.GetBalance(cacheCtx, holder1, denom).String(), "holder1 after split with too many holders")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.SplitMarker(ctx, types.NewMsgSplitMarkerRequest(denom, 3, 1, admin.String()))
+	require.NoError(t, err, "SplitMarker 3:1")
+	require.Equal(t, sdkmath.NewInt(4320), resp.Supply, "supply after 3:1 split")
+	require.Equal(t, "903"+denom, balance(holder1), "holder1 after 3:1 split")
+	require.Equal(t, "297"+denom, balance(holder2), "holder2 after 3:1 split")
+	require.Equal(t, "3120"+denom, balance(markerAddr), "escrow after 3:1 split")
+
+	var splitEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "provenance.marker.v1.EventMarkerSplit" {
+			splitEvents++
+		}
+	}
+	require.Equal(t, 1, splitEvents, "number of split events")
+
+	updated, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, app.BankKeeper.GetSupply(ctx, denom), updated.GetSupply(), "fixed supply should match bank supply")
+	require.Equal(t, sdkmath.NewInt(300), types.GrantsForAddress(minter, updated.GetAccessList()...).MintAllowance.Limit, "mint allowance limit")
+	usages := app.MarkerKeeper.GetSupplyAllowanceUsages(ctx, markerAddr, minter)
+	require.Len(t, usages, 1, "allowance usages")
+	require.Equal(t, sdkmath.NewInt(120), usages[0].Used, "allowance used")
+
+	nav, err := app.MarkerKeeper.GetNetAssetValue(ctx, denom, types.UsdDenom)
+	require.NoError(t, err, "GetNetAssetValue")
+	require.Equal(t, "5"+types.UsdDenom, nav.Price.String(), "nav price")
+	require.Equal(t, uint64(1), nav.Volume, "nav volume")
+
+	lockup, err := app.MarkerKeeper.GetLockup(ctx, markerAddr, holder1)
+	require.NoError(t, err, "GetLockup")
+	require.Equal(t, sdkmath.NewInt(600), lockup.Releases[0].Amount, "lockup release after 3:1 split")
+
+	// A reverse split rounds each balance down.
+	resp, err = msgServer.SplitMarker(ctx, types.NewMsgSplitMarkerRequest(denom, 1, 10, admin.String()))
+	require.NoError(t, err, "SplitMarker 1:10")
+	require.Equal(t, "90"+denom, balance(holder1), "holder1 after 1:10 reverse split")
+	require.Equal(t, "29"+denom, balance(holder2), "holder2 after 1:10 reverse split")
+	require.Equal(t, "312"+denom, balance(markerAddr), "escrow after 1:10 reverse split")
+	require.Equal(t, sdkmath.NewInt(431), resp.Supply, "supply after 1:10 reverse split")
+	updated, err = app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, sdk.NewInt64Coin(denom, 431), updated.GetSupply(), "marker supply after 1:10 reverse split")
+
+	_, err = app.MarkerKeeper.SplitMarker(ctx, updated, 1_000_000_000_000_000_000, 1, admin.String())
+	require.ErrorContains(t, err, "exceeds maximum allowed value", "SplitMarker above the max supply")
+}
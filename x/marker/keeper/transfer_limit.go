@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMarkerTransferLimit sets the marker's default transfer limit, or the limit of a specific account.
+func (k Keeper) SetMarkerTransferLimit(ctx sdk.Context, marker types.MarkerAccountI, limit types.TransferLimit, administrator string) error {
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("transfer limits can only be set on %s markers", types.MarkerType_RestrictedCoin)
+	}
+	if limit.Denom != marker.GetDenom() {
+		return fmt.Errorf("transfer limit denom %q does not match marker denom %q", limit.Denom, marker.GetDenom())
+	}
+	if err := k.SetTransferLimit(ctx, limit); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferLimitSet(limit, administrator))
+}
+
+// RemoveMarkerTransferLimit removes the marker's default transfer limit (if addr is empty), or an account's limit.
+// Accounts with their own limit are still limited by it after the default is removed.
+func (k Keeper) RemoveMarkerTransferLimit(ctx sdk.Context, marker types.MarkerAccountI, addr sdk.AccAddress, administrator string) error {
+	key := types.DefaultTransferLimitKey(marker.GetAddress())
+	if len(addr) > 0 {
+		key = types.TransferLimitKey(marker.GetAddress(), addr)
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		if len(addr) == 0 {
+			return types.ErrTransferLimitNotFound.Wrapf("%s default transfer limit", marker.GetDenom())
+		}
+		return types.ErrTransferLimitNotFound.Wrapf("%s transfer limit for %s", marker.GetDenom(), addr)
+	}
+	store.Delete(key)
+
+	address := ""
+	if len(addr) > 0 {
+		address = addr.String()
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferLimitRemoved(marker.GetDenom(), address, administrator))
+}
+
+// useTransferLimits records the sending of each coin against the sender's transfer limit of that coin's marker.
+// An error is returned if any coin would exceed what remains of the limit. Nothing is recorded for coins without
+// a limit or for funds sent by the marker account itself.
+func (k Keeper) useTransferLimits(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil || markerAddr.Equals(fromAddr) {
+			continue
+		}
+		limit, err := k.GetEffectiveTransferLimit(ctx, markerAddr, fromAddr)
+		if err != nil {
+			return err
+		}
+		if limit == nil {
+			continue
+		}
+
+		usage, err := k.GetTransferUsage(ctx, markerAddr, fromAddr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if err = limit.CheckSend(usage, ctx.BlockTime(), coin.Amount); err != nil {
+			return err
+		}
+		if err = k.SetTransferUsage(ctx, usage.Record(ctx.BlockTime(), coin.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEffectiveTransferLimit returns the limit that applies to the address: its own limit if it has one, otherwise the
+// marker's default limit. Nil is returned if neither exist.
+func (k Keeper) GetEffectiveTransferLimit(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.TransferLimit, error) {
+	limit, err := k.getTransferLimit(ctx, types.TransferLimitKey(markerAddr, addr))
+	if err != nil || limit != nil {
+		return limit, err
+	}
+	return k.getTransferLimit(ctx, types.DefaultTransferLimitKey(markerAddr))
+}
+
+// GetDefaultTransferLimit returns a marker's default transfer limit, or nil if it doesn't have one.
+func (k Keeper) GetDefaultTransferLimit(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.TransferLimit, error) {
+	return k.getTransferLimit(ctx, types.DefaultTransferLimitKey(markerAddr))
+}
+
+// getTransferLimit reads the transfer limit stored under the provided key, or returns nil if there isn't one.
+func (k Keeper) getTransferLimit(ctx sdk.Context, key []byte) (*types.TransferLimit, error) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var limit types.TransferLimit
+	if err := k.cdc.Unmarshal(bz, &limit); err != nil {
+		return nil, fmt.Errorf("could not read transfer limit: %w", err)
+	}
+	return &limit, nil
+}
+
+// SetTransferLimit stores a transfer limit.
+func (k Keeper) SetTransferLimit(ctx sdk.Context, limit types.TransferLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+	key, err := transferLimitKey(limit)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&limit)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// transferLimitKey returns the store key for a transfer limit.
+func transferLimitKey(limit types.TransferLimit) ([]byte, error) {
+	markerAddr, err := types.MarkerAddress(limit.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if limit.IsDefault() {
+		return types.DefaultTransferLimitKey(markerAddr), nil
+	}
+	return types.TransferLimitKey(markerAddr, limit.GetAddress()), nil
+}
+
+// IterateTransferLimits iterates all default and account transfer limits with the given handler function.
+func (k Keeper) IterateTransferLimits(ctx sdk.Context, handler func(limit types.TransferLimit) (stop bool)) error {
+	for _, pre := range [][]byte{types.DefaultTransferLimitKeyPrefix, types.TransferLimitKeyPrefix} {
+		stopped, err := k.iterateTransferLimits(ctx, pre, handler)
+		if err != nil || stopped {
+			return err
+		}
+	}
+	return nil
+}
+
+// iterateTransferLimits iterates the transfer limits under the given key prefix, returning true if the handler stopped.
+func (k Keeper) iterateTransferLimits(ctx sdk.Context, pre []byte, handler func(limit types.TransferLimit) (stop bool)) (bool, error) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limit types.TransferLimit
+		if err := k.cdc.Unmarshal(it.Value(), &limit); err != nil {
+			return false, err
+		}
+		if handler(limit) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetTransferUsage returns the recent amounts of a marker's denom sent by an address.
+func (k Keeper) GetTransferUsage(ctx sdk.Context, markerAddr, addr sdk.AccAddress, denom string) (types.TransferUsage, error) {
+	usage := types.NewTransferUsage(denom, addr)
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferUsageKey(markerAddr, addr))
+	if len(bz) > 0 {
+		if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+			return usage, fmt.Errorf("could not read transfer usage of %s: %w", addr, err)
+		}
+	}
+	return usage, nil
+}
+
+// SetTransferUsage stores the recent amounts of a marker's denom sent by an address.
+// If nothing has been sent recently, the entry is removed.
+func (k Keeper) SetTransferUsage(ctx sdk.Context, usage types.TransferUsage) error {
+	if err := usage.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(usage.Denom)
+	if err != nil {
+		return err
+	}
+	key := types.TransferUsageKey(markerAddr, usage.GetAddress())
+	store := ctx.KVStore(k.storeKey)
+	if len(usage.Buckets) == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateTransferUsages iterates all recorded transfer usages with the given handler function.
+func (k Keeper) IterateTransferUsages(ctx sdk.Context, handler func(usage types.TransferUsage) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferUsageKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var usage types.TransferUsage
+		if err := k.cdc.Unmarshal(it.Value(), &usage); err != nil {
+			return err
+		}
+		if handler(usage) {
+			break
+		}
+	}
+	return nil
+}
+
+// transferLimitStatus returns how much of each period of the limit has been used by the address.
+func (k Keeper) transferLimitStatus(ctx sdk.Context, markerAddr, addr sdk.AccAddress, limit types.TransferLimit) (*types.QueryTransferLimitResponse, error) {
+	usage, err := k.GetTransferUsage(ctx, markerAddr, addr, limit.Denom)
+	if err != nil {
+		return nil, err
+	}
+	dailyUsed := usage.SentWithin(ctx.BlockTime(), types.TransferLimitDay)
+	weeklyUsed := usage.SentWithin(ctx.BlockTime(), types.TransferLimitWeek)
+	rv := &types.QueryTransferLimitResponse{
+		Limit:      limit,
+		DailyUsed:  sdk.NewCoin(limit.Denom, dailyUsed),
+		WeeklyUsed: sdk.NewCoin(limit.Denom, weeklyUsed),
+	}
+	if limit.Daily.IsPositive() {
+		remaining := sdk.NewCoin(limit.Denom, types.RemainingTransferLimit(limit.Daily, dailyUsed))
+		rv.DailyRemaining = &remaining
+	}
+	if limit.Weekly.IsPositive() {
+		remaining := sdk.NewCoin(limit.Denom, types.RemainingTransferLimit(limit.Weekly, weeklyUsed))
+		rv.WeeklyRemaining = &remaining
+	}
+	return rv, nil
+}
+
+// splitTransferLimits scales a marker's transfer limits and the recent amounts sent against them.
+func (k Keeper) splitTransferLimits(ctx sdk.Context, markerAddr sdk.AccAddress, numerator, denominator uint64) error {
+	var limits []types.TransferLimit
+	for _, pre := range [][]byte{types.DefaultTransferLimitKey(markerAddr), types.TransferLimitMarkerPrefix(markerAddr)} {
+		_, err := k.iterateTransferLimits(ctx, pre, func(limit types.TransferLimit) bool {
+			limits = append(limits, limit)
+			return false
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, limit := range limits {
+		if err := k.SetTransferLimit(ctx, limit.Split(numerator, denominator)); err != nil {
+			return err
+		}
+	}
+
+	var usages []types.TransferUsage
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferUsageMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		var usage types.TransferUsage
+		if err := k.cdc.Unmarshal(it.Value(), &usage); err != nil {
+			it.Close()
+			return err
+		}
+		usages = append(usages, usage)
+	}
+	it.Close()
+	for _, usage := range usages {
+		if err := k.SetTransferUsage(ctx, usage.Split(numerator, denominator)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
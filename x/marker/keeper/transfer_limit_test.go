@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestTransferLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	now := time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+
+	denom := "limitcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		sdk.NewInt64Coin(denom, 0),
+		admin,
+		[]types.AccessGrant{
+			*types.NewAccessGrant(admin, types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Transfer, types.Access_ForceTransfer}),
+			*types.NewAccessGrant(holder1, types.AccessList{types.Access_Transfer}),
+			*types.NewAccessGrant(holder2, types.AccessList{types.Access_Transfer}),
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		true,
+		nil,
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	for _, holder := range []sdk.AccAddress{holder1, holder2} {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "FundAccount %s", holder)
+	}
+	// Forced transfers are only allowed from accounts that have signed a tx.
+	acc := app.AccountKeeper.GetAccount(ctx, holder1)
+	require.NoError(t, acc.SetSequence(1), "SetSequence")
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// send uses a cache context so that, like a failed tx, a failed send leaves no changes behind.
+	send := func(ctx sdk.Context, from, to sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := app.BankKeeper.SendCoins(cacheCtx, from, to, sdk.NewCoins(sdk.NewInt64Coin(denom, amt)))
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+	transfer := func(ctx sdk.Context, from, to, admin sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := app.MarkerKeeper.TransferCoin(cacheCtx, from, to, admin, sdk.NewInt64Coin(denom, amt))
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+	ibcKeeper := app.MarkerKeeper.WithIbcTransferServer(NewMockIbcTransferServer(app.BankKeeper))
+	ibcTransfer := func(ctx sdk.Context, from sdk.AccAddress, amt int64) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := ibcKeeper.IbcTransferCoin(cacheCtx, ibctypes.PortID, "channel-0", sdk.NewInt64Coin(denom, amt), from, from,
+			holder2.String(), clienttypes.NewHeight(1, 1000), 0, "")
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+
+	_, err := msgServer.SetTransferLimit(ctx, types.NewMsgSetTransferLimitRequest(denom, nil, sdkmath.NewInt(100), sdkmath.NewInt(250), holder1.String()))
+	require.ErrorContains(t, err, "does not have ACCESS_ADMIN", "SetTransferLimit without admin access")
+	_, err = msgServer.SetTransferLimit(ctx, types.NewMsgSetTransferLimitRequest(denom, nil, sdkmath.NewInt(100), sdkmath.NewInt(250), admin.String()))
+	require.NoError(t, err, "SetTransferLimit default")
+	_, err = msgServer.SetTransferLimit(ctx, types.NewMsgSetTransferLimitRequest(denom, holder2, sdkmath.ZeroInt(), sdkmath.NewInt(1000), admin.String()))
+	require.NoError(t, err, "SetTransferLimit holder2")
+
+	require.NoError(t, send(ctx, holder1, holder2, 60), "first send")
+	require.EqualError(t, send(ctx, holder1, holder2, 50),
+		"cannot send 50limitcoin from "+holder1.String()+": it exceeds the remaining daily transfer limit of 40limitcoin", "send over daily limit")
+	require.NoError(t, send(ctx, holder1, holder2, 40), "send up to daily limit")
+
+	// The next day, the daily limit has reset but the weekly limit still counts the first day's sends.
+	ctx = ctx.WithBlockTime(now.Add(25 * time.Hour))
+	require.NoError(t, send(ctx, holder1, holder2, 100), "send on the second day")
+	require.EqualError(t, send(ctx, holder1, holder2, 1),
+		"cannot send 1limitcoin from "+holder1.String()+": it exceeds the remaining daily transfer limit of 0limitcoin", "send over daily limit on the second day")
+
+	// Sends are grouped by hour, so the second day's sends stop counting towards a daily limit an hour later than exact.
+	ctx = ctx.WithBlockTime(now.Add(50 * time.Hour))
+	require.ErrorContains(t, send(ctx, holder1, holder2, 60), "exceeds the remaining weekly transfer limit of 50limitcoin", "send over weekly limit")
+	require.ErrorContains(t, transfer(ctx, holder1, holder2, holder1, 60), "exceeds the remaining weekly transfer limit of 50limitcoin", "transfer over weekly limit")
+	require.ErrorContains(t, ibcTransfer(ctx, holder1, 60), "exceeds the remaining weekly transfer limit of 50limitcoin", "ibc transfer over weekly limit")
+
+	status, err := app.MarkerKeeper.TransferLimit(ctx, &types.QueryTransferLimitRequest{Id: denom, Address: holder1.String()})
+	require.NoError(t, err, "TransferLimit")
+	require.True(t, status.Limit.IsDefault(), "holder1 limit is the default")
+	require.Equal(t, "0"+denom, status.DailyUsed.String(), "daily used")
+	require.Equal(t, "100"+denom, status.DailyRemaining.String(), "daily remaining")
+	require.Equal(t, "200"+denom, status.WeeklyUsed.String(), "weekly used")
+	require.Equal(t, "50"+denom, status.WeeklyRemaining.String(), "weekly remaining")
+
+	// Forced transfers are not limited.
+	require.NoError(t, transfer(ctx, holder1, holder2, admin, 500), "forced transfer")
+	require.Equal(t, "300"+denom, app.BankKeeper.GetBalance(ctx, holder1, denom).String(), "holder1 balance")
+
+	// holder2 has its own limit without a daily limit.
+	require.NoError(t, send(ctx, holder2, holder1, 900), "holder2 send")
+	require.ErrorContains(t, send(ctx, holder2, holder1, 101), "exceeds the remaining weekly transfer limit of 100limitcoin", "holder2 send over weekly limit")
+	status, err = app.MarkerKeeper.TransferLimit(ctx, &types.QueryTransferLimitRequest{Id: denom, Address: holder2.String()})
+	require.NoError(t, err, "TransferLimit holder2")
+	require.Nil(t, status.DailyRemaining, "holder2 daily remaining")
+
+	limits, err := app.MarkerKeeper.TransferLimits(ctx, &types.QueryTransferLimitsRequest{Id: denom})
+	require.NoError(t, err, "TransferLimits")
+	require.NotNil(t, limits.DefaultLimit, "default limit")
+	require.Len(t, limits.Limits, 1, "account limits")
+	require.Equal(t, holder2.String(), limits.Limits[0].Address, "account limit address")
+
+	// A week after the first sends, they no longer count.
+	ctx = ctx.WithBlockTime(now.Add(8 * 24 * time.Hour))
+	require.NoError(t, ibcTransfer(ctx, holder1, 60), "ibc transfer after a week")
+	require.EqualError(t, send(ctx, holder1, holder2, 50),
+		"cannot send 50limitcoin from "+holder1.String()+": it exceeds the remaining daily transfer limit of 40limitcoin", "send after an ibc transfer")
+	require.NoError(t, send(ctx, holder1, holder2, 40), "send after a week")
+
+	_, err = msgServer.RemoveTransferLimit(ctx, types.NewMsgRemoveTransferLimitRequest(denom, nil, admin.String()))
+	require.NoError(t, err, "RemoveTransferLimit default")
+	_, err = msgServer.RemoveTransferLimit(ctx, types.NewMsgRemoveTransferLimitRequest(denom, nil, admin.String()))
+	require.ErrorIs(t, err, types.ErrTransferLimitNotFound, "RemoveTransferLimit default again")
+	require.NoError(t, send(ctx, holder1, holder2, 200), "send without a limit")
+	_, err = app.MarkerKeeper.TransferLimit(ctx, &types.QueryTransferLimitRequest{Id: denom, Address: holder1.String()})
+	require.ErrorIs(t, err, types.ErrTransferLimitNotFound, "TransferLimit without a limit")
+}
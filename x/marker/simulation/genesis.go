@@ -63,9 +63,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	markerGenesis := types.GenesisState{
 		Params: types.Params{
-			MaxSupply:              maxSupply,
-			EnableGovernance:       enableGovernance,
-			UnrestrictedDenomRegex: unrestrictedDenomRegex,
+			MaxSupply:               maxSupply,
+			EnableGovernance:        enableGovernance,
+			UnrestrictedDenomRegex:  unrestrictedDenomRegex,
+			RecoveryChallengePeriod: types.DefaultRecoveryChallengePeriod,
 		},
 		Markers: []types.MarkerAccount{
 			{
@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -23,6 +26,10 @@ type AccessGrantI interface {
 
 	HasAccess(Access) bool
 	GetAccessList() []Access
+	GetMintAllowance() *SupplyAllowance
+	GetBurnAllowance() *SupplyAllowance
+	GetExpiration() *time.Time
+	IsExpired(time.Time) bool
 
 	AddAccess(Access) error
 	RemoveAccess(Access) error
@@ -88,7 +95,7 @@ func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant
 			return grant
 		}
 	}
-	return AccessGrant{account.String(), []Access{}}
+	return AccessGrant{Address: account.String(), Permissions: []Access{}}
 }
 
 // GetAddress returns the account address the access grant belongs to
@@ -105,12 +112,66 @@ func (ag AccessGrant) GetAccessList() AccessList {
 	return ag.Permissions
 }
 
+// GetMintAllowance returns the limit on the amount this grant's address can mint, or nil if there isn't one.
+func (ag AccessGrant) GetMintAllowance() *SupplyAllowance {
+	return ag.MintAllowance
+}
+
+// GetBurnAllowance returns the limit on the amount this grant's address can burn, or nil if there isn't one.
+func (ag AccessGrant) GetBurnAllowance() *SupplyAllowance {
+	return ag.BurnAllowance
+}
+
+// GetExpiration returns the time at which this grant stops being in effect, or nil if it does not expire.
+func (ag AccessGrant) GetExpiration() *time.Time {
+	return ag.Expiration
+}
+
+// IsExpired returns true if this grant has an expiration that is not after the provided time.
+func (ag AccessGrant) IsExpired(blockTime time.Time) bool {
+	return ag.Expiration != nil && !blockTime.Before(*ag.Expiration)
+}
+
+// GetSupplyAllowance returns the mint or burn allowance for the given access, or nil if there isn't one.
+func (ag AccessGrant) GetSupplyAllowance(access Access) *SupplyAllowance {
+	switch access {
+	case Access_Mint:
+		return ag.MintAllowance
+	case Access_Burn:
+		return ag.BurnAllowance
+	default:
+		return nil
+	}
+}
+
 // Validate performs checks to ensure this acccess grant is properly formed.
 func (ag AccessGrant) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(ag.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
 	}
-	return validateAccess(ag.Permissions)
+	if err := validateAccess(ag.Permissions); err != nil {
+		return err
+	}
+	if ag.MintAllowance != nil {
+		if !ag.HasAccess(Access_Mint) {
+			return fmt.Errorf("mint allowance cannot be set without %s", Access_Mint)
+		}
+		if err := ag.MintAllowance.Validate(); err != nil {
+			return fmt.Errorf("invalid mint allowance: %w", err)
+		}
+	}
+	if ag.BurnAllowance != nil {
+		if !ag.HasAccess(Access_Burn) {
+			return fmt.Errorf("burn allowance cannot be set without %s", Access_Burn)
+		}
+		if err := ag.BurnAllowance.Validate(); err != nil {
+			return fmt.Errorf("invalid burn allowance: %w", err)
+		}
+	}
+	if ag.Expiration != nil && ag.Expiration.IsZero() {
+		return fmt.Errorf("expiration cannot be the zero time")
+	}
+	return nil
 }
 
 // HasAccess returns true if the current grant contains the specified access type
@@ -142,6 +203,8 @@ func (ag *AccessGrant) RemoveAccess(access Access) error {
 }
 
 // MergeAdd looks for any missing permissions in the given grant and adds them to this instance.
+// Allowances are also taken from the given grant if this instance does not have them. The expiration is not
+// taken from the given grant though, since it would then apply to the permissions being added.
 func (ag *AccessGrant) MergeAdd(other AccessGrant) error {
 	if err := other.Validate(); err != nil {
 		return err
@@ -154,6 +217,12 @@ func (ag *AccessGrant) MergeAdd(other AccessGrant) error {
 			ag.Permissions = append(ag.Permissions, p)
 		}
 	}
+	if ag.MintAllowance == nil {
+		ag.MintAllowance = other.MintAllowance
+	}
+	if ag.BurnAllowance == nil {
+		ag.BurnAllowance = other.BurnAllowance
+	}
 	return nil
 }
 
@@ -172,6 +241,12 @@ func (ag *AccessGrant) MergeRemove(other AccessGrant) error {
 		}
 	}
 	ag.Permissions = newPerms
+	if !ag.HasAccess(Access_Mint) {
+		ag.MintAllowance = nil
+	}
+	if !ag.HasAccess(Access_Burn) {
+		ag.BurnAllowance = nil
+	}
 	return nil
 }
 
@@ -189,6 +264,42 @@ func (ag AccessGrant) String() string {
 	return fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
 }
 
+// NewSupplyAllowance creates a new SupplyAllowance with the given limit and period.
+func NewSupplyAllowance(limit sdkmath.Int, period time.Duration) *SupplyAllowance {
+	return &SupplyAllowance{
+		Limit:  limit,
+		Period: period,
+	}
+}
+
+// Validate checks that the allowance's limit and period are not negative.
+func (a SupplyAllowance) Validate() error {
+	if a.Limit.IsNil() || a.Limit.IsNegative() {
+		return fmt.Errorf("limit cannot be negative")
+	}
+	if a.Period < 0 {
+		return fmt.Errorf("period cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks that the usage record is properly formed.
+func (u SupplyAllowanceUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.MarkerAddress); err != nil {
+		return fmt.Errorf("invalid marker address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if !u.Access.IsOneOf(Access_Mint, Access_Burn) {
+		return fmt.Errorf("invalid allowance access %s", u.Access)
+	}
+	if u.Used.IsNil() || u.Used.IsNegative() {
+		return fmt.Errorf("used amount cannot be negative")
+	}
+	return nil
+}
+
 // IsOneOf returns true if the specified Access right is any of the provided options.
 func (right Access) IsOneOf(rights ...Access) bool {
 	if len(rights) == 0 {
@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -94,6 +100,12 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// mint_allowance, if set, limits the amount this address can mint. Requires ACCESS_MINT.
+	MintAllowance *SupplyAllowance `protobuf:"bytes,3,opt,name=mint_allowance,json=mintAllowance,proto3" json:"mint_allowance,omitempty"`
+	// burn_allowance, if set, limits the amount this address can burn. Requires ACCESS_BURN.
+	BurnAllowance *SupplyAllowance `protobuf:"bytes,4,opt,name=burn_allowance,json=burnAllowance,proto3" json:"burn_allowance,omitempty"`
+	// expiration, if set, is the time at which this grant stops being in effect.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -128,9 +140,106 @@ func (m *AccessGrant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessGrant proto.InternalMessageInfo
 
+// SupplyAllowance limits the amount of a marker's coin that an address can mint or burn.
+type SupplyAllowance struct {
+	// limit is the maximum amount that can be minted (or burned) in each period, or in total if there is no period.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// period is the length of time after which the amount used is reset. Zero means the limit never resets.
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *SupplyAllowance) Reset()         { *m = SupplyAllowance{} }
+func (m *SupplyAllowance) String() string { return proto.CompactTextString(m) }
+func (*SupplyAllowance) ProtoMessage()    {}
+func (*SupplyAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7242c30a84644575, []int{1}
+}
+func (m *SupplyAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyAllowance.Merge(m, src)
+}
+func (m *SupplyAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyAllowance proto.InternalMessageInfo
+
+func (m *SupplyAllowance) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// SupplyAllowanceUsage tracks how much of an address's mint or burn allowance has been used.
+type SupplyAllowanceUsage struct {
+	// marker_address is the address of the marker the allowance is for.
+	MarkerAddress string `protobuf:"bytes,1,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty"`
+	// address is the address the allowance was granted to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// access is either ACCESS_MINT or ACCESS_BURN.
+	Access Access `protobuf:"varint,3,opt,name=access,proto3,enum=provenance.marker.v1.Access" json:"access,omitempty"`
+	// used is the amount used in the current period.
+	Used cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	// period_start is the time the current period started.
+	PeriodStart time.Time `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+}
+
+func (m *SupplyAllowanceUsage) Reset()         { *m = SupplyAllowanceUsage{} }
+func (m *SupplyAllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*SupplyAllowanceUsage) ProtoMessage()    {}
+func (*SupplyAllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7242c30a84644575, []int{2}
+}
+func (m *SupplyAllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyAllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyAllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyAllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyAllowanceUsage.Merge(m, src)
+}
+func (m *SupplyAllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyAllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyAllowanceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyAllowanceUsage proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("provenance.marker.v1.Access", Access_name, Access_value)
 	proto.RegisterType((*AccessGrant)(nil), "provenance.marker.v1.AccessGrant")
+	proto.RegisterType((*SupplyAllowance)(nil), "provenance.marker.v1.SupplyAllowance")
+	proto.RegisterType((*SupplyAllowanceUsage)(nil), "provenance.marker.v1.SupplyAllowanceUsage")
 }
 
 func init() {
@@ -138,39 +247,56 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xdb, 0x46,
+	0x1c, 0x15, 0x65, 0x5a, 0x76, 0x4e, 0x7f, 0xc2, 0x1e, 0x1c, 0x54, 0x66, 0x13, 0x91, 0x75, 0x91,
+	0xc2, 0x28, 0x1a, 0x12, 0x76, 0x3a, 0xb9, 0x4b, 0x49, 0x89, 0x4a, 0x09, 0xd8, 0x8a, 0x40, 0x49,
+	0x30, 0xd0, 0x45, 0xa0, 0xc4, 0x8b, 0x7c, 0xb0, 0xc8, 0x23, 0xee, 0x4e, 0x76, 0xfc, 0x0d, 0x5a,
+	0x2d, 0xcd, 0x98, 0x45, 0x80, 0xe7, 0xce, 0xf9, 0x10, 0x41, 0xa7, 0x8c, 0x45, 0x87, 0xa4, 0xb0,
+	0x97, 0xee, 0xfd, 0x02, 0x05, 0x79, 0x54, 0xc4, 0xb8, 0x06, 0x9a, 0x6c, 0xfc, 0xe9, 0xf7, 0xde,
+	0xc3, 0xe3, 0x7b, 0x3f, 0x11, 0x7c, 0x1d, 0x53, 0x72, 0x86, 0x22, 0x3f, 0x1a, 0x23, 0x33, 0xf4,
+	0xe9, 0x29, 0xa2, 0xe6, 0xd9, 0x9e, 0xe9, 0x8f, 0xc7, 0x88, 0xb1, 0x09, 0xf5, 0x23, 0x6e, 0xc4,
+	0x94, 0x70, 0x02, 0xb7, 0x56, 0x38, 0x43, 0xe0, 0x8c, 0xb3, 0x3d, 0x75, 0x6b, 0x42, 0x26, 0x24,
+	0x05, 0x98, 0xc9, 0x93, 0xc0, 0xaa, 0xdb, 0x63, 0xc2, 0x42, 0xc2, 0x86, 0x62, 0x21, 0x86, 0x6c,
+	0xd5, 0x98, 0x10, 0x32, 0x99, 0x22, 0x33, 0x9d, 0x46, 0xb3, 0x67, 0x66, 0x30, 0xa3, 0x3e, 0xc7,
+	0x24, 0xca, 0xf6, 0xda, 0xcd, 0x3d, 0xc7, 0x21, 0x62, 0xdc, 0x0f, 0x63, 0x01, 0xd8, 0xf9, 0xa7,
+	0x08, 0xca, 0x56, 0xea, 0xee, 0x49, 0xe2, 0x0e, 0xd6, 0xc1, 0x86, 0x1f, 0x04, 0x14, 0x31, 0x56,
+	0x97, 0x74, 0x69, 0xf7, 0x8e, 0xb7, 0x1c, 0x61, 0x07, 0x94, 0x63, 0x44, 0x43, 0xcc, 0x18, 0x26,
+	0x11, 0xab, 0x17, 0xf5, 0xb5, 0xdd, 0xda, 0xfe, 0x7d, 0xe3, 0xb6, 0xf7, 0x30, 0x84, 0xa2, 0x5d,
+	0xfb, 0xed, 0x9d, 0x06, 0xc4, 0xf3, 0x21, 0x66, 0xdc, 0xcb, 0x0b, 0xc0, 0x43, 0x50, 0x0b, 0x71,
+	0xc4, 0x87, 0xfe, 0x74, 0x4a, 0xce, 0x13, 0x7e, 0x7d, 0x4d, 0x97, 0x76, 0xcb, 0xfb, 0x0f, 0x6f,
+	0x97, 0xec, 0xcd, 0xe2, 0x78, 0x7a, 0x61, 0x2d, 0xc1, 0x5e, 0x35, 0x21, 0xbf, 0x1f, 0x13, 0xb5,
+	0xd1, 0x8c, 0x46, 0x39, 0x35, 0xf9, 0x93, 0xd4, 0x12, 0xf2, 0x4a, 0xed, 0x07, 0x00, 0xd0, 0xf3,
+	0x18, 0x8b, 0x28, 0xeb, 0xeb, 0xa9, 0x92, 0x6a, 0x88, 0x2c, 0x8d, 0x65, 0x96, 0x46, 0x7f, 0x99,
+	0xa5, 0x2d, 0xbf, 0x78, 0xa7, 0x49, 0x5e, 0x8e, 0x73, 0x70, 0xff, 0xe7, 0x4b, 0xad, 0xf0, 0xf2,
+	0x52, 0x2b, 0xfc, 0x7d, 0xa9, 0x49, 0xbf, 0xbf, 0x7a, 0x54, 0xc9, 0x85, 0xec, 0xee, 0xfc, 0x22,
+	0x81, 0xbb, 0x37, 0x2c, 0xc0, 0xc7, 0x60, 0x7d, 0x8a, 0x43, 0xcc, 0x45, 0xee, 0xf6, 0x83, 0xd7,
+	0x6f, 0xb5, 0xc2, 0x9f, 0x6f, 0xb5, 0x7b, 0xa2, 0x6f, 0x16, 0x9c, 0x1a, 0x98, 0x98, 0xa1, 0xcf,
+	0x4f, 0x0c, 0x37, 0xe2, 0x9e, 0xc0, 0xc2, 0xef, 0x41, 0x29, 0x46, 0x14, 0x93, 0xa0, 0x5e, 0x4c,
+	0x4d, 0x6e, 0xff, 0xc7, 0x64, 0x2b, 0x3b, 0x08, 0x7b, 0x33, 0x11, 0x7c, 0x99, 0xf8, 0xcc, 0x28,
+	0x07, 0x72, 0xe2, 0x6d, 0xe7, 0xd7, 0x22, 0xd8, 0xba, 0xe1, 0x65, 0xc0, 0xfc, 0x09, 0x82, 0x0f,
+	0x41, 0x4d, 0x04, 0x36, 0xfc, 0xf0, 0x22, 0xaa, 0xe2, 0x57, 0x2b, 0xbb, 0x8b, 0xdc, 0xc5, 0x14,
+	0x3f, 0xbc, 0x98, 0xef, 0x40, 0x49, 0x1c, 0x7e, 0xda, 0xec, 0xff, 0x1c, 0x8b, 0x97, 0x61, 0xe1,
+	0x1e, 0x90, 0x67, 0x0c, 0x05, 0x75, 0xf9, 0x63, 0x62, 0x48, 0xa1, 0xf0, 0x09, 0xa8, 0x88, 0x57,
+	0x1a, 0x32, 0xee, 0x53, 0xfe, 0x11, 0x85, 0xa5, 0x61, 0xa4, 0xa5, 0x95, 0x05, 0xb3, 0x97, 0x10,
+	0x0f, 0xe4, 0xa4, 0xb5, 0x6f, 0x5e, 0x15, 0x41, 0x49, 0x98, 0x82, 0x5f, 0x01, 0x68, 0x35, 0x9b,
+	0x4e, 0xaf, 0x37, 0x1c, 0x74, 0x7a, 0x5d, 0xa7, 0xe9, 0xb6, 0x5d, 0xa7, 0xa5, 0x14, 0xd4, 0xf2,
+	0x7c, 0xa1, 0x6f, 0x0c, 0xa2, 0xd3, 0x88, 0x9c, 0x47, 0x70, 0x1b, 0x94, 0x33, 0xd0, 0x91, 0xdb,
+	0xe9, 0x2b, 0x92, 0xba, 0x39, 0x5f, 0xe8, 0xf2, 0x11, 0x8e, 0x78, 0x6e, 0x65, 0x0f, 0xbc, 0x8e,
+	0x52, 0x14, 0x2b, 0x7b, 0x46, 0x23, 0xa8, 0x81, 0x5a, 0xb6, 0x6a, 0x39, 0xdd, 0xa7, 0x3d, 0xb7,
+	0xaf, 0xac, 0x09, 0xd9, 0x16, 0x8a, 0x09, 0xc3, 0x1c, 0x7e, 0x09, 0xee, 0x66, 0x80, 0x63, 0xb7,
+	0xff, 0x63, 0xcb, 0xb3, 0x8e, 0x15, 0x59, 0xad, 0xcc, 0x17, 0xfa, 0xe6, 0x31, 0xe6, 0x27, 0x01,
+	0xf5, 0xcf, 0xe1, 0x03, 0x50, 0x7d, 0xaf, 0x71, 0xe8, 0xf4, 0x1d, 0x65, 0x5d, 0x05, 0xf3, 0x85,
+	0x5e, 0x6a, 0xa1, 0x29, 0xe2, 0x08, 0x7e, 0x01, 0x2a, 0xd9, 0xda, 0x6a, 0x1d, 0xb9, 0x1d, 0xa5,
+	0xa4, 0xde, 0x99, 0x2f, 0xf4, 0x75, 0x2b, 0x08, 0x71, 0x94, 0x93, 0xef, 0x7b, 0x56, 0xa7, 0xd7,
+	0x76, 0x3c, 0x65, 0x43, 0xc8, 0xf7, 0xa9, 0x1f, 0xb1, 0x67, 0x88, 0xc2, 0x6f, 0xc1, 0xbd, 0x0c,
+	0xd2, 0x7e, 0xea, 0x35, 0x9d, 0x15, 0x70, 0x53, 0xfd, 0x6c, 0xbe, 0xd0, 0xab, 0x6d, 0x42, 0xc7,
+	0x68, 0x89, 0xb6, 0x2f, 0x5e, 0x5f, 0x35, 0xa4, 0x37, 0x57, 0x0d, 0xe9, 0xaf, 0xab, 0x86, 0xf4,
+	0xe2, 0xba, 0x51, 0x78, 0x73, 0xdd, 0x28, 0xfc, 0x71, 0xdd, 0x28, 0x80, 0xcf, 0x31, 0xb9, 0xb5,
+	0x7a, 0x5b, 0xc9, 0xfd, 0x2b, 0xba, 0x49, 0x4b, 0x5d, 0xe9, 0xa7, 0xfd, 0x09, 0xe6, 0x27, 0xb3,
+	0x91, 0x31, 0x26, 0xa1, 0xb9, 0x22, 0x3d, 0xc2, 0x24, 0x37, 0x99, 0xcf, 0x97, 0x1f, 0x57, 0x7e,
+	0x11, 0x23, 0x36, 0x2a, 0xa5, 0x15, 0x3f, 0xfe, 0x77, 0x00, 0x5f, 0x3d, 0xfa, 0x36, 0x7e, 0x05,
+	0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -203,6 +329,46 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.MintAllowance.Equal(that1.MintAllowance) {
+		return false
+	}
+	if !this.BurnAllowance.Equal(that1.BurnAllowance) {
+		return false
+	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
+	return true
+}
+func (this *SupplyAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyAllowance)
+	if !ok {
+		that2, ok := that.(SupplyAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Limit.Equal(that1.Limit) {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -225,21 +391,55 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccessgrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BurnAllowance != nil {
+		{
+			size, err := m.BurnAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MintAllowance != nil {
+		{
+			size, err := m.MintAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA2 := make([]byte, len(m.Permissions)*10)
-		var j1 int
+		dAtA5 := make([]byte, len(m.Permissions)*10)
+		var j4 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j4++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintAccessgrant(dAtA, i, uint64(j1))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -253,6 +453,107 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAccessgrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyAllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyAllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyAllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintAccessgrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Access != 0 {
+		i = encodeVarintAccessgrant(dAtA, i, uint64(m.Access))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccessgrant(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintAccessgrant(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccessgrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccessgrant(v)
 	base := offset
@@ -281,6 +582,55 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if m.BurnAllowance != nil {
+		l = m.BurnAllowance.Size()
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovAccessgrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAccessgrant(uint64(l))
+	return n
+}
+
+func (m *SupplyAllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if m.Access != 0 {
+		n += 1 + sovAccessgrant(uint64(m.Access))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovAccessgrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovAccessgrant(uint64(l))
 	return n
 }
 
@@ -420,6 +770,431 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintAllowance == nil {
+				m.MintAllowance = &SupplyAllowance{}
+			}
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BurnAllowance == nil {
+				m.BurnAllowance = &SupplyAllowance{}
+			}
+			if err := m.BurnAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessgrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessgrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessgrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyAllowanceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessgrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyAllowanceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyAllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			m.Access = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Access |= Access(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -234,3 +237,106 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 }
+
+func TestSupplyAllowanceValidate(t *testing.T) {
+	addr := testAddress()
+	tests := []struct {
+		name   string
+		grant  AccessGrant
+		expErr string
+	}{
+		{
+			name:  "mint allowance with mint access",
+			grant: AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Mint}, MintAllowance: NewSupplyAllowance(sdkmath.NewInt(10), time.Hour)},
+		},
+		{
+			name:  "burn allowance with burn access and no period",
+			grant: AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Burn}, BurnAllowance: NewSupplyAllowance(sdkmath.NewInt(10), 0)},
+		},
+		{
+			name:   "mint allowance without mint access",
+			grant:  AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Burn}, MintAllowance: NewSupplyAllowance(sdkmath.NewInt(10), 0)},
+			expErr: "mint allowance cannot be set without ACCESS_MINT",
+		},
+		{
+			name:   "burn allowance without burn access",
+			grant:  AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Mint}, BurnAllowance: NewSupplyAllowance(sdkmath.NewInt(10), 0)},
+			expErr: "burn allowance cannot be set without ACCESS_BURN",
+		},
+		{
+			name:   "negative limit",
+			grant:  AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Mint}, MintAllowance: NewSupplyAllowance(sdkmath.NewInt(-1), 0)},
+			expErr: "invalid mint allowance: limit cannot be negative",
+		},
+		{
+			name:   "negative period",
+			grant:  AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Burn}, BurnAllowance: NewSupplyAllowance(sdkmath.NewInt(1), -time.Second)},
+			expErr: "invalid burn allowance: period cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.grant.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMergeSupplyAllowances(t *testing.T) {
+	addr := testAddress()
+	mintAllowance := NewSupplyAllowance(sdkmath.NewInt(100), time.Hour)
+	existing := AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Mint}, MintAllowance: mintAllowance}
+
+	added := NewAccessGrant(addr, AccessList{Access_Burn})
+	require.NoError(t, added.MergeAdd(existing), "MergeAdd")
+	require.Equal(t, mintAllowance, added.MintAllowance, "mint allowance kept from existing grant")
+	require.Nil(t, added.BurnAllowance, "burn allowance")
+
+	replaced := &AccessGrant{Address: addr.String(), Permissions: AccessList{Access_Mint}, MintAllowance: NewSupplyAllowance(sdkmath.NewInt(5), 0)}
+	require.NoError(t, replaced.MergeAdd(existing), "MergeAdd with new allowance")
+	require.Equal(t, sdkmath.NewInt(5), replaced.MintAllowance.Limit, "mint allowance from new grant takes precedence")
+
+	require.NoError(t, added.MergeRemove(*NewAccessGrant(addr, AccessList{Access_Mint})), "MergeRemove")
+	require.Nil(t, added.MintAllowance, "mint allowance after mint access removed")
+}
+
+func TestAccessGrantExpiration(t *testing.T) {
+	addr := testAddress()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	grant := NewAccessGrant(addr, AccessList{Access_Withdraw})
+	require.False(t, grant.IsExpired(now), "IsExpired without an expiration")
+	grant.Expiration = &later
+	require.False(t, grant.IsExpired(now), "IsExpired before expiration")
+	require.True(t, grant.IsExpired(later), "IsExpired at expiration")
+	require.True(t, grant.IsExpired(later.Add(time.Second)), "IsExpired after expiration")
+
+	added := NewAccessGrant(addr, AccessList{Access_Deposit})
+	require.NoError(t, added.MergeAdd(*grant), "MergeAdd")
+	require.Nil(t, added.Expiration, "expiration not taken from existing grant")
+
+	zero := time.Time{}
+	grant.Expiration = &zero
+	require.EqualError(t, grant.Validate(), "expiration cannot be the zero time", "Validate with zero expiration")
+
+	other := testAddress()
+	marker := &MarkerAccount{AccessControl: []AccessGrant{
+		{Address: addr.String(), Permissions: AccessList{Access_Withdraw}, Expiration: &later},
+		{Address: other.String(), Permissions: AccessList{Access_Admin}},
+	}}
+	require.Empty(t, marker.RemoveExpiredAccess(now), "RemoveExpiredAccess before expiration")
+	require.Len(t, marker.AccessControl, 2, "grants before expiration")
+	active := marker.WithoutExpiredAccess(later)
+	require.Equal(t, []AccessGrant{{Address: other.String(), Permissions: AccessList{Access_Admin}}}, active.GetAccessList(), "WithoutExpiredAccess grants")
+	require.Len(t, marker.AccessControl, 2, "grants after WithoutExpiredAccess")
+	expired := marker.RemoveExpiredAccess(later)
+	require.Len(t, expired, 1, "RemoveExpiredAccess at expiration")
+	require.Equal(t, addr.String(), expired[0].Address, "expired grant address")
+	require.Equal(t, []AccessGrant{{Address: other.String(), Permissions: AccessList{Access_Admin}}}, marker.AccessControl, "remaining grants")
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/jsonschema"
+)
+
+// NewAccountDataSchema creates a new AccountDataSchema.
+func NewAccountDataSchema(denom, schema string) AccountDataSchema {
+	return AccountDataSchema{Denom: denom, Schema: schema}
+}
+
+// Validate returns an error if the denom is invalid or the schema is not a supported JSON schema.
+func (s AccountDataSchema) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	return ValidateAccountDataSchema(s.Schema)
+}
+
+// ValidateAccountDataSchema returns an error if the schema is not a JSON schema describing an object that only
+// uses the keywords supported by the jsonschema package.
+func ValidateAccountDataSchema(schema string) error {
+	doc, err := jsonschema.Decode(schema)
+	if err != nil {
+		return fmt.Errorf("invalid account data schema: %w", err)
+	}
+	node, ok := doc.(map[string]interface{})
+	if !ok {
+		return errors.New("invalid account data schema: must be a JSON object")
+	}
+	if node["type"] != "object" {
+		return errors.New(`invalid account data schema: "type" must be "object"`)
+	}
+	if err = jsonschema.CheckSchema(node); err != nil {
+		return fmt.Errorf("invalid account data schema: %w", err)
+	}
+	return nil
+}
+
+// ValidateAccountData returns an error if the value does not conform to the schema.
+// The schema is expected to have already been validated using ValidateAccountDataSchema.
+func ValidateAccountData(schema, value string) error {
+	doc, err := jsonschema.Decode(schema)
+	if err != nil {
+		return fmt.Errorf("invalid account data schema: %w", err)
+	}
+	node, ok := doc.(map[string]interface{})
+	if !ok {
+		return errors.New("invalid account data schema: must be a JSON object")
+	}
+	data, err := jsonschema.Decode(value)
+	if err != nil {
+		return fmt.Errorf("account data is not valid JSON: %w", err)
+	}
+	if err = jsonschema.ValidateValue(node, data); err != nil {
+		return fmt.Errorf("account data does not conform to schema: %w", err)
+	}
+	return nil
+}
+
+// ParseAccountDataDocument returns the account data as a Struct if it is a JSON object, or nil if it isn't.
+func ParseAccountDataDocument(value string) *gogotypes.Struct {
+	doc, err := jsonschema.Decode(value)
+	if err != nil {
+		return nil
+	}
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return toStruct(obj)
+}
+
+// toStruct converts a decoded JSON object into a Struct.
+func toStruct(obj map[string]interface{}) *gogotypes.Struct {
+	rv := &gogotypes.Struct{Fields: make(map[string]*gogotypes.Value, len(obj))}
+	for key, val := range obj {
+		rv.Fields[key] = toValue(val)
+	}
+	return rv
+}
+
+// toValue converts a decoded JSON value into a Value.
+func toValue(val interface{}) *gogotypes.Value {
+	switch v := val.(type) {
+	case bool:
+		return &gogotypes.Value{Kind: &gogotypes.Value_BoolValue{BoolValue: v}}
+	case string:
+		return &gogotypes.Value{Kind: &gogotypes.Value_StringValue{StringValue: v}}
+	case json.Number:
+		f, _ := v.Float64()
+		return &gogotypes.Value{Kind: &gogotypes.Value_NumberValue{NumberValue: f}}
+	case []interface{}:
+		list := &gogotypes.ListValue{Values: make([]*gogotypes.Value, len(v))}
+		for i, entry := range v {
+			list.Values[i] = toValue(entry)
+		}
+		return &gogotypes.Value{Kind: &gogotypes.Value_ListValue{ListValue: list}}
+	case map[string]interface{}:
+		return &gogotypes.Value{Kind: &gogotypes.Value_StructValue{StructValue: toStruct(v)}}
+	}
+	return &gogotypes.Value{Kind: &gogotypes.Value_NullValue{NullValue: gogotypes.NullValue_NULL_VALUE}}
+}
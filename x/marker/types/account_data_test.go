@@ -0,0 +1,187 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const prospectusSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "Prospectus",
+  "type": "object",
+  "required": ["isin", "issuer", "maturity"],
+  "additionalProperties": false,
+  "properties": {
+    "isin": {"type": "string", "pattern": "^[A-Z]{2}[A-Z0-9]{9}[0-9]$"},
+    "issuer": {"type": "string", "minLength": 1, "maxLength": 64},
+    "maturity": {"type": "string", "format": "date"},
+    "coupon": {"type": "number", "minimum": 0, "exclusiveMaximum": 100},
+    "tranches": {"type": "integer", "minimum": 1},
+    "rating": {"enum": ["AAA", "AA", "A", null]},
+    "callable": {"type": "boolean"},
+    "agents": {"type": "array", "minItems": 1, "maxItems": 2, "items": {"type": "string"}}
+  }
+}`
+
+func TestValidateAccountDataSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		expErr string
+	}{
+		{name: "prospectus", schema: prospectusSchema},
+		{name: "minimal", schema: `{"type":"object"}`},
+		{name: "not json", schema: `{"type":`, expErr: "invalid account data schema: unexpected EOF"},
+		{name: "trailing data", schema: `{"type":"object"} {}`, expErr: "unexpected data after JSON value"},
+		{name: "not an object", schema: `["object"]`, expErr: "must be a JSON object"},
+		{name: "not an object type", schema: `{"type":"string"}`, expErr: `"type" must be "object"`},
+		{
+			name:   "unsupported keyword",
+			schema: `{"type":"object","properties":{"a":{"$ref":"#/defs/a"}}}`,
+			expErr: `$.a: unsupported keyword "$ref"`,
+		},
+		{
+			name:   "unknown type",
+			schema: `{"type":"object","properties":{"a":{"type":"decimal"}}}`,
+			expErr: `$.a: unknown type "decimal"`,
+		},
+		{
+			name:   "bad pattern",
+			schema: `{"type":"object","properties":{"a":{"pattern":"[a-"}}}`,
+			expErr: `$.a: invalid "pattern"`,
+		},
+		{
+			name:   "unsupported format",
+			schema: `{"type":"object","properties":{"a":{"format":"email"}}}`,
+			expErr: `$.a: unsupported "format" email`,
+		},
+		{
+			name:   "negative length",
+			schema: `{"type":"object","properties":{"a":{"minLength":-1}}}`,
+			expErr: `$.a: "minLength" must be a non-negative integer`,
+		},
+		{
+			name:   "huge exponent",
+			schema: `{"type":"object","properties":{"a":{"maximum":1e999999}}}`,
+			expErr: `$.a: "maximum" number 1e999999 is out of range`,
+		},
+		{
+			name:   "empty enum",
+			schema: `{"type":"object","properties":{"a":{"enum":[]}}}`,
+			expErr: `$.a: "enum" must be a non-empty array`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAccountDataSchema(tc.schema)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ValidateAccountDataSchema")
+			} else {
+				require.NoError(t, err, "ValidateAccountDataSchema")
+			}
+		})
+	}
+}
+
+func TestValidateAccountData(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		expErr string
+	}{
+		{
+			name:  "required only",
+			value: `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30"}`,
+		},
+		{
+			name: "everything",
+			value: `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","coupon":4.25,` +
+				`"tranches":3.0,"rating":null,"callable":true,"agents":["bank"]}`,
+		},
+		{name: "not json", value: "This is hotdogcoin's marker data.", expErr: "account data is not valid JSON"},
+		{name: "not an object", value: `"US0378331005"`, expErr: "$: expected object, got string"},
+		{
+			name:   "missing required",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc."}`,
+			expErr: `$: missing required property "maturity"`,
+		},
+		{
+			name:   "unknown property",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","cusip":"037833100"}`,
+			expErr: `$: unknown property "cusip"`,
+		},
+		{
+			name:   "pattern mismatch",
+			value:  `{"isin":"us0378331005","issuer":"Apple Inc.","maturity":"2030-06-30"}`,
+			expErr: `$.isin: value does not match pattern`,
+		},
+		{
+			name:   "too long",
+			value:  `{"isin":"US0378331005","issuer":"` + strings.Repeat("x", 65) + `","maturity":"2030-06-30"}`,
+			expErr: "$.issuer: length 65 is more than the maximum 64",
+		},
+		{
+			name:   "empty issuer",
+			value:  `{"isin":"US0378331005","issuer":"","maturity":"2030-06-30"}`,
+			expErr: "$.issuer: length 0 is less than the minimum 1",
+		},
+		{
+			name:   "bad date",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-02-30"}`,
+			expErr: "$.maturity: value is not a valid date",
+		},
+		{
+			name:   "coupon too high",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","coupon":1e2}`,
+			expErr: "$.coupon: 1e2 is not less than the exclusive maximum 100",
+		},
+		{
+			name:   "fractional integer",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","tranches":1.5}`,
+			expErr: "$.tranches: expected integer, got number",
+		},
+		{
+			name:   "not in enum",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","rating":"B"}`,
+			expErr: "$.rating: value is not one of the allowed values",
+		},
+		{
+			name:   "too many items",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","agents":["a","b","c"]}`,
+			expErr: "$.agents: 3 items is more than the maximum 2",
+		},
+		{
+			name:   "bad item",
+			value:  `{"isin":"US0378331005","issuer":"Apple Inc.","maturity":"2030-06-30","agents":["a",2]}`,
+			expErr: "$.agents[1]: expected string, got number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAccountData(prospectusSchema, tc.value)
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ValidateAccountData")
+			} else {
+				require.NoError(t, err, "ValidateAccountData")
+			}
+		})
+	}
+}
+
+func TestParseAccountDataDocument(t *testing.T) {
+	require.Nil(t, ParseAccountDataDocument(""), "empty value")
+	require.Nil(t, ParseAccountDataDocument("plain text"), "plain text value")
+	require.Nil(t, ParseAccountDataDocument(`["a","b"]`), "array value")
+
+	doc := ParseAccountDataDocument(`{"isin":"US0378331005","coupon":4.25,"callable":false,"agents":["bank"],"extra":{"a":null}}`)
+	require.NotNil(t, doc, "object value")
+	require.Equal(t, "US0378331005", doc.Fields["isin"].GetStringValue(), "isin")
+	require.Equal(t, 4.25, doc.Fields["coupon"].GetNumberValue(), "coupon")
+	require.False(t, doc.Fields["callable"].GetBoolValue(), "callable")
+	require.Equal(t, "bank", doc.Fields["agents"].GetListValue().Values[0].GetStringValue(), "agents")
+	require.NotNil(t, doc.Fields["extra"].GetStructValue().Fields["a"].GetNullValue(), "extra.a")
+}
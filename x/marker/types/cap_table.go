@@ -0,0 +1,93 @@
+package types
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// CapTableBuilder accumulates the balance, held, and quarantined amounts of a denom by address and builds a CapTable.
+type CapTableBuilder struct {
+	denom       string
+	fundsHolder string
+	entries     map[string]*CapTableEntry
+}
+
+// NewCapTableBuilder returns a CapTableBuilder for a denom. The balance of the quarantine funds holder is ignored
+// since those funds are attributed to the accounts they were sent to.
+func NewCapTableBuilder(denom string, quarantineFundsHolder string) *CapTableBuilder {
+	return &CapTableBuilder{
+		denom:       denom,
+		fundsHolder: quarantineFundsHolder,
+		entries:     make(map[string]*CapTableEntry),
+	}
+}
+
+// entry returns the entry for an address, creating it if needed.
+func (b *CapTableBuilder) entry(addr string) *CapTableEntry {
+	e, ok := b.entries[addr]
+	if !ok {
+		e = &CapTableEntry{
+			Address:     addr,
+			Balance:     sdkmath.ZeroInt(),
+			Held:        sdkmath.ZeroInt(),
+			Quarantined: sdkmath.ZeroInt(),
+		}
+		b.entries[addr] = e
+	}
+	return e
+}
+
+// AddBalance adds to the balance of an address.
+func (b *CapTableBuilder) AddBalance(addr string, amount sdkmath.Int) {
+	if addr == b.fundsHolder || amount.IsNil() || amount.IsZero() {
+		return
+	}
+	e := b.entry(addr)
+	e.Balance = e.Balance.Add(amount)
+}
+
+// AddHeld adds to the amount of an address's balance that is on hold.
+func (b *CapTableBuilder) AddHeld(addr string, amount sdkmath.Int) {
+	if amount.IsNil() || amount.IsZero() {
+		return
+	}
+	e := b.entry(addr)
+	e.Held = e.Held.Add(amount)
+}
+
+// AddQuarantined adds to the amount awaiting acceptance by an address in quarantine.
+func (b *CapTableBuilder) AddQuarantined(addr string, amount sdkmath.Int) {
+	if amount.IsNil() || amount.IsZero() {
+		return
+	}
+	e := b.entry(addr)
+	e.Quarantined = e.Quarantined.Add(amount)
+}
+
+// Build returns the cap table with each entry's total and percentage of the supply.
+// Entries are ordered by total, largest first, then by address.
+func (b *CapTableBuilder) Build(height int64, supply sdkmath.Int) CapTable {
+	rv := CapTable{
+		Denom:   b.denom,
+		Height:  height,
+		Supply:  supply,
+		Entries: make([]CapTableEntry, 0, len(b.entries)),
+	}
+	for _, e := range b.entries {
+		entry := *e
+		entry.Total = entry.Balance.Add(entry.Quarantined)
+		entry.Percentage = sdkmath.LegacyZeroDec()
+		if supply.IsPositive() {
+			entry.Percentage = sdkmath.LegacyNewDecFromInt(entry.Total).MulInt64(100).QuoInt(supply)
+		}
+		rv.Entries = append(rv.Entries, entry)
+	}
+	sort.Slice(rv.Entries, func(i, j int) bool {
+		if !rv.Entries[i].Total.Equal(rv.Entries[j].Total) {
+			return rv.Entries[i].Total.GT(rv.Entries[j].Total)
+		}
+		return rv.Entries[i].Address < rv.Entries[j].Address
+	})
+	return rv
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestCapTableBuilder(t *testing.T) {
+	builder := NewCapTableBuilder("fundshare", "holder")
+	builder.AddBalance("alice", sdkmath.NewInt(500))
+	builder.AddHeld("alice", sdkmath.NewInt(100))
+	builder.AddBalance("bob", sdkmath.NewInt(200))
+	builder.AddQuarantined("carol", sdkmath.NewInt(300))
+	builder.AddBalance("holder", sdkmath.NewInt(300))
+	builder.AddBalance("dave", sdkmath.ZeroInt())
+	builder.AddBalance("erin", sdkmath.NewInt(300))
+
+	capTable := builder.Build(10, sdkmath.NewInt(1300))
+	require.Equal(t, "fundshare", capTable.Denom, "denom")
+	require.Equal(t, int64(10), capTable.Height, "height")
+	require.Equal(t, "1300", capTable.Supply.String(), "supply")
+
+	type row struct {
+		addr, balance, held, quarantined, total, percentage string
+	}
+	var rows []row
+	for _, e := range capTable.Entries {
+		rows = append(rows, row{e.Address, e.Balance.String(), e.Held.String(), e.Quarantined.String(), e.Total.String(), e.Percentage.String()})
+	}
+	require.Equal(t, []row{
+		{"alice", "500", "100", "0", "500", "38.461538461538461538"},
+		{"carol", "0", "0", "300", "300", "23.076923076923076923"},
+		{"erin", "300", "0", "0", "300", "23.076923076923076923"},
+		{"bob", "200", "0", "0", "200", "15.384615384615384615"},
+	}, rows, "entries")
+
+	empty := NewCapTableBuilder("fundshare", "").Build(1, sdkmath.ZeroInt())
+	require.Empty(t, empty.Entries, "entries of an empty cap table")
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistributionPaymentsPerBlock is the maximum number of holders paid from distributions in a single block.
+const DistributionPaymentsPerBlock = 100
+
+// DistributionMaxHolders is the maximum number of holders a distribution can be created for.
+const DistributionMaxHolders = 10_000
+
+// Validate returns an error if the distribution is not well formed.
+func (d Distribution) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("distribution id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid distribution %d denom: %w", d.Id, err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d amount: %w", d.Id, err)
+	}
+	if d.Amount.IsZero() {
+		return fmt.Errorf("distribution %d amount cannot be zero", d.Id)
+	}
+	if err := d.Distributed.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d distributed amount: %w", d.Id, err)
+	}
+	if !d.Amount.IsAllGTE(d.Distributed) {
+		return fmt.Errorf("distribution %d distributed amount %s exceeds amount %s", d.Id, d.Distributed, d.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid distribution %d administrator: %w", d.Id, err)
+	}
+	if d.TotalHolding.IsNil() || !d.TotalHolding.IsPositive() {
+		return fmt.Errorf("distribution %d total holding must be positive", d.Id)
+	}
+	// Each share is calculated as amount * balance / total holding. No balance is more than the total holding,
+	// so if amount * total holding fits in an Int, every share can be calculated.
+	for _, coin := range d.Amount {
+		if _, err := coin.Amount.SafeMul(d.TotalHolding); err != nil {
+			return fmt.Errorf("distribution %d amount %s is too large to share among a total holding of %s", d.Id, coin, d.TotalHolding)
+		}
+	}
+	if d.HoldersPaid > d.HolderCount {
+		return fmt.Errorf("distribution %d holders paid %d exceeds holder count %d", d.Id, d.HoldersPaid, d.HolderCount)
+	}
+	switch d.Status {
+	case DistributionStatus_InProgress, DistributionStatus_Completed:
+	default:
+		return fmt.Errorf("invalid distribution %d status: %s", d.Id, d.Status)
+	}
+	return nil
+}
+
+// ShareFor returns the portion of the distribution amount owed to a holder with the provided balance.
+// Amounts are rounded down; any remainder is returned to the marker once the distribution completes.
+func (d Distribution) ShareFor(balance sdkmath.Int) sdk.Coins {
+	share := sdk.NewCoins()
+	for _, coin := range d.Amount {
+		amt := coin.Amount.Mul(balance).Quo(d.TotalHolding)
+		if amt.IsPositive() {
+			share = share.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return share
+}
+
+// Validate returns an error if the distribution holder entry is not well formed.
+func (h DistributionHolder) Validate() error {
+	if h.DistributionId == 0 {
+		return fmt.Errorf("distribution holder distribution id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return fmt.Errorf("invalid distribution %d holder address: %w", h.DistributionId, err)
+	}
+	if h.Balance.IsNil() || !h.Balance.IsPositive() {
+		return fmt.Errorf("distribution %d holder %s balance must be positive", h.DistributionId, h.Address)
+	}
+	return nil
+}
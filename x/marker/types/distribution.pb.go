@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/distribution.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributionStatus defines the states a distribution can be in.
+type DistributionStatus int32
+
+const (
+	// DISTRIBUTION_STATUS_UNSPECIFIED is an invalid/unknown status.
+	DistributionStatus_Unspecified DistributionStatus = 0
+	// DISTRIBUTION_STATUS_IN_PROGRESS indicates that there are still holders awaiting payment.
+	DistributionStatus_InProgress DistributionStatus = 1
+	// DISTRIBUTION_STATUS_COMPLETED indicates that all holders have been paid.
+	DistributionStatus_Completed DistributionStatus = 2
+)
+
+var DistributionStatus_name = map[int32]string{
+	0: "DISTRIBUTION_STATUS_UNSPECIFIED",
+	1: "DISTRIBUTION_STATUS_IN_PROGRESS",
+	2: "DISTRIBUTION_STATUS_COMPLETED",
+}
+
+var DistributionStatus_value = map[string]int32{
+	"DISTRIBUTION_STATUS_UNSPECIFIED": 0,
+	"DISTRIBUTION_STATUS_IN_PROGRESS": 1,
+	"DISTRIBUTION_STATUS_COMPLETED":   2,
+}
+
+func (x DistributionStatus) String() string {
+	return proto.EnumName(DistributionStatus_name, int32(x))
+}
+
+func (DistributionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{0}
+}
+
+// Distribution tracks a pro-rata payout of funds from a marker's escrow to the holders of the marker's denom.
+type Distribution struct {
+	// id is the unique identifier of this distribution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker denom whose holders are being paid.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total payout being distributed.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// administrator is the address that created this distribution.
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// total_holding is the sum of all balances recorded in the holder snapshot.
+	TotalHolding cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_holding,json=totalHolding,proto3,customtype=cosmossdk.io/math.Int" json:"total_holding"`
+	// record_height is the block height at which holder balances were recorded.
+	RecordHeight int64 `protobuf:"varint,6,opt,name=record_height,json=recordHeight,proto3" json:"record_height,omitempty"`
+	// holder_count is the number of holders recorded in the snapshot.
+	HolderCount uint64 `protobuf:"varint,7,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	// holders_paid is the number of holders that have been paid so far.
+	HoldersPaid uint64 `protobuf:"varint,8,opt,name=holders_paid,json=holdersPaid,proto3" json:"holders_paid,omitempty"`
+	// distributed is the total amount paid out to holders so far.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// status is the current state of this distribution.
+	Status DistributionStatus `protobuf:"varint,10,opt,name=status,proto3,enum=provenance.marker.v1.DistributionStatus" json:"status,omitempty"`
+}
+
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{0}
+}
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
+}
+func (m *Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
+
+// DistributionHolder is a holder balance, recorded when a distribution was created, that is awaiting payment.
+type DistributionHolder struct {
+	// distribution_id is the id of the distribution this entry belongs to.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// address is the bech32 address of the holder.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the holder's balance of the marker denom at the time the distribution was created.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *DistributionHolder) Reset()         { *m = DistributionHolder{} }
+func (m *DistributionHolder) String() string { return proto.CompactTextString(m) }
+func (*DistributionHolder) ProtoMessage()    {}
+func (*DistributionHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4957c5d2bd54c983, []int{1}
+}
+func (m *DistributionHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionHolder.Merge(m, src)
+}
+func (m *DistributionHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionHolder proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.DistributionStatus", DistributionStatus_name, DistributionStatus_value)
+	proto.RegisterType((*Distribution)(nil), "provenance.marker.v1.Distribution")
+	proto.RegisterType((*DistributionHolder)(nil), "provenance.marker.v1.DistributionHolder")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/distribution.proto", fileDescriptor_4957c5d2bd54c983)
+}
+
+var fileDescriptor_4957c5d2bd54c983 = []byte{
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x1c, 0x8d, 0x93, 0x34, 0xfd, 0xf6, 0xf2, 0xa3, 0xfd, 0x9e, 0x8a, 0x30, 0x91, 0x9a, 0x98, 0x82,
+	0xd4, 0xa8, 0x52, 0x6d, 0xd2, 0x22, 0x21, 0x31, 0x41, 0x7e, 0x94, 0x5a, 0x82, 0x36, 0x72, 0x92,
+	0x85, 0xc5, 0xba, 0xf8, 0x0e, 0xe7, 0xd4, 0xd8, 0x17, 0xf9, 0x2e, 0x11, 0x9d, 0x59, 0x50, 0x27,
+	0x26, 0x06, 0xa4, 0x4a, 0x8c, 0x88, 0xa9, 0xfc, 0x17, 0x1d, 0x2b, 0x26, 0xc4, 0x50, 0x50, 0x3b,
+	0xf4, 0xdf, 0x40, 0x3e, 0xbb, 0xad, 0x41, 0x19, 0x98, 0x58, 0x92, 0xbb, 0xa7, 0xf7, 0xfc, 0xde,
+	0xdd, 0xe7, 0xe9, 0xc0, 0xda, 0x38, 0x60, 0x53, 0xe2, 0x23, 0xdf, 0x21, 0x86, 0x87, 0x82, 0x7d,
+	0x12, 0x18, 0xd3, 0xba, 0x81, 0x29, 0x17, 0x01, 0x1d, 0x4c, 0x04, 0x65, 0xbe, 0x3e, 0x0e, 0x98,
+	0x60, 0x70, 0xf9, 0x86, 0xa8, 0x47, 0x44, 0x7d, 0x5a, 0x2f, 0xff, 0x8f, 0x3c, 0xea, 0x33, 0x43,
+	0xfe, 0x46, 0xc4, 0xf2, 0xb2, 0xcb, 0x5c, 0x26, 0x97, 0x46, 0xb8, 0x8a, 0xd1, 0x8a, 0xc3, 0xb8,
+	0xc7, 0xb8, 0x31, 0x40, 0x9c, 0x18, 0xd3, 0xfa, 0x80, 0x08, 0x54, 0x37, 0x1c, 0x46, 0xe3, 0xcf,
+	0xaf, 0x7e, 0xcd, 0x82, 0x42, 0x2b, 0xe1, 0x0a, 0x4b, 0x20, 0x4d, 0xb1, 0xaa, 0x68, 0x4a, 0x2d,
+	0x6b, 0xa5, 0x29, 0x86, 0xcb, 0x60, 0x0e, 0x13, 0x9f, 0x79, 0x6a, 0x5a, 0x53, 0x6a, 0x0b, 0x56,
+	0xb4, 0x81, 0x07, 0x20, 0x87, 0x3c, 0x36, 0xf1, 0x85, 0x9a, 0xd1, 0x32, 0xb5, 0xfc, 0xe6, 0x1d,
+	0x3d, 0xf2, 0xd1, 0x43, 0x1f, 0x3d, 0xf6, 0xd1, 0x9b, 0x8c, 0xfa, 0x8d, 0xed, 0x93, 0xb3, 0x6a,
+	0xea, 0xf3, 0x8f, 0x6a, 0xcd, 0xa5, 0x62, 0x38, 0x19, 0xe8, 0x0e, 0xf3, 0x8c, 0x38, 0x54, 0xf4,
+	0xb7, 0xc1, 0xf1, 0xbe, 0x21, 0x0e, 0xc6, 0x84, 0x4b, 0x01, 0xff, 0x70, 0x79, 0xbc, 0x5e, 0x18,
+	0x11, 0x17, 0x39, 0x07, 0x76, 0x98, 0x94, 0x7f, 0xba, 0x3c, 0x5e, 0x57, 0xac, 0xd8, 0x10, 0xde,
+	0x07, 0x45, 0x84, 0x3d, 0xea, 0x87, 0xa1, 0x91, 0x60, 0x81, 0x9a, 0x95, 0xc1, 0x7e, 0x07, 0x61,
+	0x03, 0x14, 0x05, 0x13, 0x68, 0x64, 0x0f, 0xd9, 0x08, 0x53, 0xdf, 0x55, 0xe7, 0x42, 0x56, 0x63,
+	0x25, 0x0c, 0xf3, 0xfd, 0xac, 0x7a, 0x2b, 0xb2, 0xe6, 0x78, 0x5f, 0xa7, 0xcc, 0xf0, 0x90, 0x18,
+	0xea, 0xa6, 0x2f, 0xac, 0x82, 0xd4, 0xec, 0x44, 0x12, 0x78, 0x0f, 0x14, 0x03, 0xe2, 0xb0, 0x00,
+	0xdb, 0x43, 0x42, 0xdd, 0xa1, 0x50, 0x73, 0x9a, 0x52, 0xcb, 0x58, 0x85, 0x08, 0xdc, 0x91, 0x18,
+	0xbc, 0x0b, 0x0a, 0xa1, 0x05, 0x09, 0x6c, 0x47, 0xde, 0xc7, 0xbc, 0xbc, 0xb9, 0x7c, 0x84, 0x35,
+	0x65, 0xe2, 0x6b, 0x0a, 0xb7, 0xc7, 0x88, 0x62, 0xf5, 0xbf, 0x24, 0x85, 0x77, 0x10, 0xc5, 0xf0,
+	0x8d, 0x02, 0xf2, 0xd7, 0xc3, 0x27, 0x58, 0x5d, 0xf8, 0x57, 0xb7, 0x9a, 0x74, 0x85, 0x4f, 0x40,
+	0x8e, 0x0b, 0x24, 0x26, 0x5c, 0x05, 0x9a, 0x52, 0x2b, 0x6d, 0xd6, 0xf4, 0x59, 0xe5, 0xd3, 0x93,
+	0x7d, 0xe9, 0x4a, 0xbe, 0x15, 0xeb, 0x1e, 0x67, 0xdf, 0x7e, 0xac, 0xa6, 0x56, 0xdf, 0x2b, 0x00,
+	0x26, 0x49, 0x3b, 0xf2, 0xa4, 0x70, 0x0d, 0x2c, 0x26, 0x0b, 0x6e, 0x5f, 0xf7, 0xac, 0x94, 0x84,
+	0x4d, 0x0c, 0x55, 0x30, 0x8f, 0x30, 0x0e, 0x08, 0xe7, 0x71, 0xeb, 0xae, 0xb6, 0xf0, 0x11, 0x98,
+	0x1f, 0xa0, 0x51, 0x98, 0x47, 0xcd, 0xfc, 0xcd, 0x40, 0xaf, 0xd8, 0x51, 0xb0, 0xf5, 0x2f, 0x7f,
+	0x04, 0x8b, 0xd2, 0xc3, 0x87, 0xa0, 0xda, 0x32, 0xbb, 0x3d, 0xcb, 0x6c, 0xf4, 0x7b, 0xe6, 0xde,
+	0xae, 0xdd, 0xed, 0x3d, 0xed, 0xf5, 0xbb, 0x76, 0x7f, 0xb7, 0xdb, 0x69, 0x37, 0xcd, 0x6d, 0xb3,
+	0xdd, 0x5a, 0x4a, 0x95, 0x17, 0x0f, 0x8f, 0xb4, 0x7c, 0xdf, 0xe7, 0x63, 0xe2, 0xd0, 0x57, 0x94,
+	0x60, 0xb8, 0x35, 0x5b, 0x65, 0xee, 0xda, 0x1d, 0x6b, 0xef, 0x99, 0xd5, 0xee, 0x76, 0x97, 0x94,
+	0x72, 0xe9, 0xf0, 0x48, 0x03, 0xa6, 0xdf, 0x09, 0x98, 0x2b, 0x0f, 0xf0, 0x00, 0xac, 0xcc, 0x12,
+	0x35, 0xf7, 0x5e, 0x74, 0x9e, 0xb7, 0x7b, 0xed, 0xd6, 0x52, 0xba, 0x5c, 0x3c, 0x3c, 0xd2, 0x16,
+	0x9a, 0xcc, 0x1b, 0x8f, 0x88, 0x20, 0xb8, 0xe1, 0x9e, 0x9c, 0x57, 0x94, 0xd3, 0xf3, 0x8a, 0xf2,
+	0xf3, 0xbc, 0xa2, 0xbc, 0xbb, 0xa8, 0xa4, 0x4e, 0x2f, 0x2a, 0xa9, 0x6f, 0x17, 0x95, 0x14, 0xb8,
+	0x4d, 0xd9, 0xcc, 0x01, 0x75, 0x94, 0x97, 0x9b, 0x89, 0x5a, 0xdc, 0x50, 0x36, 0x28, 0x4b, 0xec,
+	0x8c, 0xd7, 0x57, 0x2f, 0x8f, 0xac, 0xc9, 0x20, 0x27, 0x5f, 0x84, 0xad, 0x5f, 0x03, 0x00, 0xe9,
+	0x3d, 0x55, 0x7d, 0x9b, 0x04, 0x00, 0x00,
+}
+
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.HoldersPaid != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HoldersPaid))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HolderCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RecordHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RecordHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalHolding.Size()
+		i -= size
+		if _, err := m.TotalHolding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.TotalHolding.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.RecordHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.RecordHeight))
+	}
+	if m.HolderCount != 0 {
+		n += 1 + sovDistribution(uint64(m.HolderCount))
+	}
+	if m.HoldersPaid != 0 {
+		n += 1 + sovDistribution(uint64(m.HoldersPaid))
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovDistribution(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DistributionHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovDistribution(uint64(m.DistributionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHolding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHeight", wireType)
+			}
+			m.RecordHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			m.HolderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HolderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersPaid", wireType)
+			}
+			m.HoldersPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DistributionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDistribution = fmt.Errorf("proto: unexpected end of group")
+)
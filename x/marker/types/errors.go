@@ -6,11 +6,18 @@ import (
 
 // x/marker module sentinel errors
 var (
-	ErrEmptyAccessGrantAddress = cerrs.Register(ModuleName, 2, "access grant address is empty")
-	ErrAccessTypeInvalid       = cerrs.Register(ModuleName, 3, "invalid access type")
-	ErrDuplicateAccessEntry    = cerrs.Register(ModuleName, 4, "access list contains duplicate entry")
-	ErrInvalidMarkerStatus     = cerrs.Register(ModuleName, 5, "invalid marker status")
-	ErrAccessTypeNotGranted    = cerrs.Register(ModuleName, 6, "access type not granted")
-	ErrMarkerNotFound          = cerrs.Register(ModuleName, 7, "marker not found")
-	ErrDuplicateEntry          = cerrs.Register(ModuleName, 8, "duplicate entry")
+	ErrEmptyAccessGrantAddress  = cerrs.Register(ModuleName, 2, "access grant address is empty")
+	ErrAccessTypeInvalid        = cerrs.Register(ModuleName, 3, "invalid access type")
+	ErrDuplicateAccessEntry     = cerrs.Register(ModuleName, 4, "access list contains duplicate entry")
+	ErrInvalidMarkerStatus      = cerrs.Register(ModuleName, 5, "invalid marker status")
+	ErrAccessTypeNotGranted     = cerrs.Register(ModuleName, 6, "access type not granted")
+	ErrMarkerNotFound           = cerrs.Register(ModuleName, 7, "marker not found")
+	ErrDuplicateEntry           = cerrs.Register(ModuleName, 8, "duplicate entry")
+	ErrDistributionNotFound     = cerrs.Register(ModuleName, 9, "distribution not found")
+	ErrLockupNotFound           = cerrs.Register(ModuleName, 10, "lockup not found")
+	ErrRedemptionNotFound       = cerrs.Register(ModuleName, 11, "redemption not found")
+	ErrTransferLimitNotFound    = cerrs.Register(ModuleName, 12, "transfer limit not found")
+	ErrRecoveryNotFound         = cerrs.Register(ModuleName, 13, "recovery not found")
+	ErrHolderLimitNotFound      = cerrs.Register(ModuleName, 14, "holder limit not found")
+	ErrIbcChannelPolicyNotFound = cerrs.Register(ModuleName, 15, "ibc channel policy not found")
 )
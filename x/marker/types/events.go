@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -81,6 +82,18 @@ func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrato
 	}
 }
 
+// NewEventMarkerAccessExpired returns a new instance of EventMarkerAccessExpired
+func NewEventMarkerAccessExpired(grant AccessGrant, denom string) *EventMarkerAccessExpired {
+	event := &EventMarkerAccessExpired{
+		Address: grant.Address,
+		Denom:   denom,
+	}
+	if grant.Expiration != nil {
+		event.Expiration = grant.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return event
+}
+
 func NewEventMarkerFinalize(denom string, administrator string) *EventMarkerFinalize {
 	return &EventMarkerFinalize{
 		Denom:         denom,
@@ -192,3 +205,260 @@ func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSup
 		MaxSupply:              maxSupply.String(),
 	}
 }
+
+// NewEventMarkerDistributionCreated returns a new instance of EventMarkerDistributionCreated
+func NewEventMarkerDistributionCreated(dist Distribution) *EventMarkerDistributionCreated {
+	return &EventMarkerDistributionCreated{
+		DistributionId: strconv.FormatUint(dist.Id, 10),
+		Denom:          dist.Denom,
+		Amount:         dist.Amount.String(),
+		Administrator:  dist.Administrator,
+		HolderCount:    strconv.FormatUint(dist.HolderCount, 10),
+		TotalHolding:   dist.TotalHolding.String(),
+	}
+}
+
+// NewEventMarkerDistributionProgress returns a new instance of EventMarkerDistributionProgress
+func NewEventMarkerDistributionProgress(dist Distribution) *EventMarkerDistributionProgress {
+	return &EventMarkerDistributionProgress{
+		DistributionId: strconv.FormatUint(dist.Id, 10),
+		Denom:          dist.Denom,
+		HoldersPaid:    strconv.FormatUint(dist.HoldersPaid, 10),
+		HolderCount:    strconv.FormatUint(dist.HolderCount, 10),
+	}
+}
+
+// NewEventMarkerDistributionCompleted returns a new instance of EventMarkerDistributionCompleted
+func NewEventMarkerDistributionCompleted(dist Distribution, remainder sdk.Coins) *EventMarkerDistributionCompleted {
+	return &EventMarkerDistributionCompleted{
+		DistributionId: strconv.FormatUint(dist.Id, 10),
+		Denom:          dist.Denom,
+		Distributed:    dist.Distributed.String(),
+		Remainder:      remainder.String(),
+	}
+}
+
+// NewEventMarkerLockupSet returns a new instance of EventMarkerLockupSet
+func NewEventMarkerLockupSet(lockup Lockup, locked sdkmath.Int, administrator string) *EventMarkerLockupSet {
+	return &EventMarkerLockupSet{
+		Denom:         lockup.Denom,
+		Address:       lockup.Address,
+		Locked:        sdk.NewCoin(lockup.Denom, locked).String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerLockupRemoved returns a new instance of EventMarkerLockupRemoved
+func NewEventMarkerLockupRemoved(denom, address, administrator string) *EventMarkerLockupRemoved {
+	return &EventMarkerLockupRemoved{
+		Denom:         denom,
+		Address:       address,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerSplit returns a new instance of EventMarkerSplit
+func NewEventMarkerSplit(denom string, numerator, denominator uint64, oldSupply, newSupply sdkmath.Int, holderCount uint64, administrator string) *EventMarkerSplit {
+	return &EventMarkerSplit{
+		Denom:         denom,
+		Numerator:     strconv.FormatUint(numerator, 10),
+		Denominator:   strconv.FormatUint(denominator, 10),
+		OldSupply:     sdk.NewCoin(denom, oldSupply).String(),
+		NewSupply:     sdk.NewCoin(denom, newSupply).String(),
+		HolderCount:   strconv.FormatUint(holderCount, 10),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionConfigSet returns a new instance of EventMarkerRedemptionConfigSet
+func NewEventMarkerRedemptionConfigSet(config RedemptionConfig, administrator string) *EventMarkerRedemptionConfigSet {
+	return &EventMarkerRedemptionConfigSet{
+		Denom:         config.Denom,
+		RedeemDenom:   config.RedeemDenom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionConfigRemoved returns a new instance of EventMarkerRedemptionConfigRemoved
+func NewEventMarkerRedemptionConfigRemoved(denom, administrator string) *EventMarkerRedemptionConfigRemoved {
+	return &EventMarkerRedemptionConfigRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionQueued returns a new instance of EventMarkerRedemptionQueued
+func NewEventMarkerRedemptionQueued(redemption PendingRedemption) *EventMarkerRedemptionQueued {
+	return &EventMarkerRedemptionQueued{
+		RedemptionId: strconv.FormatUint(redemption.Id, 10),
+		Denom:        redemption.Denom,
+		Redeemer:     redemption.Redeemer,
+		Amount:       sdk.NewCoin(redemption.Denom, redemption.Amount).String(),
+	}
+}
+
+// NewEventMarkerRedeemed returns a new instance of EventMarkerRedeemed
+func NewEventMarkerRedeemed(redemption PendingRedemption, payout sdk.Coins, administrator string) *EventMarkerRedeemed {
+	return &EventMarkerRedeemed{
+		RedemptionId:  strconv.FormatUint(redemption.Id, 10),
+		Denom:         redemption.Denom,
+		Redeemer:      redemption.Redeemer,
+		Amount:        sdk.NewCoin(redemption.Denom, redemption.Amount).String(),
+		Payout:        payout.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionRejected returns a new instance of EventMarkerRedemptionRejected
+func NewEventMarkerRedemptionRejected(redemption PendingRedemption, administrator string) *EventMarkerRedemptionRejected {
+	return &EventMarkerRedemptionRejected{
+		RedemptionId:  strconv.FormatUint(redemption.Id, 10),
+		Denom:         redemption.Denom,
+		Redeemer:      redemption.Redeemer,
+		Amount:        sdk.NewCoin(redemption.Denom, redemption.Amount).String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerTransferLimitSet returns a new instance of EventMarkerTransferLimitSet
+func NewEventMarkerTransferLimitSet(limit TransferLimit, administrator string) *EventMarkerTransferLimitSet {
+	return &EventMarkerTransferLimitSet{
+		Denom:         limit.Denom,
+		Address:       limit.Address,
+		Daily:         sdk.NewCoin(limit.Denom, limit.Daily).String(),
+		Weekly:        sdk.NewCoin(limit.Denom, limit.Weekly).String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerTransferLimitRemoved returns a new instance of EventMarkerTransferLimitRemoved
+func NewEventMarkerTransferLimitRemoved(denom, address, administrator string) *EventMarkerTransferLimitRemoved {
+	return &EventMarkerTransferLimitRemoved{
+		Denom:         denom,
+		Address:       address,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerHolderLimitSet returns a new instance of EventMarkerHolderLimitSet
+func NewEventMarkerHolderLimitSet(limit HolderLimit, administrator string) *EventMarkerHolderLimitSet {
+	return &EventMarkerHolderLimitSet{
+		Denom:         limit.Denom,
+		MaxHolders:    strconv.FormatUint(limit.MaxHolders, 10),
+		MinHolding:    sdk.NewCoin(limit.Denom, limit.MinHolding).String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerHolderLimitRemoved returns a new instance of EventMarkerHolderLimitRemoved
+func NewEventMarkerHolderLimitRemoved(denom, administrator string) *EventMarkerHolderLimitRemoved {
+	return &EventMarkerHolderLimitRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerAccountDataSchemaSet returns a new instance of EventMarkerAccountDataSchemaSet
+func NewEventMarkerAccountDataSchemaSet(denom, signer string) *EventMarkerAccountDataSchemaSet {
+	return &EventMarkerAccountDataSchemaSet{
+		Denom:  denom,
+		Signer: signer,
+	}
+}
+
+// NewEventMarkerAccountDataSchemaRemoved returns a new instance of EventMarkerAccountDataSchemaRemoved
+func NewEventMarkerAccountDataSchemaRemoved(denom, signer string) *EventMarkerAccountDataSchemaRemoved {
+	return &EventMarkerAccountDataSchemaRemoved{
+		Denom:  denom,
+		Signer: signer,
+	}
+}
+
+// NewEventMarkerIbcChannelPolicySet returns a new instance of EventMarkerIbcChannelPolicySet
+func NewEventMarkerIbcChannelPolicySet(policy IbcChannelPolicy, administrator string) *EventMarkerIbcChannelPolicySet {
+	return &EventMarkerIbcChannelPolicySet{
+		Denom:         policy.Denom,
+		Mode:          policy.Mode.String(),
+		Channels:      policy.Channels,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerIbcChannelPolicyRemoved returns a new instance of EventMarkerIbcChannelPolicyRemoved
+func NewEventMarkerIbcChannelPolicyRemoved(denom, administrator string) *EventMarkerIbcChannelPolicyRemoved {
+	return &EventMarkerIbcChannelPolicyRemoved{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerSendDenyAdded returns a new instance of EventMarkerSendDenyAdded
+func NewEventMarkerSendDenyAdded(denom string, entry DenySendAddress, administrator string) *EventMarkerSendDenyAdded {
+	rv := &EventMarkerSendDenyAdded{
+		Denom:         denom,
+		Address:       entry.DenyAddress,
+		Reason:        entry.Reason,
+		Administrator: administrator,
+	}
+	if entry.Expiration != nil {
+		rv.Expiration = entry.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventMarkerSendDenyRemoved returns a new instance of EventMarkerSendDenyRemoved
+func NewEventMarkerSendDenyRemoved(denom, address, administrator string) *EventMarkerSendDenyRemoved {
+	return &EventMarkerSendDenyRemoved{
+		Denom:         denom,
+		Address:       address,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerSendDenyExpired returns a new instance of EventMarkerSendDenyExpired
+func NewEventMarkerSendDenyExpired(denom string, entry DenySendAddress) *EventMarkerSendDenyExpired {
+	rv := &EventMarkerSendDenyExpired{
+		Denom:   denom,
+		Address: entry.DenyAddress,
+	}
+	if entry.Expiration != nil {
+		rv.Expiration = entry.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventMarkerRecoveryProposed returns a new instance of EventMarkerRecoveryProposed
+func NewEventMarkerRecoveryProposed(recovery Recovery) *EventMarkerRecoveryProposed {
+	return &EventMarkerRecoveryProposed{
+		RecoveryId:    strconv.FormatUint(recovery.Id, 10),
+		Denom:         recovery.Denom,
+		FromAddress:   recovery.FromAddress,
+		ToAddress:     recovery.ToAddress,
+		Amount:        sdk.NewCoin(recovery.Denom, recovery.Amount).String(),
+		Reason:        recovery.Reason,
+		ChallengeEnd:  recovery.ChallengeEnd.UTC().Format(time.RFC3339Nano),
+		Administrator: recovery.Proposer,
+	}
+}
+
+// NewEventMarkerRecoveryCancelled returns a new instance of EventMarkerRecoveryCancelled
+func NewEventMarkerRecoveryCancelled(recovery Recovery, signer string) *EventMarkerRecoveryCancelled {
+	return &EventMarkerRecoveryCancelled{
+		RecoveryId:  strconv.FormatUint(recovery.Id, 10),
+		Denom:       recovery.Denom,
+		FromAddress: recovery.FromAddress,
+		Signer:      signer,
+	}
+}
+
+// NewEventMarkerRecoveryExecuted returns a new instance of EventMarkerRecoveryExecuted
+func NewEventMarkerRecoveryExecuted(recovery Recovery, administrator string) *EventMarkerRecoveryExecuted {
+	return &EventMarkerRecoveryExecuted{
+		RecoveryId:    strconv.FormatUint(recovery.Id, 10),
+		Denom:         recovery.Denom,
+		FromAddress:   recovery.FromAddress,
+		ToAddress:     recovery.ToAddress,
+		Amount:        sdk.NewCoin(recovery.Denom, recovery.Amount).String(),
+		Administrator: administrator,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/quarantine"
 )
 
 // AccountKeeper defines the auth/account functionality needed by the marker keeper.
@@ -56,6 +57,17 @@ type FeeGrantKeeper interface {
 	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
 }
 
+// HoldKeeper defines the hold functionality needed by the marker module.
+type HoldKeeper interface {
+	GetHoldCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+}
+
+// QuarantineKeeper defines the quarantine functionality needed by the marker module.
+type QuarantineKeeper interface {
+	GetFundsHolder() sdk.AccAddress
+	IterateQuarantineRecords(ctx sdk.Context, toAddr sdk.AccAddress, cb func(toAddr, recordSuffix sdk.AccAddress, record *quarantine.QuarantineRecord) (stop bool))
+}
+
 // Note: There is no IBCKeeper interface in here.
 // The SendTransfer function takes in a checkRestrictionsHandler. That is defined in the
 // ibc keeper package. Furthermore, checkRestrictionsHandler takes in an IBC Keeper anyway.
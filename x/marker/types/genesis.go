@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -30,6 +31,120 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, entry := range state.DenySendAddresses {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	distributions := make(map[uint64]bool, len(state.Distributions))
+	for _, dist := range state.Distributions {
+		if err := dist.Validate(); err != nil {
+			return err
+		}
+		if distributions[dist.Id] {
+			return fmt.Errorf("duplicate distribution id %d", dist.Id)
+		}
+		distributions[dist.Id] = true
+	}
+	for _, holder := range state.DistributionHolders {
+		if err := holder.Validate(); err != nil {
+			return err
+		}
+		if !distributions[holder.DistributionId] {
+			return fmt.Errorf("distribution holder %s references unknown distribution %d", holder.Address, holder.DistributionId)
+		}
+	}
+	for _, usage := range state.SupplyAllowanceUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+	}
+	lockups := make(map[string]bool, len(state.Lockups))
+	for _, lockup := range state.Lockups {
+		if err := lockup.Validate(); err != nil {
+			return err
+		}
+		key := lockup.Denom + "/" + lockup.Address
+		if lockups[key] {
+			return fmt.Errorf("duplicate %s lockup for %s", lockup.Denom, lockup.Address)
+		}
+		lockups[key] = true
+	}
+	redemptionConfigs := make(map[string]bool, len(state.RedemptionConfigs))
+	for _, config := range state.RedemptionConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if redemptionConfigs[config.Denom] {
+			return fmt.Errorf("duplicate %s redemption config", config.Denom)
+		}
+		redemptionConfigs[config.Denom] = true
+	}
+	for _, redemption := range state.PendingRedemptions {
+		if err := redemption.Validate(); err != nil {
+			return err
+		}
+		if redemption.Id > state.LastRedemptionId {
+			return fmt.Errorf("pending redemption id %d is greater than the last redemption id %d", redemption.Id, state.LastRedemptionId)
+		}
+	}
+	for _, recovery := range state.Recoveries {
+		if err := recovery.Validate(); err != nil {
+			return err
+		}
+		if recovery.Id > state.LastRecoveryId {
+			return fmt.Errorf("recovery id %d is greater than the last recovery id %d", recovery.Id, state.LastRecoveryId)
+		}
+	}
+	transferLimits := make(map[string]bool, len(state.TransferLimits))
+	for _, limit := range state.TransferLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		key := limit.Denom + "/" + limit.Address
+		if transferLimits[key] {
+			if limit.IsDefault() {
+				return fmt.Errorf("duplicate %s default transfer limit", limit.Denom)
+			}
+			return fmt.Errorf("duplicate %s transfer limit for %s", limit.Denom, limit.Address)
+		}
+		transferLimits[key] = true
+	}
+	for _, usage := range state.TransferUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+	}
+	holderLimits := make(map[string]bool, len(state.HolderLimits))
+	for _, limit := range state.HolderLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if holderLimits[limit.Denom] {
+			return fmt.Errorf("duplicate %s holder limit", limit.Denom)
+		}
+		holderLimits[limit.Denom] = true
+	}
+	schemas := make(map[string]bool, len(state.AccountDataSchemas))
+	for _, schema := range state.AccountDataSchemas {
+		if err := schema.Validate(); err != nil {
+			return err
+		}
+		if schemas[schema.Denom] {
+			return fmt.Errorf("duplicate %s account data schema", schema.Denom)
+		}
+		schemas[schema.Denom] = true
+	}
+	policies := make(map[string]bool, len(state.IbcChannelPolicies))
+	for _, policy := range state.IbcChannelPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if policies[policy.Denom] {
+			return fmt.Errorf("duplicate %s ibc channel policy", policy.Denom)
+		}
+		policies[policy.Denom] = true
+	}
 
 	return nil
 }
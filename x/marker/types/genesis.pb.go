@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,34 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of marker distributions
+	Distributions []Distribution `protobuf:"bytes,5,rep,name=distributions,proto3" json:"distributions"`
+	// list of holder balances awaiting payment from a distribution
+	DistributionHolders []DistributionHolder `protobuf:"bytes,6,rep,name=distribution_holders,json=distributionHolders,proto3" json:"distribution_holders"`
+	// list of amounts used against mint and burn allowances
+	SupplyAllowanceUsages []SupplyAllowanceUsage `protobuf:"bytes,7,rep,name=supply_allowance_usages,json=supplyAllowanceUsages,proto3" json:"supply_allowance_usages"`
+	// list of account lockups of marker denoms
+	Lockups []Lockup `protobuf:"bytes,8,rep,name=lockups,proto3" json:"lockups"`
+	// list of marker redemption configurations
+	RedemptionConfigs []RedemptionConfig `protobuf:"bytes,9,rep,name=redemption_configs,json=redemptionConfigs,proto3" json:"redemption_configs"`
+	// list of redemptions awaiting approval
+	PendingRedemptions []PendingRedemption `protobuf:"bytes,10,rep,name=pending_redemptions,json=pendingRedemptions,proto3" json:"pending_redemptions"`
+	// the last redemption id that was assigned
+	LastRedemptionId uint64 `protobuf:"varint,11,opt,name=last_redemption_id,json=lastRedemptionId,proto3" json:"last_redemption_id,omitempty"`
+	// list of marker transfer limits, both defaults and account specific
+	TransferLimits []TransferLimit `protobuf:"bytes,12,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// list of recent amounts sent by accounts with transfer limits
+	TransferUsages []TransferUsage `protobuf:"bytes,13,rep,name=transfer_usages,json=transferUsages,proto3" json:"transfer_usages"`
+	// list of proposed recoveries
+	Recoveries []Recovery `protobuf:"bytes,14,rep,name=recoveries,proto3" json:"recoveries"`
+	// the last recovery id that was assigned
+	LastRecoveryId uint64 `protobuf:"varint,15,opt,name=last_recovery_id,json=lastRecoveryId,proto3" json:"last_recovery_id,omitempty"`
+	// the holder limits of markers
+	HolderLimits []HolderLimit `protobuf:"bytes,16,rep,name=holder_limits,json=holderLimits,proto3" json:"holder_limits"`
+	// the account data schemas of markers
+	AccountDataSchemas []AccountDataSchema `protobuf:"bytes,17,rep,name=account_data_schemas,json=accountDataSchemas,proto3" json:"account_data_schemas"`
+	// the IBC channel policies of markers
+	IbcChannelPolicies []IbcChannelPolicy `protobuf:"bytes,18,rep,name=ibc_channel_policies,json=ibcChannelPolicies,proto3" json:"ibc_channel_policies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +106,14 @@ type DenySendAddress struct {
 	MarkerAddress string `protobuf:"bytes,1,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty"`
 	// deny_address defines all wallet addresses that are denied sends for the marker
 	DenyAddress string `protobuf:"bytes,2,opt,name=deny_address,json=denyAddress,proto3" json:"deny_address,omitempty"`
+	// reason is why the address was denied.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// added_by is the address that added the entry. Empty for entries added before this was recorded.
+	AddedBy string `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	// added_at is the block time when the entry was added. Empty for entries added before this was recorded.
+	AddedAt *time.Time `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at,omitempty"`
+	// expiration is when the entry is automatically removed. If empty, the entry remains until it is removed.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *DenySendAddress) Reset()         { *m = DenySendAddress{} }
@@ -161,33 +201,66 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x41, 0x73, 0x1b, 0x35,
+	0x14, 0xc7, 0xed, 0xc4, 0xb5, 0x53, 0x39, 0x71, 0x52, 0xc5, 0x50, 0x91, 0x61, 0xec, 0x24, 0x9d,
+	0xb4, 0x86, 0x01, 0x7b, 0x1a, 0x6e, 0x85, 0x03, 0x4e, 0x32, 0x03, 0x99, 0x09, 0x9d, 0x8c, 0x5d,
+	0x38, 0xb4, 0x33, 0xec, 0xc8, 0x2b, 0x65, 0xad, 0xe9, 0x5a, 0xda, 0xd9, 0x27, 0x9b, 0xfa, 0x1b,
+	0x70, 0xa3, 0x37, 0xae, 0xfd, 0x26, 0x5c, 0x7b, 0xec, 0x91, 0x13, 0x30, 0xc9, 0x85, 0x8f, 0xc1,
+	0xac, 0xa4, 0xc5, 0xeb, 0xb0, 0x5e, 0x7a, 0x5b, 0xbd, 0xf7, 0xfb, 0xff, 0x65, 0x3f, 0x3d, 0xe9,
+	0xa1, 0xc3, 0x28, 0x56, 0x33, 0x2e, 0xa9, 0xf4, 0x79, 0x6f, 0x42, 0xe3, 0x97, 0x3c, 0xee, 0xcd,
+	0x1e, 0xf7, 0x02, 0x2e, 0x39, 0x08, 0xe8, 0x46, 0xb1, 0xd2, 0x0a, 0x37, 0x17, 0x4c, 0xd7, 0x32,
+	0xdd, 0xd9, 0xe3, 0xbd, 0x66, 0xa0, 0x02, 0x65, 0x80, 0x5e, 0xf2, 0x65, 0xd9, 0xbd, 0x47, 0xb9,
+	0x7e, 0xd4, 0xf7, 0xd5, 0x54, 0x6a, 0x8f, 0x51, 0x4d, 0x1d, 0xd8, 0x0e, 0x94, 0x0a, 0x42, 0xde,
+	0x33, 0xab, 0xd1, 0xf4, 0xaa, 0xa7, 0xc5, 0x84, 0x83, 0xa6, 0x93, 0xc8, 0x01, 0x0f, 0x57, 0x39,
+	0x71, 0x80, 0x20, 0xa6, 0x52, 0x17, 0xee, 0xc8, 0x04, 0xe8, 0x58, 0x8c, 0xa6, 0x5a, 0x28, 0x59,
+	0x08, 0x8e, 0x55, 0xc8, 0x78, 0xec, 0x85, 0x62, 0x22, 0x52, 0xc7, 0xa3, 0x5c, 0x50, 0x8c, 0x7c,
+	0x2f, 0x52, 0xa1, 0xf0, 0xe7, 0x0e, 0x3b, 0xc8, 0xc5, 0x42, 0xe5, 0xbf, 0x9c, 0x46, 0x85, 0x88,
+	0xfd, 0x72, 0xc8, 0x83, 0x5c, 0x24, 0xe6, 0xbe, 0x9a, 0xf1, 0x78, 0x5e, 0xf8, 0x8b, 0x62, 0xce,
+	0xf8, 0x24, 0xca, 0xfc, 0xc3, 0x4f, 0x72, 0x31, 0x1d, 0x53, 0x09, 0x57, 0xcb, 0xff, 0xf1, 0xf0,
+	0xb7, 0x3a, 0xda, 0xfc, 0xc6, 0x9e, 0xf2, 0x50, 0x53, 0xcd, 0xf1, 0x13, 0x54, 0x8d, 0x68, 0x4c,
+	0x27, 0x40, 0xca, 0xfb, 0xe5, 0x4e, 0xfd, 0xf8, 0xe3, 0x6e, 0xde, 0xa9, 0x77, 0x2f, 0x0d, 0x73,
+	0x52, 0x79, 0xfb, 0x47, 0xbb, 0x34, 0x70, 0x0a, 0x7c, 0x8a, 0x6a, 0x96, 0x00, 0xb2, 0xb6, 0xbf,
+	0xde, 0xa9, 0x1f, 0x3f, 0xc8, 0x17, 0x7f, 0x67, 0xbe, 0xfa, 0xb6, 0x19, 0x9c, 0x47, 0xaa, 0xc4,
+	0xcf, 0xd1, 0x8e, 0xe4, 0xda, 0xa3, 0x00, 0x5c, 0x7b, 0x33, 0x1a, 0x4e, 0x39, 0x90, 0x75, 0xe3,
+	0xf6, 0x69, 0x91, 0xdb, 0x53, 0xae, 0xfb, 0x89, 0xe4, 0x07, 0xa3, 0x70, 0xa6, 0x0d, 0xb9, 0x14,
+	0xc5, 0x2f, 0xd0, 0x2e, 0xe3, 0x72, 0xee, 0x01, 0x97, 0xcc, 0xa3, 0x8c, 0xc5, 0x1c, 0x80, 0x03,
+	0xa9, 0x18, 0xfb, 0xa3, 0x7c, 0xfb, 0x33, 0x2e, 0xe7, 0x43, 0x2e, 0x59, 0xdf, 0xe2, 0xce, 0xf9,
+	0x1e, 0x5b, 0x0e, 0x73, 0xc0, 0x4f, 0xd1, 0x56, 0xb6, 0xdb, 0x80, 0xdc, 0x31, 0xb6, 0x87, 0x2b,
+	0x6c, 0x33, 0xa8, 0xf3, 0x5c, 0x96, 0x63, 0x8a, 0x9a, 0xd9, 0x80, 0x67, 0x3b, 0x14, 0x48, 0xd5,
+	0xd8, 0x76, 0xfe, 0xdf, 0xf6, 0x5b, 0x23, 0x70, 0xe6, 0xbb, 0xec, 0x3f, 0x19, 0xc0, 0x63, 0x74,
+	0x1f, 0xa6, 0x51, 0x14, 0xce, 0x3d, 0x1a, 0x86, 0xea, 0xa7, 0xc4, 0xcb, 0x9b, 0x02, 0x0d, 0x38,
+	0x90, 0x5a, 0x51, 0xc9, 0x87, 0x46, 0xd4, 0x4f, 0x35, 0xdf, 0x27, 0x12, 0xb7, 0xcf, 0x07, 0x90,
+	0x93, 0x03, 0xfc, 0x15, 0xaa, 0xd9, 0x1b, 0x01, 0x64, 0x63, 0x7f, 0x7d, 0x75, 0x5f, 0x5d, 0x18,
+	0x28, 0xed, 0x09, 0x27, 0xc1, 0x2f, 0x10, 0x5e, 0x34, 0xb9, 0xe7, 0x2b, 0x79, 0x25, 0x02, 0x20,
+	0x77, 0x8d, 0xd1, 0xc3, 0x7c, 0xa3, 0xc1, 0xbf, 0xfc, 0xa9, 0xc1, 0xd3, 0x73, 0x8b, 0x6f, 0xc5,
+	0x01, 0xff, 0x88, 0x76, 0x23, 0x2e, 0x99, 0x90, 0x81, 0xb7, 0x48, 0x02, 0x41, 0xc6, 0xfd, 0xd1,
+	0x8a, 0xf6, 0xb7, 0x82, 0xc5, 0x26, 0xce, 0x1e, 0x47, 0xb7, 0x13, 0x80, 0x3f, 0x43, 0x38, 0xa4,
+	0xa0, 0x33, 0xe6, 0x9e, 0x60, 0xa4, 0xbe, 0x5f, 0xee, 0x54, 0x06, 0x3b, 0x49, 0x66, 0x01, 0x9f,
+	0x33, 0x3c, 0x40, 0xdb, 0xcb, 0x17, 0x15, 0xc8, 0x66, 0xd1, 0x5d, 0x7a, 0xe6, 0xe0, 0x8b, 0x84,
+	0x4d, 0xdb, 0x5e, 0x67, 0x83, 0xb0, 0xe4, 0xe9, 0x8e, 0x77, 0xeb, 0x7d, 0x3c, 0xb3, 0xe7, 0xda,
+	0xd0, 0xd9, 0x20, 0xe0, 0x33, 0x84, 0xdc, 0xe3, 0x24, 0x38, 0x90, 0x86, 0xb1, 0x6b, 0xad, 0x3a,
+	0x0a, 0xfb, 0x88, 0x39, 0xa7, 0x8c, 0x0e, 0x77, 0xd0, 0x8e, 0xab, 0x8d, 0x45, 0x92, 0xca, 0x6c,
+	0x9b, 0xca, 0x34, 0x6c, 0x65, 0x6c, 0xf8, 0x9c, 0xe1, 0x0b, 0xb4, 0x95, 0x7d, 0xa2, 0x81, 0xec,
+	0x98, 0x2d, 0x0f, 0xf2, 0xb7, 0xb4, 0x0d, 0x9e, 0xad, 0xc9, 0xe6, 0x78, 0x11, 0x02, 0xec, 0xa1,
+	0x66, 0x76, 0x16, 0x79, 0xe0, 0x8f, 0xf9, 0x84, 0x02, 0xb9, 0x57, 0x74, 0xe8, 0xee, 0xc1, 0x3a,
+	0xa3, 0x9a, 0x0e, 0x0d, 0x9f, 0x1e, 0x3a, 0xbd, 0x9d, 0x48, 0x9a, 0xaa, 0x99, 0x0c, 0x0a, 0x7f,
+	0x4c, 0xa5, 0xe4, 0xa1, 0x1d, 0x18, 0x49, 0xa1, 0x70, 0x51, 0xcf, 0x9e, 0x8f, 0xfc, 0x53, 0x2b,
+	0xb8, 0x34, 0x03, 0x26, 0xf5, 0x17, 0xcb, 0x71, 0xc1, 0xe1, 0xc9, 0xc6, 0xcf, 0x6f, 0xda, 0xa5,
+	0xbf, 0xdf, 0xb4, 0x4b, 0x87, 0xbf, 0xae, 0xa1, 0xed, 0x5b, 0x6f, 0x14, 0x3e, 0x42, 0x0d, 0xeb,
+	0x9a, 0x3e, 0x72, 0xe6, 0x31, 0xbf, 0x3b, 0xd8, 0xb2, 0xd1, 0x14, 0x3b, 0x40, 0x9b, 0xe6, 0x39,
+	0x4c, 0xa1, 0x35, 0x03, 0xd5, 0x93, 0x58, 0x8a, 0x7c, 0x88, 0xaa, 0x31, 0xa7, 0xa0, 0x24, 0x59,
+	0x37, 0x49, 0xb7, 0xc2, 0x1f, 0xa1, 0x0d, 0xca, 0x18, 0x67, 0xde, 0x68, 0x4e, 0x2a, 0x26, 0x53,
+	0x33, 0xeb, 0x93, 0x39, 0xfe, 0x32, 0x4d, 0x51, 0x4d, 0xee, 0x98, 0x19, 0xb2, 0xd7, 0xb5, 0x43,
+	0xbe, 0x9b, 0x0e, 0xf9, 0xee, 0xb3, 0x74, 0xc8, 0x9f, 0x54, 0x5e, 0xff, 0xd9, 0x2e, 0x3b, 0x71,
+	0x5f, 0xe3, 0xaf, 0x11, 0xe2, 0xaf, 0x22, 0x11, 0xd3, 0xe4, 0x3a, 0x90, 0xea, 0x7b, 0xca, 0x33,
+	0x9a, 0x4c, 0x65, 0x7e, 0x29, 0xa3, 0x66, 0xde, 0x70, 0xc0, 0x04, 0xd5, 0x96, 0xeb, 0x92, 0x2e,
+	0xf1, 0x30, 0x67, 0xf8, 0x14, 0x8e, 0xb2, 0x25, 0xe7, 0xfc, 0xa9, 0xb3, 0xf8, 0x45, 0x27, 0xc1,
+	0xdb, 0xeb, 0x56, 0xf9, 0xdd, 0x75, 0xab, 0xfc, 0xd7, 0x75, 0xab, 0xfc, 0xfa, 0xa6, 0x55, 0x7a,
+	0x77, 0xd3, 0x2a, 0xfd, 0x7e, 0xd3, 0x2a, 0xa1, 0xfb, 0x42, 0xe5, 0x6e, 0x70, 0x59, 0x7e, 0x7e,
+	0x1c, 0x08, 0x3d, 0x9e, 0x8e, 0xba, 0xbe, 0x9a, 0xf4, 0x16, 0xc8, 0xe7, 0x42, 0x65, 0x56, 0xbd,
+	0x57, 0xe9, 0xa0, 0xd7, 0xf3, 0x88, 0xc3, 0xa8, 0x6a, 0x4a, 0xf5, 0xc5, 0x3f, 0x03, 0x00, 0xc6,
+	0x07, 0xe5, 0xab, 0xd7, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +283,190 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcChannelPolicies) > 0 {
+		for iNdEx := len(m.IbcChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AccountDataSchemas) > 0 {
+		for iNdEx := len(m.AccountDataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountDataSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.HolderLimits) > 0 {
+		for iNdEx := len(m.HolderLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.LastRecoveryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRecoveryId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Recoveries) > 0 {
+		for iNdEx := len(m.Recoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for iNdEx := len(m.TransferUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastRedemptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRedemptionId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PendingRedemptions) > 0 {
+		for iNdEx := len(m.PendingRedemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRedemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RedemptionConfigs) > 0 {
+		for iNdEx := len(m.RedemptionConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SupplyAllowanceUsages) > 0 {
+		for iNdEx := len(m.SupplyAllowanceUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyAllowanceUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for iNdEx := len(m.DistributionHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -285,6 +542,40 @@ func (m *DenySendAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AddedAt != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGenesis(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DenyAddress) > 0 {
 		i -= len(m.DenyAddress)
 		copy(dAtA[i:], m.DenyAddress)
@@ -383,6 +674,84 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for _, e := range m.DistributionHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyAllowanceUsages) > 0 {
+		for _, e := range m.SupplyAllowanceUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionConfigs) > 0 {
+		for _, e := range m.RedemptionConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRedemptions) > 0 {
+		for _, e := range m.PendingRedemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRedemptionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRedemptionId))
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for _, e := range m.TransferUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Recoveries) > 0 {
+		for _, e := range m.Recoveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRecoveryId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRecoveryId))
+	}
+	if len(m.HolderLimits) > 0 {
+		for _, e := range m.HolderLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountDataSchemas) > 0 {
+		for _, e := range m.AccountDataSchemas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcChannelPolicies) > 0 {
+		for _, e := range m.IbcChannelPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -400,6 +769,22 @@ func (m *DenySendAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.AddedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedAt)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -592,61 +977,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -656,16 +991,512 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionHolders = append(m.DistributionHolders, DistributionHolder{})
+			if err := m.DistributionHolders[len(m.DistributionHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyAllowanceUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyAllowanceUsages = append(m.SupplyAllowanceUsages, SupplyAllowanceUsage{})
+			if err := m.SupplyAllowanceUsages[len(m.SupplyAllowanceUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lockups = append(m.Lockups, Lockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionConfigs = append(m.RedemptionConfigs, RedemptionConfig{})
+			if err := m.RedemptionConfigs[len(m.RedemptionConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRedemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRedemptions = append(m.PendingRedemptions, PendingRedemption{})
+			if err := m.PendingRedemptions[len(m.PendingRedemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionId", wireType)
+			}
+			m.LastRedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimit{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferUsages = append(m.TransferUsages, TransferUsage{})
+			if err := m.TransferUsages[len(m.TransferUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoveries = append(m.Recoveries, Recovery{})
+			if err := m.Recoveries[len(m.Recoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecoveryId", wireType)
+			}
+			m.LastRecoveryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecoveryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderLimits = append(m.HolderLimits, HolderLimit{})
+			if err := m.HolderLimits[len(m.HolderLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDataSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountDataSchemas = append(m.AccountDataSchemas, AccountDataSchema{})
+			if err := m.AccountDataSchemas[len(m.AccountDataSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannelPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannelPolicies = append(m.IbcChannelPolicies, IbcChannelPolicy{})
+			if err := m.IbcChannelPolicies[len(m.IbcChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
@@ -706,6 +1537,142 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.DenyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddedAt == nil {
+				m.AddedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHolderLimit creates a new HolderLimit.
+func NewHolderLimit(denom string, maxHolders uint64, minHolding sdkmath.Int) HolderLimit {
+	return HolderLimit{
+		Denom:      denom,
+		MaxHolders: maxHolders,
+		MinHolding: minHolding,
+	}
+}
+
+// Validate returns an error if the holder limit is not well formed.
+func (l HolderLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid holder limit denom: %w", err)
+	}
+	if l.MinHolding.IsNil() || l.MinHolding.IsNegative() {
+		return fmt.Errorf("minimum holding cannot be negative")
+	}
+	if l.MaxHolders == 0 && l.MinHolding.IsZero() {
+		return fmt.Errorf("holder limit must have a maximum holder count or a minimum holding")
+	}
+	return nil
+}
+
+// CheckHolding returns an error if an account would be left with a balance that is positive but below the minimum.
+func (l HolderLimit) CheckHolding(addr sdk.AccAddress, balance sdkmath.Int) error {
+	if l.MinHolding.IsPositive() && balance.IsPositive() && balance.LT(l.MinHolding) {
+		return fmt.Errorf("%s would hold %s, less than the minimum holding of %s",
+			addr, sdk.NewCoin(l.Denom, balance), sdk.NewCoin(l.Denom, l.MinHolding))
+	}
+	return nil
+}
+
+// CheckHolderCount returns an error if the number of holders would be more than the maximum.
+func (l HolderLimit) CheckHolderCount(count uint64) error {
+	if l.MaxHolders > 0 && count > l.MaxHolders {
+		return fmt.Errorf("%s cannot have more than %d holders", l.Denom, l.MaxHolders)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHolderLimitValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  HolderLimit
+		expErr string
+	}{
+		{name: "max holders only", limit: NewHolderLimit("fundshare", 99, sdkmath.ZeroInt())},
+		{name: "min holding only", limit: NewHolderLimit("fundshare", 0, sdkmath.NewInt(1000))},
+		{name: "both", limit: NewHolderLimit("fundshare", 99, sdkmath.NewInt(1000))},
+		{
+			name:   "invalid denom",
+			limit:  NewHolderLimit("x", 99, sdkmath.ZeroInt()),
+			expErr: "invalid holder limit denom: invalid denom: x",
+		},
+		{
+			name:   "nil min holding",
+			limit:  HolderLimit{Denom: "fundshare", MaxHolders: 99},
+			expErr: "minimum holding cannot be negative",
+		},
+		{
+			name:   "negative min holding",
+			limit:  NewHolderLimit("fundshare", 99, sdkmath.NewInt(-1)),
+			expErr: "minimum holding cannot be negative",
+		},
+		{
+			name:   "no limits",
+			limit:  NewHolderLimit("fundshare", 0, sdkmath.ZeroInt()),
+			expErr: "holder limit must have a maximum holder count or a minimum holding",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestHolderLimitChecks(t *testing.T) {
+	addr := sdk.AccAddress("holder______________")
+	limit := NewHolderLimit("fundshare", 2, sdkmath.NewInt(100))
+
+	require.NoError(t, limit.CheckHolding(addr, sdkmath.ZeroInt()), "CheckHolding of nothing")
+	require.NoError(t, limit.CheckHolding(addr, sdkmath.NewInt(100)), "CheckHolding of the minimum")
+	require.EqualError(t, limit.CheckHolding(addr, sdkmath.NewInt(99)),
+		addr.String()+" would hold 99fundshare, less than the minimum holding of 100fundshare", "CheckHolding below the minimum")
+	require.NoError(t, limit.CheckHolderCount(2), "CheckHolderCount of the maximum")
+	require.EqualError(t, limit.CheckHolderCount(3), "fundshare cannot have more than 2 holders", "CheckHolderCount above the maximum")
+
+	noMax := NewHolderLimit("fundshare", 0, sdkmath.NewInt(100))
+	require.NoError(t, noMax.CheckHolderCount(1000), "CheckHolderCount without a maximum")
+
+	require.Equal(t, "50", limit.Split(1, 2).MinHolding.String(), "min holding after a reverse split")
+	require.Equal(t, "1", limit.Split(1, 1000).MinHolding.String(), "min holding after a split that would round it to zero")
+	require.Equal(t, uint64(2), limit.Split(2, 1).MaxHolders, "max holders after a split")
+}
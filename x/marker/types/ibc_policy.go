@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewIbcChannelPolicy creates a new IbcChannelPolicy.
+func NewIbcChannelPolicy(denom string, mode IbcChannelPolicyMode, channels []string, caps []IbcChannelCap) IbcChannelPolicy {
+	return IbcChannelPolicy{
+		Denom:    denom,
+		Mode:     mode,
+		Channels: channels,
+		Caps:     caps,
+	}
+}
+
+// NewIbcChannelCap creates a new IbcChannelCap.
+func NewIbcChannelCap(channelID string, amount sdkmath.Int) IbcChannelCap {
+	return IbcChannelCap{ChannelId: channelID, Amount: amount}
+}
+
+// Validate returns an error if the IBC channel policy is not well formed.
+func (p IbcChannelPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid ibc channel policy denom: %w", err)
+	}
+	if p.Mode != IbcChannelPolicyMode_Allowlist && p.Mode != IbcChannelPolicyMode_Denylist {
+		return fmt.Errorf("invalid ibc channel policy mode: %s", p.Mode)
+	}
+	seen := make(map[string]bool, len(p.Channels))
+	for _, channel := range p.Channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid ibc channel policy channel: %w", err)
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate ibc channel policy channel %s", channel)
+		}
+		seen[channel] = true
+	}
+	// Caps are measured by what's in a channel's escrow account, but IBC vouchers sent out are burned, not escrowed.
+	if len(p.Caps) > 0 && strings.HasPrefix(p.Denom, "ibc/") {
+		return fmt.Errorf("ibc channel caps cannot be used for ibc denom %s", p.Denom)
+	}
+	capped := make(map[string]bool, len(p.Caps))
+	for _, c := range p.Caps {
+		if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+			return fmt.Errorf("invalid ibc channel cap channel: %w", err)
+		}
+		if capped[c.ChannelId] {
+			return fmt.Errorf("duplicate ibc channel cap for %s", c.ChannelId)
+		}
+		capped[c.ChannelId] = true
+		if c.Amount.IsNil() || !c.Amount.IsPositive() {
+			return fmt.Errorf("ibc channel cap for %s must be positive", c.ChannelId)
+		}
+		if !p.AllowsChannel(c.ChannelId) {
+			return fmt.Errorf("ibc channel cap for %s is not allowed by the policy", c.ChannelId)
+		}
+	}
+	return nil
+}
+
+// AllowsChannel returns true if the denom can be transferred over the channel.
+func (p IbcChannelPolicy) AllowsChannel(channelID string) bool {
+	listed := false
+	for _, channel := range p.Channels {
+		if channel == channelID {
+			listed = true
+			break
+		}
+	}
+	return listed == (p.Mode == IbcChannelPolicyMode_Allowlist)
+}
+
+// GetCap returns the cap of the channel, or nil if the channel isn't capped.
+func (p IbcChannelPolicy) GetCap(channelID string) *IbcChannelCap {
+	for i := range p.Caps {
+		if p.Caps[i].ChannelId == channelID {
+			return &p.Caps[i]
+		}
+	}
+	return nil
+}
+
+// CheckTransfer returns an error if the denom cannot be transferred out over the channel, or if transferring the
+// amount would leave more than the channel's cap escrowed.
+func (p IbcChannelPolicy) CheckTransfer(channelID string, escrowed, amount sdkmath.Int) error {
+	if !p.AllowsChannel(channelID) {
+		return fmt.Errorf("%s cannot be transferred over ibc channel %s", p.Denom, channelID)
+	}
+	if c := p.GetCap(channelID); c != nil && escrowed.Add(amount).GT(c.Amount) {
+		return fmt.Errorf("cannot transfer %s%s over ibc channel %s: it would exceed the channel cap of %s%s (%s%s already out)",
+			amount, p.Denom, channelID, c.Amount, p.Denom, escrowed, p.Denom)
+	}
+	return nil
+}
+
+// CheckReceive returns an error if the denom cannot be received over the channel.
+func (p IbcChannelPolicy) CheckReceive(channelID string) error {
+	if !p.AllowsChannel(channelID) {
+		return fmt.Errorf("%s cannot be received over ibc channel %s", p.Denom, channelID)
+	}
+	return nil
+}
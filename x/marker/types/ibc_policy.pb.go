@@ -0,0 +1,713 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/ibc_policy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IbcChannelPolicyMode defines how the channels of an IbcChannelPolicy are used.
+type IbcChannelPolicyMode int32
+
+const (
+	// IBC_CHANNEL_POLICY_MODE_UNSPECIFIED is an invalid mode.
+	IbcChannelPolicyMode_Unspecified IbcChannelPolicyMode = 0
+	// IBC_CHANNEL_POLICY_MODE_ALLOWLIST only allows transfers over the listed channels.
+	IbcChannelPolicyMode_Allowlist IbcChannelPolicyMode = 1
+	// IBC_CHANNEL_POLICY_MODE_DENYLIST allows transfers over every channel except the listed ones.
+	IbcChannelPolicyMode_Denylist IbcChannelPolicyMode = 2
+)
+
+var IbcChannelPolicyMode_name = map[int32]string{
+	0: "IBC_CHANNEL_POLICY_MODE_UNSPECIFIED",
+	1: "IBC_CHANNEL_POLICY_MODE_ALLOWLIST",
+	2: "IBC_CHANNEL_POLICY_MODE_DENYLIST",
+}
+
+var IbcChannelPolicyMode_value = map[string]int32{
+	"IBC_CHANNEL_POLICY_MODE_UNSPECIFIED": 0,
+	"IBC_CHANNEL_POLICY_MODE_ALLOWLIST":   1,
+	"IBC_CHANNEL_POLICY_MODE_DENYLIST":    2,
+}
+
+func (x IbcChannelPolicyMode) String() string {
+	return proto.EnumName(IbcChannelPolicyMode_name, int32(x))
+}
+
+func (IbcChannelPolicyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_69e7c507d7fdfa1a, []int{0}
+}
+
+// IbcChannelPolicy restricts which IBC channels (on the transfer port) a restricted marker's denom can move over.
+type IbcChannelPolicy struct {
+	// denom is the marker denom that the policy applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// mode defines whether the channels are the only ones allowed, or the ones denied.
+	Mode IbcChannelPolicyMode `protobuf:"varint,2,opt,name=mode,proto3,enum=provenance.marker.v1.IbcChannelPolicyMode" json:"mode,omitempty"`
+	// channels are the ids of the listed channels, e.g. "channel-0".
+	Channels []string `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	// caps are the optional limits on how much of the denom can be out over an allowed channel at once (not for ibc/ denoms).
+	Caps []IbcChannelCap `protobuf:"bytes,4,rep,name=caps,proto3" json:"caps"`
+}
+
+func (m *IbcChannelPolicy) Reset()         { *m = IbcChannelPolicy{} }
+func (m *IbcChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*IbcChannelPolicy) ProtoMessage()    {}
+func (*IbcChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e7c507d7fdfa1a, []int{0}
+}
+func (m *IbcChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcChannelPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcChannelPolicy.Merge(m, src)
+}
+func (m *IbcChannelPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcChannelPolicy proto.InternalMessageInfo
+
+// IbcChannelCap limits how much of a marker's denom can be held in a channel's escrow account,
+// i.e. how much has been sent out over the channel and not yet come back.
+type IbcChannelCap struct {
+	// channel_id is the id of the channel that is capped.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// amount is the most of the denom that can be escrowed for the channel.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *IbcChannelCap) Reset()         { *m = IbcChannelCap{} }
+func (m *IbcChannelCap) String() string { return proto.CompactTextString(m) }
+func (*IbcChannelCap) ProtoMessage()    {}
+func (*IbcChannelCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e7c507d7fdfa1a, []int{1}
+}
+func (m *IbcChannelCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcChannelCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcChannelCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcChannelCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcChannelCap.Merge(m, src)
+}
+func (m *IbcChannelCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcChannelCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcChannelCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcChannelCap proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.IbcChannelPolicyMode", IbcChannelPolicyMode_name, IbcChannelPolicyMode_value)
+	proto.RegisterType((*IbcChannelPolicy)(nil), "provenance.marker.v1.IbcChannelPolicy")
+	proto.RegisterType((*IbcChannelCap)(nil), "provenance.marker.v1.IbcChannelCap")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/ibc_policy.proto", fileDescriptor_69e7c507d7fdfa1a)
+}
+
+var fileDescriptor_69e7c507d7fdfa1a = []byte{
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x61, 0x6b, 0xd3, 0x40,
+	0x18, 0xc7, 0x73, 0x6b, 0x1d, 0xcb, 0xcd, 0x69, 0x39, 0x2a, 0x96, 0xc0, 0xd2, 0xb8, 0x21, 0x94,
+	0x81, 0x09, 0xab, 0x0a, 0x22, 0x28, 0xb4, 0x69, 0xc4, 0x40, 0xd6, 0x96, 0xcc, 0x21, 0xf3, 0x4d,
+	0x48, 0x2f, 0x67, 0x7b, 0x34, 0xb9, 0x27, 0x34, 0x59, 0xb5, 0xdf, 0x40, 0xfa, 0xca, 0x2f, 0x50,
+	0xf0, 0x73, 0xf8, 0x09, 0xe6, 0xbb, 0xbd, 0x14, 0x5f, 0x0c, 0x69, 0xbf, 0x88, 0x34, 0xa9, 0x4e,
+	0xa5, 0x63, 0xef, 0xee, 0xb9, 0xfb, 0xfd, 0xfe, 0x0f, 0xcf, 0xf1, 0xe0, 0x87, 0xf1, 0x08, 0xc6,
+	0x4c, 0xf8, 0x82, 0x32, 0x23, 0xf2, 0x47, 0x43, 0x36, 0x32, 0xc6, 0x87, 0x06, 0xef, 0x51, 0x2f,
+	0x86, 0x90, 0xd3, 0x89, 0x1e, 0x8f, 0x20, 0x05, 0x52, 0xbe, 0xc2, 0xf4, 0x1c, 0xd3, 0xc7, 0x87,
+	0x4a, 0xb9, 0x0f, 0x7d, 0xc8, 0x00, 0x63, 0x79, 0xca, 0xd9, 0xbd, 0x6f, 0x08, 0x97, 0xec, 0x1e,
+	0x35, 0x07, 0xbe, 0x10, 0x2c, 0xec, 0x66, 0x31, 0xa4, 0x8c, 0x6f, 0x05, 0x4c, 0x40, 0x54, 0x41,
+	0x1a, 0xaa, 0xc9, 0x6e, 0x5e, 0x90, 0x97, 0xb8, 0x18, 0x41, 0xc0, 0x2a, 0x1b, 0x1a, 0xaa, 0xdd,
+	0xa9, 0x1f, 0xe8, 0xeb, 0xba, 0xe8, 0xff, 0x67, 0x1d, 0x41, 0xc0, 0xdc, 0xcc, 0x23, 0x0a, 0xde,
+	0xa2, 0xf9, 0x53, 0x52, 0x29, 0x68, 0x85, 0x9a, 0xec, 0xfe, 0xa9, 0xc9, 0x0b, 0x5c, 0xa4, 0x7e,
+	0x9c, 0x54, 0x8a, 0x5a, 0xa1, 0xb6, 0x5d, 0xdf, 0xbf, 0x29, 0xdb, 0xf4, 0xe3, 0x66, 0xf1, 0xfc,
+	0xb2, 0x2a, 0xb9, 0x99, 0xf6, 0xbc, 0xf8, 0xe9, 0x4b, 0x55, 0xda, 0x1b, 0xe2, 0x9d, 0x7f, 0x10,
+	0xb2, 0x8b, 0xf1, 0xaa, 0x83, 0xc7, 0x83, 0xd5, 0x30, 0xf2, 0xea, 0xc6, 0x0e, 0xc8, 0x53, 0xbc,
+	0xe9, 0x47, 0x70, 0x26, 0xd2, 0x6c, 0x24, 0xb9, 0xb9, 0xbb, 0x4c, 0xfc, 0x71, 0x59, 0xbd, 0x47,
+	0x21, 0x89, 0x20, 0x49, 0x82, 0xa1, 0xce, 0xc1, 0x88, 0xfc, 0x74, 0xa0, 0xdb, 0x22, 0x75, 0x57,
+	0x70, 0xde, 0xec, 0xe0, 0x2b, 0xc2, 0xe5, 0x75, 0xc3, 0x92, 0x67, 0x78, 0xdf, 0x6e, 0x9a, 0x9e,
+	0xf9, 0xba, 0xd1, 0x6e, 0x5b, 0x8e, 0xd7, 0xed, 0x38, 0xb6, 0x79, 0xea, 0x1d, 0x75, 0x5a, 0x96,
+	0x77, 0xd2, 0x3e, 0xee, 0x5a, 0xa6, 0xfd, 0xca, 0xb6, 0x5a, 0x25, 0x49, 0xb9, 0x3b, 0x9d, 0x69,
+	0xdb, 0x27, 0x22, 0x89, 0x19, 0xe5, 0xef, 0x39, 0x0b, 0xc8, 0x13, 0xfc, 0xe0, 0x3a, 0xb3, 0xe1,
+	0x38, 0x9d, 0xb7, 0x8e, 0x7d, 0xfc, 0xa6, 0x84, 0x94, 0x9d, 0xe9, 0x4c, 0x93, 0x1b, 0x61, 0x08,
+	0x1f, 0x42, 0x9e, 0xa4, 0xa4, 0x8e, 0xb5, 0xeb, 0xac, 0x96, 0xd5, 0x3e, 0xcd, 0xa4, 0x0d, 0xe5,
+	0xf6, 0x74, 0xa6, 0x6d, 0xb5, 0x98, 0x98, 0x2c, 0x9d, 0x66, 0xff, 0x7c, 0xae, 0xa2, 0x8b, 0xb9,
+	0x8a, 0x7e, 0xce, 0x55, 0xf4, 0x79, 0xa1, 0x4a, 0x17, 0x0b, 0x55, 0xfa, 0xbe, 0x50, 0x25, 0x7c,
+	0x9f, 0xc3, 0xda, 0xcf, 0xef, 0xa2, 0x77, 0xf5, 0x3e, 0x4f, 0x07, 0x67, 0x3d, 0x9d, 0x42, 0x64,
+	0x5c, 0x21, 0x8f, 0x38, 0xfc, 0x55, 0x19, 0x1f, 0x7f, 0x2f, 0x66, 0x3a, 0x89, 0x59, 0xd2, 0xdb,
+	0xcc, 0xb6, 0xec, 0xf1, 0xaf, 0x01, 0x00, 0x5d, 0x28, 0x2f, 0xa9, 0xba, 0x02, 0x00, 0x00,
+}
+
+func (m *IbcChannelPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caps) > 0 {
+		for iNdEx := len(m.Caps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Caps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIbcPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintIbcPolicy(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintIbcPolicy(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcPolicy(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcChannelCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcChannelCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcChannelCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbcPolicy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbcPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbcPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IbcChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcPolicy(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovIbcPolicy(uint64(m.Mode))
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovIbcPolicy(uint64(l))
+		}
+	}
+	if len(m.Caps) > 0 {
+		for _, e := range m.Caps {
+			l = e.Size()
+			n += 1 + l + sovIbcPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IbcChannelCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbcPolicy(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIbcPolicy(uint64(l))
+	return n
+}
+
+func sovIbcPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbcPolicy(x uint64) (n int) {
+	return sovIbcPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IbcChannelPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcChannelPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcChannelPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= IbcChannelPolicyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caps = append(m.Caps, IbcChannelCap{})
+			if err := m.Caps[len(m.Caps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcChannelCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcChannelCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcChannelCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbcPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbcPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbcPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbcPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbcPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbcPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbcPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbcPolicy = fmt.Errorf("proto: unexpected end of group")
+)
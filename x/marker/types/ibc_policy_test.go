@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestIbcChannelPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy IbcChannelPolicy
+		expErr string
+	}{
+		{
+			name:   "allowlist with caps",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0", "channel-4"}, []IbcChannelCap{NewIbcChannelCap("channel-4", sdkmath.NewInt(500))}),
+		},
+		{
+			name:   "empty allowlist",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, nil, nil),
+		},
+		{
+			name:   "denylist with cap on unlisted channel",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Denylist, []string{"channel-7"}, []IbcChannelCap{NewIbcChannelCap("channel-0", sdkmath.NewInt(500))}),
+		},
+		{
+			name:   "ibc denom without caps",
+			policy: NewIbcChannelPolicy("ibc/ABCD", IbcChannelPolicyMode_Allowlist, []string{"channel-0"}, nil),
+		},
+		{
+			name:   "ibc denom with caps",
+			policy: NewIbcChannelPolicy("ibc/ABCD", IbcChannelPolicyMode_Allowlist, []string{"channel-0"}, []IbcChannelCap{NewIbcChannelCap("channel-0", sdkmath.NewInt(500))}),
+			expErr: "ibc channel caps cannot be used for ibc denom ibc/ABCD",
+		},
+		{
+			name:   "invalid denom",
+			policy: NewIbcChannelPolicy("x", IbcChannelPolicyMode_Allowlist, nil, nil),
+			expErr: "invalid ibc channel policy denom: invalid denom: x",
+		},
+		{
+			name:   "unspecified mode",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Unspecified, nil, nil),
+			expErr: "invalid ibc channel policy mode: IBC_CHANNEL_POLICY_MODE_UNSPECIFIED",
+		},
+		{
+			name:   "invalid channel",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"c"}, nil),
+			expErr: "invalid ibc channel policy channel",
+		},
+		{
+			name:   "duplicate channel",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0", "channel-0"}, nil),
+			expErr: "duplicate ibc channel policy channel channel-0",
+		},
+		{
+			name:   "duplicate cap",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0"}, []IbcChannelCap{NewIbcChannelCap("channel-0", sdkmath.NewInt(1)), NewIbcChannelCap("channel-0", sdkmath.NewInt(2))}),
+			expErr: "duplicate ibc channel cap for channel-0",
+		},
+		{
+			name:   "zero cap",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0"}, []IbcChannelCap{NewIbcChannelCap("channel-0", sdkmath.ZeroInt())}),
+			expErr: "ibc channel cap for channel-0 must be positive",
+		},
+		{
+			name:   "cap on channel not in allowlist",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0"}, []IbcChannelCap{NewIbcChannelCap("channel-1", sdkmath.NewInt(1))}),
+			expErr: "ibc channel cap for channel-1 is not allowed by the policy",
+		},
+		{
+			name:   "cap on denied channel",
+			policy: NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Denylist, []string{"channel-0"}, []IbcChannelCap{NewIbcChannelCap("channel-0", sdkmath.NewInt(1))}),
+			expErr: "ibc channel cap for channel-0 is not allowed by the policy",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestIbcChannelPolicyCheckTransfer(t *testing.T) {
+	allow := NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Allowlist, []string{"channel-0", "channel-4"}, []IbcChannelCap{NewIbcChannelCap("channel-4", sdkmath.NewInt(500))})
+	deny := NewIbcChannelPolicy("fundshare", IbcChannelPolicyMode_Denylist, []string{"channel-7"}, nil)
+
+	require.NoError(t, allow.CheckTransfer("channel-0", sdkmath.NewInt(1_000_000), sdkmath.NewInt(1)), "allowed uncapped channel")
+	require.NoError(t, allow.CheckTransfer("channel-4", sdkmath.NewInt(400), sdkmath.NewInt(100)), "capped channel up to the cap")
+	require.EqualError(t, allow.CheckTransfer("channel-4", sdkmath.NewInt(400), sdkmath.NewInt(101)),
+		"cannot transfer 101fundshare over ibc channel channel-4: it would exceed the channel cap of 500fundshare (400fundshare already out)",
+		"capped channel over the cap")
+	require.EqualError(t, allow.CheckTransfer("channel-1", sdkmath.ZeroInt(), sdkmath.NewInt(1)),
+		"fundshare cannot be transferred over ibc channel channel-1", "channel not in allowlist")
+	require.NoError(t, deny.CheckTransfer("channel-1", sdkmath.ZeroInt(), sdkmath.NewInt(1)), "channel not in denylist")
+	require.EqualError(t, deny.CheckTransfer("channel-7", sdkmath.ZeroInt(), sdkmath.NewInt(1)),
+		"fundshare cannot be transferred over ibc channel channel-7", "channel in denylist")
+
+	require.NoError(t, allow.CheckReceive("channel-4"), "receive over allowed channel")
+	require.EqualError(t, allow.CheckReceive("channel-1"), "fundshare cannot be received over ibc channel channel-1", "receive over channel not in allowlist")
+	require.EqualError(t, deny.CheckReceive("channel-7"), "fundshare cannot be received over ibc channel channel-7", "receive over denied channel")
+
+	split := allow.Split(1, 1000)
+	require.Equal(t, sdkmath.OneInt(), split.Caps[0].Amount, "cap after reverse split")
+	require.Equal(t, sdkmath.NewInt(500), allow.Caps[0].Amount, "original cap after split")
+}
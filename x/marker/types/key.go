@@ -1,7 +1,11 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
@@ -35,6 +39,72 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// DistributionKeyPrefix prefix for marker distributions
+	DistributionKeyPrefix = []byte{0x06}
+
+	// DistributionHolderKeyPrefix prefix for holder balances awaiting payment from a distribution
+	DistributionHolderKeyPrefix = []byte{0x07}
+
+	// DistributionSequenceKey key for the last assigned distribution id
+	DistributionSequenceKey = []byte{0x08}
+
+	// SupplyAllowanceUsageKeyPrefix prefix for the amounts used against mint and burn allowances
+	SupplyAllowanceUsageKeyPrefix = []byte{0x09}
+
+	// LockupKeyPrefix prefix for account lockups of marker denoms
+	LockupKeyPrefix = []byte{0x0A}
+
+	// RedemptionConfigKeyPrefix prefix for marker redemption configurations
+	RedemptionConfigKeyPrefix = []byte{0x0B}
+
+	// PendingRedemptionKeyPrefix prefix for redemptions awaiting approval
+	PendingRedemptionKeyPrefix = []byte{0x0C}
+
+	// RedemptionSequenceKey key for the last assigned redemption id
+	RedemptionSequenceKey = []byte{0x0D}
+
+	// DefaultTransferLimitKeyPrefix prefix for the default transfer limits of markers
+	DefaultTransferLimitKeyPrefix = []byte{0x0E}
+
+	// TransferLimitKeyPrefix prefix for the transfer limits of specific accounts
+	TransferLimitKeyPrefix = []byte{0x0F}
+
+	// TransferUsageKeyPrefix prefix for the recent amounts sent by accounts with transfer limits
+	TransferUsageKeyPrefix = []byte{0x10}
+
+	// MarkerGrantIndexKeyPrefix prefix for the index of markers by the addresses that have access grants on them
+	MarkerGrantIndexKeyPrefix = []byte{0x11}
+
+	// MarkerTypeIndexKeyPrefix prefix for the index of markers by marker type
+	MarkerTypeIndexKeyPrefix = []byte{0x12}
+
+	// MarkerRequiredAttributeIndexKeyPrefix prefix for the index of markers by required attribute
+	MarkerRequiredAttributeIndexKeyPrefix = []byte{0x13}
+
+	// SendDenyExpirationKeyPrefix prefix for the index of deny send entries by when they expire
+	SendDenyExpirationKeyPrefix = []byte{0x14}
+
+	// RecoveryKeyPrefix prefix for proposed recoveries
+	RecoveryKeyPrefix = []byte{0x15}
+
+	// RecoverySequenceKey key for the last assigned recovery id
+	RecoverySequenceKey = []byte{0x16}
+
+	// HolderLimitKeyPrefix prefix for the holder limits of markers
+	HolderLimitKeyPrefix = []byte{0x17}
+
+	// HolderKeyPrefix prefix for the index of accounts holding denoms of markers with holder limits
+	HolderKeyPrefix = []byte{0x18}
+
+	// HolderCountKeyPrefix prefix for the number of accounts holding denoms of markers with holder limits
+	HolderCountKeyPrefix = []byte{0x19}
+
+	// AccountDataSchemaKeyPrefix prefix for the account data schemas of markers
+	AccountDataSchemaKeyPrefix = []byte{0x1A}
+
+	// IbcChannelPolicyKeyPrefix prefix for the IBC channel policies of markers
+	IbcChannelPolicyKeyPrefix = []byte{0x1B}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -103,3 +173,225 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// DistributionKey returns key [prefix][distribution id] for a marker distribution
+func DistributionKey(id uint64) []byte {
+	key := make([]byte, 0, len(DistributionKeyPrefix)+8)
+	key = append(key, DistributionKeyPrefix...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// DistributionHolderKeyPrefixFor returns key [prefix][distribution id] for the holders awaiting payment from a distribution
+func DistributionHolderKeyPrefixFor(id uint64) []byte {
+	key := make([]byte, 0, len(DistributionHolderKeyPrefix)+8)
+	key = append(key, DistributionHolderKeyPrefix...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// DistributionHolderKey returns key [prefix][distribution id][holder addr] for a holder awaiting payment from a distribution
+func DistributionHolderKey(id uint64, holder sdk.AccAddress) []byte {
+	return append(DistributionHolderKeyPrefixFor(id), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// GetDistributionHolderFromKey returns the distribution id and holder address from a DistributionHolderKey
+func GetDistributionHolderFromKey(key []byte) (id uint64, holder sdk.AccAddress) {
+	id = binary.BigEndian.Uint64(key[1:9])
+	holderLen := key[9]
+	holder = sdk.AccAddress(key[10 : 10+holderLen])
+	return
+}
+
+// SupplyAllowanceUsageMarkerPrefix returns key [prefix][marker addr] for the allowance usages on a marker
+func SupplyAllowanceUsageMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(SupplyAllowanceUsageKeyPrefix)+1+len(markerAddr))
+	key = append(key, SupplyAllowanceUsageKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SupplyAllowanceUsageAddressPrefix returns key [prefix][marker addr][addr] for the allowance usages of an address on a marker
+func SupplyAllowanceUsageAddressPrefix(markerAddr, addr sdk.AccAddress) []byte {
+	return append(SupplyAllowanceUsageMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// SupplyAllowanceUsageKey returns key [prefix][marker addr][addr][access] for the usage of a mint or burn allowance
+func SupplyAllowanceUsageKey(markerAddr, addr sdk.AccAddress, access Access) []byte {
+	return append(SupplyAllowanceUsageAddressPrefix(markerAddr, addr), byte(access))
+}
+
+// LockupMarkerPrefix returns key [prefix][marker addr] for the lockups of a marker's denom
+func LockupMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(LockupKeyPrefix)+1+len(markerAddr))
+	key = append(key, LockupKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// LockupKey returns key [prefix][marker addr][addr] for an account's lockup of a marker's denom
+func LockupKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(LockupMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// RedemptionConfigKey returns key [prefix][marker addr] for a marker's redemption configuration
+func RedemptionConfigKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(RedemptionConfigKeyPrefix)+1+len(markerAddr))
+	key = append(key, RedemptionConfigKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingRedemptionMarkerPrefix returns key [prefix][marker addr] for the redemptions of a marker's denom awaiting approval
+func PendingRedemptionMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(PendingRedemptionKeyPrefix)+1+len(markerAddr))
+	key = append(key, PendingRedemptionKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingRedemptionKey returns key [prefix][marker addr][id] for a redemption awaiting approval
+func PendingRedemptionKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(PendingRedemptionMarkerPrefix(markerAddr), id)
+}
+
+// RecoveryMarkerPrefix returns key [prefix][marker addr] for the proposed recoveries of a marker's denom
+func RecoveryMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(RecoveryKeyPrefix)+1+len(markerAddr))
+	key = append(key, RecoveryKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// RecoveryKey returns key [prefix][marker addr][id] for a proposed recovery
+func RecoveryKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(RecoveryMarkerPrefix(markerAddr), id)
+}
+
+// HolderLimitKey returns key [prefix][marker addr] for a marker's holder limit
+func HolderLimitKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(HolderLimitKeyPrefix)+1+len(markerAddr))
+	key = append(key, HolderLimitKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderMarkerPrefix returns key [prefix][marker addr] for the index of accounts holding a marker's denom
+func HolderMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(HolderKeyPrefix)+1+len(markerAddr))
+	key = append(key, HolderKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderKey returns key [prefix][marker addr][addr] for an account holding a marker's denom
+func HolderKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(HolderMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// HolderCountKey returns key [prefix][marker addr] for the number of accounts holding a marker's denom
+func HolderCountKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(HolderCountKeyPrefix)+1+len(markerAddr))
+	key = append(key, HolderCountKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DefaultTransferLimitKey returns key [prefix][marker addr] for a marker's default transfer limit
+func DefaultTransferLimitKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(DefaultTransferLimitKeyPrefix)+1+len(markerAddr))
+	key = append(key, DefaultTransferLimitKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferLimitMarkerPrefix returns key [prefix][marker addr] for the account transfer limits of a marker's denom
+func TransferLimitMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(TransferLimitKeyPrefix)+1+len(markerAddr))
+	key = append(key, TransferLimitKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferLimitKey returns key [prefix][marker addr][addr] for an account's transfer limit of a marker's denom
+func TransferLimitKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(TransferLimitMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// TransferUsageMarkerPrefix returns key [prefix][marker addr] for the recent sends of a marker's denom
+func TransferUsageMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(TransferUsageKeyPrefix)+1+len(markerAddr))
+	key = append(key, TransferUsageKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferUsageKey returns key [prefix][marker addr][addr] for an account's recent sends of a marker's denom
+func TransferUsageKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(TransferUsageMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// MarkerGrantIndexPrefix returns key [prefix][grantee addr] for the index of markers the address has access grants on
+func MarkerGrantIndexPrefix(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(MarkerGrantIndexKeyPrefix)+1+len(grantee))
+	key = append(key, MarkerGrantIndexKeyPrefix...)
+	return append(key, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// MarkerGrantIndexKey returns key [prefix][grantee addr][marker addr] for an entry of the grant index
+func MarkerGrantIndexKey(grantee, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerGrantIndexPrefix(grantee), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerTypeIndexPrefix returns key [prefix][marker type] for the index of markers of a type
+func MarkerTypeIndexPrefix(markerType MarkerType) []byte {
+	key := make([]byte, 0, len(MarkerTypeIndexKeyPrefix)+1)
+	key = append(key, MarkerTypeIndexKeyPrefix...)
+	return append(key, byte(markerType))
+}
+
+// MarkerTypeIndexKey returns key [prefix][marker type][marker addr] for an entry of the marker type index
+func MarkerTypeIndexKey(markerType MarkerType, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerTypeIndexPrefix(markerType), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerRequiredAttributeIndexPrefix returns key [prefix][sha256(attribute)] for the index of markers that require
+// an attribute. Required attributes are hashed since they can be longer than a length prefix allows.
+func MarkerRequiredAttributeIndexPrefix(reqAttr string) []byte {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(reqAttr))))
+	key := make([]byte, 0, len(MarkerRequiredAttributeIndexKeyPrefix)+len(hash))
+	key = append(key, MarkerRequiredAttributeIndexKeyPrefix...)
+	return append(key, hash[:]...)
+}
+
+// MarkerRequiredAttributeIndexKey returns key [prefix][sha256(attribute)][marker addr] for an entry of the
+// required attribute index
+func MarkerRequiredAttributeIndexKey(reqAttr string, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerRequiredAttributeIndexPrefix(reqAttr), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SendDenyExpirationPrefix returns key [prefix][expiration] for the deny send entries that expire at a time
+func SendDenyExpirationPrefix(expiration time.Time) []byte {
+	timeBz := sdk.FormatTimeBytes(expiration)
+	key := make([]byte, 0, len(SendDenyExpirationKeyPrefix)+len(timeBz))
+	key = append(key, SendDenyExpirationKeyPrefix...)
+	return append(key, timeBz...)
+}
+
+// SendDenyExpirationKey returns key [prefix][expiration][marker addr][deny addr] for the expiration of a deny send entry
+func SendDenyExpirationKey(expiration time.Time, markerAddr, denyAddr sdk.AccAddress) []byte {
+	key := SendDenyExpirationPrefix(expiration)
+	key = append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(key, address.MustLengthPrefix(denyAddr.Bytes())...)
+}
+
+// ParseSendDenyExpirationKey returns the marker and denied addresses from a SendDenyExpirationKey
+func ParseSendDenyExpirationKey(key []byte) (markerAddr, denyAddr sdk.AccAddress) {
+	addrs := key[len(SendDenyExpirationPrefix(time.Time{})):]
+	markerKeyLen := addrs[0]
+	denyKeyLen := addrs[markerKeyLen+1]
+	markerAddr = sdk.AccAddress(addrs[1 : markerKeyLen+1])
+	denyAddr = sdk.AccAddress(addrs[markerKeyLen+2 : markerKeyLen+2+denyKeyLen])
+	return
+}
+
+// AccountDataSchemaKey returns key [prefix][marker addr] for a marker's account data schema
+func AccountDataSchemaKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(AccountDataSchemaKeyPrefix)+1+len(markerAddr))
+	key = append(key, AccountDataSchemaKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// IbcChannelPolicyKey returns key [prefix][marker addr] for a marker's IBC channel policy
+func IbcChannelPolicyKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(IbcChannelPolicyKeyPrefix)+1+len(markerAddr))
+	key = append(key, IbcChannelPolicyKeyPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +47,21 @@ func TestGetDenySendAddresses(t *testing.T) {
 	assert.Equal(t, denyAddr, dAddr, "deny address")
 }
 
+func TestSendDenyExpirationKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	denyAddr := sdk.AccAddress("cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h")
+	early := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Second)
+
+	key := SendDenyExpirationKey(early, addr, denyAddr)
+	assert.Equal(t, uint8(0x14), key[0], "prefix")
+	mAddr, dAddr := ParseSendDenyExpirationKey(key)
+	assert.Equal(t, addr, mAddr, "module address")
+	assert.Equal(t, denyAddr, dAddr, "deny address")
+	assert.Negative(t, bytes.Compare(key, SendDenyExpirationKey(late, addr, denyAddr)), "earlier expirations should sort first")
+}
+
 func TestNetAssetValueKey(t *testing.T) {
 	addr, err := MarkerAddress("nhash")
 	require.NoError(t, err)
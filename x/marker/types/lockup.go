@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLockup creates a new Lockup with the releases ordered by release time.
+func NewLockup(denom string, addr sdk.AccAddress, releases []LockupRelease) Lockup {
+	return Lockup{
+		Denom:    denom,
+		Address:  addr.String(),
+		Releases: SortLockupReleases(releases),
+	}
+}
+
+// NewLockupRelease creates a new LockupRelease.
+func NewLockupRelease(releaseTime time.Time, amount sdkmath.Int) LockupRelease {
+	return LockupRelease{
+		ReleaseTime: releaseTime,
+		Amount:      amount,
+	}
+}
+
+// SortLockupReleases returns a copy of the provided releases ordered by release time.
+func SortLockupReleases(releases []LockupRelease) []LockupRelease {
+	rv := make([]LockupRelease, len(releases))
+	copy(rv, releases)
+	sort.SliceStable(rv, func(i, j int) bool {
+		return rv[i].ReleaseTime.Before(rv[j].ReleaseTime)
+	})
+	return rv
+}
+
+// ValidateLockupReleases returns an error if any release is not well formed or if two releases share a release time.
+func ValidateLockupReleases(releases []LockupRelease) error {
+	if len(releases) == 0 {
+		return fmt.Errorf("lockup must have at least one release")
+	}
+	seen := make(map[int64]bool, len(releases))
+	for _, release := range releases {
+		if err := release.Validate(); err != nil {
+			return err
+		}
+		key := release.ReleaseTime.UnixNano()
+		if seen[key] {
+			return fmt.Errorf("lockup contains multiple releases at %s", release.ReleaseTime.UTC().Format(time.RFC3339))
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Validate returns an error if the lockup is not well formed.
+func (l Lockup) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid lockup denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return fmt.Errorf("invalid lockup address: %w", err)
+	}
+	if err := ValidateLockupReleases(l.Releases); err != nil {
+		return fmt.Errorf("invalid %s lockup for %s: %w", l.Denom, l.Address, err)
+	}
+	return nil
+}
+
+// GetAddress returns the address of the account whose funds are locked.
+func (l Lockup) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(l.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// LockedAt returns the amount that is still locked at the provided time.
+func (l Lockup) LockedAt(blockTime time.Time) sdkmath.Int {
+	locked := sdkmath.ZeroInt()
+	for _, release := range l.Releases {
+		if blockTime.Before(release.ReleaseTime) {
+			locked = locked.Add(release.Amount)
+		}
+	}
+	return locked
+}
+
+// IsReleased returns true if nothing is still locked at the provided time.
+func (l Lockup) IsReleased(blockTime time.Time) bool {
+	return l.LockedAt(blockTime).IsZero()
+}
+
+// Validate returns an error if the release is not well formed.
+func (r LockupRelease) Validate() error {
+	if r.ReleaseTime.IsZero() {
+		return fmt.Errorf("release time cannot be the zero time")
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("release amount must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/lockup.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Lockup restricts an account from sending some of its balance of a marker's denom until scheduled release times.
+type Lockup struct {
+	// denom is the marker denom that is locked.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the bech32 address of the account whose funds are locked.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// releases is the schedule of amounts that become unlocked, ordered by release time.
+	Releases []LockupRelease `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases"`
+}
+
+func (m *Lockup) Reset()         { *m = Lockup{} }
+func (m *Lockup) String() string { return proto.CompactTextString(m) }
+func (*Lockup) ProtoMessage()    {}
+func (*Lockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c713be416e722cf7, []int{0}
+}
+func (m *Lockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lockup.Merge(m, src)
+}
+func (m *Lockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lockup proto.InternalMessageInfo
+
+// LockupRelease is an amount of a lockup that becomes unlocked at a specific time.
+type LockupRelease struct {
+	// release_time is the time at which the amount becomes unlocked.
+	ReleaseTime time.Time `protobuf:"bytes,1,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+	// amount is the amount that becomes unlocked at the release time.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *LockupRelease) Reset()         { *m = LockupRelease{} }
+func (m *LockupRelease) String() string { return proto.CompactTextString(m) }
+func (*LockupRelease) ProtoMessage()    {}
+func (*LockupRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c713be416e722cf7, []int{1}
+}
+func (m *LockupRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupRelease.Merge(m, src)
+}
+func (m *LockupRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupRelease proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Lockup)(nil), "provenance.marker.v1.Lockup")
+	proto.RegisterType((*LockupRelease)(nil), "provenance.marker.v1.LockupRelease")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/lockup.proto", fileDescriptor_c713be416e722cf7) }
+
+var fileDescriptor_c713be416e722cf7 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0xeb, 0x50,
+	0x18, 0x86, 0x73, 0x6e, 0x7b, 0x7b, 0x7b, 0x4f, 0xef, 0x5d, 0x42, 0xc5, 0x50, 0x30, 0xa9, 0x75,
+	0xe9, 0xe2, 0x39, 0xb4, 0xe2, 0xd2, 0x31, 0x20, 0x22, 0x38, 0x48, 0x70, 0x72, 0x91, 0x34, 0x39,
+	0xa6, 0xa1, 0x3d, 0xf9, 0x42, 0xce, 0x49, 0xd1, 0x1f, 0x20, 0x38, 0x76, 0x75, 0xeb, 0xcf, 0xe9,
+	0xd8, 0x51, 0x1c, 0xaa, 0xb4, 0x8b, 0x3f, 0x43, 0x92, 0x93, 0x58, 0x85, 0x6e, 0x79, 0x93, 0xe7,
+	0x7b, 0x79, 0x1f, 0x82, 0x0f, 0xe3, 0x04, 0xa6, 0x2c, 0x72, 0x23, 0x8f, 0x51, 0xee, 0x26, 0x63,
+	0x96, 0xd0, 0x69, 0x8f, 0x4e, 0xc0, 0x1b, 0xa7, 0x31, 0x89, 0x13, 0x90, 0xa0, 0x37, 0xb7, 0x08,
+	0x51, 0x08, 0x99, 0xf6, 0x5a, 0xcd, 0x00, 0x02, 0xc8, 0x01, 0x9a, 0x3d, 0x29, 0xb6, 0x65, 0x05,
+	0x00, 0xc1, 0x84, 0xd1, 0x3c, 0x0d, 0xd3, 0x3b, 0x2a, 0x43, 0xce, 0x84, 0x74, 0x79, 0x51, 0xd6,
+	0x79, 0x44, 0xb8, 0x76, 0x99, 0xb7, 0xeb, 0x4d, 0xfc, 0xdb, 0x67, 0x11, 0x70, 0x03, 0xb5, 0x51,
+	0xf7, 0xaf, 0xa3, 0x82, 0x6e, 0xe0, 0x3f, 0xae, 0xef, 0x27, 0x4c, 0x08, 0xe3, 0x57, 0xfe, 0xbe,
+	0x8c, 0xfa, 0x19, 0xae, 0x27, 0x6c, 0xc2, 0x5c, 0xc1, 0x84, 0x51, 0x69, 0x57, 0xba, 0x8d, 0xfe,
+	0x11, 0xd9, 0x35, 0x8d, 0xa8, 0x7e, 0x47, 0xb1, 0x76, 0x75, 0xb1, 0xb2, 0x34, 0xe7, 0xeb, 0x74,
+	0x50, 0x7d, 0x9a, 0x5b, 0x5a, 0xe7, 0x19, 0xe1, 0xff, 0x3f, 0x38, 0xfd, 0x1c, 0xff, 0x2b, 0x98,
+	0xdb, 0x6c, 0x74, 0xbe, 0xaa, 0xd1, 0x6f, 0x11, 0x65, 0x44, 0x4a, 0x23, 0x72, 0x5d, 0x1a, 0xd9,
+	0xf5, 0xac, 0x79, 0xf6, 0x66, 0x21, 0xa7, 0x51, 0x5c, 0x66, 0xdf, 0xf4, 0x53, 0x5c, 0x73, 0x39,
+	0xa4, 0x91, 0x54, 0x02, 0xf6, 0x41, 0x86, 0xbd, 0xae, 0xac, 0x3d, 0x0f, 0x04, 0x07, 0x21, 0xfc,
+	0x31, 0x09, 0x81, 0x72, 0x57, 0x8e, 0xc8, 0x45, 0x24, 0x9d, 0x02, 0x1e, 0xd4, 0xb3, 0x5d, 0x1f,
+	0x73, 0x0b, 0xd9, 0xc1, 0x62, 0x6d, 0xa2, 0xe5, 0xda, 0x44, 0xef, 0x6b, 0x13, 0xcd, 0x36, 0xa6,
+	0xb6, 0xdc, 0x98, 0xda, 0xcb, 0xc6, 0xd4, 0xf0, 0x7e, 0x08, 0x3b, 0x95, 0xaf, 0xd0, 0x4d, 0x3f,
+	0x08, 0xe5, 0x28, 0x1d, 0x12, 0x0f, 0x38, 0xdd, 0x22, 0xc7, 0x21, 0x7c, 0x4b, 0xf4, 0xbe, 0xfc,
+	0xc7, 0xf2, 0x21, 0x66, 0x62, 0x58, 0xcb, 0xa5, 0x4e, 0x3e, 0x07, 0x00, 0x2b, 0x7c, 0x13, 0x3d,
+	0x05, 0x02, 0x00, 0x00,
+}
+
+func (this *LockupRelease) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LockupRelease)
+	if !ok {
+		that2, ok := that.(LockupRelease)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ReleaseTime.Equal(that1.ReleaseTime) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *Lockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockupRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLockup(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLockup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Lockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockupRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovLockup(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	return n
+}
+
+func sovLockup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLockup(x uint64) (n int) {
+	return sovLockup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Lockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, LockupRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockupRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLockup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLockup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLockup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLockup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLockup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLockup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLockup = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -43,6 +44,8 @@ type MarkerAccountI interface {
 	GrantAccess(AccessGrantI) error
 	RevokeAccess(sdk.AccAddress) error
 	GetAccessList() []AccessGrant
+	RemoveExpiredAccess(time.Time) []AccessGrant
+	WithoutExpiredAccess(time.Time) MarkerAccountI
 
 	HasAccess(string, Access) bool
 	ValidateHasAccess(string, Access) error
@@ -293,6 +296,9 @@ func ValidateRequiredAttributes(requiredAttributes []string) error {
 		if strings.TrimSpace(attr) == "" {
 			return fmt.Errorf("invalid name: empty")
 		}
+		if _, err := ParseRequiredAttribute(attr); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -401,7 +407,7 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	// Find any existing permissions and append specified permissions
 	for _, ac := range ma.AccessControl {
 		if ac.GetAddress().Equals(access.GetAddress()) {
-			if err := access.MergeAdd(*NewAccessGrant(ac.GetAddress(), ac.GetAccessList())); err != nil {
+			if err := access.MergeAdd(ac); err != nil {
 				return err
 			}
 		}
@@ -411,10 +417,39 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 		return err
 	}
 	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	grant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	grant.MintAllowance = access.GetMintAllowance()
+	grant.BurnAllowance = access.GetBurnAllowance()
+	grant.Expiration = access.GetExpiration()
+	ma.AccessControl = append(ma.AccessControl, *grant)
 	return nil
 }
 
+// RemoveExpiredAccess removes any AccessGrant on this marker that has expired as of the provided time.
+// The removed grants are returned.
+func (ma *MarkerAccount) RemoveExpiredAccess(blockTime time.Time) []AccessGrant {
+	var accessList, expired []AccessGrant
+	for _, ac := range ma.AccessControl {
+		if ac.IsExpired(blockTime) {
+			expired = append(expired, ac)
+		} else {
+			accessList = append(accessList, ac)
+		}
+	}
+	if len(expired) > 0 {
+		ma.AccessControl = accessList
+	}
+	return expired
+}
+
+// WithoutExpiredAccess returns a copy of this marker without any AccessGrant that has expired as of the provided time.
+// This marker is not changed, so the copy should only be used to check access, and never be saved.
+func (ma MarkerAccount) WithoutExpiredAccess(blockTime time.Time) MarkerAccountI {
+	// ma is already a copy, and RemoveExpiredAccess builds a new access list instead of changing the existing one.
+	ma.RemoveExpiredAccess(blockTime)
+	return &ma
+}
+
 // RevokeAccess removes any AccessGrant for the given address on this marker.
 func (ma *MarkerAccount) RevokeAccess(addr sdk.AccAddress) error {
 	if err := sdk.VerifyAddressFormat(addr); err != nil {
@@ -11,15 +11,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,6 +115,8 @@ type Params struct {
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
 	// maximum amount of supply to allow a marker to be created with
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// how long a proposed recovery must wait before it can be executed, giving the owner of the funds time to cancel it
+	RecoveryChallengePeriod time.Duration `protobuf:"bytes,5,opt,name=recovery_challenge_period,json=recoveryChallengePeriod,proto3,stdduration" json:"recovery_challenge_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -168,6 +174,13 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetRecoveryChallengePeriod() time.Duration {
+	if m != nil {
+		return m.RecoveryChallengePeriod
+	}
+	return 0
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -553,6 +566,67 @@ func (m *EventMarkerDeleteAccess) GetAdministrator() string {
 	return ""
 }
 
+// EventMarkerAccessExpired event emitted when an expired access grant is removed from a marker
+type EventMarkerAccessExpired struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventMarkerAccessExpired) Reset()         { *m = EventMarkerAccessExpired{} }
+func (m *EventMarkerAccessExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccessExpired) ProtoMessage()    {}
+func (*EventMarkerAccessExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+}
+func (m *EventMarkerAccessExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccessExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccessExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerAccessExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccessExpired.Merge(m, src)
+}
+func (m *EventMarkerAccessExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccessExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccessExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccessExpired proto.InternalMessageInfo
+
+func (m *EventMarkerAccessExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventMarkerAccessExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerAccessExpired) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
+}
+
 // EventMarkerFinalize event emitted when marker is finalized
 type EventMarkerFinalize struct {
 	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -563,7 +637,7 @@ func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
 func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerFinalize) ProtoMessage()    {}
 func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
 }
 func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +690,7 @@ func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
 func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerActivate) ProtoMessage()    {}
 func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
 func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +743,7 @@ func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
 func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerCancel) ProtoMessage()    {}
 func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{9}
+	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
 func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +796,7 @@ func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
 func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerDelete) ProtoMessage()    {}
 func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{10}
+	return fileDescriptor_f7e2c25c71db7f99, []int{11}
 }
 func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +850,7 @@ func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
 func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerMint) ProtoMessage()    {}
 func (*EventMarkerMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{11}
+	return fileDescriptor_f7e2c25c71db7f99, []int{12}
 }
 func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +911,7 @@ func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
 func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerBurn) ProtoMessage()    {}
 func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{12}
+	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
 func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +973,7 @@ func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
 func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerWithdraw) ProtoMessage()    {}
 func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{13}
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
 }
 func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1043,7 @@ func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
 func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerTransfer) ProtoMessage()    {}
 func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
 }
 func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1122,7 @@ func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenom
 func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
 func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
 }
 func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1211,7 @@ func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
 func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
 func (*EventDenomUnit) ProtoMessage()    {}
 func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{16}
+	return fileDescriptor_f7e2c25c71db7f99, []int{17}
 }
 func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1273,7 @@ func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
 func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*EventSetNetAssetValue) ProtoMessage()    {}
 func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{17}
+	return fileDescriptor_f7e2c25c71db7f99, []int{18}
 }
 func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1341,7 @@ func (m *EventMarkerParamsUpdated) Reset()         { *m = EventMarkerParamsUpdat
 func (m *EventMarkerParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerParamsUpdated) ProtoMessage()    {}
 func (*EventMarkerParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+	return fileDescriptor_f7e2c25c71db7f99, []int{19}
 }
 func (m *EventMarkerParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,1615 +1391,9951 @@ func (m *EventMarkerParamsUpdated) GetMaxSupply() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
-	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
-	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
-	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
-	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
-	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
-	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
-	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
-	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
-	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
-	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
-	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
-	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
-	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
-	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
-	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
-	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
-	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
-	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
-	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.marker.v1.EventSetNetAssetValue")
-	proto.RegisterType((*EventMarkerParamsUpdated)(nil), "provenance.marker.v1.EventMarkerParamsUpdated")
+// EventMarkerDistributionCreated event emitted when a marker distribution is created
+type EventMarkerDistributionCreated struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Administrator  string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	HolderCount    string `protobuf:"bytes,5,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	TotalHolding   string `protobuf:"bytes,6,opt,name=total_holding,json=totalHolding,proto3" json:"total_holding,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
-
-var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
-	0x16, 0xe7, 0x52, 0x14, 0x25, 0x0d, 0x25, 0x9a, 0x1e, 0xd1, 0x32, 0xcd, 0x83, 0x29, 0x9a, 0xe7,
-	0x3b, 0xeb, 0x7c, 0x67, 0xd2, 0xd2, 0xc1, 0x40, 0x60, 0xa4, 0xe1, 0x97, 0x1c, 0x22, 0xd6, 0x47,
-	0x96, 0x94, 0x03, 0x1b, 0x01, 0x16, 0xc3, 0xdd, 0x11, 0xb5, 0xd0, 0xee, 0x0e, 0xb3, 0x33, 0xa4,
-	0xa5, 0x20, 0xb5, 0x61, 0xa8, 0x72, 0x99, 0x14, 0x02, 0x04, 0x24, 0x45, 0x80, 0xb4, 0xa9, 0x53,
-	0x1b, 0xa9, 0x5c, 0x06, 0x29, 0x8c, 0xc4, 0x6e, 0x52, 0x04, 0xf9, 0x1b, 0x82, 0xf9, 0xe0, 0x72,
-	0xd7, 0xa2, 0xed, 0x04, 0x8a, 0x3b, 0xbe, 0xcf, 0xf9, 0xbd, 0xb7, 0xbf, 0x99, 0xf7, 0x08, 0xae,
-	0xf4, 0x7d, 0x32, 0xc4, 0x1e, 0xf2, 0x4c, 0x5c, 0x71, 0x91, 0xbf, 0x8f, 0xfd, 0xca, 0x70, 0x55,
-	0xfd, 0x2a, 0xf7, 0x7d, 0xc2, 0x08, 0xcc, 0x8e, 0x5d, 0xca, 0xca, 0x30, 0x5c, 0xcd, 0x67, 0x7b,
-	0xa4, 0x47, 0x84, 0x43, 0x85, 0xff, 0x92, 0xbe, 0xf9, 0x82, 0x49, 0xa8, 0x4b, 0x68, 0x05, 0x0d,
-	0xd8, 0x5e, 0x65, 0xb8, 0xda, 0xc5, 0x0c, 0xad, 0x0a, 0x41, 0xd9, 0x2f, 0x49, 0xbb, 0x21, 0x03,
-	0xa5, 0xf0, 0x4a, 0x68, 0x17, 0x51, 0x1c, 0x84, 0x9a, 0xc4, 0xf6, 0x94, 0xfd, 0xdf, 0x13, 0x91,
-	0x22, 0xd3, 0xc4, 0x94, 0xf6, 0x7c, 0xe4, 0x31, 0xe9, 0x57, 0xfa, 0x45, 0x03, 0xc9, 0x6d, 0xe4,
-	0x23, 0x97, 0xc2, 0xff, 0x81, 0x8c, 0x8b, 0x0e, 0x0c, 0x46, 0x18, 0x72, 0x0c, 0x3a, 0xe8, 0xf7,
-	0x9d, 0xc3, 0x9c, 0x56, 0xd4, 0x56, 0x12, 0xb5, 0x78, 0x4e, 0xd3, 0xd3, 0x2e, 0x3a, 0xe8, 0x70,
-	0x53, 0x5b, 0x58, 0xe0, 0x7f, 0xc1, 0x79, 0xec, 0xa1, 0xae, 0x83, 0x8d, 0x1e, 0x19, 0x62, 0x5f,
-	0x9c, 0x94, 0x8b, 0x17, 0xb5, 0x95, 0x59, 0x3d, 0x23, 0x0d, 0x77, 0x02, 0x3d, 0x7c, 0x0f, 0xe4,
-	0x06, 0x9e, 0x8f, 0x29, 0xf3, 0x6d, 0x93, 0x61, 0xcb, 0xb0, 0xb0, 0x47, 0x5c, 0xc3, 0xc7, 0x3d,
-	0x7c, 0x90, 0x9b, 0x2a, 0x6a, 0x2b, 0x73, 0xfa, 0x52, 0xd8, 0xde, 0xe0, 0x66, 0x9d, 0x5b, 0xe1,
-	0xfb, 0x00, 0x70, 0x50, 0x0a, 0x4e, 0x82, 0xfb, 0xd6, 0x2e, 0x3f, 0x7d, 0xbe, 0x1c, 0xfb, 0xe9,
-	0xf9, 0xf2, 0x05, 0xd9, 0x03, 0x6a, 0xed, 0x97, 0x6d, 0x52, 0x71, 0x11, 0xdb, 0x2b, 0xb7, 0x3c,
-	0xa6, 0xcf, 0xb9, 0xe8, 0x40, 0x82, 0xbc, 0x9d, 0xf8, 0xf5, 0x64, 0x59, 0x2b, 0xfd, 0x9e, 0x00,
-	0x0b, 0x1b, 0xa2, 0x07, 0x55, 0xd3, 0x24, 0x03, 0x8f, 0xc1, 0x16, 0x98, 0xe7, 0x8d, 0x33, 0x90,
-	0x94, 0x45, 0x99, 0xa9, 0xb5, 0x62, 0x59, 0xb5, 0x58, 0x7c, 0x02, 0xd5, 0xd4, 0x72, 0x0d, 0x51,
-	0xac, 0xe2, 0x6a, 0x89, 0x67, 0xcf, 0x97, 0x35, 0x3d, 0xd5, 0x1d, 0xab, 0x60, 0x0e, 0xcc, 0xb8,
-	0xc8, 0x43, 0x3d, 0xec, 0x8b, 0xea, 0xe7, 0xf4, 0x91, 0x08, 0x37, 0x41, 0x5a, 0xf6, 0xdb, 0x30,
-	0x89, 0xc7, 0x7c, 0xe2, 0xe4, 0xa6, 0x8a, 0x53, 0x2b, 0xa9, 0xb5, 0x2b, 0xe5, 0x49, 0x14, 0x29,
-	0x57, 0x85, 0xef, 0x1d, 0xfe, 0x6d, 0x6a, 0x09, 0x5e, 0xa1, 0xbe, 0x20, 0xc3, 0xeb, 0x32, 0x1a,
-	0xde, 0x06, 0x49, 0xca, 0x10, 0x1b, 0x50, 0xd1, 0x86, 0xf4, 0x5a, 0x69, 0x72, 0x1e, 0x59, 0x69,
-	0x5b, 0x78, 0xea, 0x2a, 0x02, 0x66, 0xc1, 0xb4, 0xe8, 0x79, 0x6e, 0x5a, 0x60, 0x94, 0x02, 0xbc,
-	0x05, 0x92, 0xaa, 0xb1, 0xc9, 0x3f, 0xd3, 0x58, 0xe5, 0x0c, 0xab, 0x20, 0x25, 0x8f, 0x33, 0xd8,
-	0x61, 0x1f, 0xe7, 0x66, 0x04, 0x9a, 0xe2, 0x9b, 0xd0, 0x74, 0x0e, 0xfb, 0x58, 0x07, 0x6e, 0xf0,
-	0x1b, 0x5e, 0x01, 0xf3, 0x32, 0x99, 0xb1, 0x6b, 0x1f, 0x60, 0x2b, 0x37, 0x2b, 0x88, 0x93, 0x92,
-	0xba, 0x75, 0xae, 0xe2, 0x9c, 0x41, 0x8e, 0x43, 0x1e, 0x86, 0xf8, 0x15, 0x34, 0x72, 0x4e, 0xb8,
-	0x2f, 0x09, 0xfb, 0x98, 0x66, 0xa3, 0x46, 0xad, 0x81, 0x0b, 0x32, 0x72, 0x97, 0xf8, 0x26, 0xb6,
-	0x0c, 0xe6, 0x23, 0x8f, 0xee, 0x62, 0x3f, 0x07, 0x44, 0xd8, 0xa2, 0x30, 0xae, 0x0b, 0x5b, 0x47,
-	0x99, 0x60, 0x05, 0x2c, 0xfa, 0xf8, 0xd3, 0x81, 0xed, 0x63, 0xcb, 0x40, 0x8c, 0xf9, 0x76, 0x77,
-	0xc0, 0x30, 0xcd, 0xa5, 0x8a, 0x53, 0x2b, 0x73, 0x3a, 0x1c, 0x99, 0xaa, 0x81, 0xe5, 0x76, 0xfe,
-	0xf1, 0xc9, 0x72, 0xec, 0x8b, 0x93, 0xe5, 0xd8, 0x0f, 0xdf, 0xdd, 0x48, 0x47, 0xd8, 0xd5, 0x2a,
-	0x3d, 0xd1, 0xc0, 0xc2, 0x26, 0x66, 0x55, 0x4a, 0x31, 0xbb, 0x87, 0x9c, 0x01, 0x86, 0xb7, 0xc0,
-	0x74, 0xdf, 0xb7, 0x4d, 0xac, 0x98, 0x76, 0x69, 0xc4, 0x34, 0xce, 0xa4, 0x80, 0x69, 0x75, 0x62,
-	0x7b, 0xea, 0xd3, 0x4b, 0x6f, 0xb8, 0x04, 0x92, 0x43, 0xe2, 0x0c, 0x5c, 0x79, 0xb3, 0x12, 0xba,
-	0x92, 0xe0, 0x4d, 0x90, 0x1d, 0xf4, 0x2d, 0xc4, 0xaf, 0x52, 0xd7, 0x21, 0xe6, 0xbe, 0xb1, 0x87,
-	0xed, 0xde, 0x1e, 0x13, 0x77, 0x29, 0xa1, 0x43, 0x65, 0xab, 0x71, 0xd3, 0x07, 0xc2, 0x52, 0xfa,
-	0x56, 0x03, 0xe9, 0xe6, 0x10, 0x7b, 0x4c, 0x41, 0xb5, 0xac, 0x31, 0x27, 0xb4, 0x30, 0x27, 0x96,
-	0x40, 0x12, 0xb9, 0xe2, 0x52, 0x48, 0x3a, 0x2b, 0x89, 0xeb, 0x15, 0xfb, 0xe4, 0x85, 0x1d, 0x31,
-	0x2b, 0xc4, 0xff, 0x44, 0x94, 0xff, 0xcb, 0x51, 0x9a, 0x48, 0xe6, 0x85, 0x49, 0x90, 0x03, 0x33,
-	0xc8, 0xb2, 0x7c, 0x4c, 0xa9, 0xe4, 0x9f, 0x3e, 0x12, 0x4b, 0x5f, 0x6a, 0x20, 0x1b, 0x45, 0x2b,
-	0x6f, 0x07, 0x6c, 0x82, 0xa4, 0xbc, 0x14, 0xaa, 0x91, 0xd7, 0x26, 0xb3, 0x2e, 0x1c, 0x2b, 0xdc,
-	0x55, 0x5b, 0x55, 0xf0, 0xb8, 0xf4, 0x78, 0xb8, 0xf4, 0xab, 0x60, 0x01, 0x59, 0xae, 0xed, 0xd9,
-	0x94, 0xf9, 0x88, 0x11, 0x5f, 0x55, 0x1a, 0x55, 0x96, 0xb6, 0xc0, 0xf9, 0x53, 0xe9, 0xc3, 0xa5,
-	0x68, 0x91, 0x52, 0x60, 0x11, 0xa4, 0xfa, 0xd8, 0x77, 0x6d, 0x4a, 0x6d, 0xe2, 0xd1, 0x5c, 0x5c,
-	0x10, 0x2a, 0xac, 0x2a, 0x7d, 0x0e, 0x2e, 0x86, 0x12, 0x36, 0xb0, 0x83, 0x19, 0x56, 0x69, 0xff,
-	0x05, 0xd2, 0x3e, 0x76, 0xc9, 0x10, 0x1b, 0xd1, 0xec, 0x0b, 0x52, 0x5b, 0x55, 0x67, 0x9c, 0xa5,
-	0x9c, 0x8f, 0xc0, 0x62, 0xe8, 0xf4, 0x75, 0xdb, 0x43, 0x8e, 0xfd, 0x19, 0x7e, 0x0d, 0x39, 0x4e,
-	0xa5, 0x8c, 0xbf, 0x3d, 0x65, 0xd5, 0x64, 0xf6, 0x10, 0xb1, 0xb3, 0xa5, 0x8c, 0x36, 0xbd, 0xce,
-	0x3f, 0xb7, 0xf3, 0x37, 0x26, 0x94, 0x4d, 0x3f, 0x53, 0x42, 0x0c, 0xce, 0x85, 0x12, 0x6e, 0xd8,
-	0xf2, 0xca, 0xa8, 0xab, 0xa4, 0x45, 0xae, 0xd2, 0x59, 0x3e, 0x57, 0xf4, 0x98, 0xda, 0xc0, 0xf7,
-	0xde, 0xc9, 0x31, 0x8f, 0xb4, 0xc8, 0x37, 0xfc, 0xd8, 0x66, 0x7b, 0x96, 0x8f, 0x1e, 0xf2, 0x9c,
-	0x7c, 0xc9, 0x18, 0xf1, 0x50, 0x0a, 0x67, 0x39, 0x09, 0x5e, 0x06, 0x80, 0x91, 0x80, 0xde, 0xf2,
-	0x09, 0x99, 0x63, 0x44, 0x51, 0x9b, 0xbf, 0x5b, 0x61, 0x20, 0xc1, 0x7b, 0xfd, 0x0e, 0x8a, 0x7e,
-	0x0b, 0x14, 0x3e, 0xb3, 0x76, 0x7d, 0xe2, 0x06, 0x0e, 0xf2, 0x41, 0x4b, 0x71, 0xdd, 0x08, 0xed,
-	0x6f, 0x71, 0xf0, 0x8f, 0x10, 0xda, 0x36, 0x66, 0x62, 0x95, 0xd9, 0xc0, 0x0c, 0x59, 0x88, 0x21,
-	0xf8, 0x4f, 0xb0, 0xe0, 0xaa, 0xdf, 0x06, 0x7f, 0xfa, 0x15, 0xf8, 0xf9, 0x91, 0x92, 0xef, 0x1a,
-	0x70, 0x15, 0x64, 0x03, 0x27, 0x0b, 0x53, 0xd3, 0xb7, 0xfb, 0xcc, 0x26, 0x9e, 0xaa, 0x68, 0x71,
-	0x64, 0x6b, 0x8c, 0x4d, 0xf0, 0x3f, 0x20, 0x33, 0x0e, 0xb1, 0x69, 0xdf, 0x41, 0x87, 0xaa, 0xc4,
-	0x73, 0x81, 0xbb, 0x54, 0xc3, 0x7b, 0x91, 0xec, 0x7c, 0x0d, 0x1b, 0x78, 0x36, 0xe3, 0xe5, 0xf2,
-	0xdd, 0xe4, 0xea, 0x1b, 0xde, 0x53, 0x51, 0xca, 0x8e, 0x67, 0x33, 0x1d, 0x8e, 0x31, 0x28, 0x15,
-	0x3d, 0xdd, 0xe2, 0xe9, 0x49, 0x2d, 0x0e, 0x37, 0xc0, 0x43, 0x2e, 0x56, 0x0f, 0x7f, 0xd0, 0x80,
-	0x4d, 0xe4, 0x62, 0x78, 0x0d, 0x04, 0xa8, 0x0d, 0x7a, 0xe8, 0x76, 0x89, 0x23, 0x76, 0x8c, 0x39,
-	0x3d, 0x3d, 0x52, 0xb7, 0x85, 0xb6, 0xf4, 0x89, 0x9a, 0x69, 0x01, 0x8c, 0xd7, 0xdc, 0xe0, 0x3c,
-	0x98, 0xc5, 0x07, 0x7d, 0xe2, 0xe1, 0x60, 0xaa, 0x05, 0xb2, 0x78, 0xb9, 0x1d, 0x1b, 0x51, 0x4c,
-	0xc5, 0x7a, 0xc6, 0x5f, 0x6e, 0x29, 0x96, 0x28, 0xb8, 0x20, 0xb2, 0xb7, 0x31, 0x8b, 0x0e, 0xf3,
-	0xc9, 0x87, 0x64, 0x47, 0x23, 0x5e, 0x31, 0xef, 0xd5, 0x09, 0xae, 0xc6, 0xa6, 0x9a, 0xe0, 0x7c,
-	0x9c, 0x92, 0x81, 0x6f, 0x62, 0xc5, 0x33, 0x25, 0x95, 0x4e, 0x34, 0x90, 0x0b, 0x31, 0x48, 0xae,
-	0xe6, 0x3b, 0x72, 0x9e, 0x4f, 0xde, 0xb9, 0x25, 0x88, 0xbf, 0xb6, 0x73, 0xc7, 0xdf, 0xb8, 0x73,
-	0x5f, 0x8e, 0xec, 0xdc, 0x12, 0xf7, 0x78, 0xa9, 0xbe, 0xfe, 0x48, 0x03, 0x60, 0xbc, 0xd6, 0xc1,
-	0x15, 0x70, 0x71, 0xa3, 0xaa, 0x7f, 0xd8, 0xd4, 0x8d, 0xce, 0xfd, 0xed, 0xa6, 0xb1, 0xb3, 0xd9,
-	0xde, 0x6e, 0xd6, 0x5b, 0xeb, 0xad, 0x66, 0x23, 0x13, 0xcb, 0xa7, 0x8e, 0x8e, 0x8b, 0x33, 0x3b,
-	0xde, 0xbe, 0x47, 0x1e, 0x7a, 0xb0, 0x00, 0x32, 0x61, 0xcf, 0xfa, 0x56, 0x6b, 0x33, 0xa3, 0xe5,
-	0x67, 0x8f, 0x8e, 0x8b, 0x09, 0xbe, 0xfa, 0xc0, 0x32, 0x58, 0x0a, 0xdb, 0xf5, 0x66, 0xbb, 0xa3,
-	0xb7, 0xea, 0x9d, 0x66, 0x23, 0x13, 0xcf, 0xc3, 0xa3, 0xe3, 0x62, 0x5a, 0x0f, 0xd0, 0x72, 0xff,
-	0xeb, 0xdf, 0xc7, 0xc1, 0x7c, 0x78, 0xdb, 0x85, 0x6b, 0xe0, 0x92, 0x4a, 0xd0, 0xee, 0x54, 0x3b,
-	0x3b, 0xed, 0x57, 0xc0, 0x2c, 0x1e, 0x1d, 0x17, 0xcf, 0x49, 0xd7, 0x1d, 0xcf, 0xc2, 0xbb, 0xb6,
-	0x87, 0xad, 0xd0, 0xa1, 0x2a, 0x66, 0x5b, 0xdf, 0xda, 0xde, 0x6a, 0x37, 0x1b, 0x19, 0x4d, 0x1e,
-	0x2a, 0x03, 0xb6, 0x7d, 0xd2, 0x27, 0x14, 0x5b, 0xf0, 0x66, 0x50, 0xae, 0xf2, 0x5f, 0x6f, 0x6d,
-	0x56, 0xef, 0xb6, 0x1e, 0x08, 0x94, 0xa1, 0x13, 0x46, 0x93, 0xd4, 0x82, 0xd7, 0x41, 0x36, 0x1a,
-	0x51, 0xad, 0x77, 0x5a, 0xf7, 0x9a, 0x99, 0xa9, 0x7c, 0xe6, 0xe8, 0xb8, 0x38, 0x2f, 0xdd, 0xc5,
-	0x94, 0xc4, 0xa7, 0xb3, 0xd7, 0xab, 0x9b, 0xf5, 0xe6, 0xdd, 0xbb, 0xcd, 0x46, 0x26, 0x11, 0xce,
-	0x2e, 0x27, 0xa0, 0x33, 0x09, 0x4f, 0x83, 0xb7, 0x6d, 0xeb, 0x7e, 0xb3, 0x91, 0x99, 0x0e, 0x47,
-	0x34, 0x78, 0xef, 0xc8, 0x21, 0xb6, 0xf2, 0xb3, 0x8f, 0xbf, 0x2a, 0xc4, 0xbe, 0xf9, 0xba, 0x10,
-	0xab, 0xf5, 0x9e, 0xbe, 0x28, 0x68, 0xcf, 0x5e, 0x14, 0xb4, 0x9f, 0x5f, 0x14, 0xb4, 0x27, 0x2f,
-	0x0b, 0xb1, 0x67, 0x2f, 0x0b, 0xb1, 0x1f, 0x5f, 0x16, 0x62, 0xe0, 0xa2, 0x4d, 0x26, 0xbe, 0x04,
-	0xdb, 0xda, 0x83, 0xb5, 0x9e, 0xcd, 0xf6, 0x06, 0xdd, 0xb2, 0x49, 0xdc, 0xca, 0xd8, 0xe5, 0x86,
-	0x4d, 0x42, 0x52, 0xe5, 0x60, 0xf4, 0xa7, 0x93, 0xaf, 0x7e, 0xb4, 0x9b, 0x14, 0x7f, 0x36, 0xff,
-	0xff, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xbf, 0x1f, 0xda, 0x40, 0x0f, 0x00, 0x00,
+func (m *EventMarkerDistributionCreated) Reset()         { *m = EventMarkerDistributionCreated{} }
+func (m *EventMarkerDistributionCreated) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionCreated) ProtoMessage()    {}
+func (*EventMarkerDistributionCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{20}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *EventMarkerDistributionCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EventMarkerDistributionCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionCreated.Merge(m, src)
+}
+func (m *EventMarkerDistributionCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionCreated proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionCreated) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	if this.MaxTotalSupply != that1.MaxTotalSupply {
-		return false
+	return ""
+}
+
+func (m *EventMarkerDistributionCreated) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.EnableGovernance != that1.EnableGovernance {
-		return false
+	return ""
+}
+
+func (m *EventMarkerDistributionCreated) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if this.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
-		return false
+	return ""
+}
+
+func (m *EventMarkerDistributionCreated) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if !this.MaxSupply.Equal(that1.MaxSupply) {
-		return false
+	return ""
+}
+
+func (m *EventMarkerDistributionCreated) GetHolderCount() string {
+	if m != nil {
+		return m.HolderCount
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *EventMarkerDistributionCreated) GetTotalHolding() string {
+	if m != nil {
+		return m.TotalHolding
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDistributionProgress event emitted when a batch of holders is paid from a marker distribution
+type EventMarkerDistributionProgress struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	HoldersPaid    string `protobuf:"bytes,3,opt,name=holders_paid,json=holdersPaid,proto3" json:"holders_paid,omitempty"`
+	HolderCount    string `protobuf:"bytes,4,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxSupply.Size()
-		i -= size
-		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventMarkerDistributionProgress) Reset()         { *m = EventMarkerDistributionProgress{} }
+func (m *EventMarkerDistributionProgress) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionProgress) ProtoMessage()    {}
+func (*EventMarkerDistributionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{21}
+}
+func (m *EventMarkerDistributionProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventMarkerDistributionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionProgress.Merge(m, src)
+}
+func (m *EventMarkerDistributionProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionProgress proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionProgress) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	if m.EnableGovernance {
-		i--
-		if m.EnableGovernance {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventMarkerDistributionProgress) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.MaxTotalSupply != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventMarkerDistributionProgress) GetHoldersPaid() string {
+	if m != nil {
+		return m.HoldersPaid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerDistributionProgress) GetHolderCount() string {
+	if m != nil {
+		return m.HolderCount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDistributionCompleted event emitted when all holders have been paid from a marker distribution
+type EventMarkerDistributionCompleted struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Distributed    string `protobuf:"bytes,3,opt,name=distributed,proto3" json:"distributed,omitempty"`
+	Remainder      string `protobuf:"bytes,4,opt,name=remainder,proto3" json:"remainder,omitempty"`
 }
 
-func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventMarkerDistributionCompleted) Reset()         { *m = EventMarkerDistributionCompleted{} }
+func (m *EventMarkerDistributionCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionCompleted) ProtoMessage()    {}
+func (*EventMarkerDistributionCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{22}
+}
+func (m *EventMarkerDistributionCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+}
+func (m *EventMarkerDistributionCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionCompleted.Merge(m, src)
+}
+func (m *EventMarkerDistributionCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionCompleted proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionCompleted) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
 	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *EventMarkerDistributionCompleted) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.MarkerType != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *EventMarkerDistributionCompleted) GetDistributed() string {
+	if m != nil {
+		return m.Distributed
 	}
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+func (m *EventMarkerDistributionCompleted) GetRemainder() string {
+	if m != nil {
+		return m.Remainder
+	}
+	return ""
+}
+
+// EventMarkerLockupSet event emitted when an account's lockup of a marker denom is set
+type EventMarkerLockupSet struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Locked        string `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerLockupSet) Reset()         { *m = EventMarkerLockupSet{} }
+func (m *EventMarkerLockupSet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerLockupSet) ProtoMessage()    {}
+func (*EventMarkerLockupSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{23}
+}
+func (m *EventMarkerLockupSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerLockupSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerLockupSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EventMarkerLockupSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerLockupSet.Merge(m, src)
+}
+func (m *EventMarkerLockupSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerLockupSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerLockupSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerLockupSet proto.InternalMessageInfo
+
+func (m *EventMarkerLockupSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.Status != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *EventMarkerLockupSet) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.AccessControl) > 0 {
-		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return ""
+}
+
+func (m *EventMarkerLockupSet) GetLocked() string {
+	if m != nil {
+		return m.Locked
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerLockupSet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarker(dAtA, i, uint64(size))
+	return ""
+}
+
+// EventMarkerLockupRemoved event emitted when an account's lockup of a marker denom is removed
+type EventMarkerLockupRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerLockupRemoved) Reset()         { *m = EventMarkerLockupRemoved{} }
+func (m *EventMarkerLockupRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerLockupRemoved) ProtoMessage()    {}
+func (*EventMarkerLockupRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{24}
+}
+func (m *EventMarkerLockupRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerLockupRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerLockupRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerLockupRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerLockupRemoved.Merge(m, src)
+}
+func (m *EventMarkerLockupRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerLockupRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerLockupRemoved.DiscardUnknown(m)
 }
 
-func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerLockupRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerLockupRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerLockupRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatedBlockHeight != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.UpdatedBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Volume != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x10
+func (m *EventMarkerLockupRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// EventMarkerSplit event emitted when a marker's denom is split
+type EventMarkerSplit struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Numerator     string `protobuf:"bytes,2,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator   string `protobuf:"bytes,3,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	OldSupply     string `protobuf:"bytes,4,opt,name=old_supply,json=oldSupply,proto3" json:"old_supply,omitempty"`
+	NewSupply     string `protobuf:"bytes,5,opt,name=new_supply,json=newSupply,proto3" json:"new_supply,omitempty"`
+	HolderCount   string `protobuf:"bytes,6,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	Administrator string `protobuf:"bytes,7,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSplit) Reset()         { *m = EventMarkerSplit{} }
+func (m *EventMarkerSplit) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSplit) ProtoMessage()    {}
+func (*EventMarkerSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{25}
+}
+func (m *EventMarkerSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSplit.Merge(m, src)
+}
+func (m *EventMarkerSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSplit.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSplit proto.InternalMessageInfo
+
+func (m *EventMarkerSplit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSplit) GetNumerator() string {
+	if m != nil {
+		return m.Numerator
+	}
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MarkerType) > 0 {
-		i -= len(m.MarkerType)
-		copy(dAtA[i:], m.MarkerType)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventMarkerSplit) GetDenominator() string {
+	if m != nil {
+		return m.Denominator
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventMarkerSplit) GetOldSupply() string {
+	if m != nil {
+		return m.OldSupply
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerSplit) GetNewSupply() string {
+	if m != nil {
+		return m.NewSupply
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSplit) GetHolderCount() string {
+	if m != nil {
+		return m.HolderCount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSplit) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerRedemptionConfigSet event emitted when a marker's redemption configuration is set
+type EventMarkerRedemptionConfigSet struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RedeemDenom   string `protobuf:"bytes,2,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMarkerRedemptionConfigSet) Reset()         { *m = EventMarkerRedemptionConfigSet{} }
+func (m *EventMarkerRedemptionConfigSet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionConfigSet) ProtoMessage()    {}
+func (*EventMarkerRedemptionConfigSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{26}
+}
+func (m *EventMarkerRedemptionConfigSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionConfigSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionConfigSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerRedemptionConfigSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionConfigSet.Merge(m, src)
+}
+func (m *EventMarkerRedemptionConfigSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionConfigSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionConfigSet.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerRedemptionConfigSet proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionConfigSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerRedemptionConfigSet) GetRedeemDenom() string {
+	if m != nil {
+		return m.RedeemDenom
+	}
+	return ""
 }
 
-func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *EventMarkerRedemptionConfigSet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerRedemptionConfigRemoved event emitted when a marker's redemption configuration is removed
+type EventMarkerRedemptionConfigRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerRedemptionConfigRemoved) Reset()         { *m = EventMarkerRedemptionConfigRemoved{} }
+func (m *EventMarkerRedemptionConfigRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionConfigRemoved) ProtoMessage()    {}
+func (*EventMarkerRedemptionConfigRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{27}
+}
+func (m *EventMarkerRedemptionConfigRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionConfigRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionConfigRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerRedemptionConfigRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionConfigRemoved.Merge(m, src)
+}
+func (m *EventMarkerRedemptionConfigRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionConfigRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionConfigRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRedemptionConfigRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionConfigRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerRedemptionConfigRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerRedemptionQueued event emitted when a redemption is queued for approval
+type EventMarkerRedemptionQueued struct {
+	RedemptionId string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Redeemer     string `protobuf:"bytes,3,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerRedemptionQueued) Reset()         { *m = EventMarkerRedemptionQueued{} }
+func (m *EventMarkerRedemptionQueued) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionQueued) ProtoMessage()    {}
+func (*EventMarkerRedemptionQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{28}
+}
+func (m *EventMarkerRedemptionQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerRedemptionQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionQueued.Merge(m, src)
+}
+func (m *EventMarkerRedemptionQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRedemptionQueued proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionQueued) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	if len(m.RemoveAddress) > 0 {
-		i -= len(m.RemoveAddress)
-		copy(dAtA[i:], m.RemoveAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerRedemptionQueued) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerRedemptionQueued) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerRedemptionQueued) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+// EventMarkerRedeemed event emitted when units of a marker denom are redeemed
+type EventMarkerRedeemed struct {
+	RedemptionId  string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Redeemer      string `protobuf:"bytes,3,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payout        string `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerRedeemed) Reset()         { *m = EventMarkerRedeemed{} }
+func (m *EventMarkerRedeemed) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedeemed) ProtoMessage()    {}
+func (*EventMarkerRedeemed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{29}
+}
+func (m *EventMarkerRedeemed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedeemed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedeemed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerRedeemed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedeemed.Merge(m, src)
+}
+func (m *EventMarkerRedeemed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedeemed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedeemed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRedeemed proto.InternalMessageInfo
+
+func (m *EventMarkerRedeemed) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerRedeemed) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerRedeemed) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
+	}
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerRedeemed) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerRedeemed) GetPayout() string {
+	if m != nil {
+		return m.Payout
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerRedeemed) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerRedemptionRejected event emitted when a queued redemption is rejected
+type EventMarkerRedemptionRejected struct {
+	RedemptionId  string `protobuf:"bytes,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Redeemer      string `protobuf:"bytes,3,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerRedemptionRejected) Reset()         { *m = EventMarkerRedemptionRejected{} }
+func (m *EventMarkerRedemptionRejected) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRedemptionRejected) ProtoMessage()    {}
+func (*EventMarkerRedemptionRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{30}
+}
+func (m *EventMarkerRedemptionRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRedemptionRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRedemptionRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerRedemptionRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRedemptionRejected.Merge(m, src)
+}
+func (m *EventMarkerRedemptionRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRedemptionRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRedemptionRejected.DiscardUnknown(m)
 }
 
-func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerRedemptionRejected proto.InternalMessageInfo
+
+func (m *EventMarkerRedemptionRejected) GetRedemptionId() string {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerRedemptionRejected) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerRedemptionRejected) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerRedemptionRejected) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerRedemptionRejected) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerTransferLimitSet event emitted when a marker's default transfer limit or an account's transfer limit is set
+type EventMarkerTransferLimitSet struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Daily         string `protobuf:"bytes,3,opt,name=daily,proto3" json:"daily,omitempty"`
+	Weekly        string `protobuf:"bytes,4,opt,name=weekly,proto3" json:"weekly,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerTransferLimitSet) Reset()         { *m = EventMarkerTransferLimitSet{} }
+func (m *EventMarkerTransferLimitSet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransferLimitSet) ProtoMessage()    {}
+func (*EventMarkerTransferLimitSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{31}
+}
+func (m *EventMarkerTransferLimitSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransferLimitSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransferLimitSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerTransferLimitSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransferLimitSet.Merge(m, src)
+}
+func (m *EventMarkerTransferLimitSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransferLimitSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransferLimitSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransferLimitSet proto.InternalMessageInfo
+
+func (m *EventMarkerTransferLimitSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerTransferLimitSet) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransferLimitSet) GetDaily() string {
+	if m != nil {
+		return m.Daily
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerTransferLimitSet) GetWeekly() string {
+	if m != nil {
+		return m.Weekly
+	}
+	return ""
 }
 
-func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerTransferLimitSet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventMarkerTransferLimitRemoved event emitted when a marker's default transfer limit or an account's transfer limit
+// is removed
+type EventMarkerTransferLimitRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerTransferLimitRemoved) Reset()         { *m = EventMarkerTransferLimitRemoved{} }
+func (m *EventMarkerTransferLimitRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransferLimitRemoved) ProtoMessage()    {}
+func (*EventMarkerTransferLimitRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{32}
 }
-
-func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerTransferLimitRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransferLimitRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransferLimitRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerTransferLimitRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransferLimitRemoved.Merge(m, src)
+}
+func (m *EventMarkerTransferLimitRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransferLimitRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransferLimitRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransferLimitRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerTransferLimitRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Coins) > 0 {
-		i -= len(m.Coins)
-		copy(dAtA[i:], m.Coins)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerTransferLimitRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransferLimitRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerSendDenyAdded event emitted when an address is added to a marker's deny send list
+type EventMarkerSendDenyAdded struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Expiration    string `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerSendDenyAdded) Reset()         { *m = EventMarkerSendDenyAdded{} }
+func (m *EventMarkerSendDenyAdded) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSendDenyAdded) ProtoMessage()    {}
+func (*EventMarkerSendDenyAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{33}
+}
+func (m *EventMarkerSendDenyAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSendDenyAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSendDenyAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSendDenyAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSendDenyAdded.Merge(m, src)
+}
+func (m *EventMarkerSendDenyAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSendDenyAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSendDenyAdded.DiscardUnknown(m)
 }
 
-func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSendDenyAdded proto.InternalMessageInfo
+
+func (m *EventMarkerSendDenyAdded) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSendDenyAdded) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataSymbol) > 0 {
-		i -= len(m.MetadataSymbol)
-		copy(dAtA[i:], m.MetadataSymbol)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.MetadataName) > 0 {
-		i -= len(m.MetadataName)
-		copy(dAtA[i:], m.MetadataName)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.MetadataDisplay) > 0 {
-		i -= len(m.MetadataDisplay)
-		copy(dAtA[i:], m.MetadataDisplay)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MetadataDescription) > 0 {
-		i -= len(m.MetadataDescription)
-		copy(dAtA[i:], m.MetadataDescription)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerSendDenyAdded) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.MetadataBase) > 0 {
-		i -= len(m.MetadataBase)
-		copy(dAtA[i:], m.MetadataBase)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSendDenyAdded) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSendDenyAdded) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerSendDenyRemoved event emitted when an address is removed from a marker's deny send list
+type EventMarkerSendDenyRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *EventMarkerSendDenyRemoved) Reset()         { *m = EventMarkerSendDenyRemoved{} }
+func (m *EventMarkerSendDenyRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSendDenyRemoved) ProtoMessage()    {}
+func (*EventMarkerSendDenyRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{34}
+}
+func (m *EventMarkerSendDenyRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSendDenyRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSendDenyRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Exponent) > 0 {
-		i -= len(m.Exponent)
-		copy(dAtA[i:], m.Exponent)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerSendDenyRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSendDenyRemoved.Merge(m, src)
+}
+func (m *EventMarkerSendDenyRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSendDenyRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSendDenyRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSendDenyRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerSendDenyRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSendDenyRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSendDenyRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerSendDenyExpired event emitted when an address is automatically removed from a marker's deny send list
+// because its entry expired
+type EventMarkerSendDenyExpired struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Volume) > 0 {
-		i -= len(m.Volume)
-		copy(dAtA[i:], m.Volume)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Volume)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerSendDenyExpired) Reset()         { *m = EventMarkerSendDenyExpired{} }
+func (m *EventMarkerSendDenyExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSendDenyExpired) ProtoMessage()    {}
+func (*EventMarkerSendDenyExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{35}
+}
+func (m *EventMarkerSendDenyExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSendDenyExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSendDenyExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerSendDenyExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSendDenyExpired.Merge(m, src)
+}
+func (m *EventMarkerSendDenyExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSendDenyExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSendDenyExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSendDenyExpired proto.InternalMessageInfo
+
+func (m *EventMarkerSendDenyExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSendDenyExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSendDenyExpired) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerRecoveryProposed event emitted when a recovery of funds from an account is proposed
+type EventMarkerRecoveryProposed struct {
+	RecoveryId    string `protobuf:"bytes,1,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromAddress   string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount        string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChallengeEnd  string `protobuf:"bytes,7,opt,name=challenge_end,json=challengeEnd,proto3" json:"challenge_end,omitempty"`
+	Administrator string `protobuf:"bytes,8,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxSupply) > 0 {
-		i -= len(m.MaxSupply)
-		copy(dAtA[i:], m.MaxSupply)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxSupply)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerRecoveryProposed) Reset()         { *m = EventMarkerRecoveryProposed{} }
+func (m *EventMarkerRecoveryProposed) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRecoveryProposed) ProtoMessage()    {}
+func (*EventMarkerRecoveryProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{36}
+}
+func (m *EventMarkerRecoveryProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRecoveryProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRecoveryProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.EnableGovernance) > 0 {
-		i -= len(m.EnableGovernance)
-		copy(dAtA[i:], m.EnableGovernance)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.EnableGovernance)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerRecoveryProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRecoveryProposed.Merge(m, src)
+}
+func (m *EventMarkerRecoveryProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRecoveryProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRecoveryProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRecoveryProposed proto.InternalMessageInfo
+
+func (m *EventMarkerRecoveryProposed) GetRecoveryId() string {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventMarkerRecoveryProposed) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventMarkerRecoveryProposed) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	var l int
-	_ = l
-	if m.MaxTotalSupply != 0 {
-		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
+	return ""
+}
+
+func (m *EventMarkerRecoveryProposed) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	if m.EnableGovernance {
-		n += 2
+	return ""
+}
+
+func (m *EventMarkerRecoveryProposed) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRecoveryProposed) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MarkerAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerRecoveryProposed) GetChallengeEnd() string {
+	if m != nil {
+		return m.ChallengeEnd
 	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRecoveryProposed) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerRecoveryCancelled event emitted when a proposed recovery is cancelled
+type EventMarkerRecoveryCancelled struct {
+	RecoveryId  string `protobuf:"bytes,1,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Signer      string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventMarkerRecoveryCancelled) Reset()         { *m = EventMarkerRecoveryCancelled{} }
+func (m *EventMarkerRecoveryCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRecoveryCancelled) ProtoMessage()    {}
+func (*EventMarkerRecoveryCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{37}
+}
+func (m *EventMarkerRecoveryCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRecoveryCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRecoveryCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AccessControl) > 0 {
-		for _, e := range m.AccessControl {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
-		}
-	}
-	if m.Status != 0 {
-		n += 1 + sovMarker(uint64(m.Status))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = m.Supply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.MarkerType != 0 {
-		n += 1 + sovMarker(uint64(m.MarkerType))
+}
+func (m *EventMarkerRecoveryCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRecoveryCancelled.Merge(m, src)
+}
+func (m *EventMarkerRecoveryCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRecoveryCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRecoveryCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerRecoveryCancelled proto.InternalMessageInfo
+
+func (m *EventMarkerRecoveryCancelled) GetRecoveryId() string {
+	if m != nil {
+		return m.RecoveryId
 	}
-	if m.SupplyFixed {
-		n += 2
+	return ""
+}
+
+func (m *EventMarkerRecoveryCancelled) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	return ""
+}
+
+func (m *EventMarkerRecoveryCancelled) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	return ""
+}
+
+func (m *EventMarkerRecoveryCancelled) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerRecoveryExecuted event emitted when a proposed recovery is executed
+type EventMarkerRecoveryExecuted struct {
+	RecoveryId    string `protobuf:"bytes,1,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromAddress   string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount        string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Administrator string `protobuf:"bytes,6,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerRecoveryExecuted) Reset()         { *m = EventMarkerRecoveryExecuted{} }
+func (m *EventMarkerRecoveryExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRecoveryExecuted) ProtoMessage()    {}
+func (*EventMarkerRecoveryExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{38}
+}
+func (m *EventMarkerRecoveryExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRecoveryExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRecoveryExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerRecoveryExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRecoveryExecuted.Merge(m, src)
+}
+func (m *EventMarkerRecoveryExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRecoveryExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRecoveryExecuted.DiscardUnknown(m)
 }
 
-func (m *NetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.Volume != 0 {
-		n += 1 + sovMarker(uint64(m.Volume))
-	}
-	if m.UpdatedBlockHeight != 0 {
-		n += 1 + sovMarker(uint64(m.UpdatedBlockHeight))
+var xxx_messageInfo_EventMarkerRecoveryExecuted proto.InternalMessageInfo
+
+func (m *EventMarkerRecoveryExecuted) GetRecoveryId() string {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAdd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MarkerType)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerRecoveryExecuted) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Access.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerRecoveryExecuted) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRecoveryExecuted) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerRecoveryExecuted) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerRecoveryExecuted) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerHolderLimitSet event emitted when a marker's holder limit is set
+type EventMarkerHolderLimitSet struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxHolders    string `protobuf:"bytes,2,opt,name=max_holders,json=maxHolders,proto3" json:"max_holders,omitempty"`
+	MinHolding    string `protobuf:"bytes,3,opt,name=min_holding,json=minHolding,proto3" json:"min_holding,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerHolderLimitSet) Reset()         { *m = EventMarkerHolderLimitSet{} }
+func (m *EventMarkerHolderLimitSet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerHolderLimitSet) ProtoMessage()    {}
+func (*EventMarkerHolderLimitSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{39}
+}
+func (m *EventMarkerHolderLimitSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerHolderLimitSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerHolderLimitSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerHolderLimitSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerHolderLimitSet.Merge(m, src)
+}
+func (m *EventMarkerHolderLimitSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerHolderLimitSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerHolderLimitSet.DiscardUnknown(m)
 }
 
-func (m *EventMarkerDeleteAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RemoveAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerHolderLimitSet proto.InternalMessageInfo
+
+func (m *EventMarkerHolderLimitSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerFinalize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerHolderLimitSet) GetMaxHolders() string {
+	if m != nil {
+		return m.MaxHolders
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerActivate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerHolderLimitSet) GetMinHolding() string {
+	if m != nil {
+		return m.MinHolding
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerCancel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerHolderLimitSet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+// EventMarkerHolderLimitRemoved event emitted when a marker's holder limit is removed
+type EventMarkerHolderLimitRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerHolderLimitRemoved) Reset()         { *m = EventMarkerHolderLimitRemoved{} }
+func (m *EventMarkerHolderLimitRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerHolderLimitRemoved) ProtoMessage()    {}
+func (*EventMarkerHolderLimitRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{40}
+}
+func (m *EventMarkerHolderLimitRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerHolderLimitRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerHolderLimitRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerHolderLimitRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerHolderLimitRemoved.Merge(m, src)
+}
+func (m *EventMarkerHolderLimitRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerHolderLimitRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerHolderLimitRemoved.DiscardUnknown(m)
 }
 
-func (m *EventMarkerBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerHolderLimitRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerHolderLimitRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Coins)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerHolderLimitRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+// EventMarkerAccountDataSchemaSet event emitted when a marker's account data schema is set
+type EventMarkerAccountDataSchemaSet struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventMarkerAccountDataSchemaSet) Reset()         { *m = EventMarkerAccountDataSchemaSet{} }
+func (m *EventMarkerAccountDataSchemaSet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccountDataSchemaSet) ProtoMessage()    {}
+func (*EventMarkerAccountDataSchemaSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{41}
+}
+func (m *EventMarkerAccountDataSchemaSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccountDataSchemaSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccountDataSchemaSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerAccountDataSchemaSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccountDataSchemaSet.Merge(m, src)
+}
+func (m *EventMarkerAccountDataSchemaSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccountDataSchemaSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccountDataSchemaSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccountDataSchemaSet proto.InternalMessageInfo
+
+func (m *EventMarkerAccountDataSchemaSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerAccountDataSchemaSet) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	var l int
-	_ = l
-	l = len(m.MetadataBase)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerAccountDataSchemaRemoved event emitted when a marker's account data schema is removed
+type EventMarkerAccountDataSchemaRemoved struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) Reset()         { *m = EventMarkerAccountDataSchemaRemoved{} }
+func (m *EventMarkerAccountDataSchemaRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccountDataSchemaRemoved) ProtoMessage()    {}
+func (*EventMarkerAccountDataSchemaRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{42}
+}
+func (m *EventMarkerAccountDataSchemaRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccountDataSchemaRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccountDataSchemaRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MetadataDescription)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerAccountDataSchemaRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccountDataSchemaRemoved.Merge(m, src)
+}
+func (m *EventMarkerAccountDataSchemaRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccountDataSchemaRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccountDataSchemaRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccountDataSchemaRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerAccountDataSchemaRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.MetadataDisplay)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for _, e := range m.MetadataDenomUnits {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerIbcChannelPolicySet event emitted when a marker's IBC channel policy is set
+type EventMarkerIbcChannelPolicySet struct {
+	Denom         string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Mode          string   `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Channels      []string `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	Administrator string   `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerIbcChannelPolicySet) Reset()         { *m = EventMarkerIbcChannelPolicySet{} }
+func (m *EventMarkerIbcChannelPolicySet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerIbcChannelPolicySet) ProtoMessage()    {}
+func (*EventMarkerIbcChannelPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{43}
+}
+func (m *EventMarkerIbcChannelPolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerIbcChannelPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerIbcChannelPolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataName)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataSymbol)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerIbcChannelPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerIbcChannelPolicySet.Merge(m, src)
+}
+func (m *EventMarkerIbcChannelPolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerIbcChannelPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerIbcChannelPolicySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerIbcChannelPolicySet proto.InternalMessageInfo
+
+func (m *EventMarkerIbcChannelPolicySet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventDenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerIbcChannelPolicySet) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerIbcChannelPolicySet) GetChannels() []string {
+	if m != nil {
+		return m.Channels
 	}
-	l = len(m.Exponent)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return nil
+}
+
+func (m *EventMarkerIbcChannelPolicySet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerIbcChannelPolicyRemoved event emitted when a marker's IBC channel policy is removed
+type EventMarkerIbcChannelPolicyRemoved struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) Reset()         { *m = EventMarkerIbcChannelPolicyRemoved{} }
+func (m *EventMarkerIbcChannelPolicyRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerIbcChannelPolicyRemoved) ProtoMessage()    {}
+func (*EventMarkerIbcChannelPolicyRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{44}
+}
+func (m *EventMarkerIbcChannelPolicyRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerIbcChannelPolicyRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerIbcChannelPolicyRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerIbcChannelPolicyRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerIbcChannelPolicyRemoved.Merge(m, src)
+}
+func (m *EventMarkerIbcChannelPolicyRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerIbcChannelPolicyRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerIbcChannelPolicyRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerIbcChannelPolicyRemoved proto.InternalMessageInfo
+
+func (m *EventMarkerIbcChannelPolicyRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
+	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
+	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
+	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
+	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
+	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
+	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
+	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
+	proto.RegisterType((*EventMarkerAccessExpired)(nil), "provenance.marker.v1.EventMarkerAccessExpired")
+	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
+	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
+	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
+	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
+	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
+	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
+	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
+	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
+	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
+	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.marker.v1.EventSetNetAssetValue")
+	proto.RegisterType((*EventMarkerParamsUpdated)(nil), "provenance.marker.v1.EventMarkerParamsUpdated")
+	proto.RegisterType((*EventMarkerDistributionCreated)(nil), "provenance.marker.v1.EventMarkerDistributionCreated")
+	proto.RegisterType((*EventMarkerDistributionProgress)(nil), "provenance.marker.v1.EventMarkerDistributionProgress")
+	proto.RegisterType((*EventMarkerDistributionCompleted)(nil), "provenance.marker.v1.EventMarkerDistributionCompleted")
+	proto.RegisterType((*EventMarkerLockupSet)(nil), "provenance.marker.v1.EventMarkerLockupSet")
+	proto.RegisterType((*EventMarkerLockupRemoved)(nil), "provenance.marker.v1.EventMarkerLockupRemoved")
+	proto.RegisterType((*EventMarkerSplit)(nil), "provenance.marker.v1.EventMarkerSplit")
+	proto.RegisterType((*EventMarkerRedemptionConfigSet)(nil), "provenance.marker.v1.EventMarkerRedemptionConfigSet")
+	proto.RegisterType((*EventMarkerRedemptionConfigRemoved)(nil), "provenance.marker.v1.EventMarkerRedemptionConfigRemoved")
+	proto.RegisterType((*EventMarkerRedemptionQueued)(nil), "provenance.marker.v1.EventMarkerRedemptionQueued")
+	proto.RegisterType((*EventMarkerRedeemed)(nil), "provenance.marker.v1.EventMarkerRedeemed")
+	proto.RegisterType((*EventMarkerRedemptionRejected)(nil), "provenance.marker.v1.EventMarkerRedemptionRejected")
+	proto.RegisterType((*EventMarkerTransferLimitSet)(nil), "provenance.marker.v1.EventMarkerTransferLimitSet")
+	proto.RegisterType((*EventMarkerTransferLimitRemoved)(nil), "provenance.marker.v1.EventMarkerTransferLimitRemoved")
+	proto.RegisterType((*EventMarkerSendDenyAdded)(nil), "provenance.marker.v1.EventMarkerSendDenyAdded")
+	proto.RegisterType((*EventMarkerSendDenyRemoved)(nil), "provenance.marker.v1.EventMarkerSendDenyRemoved")
+	proto.RegisterType((*EventMarkerSendDenyExpired)(nil), "provenance.marker.v1.EventMarkerSendDenyExpired")
+	proto.RegisterType((*EventMarkerRecoveryProposed)(nil), "provenance.marker.v1.EventMarkerRecoveryProposed")
+	proto.RegisterType((*EventMarkerRecoveryCancelled)(nil), "provenance.marker.v1.EventMarkerRecoveryCancelled")
+	proto.RegisterType((*EventMarkerRecoveryExecuted)(nil), "provenance.marker.v1.EventMarkerRecoveryExecuted")
+	proto.RegisterType((*EventMarkerHolderLimitSet)(nil), "provenance.marker.v1.EventMarkerHolderLimitSet")
+	proto.RegisterType((*EventMarkerHolderLimitRemoved)(nil), "provenance.marker.v1.EventMarkerHolderLimitRemoved")
+	proto.RegisterType((*EventMarkerAccountDataSchemaSet)(nil), "provenance.marker.v1.EventMarkerAccountDataSchemaSet")
+	proto.RegisterType((*EventMarkerAccountDataSchemaRemoved)(nil), "provenance.marker.v1.EventMarkerAccountDataSchemaRemoved")
+	proto.RegisterType((*EventMarkerIbcChannelPolicySet)(nil), "provenance.marker.v1.EventMarkerIbcChannelPolicySet")
+	proto.RegisterType((*EventMarkerIbcChannelPolicyRemoved)(nil), "provenance.marker.v1.EventMarkerIbcChannelPolicyRemoved")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+
+var fileDescriptor_f7e2c25c71db7f99 = []byte{
+	// 2358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x5a, 0x8a, 0xa2, 0xc5, 0xa1, 0x24, 0x33, 0x6b, 0x59, 0xa6, 0x14, 0x5b, 0xa2, 0xd7, 0xf9,
+	0xfd, 0xac, 0xba, 0x0d, 0x15, 0xab, 0x08, 0x50, 0x18, 0xbd, 0x50, 0x24, 0x1d, 0x13, 0xb5, 0x25,
+	0x65, 0x29, 0xb9, 0x48, 0x5a, 0x60, 0x3b, 0xda, 0x7d, 0xa2, 0x26, 0xda, 0xdd, 0xd9, 0xee, 0x87,
+	0x3e, 0x8a, 0x02, 0xbd, 0x14, 0x41, 0x60, 0xe4, 0xe0, 0xde, 0x92, 0x83, 0x01, 0x07, 0x2d, 0x8a,
+	0x00, 0xb9, 0xe6, 0xdc, 0x43, 0x4e, 0x41, 0x4f, 0x3e, 0xf4, 0x50, 0xf4, 0xe0, 0x16, 0x36, 0x0a,
+	0xf4, 0x50, 0xb4, 0xff, 0x42, 0x31, 0x1f, 0xbb, 0xdc, 0x15, 0x49, 0x59, 0xb6, 0xac, 0xdc, 0xf8,
+	0x3e, 0xe6, 0xcd, 0xfb, 0x9e, 0xf7, 0x96, 0xe8, 0xaa, 0xe7, 0xd3, 0x3d, 0x70, 0xb1, 0x6b, 0xc2,
+	0x92, 0x83, 0xfd, 0x5d, 0xf0, 0x97, 0xf6, 0x6e, 0xca, 0x5f, 0x35, 0xcf, 0xa7, 0x21, 0x55, 0xa7,
+	0x7b, 0x2c, 0x35, 0x49, 0xd8, 0xbb, 0x39, 0x37, 0xdd, 0xa5, 0x5d, 0xca, 0x19, 0x96, 0xd8, 0x2f,
+	0xc1, 0x3b, 0x37, 0x6f, 0xd2, 0xc0, 0xa1, 0xc1, 0x12, 0x8e, 0xc2, 0x9d, 0xa5, 0xbd, 0x9b, 0x5b,
+	0x10, 0xe2, 0x9b, 0x1c, 0x90, 0xf4, 0x59, 0x41, 0x37, 0xc4, 0x41, 0x01, 0x1c, 0x39, 0xba, 0x85,
+	0x03, 0x48, 0x8e, 0x9a, 0x94, 0xb8, 0x31, 0xbd, 0x4b, 0x69, 0xd7, 0x86, 0x25, 0x0e, 0x6d, 0x45,
+	0xdb, 0x4b, 0x56, 0xe4, 0xe3, 0x90, 0xd0, 0x98, 0xfe, 0xff, 0x03, 0x2d, 0xc1, 0xa6, 0x09, 0x41,
+	0xd0, 0xf5, 0xb1, 0x1b, 0x0a, 0x3e, 0xed, 0x9b, 0x1c, 0x2a, 0xac, 0x63, 0x1f, 0x3b, 0x81, 0xfa,
+	0x03, 0x54, 0x76, 0xf0, 0x81, 0x11, 0xd2, 0x10, 0xdb, 0x46, 0x10, 0x79, 0x9e, 0x7d, 0x58, 0x51,
+	0xaa, 0xca, 0x62, 0x7e, 0x25, 0x57, 0x51, 0xf4, 0x29, 0x07, 0x1f, 0x6c, 0x30, 0x52, 0x87, 0x53,
+	0xd4, 0xef, 0xa3, 0x37, 0xc0, 0xc5, 0x5b, 0x36, 0x18, 0x5d, 0xba, 0x07, 0x3e, 0xbf, 0xa9, 0x92,
+	0xab, 0x2a, 0x8b, 0xe3, 0x7a, 0x59, 0x10, 0xde, 0x4b, 0xf0, 0xea, 0x8f, 0x50, 0x25, 0x72, 0x7d,
+	0x08, 0x42, 0x9f, 0x98, 0x21, 0x58, 0x86, 0x05, 0x2e, 0x75, 0x0c, 0x1f, 0xba, 0x70, 0x50, 0x19,
+	0xad, 0x2a, 0x8b, 0x45, 0x7d, 0x26, 0x4d, 0x6f, 0x32, 0xb2, 0xce, 0xa8, 0xea, 0x8f, 0x11, 0x62,
+	0x4a, 0x49, 0x75, 0xf2, 0x8c, 0x77, 0xe5, 0xca, 0xb7, 0x4f, 0x17, 0x46, 0xfe, 0xf6, 0x74, 0xe1,
+	0xa2, 0xf0, 0x51, 0x60, 0xed, 0xd6, 0x08, 0x5d, 0x72, 0x70, 0xb8, 0x53, 0x6b, 0xbb, 0xa1, 0x5e,
+	0x74, 0xf0, 0x81, 0x54, 0xd2, 0x40, 0xb3, 0x3e, 0x98, 0x4c, 0x8f, 0x43, 0xc3, 0xdc, 0xc1, 0xb6,
+	0x0d, 0x6e, 0x17, 0x0c, 0x0f, 0x7c, 0x42, 0xad, 0xca, 0x58, 0x55, 0x59, 0x2c, 0x2d, 0xcf, 0xd6,
+	0x84, 0x27, 0x6b, 0xb1, 0x27, 0x6b, 0x4d, 0xe9, 0xc9, 0x95, 0x71, 0x76, 0xcf, 0x67, 0x7f, 0x5f,
+	0x50, 0xf4, 0x4b, 0xb1, 0x94, 0x46, 0x2c, 0x64, 0x9d, 0xcb, 0xb8, 0x95, 0xff, 0xd7, 0xe3, 0x05,
+	0x45, 0xfb, 0x4f, 0x1e, 0x4d, 0xde, 0xe3, 0x4e, 0xae, 0x9b, 0x26, 0x8d, 0xdc, 0x50, 0x6d, 0xa3,
+	0x09, 0x16, 0x39, 0x03, 0x0b, 0x98, 0xfb, 0xb1, 0xb4, 0x5c, 0xad, 0xc9, 0x18, 0xf3, 0x1c, 0x90,
+	0x51, 0xad, 0xad, 0xe0, 0x00, 0xe4, 0xb9, 0x95, 0xfc, 0x93, 0xa7, 0x0b, 0x8a, 0x5e, 0xda, 0xea,
+	0xa1, 0xd4, 0x0a, 0x3a, 0xe7, 0x60, 0x17, 0x77, 0xc1, 0xe7, 0xee, 0x2d, 0xea, 0x31, 0xa8, 0xae,
+	0xa2, 0x29, 0x11, 0x50, 0xc3, 0xa4, 0x6e, 0xe8, 0x53, 0xbb, 0x32, 0x5a, 0x1d, 0x5d, 0x2c, 0x2d,
+	0x5f, 0xad, 0x0d, 0xca, 0xd1, 0x5a, 0x9d, 0xf3, 0xbe, 0xc7, 0x82, 0xbf, 0x92, 0x67, 0xa6, 0xe9,
+	0x93, 0xe2, 0x78, 0x43, 0x9c, 0x56, 0x6f, 0xa1, 0x42, 0x10, 0xe2, 0x30, 0x0a, 0xb8, 0x9f, 0xa7,
+	0x96, 0xb5, 0xc1, 0x72, 0x84, 0xa5, 0x1d, 0xce, 0xa9, 0xcb, 0x13, 0xea, 0x34, 0x1a, 0xe3, 0x41,
+	0xe5, 0x5e, 0x2d, 0xea, 0x02, 0x50, 0xdf, 0x45, 0x05, 0x19, 0xb9, 0xc2, 0x49, 0x22, 0x27, 0x99,
+	0xd5, 0x3a, 0x2a, 0x89, 0xeb, 0x8c, 0xf0, 0xd0, 0x83, 0xca, 0x39, 0xae, 0x4d, 0xf5, 0x38, 0x6d,
+	0x36, 0x0e, 0x3d, 0xd0, 0x91, 0x93, 0xfc, 0x56, 0xaf, 0xa2, 0x09, 0x21, 0xcc, 0xd8, 0x26, 0x07,
+	0x60, 0x55, 0xc6, 0x79, 0x66, 0x96, 0x04, 0xee, 0x36, 0x43, 0xb1, 0xa4, 0xc4, 0xb6, 0x4d, 0xf7,
+	0x53, 0x09, 0x9c, 0x38, 0xb2, 0xc8, 0xd9, 0x67, 0x38, 0xbd, 0x97, 0xc7, 0xb1, 0xa3, 0x96, 0xd1,
+	0x45, 0x71, 0x72, 0x9b, 0xfa, 0x26, 0x58, 0x46, 0xe8, 0x63, 0x37, 0xd8, 0x06, 0xbf, 0x82, 0xf8,
+	0xb1, 0x0b, 0x9c, 0x78, 0x9b, 0xd3, 0x36, 0x24, 0x49, 0x5d, 0x42, 0x17, 0x7c, 0xf8, 0x65, 0x44,
+	0x7c, 0xb0, 0x0c, 0x1c, 0x86, 0x3e, 0xd9, 0x8a, 0x42, 0x08, 0x2a, 0xa5, 0xea, 0xe8, 0x62, 0x51,
+	0x57, 0x63, 0x52, 0x3d, 0xa1, 0xdc, 0x9a, 0xfb, 0xe4, 0xf1, 0xc2, 0xc8, 0x67, 0x8f, 0x17, 0x46,
+	0xfe, 0xfc, 0xf5, 0xdb, 0x53, 0x99, 0xec, 0x6a, 0x6b, 0x0f, 0x15, 0x34, 0xb9, 0x0a, 0x61, 0x3d,
+	0x08, 0x20, 0xbc, 0x8f, 0xed, 0x08, 0xd4, 0x77, 0xd1, 0x98, 0xe7, 0x13, 0x13, 0x64, 0xa6, 0xcd,
+	0xc6, 0x99, 0xc6, 0x32, 0x29, 0xc9, 0xb4, 0x06, 0x25, 0xae, 0x0c, 0xbd, 0xe0, 0x56, 0x67, 0x50,
+	0x61, 0x8f, 0xda, 0x91, 0x23, 0x4a, 0x37, 0xaf, 0x4b, 0x48, 0x7d, 0x07, 0x4d, 0x47, 0x9e, 0x85,
+	0x59, 0xad, 0x6e, 0xd9, 0xd4, 0xdc, 0x35, 0x76, 0x80, 0x74, 0x77, 0x42, 0x5e, 0xac, 0x79, 0x5d,
+	0x95, 0xb4, 0x15, 0x46, 0xba, 0xc3, 0x29, 0xda, 0x57, 0x0a, 0x9a, 0x6a, 0xed, 0x81, 0x1b, 0x4a,
+	0x55, 0x2d, 0xab, 0x97, 0x13, 0x4a, 0x3a, 0x27, 0x66, 0x50, 0x01, 0x3b, 0xbc, 0x28, 0x44, 0x3a,
+	0x4b, 0x88, 0xe1, 0x65, 0xf6, 0x89, 0x8e, 0x20, 0xa1, 0x74, 0xfe, 0xe7, 0xb3, 0xf9, 0xbf, 0x90,
+	0x4d, 0x13, 0x91, 0x79, 0xe9, 0x24, 0xa8, 0xa0, 0x73, 0xd8, 0xb2, 0x7c, 0x08, 0x02, 0x91, 0x7f,
+	0x7a, 0x0c, 0x6a, 0x9f, 0x2b, 0x68, 0x3a, 0xab, 0xad, 0xa8, 0x0e, 0xb5, 0x85, 0x0a, 0xa2, 0x28,
+	0xa4, 0x23, 0xaf, 0x0f, 0xce, 0xba, 0xf4, 0x59, 0xce, 0x2e, 0xdd, 0x2a, 0x0f, 0xf7, 0x4c, 0xcf,
+	0xa5, 0x4d, 0x7f, 0x0b, 0x4d, 0x62, 0xcb, 0x21, 0x2e, 0x09, 0x42, 0x1f, 0x87, 0xd4, 0x97, 0x96,
+	0x66, 0x91, 0xda, 0x1a, 0x7a, 0xa3, 0x4f, 0x7c, 0xda, 0x14, 0x25, 0x63, 0x8a, 0x5a, 0x45, 0x25,
+	0x0f, 0x7c, 0x87, 0x04, 0x01, 0xa1, 0x6e, 0x50, 0xc9, 0xf1, 0x84, 0x4a, 0xa3, 0xb4, 0x5f, 0xa3,
+	0x4b, 0x29, 0x81, 0x4d, 0xb0, 0x21, 0x04, 0x29, 0xf6, 0xff, 0xd0, 0x94, 0x0f, 0x0e, 0xdd, 0x03,
+	0x23, 0x2b, 0x7d, 0x52, 0x60, 0xeb, 0xf2, 0x8e, 0xd3, 0x98, 0xf3, 0x11, 0xaa, 0xf4, 0x99, 0xd3,
+	0x3a, 0xf0, 0x58, 0xb6, 0x1f, 0x63, 0xd5, 0xe0, 0x1b, 0xe7, 0x11, 0x02, 0x76, 0x94, 0xf7, 0x67,
+	0x79, 0x5d, 0x0a, 0xa3, 0xbd, 0x8f, 0x2e, 0xa4, 0xee, 0xba, 0x4d, 0x5c, 0x6c, 0x93, 0x5f, 0xc1,
+	0x90, 0x44, 0xec, 0x53, 0x3f, 0x37, 0x48, 0xfd, 0xac, 0xc8, 0xba, 0x19, 0x92, 0x3d, 0x1c, 0x9e,
+	0x4e, 0x64, 0x36, 0xc0, 0x0d, 0x96, 0x5a, 0xf6, 0x6b, 0x14, 0x28, 0x02, 0x7c, 0x2a, 0x81, 0x80,
+	0xce, 0xa7, 0x04, 0xde, 0x23, 0xa2, 0x3c, 0x65, 0xd9, 0x2a, 0x99, 0xb2, 0x3d, 0x4d, 0x6a, 0x64,
+	0xaf, 0x59, 0x89, 0x7c, 0xf7, 0x4c, 0xae, 0xf9, 0x58, 0xc9, 0xc4, 0xf0, 0xa7, 0x24, 0xdc, 0xb1,
+	0x7c, 0xbc, 0xcf, 0x64, 0xb2, 0x89, 0x2a, 0xce, 0x3d, 0x01, 0x9c, 0xe6, 0x26, 0xf5, 0x0a, 0x42,
+	0x21, 0x4d, 0x4a, 0x49, 0xb4, 0xab, 0x62, 0x48, 0x65, 0x19, 0x69, 0x5f, 0x65, 0x15, 0x49, 0xde,
+	0x86, 0x33, 0x30, 0xfa, 0x05, 0xaa, 0xb0, 0xf7, 0x71, 0xdb, 0xa7, 0x4e, 0xc2, 0x20, 0x9a, 0x67,
+	0x89, 0xe1, 0x62, 0x6d, 0xff, 0x9d, 0x43, 0x6f, 0xa6, 0xb4, 0xed, 0x40, 0xc8, 0xe7, 0xb2, 0x7b,
+	0x10, 0x62, 0x0b, 0x87, 0x58, 0xbd, 0x86, 0x26, 0x1d, 0xf9, 0xdb, 0x60, 0xcf, 0x8c, 0x54, 0x7e,
+	0x22, 0x46, 0xb2, 0xb9, 0x46, 0xbd, 0x89, 0xa6, 0x13, 0x26, 0x0b, 0x02, 0xd3, 0x27, 0x1e, 0xaf,
+	0x5d, 0x61, 0xd1, 0x85, 0x98, 0xd6, 0xec, 0x91, 0xd4, 0xef, 0xa1, 0x72, 0xef, 0x08, 0x09, 0x3c,
+	0x1b, 0x1f, 0x4a, 0x13, 0xcf, 0x27, 0xec, 0x02, 0xad, 0xde, 0xcf, 0x48, 0x67, 0x33, 0x65, 0xe4,
+	0x92, 0x90, 0x99, 0xcb, 0xe6, 0xa0, 0xb7, 0x8e, 0xe9, 0xdd, 0xdc, 0x94, 0x4d, 0x97, 0x84, 0xba,
+	0xda, 0xd3, 0x41, 0xa2, 0x82, 0x7e, 0x17, 0x8f, 0x0d, 0x72, 0x71, 0xda, 0x01, 0x2e, 0x76, 0xa0,
+	0x52, 0xc8, 0x3a, 0x60, 0x15, 0x3b, 0xa0, 0x5e, 0x47, 0x89, 0xd6, 0x46, 0x70, 0xe8, 0x6c, 0x51,
+	0x9b, 0xcf, 0x33, 0x45, 0x7d, 0x2a, 0x46, 0x77, 0x38, 0x56, 0xfb, 0xb9, 0x7c, 0x3f, 0x13, 0x35,
+	0x86, 0x54, 0xf0, 0x1c, 0x1a, 0x87, 0x03, 0x8f, 0xba, 0x90, 0xbc, 0xa0, 0x09, 0xcc, 0xfb, 0xa9,
+	0x4d, 0x70, 0x00, 0x01, 0x1f, 0x05, 0x8b, 0x7a, 0x0c, 0x6a, 0x01, 0xba, 0xc8, 0xa5, 0x77, 0x20,
+	0xcc, 0x0e, 0x0e, 0x83, 0x2f, 0x99, 0x8e, 0xc7, 0x09, 0x99, 0x79, 0x47, 0xa7, 0x05, 0xf9, 0x44,
+	0x0b, 0x88, 0xe1, 0x03, 0x1a, 0xf9, 0x26, 0xc8, 0x3c, 0x93, 0x90, 0xf6, 0x58, 0xc9, 0xf4, 0x7e,
+	0xb1, 0x67, 0x6c, 0x8a, 0xd9, 0x61, 0xf0, 0x02, 0x21, 0x94, 0x78, 0xb9, 0x05, 0x22, 0x77, 0xec,
+	0x02, 0x71, 0x25, 0xb3, 0x40, 0x08, 0xbd, 0x7b, 0x1b, 0x82, 0xf6, 0x4f, 0x05, 0xcd, 0xa7, 0x7b,
+	0x27, 0x09, 0xc4, 0x00, 0x46, 0xa8, 0xdb, 0xf0, 0x81, 0x2b, 0x7a, 0x1d, 0x9d, 0xb7, 0x52, 0x68,
+	0x83, 0x58, 0x52, 0xcd, 0xa9, 0x34, 0xba, 0x6d, 0x0d, 0x29, 0xd7, 0x5e, 0x71, 0x8f, 0x66, 0x8a,
+	0xbb, 0x2f, 0xc7, 0xf2, 0x83, 0x72, 0xec, 0x2a, 0x9a, 0xd8, 0xa1, 0xb6, 0x05, 0xbe, 0x21, 0x16,
+	0x09, 0x59, 0xa7, 0x02, 0xd7, 0xe0, 0x82, 0xae, 0xa1, 0x49, 0xb1, 0xb3, 0x31, 0x24, 0x71, 0xbb,
+	0x71, 0x1a, 0x72, 0xe4, 0x1d, 0x81, 0xd3, 0xfe, 0xa8, 0xa0, 0x85, 0x21, 0x76, 0xae, 0xfb, 0xb4,
+	0xcb, 0x7b, 0xc2, 0x29, 0x0d, 0x4d, 0x54, 0x0d, 0x0c, 0x0f, 0x13, 0xab, 0x32, 0x9a, 0x56, 0x35,
+	0x58, 0xc7, 0xc4, 0xea, 0xb3, 0x26, 0xdf, 0x67, 0x8d, 0xf6, 0x85, 0x82, 0xaa, 0xc3, 0x02, 0x42,
+	0x1d, 0xcf, 0x86, 0xd7, 0x10, 0x92, 0x2a, 0x2a, 0x25, 0x7c, 0x90, 0x28, 0x9a, 0x42, 0xa9, 0x97,
+	0x51, 0xd1, 0x07, 0x07, 0x13, 0xd7, 0x4a, 0xc6, 0xce, 0x1e, 0x42, 0xfb, 0x6d, 0x76, 0x7a, 0xbc,
+	0x4b, 0xcd, 0xdd, 0xc8, 0xeb, 0xc0, 0xb0, 0x8a, 0x4d, 0x4d, 0x39, 0xb9, 0xec, 0x94, 0x33, 0x83,
+	0x0a, 0x6c, 0x84, 0x4e, 0x74, 0x90, 0xd0, 0xc9, 0x72, 0x43, 0xf3, 0x50, 0xa5, 0x4f, 0x0b, 0x9d,
+	0xcf, 0x6d, 0xd6, 0x4b, 0x6b, 0x72, 0xb2, 0x97, 0xf4, 0xbf, 0x0a, 0x2a, 0xa7, 0x9f, 0x04, 0xcf,
+	0x1e, 0xda, 0xa6, 0x2e, 0xa3, 0xa2, 0x1b, 0x39, 0x90, 0x1e, 0x32, 0x7a, 0x08, 0x1e, 0x01, 0xc6,
+	0x46, 0xdc, 0xd4, 0x65, 0x69, 0x14, 0xab, 0x5b, 0x6a, 0x5b, 0x99, 0xc5, 0x5f, 0x2f, 0x52, 0xdb,
+	0x92, 0x9b, 0xfd, 0x15, 0x84, 0x5c, 0xd8, 0x8f, 0xc9, 0x63, 0x52, 0x3e, 0xec, 0x4b, 0xf2, 0xd1,
+	0x44, 0x2b, 0xf4, 0x97, 0x4d, 0x9f, 0xc5, 0xe7, 0x06, 0x59, 0xfc, 0x9b, 0x4c, 0x7b, 0xd0, 0xc1,
+	0x02, 0xc7, 0x13, 0xb9, 0xe8, 0x6e, 0x93, 0xee, 0xf0, 0x98, 0x5f, 0x45, 0x13, 0x3e, 0x58, 0x00,
+	0x8e, 0x91, 0xce, 0xbf, 0x92, 0xc0, 0x35, 0x5f, 0x62, 0x78, 0xf9, 0x05, 0xd2, 0x8e, 0x51, 0xe0,
+	0xf8, 0x70, 0x9f, 0x6c, 0xd8, 0xfb, 0x54, 0x41, 0x6f, 0x0e, 0xbc, 0xe2, 0xfd, 0x08, 0x22, 0xb0,
+	0x58, 0x7f, 0xf1, 0x13, 0x5c, 0xaf, 0xd4, 0x26, 0x7a, 0xc8, 0xa1, 0x85, 0x36, 0x87, 0xc6, 0x85,
+	0xc5, 0x10, 0x5b, 0x97, 0xc0, 0xa9, 0xbe, 0x98, 0x4f, 0xf7, 0x45, 0xed, 0x9b, 0xec, 0x90, 0xa4,
+	0x0b, 0xfe, 0xef, 0x5a, 0x0d, 0x86, 0xf7, 0xf0, 0x21, 0x8d, 0xe2, 0x96, 0x2b, 0xa1, 0x7e, 0x9f,
+	0x16, 0x06, 0xf9, 0xf4, 0x6b, 0x05, 0x5d, 0x19, 0xe8, 0x53, 0x1d, 0x3e, 0x02, 0x33, 0xfc, 0xee,
+	0xcd, 0x39, 0xd1, 0x44, 0xa3, 0x7d, 0x91, 0x4d, 0x85, 0x78, 0x40, 0xbd, 0x4b, 0x1c, 0x12, 0xbe,
+	0x4a, 0x7f, 0x63, 0xfc, 0x98, 0x24, 0xef, 0xae, 0x00, 0x98, 0x8e, 0xfb, 0x00, 0xbb, 0x49, 0x59,
+	0x4b, 0xe8, 0x84, 0x3a, 0xee, 0xa3, 0x85, 0x61, 0x2a, 0x9e, 0x6d, 0xf3, 0xfb, 0x32, 0x3b, 0xcd,
+	0x74, 0xc0, 0x65, 0x73, 0xc6, 0x61, 0xdd, 0xb2, 0x5e, 0xe1, 0xca, 0x19, 0x54, 0xf0, 0x01, 0x07,
+	0xc9, 0x16, 0x2b, 0xa1, 0x23, 0x1b, 0x6e, 0xfe, 0xe8, 0x86, 0x7b, 0x42, 0x1f, 0xf9, 0x68, 0x6e,
+	0x80, 0xa6, 0x67, 0xeb, 0x1e, 0x7b, 0xe0, 0x9d, 0xf1, 0xa6, 0xff, 0xb2, 0x77, 0xbe, 0x68, 0xd3,
+	0xff, 0x5d, 0xee, 0x48, 0xd3, 0x12, 0xdf, 0x67, 0xd7, 0x7d, 0xea, 0xd1, 0x00, 0x2c, 0xf6, 0x6d,
+	0x28, 0xf9, 0xf2, 0x9b, 0x14, 0x17, 0x8a, 0x51, 0xc7, 0xcd, 0x30, 0x99, 0xb5, 0x68, 0xb4, 0x6f,
+	0x2d, 0x7a, 0xd1, 0x62, 0xd5, 0x2b, 0xc0, 0xb1, 0xa3, 0xfd, 0x44, 0x06, 0xbc, 0x90, 0x09, 0xf8,
+	0x35, 0x34, 0xd9, 0xfb, 0x32, 0x0d, 0xae, 0x25, 0x9f, 0xa1, 0x89, 0x04, 0xd9, 0x72, 0x07, 0xcc,
+	0x03, 0xe3, 0x83, 0x22, 0xf0, 0x50, 0x41, 0x97, 0x07, 0xf8, 0x44, 0x7c, 0x60, 0xb0, 0xcf, 0xd4,
+	0x29, 0x6c, 0x03, 0x20, 0x5d, 0x37, 0x19, 0x96, 0x24, 0xa4, 0xfd, 0x45, 0x19, 0x18, 0xa6, 0xd6,
+	0x01, 0x98, 0x51, 0x78, 0xa6, 0x1a, 0xbd, 0x62, 0x98, 0x4e, 0xd6, 0xde, 0x3f, 0x57, 0xd0, 0x6c,
+	0xca, 0xac, 0x3b, 0x7c, 0xac, 0x78, 0x41, 0x97, 0xe4, 0x5f, 0x2b, 0x0f, 0x0c, 0x39, 0x0e, 0x4b,
+	0x7b, 0xd8, 0x6e, 0x22, 0x4e, 0x07, 0x9c, 0x81, 0xb8, 0xc9, 0x14, 0x2f, 0x73, 0xde, 0x21, 0xae,
+	0x9c, 0xe1, 0x4f, 0x38, 0x15, 0xfe, 0x2c, 0xf3, 0xf2, 0xa4, 0x54, 0x7b, 0x1d, 0xb3, 0xc2, 0x5a,
+	0xa6, 0xf9, 0xca, 0xef, 0xd1, 0x4d, 0xb6, 0xc4, 0x9a, 0x3b, 0xe0, 0xe0, 0xe1, 0xd6, 0xf7, 0x12,
+	0x24, 0x97, 0x49, 0x90, 0x0e, 0xba, 0x76, 0x9c, 0xc0, 0xe3, 0x75, 0x1e, 0x26, 0xf4, 0xd3, 0xec,
+	0x52, 0xd7, 0xde, 0x32, 0x1b, 0x3b, 0xd8, 0x75, 0xc1, 0x5e, 0xa7, 0x36, 0x31, 0x0f, 0x87, 0x6b,
+	0xa9, 0xa2, 0xbc, 0x43, 0xad, 0x78, 0xeb, 0xe5, 0xbf, 0xd9, 0x6b, 0x6b, 0x8a, 0xd3, 0xf1, 0x52,
+	0x9d, 0xc0, 0x27, 0x8c, 0x48, 0x76, 0x84, 0x3b, 0xaa, 0xcd, 0x6b, 0x08, 0xcb, 0x8d, 0x8f, 0x15,
+	0x84, 0x7a, 0x7f, 0x84, 0xa8, 0x8b, 0xe8, 0xd2, 0xbd, 0xba, 0xfe, 0x93, 0x96, 0x6e, 0x6c, 0x7c,
+	0xb0, 0xde, 0x32, 0x36, 0x57, 0x3b, 0xeb, 0xad, 0x46, 0xfb, 0x76, 0xbb, 0xd5, 0x2c, 0x8f, 0xcc,
+	0x95, 0x1e, 0x3c, 0xaa, 0x9e, 0xdb, 0x74, 0x77, 0x5d, 0xba, 0xcf, 0x9e, 0x9b, 0x72, 0x9a, 0xb3,
+	0xb1, 0xd6, 0x5e, 0x2d, 0x2b, 0x73, 0xe3, 0x0f, 0x1e, 0x55, 0xf3, 0xec, 0xcf, 0x02, 0xb5, 0x86,
+	0x66, 0xd2, 0x74, 0xbd, 0xd5, 0xd9, 0xd0, 0xdb, 0x8d, 0x8d, 0x56, 0xb3, 0x9c, 0x9b, 0x53, 0x1f,
+	0x3c, 0xaa, 0x4e, 0xe9, 0xc9, 0xce, 0xcd, 0xf8, 0x6f, 0xfc, 0x29, 0x87, 0x26, 0xd2, 0xff, 0x0f,
+	0xa9, 0xcb, 0x68, 0x56, 0x0a, 0xe8, 0x6c, 0xd4, 0x37, 0x36, 0x3b, 0x47, 0x94, 0xb9, 0xf0, 0xe0,
+	0x51, 0xf5, 0xbc, 0x60, 0xdd, 0x74, 0x2d, 0xd8, 0x26, 0x2e, 0x58, 0xa9, 0x4b, 0xe5, 0x99, 0x75,
+	0x7d, 0x6d, 0x7d, 0xad, 0xd3, 0x6a, 0x96, 0x15, 0x71, 0xa9, 0x38, 0x90, 0xf4, 0xfa, 0x77, 0xd0,
+	0xa5, 0x2c, 0xff, 0xed, 0xf6, 0x6a, 0xfd, 0x6e, 0xfb, 0x43, 0xae, 0x65, 0xea, 0x86, 0xf8, 0x7b,
+	0xb0, 0xa5, 0xde, 0x40, 0xd3, 0xd9, 0x13, 0xf5, 0xc6, 0x46, 0xfb, 0x7e, 0xab, 0x3c, 0x3a, 0x57,
+	0x7e, 0xf0, 0xa8, 0x3a, 0x21, 0xd8, 0xf9, 0xb7, 0x5e, 0xe8, 0x97, 0xde, 0xa8, 0xaf, 0x36, 0x5a,
+	0x77, 0xef, 0xb6, 0x9a, 0xe5, 0x7c, 0x5a, 0x7a, 0xaf, 0xcd, 0xf6, 0x9d, 0x68, 0x32, 0xb7, 0xad,
+	0x7d, 0xd0, 0x6a, 0x96, 0xc7, 0xd2, 0x27, 0x9a, 0xcc, 0x77, 0xf4, 0x10, 0xac, 0xb9, 0xf1, 0x4f,
+	0x7e, 0x3f, 0x3f, 0xf2, 0xe5, 0x1f, 0xe6, 0x47, 0x56, 0xba, 0xdf, 0x3e, 0x9b, 0x57, 0x9e, 0x3c,
+	0x9b, 0x57, 0xfe, 0xf1, 0x6c, 0x5e, 0x79, 0xf8, 0x7c, 0x7e, 0xe4, 0xc9, 0xf3, 0xf9, 0x91, 0xbf,
+	0x3e, 0x9f, 0x1f, 0x41, 0x97, 0x08, 0x1d, 0xf8, 0x3d, 0x6b, 0x5d, 0xf9, 0x70, 0xb9, 0x4b, 0xc2,
+	0x9d, 0x68, 0xab, 0x66, 0x52, 0x67, 0xa9, 0xc7, 0xf2, 0x36, 0xa1, 0x29, 0x68, 0xe9, 0x20, 0xfe,
+	0x1f, 0x98, 0xfd, 0x59, 0x12, 0x6c, 0x15, 0xf8, 0xff, 0x9d, 0x3f, 0xfc, 0xdf, 0x00, 0x34, 0x56,
+	0x65, 0xa5, 0xf3, 0x1e, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTotalSupply != that1.MaxTotalSupply {
+		return false
+	}
+	if this.EnableGovernance != that1.EnableGovernance {
+		return false
+	}
+	if this.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.RecoveryChallengePeriod != that1.RecoveryChallengePeriod {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecoveryChallengePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecoveryChallengePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMarker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnableGovernance {
+		i--
+		if m.EnableGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccessControl) > 0 {
+		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Volume != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MarkerType) > 0 {
+		i -= len(m.MarkerType)
+		copy(dAtA[i:], m.MarkerType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddress) > 0 {
+		i -= len(m.RemoveAddress)
+		copy(dAtA[i:], m.RemoveAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccessExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccessExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccessExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataSymbol) > 0 {
+		i -= len(m.MetadataSymbol)
+		copy(dAtA[i:], m.MetadataSymbol)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MetadataName) > 0 {
+		i -= len(m.MetadataName)
+		copy(dAtA[i:], m.MetadataName)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MetadataDisplay) > 0 {
+		i -= len(m.MetadataDisplay)
+		copy(dAtA[i:], m.MetadataDisplay)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetadataDescription) > 0 {
+		i -= len(m.MetadataDescription)
+		copy(dAtA[i:], m.MetadataDescription)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataBase) > 0 {
+		i -= len(m.MetadataBase)
+		copy(dAtA[i:], m.MetadataBase)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Exponent) > 0 {
+		i -= len(m.Exponent)
+		copy(dAtA[i:], m.Exponent)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetNetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EnableGovernance) > 0 {
+		i -= len(m.EnableGovernance)
+		copy(dAtA[i:], m.EnableGovernance)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.EnableGovernance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalHolding) > 0 {
+		i -= len(m.TotalHolding)
+		copy(dAtA[i:], m.TotalHolding)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TotalHolding)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HolderCount) > 0 {
+		i -= len(m.HolderCount)
+		copy(dAtA[i:], m.HolderCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderCount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HolderCount) > 0 {
+		i -= len(m.HolderCount)
+		copy(dAtA[i:], m.HolderCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HoldersPaid) > 0 {
+		i -= len(m.HoldersPaid)
+		copy(dAtA[i:], m.HoldersPaid)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HoldersPaid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		i -= len(m.Remainder)
+		copy(dAtA[i:], m.Remainder)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Remainder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Distributed) > 0 {
+		i -= len(m.Distributed)
+		copy(dAtA[i:], m.Distributed)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Distributed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerLockupSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerLockupSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerLockupSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Locked) > 0 {
+		i -= len(m.Locked)
+		copy(dAtA[i:], m.Locked)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Locked)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerLockupRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerLockupRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerLockupRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HolderCount) > 0 {
+		i -= len(m.HolderCount)
+		copy(dAtA[i:], m.HolderCount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderCount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewSupply) > 0 {
+		i -= len(m.NewSupply)
+		copy(dAtA[i:], m.NewSupply)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.NewSupply)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OldSupply) > 0 {
+		i -= len(m.OldSupply)
+		copy(dAtA[i:], m.OldSupply)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OldSupply)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denominator) > 0 {
+		i -= len(m.Denominator)
+		copy(dAtA[i:], m.Denominator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denominator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Numerator) > 0 {
+		i -= len(m.Numerator)
+		copy(dAtA[i:], m.Numerator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Numerator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionConfigSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionConfigSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionConfigSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RedeemDenom) > 0 {
+		i -= len(m.RedeemDenom)
+		copy(dAtA[i:], m.RedeemDenom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedeemDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionConfigRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionConfigRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionConfigRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedeemed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedeemed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedeemed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRedemptionRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRedemptionRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRedemptionRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionId) > 0 {
+		i -= len(m.RedemptionId)
+		copy(dAtA[i:], m.RedemptionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RedemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransferLimitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransferLimitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransferLimitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Weekly) > 0 {
+		i -= len(m.Weekly)
+		copy(dAtA[i:], m.Weekly)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Weekly)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Daily) > 0 {
+		i -= len(m.Daily)
+		copy(dAtA[i:], m.Daily)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Daily)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransferLimitRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransferLimitRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransferLimitRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSendDenyAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSendDenyAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSendDenyAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSendDenyRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSendDenyRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSendDenyRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSendDenyExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSendDenyExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSendDenyExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRecoveryProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRecoveryProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRecoveryProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ChallengeEnd) > 0 {
+		i -= len(m.ChallengeEnd)
+		copy(dAtA[i:], m.ChallengeEnd)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ChallengeEnd)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecoveryId) > 0 {
+		i -= len(m.RecoveryId)
+		copy(dAtA[i:], m.RecoveryId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RecoveryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRecoveryCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRecoveryCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRecoveryCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecoveryId) > 0 {
+		i -= len(m.RecoveryId)
+		copy(dAtA[i:], m.RecoveryId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RecoveryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRecoveryExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRecoveryExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRecoveryExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecoveryId) > 0 {
+		i -= len(m.RecoveryId)
+		copy(dAtA[i:], m.RecoveryId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RecoveryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerHolderLimitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerHolderLimitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerHolderLimitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinHolding) > 0 {
+		i -= len(m.MinHolding)
+		copy(dAtA[i:], m.MinHolding)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MinHolding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxHolders) > 0 {
+		i -= len(m.MaxHolders)
+		copy(dAtA[i:], m.MaxHolders)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxHolders)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerHolderLimitRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerHolderLimitRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerHolderLimitRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccountDataSchemaSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccountDataSchemaSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccountDataSchemaSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerIbcChannelPolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerIbcChannelPolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerIbcChannelPolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
+	}
+	if m.EnableGovernance {
+		n += 2
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecoveryChallengePeriod)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *MarkerAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AccessControl) > 0 {
+		for _, e := range m.AccessControl {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.MarkerType != 0 {
+		n += 1 + sovMarker(uint64(m.MarkerType))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Volume != 0 {
+		n += 1 + sovMarker(uint64(m.Volume))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovMarker(uint64(m.UpdatedBlockHeight))
+	}
+	return n
+}
+
+func (m *EventMarkerAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAddAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Access.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerDeleteAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoveAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccessExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataBase)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDescription)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDisplay)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for _, e := range m.MetadataDenomUnits {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataName)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataSymbol)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Exponent)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetNetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnableGovernance)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.TotalHolding)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HoldersPaid)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Distributed)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Remainder)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerLockupSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Locked)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerLockupRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Numerator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denominator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.OldSupply)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.NewSupply)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderCount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionConfigSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.RedeemDenom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionConfigRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedeemed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRedemptionRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedemptionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransferLimitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Daily)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Weekly)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransferLimitRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSendDenyAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSendDenyRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSendDenyExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRecoveryProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecoveryId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ChallengeEnd)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRecoveryCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecoveryId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRecoveryExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecoveryId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerHolderLimitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxHolders)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MinHolding)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerHolderLimitRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccountDataSchemaSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccountDataSchemaRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerIbcChannelPolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerIbcChannelPolicyRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func sovMarker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarker(x uint64) (n int) {
+	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovernance = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryChallengePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecoveryChallengePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControl = append(m.AccessControl, AccessGrant{})
+			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccessExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccessExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccessExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
+			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exponent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnableGovernance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalHolding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HoldersPaid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDistributionCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDistributionCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDistributionCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerLockupSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerLockupSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerLockupSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerLockupRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerLockupRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerLockupRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Numerator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denominator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EventSetNetAssetValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Volume)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
-}
 
-func (m *EventMarkerParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EnableGovernance)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MaxSupply)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMarker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarker(x uint64) (n int) {
-	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRedemptionConfigSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2948,17 +11358,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRedemptionConfigSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRedemptionConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.MaxTotalSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2968,34 +11378,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.EnableGovernance = bool(v != 0)
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3023,11 +11426,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3055,9 +11458,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3080,7 +11481,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRedemptionConfigRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3103,17 +11504,17 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRedemptionConfigRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRedemptionConfigRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3123,31 +11524,109 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerRedemptionQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerRedemptionQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerRedemptionQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3175,13 +11654,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3191,48 +11670,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControl = append(m.AccessControl, AccessGrant{})
-			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3260,11 +11718,11 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3292,54 +11750,63 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerRedeemed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerRedeemed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerRedeemed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3349,17 +11816,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3369,15 +11848,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3405,63 +11896,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3471,30 +11912,29 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
-			m.Volume = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3504,16 +11944,29 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.UpdatedBlockHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3523,11 +11976,24 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -3549,7 +12015,7 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRedemptionRejected) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,15 +12038,15 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRedemptionRejected: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRedemptionRejected: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3608,11 +12074,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.RedemptionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3640,11 +12106,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3672,11 +12138,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3704,43 +12170,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarkerType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3768,7 +12202,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3791,7 +12225,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerTransferLimitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,17 +12248,17 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerTransferLimitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerTransferLimitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3834,28 +12268,27 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3883,11 +12316,11 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Daily", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3915,61 +12348,11 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Daily = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekly", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3997,11 +12380,11 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Weekly = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,7 +12412,7 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4052,7 +12435,7 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerTransferLimitRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4075,15 +12458,15 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerTransferLimitRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerTransferLimitRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4111,11 +12494,11 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4143,7 +12526,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4198,7 +12581,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSendDenyAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4221,10 +12604,10 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSendDenyAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSendDenyAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4261,7 +12644,7 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4289,61 +12672,43 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4371,9 +12736,9 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4426,7 +12791,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSendDenyRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4449,10 +12814,10 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSendDenyRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSendDenyRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4488,6 +12853,38 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4540,7 +12937,7 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSendDenyExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4563,10 +12960,10 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSendDenyExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSendDenyExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4603,7 +13000,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4631,7 +13028,39 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4654,7 +13083,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRecoveryProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4677,15 +13106,15 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRecoveryProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRecoveryProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4713,7 +13142,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.RecoveryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4749,7 +13178,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4777,59 +13206,41 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -4861,9 +13272,9 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4891,9 +13302,41 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeEnd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4946,7 +13389,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRecoveryCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4969,15 +13412,15 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRecoveryCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRecoveryCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5005,7 +13448,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = string(dAtA[iNdEx:postIndex])
+			m.RecoveryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5041,7 +13484,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5069,11 +13512,11 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5101,7 +13544,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5124,7 +13567,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRecoveryExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5147,15 +13590,15 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRecoveryExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRecoveryExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5183,7 +13626,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.RecoveryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5219,7 +13662,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5247,11 +13690,43 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5279,11 +13754,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5311,7 +13786,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5334,7 +13809,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerHolderLimitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5357,15 +13832,15 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerHolderLimitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerHolderLimitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5393,11 +13868,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataBase = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHolders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5425,11 +13900,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
+			m.MaxHolders = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHolding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5457,43 +13932,9 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
+			m.MinHolding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
-			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -5525,9 +13966,59 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerHolderLimitRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerHolderLimitRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerHolderLimitRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5555,11 +14046,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataName = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5587,7 +14078,7 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5610,7 +14101,7 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerAccountDataSchemaSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5633,10 +14124,10 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerAccountDataSchemaSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerAccountDataSchemaSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5673,7 +14164,7 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5701,11 +14192,61 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exponent = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccountDataSchemaRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccountDataSchemaRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccountDataSchemaRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5733,7 +14274,39 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5756,7 +14329,7 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerIbcChannelPolicySet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5779,10 +14352,10 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerIbcChannelPolicySet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerIbcChannelPolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5819,7 +14392,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5847,11 +14420,11 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5879,11 +14452,11 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = string(dAtA[iNdEx:postIndex])
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5911,7 +14484,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5934,7 +14507,7 @@ func (m *EventSetNetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerIbcChannelPolicyRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5957,15 +14530,15 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerParamsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerIbcChannelPolicyRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerIbcChannelPolicyRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5993,43 +14566,11 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnableGovernance = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6057,7 +14598,7 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
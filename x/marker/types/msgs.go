@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	feegranttypes "cosmossdk.io/x/feegrant"
@@ -46,6 +47,24 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgCreateDistributionRequest)(nil),
+	(*MsgSetLockupRequest)(nil),
+	(*MsgRemoveLockupRequest)(nil),
+	(*MsgSplitMarkerRequest)(nil),
+	(*MsgSetRedemptionConfigRequest)(nil),
+	(*MsgRedeemRequest)(nil),
+	(*MsgApproveRedemptionRequest)(nil),
+	(*MsgRejectRedemptionRequest)(nil),
+	(*MsgSetTransferLimitRequest)(nil),
+	(*MsgRemoveTransferLimitRequest)(nil),
+	(*MsgProposeRecoveryRequest)(nil),
+	(*MsgCancelRecoveryRequest)(nil),
+	(*MsgExecuteRecoveryRequest)(nil),
+	(*MsgSetHolderLimitRequest)(nil),
+	(*MsgRemoveHolderLimitRequest)(nil),
+	(*MsgSetAccountDataSchemaRequest)(nil),
+	(*MsgSetIbcChannelPolicyRequest)(nil),
+	(*MsgRemoveIbcChannelPolicyRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -566,6 +585,12 @@ func (msg MsgUpdateSendDenyListRequest) ValidateBasic() error {
 		}
 		seen[addr] = true
 	}
+	if len(msg.Reason) > MaxSendDenyReasonLength {
+		return fmt.Errorf("reason length %d exceeds maximum length of %d", len(msg.Reason), MaxSendDenyReasonLength)
+	}
+	if (len(msg.Reason) > 0 || msg.Expiration != nil) && len(msg.AddDeniedAddresses) == 0 {
+		return fmt.Errorf("a reason or expiration can only be provided when adding denied addresses")
+	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
@@ -728,6 +753,7 @@ func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	recoveryChallengePeriod time.Duration,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -736,6 +762,7 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			recoveryChallengePeriod,
 		),
 	}
 }
@@ -747,3 +774,375 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+func NewMsgCreateDistributionRequest(denom string, amount sdk.Coins, administrator string) *MsgCreateDistributionRequest {
+	return &MsgCreateDistributionRequest{
+		Denom:         denom,
+		Amount:        amount,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgCreateDistributionRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if msg.Amount.IsZero() {
+		return fmt.Errorf("distribution amount cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+func NewMsgSetLockupRequest(denom string, addr sdk.AccAddress, releases []LockupRelease, authority string) *MsgSetLockupRequest {
+	return &MsgSetLockupRequest{
+		Denom:     denom,
+		Address:   addr.String(),
+		Releases:  releases,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetLockupRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if err := ValidateLockupReleases(msg.Releases); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgRemoveLockupRequest(denom string, addr sdk.AccAddress, authority string) *MsgRemoveLockupRequest {
+	return &MsgRemoveLockupRequest{
+		Denom:     denom,
+		Address:   addr.String(),
+		Authority: authority,
+	}
+}
+
+func (msg MsgRemoveLockupRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgSplitMarkerRequest creates a new MsgSplitMarkerRequest
+func NewMsgSplitMarkerRequest(denom string, numerator, denominator uint64, authority string) *MsgSplitMarkerRequest {
+	return &MsgSplitMarkerRequest{
+		Denom:       denom,
+		Numerator:   numerator,
+		Denominator: denominator,
+		Authority:   authority,
+	}
+}
+
+func (msg MsgSplitMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateSplitRatio(msg.Numerator, msg.Denominator); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgSetRedemptionConfigRequest creates a new MsgSetRedemptionConfigRequest
+func NewMsgSetRedemptionConfigRequest(denom string, config *RedemptionConfig, authority string) *MsgSetRedemptionConfigRequest {
+	return &MsgSetRedemptionConfigRequest{
+		Denom:     denom,
+		Config:    config,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetRedemptionConfigRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Config != nil {
+		if msg.Config.Denom != msg.Denom {
+			return fmt.Errorf("redemption config denom %q does not match %q", msg.Config.Denom, msg.Denom)
+		}
+		if err := msg.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgRedeemRequest creates a new MsgRedeemRequest
+func NewMsgRedeemRequest(amount sdk.Coin, redeemer sdk.AccAddress) *MsgRedeemRequest {
+	return &MsgRedeemRequest{
+		Amount:   amount,
+		Redeemer: redeemer.String(),
+	}
+}
+
+func (msg MsgRedeemRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("redemption amount must be positive")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	return err
+}
+
+// NewMsgApproveRedemptionRequest creates a new MsgApproveRedemptionRequest
+func NewMsgApproveRedemptionRequest(denom string, id uint64, authority string) *MsgApproveRedemptionRequest {
+	return &MsgApproveRedemptionRequest{
+		Denom:        denom,
+		RedemptionId: id,
+		Authority:    authority,
+	}
+}
+
+func (msg MsgApproveRedemptionRequest) ValidateBasic() error {
+	return validateRedemptionResolution(msg.Denom, msg.RedemptionId, msg.Authority)
+}
+
+// NewMsgRejectRedemptionRequest creates a new MsgRejectRedemptionRequest
+func NewMsgRejectRedemptionRequest(denom string, id uint64, authority string) *MsgRejectRedemptionRequest {
+	return &MsgRejectRedemptionRequest{
+		Denom:        denom,
+		RedemptionId: id,
+		Authority:    authority,
+	}
+}
+
+func (msg MsgRejectRedemptionRequest) ValidateBasic() error {
+	return validateRedemptionResolution(msg.Denom, msg.RedemptionId, msg.Authority)
+}
+
+// validateRedemptionResolution checks the fields of a message that approves or rejects a pending redemption.
+func validateRedemptionResolution(denom string, id uint64, authority string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if id == 0 {
+		return fmt.Errorf("redemption id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(authority)
+	return err
+}
+
+// NewMsgSetTransferLimitRequest creates a new MsgSetTransferLimitRequest. A nil or empty addr sets the default limit.
+func NewMsgSetTransferLimitRequest(denom string, addr sdk.AccAddress, daily, weekly sdkmath.Int, authority string) *MsgSetTransferLimitRequest {
+	return &MsgSetTransferLimitRequest{
+		Limit:     NewTransferLimit(denom, addr, daily, weekly),
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetTransferLimitRequest) ValidateBasic() error {
+	if err := msg.Limit.Validate(); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgRemoveTransferLimitRequest creates a new MsgRemoveTransferLimitRequest. A nil or empty addr removes the
+// default limit.
+func NewMsgRemoveTransferLimitRequest(denom string, addr sdk.AccAddress, authority string) *MsgRemoveTransferLimitRequest {
+	rv := &MsgRemoveTransferLimitRequest{
+		Denom:     denom,
+		Authority: authority,
+	}
+	if len(addr) > 0 {
+		rv.Address = addr.String()
+	}
+	return rv
+}
+
+func (msg MsgRemoveTransferLimitRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+			return fmt.Errorf("invalid address: %w", err)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgSetHolderLimitRequest creates a new MsgSetHolderLimitRequest
+func NewMsgSetHolderLimitRequest(denom string, maxHolders uint64, minHolding sdkmath.Int, authority string) *MsgSetHolderLimitRequest {
+	return &MsgSetHolderLimitRequest{
+		Limit:     NewHolderLimit(denom, maxHolders, minHolding),
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetHolderLimitRequest) ValidateBasic() error {
+	if err := msg.Limit.Validate(); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgRemoveHolderLimitRequest creates a new MsgRemoveHolderLimitRequest
+func NewMsgRemoveHolderLimitRequest(denom string, authority string) *MsgRemoveHolderLimitRequest {
+	return &MsgRemoveHolderLimitRequest{
+		Denom:     denom,
+		Authority: authority,
+	}
+}
+
+func (msg MsgRemoveHolderLimitRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgSetAccountDataSchemaRequest creates a new MsgSetAccountDataSchemaRequest
+func NewMsgSetAccountDataSchemaRequest(denom, schema string, signer sdk.AccAddress) *MsgSetAccountDataSchemaRequest {
+	return &MsgSetAccountDataSchemaRequest{
+		Denom:  denom,
+		Schema: schema,
+		Signer: signer.String(),
+	}
+}
+
+func (msg MsgSetAccountDataSchemaRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Schema) > 0 {
+		if err := ValidateAccountDataSchema(msg.Schema); err != nil {
+			return err
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	return err
+}
+
+// NewMsgSetIbcChannelPolicyRequest creates a new MsgSetIbcChannelPolicyRequest
+func NewMsgSetIbcChannelPolicyRequest(policy IbcChannelPolicy, authority string) *MsgSetIbcChannelPolicyRequest {
+	return &MsgSetIbcChannelPolicyRequest{
+		Policy:    policy,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetIbcChannelPolicyRequest) ValidateBasic() error {
+	if err := msg.Policy.Validate(); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgRemoveIbcChannelPolicyRequest creates a new MsgRemoveIbcChannelPolicyRequest
+func NewMsgRemoveIbcChannelPolicyRequest(denom string, authority string) *MsgRemoveIbcChannelPolicyRequest {
+	return &MsgRemoveIbcChannelPolicyRequest{
+		Denom:     denom,
+		Authority: authority,
+	}
+}
+
+func (msg MsgRemoveIbcChannelPolicyRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// NewMsgProposeRecoveryRequest creates a new MsgProposeRecoveryRequest
+func NewMsgProposeRecoveryRequest(amount sdk.Coin, from, to sdk.AccAddress, reason string, administrator sdk.AccAddress) *MsgProposeRecoveryRequest {
+	return &MsgProposeRecoveryRequest{
+		Amount:        amount,
+		FromAddress:   from.String(),
+		ToAddress:     to.String(),
+		Reason:        reason,
+		Administrator: administrator.String(),
+	}
+}
+
+func (msg MsgProposeRecoveryRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if msg.FromAddress == msg.ToAddress {
+		return fmt.Errorf("recovery from and to addresses cannot be the same: %s", msg.FromAddress)
+	}
+	if msg.FromAddress == msg.Administrator {
+		return fmt.Errorf("cannot propose a recovery of funds from the administrator's own account")
+	}
+	if len(msg.Reason) > MaxRecoveryReasonLength {
+		return fmt.Errorf("recovery reason length %d exceeds maximum length of %d", len(msg.Reason), MaxRecoveryReasonLength)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("recovery amount must be positive")
+	}
+	return nil
+}
+
+// NewMsgCancelRecoveryRequest creates a new MsgCancelRecoveryRequest
+func NewMsgCancelRecoveryRequest(denom string, id uint64, signer string) *MsgCancelRecoveryRequest {
+	return &MsgCancelRecoveryRequest{
+		Denom:      denom,
+		RecoveryId: id,
+		Signer:     signer,
+	}
+}
+
+func (msg MsgCancelRecoveryRequest) ValidateBasic() error {
+	return validateRecoveryResolution(msg.Denom, msg.RecoveryId, msg.Signer)
+}
+
+// NewMsgExecuteRecoveryRequest creates a new MsgExecuteRecoveryRequest
+func NewMsgExecuteRecoveryRequest(denom string, id uint64, administrator string) *MsgExecuteRecoveryRequest {
+	return &MsgExecuteRecoveryRequest{
+		Denom:         denom,
+		RecoveryId:    id,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgExecuteRecoveryRequest) ValidateBasic() error {
+	return validateRecoveryResolution(msg.Denom, msg.RecoveryId, msg.Administrator)
+}
+
+// validateRecoveryResolution checks the fields of a message that cancels or executes a proposed recovery.
+func validateRecoveryResolution(denom string, id uint64, signer string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if id == 0 {
+		return fmt.Errorf("recovery id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(signer)
+	return err
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,6 +53,24 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateDistributionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetLockupRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveLockupRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSplitMarkerRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetRedemptionConfigRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRedeemRequest{Redeemer: signer} },
+		func(signer string) sdk.Msg { return &MsgApproveRedemptionRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRejectRedemptionRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetTransferLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveTransferLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgProposeRecoveryRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelRecoveryRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgExecuteRecoveryRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetHolderLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveHolderLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAccountDataSchemaRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetIbcChannelPolicyRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveIbcChannelPolicyRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -715,6 +734,7 @@ func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
 	denom := "somedenom"
 	addAddr := sdk.AccAddress("addAddr________________").String()
 	removeAddr := sdk.AccAddress("removeAddr________________").String()
+	expiration := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name   string
@@ -760,6 +780,20 @@ func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
 			msg:    MsgUpdateSendDenyListRequest{Denom: "1", RemoveDeniedAddresses: []string{removeAddr}, AddDeniedAddresses: []string{addAddr}, Authority: addr},
 			expErr: "invalid denom: 1",
 		},
+		{
+			name: "reason and expiration",
+			msg:  MsgUpdateSendDenyListRequest{Denom: denom, AddDeniedAddresses: []string{addAddr}, Authority: addr, Reason: "sanctions", Expiration: &expiration},
+		},
+		{
+			name:   "reason too long",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, AddDeniedAddresses: []string{addAddr}, Authority: addr, Reason: strings.Repeat("r", MaxSendDenyReasonLength+1)},
+			expErr: "reason length 257 exceeds maximum length of 256",
+		},
+		{
+			name:   "expiration without added addresses",
+			msg:    MsgUpdateSendDenyListRequest{Denom: denom, RemoveDeniedAddresses: []string{removeAddr}, Authority: addr, Expiration: &expiration},
+			expErr: "a reason or expiration can only be provided when adding denied addresses",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1238,6 +1272,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultRecoveryChallengePeriod,
 				),
 			},
 			expectError: false,
@@ -1250,6 +1285,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					DefaultRecoveryChallengePeriod,
 				),
 			},
 			expectError:   true,
@@ -1263,6 +1299,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultRecoveryChallengePeriod,
 				),
 			},
 			expectError:   true,
@@ -1283,3 +1320,172 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateDistributionRequestValidateBasic(t *testing.T) {
+	validAdmin := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateDistributionRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgCreateDistributionRequest("fundshare", sdk.NewCoins(sdk.NewInt64Coin("usd", 100)), validAdmin),
+		},
+		{
+			name:   "invalid denom",
+			msg:    *NewMsgCreateDistributionRequest("x", sdk.NewCoins(sdk.NewInt64Coin("usd", 100)), validAdmin),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "empty amount",
+			msg:    *NewMsgCreateDistributionRequest("fundshare", sdk.Coins{}, validAdmin),
+			expErr: "distribution amount cannot be zero",
+		},
+		{
+			name:   "negative amount",
+			msg:    *NewMsgCreateDistributionRequest("fundshare", sdk.Coins{sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)}}, validAdmin),
+			expErr: "coin -1usd amount is not positive",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    *NewMsgCreateDistributionRequest("fundshare", sdk.NewCoins(sdk.NewInt64Coin("usd", 100)), "invalid"),
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSetLockupRequestValidateBasic(t *testing.T) {
+	validAuthority := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	addr := sdk.AccAddress("lockedup____________")
+	releaseTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	release := NewLockupRelease(releaseTime, sdkmath.NewInt(1000))
+
+	tests := []struct {
+		name   string
+		msg    MsgSetLockupRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgSetLockupRequest("fundshare", addr, []LockupRelease{release}, validAuthority),
+		},
+		{
+			name:   "invalid denom",
+			msg:    *NewMsgSetLockupRequest("x", addr, []LockupRelease{release}, validAuthority),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "invalid address",
+			msg:    MsgSetLockupRequest{Denom: "fundshare", Address: "invalid", Releases: []LockupRelease{release}, Authority: validAuthority},
+			expErr: "invalid address: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "no releases",
+			msg:    *NewMsgSetLockupRequest("fundshare", addr, nil, validAuthority),
+			expErr: "lockup must have at least one release",
+		},
+		{
+			name:   "zero amount",
+			msg:    *NewMsgSetLockupRequest("fundshare", addr, []LockupRelease{NewLockupRelease(releaseTime, sdkmath.ZeroInt())}, validAuthority),
+			expErr: "release amount must be positive",
+		},
+		{
+			name:   "duplicate release time",
+			msg:    *NewMsgSetLockupRequest("fundshare", addr, []LockupRelease{release, release}, validAuthority),
+			expErr: "lockup contains multiple releases at 2027-01-01T00:00:00Z",
+		},
+		{
+			name:   "invalid authority",
+			msg:    *NewMsgSetLockupRequest("fundshare", addr, []LockupRelease{release}, "invalid"),
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgProposeRecoveryRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	from := sdk.AccAddress("lostkeys____________")
+	to := sdk.AccAddress("newkeys_____________")
+	amount := sdk.NewInt64Coin("fundshare", 1000)
+
+	tests := []struct {
+		name   string
+		msg    MsgProposeRecoveryRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgProposeRecoveryRequest(amount, from, to, "lost keys", admin),
+		},
+		{
+			name:   "invalid from address",
+			msg:    MsgProposeRecoveryRequest{Amount: amount, FromAddress: "invalid", ToAddress: to.String(), Administrator: admin.String()},
+			expErr: "invalid from address: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "invalid to address",
+			msg:    MsgProposeRecoveryRequest{Amount: amount, FromAddress: from.String(), ToAddress: "invalid", Administrator: admin.String()},
+			expErr: "invalid to address: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "same from and to",
+			msg:    *NewMsgProposeRecoveryRequest(amount, from, from, "", admin),
+			expErr: "recovery from and to addresses cannot be the same: " + from.String(),
+		},
+		{
+			name:   "from administrator",
+			msg:    *NewMsgProposeRecoveryRequest(amount, admin, to, "", admin),
+			expErr: "cannot propose a recovery of funds from the administrator's own account",
+		},
+		{
+			name:   "reason too long",
+			msg:    *NewMsgProposeRecoveryRequest(amount, from, to, strings.Repeat("r", MaxRecoveryReasonLength+1), admin),
+			expErr: "recovery reason length 257 exceeds maximum length of 256",
+		},
+		{
+			name:   "zero amount",
+			msg:    *NewMsgProposeRecoveryRequest(sdk.NewInt64Coin("fundshare", 0), from, to, "", admin),
+			expErr: "recovery amount must be positive",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    MsgProposeRecoveryRequest{Amount: amount, FromAddress: from.String(), ToAddress: to.String(), Administrator: "invalid"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 )
@@ -14,6 +15,8 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultRecoveryChallengePeriod is how long a proposed recovery must wait before it can be executed.
+	DefaultRecoveryChallengePeriod = 7 * 24 * time.Hour
 )
 
 // NewParams creates a new parameter object
@@ -21,11 +24,13 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	recoveryChallengePeriod time.Duration,
 ) Params {
 	return Params{
-		EnableGovernance:       enableGovernance,
-		UnrestrictedDenomRegex: unrestrictedDenomRegex,
-		MaxSupply:              maxSupply,
+		EnableGovernance:        enableGovernance,
+		UnrestrictedDenomRegex:  unrestrictedDenomRegex,
+		MaxSupply:               maxSupply,
+		RecoveryChallengePeriod: recoveryChallengePeriod,
 	}
 }
 
@@ -35,6 +40,7 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		DefaultRecoveryChallengePeriod,
 	)
 }
 
@@ -43,8 +49,13 @@ func (p Params) Validate() error {
 	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
 		return fmt.Errorf("invalid parameter, validation regex must not contain anchors ^,$")
 	}
-	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
-	return err
+	if _, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp)); err != nil {
+		return err
+	}
+	if p.RecoveryChallengePeriod <= 0 {
+		return fmt.Errorf("invalid parameter, recovery challenge period must be positive: %s", p.RecoveryChallengePeriod)
+	}
+	return nil
 }
 
 func StringToBigInt(val string) sdkmath.Int {
@@ -3,6 +3,7 @@ package types
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,10 +20,11 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultRecoveryChallengePeriod)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultRecoveryChallengePeriod)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply), DefaultRecoveryChallengePeriod)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), DefaultRecoveryChallengePeriod)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), time.Hour)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,7 +41,8 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`recovery_challenge_period:<seconds:604800 > `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
@@ -65,7 +68,8 @@ func TestParamsValidate(t *testing.T) {
 		{
 			name: "valid regex",
 			params: Params{
-				UnrestrictedDenomRegex: `[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}`,
+				UnrestrictedDenomRegex:  `[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}`,
+				RecoveryChallengePeriod: time.Hour,
 			},
 			expectedErr: "",
 		},
@@ -97,6 +101,21 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectedErr: "error parsing regexp: missing closing ):",
 		},
+		{
+			name: "negative recovery challenge period",
+			params: Params{
+				UnrestrictedDenomRegex:  `[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}`,
+				RecoveryChallengePeriod: -time.Hour,
+			},
+			expectedErr: "invalid parameter, recovery challenge period must be positive: -1h0m0s",
+		},
+		{
+			name: "zero recovery challenge period",
+			params: Params{
+				UnrestrictedDenomRegex: `[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}`,
+			},
+			expectedErr: "invalid parameter, recovery challenge period must be positive: 0s",
+		},
 	}
 
 	for _, tc := range testCases {
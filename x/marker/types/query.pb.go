@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,6 +17,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types3 "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -36,6 +38,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FlagFilter restricts a query to markers that either have or do not have a flag set.
+type FlagFilter int32
+
+const (
+	// FLAG_FILTER_UNSPECIFIED does not filter on the flag.
+	FlagFilterAny FlagFilter = 0
+	// FLAG_FILTER_TRUE only matches markers that have the flag set.
+	FlagFilterTrue FlagFilter = 1
+	// FLAG_FILTER_FALSE only matches markers that do not have the flag set.
+	FlagFilterFalse FlagFilter = 2
+)
+
+var FlagFilter_name = map[int32]string{
+	0: "FLAG_FILTER_UNSPECIFIED",
+	1: "FLAG_FILTER_TRUE",
+	2: "FLAG_FILTER_FALSE",
+}
+
+var FlagFilter_value = map[string]int32{
+	"FLAG_FILTER_UNSPECIFIED": 0,
+	"FLAG_FILTER_TRUE":        1,
+	"FLAG_FILTER_FALSE":       2,
+}
+
+func (x FlagFilter) String() string {
+	return proto.EnumName(FlagFilter_name, int32(x))
+}
+
+func (FlagFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -842,6 +876,10 @@ func (m *QueryAccountDataRequest) GetDenom() string {
 type QueryAccountDataResponse struct {
 	// The accountdata for the requested denom.
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// document is the parsed accountdata when it is a JSON object.
+	Document *types3.Struct `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	// schema is the JSON schema that the accountdata must conform to, if the marker has one.
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (m *QueryAccountDataResponse) Reset()         { *m = QueryAccountDataResponse{} }
@@ -884,6 +922,20 @@ func (m *QueryAccountDataResponse) GetValue() string {
 	return ""
 }
 
+func (m *QueryAccountDataResponse) GetDocument() *types3.Struct {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *QueryAccountDataResponse) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 // Balance defines an account address and balance pair used in queries for accounts holding a marker
 type Balance struct {
 	// address is the address of the balance holder.
@@ -1017,1606 +1069,10744 @@ func (m *QueryNetAssetValuesResponse) GetNetAssetValues() []NetAssetValue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.marker.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.marker.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllMarkersRequest)(nil), "provenance.marker.v1.QueryAllMarkersRequest")
-	proto.RegisterType((*QueryAllMarkersResponse)(nil), "provenance.marker.v1.QueryAllMarkersResponse")
-	proto.RegisterType((*QueryMarkerRequest)(nil), "provenance.marker.v1.QueryMarkerRequest")
-	proto.RegisterType((*QueryMarkerResponse)(nil), "provenance.marker.v1.QueryMarkerResponse")
-	proto.RegisterType((*QueryHoldingRequest)(nil), "provenance.marker.v1.QueryHoldingRequest")
-	proto.RegisterType((*QueryHoldingResponse)(nil), "provenance.marker.v1.QueryHoldingResponse")
-	proto.RegisterType((*QuerySupplyRequest)(nil), "provenance.marker.v1.QuerySupplyRequest")
-	proto.RegisterType((*QuerySupplyResponse)(nil), "provenance.marker.v1.QuerySupplyResponse")
-	proto.RegisterType((*QueryEscrowRequest)(nil), "provenance.marker.v1.QueryEscrowRequest")
-	proto.RegisterType((*QueryEscrowResponse)(nil), "provenance.marker.v1.QueryEscrowResponse")
-	proto.RegisterType((*QueryAccessRequest)(nil), "provenance.marker.v1.QueryAccessRequest")
-	proto.RegisterType((*QueryAccessResponse)(nil), "provenance.marker.v1.QueryAccessResponse")
-	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
-	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
-	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.marker.v1.QueryAccountDataRequest")
-	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.marker.v1.QueryAccountDataResponse")
-	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
-	proto.RegisterType((*QueryNetAssetValuesRequest)(nil), "provenance.marker.v1.QueryNetAssetValuesRequest")
-	proto.RegisterType((*QueryNetAssetValuesResponse)(nil), "provenance.marker.v1.QueryNetAssetValuesResponse")
+// QueryDistributionRequest is the request type for the Query/Distribution method.
+type QueryDistributionRequest struct {
+	// the id of the distribution
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
-
-var fileDescriptor_a76fb1fac8494cdc = []byte{
-	// 1163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x81, 0x38, 0x61, 0x02, 0x11, 0x0c, 0x16, 0x4d, 0xb6, 0xa9, 0xd3, 0x6c, 0xa3,
-	0x92, 0x98, 0x66, 0x37, 0x0e, 0x12, 0x48, 0xbd, 0x40, 0xd2, 0xd2, 0xc2, 0xa1, 0x55, 0xea, 0x48,
-	0x20, 0x55, 0x42, 0xd1, 0x78, 0x77, 0xd8, 0xae, 0xb2, 0x9e, 0x71, 0x77, 0xc6, 0x2e, 0x56, 0xd5,
-	0x0b, 0x5c, 0x7a, 0x40, 0xa2, 0x12, 0x37, 0x84, 0x44, 0x4e, 0xa8, 0xea, 0xa9, 0x07, 0x3e, 0x44,
-	0xc5, 0xa9, 0x12, 0x97, 0x9e, 0x00, 0x25, 0x48, 0xe5, 0x63, 0xa0, 0x9d, 0x79, 0x63, 0x7b, 0xf1,
-	0x7a, 0x63, 0xa4, 0xaa, 0x97, 0xc4, 0xb3, 0xfb, 0x7f, 0xf3, 0x7e, 0xf3, 0xde, 0xf8, 0xfd, 0x8d,
-	0xce, 0xb6, 0x13, 0xde, 0xa5, 0x8c, 0x30, 0x9f, 0x7a, 0x2d, 0x92, 0x1c, 0xd0, 0xc4, 0xeb, 0xd6,
-	0xbd, 0xdb, 0x1d, 0x9a, 0xf4, 0xdc, 0x76, 0xc2, 0x25, 0xc7, 0x95, 0x81, 0xc2, 0xd5, 0x0a, 0xb7,
-	0x5b, 0xb7, 0xdf, 0x22, 0xad, 0x88, 0x71, 0x4f, 0xfd, 0xd5, 0x42, 0xbb, 0x12, 0xf2, 0x90, 0xab,
-	0x8f, 0x5e, 0xfa, 0x09, 0x9e, 0x2e, 0x86, 0x9c, 0x87, 0x31, 0xf5, 0xd4, 0xaa, 0xd9, 0xf9, 0xca,
-	0x23, 0x0c, 0x76, 0xb6, 0x6b, 0x3e, 0x17, 0x2d, 0x2e, 0xbc, 0x26, 0x11, 0x54, 0xa7, 0xf4, 0xba,
-	0xf5, 0x26, 0x95, 0xa4, 0xee, 0xb5, 0x49, 0x18, 0x31, 0x22, 0x23, 0xce, 0x40, 0x5b, 0x1d, 0xd6,
-	0x1a, 0x95, 0xcf, 0xa3, 0xd1, 0xf7, 0xec, 0xa0, 0xff, 0x3e, 0x5d, 0x18, 0x0c, 0xfd, 0x7e, 0x5f,
-	0xf3, 0xe9, 0x05, 0xbc, 0x5a, 0x02, 0x42, 0xd2, 0x8e, 0x3c, 0xc2, 0x18, 0x97, 0x2a, 0xaf, 0x79,
-	0xbb, 0x92, 0x5b, 0x20, 0x28, 0x84, 0x96, 0x9c, 0xcf, 0x95, 0x10, 0xdf, 0xa7, 0x42, 0x84, 0x09,
-	0x61, 0x52, 0xeb, 0x9c, 0x0a, 0xc2, 0x37, 0xd2, 0x53, 0xee, 0x92, 0x84, 0xb4, 0x44, 0x83, 0xde,
-	0xee, 0x50, 0x21, 0x9d, 0x1b, 0xe8, 0xed, 0xcc, 0x53, 0xd1, 0xe6, 0x4c, 0x50, 0x7c, 0x11, 0x95,
-	0xdb, 0xea, 0xc9, 0x82, 0x75, 0xd6, 0x5a, 0x9b, 0xdb, 0x5a, 0x72, 0xf3, 0xfa, 0xe0, 0xea, 0xa8,
-	0x9d, 0x57, 0x9f, 0xfc, 0xb1, 0x5c, 0x6a, 0x40, 0x84, 0xf3, 0x93, 0x85, 0xde, 0x51, 0x7b, 0x6e,
-	0xc7, 0xf1, 0x35, 0x25, 0x35, 0xd9, 0xd2, 0x6d, 0x85, 0x24, 0xb2, 0xa3, 0xb7, 0x9d, 0xdf, 0x72,
-	0xf2, 0xb7, 0xd5, 0x51, 0x7b, 0x4a, 0xd9, 0x80, 0x08, 0x7c, 0x05, 0xa1, 0x41, 0x5f, 0x16, 0xa6,
-	0x14, 0xd6, 0x79, 0x17, 0x6a, 0x99, 0x36, 0xc6, 0xd5, 0xf7, 0x06, 0xca, 0xef, 0xee, 0x92, 0x90,
-	0x42, 0xde, 0xc6, 0x50, 0xa4, 0xf3, 0x8b, 0x85, 0x4e, 0x8d, 0xe0, 0xc1, 0xb1, 0x77, 0xd0, 0x8c,
-	0xa6, 0x48, 0x01, 0x5f, 0x59, 0x9b, 0xdb, 0xaa, 0xb8, 0xba, 0x3d, 0xae, 0xb9, 0x40, 0xee, 0x36,
-	0xeb, 0xed, 0xe0, 0xdf, 0x7e, 0xdd, 0x98, 0xd7, 0xb1, 0xdb, 0xbe, 0xcf, 0x3b, 0x4c, 0x7e, 0xd6,
-	0x30, 0x81, 0xf8, 0x6a, 0x0e, 0xe7, 0xbb, 0x27, 0x72, 0x6a, 0x80, 0x0c, 0xe8, 0x2a, 0x34, 0x4c,
-	0x27, 0x32, 0x25, 0x9c, 0x47, 0x53, 0x51, 0xa0, 0xca, 0xf7, 0x5a, 0x63, 0x2a, 0x0a, 0x9c, 0x2f,
-	0xa0, 0x81, 0x46, 0x05, 0x27, 0xf9, 0x18, 0x95, 0x35, 0x10, 0x34, 0x70, 0xf2, 0x83, 0x40, 0x9c,
-	0xd3, 0x82, 0x8d, 0x3f, 0xe5, 0x71, 0x10, 0xb1, 0x70, 0x4c, 0xfe, 0x17, 0xd6, 0x96, 0x43, 0x0b,
-	0x55, 0xb2, 0xf9, 0xe0, 0x24, 0x1f, 0xa1, 0xd9, 0x26, 0x89, 0xd3, 0x1b, 0x62, 0x9a, 0x72, 0x26,
-	0xff, 0xd6, 0xec, 0x68, 0x15, 0xdc, 0xc6, 0x7e, 0xd0, 0x8b, 0x6f, 0xc8, 0x5e, 0xa7, 0xdd, 0x8e,
-	0x7b, 0xe3, 0x1a, 0x72, 0x1d, 0xea, 0x66, 0x54, 0x70, 0x8c, 0x0f, 0x51, 0x99, 0xb4, 0xd2, 0x0a,
-	0x43, 0x43, 0x16, 0x33, 0x04, 0x26, 0xf7, 0x25, 0x1e, 0x31, 0xf3, 0x75, 0xd2, 0xf2, 0x7e, 0xd6,
-	0x4f, 0x84, 0x9f, 0xf0, 0x3b, 0xe3, 0xb2, 0x3e, 0xb0, 0x20, 0xad, 0x91, 0x41, 0xda, 0x1e, 0x2a,
-	0x53, 0xf5, 0x04, 0x6a, 0x57, 0x90, 0xf6, 0x4a, 0x9a, 0xf6, 0xd1, 0x9f, 0xcb, 0x6b, 0x61, 0x24,
-	0x6f, 0x75, 0x9a, 0xae, 0xcf, 0x5b, 0x30, 0xaa, 0xe0, 0xdf, 0x86, 0x08, 0x0e, 0x3c, 0xd9, 0x6b,
-	0x53, 0xa1, 0x02, 0xc4, 0x8f, 0xcf, 0x1f, 0xd7, 0x5e, 0x8f, 0x69, 0x48, 0xfc, 0xde, 0x7e, 0x3a,
-	0x0c, 0xc5, 0xc3, 0xe7, 0x8f, 0x6b, 0x56, 0x03, 0x12, 0xf6, 0xc1, 0xb7, 0xd5, 0x28, 0x1a, 0x07,
-	0x7e, 0x13, 0xb8, 0x8d, 0x0a, 0xb8, 0x2f, 0xa1, 0x59, 0xa2, 0x6f, 0xa4, 0xe9, 0xfa, 0x4a, 0x7e,
-	0xd7, 0x75, 0xdc, 0xd5, 0x74, 0xd0, 0x99, 0xce, 0x9b, 0x40, 0xa7, 0x8e, 0x16, 0xd5, 0xde, 0x97,
-	0x29, 0xe3, 0xad, 0x6b, 0x54, 0x92, 0x80, 0x48, 0x62, 0x40, 0x2a, 0x68, 0x3a, 0x48, 0x9f, 0x03,
-	0x8b, 0x5e, 0x38, 0x5f, 0x22, 0x3b, 0x2f, 0x64, 0x70, 0x17, 0x5b, 0xf0, 0x0c, 0xda, 0x78, 0x66,
-	0x50, 0x4f, 0x76, 0xd0, 0xaf, 0xa7, 0x09, 0x34, 0x44, 0x26, 0xc8, 0xf1, 0xcc, 0xec, 0xd1, 0x88,
-	0x97, 0x4f, 0xe4, 0xd9, 0x44, 0x0b, 0xa3, 0x01, 0x40, 0x53, 0x41, 0xd3, 0x5d, 0x12, 0x77, 0xa8,
-	0x89, 0x50, 0x8b, 0x74, 0xbe, 0xcd, 0xc0, 0x57, 0x01, 0x2f, 0xa0, 0x19, 0x12, 0x04, 0x09, 0x15,
-	0x02, 0x34, 0x66, 0x89, 0xef, 0xa0, 0x69, 0xd5, 0xb2, 0x85, 0xa9, 0x97, 0x75, 0x2d, 0x74, 0xbe,
-	0x8b, 0xb3, 0xf7, 0x0f, 0x97, 0x4b, 0xff, 0x1c, 0x2e, 0x97, 0x9c, 0x0b, 0x50, 0xea, 0xeb, 0x54,
-	0x6e, 0x0b, 0x41, 0xe5, 0xe7, 0x29, 0xfe, 0xd8, 0x7b, 0x92, 0xa0, 0xd3, 0xb9, 0x6a, 0xa8, 0xc5,
-	0x1e, 0x7a, 0x93, 0x51, 0xb9, 0x4f, 0xd2, 0x57, 0xfb, 0xaa, 0x10, 0xe6, 0xde, 0x9c, 0xcb, 0xbf,
-	0x37, 0x99, 0x7d, 0xa0, 0x4f, 0xf3, 0x2c, 0xb3, 0xf9, 0xd6, 0xb3, 0x39, 0x34, 0xad, 0x92, 0xe2,
-	0x6f, 0x2d, 0x54, 0xd6, 0x66, 0x87, 0xd7, 0xf2, 0xf7, 0x1b, 0xf5, 0x56, 0x7b, 0x7d, 0x02, 0xa5,
-	0xc6, 0x77, 0x56, 0xbf, 0xf9, 0xfd, 0xef, 0x1f, 0xa6, 0xaa, 0x78, 0xc9, 0xcb, 0x75, 0x73, 0xed,
-	0xac, 0xf8, 0x3b, 0x0b, 0xa1, 0x81, 0x6b, 0xe1, 0x0b, 0x05, 0xfb, 0x8f, 0x78, 0xaf, 0xbd, 0x31,
-	0xa1, 0x1a, 0x88, 0x56, 0x14, 0xd1, 0x69, 0xbc, 0x98, 0x4f, 0x44, 0xe2, 0x18, 0xdf, 0xb7, 0x50,
-	0x59, 0x87, 0x15, 0x16, 0x25, 0xe3, 0x5f, 0x85, 0x45, 0xc9, 0x7a, 0x98, 0xb3, 0xae, 0x10, 0xce,
-	0xe1, 0x95, 0x7c, 0x84, 0x80, 0x4a, 0x12, 0xc5, 0xde, 0xdd, 0x28, 0xb8, 0x97, 0x56, 0x66, 0x06,
-	0x8c, 0x03, 0x17, 0x65, 0xc8, 0x9a, 0x99, 0x5d, 0x9b, 0x44, 0x0a, 0x34, 0x35, 0x45, 0xb3, 0x8a,
-	0x9d, 0x7c, 0x9a, 0x5b, 0x5a, 0xae, 0x71, 0xd2, 0xca, 0xe8, 0xf9, 0x5f, 0x58, 0x99, 0x8c, 0x91,
-	0x14, 0x56, 0x26, 0x6b, 0x26, 0x27, 0x55, 0x46, 0x28, 0xf5, 0x00, 0x45, 0x7b, 0x42, 0x21, 0x4a,
-	0xc6, 0x5d, 0x0a, 0x51, 0xb2, 0x06, 0x73, 0x12, 0x8a, 0xf6, 0x02, 0x8d, 0xf2, 0xbd, 0x85, 0xca,
-	0x7a, 0x5c, 0x17, 0xa2, 0x64, 0xfc, 0xa2, 0x10, 0x25, 0xeb, 0x19, 0xce, 0xa6, 0x42, 0xa9, 0xe1,
-	0x35, 0xaf, 0xe0, 0x27, 0xb1, 0xcf, 0x99, 0x4c, 0x38, 0x5c, 0x9b, 0x47, 0x16, 0x7a, 0x23, 0x33,
-	0xe9, 0xb1, 0x57, 0x90, 0x2e, 0xcf, 0x46, 0xec, 0xcd, 0xc9, 0x03, 0x00, 0xf3, 0x03, 0x85, 0xb9,
-	0x89, 0xdd, 0x7c, 0xcc, 0x90, 0x4a, 0x35, 0xfa, 0x8d, 0x67, 0x78, 0x77, 0xd5, 0xf2, 0x1e, 0xfe,
-	0xd9, 0x42, 0x73, 0x43, 0x36, 0x80, 0x37, 0x8a, 0x2b, 0xf3, 0x1f, 0x7f, 0xb1, 0xdd, 0x49, 0xe5,
-	0x80, 0x59, 0x57, 0x98, 0xef, 0xe1, 0xf5, 0xb1, 0xd5, 0x4c, 0x43, 0x32, 0x84, 0x0f, 0x2d, 0x34,
-	0x9f, 0x9d, 0xcf, 0xb8, 0xa8, 0x3c, 0xb9, 0x83, 0xdf, 0xae, 0xff, 0x8f, 0x88, 0xc9, 0x50, 0x19,
-	0x95, 0xca, 0x17, 0xb4, 0x2d, 0xa8, 0xce, 0xef, 0x84, 0x4f, 0x8e, 0xaa, 0xd6, 0xd3, 0xa3, 0xaa,
-	0xf5, 0xd7, 0x51, 0xd5, 0x7a, 0x70, 0x5c, 0x2d, 0x3d, 0x3d, 0xae, 0x96, 0x9e, 0x1d, 0x57, 0x4b,
-	0xe8, 0x54, 0xc4, 0x73, 0x09, 0x76, 0xad, 0x9b, 0x5b, 0x43, 0x16, 0x38, 0x90, 0x6c, 0x44, 0x7c,
-	0x38, 0xef, 0xd7, 0x26, 0xb3, 0xb2, 0xc4, 0x66, 0x59, 0xfd, 0xe0, 0x7e, 0xff, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x4e, 0x1a, 0x7c, 0xd9, 0xeb, 0x0e, 0x00, 0x00,
+func (m *QueryDistributionRequest) Reset()         { *m = QueryDistributionRequest{} }
+func (m *QueryDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRequest) ProtoMessage()    {}
+func (*QueryDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{21}
+}
+func (m *QueryDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRequest.Merge(m, src)
+}
+func (m *QueryDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDistributionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/bank module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Returns a list of all markers on the blockchain
-	AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error)
-	// query for a single marker by denom or address
-	Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error)
-	// query for all accounts holding the given marker coins
-	Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error)
-	// query for supply of coin on a marker account
-	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
-	// query for coins on a marker account
-	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
-	// query for access records on an account
-	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
-	// query for access records on an account
-	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
-	// query for account data associated with a denom
-	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
-	// NetAssetValues returns net asset values for marker
-	NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error)
+func (m *QueryDistributionRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryDistributionResponse is the response type for the Query/Distribution method.
+type QueryDistributionResponse struct {
+	// the distribution
+	Distribution Distribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDistributionResponse) Reset()         { *m = QueryDistributionResponse{} }
+func (m *QueryDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionResponse) ProtoMessage()    {}
+func (*QueryDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{22}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionResponse.Merge(m, src)
+}
+func (m *QueryDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error) {
-	out := new(QueryAllMarkersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AllMarkers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionResponse) GetDistribution() Distribution {
+	if m != nil {
+		return m.Distribution
 	}
-	return out, nil
+	return Distribution{}
 }
 
-func (c *queryClient) Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error) {
-	out := new(QueryMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Marker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDistributionsRequest is the request type for the Query/Distributions method.
+type QueryDistributionsRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error) {
-	out := new(QueryHoldingResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Holding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionsRequest) Reset()         { *m = QueryDistributionsRequest{} }
+func (m *QueryDistributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionsRequest) ProtoMessage()    {}
+func (*QueryDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{23}
+}
+func (m *QueryDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionsRequest.Merge(m, src)
+}
+func (m *QueryDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
-	out := new(QuerySupplyResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Supply", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDistributionsRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
-	out := new(QueryEscrowResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Escrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error) {
-	out := new(QueryAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Access", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDistributionsResponse is the response type for the Query/Distributions method.
+type QueryDistributionsResponse struct {
+	// the distributions for the marker
+	Distributions []Distribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error) {
-	out := new(QueryDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/DenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionsResponse) Reset()         { *m = QueryDistributionsResponse{} }
+func (m *QueryDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionsResponse) ProtoMessage()    {}
+func (*QueryDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{24}
+}
+func (m *QueryDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionsResponse.Merge(m, src)
+}
+func (m *QueryDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
-	out := new(QueryAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionsResponse) GetDistributions() []Distribution {
+	if m != nil {
+		return m.Distributions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error) {
-	out := new(QueryNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/NetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/bank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Returns a list of all markers on the blockchain
-	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
-	// query for a single marker by denom or address
-	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
-	// query for all accounts holding the given marker coins
-	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
-	// query for supply of coin on a marker account
-	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
-	// query for coins on a marker account
-	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
-	// query for access records on an account
-	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
-	// query for access records on an account
-	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
-	// query for account data associated with a denom
-	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
-	// NetAssetValues returns net asset values for marker
-	NetAssetValues(context.Context, *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error)
+// QuerySupplyAllowanceUsagesRequest is the request type for the Query/SupplyAllowanceUsages method.
+type QuerySupplyAllowanceUsagesRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address the allowances were granted to
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySupplyAllowanceUsagesRequest) Reset()         { *m = QuerySupplyAllowanceUsagesRequest{} }
+func (m *QuerySupplyAllowanceUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyAllowanceUsagesRequest) ProtoMessage()    {}
+func (*QuerySupplyAllowanceUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{25}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) AllMarkers(ctx context.Context, req *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllMarkers not implemented")
-}
-func (*UnimplementedQueryServer) Marker(ctx context.Context, req *QueryMarkerRequest) (*QueryMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Marker not implemented")
-}
-func (*UnimplementedQueryServer) Holding(ctx context.Context, req *QueryHoldingRequest) (*QueryHoldingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Holding not implemented")
+func (m *QuerySupplyAllowanceUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+func (m *QuerySupplyAllowanceUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyAllowanceUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+func (m *QuerySupplyAllowanceUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyAllowanceUsagesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessRequest) (*QueryAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Access not implemented")
+func (m *QuerySupplyAllowanceUsagesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
+func (m *QuerySupplyAllowanceUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyAllowanceUsagesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+
+var xxx_messageInfo_QuerySupplyAllowanceUsagesRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyAllowanceUsagesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) NetAssetValues(ctx context.Context, req *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetAssetValues not implemented")
+
+func (m *QuerySupplyAllowanceUsagesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QuerySupplyAllowanceUsagesResponse is the response type for the Query/SupplyAllowanceUsages method.
+type QuerySupplyAllowanceUsagesResponse struct {
+	// the allowances granted to the address
+	Grant AccessGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant"`
+	// the amounts used against the allowances
+	Usages []SupplyAllowanceUsage `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySupplyAllowanceUsagesResponse) Reset()         { *m = QuerySupplyAllowanceUsagesResponse{} }
+func (m *QuerySupplyAllowanceUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyAllowanceUsagesResponse) ProtoMessage()    {}
+func (*QuerySupplyAllowanceUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{26}
+}
+func (m *QuerySupplyAllowanceUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyAllowanceUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyAllowanceUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySupplyAllowanceUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyAllowanceUsagesResponse.Merge(m, src)
+}
+func (m *QuerySupplyAllowanceUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyAllowanceUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyAllowanceUsagesResponse.DiscardUnknown(m)
 }
 
-func _Query_AllMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMarkersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllMarkers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/AllMarkers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllMarkers(ctx, req.(*QueryAllMarkersRequest))
+var xxx_messageInfo_QuerySupplyAllowanceUsagesResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyAllowanceUsagesResponse) GetGrant() AccessGrant {
+	if m != nil {
+		return m.Grant
 	}
-	return interceptor(ctx, in, info, handler)
+	return AccessGrant{}
 }
 
-func _Query_Marker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Marker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Marker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Marker(ctx, req.(*QueryMarkerRequest))
+func (m *QuerySupplyAllowanceUsagesResponse) GetUsages() []SupplyAllowanceUsage {
+	if m != nil {
+		return m.Usages
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Holding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHoldingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Holding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Holding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Holding(ctx, req.(*QueryHoldingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLockupRequest is the request type for the Query/Lockup method.
+type QueryLockupRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address whose funds are locked
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Supply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Supply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+func (m *QueryLockupRequest) Reset()         { *m = QueryLockupRequest{} }
+func (m *QueryLockupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupRequest) ProtoMessage()    {}
+func (*QueryLockupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{27}
+}
+func (m *QueryLockupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLockupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupRequest.Merge(m, src)
+}
+func (m *QueryLockupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupRequest.DiscardUnknown(m)
 }
 
-func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Escrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Escrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+var xxx_messageInfo_QueryLockupRequest proto.InternalMessageInfo
+
+func (m *QueryLockupRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Access_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Access(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/Access",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Access(ctx, req.(*QueryAccessRequest))
+func (m *QueryLockupRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/DenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomMetadata(ctx, req.(*QueryDenomMetadataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLockupResponse is the response type for the Query/Lockup method.
+type QueryLockupResponse struct {
+	// the lockup
+	Lockup Lockup `protobuf:"bytes,1,opt,name=lockup,proto3" json:"lockup"`
+	// the amount that is locked as of the current block time
+	Locked types1.Coin `protobuf:"bytes,2,opt,name=locked,proto3" json:"locked"`
 }
 
-func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/AccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
+func (m *QueryLockupResponse) Reset()         { *m = QueryLockupResponse{} }
+func (m *QueryLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupResponse) ProtoMessage()    {}
+func (*QueryLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{28}
+}
+func (m *QueryLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupResponse.Merge(m, src)
+}
+func (m *QueryLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupResponse.DiscardUnknown(m)
 }
 
-func _Query_NetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Query/NetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NetAssetValues(ctx, req.(*QueryNetAssetValuesRequest))
+var xxx_messageInfo_QueryLockupResponse proto.InternalMessageInfo
+
+func (m *QueryLockupResponse) GetLockup() Lockup {
+	if m != nil {
+		return m.Lockup
 	}
-	return interceptor(ctx, in, info, handler)
+	return Lockup{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AllMarkers",
-			Handler:    _Query_AllMarkers_Handler,
-		},
-		{
-			MethodName: "Marker",
-			Handler:    _Query_Marker_Handler,
-		},
-		{
-			MethodName: "Holding",
-			Handler:    _Query_Holding_Handler,
-		},
-		{
-			MethodName: "Supply",
-			Handler:    _Query_Supply_Handler,
-		},
-		{
-			MethodName: "Escrow",
-			Handler:    _Query_Escrow_Handler,
-		},
-		{
-			MethodName: "Access",
-			Handler:    _Query_Access_Handler,
-		},
-		{
-			MethodName: "DenomMetadata",
-			Handler:    _Query_DenomMetadata_Handler,
-		},
-		{
-			MethodName: "AccountData",
-			Handler:    _Query_AccountData_Handler,
-		},
-		{
-			MethodName: "NetAssetValues",
-			Handler:    _Query_NetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockupResponse) GetLocked() types1.Coin {
+	if m != nil {
+		return m.Locked
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLockupsRequest is the request type for the Query/Lockups method.
+type QueryLockupsRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLockupsRequest) Reset()         { *m = QueryLockupsRequest{} }
+func (m *QueryLockupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupsRequest) ProtoMessage()    {}
+func (*QueryLockupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{29}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLockupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupsRequest.Merge(m, src)
+}
+func (m *QueryLockupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryLockupsRequest proto.InternalMessageInfo
+
+func (m *QueryLockupsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllMarkersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLockupsResponse is the response type for the Query/Lockups method.
+type QueryLockupsResponse struct {
+	// the lockups of the marker's denom
+	Lockups []Lockup `protobuf:"bytes,1,rep,name=lockups,proto3" json:"lockups"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLockupsResponse) Reset()         { *m = QueryLockupsResponse{} }
+func (m *QueryLockupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockupsResponse) ProtoMessage()    {}
+func (*QueryLockupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{30}
+}
+func (m *QueryLockupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryLockupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockupsResponse.Merge(m, src)
+}
+func (m *QueryLockupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockupsResponse proto.InternalMessageInfo
+
+func (m *QueryLockupsResponse) GetLockups() []Lockup {
+	if m != nil {
+		return m.Lockups
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllMarkersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedemptionConfigRequest is the request type for the Query/RedemptionConfig method.
+type QueryRedemptionConfigRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryAllMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Markers) > 0 {
-		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRedemptionConfigRequest) Reset()         { *m = QueryRedemptionConfigRequest{} }
+func (m *QueryRedemptionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionConfigRequest) ProtoMessage()    {}
+func (*QueryRedemptionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{31}
+}
+func (m *QueryRedemptionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedemptionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionConfigRequest.Merge(m, src)
+}
+func (m *QueryRedemptionConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionConfigRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionConfigRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRedemptionConfigResponse is the response type for the Query/RedemptionConfig method.
+type QueryRedemptionConfigResponse struct {
+	// the redemption configuration
+	Config RedemptionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *QueryMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRedemptionConfigResponse) Reset()         { *m = QueryRedemptionConfigResponse{} }
+func (m *QueryRedemptionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionConfigResponse) ProtoMessage()    {}
+func (*QueryRedemptionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{32}
 }
-
-func (m *QueryMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Marker != nil {
-		{
-			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRedemptionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedemptionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionConfigResponse.Merge(m, src)
+}
+func (m *QueryRedemptionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionConfigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHoldingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionConfigResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionConfigResponse) GetConfig() RedemptionConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return RedemptionConfig{}
 }
 
-func (m *QueryHoldingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingRedemptionsRequest is the request type for the Query/PendingRedemptions method.
+type QueryPendingRedemptionsRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHoldingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingRedemptionsRequest) Reset()         { *m = QueryPendingRedemptionsRequest{} }
+func (m *QueryPendingRedemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRedemptionsRequest) ProtoMessage()    {}
+func (*QueryPendingRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{33}
+}
+func (m *QueryPendingRedemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRedemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRedemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPendingRedemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRedemptionsRequest.Merge(m, src)
+}
+func (m *QueryPendingRedemptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRedemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRedemptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRedemptionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRedemptionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryHoldingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRedemptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHoldingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingRedemptionsResponse is the response type for the Query/PendingRedemptions method.
+type QueryPendingRedemptionsResponse struct {
+	// the redemptions awaiting approval
+	Redemptions []PendingRedemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHoldingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingRedemptionsResponse) Reset()         { *m = QueryPendingRedemptionsResponse{} }
+func (m *QueryPendingRedemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRedemptionsResponse) ProtoMessage()    {}
+func (*QueryPendingRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{34}
+}
+func (m *QueryPendingRedemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRedemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRedemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingRedemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRedemptionsResponse.Merge(m, src)
 }
-
-func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingRedemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRedemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRedemptionsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingRedemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRedemptionsResponse) GetRedemptions() []PendingRedemption {
+	if m != nil {
+		return m.Redemptions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRedemptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransferLimitRequest is the request type for the Query/TransferLimit method.
+type QueryTransferLimitRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address that is sending funds
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTransferLimitRequest) Reset()         { *m = QueryTransferLimitRequest{} }
+func (m *QueryTransferLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitRequest) ProtoMessage()    {}
+func (*QueryTransferLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{35}
+}
+func (m *QueryTransferLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTransferLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitRequest.Merge(m, src)
 }
-
-func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTransferLimitRequest proto.InternalMessageInfo
+
+func (m *QueryTransferLimitRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransferLimitResponse is the response type for the Query/TransferLimit method.
+type QueryTransferLimitResponse struct {
+	// the limit that applies to the address, either its own or the marker's default
+	Limit TransferLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// the amount sent in the past 24 hours
+	DailyUsed types1.Coin `protobuf:"bytes,2,opt,name=daily_used,json=dailyUsed,proto3" json:"daily_used"`
+	// the amount that can still be sent before reaching the daily limit, empty if there is no daily limit
+	DailyRemaining *types1.Coin `protobuf:"bytes,3,opt,name=daily_remaining,json=dailyRemaining,proto3" json:"daily_remaining,omitempty"`
+	// the amount sent in the past 7 days
+	WeeklyUsed types1.Coin `protobuf:"bytes,4,opt,name=weekly_used,json=weeklyUsed,proto3" json:"weekly_used"`
+	// the amount that can still be sent before reaching the weekly limit, empty if there is no weekly limit
+	WeeklyRemaining *types1.Coin `protobuf:"bytes,5,opt,name=weekly_remaining,json=weeklyRemaining,proto3" json:"weekly_remaining,omitempty"`
 }
 
-func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Escrow) > 0 {
-		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTransferLimitResponse) Reset()         { *m = QueryTransferLimitResponse{} }
+func (m *QueryTransferLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitResponse) ProtoMessage()    {}
+func (*QueryTransferLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{36}
+}
+func (m *QueryTransferLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransferLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitResponse.Merge(m, src)
+}
+func (m *QueryTransferLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitResponse proto.InternalMessageInfo
+
+func (m *QueryTransferLimitResponse) GetLimit() TransferLimit {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return TransferLimit{}
 }
 
-func (m *QueryAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitResponse) GetDailyUsed() types1.Coin {
+	if m != nil {
+		return m.DailyUsed
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTransferLimitResponse) GetDailyRemaining() *types1.Coin {
+	if m != nil {
+		return m.DailyRemaining
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitResponse) GetWeeklyUsed() types1.Coin {
+	if m != nil {
+		return m.WeeklyUsed
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *QueryAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitResponse) GetWeeklyRemaining() *types1.Coin {
+	if m != nil {
+		return m.WeeklyRemaining
+	}
+	return nil
 }
 
-func (m *QueryAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryTransferLimitsRequest is the request type for the Query/TransferLimits method.
+type QueryTransferLimitsRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitsRequest) Reset()         { *m = QueryTransferLimitsRequest{} }
+func (m *QueryTransferLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsRequest) ProtoMessage()    {}
+func (*QueryTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{37}
+}
+func (m *QueryTransferLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsRequest.Merge(m, src)
+}
+func (m *QueryTransferLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTransferLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryTransferLimitsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTransferLimitsResponse is the response type for the Query/TransferLimits method.
+type QueryTransferLimitsResponse struct {
+	// the marker's default limit, if it has one
+	DefaultLimit *TransferLimit `protobuf:"bytes,1,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+	// the limits of specific accounts
+	Limits []TransferLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTransferLimitsResponse) Reset()         { *m = QueryTransferLimitsResponse{} }
+func (m *QueryTransferLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsResponse) ProtoMessage()    {}
+func (*QueryTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{38}
+}
+func (m *QueryTransferLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTransferLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsResponse.Merge(m, src)
 }
-
-func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTransferLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryTransferLimitsResponse) GetDefaultLimit() *TransferLimit {
+	if m != nil {
+		return m.DefaultLimit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTransferLimitsResponse) GetLimits() []TransferLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
 }
 
-func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTransferLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryFilteredMarkersRequest is the request type for the Query/FilteredMarkers method.
+// Empty and unspecified fields do not filter the results.
+type QueryFilteredMarkersRequest struct {
+	// grant_address only matches markers where this address has an access grant.
+	GrantAddress string `protobuf:"bytes,1,opt,name=grant_address,json=grantAddress,proto3" json:"grant_address,omitempty"`
+	// access only matches markers where the grant_address has this access. Requires a grant_address.
+	Access Access `protobuf:"varint,2,opt,name=access,proto3,enum=provenance.marker.v1.Access" json:"access,omitempty"`
+	// marker_type only matches markers of this type.
+	MarkerType MarkerType `protobuf:"varint,3,opt,name=marker_type,json=markerType,proto3,enum=provenance.marker.v1.MarkerType" json:"marker_type,omitempty"`
+	// status only matches markers with this status.
+	Status MarkerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=provenance.marker.v1.MarkerStatus" json:"status,omitempty"`
+	// supply_fixed filters on whether the marker has a fixed supply.
+	SupplyFixed FlagFilter `protobuf:"varint,5,opt,name=supply_fixed,json=supplyFixed,proto3,enum=provenance.marker.v1.FlagFilter" json:"supply_fixed,omitempty"`
+	// allow_forced_transfer filters on whether the marker allows forced transfers.
+	AllowForcedTransfer FlagFilter `protobuf:"varint,6,opt,name=allow_forced_transfer,json=allowForcedTransfer,proto3,enum=provenance.marker.v1.FlagFilter" json:"allow_forced_transfer,omitempty"`
+	// required_attribute only matches markers that require this attribute name to receive funds, either on its own or as
+	// part of a required attribute expression.
+	RequiredAttribute string `protobuf:"bytes,7,opt,name=required_attribute,json=requiredAttribute,proto3" json:"required_attribute,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredMarkersRequest) Reset()         { *m = QueryFilteredMarkersRequest{} }
+func (m *QueryFilteredMarkersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilteredMarkersRequest) ProtoMessage()    {}
+func (*QueryFilteredMarkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{39}
 }
-
-func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryFilteredMarkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilteredMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilteredMarkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFilteredMarkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilteredMarkersRequest.Merge(m, src)
 }
-
-func (m *QueryNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredMarkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilteredMarkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilteredMarkersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFilteredMarkersRequest proto.InternalMessageInfo
+
+func (m *QueryFilteredMarkersRequest) GetGrantAddress() string {
+	if m != nil {
+		return m.GrantAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFilteredMarkersRequest) GetAccess() Access {
+	if m != nil {
+		return m.Access
 	}
-	return dAtA[:n], nil
+	return Access_Unknown
 }
 
-func (m *QueryNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFilteredMarkersRequest) GetMarkerType() MarkerType {
+	if m != nil {
+		return m.MarkerType
+	}
+	return MarkerType_Unknown
 }
 
-func (m *QueryNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryFilteredMarkersRequest) GetStatus() MarkerStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return StatusUndefined
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFilteredMarkersRequest) GetSupplyFixed() FlagFilter {
+	if m != nil {
+		return m.SupplyFixed
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return FlagFilterAny
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryFilteredMarkersRequest) GetAllowForcedTransfer() FlagFilter {
+	if m != nil {
+		return m.AllowForcedTransfer
 	}
-	var l int
-	_ = l
-	return n
+	return FlagFilterAny
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFilteredMarkersRequest) GetRequiredAttribute() string {
+	if m != nil {
+		return m.RequiredAttribute
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllMarkersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFilteredMarkersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllMarkersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markers) > 0 {
-		for _, e := range m.Markers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryFilteredMarkersResponse is the response type for the Query/FilteredMarkers method.
+type QueryFilteredMarkersResponse struct {
+	Markers []*types.Any `protobuf:"bytes,1,rep,name=markers,proto3" json:"markers,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFilteredMarkersResponse) Reset()         { *m = QueryFilteredMarkersResponse{} }
+func (m *QueryFilteredMarkersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFilteredMarkersResponse) ProtoMessage()    {}
+func (*QueryFilteredMarkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{40}
+}
+func (m *QueryFilteredMarkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilteredMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilteredMarkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryFilteredMarkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilteredMarkersResponse.Merge(m, src)
+}
+func (m *QueryFilteredMarkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilteredMarkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilteredMarkersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFilteredMarkersResponse proto.InternalMessageInfo
+
+func (m *QueryFilteredMarkersResponse) GetMarkers() []*types.Any {
+	if m != nil {
+		return m.Markers
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Marker != nil {
-		l = m.Marker.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFilteredMarkersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryHoldingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QuerySendDenyListRequest is the request type for the Query/SendDenyList method.
+type QuerySendDenyListRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHoldingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySendDenyListRequest) Reset()         { *m = QuerySendDenyListRequest{} }
+func (m *QuerySendDenyListRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendDenyListRequest) ProtoMessage()    {}
+func (*QuerySendDenyListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{41}
+}
+func (m *QuerySendDenyListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendDenyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendDenyListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySendDenyListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendDenyListRequest.Merge(m, src)
+}
+func (m *QuerySendDenyListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendDenyListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendDenyListRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySendDenyListRequest proto.InternalMessageInfo
+
+func (m *QuerySendDenyListRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *QuerySupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySendDenyListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryEscrowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QuerySendDenyListResponse is the response type for the Query/SendDenyList method.
+type QuerySendDenyListResponse struct {
+	// the entries of the marker's deny send list
+	Entries []DenySendAddress `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Escrow) > 0 {
-		for _, e := range m.Escrow {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySendDenyListResponse) Reset()         { *m = QuerySendDenyListResponse{} }
+func (m *QuerySendDenyListResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendDenyListResponse) ProtoMessage()    {}
+func (*QuerySendDenyListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{42}
+}
+func (m *QuerySendDenyListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendDenyListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendDenyListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySendDenyListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendDenyListResponse.Merge(m, src)
+}
+func (m *QuerySendDenyListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendDenyListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendDenyListResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySendDenyListResponse proto.InternalMessageInfo
+
+func (m *QuerySendDenyListResponse) GetEntries() []DenySendAddress {
+	if m != nil {
+		return m.Entries
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySendDenyListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryRecoveryRequest is the request type for the Query/Recovery method.
+type QueryRecoveryRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the id of the recovery
+	RecoveryId uint64 `protobuf:"varint,2,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+}
+
+func (m *QueryRecoveryRequest) Reset()         { *m = QueryRecoveryRequest{} }
+func (m *QueryRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRequest) ProtoMessage()    {}
+func (*QueryRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{43}
+}
+func (m *QueryRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRequest.Merge(m, src)
+}
+func (m *QueryRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryRecoveryRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveryRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecoveryRequest) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryRecoveryResponse is the response type for the Query/Recovery method.
+type QueryRecoveryResponse struct {
+	// the proposed recovery
+	Recovery Recovery `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
+	// whether the challenge period has ended so that the recovery can be executed
+	Executable bool `protobuf:"varint,2,opt,name=executable,proto3" json:"executable,omitempty"`
 }
 
-func (m *QueryAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRecoveryResponse) Reset()         { *m = QueryRecoveryResponse{} }
+func (m *QueryRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryResponse) ProtoMessage()    {}
+func (*QueryRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{44}
+}
+func (m *QueryRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryResponse.Merge(m, src)
+}
+func (m *QueryRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryResponse.DiscardUnknown(m)
 }
 
-func (m *Balance) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRecoveryResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveryResponse) GetRecovery() Recovery {
+	if m != nil {
+		return m.Recovery
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return Recovery{}
+}
+
+func (m *QueryRecoveryResponse) GetExecutable() bool {
+	if m != nil {
+		return m.Executable
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+// QueryRecoveriesRequest is the request type for the Query/Recoveries method.
+type QueryRecoveriesRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// an optional address to only return the recoveries of funds from that account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecoveriesRequest) Reset()         { *m = QueryRecoveriesRequest{} }
+func (m *QueryRecoveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveriesRequest) ProtoMessage()    {}
+func (*QueryRecoveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{45}
+}
+func (m *QueryRecoveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRecoveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveriesRequest.Merge(m, src)
+}
+func (m *QueryRecoveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryRecoveriesRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveriesRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *QueryNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecoveriesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return ""
+}
+
+func (m *QueryRecoveriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryRecoveriesResponse is the response type for the Query/Recoveries method.
+type QueryRecoveriesResponse struct {
+	// the proposed recoveries
+	Recoveries []Recovery `protobuf:"bytes,1,rep,name=recoveries,proto3" json:"recoveries"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRecoveriesResponse) Reset()         { *m = QueryRecoveriesResponse{} }
+func (m *QueryRecoveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveriesResponse) ProtoMessage()    {}
+func (*QueryRecoveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{46}
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *QueryRecoveriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveriesResponse.Merge(m, src)
+}
+func (m *QueryRecoveriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveriesResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveriesResponse) GetRecoveries() []Recovery {
+	if m != nil {
+		return m.Recoveries
+	}
+	return nil
+}
+
+func (m *QueryRecoveriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCapTableRequest is the request type for the Query/CapTable method.
+type QueryCapTableRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryCapTableRequest) Reset()         { *m = QueryCapTableRequest{} }
+func (m *QueryCapTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableRequest) ProtoMessage()    {}
+func (*QueryCapTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{47}
+}
+func (m *QueryCapTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableRequest.Merge(m, src)
+}
+func (m *QueryCapTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapTableRequest proto.InternalMessageInfo
+
+func (m *QueryCapTableRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryCapTableResponse is the response type for the Query/CapTable method.
+type QueryCapTableResponse struct {
+	// the holders of the marker's denom
+	CapTable CapTable `protobuf:"bytes,1,opt,name=cap_table,json=capTable,proto3" json:"cap_table"`
+}
+
+func (m *QueryCapTableResponse) Reset()         { *m = QueryCapTableResponse{} }
+func (m *QueryCapTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableResponse) ProtoMessage()    {}
+func (*QueryCapTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{48}
+}
+func (m *QueryCapTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableResponse.Merge(m, src)
+}
+func (m *QueryCapTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapTableResponse proto.InternalMessageInfo
+
+func (m *QueryCapTableResponse) GetCapTable() CapTable {
+	if m != nil {
+		return m.CapTable
+	}
+	return CapTable{}
+}
+
+// CapTable is a snapshot of the accounts holding a denom at a block height.
+type CapTable struct {
+	// denom is the denom the cap table is for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the block height the cap table was taken at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// supply is the total supply of the denom.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// entries are the accounts holding the denom, ordered by total amount, largest first.
+	Entries []CapTableEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *CapTable) Reset()         { *m = CapTable{} }
+func (m *CapTable) String() string { return proto.CompactTextString(m) }
+func (*CapTable) ProtoMessage()    {}
+func (*CapTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{49}
+}
+func (m *CapTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapTable.Merge(m, src)
+}
+func (m *CapTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapTable proto.InternalMessageInfo
+
+func (m *CapTable) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CapTable) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CapTable) GetEntries() []CapTableEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// CapTableEntry is the amount of a denom held by an account.
+type CapTableEntry struct {
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the amount in the account, including any of it that is on hold.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// held is the part of the balance that is on hold.
+	Held cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=held,proto3,customtype=cosmossdk.io/math.Int" json:"held"`
+	// quarantined is the amount sent to the account that is awaiting acceptance in quarantine.
+	Quarantined cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=quarantined,proto3,customtype=cosmossdk.io/math.Int" json:"quarantined"`
+	// total is the balance plus the quarantined amount.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// percentage is the total as a percentage of the supply.
+	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
+}
+
+func (m *CapTableEntry) Reset()         { *m = CapTableEntry{} }
+func (m *CapTableEntry) String() string { return proto.CompactTextString(m) }
+func (*CapTableEntry) ProtoMessage()    {}
+func (*CapTableEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{50}
+}
+func (m *CapTableEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapTableEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapTableEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapTableEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapTableEntry.Merge(m, src)
+}
+func (m *CapTableEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapTableEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapTableEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapTableEntry proto.InternalMessageInfo
+
+func (m *CapTableEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryHolderLimitRequest is the request type for the Query/HolderLimit method.
+type QueryHolderLimitRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryHolderLimitRequest) Reset()         { *m = QueryHolderLimitRequest{} }
+func (m *QueryHolderLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderLimitRequest) ProtoMessage()    {}
+func (*QueryHolderLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{51}
+}
+func (m *QueryHolderLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHolderLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderLimitRequest.Merge(m, src)
+}
+func (m *QueryHolderLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHolderLimitRequest proto.InternalMessageInfo
+
+func (m *QueryHolderLimitRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryHolderLimitResponse is the response type for the Query/HolderLimit method.
+type QueryHolderLimitResponse struct {
+	// the marker's holder limit
+	Limit HolderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// the number of accounts currently holding the marker's denom
+	HolderCount uint64 `protobuf:"varint,2,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+}
+
+func (m *QueryHolderLimitResponse) Reset()         { *m = QueryHolderLimitResponse{} }
+func (m *QueryHolderLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderLimitResponse) ProtoMessage()    {}
+func (*QueryHolderLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{52}
+}
+func (m *QueryHolderLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHolderLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHolderLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHolderLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderLimitResponse.Merge(m, src)
+}
+func (m *QueryHolderLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHolderLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHolderLimitResponse proto.InternalMessageInfo
+
+func (m *QueryHolderLimitResponse) GetLimit() HolderLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return HolderLimit{}
+}
+
+func (m *QueryHolderLimitResponse) GetHolderCount() uint64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
+}
+
+// QueryIbcChannelPolicyRequest is the request type for the Query/IbcChannelPolicy method.
+type QueryIbcChannelPolicyRequest struct {
+	// address or denom for the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryIbcChannelPolicyRequest) Reset()         { *m = QueryIbcChannelPolicyRequest{} }
+func (m *QueryIbcChannelPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcChannelPolicyRequest) ProtoMessage()    {}
+func (*QueryIbcChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{53}
+}
+func (m *QueryIbcChannelPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcChannelPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcChannelPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIbcChannelPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcChannelPolicyRequest.Merge(m, src)
+}
+func (m *QueryIbcChannelPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcChannelPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcChannelPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIbcChannelPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryIbcChannelPolicyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryIbcChannelPolicyResponse is the response type for the Query/IbcChannelPolicy method.
+type QueryIbcChannelPolicyResponse struct {
+	// the marker's IBC channel policy
+	Policy IbcChannelPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// the amount of the marker's denom currently escrowed for each capped channel
+	Escrows []IbcChannelEscrow `protobuf:"bytes,2,rep,name=escrows,proto3" json:"escrows"`
+}
+
+func (m *QueryIbcChannelPolicyResponse) Reset()         { *m = QueryIbcChannelPolicyResponse{} }
+func (m *QueryIbcChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcChannelPolicyResponse) ProtoMessage()    {}
+func (*QueryIbcChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{54}
+}
+func (m *QueryIbcChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIbcChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcChannelPolicyResponse.Merge(m, src)
+}
+func (m *QueryIbcChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcChannelPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIbcChannelPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryIbcChannelPolicyResponse) GetPolicy() IbcChannelPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return IbcChannelPolicy{}
+}
+
+func (m *QueryIbcChannelPolicyResponse) GetEscrows() []IbcChannelEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+// IbcChannelEscrow is the amount of a marker's denom held in a channel's escrow account.
+type IbcChannelEscrow struct {
+	// channel_id is the id of the channel.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// amount is the amount of the denom that is escrowed for the channel.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *IbcChannelEscrow) Reset()         { *m = IbcChannelEscrow{} }
+func (m *IbcChannelEscrow) String() string { return proto.CompactTextString(m) }
+func (*IbcChannelEscrow) ProtoMessage()    {}
+func (*IbcChannelEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{55}
+}
+func (m *IbcChannelEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcChannelEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcChannelEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcChannelEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcChannelEscrow.Merge(m, src)
+}
+func (m *IbcChannelEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcChannelEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcChannelEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcChannelEscrow proto.InternalMessageInfo
+
+func (m *IbcChannelEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.FlagFilter", FlagFilter_name, FlagFilter_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.marker.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.marker.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllMarkersRequest)(nil), "provenance.marker.v1.QueryAllMarkersRequest")
+	proto.RegisterType((*QueryAllMarkersResponse)(nil), "provenance.marker.v1.QueryAllMarkersResponse")
+	proto.RegisterType((*QueryMarkerRequest)(nil), "provenance.marker.v1.QueryMarkerRequest")
+	proto.RegisterType((*QueryMarkerResponse)(nil), "provenance.marker.v1.QueryMarkerResponse")
+	proto.RegisterType((*QueryHoldingRequest)(nil), "provenance.marker.v1.QueryHoldingRequest")
+	proto.RegisterType((*QueryHoldingResponse)(nil), "provenance.marker.v1.QueryHoldingResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "provenance.marker.v1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "provenance.marker.v1.QuerySupplyResponse")
+	proto.RegisterType((*QueryEscrowRequest)(nil), "provenance.marker.v1.QueryEscrowRequest")
+	proto.RegisterType((*QueryEscrowResponse)(nil), "provenance.marker.v1.QueryEscrowResponse")
+	proto.RegisterType((*QueryAccessRequest)(nil), "provenance.marker.v1.QueryAccessRequest")
+	proto.RegisterType((*QueryAccessResponse)(nil), "provenance.marker.v1.QueryAccessResponse")
+	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
+	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.marker.v1.QueryAccountDataRequest")
+	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.marker.v1.QueryAccountDataResponse")
+	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
+	proto.RegisterType((*QueryNetAssetValuesRequest)(nil), "provenance.marker.v1.QueryNetAssetValuesRequest")
+	proto.RegisterType((*QueryNetAssetValuesResponse)(nil), "provenance.marker.v1.QueryNetAssetValuesResponse")
+	proto.RegisterType((*QueryDistributionRequest)(nil), "provenance.marker.v1.QueryDistributionRequest")
+	proto.RegisterType((*QueryDistributionResponse)(nil), "provenance.marker.v1.QueryDistributionResponse")
+	proto.RegisterType((*QueryDistributionsRequest)(nil), "provenance.marker.v1.QueryDistributionsRequest")
+	proto.RegisterType((*QueryDistributionsResponse)(nil), "provenance.marker.v1.QueryDistributionsResponse")
+	proto.RegisterType((*QuerySupplyAllowanceUsagesRequest)(nil), "provenance.marker.v1.QuerySupplyAllowanceUsagesRequest")
+	proto.RegisterType((*QuerySupplyAllowanceUsagesResponse)(nil), "provenance.marker.v1.QuerySupplyAllowanceUsagesResponse")
+	proto.RegisterType((*QueryLockupRequest)(nil), "provenance.marker.v1.QueryLockupRequest")
+	proto.RegisterType((*QueryLockupResponse)(nil), "provenance.marker.v1.QueryLockupResponse")
+	proto.RegisterType((*QueryLockupsRequest)(nil), "provenance.marker.v1.QueryLockupsRequest")
+	proto.RegisterType((*QueryLockupsResponse)(nil), "provenance.marker.v1.QueryLockupsResponse")
+	proto.RegisterType((*QueryRedemptionConfigRequest)(nil), "provenance.marker.v1.QueryRedemptionConfigRequest")
+	proto.RegisterType((*QueryRedemptionConfigResponse)(nil), "provenance.marker.v1.QueryRedemptionConfigResponse")
+	proto.RegisterType((*QueryPendingRedemptionsRequest)(nil), "provenance.marker.v1.QueryPendingRedemptionsRequest")
+	proto.RegisterType((*QueryPendingRedemptionsResponse)(nil), "provenance.marker.v1.QueryPendingRedemptionsResponse")
+	proto.RegisterType((*QueryTransferLimitRequest)(nil), "provenance.marker.v1.QueryTransferLimitRequest")
+	proto.RegisterType((*QueryTransferLimitResponse)(nil), "provenance.marker.v1.QueryTransferLimitResponse")
+	proto.RegisterType((*QueryTransferLimitsRequest)(nil), "provenance.marker.v1.QueryTransferLimitsRequest")
+	proto.RegisterType((*QueryTransferLimitsResponse)(nil), "provenance.marker.v1.QueryTransferLimitsResponse")
+	proto.RegisterType((*QueryFilteredMarkersRequest)(nil), "provenance.marker.v1.QueryFilteredMarkersRequest")
+	proto.RegisterType((*QueryFilteredMarkersResponse)(nil), "provenance.marker.v1.QueryFilteredMarkersResponse")
+	proto.RegisterType((*QuerySendDenyListRequest)(nil), "provenance.marker.v1.QuerySendDenyListRequest")
+	proto.RegisterType((*QuerySendDenyListResponse)(nil), "provenance.marker.v1.QuerySendDenyListResponse")
+	proto.RegisterType((*QueryRecoveryRequest)(nil), "provenance.marker.v1.QueryRecoveryRequest")
+	proto.RegisterType((*QueryRecoveryResponse)(nil), "provenance.marker.v1.QueryRecoveryResponse")
+	proto.RegisterType((*QueryRecoveriesRequest)(nil), "provenance.marker.v1.QueryRecoveriesRequest")
+	proto.RegisterType((*QueryRecoveriesResponse)(nil), "provenance.marker.v1.QueryRecoveriesResponse")
+	proto.RegisterType((*QueryCapTableRequest)(nil), "provenance.marker.v1.QueryCapTableRequest")
+	proto.RegisterType((*QueryCapTableResponse)(nil), "provenance.marker.v1.QueryCapTableResponse")
+	proto.RegisterType((*CapTable)(nil), "provenance.marker.v1.CapTable")
+	proto.RegisterType((*CapTableEntry)(nil), "provenance.marker.v1.CapTableEntry")
+	proto.RegisterType((*QueryHolderLimitRequest)(nil), "provenance.marker.v1.QueryHolderLimitRequest")
+	proto.RegisterType((*QueryHolderLimitResponse)(nil), "provenance.marker.v1.QueryHolderLimitResponse")
+	proto.RegisterType((*QueryIbcChannelPolicyRequest)(nil), "provenance.marker.v1.QueryIbcChannelPolicyRequest")
+	proto.RegisterType((*QueryIbcChannelPolicyResponse)(nil), "provenance.marker.v1.QueryIbcChannelPolicyResponse")
+	proto.RegisterType((*IbcChannelEscrow)(nil), "provenance.marker.v1.IbcChannelEscrow")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
+
+var fileDescriptor_a76fb1fac8494cdc = []byte{
+	// 2972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdf, 0x6f, 0xdc, 0xc6,
+	0xf1, 0x17, 0x65, 0xe9, 0x24, 0x8f, 0x7e, 0x58, 0x5e, 0xc9, 0xf1, 0x99, 0xb6, 0x4f, 0x32, 0x1d,
+	0xdb, 0x92, 0x1c, 0x1d, 0x2d, 0xc9, 0x4e, 0xbe, 0xdf, 0x34, 0x4d, 0x72, 0xfa, 0xe5, 0x08, 0x50,
+	0x5c, 0xe7, 0x24, 0xb7, 0x40, 0x80, 0xe2, 0x40, 0x91, 0xab, 0x13, 0x21, 0x1e, 0x79, 0x26, 0x79,
+	0xb6, 0x05, 0x57, 0x2f, 0xed, 0x4b, 0x6a, 0x14, 0x68, 0xd0, 0xbe, 0x14, 0x45, 0xdc, 0x1a, 0x68,
+	0x51, 0x04, 0x09, 0x52, 0xa4, 0x40, 0x0a, 0x14, 0xe8, 0x1f, 0xd0, 0xa0, 0x7d, 0x09, 0xd0, 0x97,
+	0xa2, 0x0f, 0x69, 0x91, 0x14, 0x48, 0xfb, 0xdc, 0x7f, 0xa0, 0xe0, 0xee, 0xec, 0x1d, 0x79, 0x22,
+	0x79, 0x3c, 0x43, 0x2e, 0xfa, 0x62, 0x8b, 0xe4, 0x7c, 0x66, 0x3f, 0x3b, 0x33, 0xbb, 0x3b, 0x3b,
+	0x73, 0x30, 0x55, 0x77, 0x9d, 0x7b, 0xd4, 0xd6, 0x6c, 0x9d, 0xaa, 0x35, 0xcd, 0xdd, 0xa3, 0xae,
+	0x7a, 0x6f, 0x5e, 0xbd, 0xdb, 0xa0, 0xee, 0x7e, 0xb1, 0xee, 0x3a, 0xbe, 0x43, 0x26, 0x5a, 0x12,
+	0x45, 0x2e, 0x51, 0xbc, 0x37, 0x2f, 0x9f, 0xd4, 0x6a, 0xa6, 0xed, 0xa8, 0xec, 0x5f, 0x2e, 0x28,
+	0x4f, 0x54, 0x9d, 0xaa, 0xc3, 0xfe, 0x54, 0x83, 0xbf, 0xf0, 0xed, 0x99, 0xaa, 0xe3, 0x54, 0x2d,
+	0xaa, 0xb2, 0xa7, 0xed, 0xc6, 0x8e, 0xaa, 0xd9, 0xa8, 0x59, 0x3e, 0xd7, 0xfe, 0xc9, 0xf3, 0xdd,
+	0x86, 0xee, 0xe3, 0xd7, 0x59, 0xdd, 0xf1, 0x6a, 0x8e, 0xa7, 0x6e, 0x6b, 0x1e, 0xe5, 0x84, 0xd4,
+	0x7b, 0xf3, 0xdb, 0xd4, 0xd7, 0xe6, 0xd5, 0xba, 0x56, 0x35, 0x6d, 0xcd, 0x37, 0x1d, 0x1b, 0x65,
+	0x0b, 0x61, 0x59, 0x21, 0xa5, 0x3b, 0xe6, 0xe1, 0xef, 0xf6, 0x5e, 0xf3, 0x7b, 0xf0, 0x20, 0x48,
+	0xf2, 0xef, 0x15, 0xce, 0x9e, 0x3f, 0xb4, 0x91, 0xd4, 0xea, 0xa6, 0xaa, 0xd9, 0xb6, 0xe3, 0xb3,
+	0x71, 0xc5, 0xd7, 0x2b, 0xb1, 0xe6, 0x33, 0x4c, 0xcf, 0x77, 0xcd, 0xed, 0x46, 0x88, 0xa1, 0x12,
+	0x2b, 0x58, 0xa5, 0x36, 0xf5, 0xcc, 0x74, 0x65, 0xbb, 0x8e, 0x65, 0x50, 0xb7, 0x62, 0x99, 0x35,
+	0x53, 0x98, 0xe6, 0x52, 0xac, 0xa0, 0xb9, 0xad, 0x57, 0xea, 0x8e, 0x65, 0xea, 0xc2, 0xbe, 0x17,
+	0x62, 0xc5, 0x2c, 0x47, 0xdf, 0x6b, 0xd4, 0x51, 0xe4, 0x62, 0xac, 0x88, 0x4b, 0x75, 0xe7, 0x5e,
+	0x33, 0x02, 0x12, 0x86, 0x73, 0xa9, 0x41, 0x6b, 0xf5, 0xd0, 0x14, 0x67, 0x62, 0xc5, 0x7c, 0x57,
+	0xb3, 0xbd, 0x9d, 0xb6, 0x09, 0xc4, 0x33, 0xe3, 0x7f, 0xa1, 0xc8, 0xe5, 0x58, 0x11, 0x4d, 0xd7,
+	0xa9, 0xe7, 0x55, 0x5d, 0xcd, 0x46, 0x55, 0xca, 0x04, 0x90, 0xb7, 0x82, 0xe0, 0xb8, 0xad, 0xb9,
+	0x5a, 0xcd, 0x2b, 0xd3, 0xbb, 0x0d, 0xea, 0xf9, 0xca, 0x5b, 0x30, 0x1e, 0x79, 0xeb, 0xd5, 0x1d,
+	0xdb, 0xa3, 0xe4, 0x65, 0xc8, 0xd5, 0xd9, 0x9b, 0xbc, 0x34, 0x25, 0x4d, 0x0f, 0x2d, 0x9c, 0x2b,
+	0xc6, 0x05, 0x77, 0x91, 0xa3, 0x96, 0xfa, 0x3e, 0xfd, 0x7c, 0xb2, 0xa7, 0x8c, 0x08, 0xe5, 0x3d,
+	0x09, 0x9e, 0x63, 0x3a, 0x4b, 0x96, 0xf5, 0x26, 0x13, 0x15, 0xa3, 0x05, 0x6a, 0x3d, 0x5f, 0xf3,
+	0x1b, 0x5c, 0xed, 0xe8, 0x82, 0x12, 0xaf, 0x96, 0xa3, 0x36, 0x99, 0x64, 0x19, 0x11, 0x64, 0x0d,
+	0xa0, 0x15, 0xce, 0xf9, 0x5e, 0x46, 0xeb, 0x72, 0x11, 0x43, 0x30, 0x88, 0xe7, 0x22, 0x5f, 0x8c,
+	0x18, 0xb5, 0xc5, 0xdb, 0x5a, 0x95, 0xe2, 0xb8, 0xe5, 0x10, 0x52, 0xf9, 0x95, 0x04, 0xa7, 0x0f,
+	0xd1, 0xc3, 0x69, 0x2f, 0xc1, 0x00, 0x67, 0x11, 0x10, 0x3c, 0x36, 0x3d, 0xb4, 0x30, 0x51, 0xe4,
+	0x51, 0x5d, 0x14, 0x4b, 0xaf, 0x58, 0xb2, 0xf7, 0x97, 0xc8, 0x1f, 0x3f, 0x99, 0x1b, 0xe5, 0xd8,
+	0x92, 0xae, 0x3b, 0x0d, 0xdb, 0x5f, 0x2f, 0x0b, 0x20, 0xb9, 0x19, 0xc3, 0xf3, 0x4a, 0x47, 0x9e,
+	0x9c, 0x40, 0x84, 0xe8, 0xf3, 0xe8, 0x30, 0x3e, 0x90, 0x30, 0xe1, 0x28, 0xf4, 0x9a, 0x06, 0x33,
+	0xdf, 0xf1, 0x72, 0xaf, 0x69, 0x28, 0xdf, 0x82, 0xf1, 0x88, 0x14, 0xce, 0xe4, 0x75, 0xc8, 0x71,
+	0x42, 0xe8, 0xc0, 0xec, 0x13, 0x41, 0x9c, 0x52, 0x43, 0xc5, 0x6f, 0x38, 0x96, 0x61, 0xda, 0xd5,
+	0x84, 0xf1, 0x8f, 0xcc, 0x2d, 0x4f, 0x24, 0x98, 0x88, 0x8e, 0x87, 0x33, 0x79, 0x0d, 0x06, 0xb7,
+	0x35, 0x2b, 0x88, 0x10, 0xe1, 0x94, 0xf3, 0xf1, 0x51, 0xb3, 0xc4, 0xa5, 0x30, 0x1a, 0x9b, 0xa0,
+	0xa3, 0x77, 0xc8, 0x66, 0xa3, 0x5e, 0xb7, 0xf6, 0x93, 0x1c, 0x72, 0x0b, 0xc6, 0x23, 0x52, 0x38,
+	0x8d, 0x97, 0x20, 0xa7, 0xd5, 0x02, 0x0b, 0xa3, 0x43, 0xce, 0x44, 0x18, 0x88, 0xb1, 0x97, 0x1d,
+	0xd3, 0x16, 0xcb, 0x89, 0x8b, 0x37, 0x47, 0x5d, 0xf5, 0x74, 0xd7, 0xb9, 0x9f, 0x34, 0xea, 0xbb,
+	0x12, 0x8c, 0x47, 0xc4, 0x70, 0xd8, 0x7d, 0xc8, 0x51, 0xf6, 0x06, 0x6d, 0x97, 0x32, 0xec, 0x5a,
+	0x30, 0xec, 0x07, 0x7f, 0x9b, 0x9c, 0xae, 0x9a, 0xfe, 0x6e, 0x63, 0xbb, 0xa8, 0x3b, 0x35, 0xdc,
+	0xe1, 0xf1, 0xbf, 0x39, 0xcf, 0xd8, 0x53, 0xfd, 0xfd, 0x3a, 0xf5, 0x18, 0xc0, 0xfb, 0xe9, 0x57,
+	0x1f, 0xcf, 0x0e, 0x5b, 0xb4, 0xaa, 0xe9, 0xfb, 0x95, 0xe0, 0x0c, 0xf1, 0xde, 0xff, 0xea, 0xe3,
+	0x59, 0xa9, 0x8c, 0x03, 0x36, 0x89, 0x97, 0xd8, 0x56, 0x94, 0x44, 0xfc, 0x6d, 0x18, 0x8f, 0x48,
+	0x21, 0xef, 0x65, 0x18, 0xd4, 0x78, 0x44, 0x0a, 0xaf, 0x5f, 0x88, 0xf7, 0x3a, 0xc7, 0xdd, 0x0c,
+	0x36, 0x3a, 0xe1, 0x79, 0x01, 0x54, 0xe6, 0xe1, 0x0c, 0xd3, 0xbd, 0x42, 0x6d, 0xa7, 0xf6, 0x26,
+	0xf5, 0x35, 0x43, 0xf3, 0x35, 0x41, 0x64, 0x02, 0xfa, 0x8d, 0xe0, 0x3d, 0x72, 0xe1, 0x0f, 0xca,
+	0xb7, 0x41, 0x8e, 0x83, 0xb4, 0x62, 0xb1, 0x86, 0xef, 0xd0, 0x8d, 0xe7, 0x5b, 0xf6, 0xb4, 0xf7,
+	0x9a, 0xf6, 0x14, 0x40, 0xc1, 0x48, 0x80, 0x14, 0x55, 0xec, 0x3d, 0x9c, 0xe2, 0x4a, 0x47, 0x3e,
+	0x07, 0x90, 0x3f, 0x0c, 0x40, 0x36, 0x13, 0xd0, 0x7f, 0x4f, 0xb3, 0x1a, 0x54, 0x20, 0xd8, 0x03,
+	0x59, 0x84, 0x41, 0xc3, 0xd1, 0x1b, 0x35, 0x6a, 0xfb, 0x18, 0xec, 0xa7, 0x0f, 0xad, 0xfd, 0x4d,
+	0x96, 0x3f, 0x94, 0x9b, 0x82, 0xe4, 0x39, 0xc8, 0x79, 0xfa, 0x2e, 0xad, 0x69, 0xf9, 0x63, 0x4c,
+	0x17, 0x3e, 0x05, 0x9b, 0xe5, 0x00, 0xae, 0x2b, 0x92, 0x87, 0x01, 0xcd, 0x30, 0x5c, 0xea, 0x79,
+	0x38, 0xa0, 0x78, 0x24, 0xf7, 0xa1, 0x9f, 0xf9, 0x3f, 0xdf, 0xfb, 0xdf, 0x8a, 0x31, 0x3e, 0xde,
+	0xcb, 0x83, 0xef, 0x3c, 0x99, 0xec, 0xf9, 0xe7, 0x93, 0xc9, 0x1e, 0xe5, 0x05, 0xf4, 0xdb, 0x2d,
+	0xea, 0x97, 0x3c, 0x8f, 0xfa, 0xdf, 0x0c, 0x6c, 0x91, 0x18, 0x74, 0x2e, 0x9c, 0x8d, 0x95, 0x46,
+	0xc3, 0x6e, 0xc2, 0x98, 0x4d, 0xfd, 0x8a, 0x16, 0x7c, 0xaa, 0x30, 0xab, 0x8a, 0x20, 0xbc, 0x18,
+	0x1f, 0x84, 0x11, 0x3d, 0xe8, 0xf4, 0x51, 0x3b, 0xa2, 0x5c, 0x59, 0x46, 0x4f, 0xae, 0x84, 0x72,
+	0x1e, 0xc1, 0xef, 0x0a, 0x9c, 0x08, 0xa7, 0x42, 0x15, 0x24, 0xdb, 0x57, 0x1e, 0x0d, 0xbf, 0x5e,
+	0x37, 0x14, 0x53, 0x44, 0x74, 0x44, 0x09, 0xd2, 0xde, 0x80, 0xe1, 0xb0, 0x38, 0x46, 0x68, 0xc2,
+	0x19, 0x1b, 0xd6, 0x80, 0x8c, 0x23, 0x68, 0xc5, 0x8b, 0x19, 0xca, 0x7b, 0xd6, 0xa7, 0xc0, 0x6f,
+	0x25, 0x90, 0xe3, 0x46, 0xc5, 0x19, 0xde, 0x82, 0x91, 0x30, 0x47, 0xe1, 0x95, 0xec, 0x53, 0x8c,
+	0xc2, 0x8f, 0xee, 0x68, 0x78, 0x13, 0x2e, 0x84, 0x36, 0xfd, 0x92, 0x65, 0x39, 0xf7, 0x03, 0x32,
+	0x77, 0x3c, 0xad, 0x9a, 0x18, 0x85, 0xe1, 0x05, 0xd5, 0x1b, 0x59, 0x50, 0xca, 0x47, 0x12, 0x28,
+	0x69, 0xfa, 0xd0, 0x1c, 0x5f, 0x87, 0x7e, 0x96, 0xe1, 0xa1, 0xa7, 0x33, 0xef, 0x90, 0x1c, 0x45,
+	0xde, 0x80, 0x5c, 0x83, 0x29, 0xc4, 0x75, 0x3b, 0x1b, 0x8f, 0x8f, 0xe3, 0x20, 0xce, 0x28, 0x8e,
+	0x57, 0x5e, 0xc5, 0xad, 0x7e, 0x83, 0xa5, 0xcc, 0xdd, 0xcf, 0xf7, 0x91, 0x38, 0xbd, 0x84, 0x82,
+	0x56, 0x1a, 0xca, 0xb3, 0xf0, 0xf4, 0x34, 0x94, 0xa3, 0x04, 0x27, 0x8e, 0x08, 0x0e, 0xdc, 0xe0,
+	0x2f, 0x6a, 0xa0, 0x5f, 0x3b, 0x1f, 0xb8, 0x5c, 0xbc, 0x99, 0xf8, 0x70, 0xad, 0xcf, 0x3c, 0xe4,
+	0x1f, 0x8b, 0xc4, 0xa7, 0x39, 0x1e, 0x4e, 0xfe, 0x15, 0x18, 0xe0, 0x53, 0x11, 0x61, 0x9e, 0x65,
+	0xf6, 0x02, 0x72, 0x74, 0xa1, 0x5d, 0x84, 0x73, 0x8c, 0x5e, 0xb9, 0x79, 0x8d, 0x59, 0x76, 0xec,
+	0x1d, 0x33, 0x29, 0x21, 0x54, 0x28, 0x9c, 0x4f, 0x90, 0xc7, 0x79, 0xad, 0x40, 0x4e, 0x67, 0x6f,
+	0xd0, 0xa9, 0x97, 0xe3, 0xa7, 0xd5, 0x8e, 0x17, 0x5e, 0xe2, 0x58, 0xe5, 0x01, 0x14, 0xf8, 0xc5,
+	0x85, 0xda, 0x3c, 0x5d, 0x14, 0xd2, 0xcf, 0xdc, 0x61, 0xbf, 0x97, 0x60, 0x32, 0x71, 0x68, 0x9c,
+	0xe3, 0x37, 0x60, 0xa8, 0x75, 0xed, 0x13, 0xfe, 0xbb, 0x92, 0x70, 0x89, 0x6a, 0x57, 0x83, 0x33,
+	0x0d, 0x6b, 0x38, 0x3a, 0x77, 0xae, 0xe2, 0xb6, 0xbe, 0x85, 0xd7, 0xcd, 0x8d, 0xe0, 0xb6, 0xd9,
+	0xfd, 0x8a, 0xfd, 0x57, 0x2f, 0xc8, 0x71, 0x7a, 0x9a, 0x89, 0x52, 0x3f, 0xbb, 0xc6, 0xa2, 0x8b,
+	0x13, 0x8e, 0xcd, 0x08, 0x56, 0xec, 0x4d, 0x0c, 0x47, 0x5e, 0x05, 0x30, 0x34, 0xd3, 0xda, 0xaf,
+	0x34, 0xbc, 0xec, 0x2b, 0xf8, 0x38, 0x83, 0xdc, 0xf1, 0xa8, 0x41, 0x96, 0xe0, 0x04, 0xc7, 0xbb,
+	0xb4, 0xa6, 0x99, 0xb6, 0x69, 0x57, 0xf3, 0xc7, 0x3a, 0x28, 0x29, 0x8f, 0x32, 0x44, 0x59, 0x00,
+	0xc8, 0xeb, 0x30, 0x74, 0x9f, 0xd2, 0x3d, 0x41, 0xa2, 0x2f, 0x1b, 0x09, 0xe0, 0x18, 0xc6, 0x62,
+	0x05, 0xc6, 0x50, 0x43, 0x8b, 0x46, 0x7f, 0x27, 0x1a, 0x27, 0x38, 0xa4, 0xc9, 0x43, 0xf1, 0xe3,
+	0x4c, 0xfd, 0xcc, 0xc3, 0xfc, 0xdf, 0x12, 0x9c, 0x8d, 0x1d, 0x16, 0x5d, 0xfc, 0x06, 0x8c, 0x18,
+	0x74, 0x47, 0x6b, 0x58, 0x7e, 0xa5, 0x5b, 0x57, 0x97, 0x87, 0x11, 0xc9, 0x9e, 0x48, 0x09, 0x72,
+	0x4c, 0x83, 0x38, 0x87, 0xba, 0x88, 0x16, 0x04, 0xb6, 0x2d, 0x8f, 0x63, 0x4f, 0xbf, 0x3c, 0xbe,
+	0xdf, 0x87, 0xb3, 0x5e, 0x33, 0x2d, 0x9f, 0xba, 0xd4, 0x68, 0xab, 0x60, 0x5c, 0x84, 0x11, 0x76,
+	0x78, 0x56, 0xa2, 0xa9, 0xf0, 0x30, 0x7b, 0x59, 0xe2, 0xef, 0xc8, 0x75, 0xc8, 0xf1, 0xfa, 0x0b,
+	0x33, 0xff, 0xe8, 0xc2, 0xb9, 0xb4, 0x83, 0xb9, 0x8c, 0xb2, 0xa4, 0x04, 0x43, 0xfc, 0x5b, 0x25,
+	0xc8, 0x7f, 0xd9, 0x24, 0x46, 0x17, 0xa6, 0xd2, 0x2a, 0x24, 0x5b, 0xfb, 0x75, 0x5a, 0x86, 0x5a,
+	0xf3, 0xef, 0x50, 0x7d, 0xa5, 0xaf, 0xeb, 0xfa, 0xca, 0x32, 0x0c, 0x7b, 0xec, 0xa4, 0xaf, 0xec,
+	0x98, 0x0f, 0xa8, 0x91, 0xef, 0x4f, 0x1b, 0x7f, 0xcd, 0xd2, 0xaa, 0xdc, 0x42, 0xe5, 0x21, 0x8e,
+	0x5a, 0x0b, 0x40, 0x64, 0x0b, 0x4e, 0x69, 0x41, 0xa2, 0x50, 0xd9, 0x71, 0x5c, 0x9d, 0x1a, 0x15,
+	0x51, 0xd4, 0xca, 0xe7, 0x32, 0x6a, 0x1b, 0x67, 0xf0, 0x35, 0x86, 0x16, 0x0e, 0x27, 0x73, 0x40,
+	0x5c, 0x7a, 0xb7, 0x61, 0xba, 0xd4, 0xa8, 0x68, 0x3e, 0xcf, 0xdf, 0x68, 0x7e, 0x80, 0x59, 0xfe,
+	0xa4, 0xf8, 0x52, 0x12, 0x1f, 0xda, 0x56, 0xc0, 0xe0, 0x53, 0xaf, 0x80, 0x0f, 0x25, 0x3c, 0xfa,
+	0x0e, 0xc5, 0xc2, 0xff, 0x62, 0xb9, 0xc8, 0xc5, 0xfb, 0xc5, 0x26, 0xb5, 0x8d, 0x15, 0x6a, 0xef,
+	0x6f, 0x98, 0x9e, 0xff, 0xac, 0xf7, 0x88, 0x0f, 0x25, 0x38, 0x13, 0x33, 0x28, 0x9a, 0x67, 0x15,
+	0x06, 0xa8, 0xed, 0xbb, 0x66, 0xf3, 0xf6, 0x74, 0x29, 0x21, 0x4f, 0xa7, 0x36, 0x53, 0x80, 0xcb,
+	0x47, 0x64, 0x32, 0x88, 0x3d, 0x3a, 0x0b, 0xdd, 0xc4, 0x44, 0xab, 0x8c, 0x55, 0xdb, 0x24, 0xeb,
+	0x4c, 0x06, 0x87, 0x37, 0x17, 0x09, 0x6e, 0x62, 0xbd, 0xec, 0x26, 0x06, 0xe2, 0xd5, 0xba, 0xa1,
+	0xec, 0xc3, 0xa9, 0x36, 0x45, 0xcd, 0xaa, 0xdb, 0xa0, 0x10, 0xc3, 0xed, 0xb0, 0x90, 0x94, 0xdc,
+	0x70, 0x29, 0x51, 0x20, 0x10, 0x28, 0x52, 0x00, 0xa0, 0x0f, 0xa8, 0xde, 0xf0, 0xb5, 0x6d, 0x8b,
+	0xb2, 0xa1, 0x07, 0xcb, 0xa1, 0x37, 0xca, 0x23, 0x51, 0x5c, 0x45, 0x0d, 0xe6, 0x53, 0x5c, 0x2f,
+	0xda, 0xdc, 0x7f, 0xec, 0xa9, 0xdd, 0xff, 0xbe, 0x28, 0xa5, 0x86, 0xc9, 0x34, 0xb3, 0x3c, 0x61,
+	0xb1, 0x96, 0xff, 0xb3, 0x19, 0x23, 0x84, 0x3b, 0x3a, 0xdf, 0x5f, 0x46, 0xdf, 0x2f, 0x6b, 0xf5,
+	0xad, 0xc0, 0x90, 0xc9, 0xe5, 0xa8, 0x53, 0x6d, 0x72, 0x38, 0x9f, 0x12, 0x1c, 0xd7, 0xb5, 0x7a,
+	0x85, 0xfb, 0x25, 0xd5, 0xb7, 0x02, 0x2a, 0x7c, 0xab, 0xe3, 0xb3, 0xf2, 0x89, 0x04, 0x83, 0xe2,
+	0x63, 0x7c, 0xb9, 0x27, 0xa8, 0xc3, 0xec, 0x52, 0xb3, 0xba, 0xcb, 0x4b, 0x37, 0xc7, 0xca, 0xf8,
+	0x44, 0x6e, 0x40, 0x8e, 0x6f, 0xb3, 0xbc, 0x3e, 0xb3, 0x74, 0x3e, 0x50, 0xfd, 0xd7, 0xcf, 0x27,
+	0x4f, 0x71, 0x53, 0x78, 0xc6, 0x5e, 0xd1, 0x74, 0xd4, 0x9a, 0xe6, 0xef, 0x16, 0xd7, 0x6d, 0xbf,
+	0x8c, 0xc2, 0x64, 0xb9, 0xb5, 0x02, 0xfb, 0xd2, 0x8e, 0x56, 0xc1, 0x6a, 0xd5, 0xf6, 0x9b, 0x6e,
+	0x10, 0x48, 0xe5, 0xb3, 0x5e, 0x18, 0x89, 0x08, 0xa4, 0x54, 0x82, 0x5e, 0x82, 0x01, 0xac, 0xbb,
+	0xe6, 0x7b, 0xb3, 0x10, 0x15, 0xd2, 0x64, 0x1e, 0xfa, 0x76, 0xa9, 0x65, 0x64, 0x9b, 0x1e, 0x13,
+	0x25, 0xaf, 0xc1, 0xd0, 0xdd, 0x86, 0x16, 0x9c, 0xbb, 0xa6, 0x8d, 0xe9, 0x59, 0x47, 0x64, 0x18,
+	0x41, 0x16, 0xa1, 0xdf, 0x77, 0x7c, 0xcd, 0xca, 0xf7, 0x67, 0x81, 0x72, 0x59, 0xb2, 0x0c, 0x50,
+	0xa7, 0xae, 0x4e, 0x6d, 0x5f, 0xab, 0x52, 0x76, 0xac, 0x1d, 0x5f, 0xba, 0x88, 0xc8, 0xb3, 0x87,
+	0x91, 0x1b, 0xac, 0x74, 0xb5, 0x42, 0xf5, 0x72, 0x08, 0xa6, 0xcc, 0xc0, 0xe9, 0x66, 0xad, 0x3b,
+	0x3d, 0x05, 0x57, 0xbe, 0x03, 0xf9, 0xc3, 0xa2, 0xad, 0xfb, 0x7f, 0x38, 0xf5, 0x4a, 0xb8, 0xff,
+	0x87, 0x90, 0xd1, 0x1c, 0xfb, 0x02, 0x0c, 0x63, 0xcf, 0x8c, 0x1d, 0x4a, 0xb8, 0xd1, 0x0d, 0xf1,
+	0x77, 0xcb, 0xc1, 0xab, 0xe6, 0xe5, 0x6f, 0x7d, 0x5b, 0x5f, 0xde, 0xd5, 0x6c, 0x9b, 0x5a, 0xb7,
+	0x59, 0xe3, 0x2c, 0x89, 0xed, 0x47, 0x12, 0x9c, 0x4f, 0x00, 0xb4, 0x6e, 0x7f, 0xbc, 0xf7, 0x96,
+	0x7e, 0xfb, 0x6b, 0xc7, 0x37, 0x7b, 0x4c, 0xec, 0x89, 0xac, 0xc1, 0x00, 0xaf, 0x32, 0x8b, 0x9c,
+	0xb1, 0xa3, 0x1a, 0x5e, 0x17, 0x6f, 0xc6, 0x36, 0x07, 0x2b, 0xbb, 0x30, 0xd6, 0x2e, 0x42, 0xce,
+	0x03, 0xe8, 0xfc, 0x45, 0xa5, 0x39, 0xb7, 0xe3, 0xf8, 0x66, 0xdd, 0x08, 0x96, 0xa2, 0x56, 0x6b,
+	0xda, 0xab, 0xf3, 0x52, 0xe4, 0xc2, 0xb3, 0x3f, 0x91, 0x00, 0x5a, 0x79, 0x0e, 0x29, 0xc2, 0xe9,
+	0xb5, 0x8d, 0xd2, 0xcd, 0xca, 0xda, 0xfa, 0xc6, 0xd6, 0x6a, 0xb9, 0x72, 0xe7, 0xd6, 0xe6, 0xed,
+	0xd5, 0xe5, 0xf5, 0xb5, 0xf5, 0xd5, 0x95, 0xb1, 0x1e, 0xf9, 0xe4, 0xa3, 0xc7, 0x53, 0x23, 0x2d,
+	0xe1, 0x92, 0xbd, 0x4f, 0xa6, 0x61, 0x2c, 0x2c, 0xbf, 0x55, 0xbe, 0xb3, 0x3a, 0x26, 0xc9, 0xe4,
+	0xd1, 0xe3, 0xa9, 0xd1, 0x96, 0xe0, 0x96, 0xdb, 0xa0, 0x64, 0x16, 0x4e, 0x86, 0x25, 0xd7, 0x4a,
+	0x1b, 0x9b, 0xab, 0x63, 0xbd, 0xf2, 0xf8, 0xa3, 0xc7, 0x53, 0x27, 0x5a, 0xa2, 0x6b, 0x9a, 0xe5,
+	0x51, 0xb9, 0xef, 0x9d, 0x5f, 0x14, 0x7a, 0x16, 0xfe, 0x34, 0x05, 0xfd, 0xcc, 0x69, 0xe4, 0x7b,
+	0x12, 0xe4, 0x78, 0x4f, 0x8f, 0x4c, 0xc7, 0x1b, 0xf4, 0x70, 0x0b, 0x51, 0x9e, 0xc9, 0x20, 0xc9,
+	0x9d, 0xaf, 0x3c, 0xff, 0xdd, 0x3f, 0xff, 0xe3, 0xc7, 0xbd, 0x05, 0x72, 0x4e, 0x8d, 0x6d, 0x5a,
+	0xf2, 0x06, 0x22, 0xf9, 0x81, 0x04, 0xd0, 0x6a, 0xce, 0x91, 0x17, 0x52, 0xf4, 0x1f, 0x6a, 0x31,
+	0xca, 0x73, 0x19, 0xa5, 0x91, 0xd1, 0x05, 0xc6, 0xe8, 0x2c, 0x39, 0x13, 0xcf, 0x48, 0xb3, 0x2c,
+	0xf2, 0x8e, 0x04, 0x39, 0x0e, 0x4b, 0x35, 0x4a, 0xa4, 0x4d, 0x27, 0xcf, 0x64, 0x90, 0x44, 0x0a,
+	0x33, 0x8c, 0xc2, 0x45, 0x72, 0x21, 0x9e, 0x82, 0x41, 0x7d, 0xcd, 0xb4, 0xd4, 0x87, 0xa6, 0x71,
+	0x10, 0x58, 0x66, 0x00, 0xfb, 0x63, 0x24, 0x6d, 0x84, 0x68, 0xcf, 0x4e, 0x9e, 0xcd, 0x22, 0x8a,
+	0x6c, 0x66, 0x19, 0x9b, 0xe7, 0x89, 0xa2, 0x26, 0x36, 0xd9, 0x4d, 0xbb, 0xca, 0xe9, 0x04, 0x96,
+	0xe1, 0xd5, 0xc1, 0x54, 0xcb, 0x44, 0xfa, 0x65, 0xf2, 0x4c, 0x06, 0xc9, 0x6c, 0x96, 0xe1, 0x87,
+	0x5c, 0x8b, 0x0a, 0xae, 0xdf, 0x34, 0x2a, 0x91, 0x26, 0x9a, 0x3c, 0x93, 0x41, 0x32, 0x1b, 0x15,
+	0xbe, 0x9f, 0x70, 0x2a, 0x3f, 0x94, 0x20, 0xc7, 0xaf, 0x76, 0xa9, 0x54, 0x22, 0x6d, 0x31, 0x79,
+	0x26, 0x83, 0x24, 0x52, 0xb9, 0xc6, 0xa8, 0xcc, 0x92, 0x69, 0x35, 0xa5, 0xf3, 0xaf, 0x3b, 0xb6,
+	0xef, 0x3a, 0x18, 0x36, 0x1f, 0x48, 0x30, 0x12, 0x69, 0x68, 0x11, 0x35, 0x65, 0xb8, 0xb8, 0x6e,
+	0x99, 0x7c, 0x2d, 0x3b, 0x00, 0x69, 0xbe, 0xc8, 0x68, 0x5e, 0x23, 0x45, 0x35, 0xe1, 0x17, 0x1d,
+	0x3e, 0x4b, 0x79, 0x44, 0x6b, 0x4c, 0x7d, 0xc8, 0x1e, 0x0f, 0xc8, 0xcf, 0x25, 0x18, 0x0a, 0x75,
+	0xbb, 0xc8, 0x5c, 0xba, 0x65, 0xda, 0xda, 0x68, 0x72, 0x31, 0xab, 0x38, 0xd2, 0x9c, 0x67, 0x34,
+	0xaf, 0x92, 0x99, 0x44, 0x6b, 0x06, 0x90, 0x08, 0xc3, 0xf7, 0x25, 0x18, 0x8d, 0x76, 0x8e, 0x48,
+	0x9a, 0x79, 0x62, 0x5b, 0x52, 0xf2, 0x7c, 0x17, 0x88, 0x6c, 0x54, 0x6d, 0xea, 0xb3, 0x8e, 0x15,
+	0x6f, 0x58, 0x71, 0xcf, 0x7f, 0x24, 0xc1, 0x70, 0xb8, 0x0d, 0x42, 0xd2, 0xcc, 0x13, 0xd3, 0x99,
+	0x92, 0xd5, 0xcc, 0xf2, 0x48, 0xf2, 0x15, 0x46, 0xf2, 0x45, 0x72, 0x5d, 0xed, 0xf8, 0x8b, 0x1f,
+	0xf5, 0x61, 0x5b, 0xd3, 0xeb, 0x80, 0xfc, 0x32, 0x88, 0xd4, 0x48, 0x8b, 0x26, 0x2b, 0x01, 0x2f,
+	0x53, 0xa4, 0xc6, 0x75, 0x95, 0x3a, 0x2d, 0xa8, 0x30, 0x49, 0x34, 0xeb, 0x1f, 0x24, 0x38, 0x15,
+	0xdb, 0x9a, 0x21, 0x2f, 0x75, 0xdc, 0xdd, 0xe2, 0x9b, 0x43, 0xf2, 0xff, 0x75, 0x0f, 0x44, 0xfa,
+	0x5f, 0x63, 0xf4, 0x6f, 0x90, 0xc5, 0xc4, 0x23, 0x8c, 0xc3, 0x58, 0xaf, 0x86, 0xf1, 0x57, 0x1f,
+	0x62, 0xbe, 0x7e, 0x40, 0x7e, 0x24, 0x41, 0x8e, 0x37, 0x10, 0x52, 0x37, 0xab, 0x48, 0x63, 0x47,
+	0x9e, 0xc9, 0x20, 0x89, 0xe4, 0x16, 0x19, 0xb9, 0x39, 0x72, 0x55, 0x4d, 0xf9, 0x8d, 0x55, 0x3b,
+	0xa9, 0xe0, 0x98, 0xdb, 0xc0, 0x3e, 0x46, 0xe7, 0xb1, 0xbc, 0x2c, 0xc7, 0x5c, 0x5b, 0x73, 0xa5,
+	0xd3, 0x31, 0xc7, 0x79, 0xa1, 0xb7, 0x7f, 0x23, 0xc1, 0x58, 0x7b, 0x37, 0x82, 0x2c, 0xa4, 0x0c,
+	0x96, 0xd0, 0x2a, 0x91, 0x17, 0xbb, 0xc2, 0x20, 0xd3, 0xeb, 0x8c, 0x69, 0x91, 0xbc, 0xa0, 0x76,
+	0xf8, 0x75, 0x19, 0xb7, 0x22, 0x6f, 0x8f, 0x90, 0xdf, 0x49, 0x40, 0x0e, 0xf7, 0x27, 0xc8, 0xf5,
+	0xb4, 0x5c, 0x2d, 0xa9, 0x93, 0x22, 0xdf, 0xe8, 0x12, 0x85, 0xcc, 0x6f, 0x30, 0xe6, 0x2a, 0x99,
+	0xcb, 0xc6, 0xbc, 0xce, 0x35, 0x91, 0x5f, 0x4b, 0x30, 0x12, 0xa9, 0xf5, 0xa6, 0xee, 0x01, 0x71,
+	0x7d, 0x0c, 0xf9, 0x5a, 0x76, 0x00, 0x72, 0x7d, 0x99, 0x71, 0xbd, 0x4e, 0x16, 0xd4, 0xd4, 0x1f,
+	0xe7, 0xb1, 0x8b, 0x53, 0x7b, 0xb8, 0x06, 0xe7, 0x41, 0x44, 0x6b, 0xfa, 0x79, 0x10, 0x5b, 0xc6,
+	0x97, 0xe7, 0xbb, 0x40, 0x64, 0x3b, 0x0f, 0x22, 0x9c, 0x31, 0x94, 0x9f, 0x48, 0x70, 0xa2, 0xad,
+	0x9a, 0x49, 0xd2, 0x46, 0x8e, 0xaf, 0x82, 0xcb, 0x0b, 0xdd, 0x40, 0x90, 0xed, 0x65, 0xc6, 0x76,
+	0x8a, 0x14, 0xe2, 0xd9, 0xee, 0x20, 0x8c, 0xbc, 0x27, 0xc1, 0x70, 0xb8, 0x9c, 0x98, 0x7a, 0x64,
+	0xc5, 0x14, 0x3b, 0x65, 0x35, 0xb3, 0x3c, 0x32, 0xbb, 0xca, 0x98, 0x5d, 0x22, 0x17, 0x13, 0xd2,
+	0x4c, 0x6a, 0x1b, 0x06, 0xb5, 0x31, 0xd1, 0xfc, 0x99, 0x04, 0x83, 0xa2, 0x60, 0x45, 0x66, 0x53,
+	0x17, 0x74, 0xa4, 0xca, 0x28, 0x5f, 0xcd, 0x24, 0x8b, 0x94, 0xfe, 0x9f, 0x51, 0x5a, 0x24, 0xf3,
+	0x6a, 0xea, 0xef, 0x4e, 0x31, 0x12, 0x43, 0xd5, 0xca, 0x03, 0x12, 0x5c, 0x34, 0x5b, 0xf5, 0xb8,
+	0xd4, 0xdb, 0xd3, 0xa1, 0x1a, 0xa2, 0x3c, 0x97, 0x51, 0x1a, 0x69, 0xce, 0x31, 0x9a, 0x57, 0xc8,
+	0xa5, 0x54, 0x9a, 0xa6, 0xc8, 0x46, 0xde, 0x0d, 0x17, 0xc0, 0xd2, 0x6c, 0xd7, 0x56, 0xa5, 0x93,
+	0xaf, 0x66, 0x92, 0xcd, 0xe6, 0x4e, 0x5d, 0xab, 0xb3, 0x22, 0x1e, 0xa7, 0xf4, 0x9e, 0x04, 0x43,
+	0xa1, 0x02, 0x49, 0x6a, 0xb6, 0x79, 0xb8, 0x5a, 0x23, 0x17, 0xb3, 0x8a, 0x23, 0xb7, 0x22, 0xe3,
+	0x36, 0x4d, 0x2e, 0xab, 0x29, 0x3f, 0x61, 0x6e, 0x6d, 0x32, 0xe4, 0x63, 0x09, 0xc6, 0xda, 0x4b,
+	0x21, 0xa9, 0x47, 0x4f, 0x42, 0xa1, 0x46, 0x5e, 0xec, 0x0a, 0x93, 0xed, 0xf0, 0x36, 0xb7, 0x75,
+	0x2c, 0x8b, 0xf0, 0xaa, 0x0c, 0xa3, 0xbc, 0x54, 0xfd, 0xf4, 0x8b, 0x82, 0xf4, 0xd9, 0x17, 0x05,
+	0xe9, 0xef, 0x5f, 0x14, 0xa4, 0x77, 0xbf, 0x2c, 0xf4, 0x7c, 0xf6, 0x65, 0xa1, 0xe7, 0x2f, 0x5f,
+	0x16, 0x7a, 0xe0, 0xb4, 0xe9, 0xc4, 0xb2, 0xb8, 0x2d, 0xbd, 0xbd, 0x10, 0xfa, 0x3d, 0x58, 0x4b,
+	0x64, 0xce, 0x74, 0xc2, 0x23, 0x3f, 0x10, 0x63, 0xb3, 0xdf, 0x87, 0x6d, 0xe7, 0x58, 0x93, 0x65,
+	0xf1, 0x3f, 0x03, 0x00, 0x3d, 0x26, 0x1f, 0xc9, 0x9a, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/bank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns a list of all markers on the blockchain
+	AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error)
+	// query for a single marker by denom or address
+	Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error)
+	// query for all accounts holding the given marker coins
+	Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error)
+	// query for supply of coin on a marker account
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// query for coins on a marker account
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// query for access records on an account
+	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
+	// query for access records on an account
+	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
+	// query for account data associated with a denom
+	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+	// NetAssetValues returns net asset values for marker
+	NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error)
+	// Distribution returns the status of a marker distribution.
+	Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error)
+	// Distributions returns the distributions for a marker.
+	Distributions(ctx context.Context, in *QueryDistributionsRequest, opts ...grpc.CallOption) (*QueryDistributionsResponse, error)
+	// SupplyAllowanceUsages returns the amounts an address has used of its mint and burn allowances on a marker.
+	SupplyAllowanceUsages(ctx context.Context, in *QuerySupplyAllowanceUsagesRequest, opts ...grpc.CallOption) (*QuerySupplyAllowanceUsagesResponse, error)
+	// Lockup returns an account's lockup of a marker's denom along with the amount currently locked.
+	Lockup(ctx context.Context, in *QueryLockupRequest, opts ...grpc.CallOption) (*QueryLockupResponse, error)
+	// Lockups returns all lockups of a marker's denom.
+	Lockups(ctx context.Context, in *QueryLockupsRequest, opts ...grpc.CallOption) (*QueryLockupsResponse, error)
+	// RedemptionConfig returns how holders can redeem a marker's denom.
+	RedemptionConfig(ctx context.Context, in *QueryRedemptionConfigRequest, opts ...grpc.CallOption) (*QueryRedemptionConfigResponse, error)
+	// PendingRedemptions returns the redemptions of a marker's denom that are awaiting approval.
+	PendingRedemptions(ctx context.Context, in *QueryPendingRedemptionsRequest, opts ...grpc.CallOption) (*QueryPendingRedemptionsResponse, error)
+	// TransferLimit returns the transfer limit that applies to an account along with how much of it is used and left.
+	TransferLimit(ctx context.Context, in *QueryTransferLimitRequest, opts ...grpc.CallOption) (*QueryTransferLimitResponse, error)
+	// TransferLimits returns a marker's default transfer limit and the limits of specific accounts.
+	TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error)
+	// FilteredMarkers returns the markers that match all of the provided filters.
+	FilteredMarkers(ctx context.Context, in *QueryFilteredMarkersRequest, opts ...grpc.CallOption) (*QueryFilteredMarkersResponse, error)
+	// SendDenyList returns the entries of a marker's deny send list along with why and by whom they were added.
+	SendDenyList(ctx context.Context, in *QuerySendDenyListRequest, opts ...grpc.CallOption) (*QuerySendDenyListResponse, error)
+	// Recovery returns a proposed recovery of a marker's denom.
+	Recovery(ctx context.Context, in *QueryRecoveryRequest, opts ...grpc.CallOption) (*QueryRecoveryResponse, error)
+	// Recoveries returns the proposed recoveries of a marker's denom.
+	Recoveries(ctx context.Context, in *QueryRecoveriesRequest, opts ...grpc.CallOption) (*QueryRecoveriesResponse, error)
+	// CapTable returns a snapshot of every account holding a marker's denom, including held and quarantined funds.
+	// Query at a specific height to get the cap table as of that height.
+	CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error)
+	// HolderLimit returns a marker's holder limit and the number of accounts currently holding its denom.
+	HolderLimit(ctx context.Context, in *QueryHolderLimitRequest, opts ...grpc.CallOption) (*QueryHolderLimitResponse, error)
+	// IbcChannelPolicy returns a marker's IBC channel policy and how much of its denom is escrowed for each capped channel.
+	IbcChannelPolicy(ctx context.Context, in *QueryIbcChannelPolicyRequest, opts ...grpc.CallOption) (*QueryIbcChannelPolicyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error) {
+	out := new(QueryAllMarkersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AllMarkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error) {
+	out := new(QueryMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Marker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error) {
+	out := new(QueryHoldingResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Holding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Escrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error) {
+	out := new(QueryAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Access", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error) {
+	out := new(QueryDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/DenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
+	out := new(QueryAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetAssetValues(ctx context.Context, in *QueryNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryNetAssetValuesResponse, error) {
+	out := new(QueryNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/NetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error) {
+	out := new(QueryDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Distribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Distributions(ctx context.Context, in *QueryDistributionsRequest, opts ...grpc.CallOption) (*QueryDistributionsResponse, error) {
+	out := new(QueryDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Distributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyAllowanceUsages(ctx context.Context, in *QuerySupplyAllowanceUsagesRequest, opts ...grpc.CallOption) (*QuerySupplyAllowanceUsagesResponse, error) {
+	out := new(QuerySupplyAllowanceUsagesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/SupplyAllowanceUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lockup(ctx context.Context, in *QueryLockupRequest, opts ...grpc.CallOption) (*QueryLockupResponse, error) {
+	out := new(QueryLockupResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Lockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lockups(ctx context.Context, in *QueryLockupsRequest, opts ...grpc.CallOption) (*QueryLockupsResponse, error) {
+	out := new(QueryLockupsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Lockups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionConfig(ctx context.Context, in *QueryRedemptionConfigRequest, opts ...grpc.CallOption) (*QueryRedemptionConfigResponse, error) {
+	out := new(QueryRedemptionConfigResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/RedemptionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRedemptions(ctx context.Context, in *QueryPendingRedemptionsRequest, opts ...grpc.CallOption) (*QueryPendingRedemptionsResponse, error) {
+	out := new(QueryPendingRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/PendingRedemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferLimit(ctx context.Context, in *QueryTransferLimitRequest, opts ...grpc.CallOption) (*QueryTransferLimitResponse, error) {
+	out := new(QueryTransferLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/TransferLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error) {
+	out := new(QueryTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/TransferLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FilteredMarkers(ctx context.Context, in *QueryFilteredMarkersRequest, opts ...grpc.CallOption) (*QueryFilteredMarkersResponse, error) {
+	out := new(QueryFilteredMarkersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/FilteredMarkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendDenyList(ctx context.Context, in *QuerySendDenyListRequest, opts ...grpc.CallOption) (*QuerySendDenyListResponse, error) {
+	out := new(QuerySendDenyListResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/SendDenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recovery(ctx context.Context, in *QueryRecoveryRequest, opts ...grpc.CallOption) (*QueryRecoveryResponse, error) {
+	out := new(QueryRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Recovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recoveries(ctx context.Context, in *QueryRecoveriesRequest, opts ...grpc.CallOption) (*QueryRecoveriesResponse, error) {
+	out := new(QueryRecoveriesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Recoveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error) {
+	out := new(QueryCapTableResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/CapTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HolderLimit(ctx context.Context, in *QueryHolderLimitRequest, opts ...grpc.CallOption) (*QueryHolderLimitResponse, error) {
+	out := new(QueryHolderLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/HolderLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IbcChannelPolicy(ctx context.Context, in *QueryIbcChannelPolicyRequest, opts ...grpc.CallOption) (*QueryIbcChannelPolicyResponse, error) {
+	out := new(QueryIbcChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/IbcChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/bank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns a list of all markers on the blockchain
+	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
+	// query for a single marker by denom or address
+	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
+	// query for all accounts holding the given marker coins
+	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
+	// query for supply of coin on a marker account
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// query for coins on a marker account
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// query for access records on an account
+	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
+	// query for access records on an account
+	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
+	// query for account data associated with a denom
+	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+	// NetAssetValues returns net asset values for marker
+	NetAssetValues(context.Context, *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error)
+	// Distribution returns the status of a marker distribution.
+	Distribution(context.Context, *QueryDistributionRequest) (*QueryDistributionResponse, error)
+	// Distributions returns the distributions for a marker.
+	Distributions(context.Context, *QueryDistributionsRequest) (*QueryDistributionsResponse, error)
+	// SupplyAllowanceUsages returns the amounts an address has used of its mint and burn allowances on a marker.
+	SupplyAllowanceUsages(context.Context, *QuerySupplyAllowanceUsagesRequest) (*QuerySupplyAllowanceUsagesResponse, error)
+	// Lockup returns an account's lockup of a marker's denom along with the amount currently locked.
+	Lockup(context.Context, *QueryLockupRequest) (*QueryLockupResponse, error)
+	// Lockups returns all lockups of a marker's denom.
+	Lockups(context.Context, *QueryLockupsRequest) (*QueryLockupsResponse, error)
+	// RedemptionConfig returns how holders can redeem a marker's denom.
+	RedemptionConfig(context.Context, *QueryRedemptionConfigRequest) (*QueryRedemptionConfigResponse, error)
+	// PendingRedemptions returns the redemptions of a marker's denom that are awaiting approval.
+	PendingRedemptions(context.Context, *QueryPendingRedemptionsRequest) (*QueryPendingRedemptionsResponse, error)
+	// TransferLimit returns the transfer limit that applies to an account along with how much of it is used and left.
+	TransferLimit(context.Context, *QueryTransferLimitRequest) (*QueryTransferLimitResponse, error)
+	// TransferLimits returns a marker's default transfer limit and the limits of specific accounts.
+	TransferLimits(context.Context, *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error)
+	// FilteredMarkers returns the markers that match all of the provided filters.
+	FilteredMarkers(context.Context, *QueryFilteredMarkersRequest) (*QueryFilteredMarkersResponse, error)
+	// SendDenyList returns the entries of a marker's deny send list along with why and by whom they were added.
+	SendDenyList(context.Context, *QuerySendDenyListRequest) (*QuerySendDenyListResponse, error)
+	// Recovery returns a proposed recovery of a marker's denom.
+	Recovery(context.Context, *QueryRecoveryRequest) (*QueryRecoveryResponse, error)
+	// Recoveries returns the proposed recoveries of a marker's denom.
+	Recoveries(context.Context, *QueryRecoveriesRequest) (*QueryRecoveriesResponse, error)
+	// CapTable returns a snapshot of every account holding a marker's denom, including held and quarantined funds.
+	// Query at a specific height to get the cap table as of that height.
+	CapTable(context.Context, *QueryCapTableRequest) (*QueryCapTableResponse, error)
+	// HolderLimit returns a marker's holder limit and the number of accounts currently holding its denom.
+	HolderLimit(context.Context, *QueryHolderLimitRequest) (*QueryHolderLimitResponse, error)
+	// IbcChannelPolicy returns a marker's IBC channel policy and how much of its denom is escrowed for each capped channel.
+	IbcChannelPolicy(context.Context, *QueryIbcChannelPolicyRequest) (*QueryIbcChannelPolicyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllMarkers(ctx context.Context, req *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMarkers not implemented")
+}
+func (*UnimplementedQueryServer) Marker(ctx context.Context, req *QueryMarkerRequest) (*QueryMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Marker not implemented")
+}
+func (*UnimplementedQueryServer) Holding(ctx context.Context, req *QueryHoldingRequest) (*QueryHoldingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holding not implemented")
+}
+func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessRequest) (*QueryAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Access not implemented")
+}
+func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
+}
+func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+}
+func (*UnimplementedQueryServer) NetAssetValues(ctx context.Context, req *QueryNetAssetValuesRequest) (*QueryNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetAssetValues not implemented")
+}
+func (*UnimplementedQueryServer) Distribution(ctx context.Context, req *QueryDistributionRequest) (*QueryDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (*UnimplementedQueryServer) Distributions(ctx context.Context, req *QueryDistributionsRequest) (*QueryDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distributions not implemented")
+}
+func (*UnimplementedQueryServer) SupplyAllowanceUsages(ctx context.Context, req *QuerySupplyAllowanceUsagesRequest) (*QuerySupplyAllowanceUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyAllowanceUsages not implemented")
+}
+func (*UnimplementedQueryServer) Lockup(ctx context.Context, req *QueryLockupRequest) (*QueryLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lockup not implemented")
+}
+func (*UnimplementedQueryServer) Lockups(ctx context.Context, req *QueryLockupsRequest) (*QueryLockupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lockups not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionConfig(ctx context.Context, req *QueryRedemptionConfigRequest) (*QueryRedemptionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionConfig not implemented")
+}
+func (*UnimplementedQueryServer) PendingRedemptions(ctx context.Context, req *QueryPendingRedemptionsRequest) (*QueryPendingRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRedemptions not implemented")
+}
+func (*UnimplementedQueryServer) TransferLimit(ctx context.Context, req *QueryTransferLimitRequest) (*QueryTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLimit not implemented")
+}
+func (*UnimplementedQueryServer) TransferLimits(ctx context.Context, req *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLimits not implemented")
+}
+func (*UnimplementedQueryServer) FilteredMarkers(ctx context.Context, req *QueryFilteredMarkersRequest) (*QueryFilteredMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilteredMarkers not implemented")
+}
+func (*UnimplementedQueryServer) SendDenyList(ctx context.Context, req *QuerySendDenyListRequest) (*QuerySendDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDenyList not implemented")
+}
+func (*UnimplementedQueryServer) Recovery(ctx context.Context, req *QueryRecoveryRequest) (*QueryRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recovery not implemented")
+}
+func (*UnimplementedQueryServer) Recoveries(ctx context.Context, req *QueryRecoveriesRequest) (*QueryRecoveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recoveries not implemented")
+}
+func (*UnimplementedQueryServer) CapTable(ctx context.Context, req *QueryCapTableRequest) (*QueryCapTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapTable not implemented")
+}
+func (*UnimplementedQueryServer) HolderLimit(ctx context.Context, req *QueryHolderLimitRequest) (*QueryHolderLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HolderLimit not implemented")
+}
+func (*UnimplementedQueryServer) IbcChannelPolicy(ctx context.Context, req *QueryIbcChannelPolicyRequest) (*QueryIbcChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcChannelPolicy not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMarkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AllMarkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMarkers(ctx, req.(*QueryAllMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Marker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Marker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Marker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Marker(ctx, req.(*QueryMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Holding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holding(ctx, req.(*QueryHoldingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Escrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Access_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Access(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Access",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Access(ctx, req.(*QueryAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/DenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMetadata(ctx, req.(*QueryDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountData(ctx, req.(*QueryAccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/NetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetAssetValues(ctx, req.(*QueryNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Distribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distribution(ctx, req.(*QueryDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Distributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distributions(ctx, req.(*QueryDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyAllowanceUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyAllowanceUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyAllowanceUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/SupplyAllowanceUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyAllowanceUsages(ctx, req.(*QuerySupplyAllowanceUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Lockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lockup(ctx, req.(*QueryLockupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lockups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lockups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Lockups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lockups(ctx, req.(*QueryLockupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/RedemptionConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionConfig(ctx, req.(*QueryRedemptionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/PendingRedemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRedemptions(ctx, req.(*QueryPendingRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/TransferLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferLimit(ctx, req.(*QueryTransferLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/TransferLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferLimits(ctx, req.(*QueryTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilteredMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilteredMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilteredMarkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/FilteredMarkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilteredMarkers(ctx, req.(*QueryFilteredMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendDenyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendDenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/SendDenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendDenyList(ctx, req.(*QuerySendDenyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Recovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recovery(ctx, req.(*QueryRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recoveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recoveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Recoveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recoveries(ctx, req.(*QueryRecoveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CapTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CapTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/CapTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapTable(ctx, req.(*QueryCapTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HolderLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HolderLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/HolderLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HolderLimit(ctx, req.(*QueryHolderLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IbcChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IbcChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/IbcChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IbcChannelPolicy(ctx, req.(*QueryIbcChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllMarkers",
+			Handler:    _Query_AllMarkers_Handler,
+		},
+		{
+			MethodName: "Marker",
+			Handler:    _Query_Marker_Handler,
+		},
+		{
+			MethodName: "Holding",
+			Handler:    _Query_Holding_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Access",
+			Handler:    _Query_Access_Handler,
+		},
+		{
+			MethodName: "DenomMetadata",
+			Handler:    _Query_DenomMetadata_Handler,
+		},
+		{
+			MethodName: "AccountData",
+			Handler:    _Query_AccountData_Handler,
+		},
+		{
+			MethodName: "NetAssetValues",
+			Handler:    _Query_NetAssetValues_Handler,
+		},
+		{
+			MethodName: "Distribution",
+			Handler:    _Query_Distribution_Handler,
+		},
+		{
+			MethodName: "Distributions",
+			Handler:    _Query_Distributions_Handler,
+		},
+		{
+			MethodName: "SupplyAllowanceUsages",
+			Handler:    _Query_SupplyAllowanceUsages_Handler,
+		},
+		{
+			MethodName: "Lockup",
+			Handler:    _Query_Lockup_Handler,
+		},
+		{
+			MethodName: "Lockups",
+			Handler:    _Query_Lockups_Handler,
+		},
+		{
+			MethodName: "RedemptionConfig",
+			Handler:    _Query_RedemptionConfig_Handler,
+		},
+		{
+			MethodName: "PendingRedemptions",
+			Handler:    _Query_PendingRedemptions_Handler,
+		},
+		{
+			MethodName: "TransferLimit",
+			Handler:    _Query_TransferLimit_Handler,
+		},
+		{
+			MethodName: "TransferLimits",
+			Handler:    _Query_TransferLimits_Handler,
+		},
+		{
+			MethodName: "FilteredMarkers",
+			Handler:    _Query_FilteredMarkers_Handler,
+		},
+		{
+			MethodName: "SendDenyList",
+			Handler:    _Query_SendDenyList_Handler,
+		},
+		{
+			MethodName: "Recovery",
+			Handler:    _Query_Recovery_Handler,
+		},
+		{
+			MethodName: "Recoveries",
+			Handler:    _Query_Recoveries_Handler,
+		},
+		{
+			MethodName: "CapTable",
+			Handler:    _Query_CapTable_Handler,
+		},
+		{
+			MethodName: "HolderLimit",
+			Handler:    _Query_HolderLimit_Handler,
+		},
+		{
+			MethodName: "IbcChannelPolicy",
+			Handler:    _Query_IbcChannelPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markers) > 0 {
+		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Marker != nil {
+		{
+			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyAllowanceUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyAllowanceUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyAllowanceUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyAllowanceUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyAllowanceUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyAllowanceUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Lockup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRedemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRedemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRedemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRedemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeeklyRemaining != nil {
+		{
+			size, err := m.WeeklyRemaining.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.WeeklyUsed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DailyRemaining != nil {
+		{
+			size, err := m.DailyRemaining.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.DailyUsed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultLimit != nil {
+		{
+			size, err := m.DefaultLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilteredMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilteredMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilteredMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequiredAttribute) > 0 {
+		i -= len(m.RequiredAttribute)
+		copy(dAtA[i:], m.RequiredAttribute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequiredAttribute)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AllowForcedTransfer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AllowForcedTransfer))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SupplyFixed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SupplyFixed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Access != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Access))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GrantAddress) > 0 {
+		i -= len(m.GrantAddress)
+		copy(dAtA[i:], m.GrantAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GrantAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFilteredMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilteredMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilteredMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markers) > 0 {
+		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executable {
+		i--
+		if m.Executable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recoveries) > 0 {
+		for iNdEx := len(m.Recoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CapTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CapTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapTableEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapTableEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapTableEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Quarantined.Size()
+		i -= size
+		if _, err := m.Quarantined.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Held.Size()
+		i -= size
+		if _, err := m.Held.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HolderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcChannelPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcChannelPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcChannelPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcChannelEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcChannelEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcChannelEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovQuery(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *QueryDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyAllowanceUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyAllowanceUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Grant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lockup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLockupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingRedemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRedemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DailyUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DailyRemaining != nil {
+		l = m.DailyRemaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.WeeklyUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WeeklyRemaining != nil {
+		l = m.WeeklyRemaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultLimit != nil {
+		l = m.DefaultLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilteredMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrantAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Access != 0 {
+		n += 1 + sovQuery(uint64(m.Access))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovQuery(uint64(m.MarkerType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.SupplyFixed != 0 {
+		n += 1 + sovQuery(uint64(m.SupplyFixed))
+	}
+	if m.AllowForcedTransfer != 0 {
+		n += 1 + sovQuery(uint64(m.AllowForcedTransfer))
+	}
+	l = len(m.RequiredAttribute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFilteredMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecoveryId != 0 {
+		n += 1 + sovQuery(uint64(m.RecoveryId))
+	}
+	return n
+}
+
+func (m *QueryRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recovery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Executable {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRecoveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recoveries) > 0 {
+		for _, e := range m.Recoveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CapTable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CapTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CapTableEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Held.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Quarantined.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Percentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHolderLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HolderCount != 0 {
+		n += 1 + sovQuery(uint64(m.HolderCount))
+	}
+	return n
+}
+
+func (m *QueryIbcChannelPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIbcChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IbcChannelEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, &types.Any{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Marker == nil {
+				m.Marker = &types.Any{}
+			}
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types1.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccessGrant{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &types3.Struct{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAssetValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetAssetValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyAllowanceUsagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyAllowanceUsagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyAllowanceUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyAllowanceUsagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyAllowanceUsagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyAllowanceUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, SupplyAllowanceUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lockup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lockups = append(m.Lockups, Lockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRedemptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRedemptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRedemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRedemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRedemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, PendingRedemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DailyRemaining == nil {
+				m.DailyRemaining = &types1.Coin{}
+			}
+			if err := m.DailyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeeklyUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeeklyRemaining == nil {
+				m.WeeklyRemaining = &types1.Coin{}
+			}
+			if err := m.WeeklyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultLimit == nil {
+				m.DefaultLimit = &TransferLimit{}
+			}
+			if err := m.DefaultLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, TransferLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFilteredMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilteredMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilteredMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			m.Access = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Access |= Access(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			m.SupplyFixed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyFixed |= FlagFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			m.AllowForcedTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowForcedTransfer |= FlagFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RequiredAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2638,7 +11828,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFilteredMarkersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2661,15 +11851,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFilteredMarkersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFilteredMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2696,7 +11886,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markers = append(m.Markers, &types.Any{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2721,7 +11948,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySendDenyListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2744,17 +11971,17 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendDenyListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2764,11 +11991,24 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -2826,7 +12066,7 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySendDenyListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2849,15 +12089,15 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendDenyListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2884,8 +12124,8 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, &types.Any{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, DenySendAddress{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2946,7 +12186,7 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2969,10 +12209,10 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3007,6 +12247,25 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
+			}
+			m.RecoveryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3028,7 +12287,7 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3051,15 +12310,15 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoveryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3086,13 +12345,30 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Marker == nil {
-				m.Marker = &types.Any{}
-			}
-			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3114,7 +12390,7 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoveriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3127,25 +12403,57 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3173,9 +12481,9 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3232,7 +12540,7 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecoveriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3255,15 +12563,15 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecoveriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecoveriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3290,8 +12598,8 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recoveries = append(m.Recoveries, Recovery{})
+			if err := m.Recoveries[len(m.Recoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3352,7 +12660,7 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,10 +12683,10 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3434,7 +12742,7 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,15 +12765,15 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3492,7 +12800,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CapTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3517,7 +12825,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+func (m *CapTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3540,15 +12848,15 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3576,61 +12884,64 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3657,8 +12968,8 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Escrow = append(m.Escrow, types1.Coin{})
-			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, CapTableEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3683,7 +12994,7 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *CapTableEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3706,15 +13017,149 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapTableEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapTableEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Held.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quarantined.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3742,63 +13187,15 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3808,23 +13205,23 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, AccessGrant{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3849,7 +13246,7 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3872,15 +13269,15 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3908,7 +13305,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3931,7 +13328,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3954,15 +13351,15 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3989,65 +13386,15 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
 			}
-			var stringLen uint64
+			m.HolderCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4057,24 +13404,11 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HolderCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4096,7 +13430,7 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcChannelPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4119,15 +13453,15 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcChannelPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcChannelPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4155,7 +13489,7 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4178,7 +13512,7 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcChannelPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4201,17 +13535,17 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcChannelPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4221,27 +13555,28 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4268,8 +13603,8 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrows = append(m.Escrows, IbcChannelEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4294,7 +13629,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *IbcChannelEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4317,15 +13652,15 @@ func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcChannelEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcChannelEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4353,63 +13688,13 @@ func (m *QueryNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetAssetValuesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4419,23 +13704,23 @@ func (m *QueryNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
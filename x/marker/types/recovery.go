@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRecoveryReasonLength is the maximum length of the reason given for a recovery.
+const MaxRecoveryReasonLength = 256
+
+// Validate returns an error if the recovery is not well formed.
+func (r Recovery) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("recovery id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if r.FromAddress == r.ToAddress {
+		return fmt.Errorf("recovery from and to addresses cannot be the same: %s", r.FromAddress)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("recovery amount must be positive")
+	}
+	if len(r.Reason) > MaxRecoveryReasonLength {
+		return fmt.Errorf("recovery reason length %d exceeds maximum length of %d", len(r.Reason), MaxRecoveryReasonLength)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer: %w", err)
+	}
+	if r.ChallengeEnd.Before(r.ProposedAt) {
+		return fmt.Errorf("recovery challenge end %s cannot be before it was proposed %s",
+			r.ChallengeEnd.UTC().Format(time.RFC3339), r.ProposedAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// GetFromAddress returns the address of the account the funds are taken from.
+func (r Recovery) GetFromAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.FromAddress)
+}
+
+// GetToAddress returns the address of the account the funds are given to.
+func (r Recovery) GetToAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.ToAddress)
+}
+
+// CanExecute returns true if the recovery's challenge period has ended at the provided time.
+func (r Recovery) CanExecute(blockTime time.Time) bool {
+	return !blockTime.Before(r.ChallengeEnd)
+}
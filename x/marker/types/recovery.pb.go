@@ -0,0 +1,680 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/recovery.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Recovery is a proposed forced transfer of funds out of an account, e.g. one whose keys were lost.
+// It can only be executed after its challenge period ends, and until then, the owner of the funds can cancel it.
+type Recovery struct {
+	// id is the unique identifier of this recovery.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker denom being recovered.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_address is the bech32 address of the account the funds are taken from.
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the bech32 address of the account the funds are given to.
+	ToAddress string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the number of units being recovered.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// reason is why the funds are being recovered.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// proposer is the bech32 address of the account that proposed the recovery.
+	Proposer string `protobuf:"bytes,7,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proposed_at is the block time at which the recovery was proposed.
+	ProposedAt time.Time `protobuf:"bytes,8,opt,name=proposed_at,json=proposedAt,proto3,stdtime" json:"proposed_at"`
+	// challenge_end is the time at which the challenge period ends and the recovery can be executed.
+	ChallengeEnd time.Time `protobuf:"bytes,9,opt,name=challenge_end,json=challengeEnd,proto3,stdtime" json:"challenge_end"`
+}
+
+func (m *Recovery) Reset()         { *m = Recovery{} }
+func (m *Recovery) String() string { return proto.CompactTextString(m) }
+func (*Recovery) ProtoMessage()    {}
+func (*Recovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a4eef77c06e19e, []int{0}
+}
+func (m *Recovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recovery.Merge(m, src)
+}
+func (m *Recovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recovery proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Recovery)(nil), "provenance.marker.v1.Recovery")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/recovery.proto", fileDescriptor_56a4eef77c06e19e)
+}
+
+var fileDescriptor_56a4eef77c06e19e = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0xcf, 0x21, 0x0d, 0x89, 0x53, 0x18, 0xac, 0x00, 0xd6, 0x49, 0xbd, 0x0b, 0xb0, 0x64,
+	0xc1, 0x56, 0x8b, 0x58, 0xd8, 0x1a, 0xa9, 0x43, 0x37, 0x74, 0x62, 0x62, 0x89, 0x9c, 0xb3, 0x7b,
+	0x39, 0x35, 0xf6, 0x7b, 0xb2, 0x9d, 0x13, 0xfd, 0x06, 0x8c, 0xfd, 0x08, 0x7c, 0x9c, 0x8e, 0x1d,
+	0x11, 0x43, 0x41, 0xc9, 0x67, 0x60, 0x47, 0xf7, 0x2f, 0x61, 0x60, 0xe9, 0xf6, 0x3e, 0xef, 0xf3,
+	0x7b, 0x5f, 0xbd, 0x7a, 0x6c, 0xfc, 0xb6, 0xb0, 0x50, 0x2a, 0x23, 0x4c, 0xaa, 0xb8, 0x16, 0xf6,
+	0x5a, 0x59, 0x5e, 0x9e, 0x72, 0xab, 0x52, 0x28, 0x95, 0xbd, 0x61, 0x85, 0x05, 0x0f, 0x64, 0x72,
+	0x80, 0x58, 0x03, 0xb1, 0xf2, 0x34, 0x9c, 0x64, 0x90, 0x41, 0x0d, 0xf0, 0xaa, 0x6a, 0xd8, 0x30,
+	0xce, 0x00, 0xb2, 0xb5, 0xe2, 0xb5, 0x5a, 0x6e, 0xae, 0xb8, 0xcf, 0xb5, 0x72, 0x5e, 0xe8, 0xa2,
+	0x01, 0xde, 0xfc, 0xe9, 0xe1, 0x61, 0xd2, 0xee, 0x27, 0xcf, 0x71, 0x2f, 0x97, 0x14, 0x4d, 0xd1,
+	0xac, 0x9f, 0xf4, 0x72, 0x49, 0x26, 0xf8, 0x48, 0x2a, 0x03, 0x9a, 0xf6, 0xa6, 0x68, 0x36, 0x4a,
+	0x1a, 0x41, 0x5e, 0xe3, 0xe3, 0x2b, 0x0b, 0x7a, 0x21, 0xa4, 0xb4, 0xca, 0x39, 0xfa, 0xa4, 0x36,
+	0xc7, 0x55, 0xef, 0xbc, 0x69, 0x91, 0x13, 0x8c, 0x3d, 0xec, 0x81, 0x7e, 0x0d, 0x8c, 0x3c, 0x74,
+	0xf6, 0x07, 0x3c, 0x10, 0x1a, 0x36, 0xc6, 0xd3, 0xa3, 0xca, 0x9a, 0x9f, 0xdc, 0x3d, 0xc4, 0xc1,
+	0xcf, 0x87, 0xf8, 0x45, 0x0a, 0x4e, 0x83, 0x73, 0xf2, 0x9a, 0xe5, 0xc0, 0xb5, 0xf0, 0x2b, 0x76,
+	0x69, 0x7c, 0xd2, 0xc2, 0xe4, 0x25, 0x1e, 0x58, 0x25, 0x1c, 0x18, 0x3a, 0xa8, 0x37, 0xb6, 0x8a,
+	0x84, 0x78, 0x58, 0x58, 0x28, 0xc0, 0x29, 0x4b, 0x9f, 0xd6, 0xce, 0x5e, 0x93, 0x0b, 0x3c, 0x6e,
+	0x6b, 0xb9, 0x10, 0x9e, 0x0e, 0xa7, 0x68, 0x36, 0x3e, 0x0b, 0x59, 0x13, 0x0b, 0xeb, 0x62, 0x61,
+	0x9f, 0xbb, 0x58, 0xe6, 0xc3, 0xea, 0x96, 0xdb, 0x5f, 0x31, 0x4a, 0x70, 0x37, 0x78, 0xee, 0xc9,
+	0x25, 0x7e, 0x96, 0xae, 0xc4, 0x7a, 0xad, 0x4c, 0xa6, 0x16, 0xca, 0x48, 0x3a, 0x7a, 0xc4, 0xa2,
+	0xe3, 0xfd, 0xe8, 0x85, 0x91, 0x1f, 0xfb, 0xdf, 0xbe, 0xc7, 0xc1, 0x3c, 0xbb, 0xdb, 0x46, 0xe8,
+	0x7e, 0x1b, 0xa1, 0xdf, 0xdb, 0x08, 0xdd, 0xee, 0xa2, 0xe0, 0x7e, 0x17, 0x05, 0x3f, 0x76, 0x51,
+	0x80, 0x5f, 0xe5, 0xc0, 0xfe, 0xf7, 0xc2, 0x9f, 0xd0, 0x97, 0xb3, 0x2c, 0xf7, 0xab, 0xcd, 0x92,
+	0xa5, 0xa0, 0xf9, 0x01, 0x79, 0x97, 0xc3, 0x3f, 0x8a, 0x7f, 0xed, 0x7e, 0x8e, 0xbf, 0x29, 0x94,
+	0x5b, 0x0e, 0xea, 0xd3, 0xde, 0xff, 0x1d, 0x00, 0xe2, 0x9b, 0xbc, 0xd1, 0x5b, 0x02, 0x00, 0x00,
+}
+
+func (m *Recovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ChallengeEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChallengeEnd):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRecovery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProposedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRecovery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecovery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Recovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRecovery(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRecovery(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposedAt)
+	n += 1 + l + sovRecovery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChallengeEnd)
+	n += 1 + l + sovRecovery(uint64(l))
+	return n
+}
+
+func sovRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecovery(x uint64) (n int) {
+	return sovRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Recovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProposedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ChallengeEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecovery = fmt.Errorf("proto: unexpected end of group")
+)
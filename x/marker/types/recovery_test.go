@@ -0,0 +1,87 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRecoveryValidate(t *testing.T) {
+	proposedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	from := sdk.AccAddress("lostkeys____________").String()
+	to := sdk.AccAddress("newkeys_____________").String()
+	admin := sdk.AccAddress("admin_______________").String()
+	recovery := func(modify func(r *Recovery)) Recovery {
+		rv := Recovery{
+			Id:           1,
+			Denom:        "fundshare",
+			FromAddress:  from,
+			ToAddress:    to,
+			Amount:       sdkmath.NewInt(400),
+			Reason:       "lost keys",
+			Proposer:     admin,
+			ProposedAt:   proposedAt,
+			ChallengeEnd: proposedAt.Add(DefaultRecoveryChallengePeriod),
+		}
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		recovery Recovery
+		expErr   string
+	}{
+		{name: "valid", recovery: recovery(nil)},
+		{name: "zero id", recovery: recovery(func(r *Recovery) { r.Id = 0 }), expErr: "recovery id cannot be zero"},
+		{name: "invalid denom", recovery: recovery(func(r *Recovery) { r.Denom = "x" }), expErr: "invalid denom: invalid denom: x"},
+		{
+			name:     "invalid from address",
+			recovery: recovery(func(r *Recovery) { r.FromAddress = "invalid" }),
+			expErr:   "invalid from address: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:     "same from and to",
+			recovery: recovery(func(r *Recovery) { r.ToAddress = from }),
+			expErr:   "recovery from and to addresses cannot be the same: " + from,
+		},
+		{name: "zero amount", recovery: recovery(func(r *Recovery) { r.Amount = sdkmath.ZeroInt() }), expErr: "recovery amount must be positive"},
+		{
+			name:     "reason too long",
+			recovery: recovery(func(r *Recovery) { r.Reason = strings.Repeat("r", MaxRecoveryReasonLength+1) }),
+			expErr:   "recovery reason length 257 exceeds maximum length of 256",
+		},
+		{
+			name:     "challenge ends before proposal",
+			recovery: recovery(func(r *Recovery) { r.ChallengeEnd = proposedAt.Add(-time.Hour) }),
+			expErr:   "recovery challenge end 2024-12-31T23:00:00Z cannot be before it was proposed 2025-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recovery.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestRecoveryCanExecute(t *testing.T) {
+	end := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	recovery := Recovery{ChallengeEnd: end}
+	require.False(t, recovery.CanExecute(end.Add(-time.Nanosecond)), "before the challenge end")
+	require.True(t, recovery.CanExecute(end), "at the challenge end")
+	require.True(t, recovery.CanExecute(end.Add(time.Hour)), "after the challenge end")
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRedemptionWindow creates a new RedemptionWindow.
+func NewRedemptionWindow(start, end time.Time) RedemptionWindow {
+	return RedemptionWindow{
+		Start: start,
+		End:   end,
+	}
+}
+
+// Validate returns an error if the redemption configuration is not well formed.
+func (c RedemptionConfig) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(c.RedeemDenom); err != nil {
+		return fmt.Errorf("invalid redeem denom: %w", err)
+	}
+	if c.RedeemDenom == c.Denom {
+		return fmt.Errorf("redeem denom cannot be the marker denom %q", c.Denom)
+	}
+	if !c.UseNetAssetValue {
+		if c.Units.IsNil() || !c.Units.IsPositive() {
+			return fmt.Errorf("redemption units must be positive")
+		}
+		if c.Amount.IsNil() || !c.Amount.IsPositive() {
+			return fmt.Errorf("redemption amount must be positive")
+		}
+	}
+	for _, window := range c.Windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if redemptions can be requested at the provided time.
+func (c RedemptionConfig) IsOpen(blockTime time.Time) bool {
+	if len(c.Windows) == 0 {
+		return true
+	}
+	for _, window := range c.Windows {
+		if window.Contains(blockTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the window does not end after it starts.
+func (w RedemptionWindow) Validate() error {
+	if w.Start.IsZero() || w.End.IsZero() {
+		return fmt.Errorf("redemption window start and end are required")
+	}
+	if !w.End.After(w.Start) {
+		return fmt.Errorf("redemption window end %s must be after its start %s",
+			w.End.UTC().Format(time.RFC3339), w.Start.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Contains returns true if the provided time is at or after the window's start and before its end.
+func (w RedemptionWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// RedemptionPayout returns the amount paid for the provided units given a price of amount per units, rounded down.
+func RedemptionPayout(redeemed, amount, units sdkmath.Int) sdkmath.Int {
+	return redeemed.Mul(amount).Quo(units)
+}
+
+// Validate returns an error if the pending redemption is not well formed.
+func (r PendingRedemption) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("redemption id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Redeemer); err != nil {
+		return fmt.Errorf("invalid redeemer: %w", err)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("redemption amount must be positive")
+	}
+	return nil
+}
+
+// GetRedeemer returns the address of the account redeeming the units.
+func (r PendingRedemption) GetRedeemer() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.Redeemer)
+}
@@ -0,0 +1,1178 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/redemption.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RedemptionConfig allows holders of a marker's denom to redeem it for funds held in the marker's escrow.
+type RedemptionConfig struct {
+	// denom is the marker denom that can be redeemed.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// redeem_denom is the denom paid out of the marker's escrow for redeemed units.
+	RedeemDenom string `protobuf:"bytes,2,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"`
+	// units is the number of units of the marker's denom that are redeemed for amount.
+	// Not used when use_net_asset_value is true.
+	Units cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=units,proto3,customtype=cosmossdk.io/math.Int" json:"units"`
+	// amount is the amount of redeem_denom paid for each units of the marker's denom.
+	// Not used when use_net_asset_value is true.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// use_net_asset_value indicates that the price comes from the marker's net asset value in redeem_denom.
+	UseNetAssetValue bool `protobuf:"varint,5,opt,name=use_net_asset_value,json=useNetAssetValue,proto3" json:"use_net_asset_value,omitempty"`
+	// windows are the periods during which redemptions can be requested. If empty, redemptions are always allowed.
+	Windows []RedemptionWindow `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows"`
+	// requires_approval indicates that redemptions are queued until approved or rejected by the marker's withdraw admin.
+	RequiresApproval bool `protobuf:"varint,7,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+}
+
+func (m *RedemptionConfig) Reset()         { *m = RedemptionConfig{} }
+func (m *RedemptionConfig) String() string { return proto.CompactTextString(m) }
+func (*RedemptionConfig) ProtoMessage()    {}
+func (*RedemptionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5d96cd094c7aa0d, []int{0}
+}
+func (m *RedemptionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionConfig.Merge(m, src)
+}
+func (m *RedemptionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionConfig proto.InternalMessageInfo
+
+// RedemptionWindow is a period during which redemptions can be requested.
+type RedemptionWindow struct {
+	// start is the time at which the window opens.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the time at which the window closes.
+	End time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+}
+
+func (m *RedemptionWindow) Reset()         { *m = RedemptionWindow{} }
+func (m *RedemptionWindow) String() string { return proto.CompactTextString(m) }
+func (*RedemptionWindow) ProtoMessage()    {}
+func (*RedemptionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5d96cd094c7aa0d, []int{1}
+}
+func (m *RedemptionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionWindow.Merge(m, src)
+}
+func (m *RedemptionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionWindow proto.InternalMessageInfo
+
+// PendingRedemption is a redemption awaiting approval. The units being redeemed are held by the marker module.
+type PendingRedemption struct {
+	// id is the unique identifier of this redemption.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the marker denom being redeemed.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// redeemer is the bech32 address of the account redeeming the units.
+	Redeemer string `protobuf:"bytes,3,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	// amount is the number of units being redeemed.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// request_time is the block time at which the redemption was requested.
+	RequestTime time.Time `protobuf:"bytes,5,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
+}
+
+func (m *PendingRedemption) Reset()         { *m = PendingRedemption{} }
+func (m *PendingRedemption) String() string { return proto.CompactTextString(m) }
+func (*PendingRedemption) ProtoMessage()    {}
+func (*PendingRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5d96cd094c7aa0d, []int{2}
+}
+func (m *PendingRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRedemption.Merge(m, src)
+}
+func (m *PendingRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRedemption proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RedemptionConfig)(nil), "provenance.marker.v1.RedemptionConfig")
+	proto.RegisterType((*RedemptionWindow)(nil), "provenance.marker.v1.RedemptionWindow")
+	proto.RegisterType((*PendingRedemption)(nil), "provenance.marker.v1.PendingRedemption")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/redemption.proto", fileDescriptor_f5d96cd094c7aa0d)
+}
+
+var fileDescriptor_f5d96cd094c7aa0d = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x38, 0x3f, 0xcd, 0x37, 0xf9, 0x84, 0x52, 0x13, 0x84, 0x15, 0x09, 0x3b, 0x44, 0x02,
+	0x45, 0x42, 0x1d, 0xab, 0xa9, 0x60, 0x91, 0x5d, 0x03, 0x02, 0xb1, 0x41, 0x95, 0x85, 0x40, 0x62,
+	0x63, 0x39, 0xf1, 0xad, 0x3b, 0x6a, 0x66, 0xc6, 0xf5, 0x8c, 0x53, 0x78, 0x03, 0x96, 0x79, 0x84,
+	0x3e, 0x4e, 0x97, 0x5d, 0x22, 0x90, 0x0a, 0x24, 0x1b, 0x1e, 0x03, 0x8d, 0x27, 0xa9, 0x2b, 0xd4,
+	0x05, 0x65, 0xe7, 0x7b, 0xcf, 0x39, 0x33, 0xc7, 0xf7, 0xdc, 0xc1, 0x8f, 0xd2, 0x4c, 0xcc, 0x81,
+	0x47, 0x7c, 0x0a, 0x3e, 0x8b, 0xb2, 0x63, 0xc8, 0xfc, 0xf9, 0xae, 0x9f, 0x41, 0x0c, 0x2c, 0x55,
+	0x54, 0x70, 0x92, 0x66, 0x42, 0x09, 0xbb, 0x53, 0xd2, 0x88, 0xa1, 0x91, 0xf9, 0x6e, 0xb7, 0x93,
+	0x88, 0x44, 0x14, 0x04, 0x5f, 0x7f, 0x19, 0x6e, 0xd7, 0x4b, 0x84, 0x48, 0x66, 0xe0, 0x17, 0xd5,
+	0x24, 0x3f, 0xf4, 0x15, 0x65, 0x20, 0x55, 0xc4, 0x52, 0x43, 0xe8, 0xff, 0xb4, 0x70, 0x3b, 0xb8,
+	0xba, 0xe1, 0xb9, 0xe0, 0x87, 0x34, 0xb1, 0x3b, 0xb8, 0x1e, 0x03, 0x17, 0xcc, 0x41, 0x3d, 0x34,
+	0xf8, 0x2f, 0x30, 0x85, 0xfd, 0x10, 0xff, 0xaf, 0xbd, 0x00, 0x0b, 0x0d, 0x68, 0x15, 0x60, 0xcb,
+	0xf4, 0x5e, 0x14, 0x94, 0x3d, 0x5c, 0xcf, 0x39, 0x55, 0xd2, 0xa9, 0x6a, 0x6c, 0xfc, 0xe0, 0xfc,
+	0xd2, 0xab, 0x7c, 0xbd, 0xf4, 0xee, 0x4d, 0x85, 0x64, 0x42, 0xca, 0xf8, 0x98, 0x50, 0xe1, 0xb3,
+	0x48, 0x1d, 0x91, 0xd7, 0x5c, 0x05, 0x86, 0x6b, 0x3f, 0xc5, 0x8d, 0x88, 0x89, 0x9c, 0x2b, 0xa7,
+	0xf6, 0x37, 0xaa, 0x35, 0xd9, 0xde, 0xc1, 0x77, 0x73, 0x09, 0x21, 0x07, 0x15, 0x46, 0x52, 0x82,
+	0x0a, 0xe7, 0xd1, 0x2c, 0x07, 0xa7, 0xde, 0x43, 0x83, 0x66, 0xd0, 0xce, 0x25, 0xbc, 0x01, 0xb5,
+	0xaf, 0x81, 0x77, 0xba, 0x6f, 0xbf, 0xc4, 0x5b, 0xa7, 0x94, 0xc7, 0xe2, 0x54, 0x3a, 0x8d, 0x5e,
+	0x75, 0xd0, 0x1a, 0x3e, 0x26, 0x37, 0xcd, 0x91, 0x94, 0xc3, 0x78, 0x5f, 0xd0, 0xc7, 0x35, 0x6d,
+	0x27, 0xd8, 0x88, 0xed, 0x27, 0x78, 0x3b, 0x83, 0x93, 0x9c, 0x66, 0x20, 0xc3, 0x28, 0xd5, 0x47,
+	0x44, 0x33, 0x67, 0xcb, 0x5c, 0xba, 0x01, 0xf6, 0xd7, 0xfd, 0x51, 0xed, 0xf3, 0x99, 0x57, 0xe9,
+	0x2f, 0x10, 0x6e, 0xff, 0x79, 0xac, 0x3d, 0xc2, 0x75, 0xa9, 0xa2, 0x4c, 0x15, 0x33, 0x6e, 0x0d,
+	0xbb, 0xc4, 0x24, 0x45, 0x36, 0x49, 0x91, 0xb7, 0x9b, 0xa4, 0xc6, 0x4d, 0xed, 0x60, 0xf1, 0xdd,
+	0x43, 0x81, 0x91, 0xd8, 0xcf, 0x70, 0x15, 0x78, 0xec, 0x58, 0xb7, 0x50, 0x6a, 0xc1, 0xa8, 0xa9,
+	0xed, 0xfc, 0x3a, 0xf3, 0x50, 0xff, 0x1b, 0xc2, 0xdb, 0x07, 0xc0, 0x63, 0xca, 0x93, 0xd2, 0x99,
+	0x7d, 0x07, 0x5b, 0x34, 0x2e, 0x0c, 0xd5, 0x02, 0x8b, 0xc6, 0xe5, 0x1e, 0x58, 0xd7, 0xf7, 0xa0,
+	0x8b, 0x9b, 0x26, 0x73, 0xc8, 0x4c, 0xce, 0xc1, 0x55, 0xfd, 0xaf, 0x59, 0xbe, 0xd2, 0xab, 0x75,
+	0x92, 0x83, 0x54, 0xa1, 0x5e, 0x50, 0xa7, 0x7e, 0x8b, 0x3f, 0x6b, 0xad, 0x95, 0x1a, 0x33, 0x03,
+	0x1f, 0x27, 0xe7, 0x4b, 0x17, 0x5d, 0x2c, 0x5d, 0xf4, 0x63, 0xe9, 0xa2, 0xc5, 0xca, 0xad, 0x5c,
+	0xac, 0xdc, 0xca, 0x97, 0x95, 0x5b, 0xc1, 0xf7, 0xa9, 0xb8, 0x31, 0xf6, 0x03, 0xf4, 0x61, 0x98,
+	0x50, 0x75, 0x94, 0x4f, 0xc8, 0x54, 0x30, 0xbf, 0xa4, 0xec, 0x50, 0x71, 0xad, 0xf2, 0x3f, 0x6e,
+	0x1e, 0xa6, 0xfa, 0x94, 0x82, 0x9c, 0x34, 0x0a, 0x67, 0x7b, 0xbf, 0x07, 0x00, 0xb1, 0x9c, 0x4a,
+	0xc7, 0xba, 0x03, 0x00, 0x00,
+}
+
+func (this *RedemptionWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedemptionWindow)
+	if !ok {
+		that2, ok := that.(RedemptionWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if !this.End.Equal(that1.End) {
+		return false
+	}
+	return true
+}
+func (m *RedemptionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiresApproval {
+		i--
+		if m.RequiresApproval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRedemption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UseNetAssetValue {
+		i--
+		if m.UseNetAssetValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RedeemDenom) > 0 {
+		i -= len(m.RedeemDenom)
+		copy(dAtA[i:], m.RedeemDenom)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.RedeemDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRedemption(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRedemption(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RequestTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRedemption(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RedemptionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.RedeemDenom)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	if m.UseNetAssetValue {
+		n += 2
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovRedemption(uint64(l))
+		}
+	}
+	if m.RequiresApproval {
+		n += 2
+	}
+	return n
+}
+
+func (m *RedemptionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovRedemption(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovRedemption(uint64(l))
+	return n
+}
+
+func (m *PendingRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRedemption(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime)
+	n += 1 + l + sovRedemption(uint64(l))
+	return n
+}
+
+func sovRedemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemption(x uint64) (n int) {
+	return sovRedemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RedemptionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNetAssetValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNetAssetValue = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, RedemptionWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresApproval = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemption = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestRedemptionConfigValidate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	fixed := func(units, amount int64) RedemptionConfig {
+		return RedemptionConfig{Denom: "fundshare", RedeemDenom: "usdcoin", Units: sdkmath.NewInt(units), Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name   string
+		config RedemptionConfig
+		expErr string
+	}{
+		{name: "fixed price", config: fixed(100, 105)},
+		{name: "net asset value", config: RedemptionConfig{Denom: "fundshare", RedeemDenom: "usdcoin", UseNetAssetValue: true}},
+		{
+			name:   "invalid redeem denom",
+			config: RedemptionConfig{Denom: "fundshare", RedeemDenom: "x", UseNetAssetValue: true},
+			expErr: "invalid redeem denom: invalid denom: x",
+		},
+		{
+			name:   "redeem denom is the marker denom",
+			config: RedemptionConfig{Denom: "fundshare", RedeemDenom: "fundshare", UseNetAssetValue: true},
+			expErr: `redeem denom cannot be the marker denom "fundshare"`,
+		},
+		{name: "zero units", config: fixed(0, 105), expErr: "redemption units must be positive"},
+		{name: "zero amount", config: fixed(100, 0), expErr: "redemption amount must be positive"},
+		{
+			name: "window ends before it starts",
+			config: func() RedemptionConfig {
+				c := fixed(1, 1)
+				c.Windows = []RedemptionWindow{NewRedemptionWindow(end, start)}
+				return c
+			}(),
+			expErr: "redemption window end 2025-01-01T00:00:00Z must be after its start 2025-01-02T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestRedemptionConfigIsOpen(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	always := RedemptionConfig{}
+	require.True(t, always.IsOpen(start.Add(-time.Hour)), "config without windows")
+
+	windowed := RedemptionConfig{Windows: []RedemptionWindow{NewRedemptionWindow(start, end)}}
+	require.False(t, windowed.IsOpen(start.Add(-time.Second)), "before the window")
+	require.True(t, windowed.IsOpen(start), "at the window start")
+	require.True(t, windowed.IsOpen(end.Add(-time.Second)), "just before the window end")
+	require.False(t, windowed.IsOpen(end), "at the window end")
+}
+
+func TestRedemptionPayout(t *testing.T) {
+	require.Equal(t, sdkmath.NewInt(105), RedemptionPayout(sdkmath.NewInt(100), sdkmath.NewInt(105), sdkmath.NewInt(100)), "100 at 105 per 100")
+	require.Equal(t, sdkmath.NewInt(3), RedemptionPayout(sdkmath.NewInt(10), sdkmath.NewInt(1), sdkmath.NewInt(3)), "10 at 1 per 3 rounds down")
+}
@@ -0,0 +1,291 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Required attribute entries are either a single attribute name (optionally starting with "*." to match any
+// attribute ending with the rest of the name), or a boolean expression of attribute names that starts with
+// RequiredAttributeExprPrefix. All entries in a marker's required attributes must be satisfied.
+//
+// The expression grammar (after the prefix) is:
+//
+//	expr   = or
+//	or     = and { "OR" and }
+//	and    = unary { "AND" unary }
+//	unary  = "NOT" unary | "(" expr ")" | name
+//
+// Operators are case-insensitive and must be separated from names by whitespace or parentheses.
+// For example: "expr:(kyc.us OR kyc.eu) AND NOT frozen.pb".
+
+// RequiredAttributeExprPrefix is the prefix that marks a required attribute entry as an expression.
+// Attribute names cannot contain a colon, so an entry with this prefix is never a single attribute name.
+const RequiredAttributeExprPrefix = "expr:"
+
+// AttributeExprOp identifies the type of a node in a required attribute expression.
+type AttributeExprOp int
+
+const (
+	// AttributeExprName is a leaf node that is satisfied by a matching attribute name.
+	AttributeExprName AttributeExprOp = iota
+	// AttributeExprAnd is satisfied when all of its operands are satisfied.
+	AttributeExprAnd
+	// AttributeExprOr is satisfied when at least one of its operands is satisfied.
+	AttributeExprOr
+	// AttributeExprNot is satisfied when its single operand is not satisfied.
+	AttributeExprNot
+)
+
+// Keywords used in required attribute expressions.
+const (
+	attrExprKeywordAnd = "AND"
+	attrExprKeywordOr  = "OR"
+	attrExprKeywordNot = "NOT"
+)
+
+// AttributeExpr is a parsed required attribute expression.
+type AttributeExpr struct {
+	// Op is the type of this node.
+	Op AttributeExprOp
+	// Name is the required attribute name, only used when Op is AttributeExprName.
+	Name string
+	// Operands are the sub-expressions of an And, Or, or Not node.
+	Operands []*AttributeExpr
+}
+
+// IsRequiredAttributeExpression returns true if the provided required attribute entry is a boolean expression
+// rather than a single attribute name, i.e. if it starts with RequiredAttributeExprPrefix.
+func IsRequiredAttributeExpression(reqAttr string) bool {
+	return strings.HasPrefix(strings.TrimSpace(reqAttr), RequiredAttributeExprPrefix)
+}
+
+// ParseRequiredAttribute parses a required attribute entry. Entries that are not expressions are returned
+// as a single name node containing the trimmed entry.
+func ParseRequiredAttribute(reqAttr string) (*AttributeExpr, error) {
+	if !IsRequiredAttributeExpression(reqAttr) {
+		name := strings.TrimSpace(reqAttr)
+		if len(name) == 0 {
+			return nil, fmt.Errorf("invalid name: empty")
+		}
+		return &AttributeExpr{Op: AttributeExprName, Name: name}, nil
+	}
+	p := &attrExprParser{tokens: tokenizeAttrExpr(strings.TrimPrefix(strings.TrimSpace(reqAttr), RequiredAttributeExprPrefix))}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid required attribute expression %q: %w", reqAttr, err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid required attribute expression %q: unexpected %q", reqAttr, p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+// Names returns all attribute names used in this expression, in the order they appear.
+func (e *AttributeExpr) Names() []string {
+	if e.Op == AttributeExprName {
+		return []string{e.Name}
+	}
+	var rv []string
+	for _, operand := range e.Operands {
+		rv = append(rv, operand.Names()...)
+	}
+	return rv
+}
+
+// MapNames returns a copy of this expression with each attribute name replaced by the result of the provided function.
+func (e *AttributeExpr) MapNames(mapper func(name string) (string, error)) (*AttributeExpr, error) {
+	if e.Op == AttributeExprName {
+		name, err := mapper(e.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &AttributeExpr{Op: AttributeExprName, Name: name}, nil
+	}
+	rv := &AttributeExpr{Op: e.Op, Operands: make([]*AttributeExpr, len(e.Operands))}
+	for i, operand := range e.Operands {
+		var err error
+		if rv.Operands[i], err = operand.MapNames(mapper); err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// Evaluate returns true if this expression is satisfied, using the provided function to check each attribute name.
+func (e *AttributeExpr) Evaluate(hasAttr func(name string) bool) bool {
+	switch e.Op {
+	case AttributeExprName:
+		return hasAttr(e.Name)
+	case AttributeExprAnd:
+		for _, operand := range e.Operands {
+			if !operand.Evaluate(hasAttr) {
+				return false
+			}
+		}
+		return true
+	case AttributeExprOr:
+		for _, operand := range e.Operands {
+			if operand.Evaluate(hasAttr) {
+				return true
+			}
+		}
+		return false
+	case AttributeExprNot:
+		return !e.Operands[0].Evaluate(hasAttr)
+	default:
+		return false
+	}
+}
+
+// Entry returns this expression as a canonical required attribute entry. A single name is returned as is,
+// and anything else is returned in its String form with the RequiredAttributeExprPrefix.
+func (e *AttributeExpr) Entry() string {
+	if e.Op == AttributeExprName {
+		return e.Name
+	}
+	return RequiredAttributeExprPrefix + e.String()
+}
+
+// String returns the canonical form of this expression (without the RequiredAttributeExprPrefix).
+func (e *AttributeExpr) String() string {
+	switch e.Op {
+	case AttributeExprName:
+		return e.Name
+	case AttributeExprNot:
+		return attrExprKeywordNot + " " + e.Operands[0].stringAsOperand(e.Op)
+	case AttributeExprAnd, AttributeExprOr:
+		keyword := attrExprKeywordAnd
+		if e.Op == AttributeExprOr {
+			keyword = attrExprKeywordOr
+		}
+		parts := make([]string, len(e.Operands))
+		for i, operand := range e.Operands {
+			parts[i] = operand.stringAsOperand(e.Op)
+		}
+		return strings.Join(parts, " "+keyword+" ")
+	default:
+		return ""
+	}
+}
+
+// stringAsOperand returns the string form of this expression, wrapped in parentheses if needed
+// for it to be used as an operand of the provided parent operator.
+func (e *AttributeExpr) stringAsOperand(parent AttributeExprOp) string {
+	if e.Op == AttributeExprName || e.Op == AttributeExprNot || (e.Op == AttributeExprAnd && parent == AttributeExprOr) {
+		return e.String()
+	}
+	return "(" + e.String() + ")"
+}
+
+// isAttrExprKeyword returns true if the provided token is one of the expression operators.
+func isAttrExprKeyword(token string) bool {
+	switch strings.ToUpper(token) {
+	case attrExprKeywordAnd, attrExprKeywordOr, attrExprKeywordNot:
+		return true
+	}
+	return false
+}
+
+// tokenizeAttrExpr splits an expression into parentheses and whitespace separated words.
+func tokenizeAttrExpr(expr string) []string {
+	var tokens []string
+	for _, field := range strings.Fields(expr) {
+		start := 0
+		for i, r := range field {
+			if r == '(' || r == ')' {
+				if i > start {
+					tokens = append(tokens, field[start:i])
+				}
+				tokens = append(tokens, string(r))
+				start = i + 1
+			}
+		}
+		if start < len(field) {
+			tokens = append(tokens, field[start:])
+		}
+	}
+	return tokens
+}
+
+// attrExprParser is a recursive descent parser for required attribute expressions.
+type attrExprParser struct {
+	tokens []string
+	pos    int
+}
+
+// peek returns the next token, or an empty string if there are no more.
+func (p *attrExprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// acceptKeyword consumes the next token if it is the provided keyword.
+func (p *attrExprParser) acceptKeyword(keyword string) bool {
+	if strings.EqualFold(p.peek(), keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *attrExprParser) parseOr() (*AttributeExpr, error) {
+	return p.parseBinary(attrExprKeywordOr, AttributeExprOr, p.parseAnd)
+}
+
+func (p *attrExprParser) parseAnd() (*AttributeExpr, error) {
+	return p.parseBinary(attrExprKeywordAnd, AttributeExprAnd, p.parseUnary)
+}
+
+// parseBinary parses one or more operands separated by the given keyword.
+func (p *attrExprParser) parseBinary(keyword string, op AttributeExprOp, parseOperand func() (*AttributeExpr, error)) (*AttributeExpr, error) {
+	first, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*AttributeExpr{first}
+	for p.acceptKeyword(keyword) {
+		next, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &AttributeExpr{Op: op, Operands: operands}, nil
+}
+
+func (p *attrExprParser) parseUnary() (*AttributeExpr, error) {
+	token := p.peek()
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case strings.EqualFold(token, attrExprKeywordNot):
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &AttributeExpr{Op: AttributeExprNot, Operands: []*AttributeExpr{operand}}, nil
+	case token == "(":
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	case token == ")" || isAttrExprKeyword(token):
+		return nil, fmt.Errorf("unexpected %q", token)
+	default:
+		p.pos++
+		return &AttributeExpr{Op: AttributeExprName, Name: token}, nil
+	}
+}
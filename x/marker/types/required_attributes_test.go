@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRequiredAttributeExpression(t *testing.T) {
+	tests := []struct {
+		reqAttr string
+		exp     bool
+	}{
+		{reqAttr: "kyc.provenance.io", exp: false},
+		{reqAttr: "*.provenance.io", exp: false},
+		{reqAttr: " kyc.provenance.io ", exp: false},
+		{reqAttr: "android.provenance.io", exp: false},
+		{reqAttr: "and.example", exp: false},
+		{reqAttr: "not", exp: false},
+		{reqAttr: "kyc.us OR kyc.eu", exp: false},
+		{reqAttr: "(kyc.us)", exp: false},
+		{reqAttr: "expr:kyc.us OR kyc.eu", exp: true},
+		{reqAttr: " expr:NOT frozen.pb", exp: true},
+		{reqAttr: "expr:(kyc.us)", exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.reqAttr, func(t *testing.T) {
+			assert.Equal(t, tc.exp, IsRequiredAttributeExpression(tc.reqAttr), "IsRequiredAttributeExpression(%q)", tc.reqAttr)
+		})
+	}
+}
+
+func TestParseRequiredAttribute(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		expStr string
+		expErr string
+	}{
+		{name: "single name", expr: " kyc.pb ", expStr: "kyc.pb"},
+		{name: "name starting with a keyword", expr: "and.example", expStr: "and.example"},
+		{name: "name that is a keyword", expr: "not", expStr: "not"},
+		{name: "operators without prefix", expr: "kyc.us OR kyc.eu", expStr: "kyc.us OR kyc.eu"},
+		{name: "empty", expr: "  ", expErr: "invalid name: empty"},
+		{name: "empty expression", expr: "expr:", expErr: `invalid required attribute expression "expr:": unexpected end of expression`},
+		{name: "expression of a single name", expr: "expr:(kyc.us)", expStr: "kyc.us"},
+		{name: "or", expr: "expr:kyc.us OR kyc.eu", expStr: "expr:kyc.us OR kyc.eu"},
+		{name: "lower case operators", expr: "expr:kyc.us or kyc.eu and not frozen.pb", expStr: "expr:kyc.us OR kyc.eu AND NOT frozen.pb"},
+		{name: "and binds tighter than or", expr: "expr:a.pb AND b.pb OR c.pb", expStr: "expr:a.pb AND b.pb OR c.pb"},
+		{name: "parentheses kept when needed", expr: "expr:(kyc.us OR kyc.eu) AND NOT frozen.pb", expStr: "expr:(kyc.us OR kyc.eu) AND NOT frozen.pb"},
+		{name: "redundant parentheses removed", expr: "expr:((kyc.us)) OR (a.pb AND b.pb)", expStr: "expr:kyc.us OR a.pb AND b.pb"},
+		{name: "not of group", expr: "expr:NOT(a.pb OR b.pb)", expStr: "expr:NOT (a.pb OR b.pb)"},
+		{name: "wildcard", expr: "expr:*.kyc.pb OR other.pb", expStr: "expr:*.kyc.pb OR other.pb"},
+		{name: "space after prefix", expr: " expr: and.example AND not.example ", expStr: "expr:and.example AND not.example"},
+		{name: "keyword as name", expr: "expr:and OR kyc.us", expErr: `invalid required attribute expression "expr:and OR kyc.us": unexpected "and"`},
+		{name: "missing operand", expr: "expr:kyc.us OR", expErr: `invalid required attribute expression "expr:kyc.us OR": unexpected end of expression`},
+		{name: "missing operator", expr: "expr:(kyc.us kyc.eu)", expErr: `invalid required attribute expression "expr:(kyc.us kyc.eu)": missing closing parenthesis`},
+		{name: "unbalanced close", expr: "expr:kyc.us) OR kyc.eu", expErr: `invalid required attribute expression "expr:kyc.us) OR kyc.eu": unexpected ")"`},
+		{name: "leading operator", expr: "expr:AND kyc.us", expErr: `invalid required attribute expression "expr:AND kyc.us": unexpected "AND"`},
+		{name: "empty group", expr: "expr:()", expErr: `invalid required attribute expression "expr:()": unexpected ")"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := ParseRequiredAttribute(tc.expr)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ParseRequiredAttribute(%q) error", tc.expr)
+				return
+			}
+			require.NoError(t, err, "ParseRequiredAttribute(%q) error", tc.expr)
+			assert.Equal(t, tc.expStr, expr.Entry(), "ParseRequiredAttribute(%q).Entry()", tc.expr)
+
+			reparsed, err := ParseRequiredAttribute(expr.Entry())
+			require.NoError(t, err, "ParseRequiredAttribute(%q) of canonical form", expr.Entry())
+			assert.Equal(t, expr, reparsed, "reparsed canonical form")
+		})
+	}
+}
+
+func TestAttributeExprEvaluate(t *testing.T) {
+	expr, err := ParseRequiredAttribute("expr:(kyc.us OR kyc.eu) AND NOT frozen.pb")
+	require.NoError(t, err, "ParseRequiredAttribute")
+	assert.Equal(t, []string{"kyc.us", "kyc.eu", "frozen.pb"}, expr.Names(), "Names")
+
+	tests := []struct {
+		name  string
+		attrs []string
+		exp   bool
+	}{
+		{name: "no attributes", attrs: nil, exp: false},
+		{name: "us", attrs: []string{"kyc.us"}, exp: true},
+		{name: "eu", attrs: []string{"kyc.eu"}, exp: true},
+		{name: "us and eu", attrs: []string{"kyc.us", "kyc.eu"}, exp: true},
+		{name: "us and frozen", attrs: []string{"kyc.us", "frozen.pb"}, exp: false},
+		{name: "frozen only", attrs: []string{"frozen.pb"}, exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hasAttr := func(name string) bool {
+				for _, attr := range tc.attrs {
+					if attr == name {
+						return true
+					}
+				}
+				return false
+			}
+			assert.Equal(t, tc.exp, expr.Evaluate(hasAttr), "Evaluate")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSendDenyReasonLength is the longest reason that can be recorded with a deny send entry.
+const MaxSendDenyReasonLength = 256
+
+// NewDenySendAddress creates a new deny send entry. The expiration is optional.
+func NewDenySendAddress(markerAddr, denyAddr sdk.AccAddress, reason, addedBy string, addedAt time.Time, expiration *time.Time) DenySendAddress {
+	return DenySendAddress{
+		MarkerAddress: markerAddr.String(),
+		DenyAddress:   denyAddr.String(),
+		Reason:        reason,
+		AddedBy:       addedBy,
+		AddedAt:       &addedAt,
+		Expiration:    expiration,
+	}
+}
+
+// Validate returns an error if the deny send entry is not well formed.
+func (d DenySendAddress) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.MarkerAddress); err != nil {
+		return fmt.Errorf("invalid deny send marker address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.DenyAddress); err != nil {
+		return fmt.Errorf("invalid deny send address: %w", err)
+	}
+	if len(d.AddedBy) > 0 {
+		if _, err := sdk.AccAddressFromBech32(d.AddedBy); err != nil {
+			return fmt.Errorf("invalid deny send added by address: %w", err)
+		}
+	}
+	if len(d.Reason) > MaxSendDenyReasonLength {
+		return fmt.Errorf("deny send reason length %d exceeds maximum length of %d", len(d.Reason), MaxSendDenyReasonLength)
+	}
+	return nil
+}
+
+// GetMarkerAddress returns the address of the marker whose deny send list has this entry.
+func (d DenySendAddress) GetMarkerAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(d.MarkerAddress)
+}
+
+// GetDenyAddress returns the address that is denied sends.
+func (d DenySendAddress) GetDenyAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(d.DenyAddress)
+}
+
+// IsExpired returns true if the entry has an expiration that is not after the provided time.
+func (d DenySendAddress) IsExpired(blockTime time.Time) bool {
+	return d.Expiration != nil && !d.Expiration.After(blockTime)
+}
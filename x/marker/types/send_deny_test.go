@@ -0,0 +1,40 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDenySendAddressValidate(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("denycoin")
+	denyAddr := sdk.AccAddress("denied______________")
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	entry := NewDenySendAddress(markerAddr, denyAddr, "sanctions", denyAddr.String(), now, nil)
+	require.NoError(t, entry.Validate(), "Validate")
+
+	entry.AddedBy = "bad"
+	require.ErrorContains(t, entry.Validate(), "invalid deny send added by address", "Validate bad added by")
+
+	entry.AddedBy = ""
+	entry.Reason = strings.Repeat("r", MaxSendDenyReasonLength+1)
+	require.EqualError(t, entry.Validate(), "deny send reason length 257 exceeds maximum length of 256", "Validate long reason")
+
+	legacy := DenySendAddress{MarkerAddress: markerAddr.String(), DenyAddress: denyAddr.String()}
+	require.NoError(t, legacy.Validate(), "Validate legacy entry")
+}
+
+func TestDenySendAddressIsExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.False(t, DenySendAddress{}.IsExpired(now), "entry without expiration")
+
+	entry := DenySendAddress{Expiration: &now}
+	require.False(t, entry.IsExpired(now.Add(-time.Second)), "before expiration")
+	require.True(t, entry.IsExpired(now), "at expiration")
+	require.True(t, entry.IsExpired(now.Add(time.Second)), "after expiration")
+}
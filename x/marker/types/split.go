@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// SplitMaxHolders is the maximum number of holders a marker can have and still be split.
+const SplitMaxHolders = 10_000
+
+// ValidateSplitRatio returns an error if the numerator and denominator do not define a usable split ratio.
+func ValidateSplitRatio(numerator, denominator uint64) error {
+	if numerator == 0 {
+		return fmt.Errorf("split numerator must be positive")
+	}
+	if denominator == 0 {
+		return fmt.Errorf("split denominator must be positive")
+	}
+	if numerator == denominator {
+		return fmt.Errorf("split ratio %d:%d would not change any balances", numerator, denominator)
+	}
+	return nil
+}
+
+// SplitAmount returns the provided amount multiplied by numerator/denominator, rounded down.
+func SplitAmount(amount sdkmath.Int, numerator, denominator uint64) sdkmath.Int {
+	return amount.Mul(sdkmath.NewIntFromUint64(numerator)).Quo(sdkmath.NewIntFromUint64(denominator))
+}
+
+// Split returns a copy of this net asset value that prices the same value after the marker's denom is split.
+// Since volume*numerator new units are worth what volume*denominator old units were, the price is multiplied by the
+// denominator and the volume by the numerator (then both are reduced by their greatest common divisor) so that no
+// rounding is needed.
+func (mnav NetAssetValue) Split(numerator, denominator uint64) (NetAssetValue, error) {
+	if mnav.Volume == 0 || mnav.Price.Amount.IsZero() {
+		return mnav, nil
+	}
+	price := mnav.Price.Amount.Mul(sdkmath.NewIntFromUint64(denominator))
+	volume := sdkmath.NewIntFromUint64(mnav.Volume).Mul(sdkmath.NewIntFromUint64(numerator))
+	price, volume = reduceFraction(price, volume)
+	if !volume.IsUint64() {
+		return mnav, fmt.Errorf("net asset value volume %s is too large", volume)
+	}
+	rv := mnav
+	rv.Price.Amount = price
+	rv.Volume = volume.Uint64()
+	return rv, nil
+}
+
+// Split returns a copy of this lockup with each release amount multiplied by numerator/denominator, rounded down.
+// Releases that round down to zero are dropped, so the result might not have any releases.
+func (l Lockup) Split(numerator, denominator uint64) Lockup {
+	rv := Lockup{Denom: l.Denom, Address: l.Address}
+	for _, release := range l.Releases {
+		amount := SplitAmount(release.Amount, numerator, denominator)
+		if amount.IsPositive() {
+			rv.Releases = append(rv.Releases, NewLockupRelease(release.ReleaseTime, amount))
+		}
+	}
+	return rv
+}
+
+// Split returns a copy of this redemption config with its fixed price rescaled to pay the same value in split units.
+// Like net asset values, the amount is multiplied by the denominator and the units by the numerator.
+func (c RedemptionConfig) Split(numerator, denominator uint64) RedemptionConfig {
+	rv := c
+	rv.Amount, rv.Units = reduceFraction(
+		c.Amount.Mul(sdkmath.NewIntFromUint64(denominator)),
+		c.Units.Mul(sdkmath.NewIntFromUint64(numerator)),
+	)
+	return rv
+}
+
+// Split returns a copy of this holder limit with its minimum holding multiplied by numerator/denominator, rounded
+// down. A minimum holding never rounds down to zero, since that would remove it.
+func (l HolderLimit) Split(numerator, denominator uint64) HolderLimit {
+	rv := l
+	if l.MinHolding.IsPositive() {
+		rv.MinHolding = sdkmath.MaxInt(SplitAmount(l.MinHolding, numerator, denominator), sdkmath.OneInt())
+	}
+	return rv
+}
+
+// Split returns a copy of this IBC channel policy with its caps multiplied by numerator/denominator, rounded down.
+// A cap never rounds down to zero.
+func (p IbcChannelPolicy) Split(numerator, denominator uint64) IbcChannelPolicy {
+	rv := p
+	rv.Caps = make([]IbcChannelCap, len(p.Caps))
+	for i, c := range p.Caps {
+		rv.Caps[i] = NewIbcChannelCap(c.ChannelId, sdkmath.MaxInt(SplitAmount(c.Amount, numerator, denominator), sdkmath.OneInt()))
+	}
+	return rv
+}
+
+// Split returns a copy of this transfer limit with its limits multiplied by numerator/denominator, rounded down.
+// A limit never rounds down to zero, since that would remove it.
+func (l TransferLimit) Split(numerator, denominator uint64) TransferLimit {
+	splitLimit := func(limit sdkmath.Int) sdkmath.Int {
+		if !limit.IsPositive() {
+			return limit
+		}
+		return sdkmath.MaxInt(SplitAmount(limit, numerator, denominator), sdkmath.OneInt())
+	}
+	rv := l
+	rv.Daily = splitLimit(l.Daily)
+	rv.Weekly = splitLimit(l.Weekly)
+	return rv
+}
+
+// Split returns a copy of this transfer usage with each amount multiplied by numerator/denominator, rounded down.
+// Amounts that round down to zero are dropped.
+func (u TransferUsage) Split(numerator, denominator uint64) TransferUsage {
+	rv := TransferUsage{Denom: u.Denom, Address: u.Address}
+	for _, bucket := range u.Buckets {
+		amount := SplitAmount(bucket.Amount, numerator, denominator)
+		if amount.IsPositive() {
+			rv.Buckets = append(rv.Buckets, TransferUsageBucket{Start: bucket.Start, Amount: amount})
+		}
+	}
+	return rv
+}
+
+// Split returns a copy of this recovery with its amount multiplied by numerator/denominator, rounded down.
+func (r Recovery) Split(numerator, denominator uint64) Recovery {
+	rv := r
+	rv.Amount = SplitAmount(r.Amount, numerator, denominator)
+	return rv
+}
+
+// reduceFraction divides the provided numerator and denominator by their greatest common divisor.
+func reduceFraction(num, den sdkmath.Int) (sdkmath.Int, sdkmath.Int) {
+	if gcd := sdkmath.NewIntFromBigInt(new(big.Int).GCD(nil, nil, num.BigInt(), den.BigInt())); gcd.GT(sdkmath.OneInt()) {
+		return num.Quo(gcd), den.Quo(gcd)
+	}
+	return num, den
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateSplitRatio(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   uint64
+		denominator uint64
+		expErr      string
+	}{
+		{name: "split", numerator: 2, denominator: 1},
+		{name: "reverse split", numerator: 1, denominator: 10},
+		{name: "zero numerator", numerator: 0, denominator: 1, expErr: "split numerator must be positive"},
+		{name: "zero denominator", numerator: 1, denominator: 0, expErr: "split denominator must be positive"},
+		{name: "no change", numerator: 3, denominator: 3, expErr: "split ratio 3:3 would not change any balances"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSplitRatio(tc.numerator, tc.denominator)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateSplitRatio")
+			} else {
+				require.NoError(t, err, "ValidateSplitRatio")
+			}
+		})
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	require.Equal(t, sdkmath.NewInt(300), SplitAmount(sdkmath.NewInt(100), 3, 1), "3:1 split")
+	require.Equal(t, sdkmath.NewInt(33), SplitAmount(sdkmath.NewInt(100), 1, 3), "1:3 reverse split rounds down")
+	require.Equal(t, sdkmath.NewInt(151), SplitAmount(sdkmath.NewInt(101), 3, 2), "3:2 split rounds down")
+}
+
+func TestNetAssetValueSplit(t *testing.T) {
+	tests := []struct {
+		name        string
+		nav         NetAssetValue
+		numerator   uint64
+		denominator uint64
+		expNav      NetAssetValue
+		expErr      string
+	}{
+		{
+			name:        "split",
+			nav:         NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 100), 1),
+			numerator:   2,
+			denominator: 1,
+			expNav:      NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 50), 1),
+		},
+		{
+			name:        "reverse split",
+			nav:         NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 15), 10),
+			numerator:   1,
+			denominator: 10,
+			expNav:      NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 15), 1),
+		},
+		{
+			name:        "price not divisible",
+			nav:         NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 7), 1),
+			numerator:   3,
+			denominator: 1,
+			expNav:      NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 7), 3),
+		},
+		{
+			name:        "zero price",
+			nav:         NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 0), 0),
+			numerator:   3,
+			denominator: 1,
+			expNav:      NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 0), 0),
+		},
+		{
+			name:        "volume too large",
+			nav:         NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, 1), 1<<63),
+			numerator:   2,
+			denominator: 1,
+			expErr:      "net asset value volume 18446744073709551616 is too large",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nav, err := tc.nav.Split(tc.numerator, tc.denominator)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Split")
+				return
+			}
+			require.NoError(t, err, "Split")
+			require.Equal(t, tc.expNav, nav, "Split result")
+		})
+	}
+}
+
+func TestLockupSplit(t *testing.T) {
+	t1 := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress("lockedup____________")
+	lockup := NewLockup("fundshare", addr, []LockupRelease{
+		NewLockupRelease(t1, sdkmath.NewInt(5)),
+		NewLockupRelease(t2, sdkmath.NewInt(25)),
+	})
+
+	split := lockup.Split(1, 10)
+	require.Equal(t, NewLockup("fundshare", addr, []LockupRelease{NewLockupRelease(t2, sdkmath.NewInt(2))}), split,
+		"1:10 reverse split should drop the release that rounds to zero")
+	require.Empty(t, lockup.Split(1, 100).Releases, "1:100 reverse split releases")
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TransferLimitDay is the length of the rolling period of a daily transfer limit.
+	TransferLimitDay = 24 * time.Hour
+	// TransferLimitWeek is the length of the rolling period of a weekly transfer limit.
+	TransferLimitWeek = 7 * TransferLimitDay
+	// TransferUsageBucketSize is the length of time that sent amounts are grouped into.
+	TransferUsageBucketSize = time.Hour
+)
+
+// NewTransferLimit creates a new TransferLimit. A nil or empty addr creates a marker's default limit.
+func NewTransferLimit(denom string, addr sdk.AccAddress, daily, weekly sdkmath.Int) TransferLimit {
+	rv := TransferLimit{
+		Denom:  denom,
+		Daily:  daily,
+		Weekly: weekly,
+	}
+	if len(addr) > 0 {
+		rv.Address = addr.String()
+	}
+	return rv
+}
+
+// Validate returns an error if the transfer limit is not well formed.
+func (l TransferLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid transfer limit denom: %w", err)
+	}
+	if len(l.Address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+			return fmt.Errorf("invalid transfer limit address: %w", err)
+		}
+	}
+	if l.Daily.IsNil() || l.Daily.IsNegative() {
+		return fmt.Errorf("daily transfer limit cannot be negative")
+	}
+	if l.Weekly.IsNil() || l.Weekly.IsNegative() {
+		return fmt.Errorf("weekly transfer limit cannot be negative")
+	}
+	if l.Daily.IsZero() && l.Weekly.IsZero() {
+		return fmt.Errorf("transfer limit must have a daily or weekly limit")
+	}
+	return nil
+}
+
+// IsDefault returns true if this is a marker's default limit instead of the limit of a specific account.
+func (l TransferLimit) IsDefault() bool {
+	return len(l.Address) == 0
+}
+
+// GetAddress returns the address of the account the limit applies to, or nil for a marker's default limit.
+func (l TransferLimit) GetAddress() sdk.AccAddress {
+	if l.IsDefault() {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(l.Address)
+}
+
+// CheckSend returns an error if sending amount in addition to what has already been sent would exceed the limit.
+func (l TransferLimit) CheckSend(usage TransferUsage, blockTime time.Time, amount sdkmath.Int) error {
+	periods := []struct {
+		name   string
+		limit  sdkmath.Int
+		length time.Duration
+	}{
+		{name: "daily", limit: l.Daily, length: TransferLimitDay},
+		{name: "weekly", limit: l.Weekly, length: TransferLimitWeek},
+	}
+	for _, period := range periods {
+		if !period.limit.IsPositive() {
+			continue
+		}
+		sent := usage.SentWithin(blockTime, period.length)
+		if sent.Add(amount).GT(period.limit) {
+			return fmt.Errorf("cannot send %s%s from %s: it exceeds the remaining %s transfer limit of %s%s",
+				amount, l.Denom, usage.Address, period.name, RemainingTransferLimit(period.limit, sent), l.Denom)
+		}
+	}
+	return nil
+}
+
+// RemainingTransferLimit returns how much of the limit is left after sent has been used, but not less than zero.
+func RemainingTransferLimit(limit, sent sdkmath.Int) sdkmath.Int {
+	if sent.GTE(limit) {
+		return sdkmath.ZeroInt()
+	}
+	return limit.Sub(sent)
+}
+
+// NewTransferUsage creates a new TransferUsage without any recorded sends.
+func NewTransferUsage(denom string, addr sdk.AccAddress) TransferUsage {
+	return TransferUsage{
+		Denom:   denom,
+		Address: addr.String(),
+	}
+}
+
+// Validate returns an error if the transfer usage is not well formed.
+func (u TransferUsage) Validate() error {
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return fmt.Errorf("invalid transfer usage denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid transfer usage address: %w", err)
+	}
+	for i, bucket := range u.Buckets {
+		if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+			return fmt.Errorf("transfer usage amount must be positive")
+		}
+		if i > 0 && !bucket.Start.After(u.Buckets[i-1].Start) {
+			return fmt.Errorf("transfer usage buckets must be ordered by time")
+		}
+	}
+	return nil
+}
+
+// GetAddress returns the address of the account that sent the funds.
+func (u TransferUsage) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(u.Address)
+}
+
+// SentWithin returns the amount sent during the provided length of time before the block time.
+// Sends are grouped by hour, so a send counts until the end of its hour falls out of the period.
+func (u TransferUsage) SentWithin(blockTime time.Time, length time.Duration) sdkmath.Int {
+	cutoff := blockTime.Add(-length)
+	sent := sdkmath.ZeroInt()
+	for _, bucket := range u.Buckets {
+		if bucket.Start.Add(TransferUsageBucketSize).After(cutoff) {
+			sent = sent.Add(bucket.Amount)
+		}
+	}
+	return sent
+}
+
+// Prune returns a copy of the usage without the sends that no longer count towards a weekly limit.
+func (u TransferUsage) Prune(blockTime time.Time) TransferUsage {
+	cutoff := blockTime.Add(-TransferLimitWeek)
+	rv := TransferUsage{Denom: u.Denom, Address: u.Address}
+	for _, bucket := range u.Buckets {
+		if bucket.Start.Add(TransferUsageBucketSize).After(cutoff) {
+			rv.Buckets = append(rv.Buckets, bucket)
+		}
+	}
+	return rv
+}
+
+// Record returns a copy of the usage with the amount added as sent at the block time and old sends pruned.
+func (u TransferUsage) Record(blockTime time.Time, amount sdkmath.Int) TransferUsage {
+	rv := u.Prune(blockTime)
+	start := blockTime.UTC().Truncate(TransferUsageBucketSize)
+	if last := len(rv.Buckets) - 1; last >= 0 && rv.Buckets[last].Start.Equal(start) {
+		rv.Buckets[last].Amount = rv.Buckets[last].Amount.Add(amount)
+		return rv
+	}
+	rv.Buckets = append(rv.Buckets, TransferUsageBucket{Start: start, Amount: amount})
+	return rv
+}
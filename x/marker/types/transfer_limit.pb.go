@@ -0,0 +1,981 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/transfer_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferLimit caps how much of a marker's denom an account can send in a rolling day and a rolling week.
+type TransferLimit struct {
+	// denom is the marker denom that is limited.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the bech32 address of the account the limit applies to.
+	// If empty, this is the marker's default limit, which applies to every account without its own limit.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// daily is the most that can be sent in any 24 hour period. Zero means there is no daily limit.
+	Daily cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=daily,proto3,customtype=cosmossdk.io/math.Int" json:"daily"`
+	// weekly is the most that can be sent in any 7 day period. Zero means there is no weekly limit.
+	Weekly cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=weekly,proto3,customtype=cosmossdk.io/math.Int" json:"weekly"`
+}
+
+func (m *TransferLimit) Reset()         { *m = TransferLimit{} }
+func (m *TransferLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferLimit) ProtoMessage()    {}
+func (*TransferLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f4367592dce20ff, []int{0}
+}
+func (m *TransferLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimit.Merge(m, src)
+}
+func (m *TransferLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLimit proto.InternalMessageInfo
+
+// TransferUsage records the amounts of a marker's denom that an account has recently sent.
+type TransferUsage struct {
+	// denom is the marker denom that was sent.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the bech32 address of the account that sent the funds.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// buckets are the amounts sent during each hour of the past week, ordered by time.
+	Buckets []TransferUsageBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *TransferUsage) Reset()         { *m = TransferUsage{} }
+func (m *TransferUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferUsage) ProtoMessage()    {}
+func (*TransferUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f4367592dce20ff, []int{1}
+}
+func (m *TransferUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUsage.Merge(m, src)
+}
+func (m *TransferUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUsage proto.InternalMessageInfo
+
+// TransferUsageBucket is the total amount sent during one hour.
+type TransferUsageBucket struct {
+	// start is the start of the hour.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// amount is the total amount sent during the hour.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *TransferUsageBucket) Reset()         { *m = TransferUsageBucket{} }
+func (m *TransferUsageBucket) String() string { return proto.CompactTextString(m) }
+func (*TransferUsageBucket) ProtoMessage()    {}
+func (*TransferUsageBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f4367592dce20ff, []int{2}
+}
+func (m *TransferUsageBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUsageBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUsageBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferUsageBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUsageBucket.Merge(m, src)
+}
+func (m *TransferUsageBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUsageBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUsageBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUsageBucket proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TransferLimit)(nil), "provenance.marker.v1.TransferLimit")
+	proto.RegisterType((*TransferUsage)(nil), "provenance.marker.v1.TransferUsage")
+	proto.RegisterType((*TransferUsageBucket)(nil), "provenance.marker.v1.TransferUsageBucket")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/transfer_limit.proto", fileDescriptor_7f4367592dce20ff)
+}
+
+var fileDescriptor_7f4367592dce20ff = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x8e, 0xda, 0x30,
+	0x18, 0xc7, 0xe3, 0x03, 0xee, 0xae, 0x3e, 0x75, 0x49, 0xa9, 0x1a, 0x21, 0x35, 0x39, 0xdd, 0x74,
+	0x37, 0xd4, 0x16, 0xa0, 0x2e, 0x8c, 0xd9, 0x90, 0x3a, 0x54, 0x11, 0x5d, 0xba, 0x54, 0x4e, 0x62,
+	0x82, 0x95, 0x38, 0x8e, 0x6c, 0x87, 0x96, 0x37, 0xe8, 0x88, 0xfa, 0x04, 0xbc, 0x42, 0xdf, 0x82,
+	0x91, 0xb1, 0xea, 0x40, 0x2b, 0x58, 0xfa, 0x18, 0x55, 0x62, 0x22, 0xa8, 0xc4, 0x40, 0xb7, 0xfc,
+	0x3f, 0xfd, 0xfe, 0xf9, 0xfe, 0xdf, 0xf7, 0x19, 0x3e, 0x15, 0x52, 0xcc, 0x69, 0x4e, 0xf2, 0x88,
+	0x62, 0x4e, 0x64, 0x4a, 0x25, 0x9e, 0xf7, 0xb1, 0x96, 0x24, 0x57, 0x53, 0x2a, 0x3f, 0x65, 0x8c,
+	0x33, 0x8d, 0x0a, 0x29, 0xb4, 0xb0, 0xbb, 0x47, 0x14, 0x19, 0x14, 0xcd, 0xfb, 0xbd, 0x6e, 0x22,
+	0x12, 0x51, 0x03, 0xb8, 0xfa, 0x32, 0x6c, 0xcf, 0x4b, 0x84, 0x48, 0x32, 0x8a, 0x6b, 0x15, 0x96,
+	0x53, 0xac, 0x19, 0xa7, 0x4a, 0x13, 0x5e, 0x18, 0xe0, 0xe1, 0x3b, 0x80, 0xcf, 0x27, 0x87, 0x2e,
+	0xef, 0xaa, 0x26, 0x76, 0x17, 0x76, 0x62, 0x9a, 0x0b, 0xee, 0x80, 0x7b, 0xf0, 0xf8, 0x2c, 0x30,
+	0xc2, 0x76, 0xe0, 0x0d, 0x89, 0x63, 0x49, 0x95, 0x72, 0xae, 0xea, 0x7a, 0x23, 0xed, 0x21, 0xec,
+	0xc4, 0x84, 0x65, 0x0b, 0xa7, 0x55, 0xd5, 0xfd, 0xd7, 0xeb, 0xad, 0x67, 0xfd, 0xdc, 0x7a, 0x2f,
+	0x23, 0xa1, 0xb8, 0x50, 0x2a, 0x4e, 0x11, 0x13, 0x98, 0x13, 0x3d, 0x43, 0xe3, 0x5c, 0x07, 0x86,
+	0xb5, 0xdf, 0xc2, 0xeb, 0xcf, 0x94, 0xa6, 0xd9, 0xc2, 0x69, 0x5f, 0xe2, 0x3a, 0xc0, 0xa3, 0xf6,
+	0xd7, 0x95, 0x67, 0x3d, 0x2c, 0x4f, 0x32, 0x7f, 0x50, 0x24, 0xa1, 0xff, 0x9d, 0x79, 0x0c, 0x6f,
+	0xc2, 0x32, 0x4a, 0xa9, 0x56, 0x4e, 0xeb, 0xbe, 0xf5, 0x78, 0x37, 0x78, 0x42, 0xe7, 0x96, 0x8a,
+	0xfe, 0xe9, 0xe2, 0xd7, 0x0e, 0xbf, 0x5d, 0x45, 0x0d, 0x1a, 0xff, 0x21, 0xd2, 0x37, 0x00, 0x5f,
+	0x9c, 0x81, 0xed, 0x11, 0xec, 0x28, 0x4d, 0xa4, 0xae, 0x83, 0xdd, 0x0d, 0x7a, 0xc8, 0xdc, 0x03,
+	0x35, 0xf7, 0x40, 0x93, 0xe6, 0x1e, 0xfe, 0x6d, 0xf5, 0xdf, 0xe5, 0x2f, 0x0f, 0x04, 0xc6, 0x52,
+	0xed, 0x88, 0x70, 0x51, 0xe6, 0xda, 0xb9, 0xba, 0x68, 0x47, 0x06, 0x1e, 0xdd, 0x56, 0x81, 0xfe,
+	0xac, 0x3c, 0xe0, 0x27, 0xeb, 0x9d, 0x0b, 0x36, 0x3b, 0x17, 0xfc, 0xde, 0xb9, 0x60, 0xb9, 0x77,
+	0xad, 0xcd, 0xde, 0xb5, 0x7e, 0xec, 0x5d, 0x0b, 0xbe, 0x62, 0xe2, 0xec, 0xc0, 0xef, 0xc1, 0xc7,
+	0x41, 0xc2, 0xf4, 0xac, 0x0c, 0x51, 0x24, 0x38, 0x3e, 0x22, 0x6f, 0x98, 0x38, 0x51, 0xf8, 0x4b,
+	0xf3, 0x46, 0xf5, 0xa2, 0xa0, 0x2a, 0xbc, 0xae, 0xc7, 0x19, 0xfe, 0x1d, 0x00, 0x6b, 0x8d, 0x9e,
+	0x88, 0xc5, 0x02, 0x00, 0x00,
+}
+
+func (this *TransferUsageBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferUsageBucket)
+	if !ok {
+		that2, ok := that.(TransferUsageBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *TransferLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weekly.Size()
+		i -= size
+		if _, err := m.Weekly.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Daily.Size()
+		i -= size
+		if _, err := m.Daily.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransferLimit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransferLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransferLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransferLimit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransferLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferUsageBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUsageBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUsageBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTransferLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransferLimit(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransferLimit(uint64(l))
+	}
+	l = m.Daily.Size()
+	n += 1 + l + sovTransferLimit(uint64(l))
+	l = m.Weekly.Size()
+	n += 1 + l + sovTransferLimit(uint64(l))
+	return n
+}
+
+func (m *TransferUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransferLimit(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransferLimit(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTransferLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferUsageBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTransferLimit(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTransferLimit(uint64(l))
+	return n
+}
+
+func sovTransferLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferLimit(x uint64) (n int) {
+	return sovTransferLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daily", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Daily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekly", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weekly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, TransferUsageBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferUsageBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUsageBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUsageBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferLimitValidate(t *testing.T) {
+	addr := sdk.AccAddress("limited_____________")
+	tests := []struct {
+		name   string
+		limit  TransferLimit
+		expErr string
+	}{
+		{name: "default", limit: NewTransferLimit("limitcoin", nil, sdkmath.NewInt(10), sdkmath.NewInt(50))},
+		{name: "account daily only", limit: NewTransferLimit("limitcoin", addr, sdkmath.NewInt(10), sdkmath.ZeroInt())},
+		{name: "account weekly only", limit: NewTransferLimit("limitcoin", addr, sdkmath.ZeroInt(), sdkmath.NewInt(50))},
+		{
+			name:   "invalid address",
+			limit:  TransferLimit{Denom: "limitcoin", Address: "bad", Daily: sdkmath.NewInt(10), Weekly: sdkmath.ZeroInt()},
+			expErr: "invalid transfer limit address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "negative daily",
+			limit:  NewTransferLimit("limitcoin", nil, sdkmath.NewInt(-1), sdkmath.NewInt(50)),
+			expErr: "daily transfer limit cannot be negative",
+		},
+		{
+			name:   "no limits",
+			limit:  NewTransferLimit("limitcoin", addr, sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+			expErr: "transfer limit must have a daily or weekly limit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestTransferUsage(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC)
+	addr := sdk.AccAddress("limited_____________")
+	limit := NewTransferLimit("limitcoin", addr, sdkmath.NewInt(100), sdkmath.NewInt(140))
+
+	usage := NewTransferUsage("limitcoin", addr)
+	usage = usage.Record(start, sdkmath.NewInt(30))
+	usage = usage.Record(start.Add(20*time.Minute), sdkmath.NewInt(20))
+	require.Len(t, usage.Buckets, 1, "sends in the same hour share a bucket")
+	usage = usage.Record(start.Add(40*time.Minute), sdkmath.NewInt(50))
+	require.Len(t, usage.Buckets, 2, "sends in the next hour get a new bucket")
+	require.NoError(t, usage.Validate(), "Validate")
+
+	require.Equal(t, sdkmath.NewInt(100), usage.SentWithin(start.Add(time.Hour), TransferLimitDay), "sent in the first day")
+	require.EqualError(t, limit.CheckSend(usage, start.Add(time.Hour), sdkmath.OneInt()),
+		"cannot send 1limitcoin from "+addr.String()+": it exceeds the remaining daily transfer limit of 0limitcoin", "CheckSend over the daily limit")
+
+	nextDay := start.Add(TransferLimitDay).Add(TransferUsageBucketSize)
+	require.Equal(t, sdkmath.NewInt(50), usage.SentWithin(nextDay, TransferLimitDay), "sent in the day before the next day")
+	require.NoError(t, limit.CheckSend(usage, nextDay, sdkmath.NewInt(40)), "CheckSend up to the weekly limit")
+	require.EqualError(t, limit.CheckSend(usage, nextDay, sdkmath.NewInt(41)),
+		"cannot send 41limitcoin from "+addr.String()+": it exceeds the remaining weekly transfer limit of 40limitcoin", "CheckSend over the weekly limit")
+
+	nextWeek := start.Add(TransferLimitWeek).Add(2 * TransferUsageBucketSize)
+	require.Empty(t, usage.Prune(nextWeek).Buckets, "buckets after a week")
+	require.Equal(t, sdkmath.NewInt(10), usage.Record(nextWeek, sdkmath.NewInt(10)).SentWithin(nextWeek, TransferLimitWeek), "sent in the next week")
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -17,20 +18,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	github_com_cosmos_ibc_go_v8_modules_apps_transfer_types "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -2121,6 +2126,10 @@ type MsgUpdateSendDenyListRequest struct {
 	AddDeniedAddresses []string `protobuf:"bytes,3,rep,name=add_denied_addresses,json=addDeniedAddresses,proto3" json:"add_denied_addresses,omitempty"`
 	// The signer of the message.  Must have admin authority to marker or be governance module account address.
 	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reason is recorded with each of the added addresses to say why they were denied.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expiration is an optional time when the added addresses are automatically removed from the deny send list.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *MsgUpdateSendDenyListRequest) Reset()         { *m = MsgUpdateSendDenyListRequest{} }
@@ -2184,6 +2193,20 @@ func (m *MsgUpdateSendDenyListRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateSendDenyListRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgUpdateSendDenyListRequest) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // MsgUpdateSendDenyListResponse defines the Msg/UpdateSendDenyList response type
 type MsgUpdateSendDenyListResponse struct {
 }
@@ -2901,4653 +2924,12715 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
-	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
-	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
-	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
-	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
-	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
-	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
-	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
-	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
-	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
-	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
-	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
-	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
-	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
-	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
-	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
-	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
-	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
-	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
-	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
-	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
-	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
-	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
-	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
-	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
-	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
-	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
-	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
-	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
-	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
-	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
-	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
-	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
-	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
-	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
-	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
-	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
-	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
-	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
-	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
-	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
-	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
-	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
-	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
-	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+// MsgCreateDistributionRequest defines the Msg/CreateDistribution request type
+type MsgCreateDistributionRequest struct {
+	// denom is the marker denom whose holders will be paid.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total payout, taken from the marker's escrow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The signer of the message.  Must have withdraw authority to marker or be governance module account address.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
-
-var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 2359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xef, 0x38, 0x8e, 0x37, 0x3e, 0x6e, 0xd3, 0xe6, 0x36, 0x6d, 0x27, 0x93, 0xcd, 0xdf, 0x36,
-	0xad, 0xb7, 0x6c, 0xec, 0xc6, 0x0b, 0xdd, 0x6e, 0x58, 0x09, 0x39, 0xc9, 0xa6, 0x54, 0x60, 0x54,
-	0x39, 0x0b, 0x08, 0x5e, 0xac, 0xf1, 0xcc, 0xcd, 0x64, 0x14, 0x7b, 0xc6, 0x9d, 0x7b, 0xed, 0x34,
-	0x2b, 0x21, 0x21, 0xf6, 0x69, 0x9f, 0x58, 0xf6, 0x01, 0x21, 0xc4, 0x03, 0x4f, 0x08, 0xf1, 0x54,
-	0xa1, 0x15, 0x1f, 0x00, 0x09, 0xb1, 0x80, 0x40, 0xab, 0xe5, 0x05, 0xf1, 0xb0, 0xa0, 0x56, 0xa2,
-	0x08, 0xf1, 0x19, 0x00, 0xcd, 0xbd, 0x77, 0xc6, 0x1e, 0x7b, 0xe6, 0xda, 0x49, 0x5c, 0x2d, 0x2f,
-	0x6d, 0xe6, 0x9e, 0x73, 0xee, 0x39, 0xbf, 0x73, 0xcf, 0xb9, 0xf7, 0x9c, 0x93, 0xc0, 0x42, 0xcb,
-	0x73, 0x3b, 0xd8, 0xd1, 0x1d, 0x03, 0x17, 0x9b, 0xba, 0x77, 0x88, 0xbd, 0x62, 0x67, 0xa3, 0x48,
-	0x1f, 0x17, 0x5a, 0x9e, 0x4b, 0x5d, 0x34, 0xdb, 0x25, 0x17, 0x38, 0xb9, 0xd0, 0xd9, 0xd0, 0x66,
-	0xf4, 0xa6, 0xed, 0xb8, 0x45, 0xf6, 0x2f, 0x67, 0xd4, 0xe6, 0x2c, 0xd7, 0xb5, 0x1a, 0xb8, 0xc8,
-	0xbe, 0xea, 0xed, 0xfd, 0xa2, 0xee, 0x1c, 0x07, 0x24, 0xc3, 0x25, 0x4d, 0x97, 0xd4, 0xd8, 0x57,
-	0x91, 0x7f, 0x08, 0xd2, 0xac, 0xe5, 0x5a, 0x2e, 0x5f, 0xf7, 0x7f, 0x12, 0xab, 0x8b, 0x9c, 0xa7,
-	0x58, 0xd7, 0x09, 0x2e, 0x76, 0x36, 0xea, 0x98, 0xea, 0x1b, 0x45, 0xc3, 0xb5, 0x9d, 0x01, 0xba,
-	0x73, 0x18, 0xd2, 0xfd, 0x0f, 0x41, 0xbf, 0x26, 0xe8, 0x4d, 0x62, 0xf9, 0x60, 0x9a, 0xc4, 0x12,
-	0x84, 0x35, 0xbb, 0x6e, 0x14, 0xf5, 0x56, 0xab, 0x61, 0x1b, 0x3a, 0xb5, 0x5d, 0x87, 0x14, 0xa9,
-	0xa7, 0x3b, 0x64, 0x3f, 0x0a, 0x5a, 0x5b, 0x89, 0xf5, 0x89, 0x80, 0xcf, 0x59, 0x6e, 0xc6, 0xb2,
-	0xe8, 0x86, 0x81, 0x09, 0xb1, 0x3c, 0xdd, 0xa1, 0x9c, 0x6f, 0xf5, 0x0f, 0x0a, 0xa8, 0x15, 0x62,
-	0xdd, 0xf7, 0x97, 0xca, 0x8d, 0x86, 0x7b, 0xe4, 0x4b, 0x54, 0xf1, 0xa3, 0x36, 0x26, 0x14, 0xcd,
-	0xc2, 0xa4, 0x89, 0x1d, 0xb7, 0xa9, 0x2a, 0xcb, 0x4a, 0x3e, 0x5b, 0xe5, 0x1f, 0xe8, 0x06, 0x5c,
-	0xd0, 0xcd, 0xa6, 0xed, 0xd8, 0x84, 0x7a, 0x3a, 0x75, 0x3d, 0x35, 0xc5, 0xa8, 0xd1, 0x45, 0xa4,
-	0xc2, 0x4b, 0x4c, 0x0f, 0xc6, 0xea, 0x04, 0xa3, 0x07, 0x9f, 0xe8, 0x2d, 0xc8, 0xea, 0x81, 0x26,
-	0x35, 0xbd, 0xac, 0xe4, 0x73, 0xa5, 0xd9, 0x02, 0x3f, 0x9d, 0x42, 0x70, 0x3a, 0x85, 0xb2, 0x73,
-	0xbc, 0x35, 0xf3, 0xfb, 0x0f, 0xd7, 0x2f, 0xec, 0x62, 0x1c, 0xda, 0xf5, 0xa0, 0xda, 0x95, 0xdc,
-	0x44, 0xdf, 0x7b, 0xfe, 0xe4, 0x76, 0x54, 0xe9, 0xea, 0x3c, 0xcc, 0xc5, 0x80, 0x21, 0x2d, 0xd7,
-	0x21, 0x78, 0xf5, 0xbf, 0x69, 0xb8, 0x5c, 0x21, 0x56, 0xd9, 0x34, 0x2b, 0xcc, 0x21, 0x01, 0xca,
-	0xd7, 0x21, 0xa3, 0x37, 0xdd, 0xb6, 0x43, 0x19, 0xcc, 0x5c, 0x69, 0xae, 0x20, 0x42, 0xc0, 0x3f,
-	0xde, 0x82, 0x38, 0xbe, 0xc2, 0xb6, 0x6b, 0x3b, 0x5b, 0xe9, 0x8f, 0x3e, 0x5d, 0x3a, 0x57, 0x15,
-	0xec, 0x3e, 0xc4, 0xa6, 0xee, 0xe8, 0x16, 0xf6, 0x02, 0x88, 0xe2, 0x13, 0xad, 0xc0, 0xf9, 0x7d,
-	0xcf, 0x6d, 0xd6, 0x74, 0xd3, 0xf4, 0x30, 0x21, 0x0c, 0x65, 0xb6, 0x9a, 0xf3, 0xd7, 0xca, 0x7c,
-	0x09, 0x6d, 0x42, 0x86, 0x50, 0x9d, 0xb6, 0x89, 0x3a, 0xb9, 0xac, 0xe4, 0xa7, 0x4b, 0xab, 0x85,
-	0xb8, 0x48, 0x2e, 0x70, 0x53, 0xf7, 0x18, 0x67, 0x55, 0x48, 0xa0, 0x32, 0xe4, 0x38, 0x47, 0x8d,
-	0x1e, 0xb7, 0xb0, 0x9a, 0x61, 0x1b, 0x2c, 0xcb, 0x36, 0x78, 0xfb, 0xb8, 0x85, 0xab, 0xd0, 0x0c,
-	0x7f, 0x46, 0x5f, 0x86, 0x1c, 0x0f, 0x86, 0x5a, 0xc3, 0x26, 0x54, 0x7d, 0x69, 0x79, 0x22, 0x9f,
-	0x2b, 0xad, 0xc4, 0x6f, 0x51, 0x66, 0x8c, 0xcc, 0xab, 0xc2, 0x03, 0xc0, 0x65, 0xbf, 0x6a, 0x13,
-	0xea, 0x63, 0x25, 0xed, 0x56, 0xab, 0x71, 0x5c, 0xdb, 0xb7, 0x1f, 0x63, 0x53, 0x9d, 0x5a, 0x56,
-	0xf2, 0x53, 0xd5, 0x1c, 0x5f, 0xdb, 0xf5, 0x97, 0xd0, 0x3d, 0x50, 0xd9, 0xb9, 0xd5, 0x2c, 0xb7,
-	0x83, 0x3d, 0xb6, 0x7d, 0xcd, 0x70, 0x1d, 0xea, 0xb9, 0x0d, 0x35, 0xcb, 0xd8, 0xaf, 0x32, 0xfa,
-	0xfd, 0x90, 0xbc, 0xcd, 0xa9, 0xa8, 0x04, 0x57, 0xb8, 0xe4, 0xbe, 0xeb, 0x19, 0xd8, 0xac, 0x05,
-	0xe9, 0xa0, 0x02, 0x13, 0xbb, 0xcc, 0x88, 0xbb, 0x8c, 0xf6, 0xb6, 0x20, 0xa1, 0x22, 0x5c, 0xf6,
-	0xf0, 0xa3, 0xb6, 0xed, 0x61, 0xb3, 0xa6, 0x53, 0xea, 0xd9, 0xf5, 0x36, 0xc5, 0x44, 0xcd, 0x2d,
-	0x4f, 0xe4, 0xb3, 0x55, 0x14, 0x90, 0xca, 0x21, 0x05, 0x2d, 0x41, 0xb6, 0x4d, 0xcc, 0x9a, 0x81,
-	0x1d, 0x4a, 0xd4, 0xf3, 0xcb, 0x4a, 0x3e, 0xbd, 0x95, 0x52, 0x95, 0xea, 0x54, 0x9b, 0x98, 0xdb,
-	0xfe, 0x1a, 0xba, 0x0a, 0x99, 0x8e, 0xdb, 0x68, 0x37, 0xb1, 0x7a, 0xc1, 0xa7, 0x56, 0xc5, 0x17,
-	0x9a, 0xe7, 0x82, 0x4d, 0xbb, 0xd1, 0x20, 0xea, 0x34, 0x23, 0xf9, 0x42, 0x15, 0xff, 0x7b, 0x73,
-	0xc6, 0x8f, 0xcf, 0x48, 0x18, 0xac, 0x5e, 0x85, 0xd9, 0x68, 0x00, 0x8a, 0xc8, 0xfc, 0x99, 0x12,
-	0x44, 0x26, 0x77, 0xf5, 0x38, 0xf2, 0xef, 0x4b, 0x90, 0xe1, 0x87, 0xa4, 0x4e, 0x9c, 0xec, 0x6c,
-	0x85, 0x58, 0x6c, 0x7e, 0x85, 0x00, 0x02, 0x3b, 0x05, 0x80, 0x1f, 0x28, 0x70, 0xb5, 0x42, 0xac,
-	0x1d, 0xdc, 0xc0, 0x14, 0x8f, 0x0f, 0xc3, 0x2d, 0xb8, 0xe8, 0xe1, 0xa6, 0xdb, 0xf1, 0x0f, 0x52,
-	0x64, 0x12, 0x4f, 0xb4, 0x69, 0xb1, 0x2c, 0x92, 0x29, 0xd6, 0xd6, 0x39, 0xb8, 0x36, 0x60, 0x92,
-	0x30, 0xd7, 0x04, 0x54, 0x21, 0xd6, 0xae, 0xed, 0xe8, 0x0d, 0xfb, 0x9d, 0x71, 0xdc, 0x76, 0xb1,
-	0x06, 0x5c, 0x61, 0x87, 0xda, 0xd5, 0x12, 0x51, 0x5e, 0x36, 0xa8, 0xdd, 0xd1, 0xe9, 0x0b, 0x56,
-	0xde, 0xd5, 0x22, 0x94, 0xd7, 0xe1, 0x52, 0x85, 0x58, 0xdb, 0x7e, 0x10, 0x34, 0x5e, 0x94, 0xea,
-	0xcb, 0x30, 0xd3, 0xa3, 0x23, 0xa2, 0x98, 0x9f, 0xc6, 0x8b, 0x55, 0x1c, 0xe8, 0x10, 0x8a, 0xdf,
-	0x55, 0x60, 0xba, 0x42, 0xac, 0x8a, 0xed, 0xd0, 0x33, 0x5f, 0xf8, 0xa7, 0x37, 0x6d, 0x06, 0x2e,
-	0x86, 0x46, 0x44, 0x0d, 0xdb, 0x6a, 0x7b, 0xce, 0x67, 0x6e, 0x18, 0x37, 0x42, 0x18, 0xf6, 0x1f,
-	0x85, 0x45, 0xe8, 0x37, 0x6d, 0x7a, 0x60, 0x7a, 0xfa, 0xd1, 0x38, 0x12, 0x79, 0x01, 0x80, 0xba,
-	0x7d, 0x39, 0x9c, 0xa5, 0x6e, 0xf0, 0x16, 0x1e, 0x87, 0xb8, 0xd3, 0xec, 0xae, 0x92, 0xe0, 0xde,
-	0xf5, 0x71, 0xff, 0xe2, 0x6f, 0x4b, 0x79, 0xcb, 0xa6, 0x07, 0xed, 0x7a, 0xc1, 0x70, 0x9b, 0xa2,
-	0x62, 0x13, 0xff, 0xad, 0x13, 0xf3, 0xb0, 0xe8, 0x3f, 0x8b, 0x84, 0x09, 0x90, 0x1f, 0xfb, 0xb7,
-	0x70, 0x03, 0x5b, 0xba, 0x71, 0x5c, 0xf3, 0x4b, 0x34, 0xf2, 0xf3, 0xe7, 0x4f, 0x6e, 0x2b, 0x81,
-	0xe7, 0x24, 0xb9, 0xd3, 0xc5, 0x2f, 0xfc, 0xf2, 0x3b, 0xee, 0x97, 0xe0, 0x9d, 0x19, 0xff, 0xa1,
-	0x4d, 0xc4, 0xb9, 0x6e, 0x84, 0x52, 0x22, 0xea, 0xdd, 0xc9, 0x3e, 0xef, 0x4a, 0x20, 0x76, 0xa1,
-	0x08, 0x88, 0xff, 0x50, 0xe0, 0x4a, 0x85, 0x58, 0x0f, 0xea, 0x46, 0x3f, 0xca, 0x0f, 0x14, 0x98,
-	0x0a, 0x1f, 0x5f, 0x0e, 0xf4, 0x95, 0x82, 0x5d, 0x37, 0x0a, 0xbd, 0xd5, 0x6a, 0x21, 0xe0, 0x60,
-	0x85, 0x47, 0x77, 0xff, 0xad, 0xaf, 0xf8, 0xc0, 0xff, 0xfa, 0xe9, 0xd2, 0xf6, 0xe0, 0xa9, 0xd9,
-	0x75, 0x63, 0xdd, 0x72, 0x8b, 0x9d, 0x7b, 0xc5, 0xa6, 0x6b, 0xb6, 0x1b, 0x98, 0xf8, 0xf5, 0x6f,
-	0x4f, 0xdd, 0xcb, 0x8f, 0xb2, 0xd7, 0xd8, 0xd0, 0x8e, 0x33, 0x84, 0xbd, 0xca, 0xde, 0xab, 0x08,
-	0x4e, 0xe1, 0x82, 0x3f, 0x2a, 0xa0, 0x55, 0x88, 0xb5, 0x87, 0xe9, 0x8e, 0x1f, 0xe0, 0x15, 0x4c,
-	0x75, 0x53, 0xa7, 0x7a, 0xe0, 0x87, 0x36, 0x4c, 0x35, 0xc5, 0x92, 0x70, 0xc3, 0x42, 0xf7, 0xbc,
-	0x9d, 0xc3, 0xf0, 0xbc, 0x03, 0xb9, 0xad, 0x4d, 0x01, 0xbd, 0x24, 0x0d, 0xd8, 0xc7, 0xbc, 0x57,
-	0x10, 0x60, 0x03, 0x9d, 0xa1, 0xaa, 0x33, 0x20, 0x5d, 0x80, 0xf9, 0x58, 0x38, 0x02, 0xee, 0x9f,
-	0xd3, 0x70, 0x9d, 0x3f, 0xe9, 0xc1, 0x43, 0x15, 0xbc, 0x19, 0xff, 0x0f, 0x45, 0x72, 0x5f, 0xa1,
-	0x3b, 0x79, 0xf6, 0x42, 0x37, 0x33, 0xbe, 0x42, 0xf7, 0xa5, 0x93, 0x15, 0xba, 0x53, 0xa7, 0x2b,
-	0x74, 0xb3, 0x27, 0x2e, 0x74, 0x61, 0xb4, 0x42, 0x37, 0x27, 0x2d, 0x74, 0xcf, 0x27, 0x17, 0xba,
-	0x17, 0x86, 0x17, 0xba, 0x37, 0xe1, 0x86, 0x3c, 0xa8, 0x44, 0xf4, 0xfd, 0x49, 0x81, 0x65, 0x3f,
-	0x3a, 0x99, 0x0b, 0x1f, 0x38, 0x86, 0x87, 0x75, 0x82, 0x1f, 0x7a, 0x6e, 0xcb, 0x25, 0x7a, 0xe3,
-	0xcc, 0xa1, 0xb7, 0x06, 0xd3, 0x54, 0xf7, 0x2c, 0x4c, 0xc3, 0x10, 0x13, 0x59, 0xc3, 0x57, 0x83,
-	0x20, 0xbb, 0x0b, 0x59, 0xbd, 0x4d, 0x0f, 0x5c, 0xcf, 0xa6, 0xc7, 0x3c, 0x46, 0xb7, 0xd4, 0x4f,
-	0x3e, 0x5c, 0x9f, 0x15, 0x5a, 0x04, 0xdb, 0x1e, 0xf5, 0x6c, 0xc7, 0xaa, 0x76, 0x59, 0x37, 0xd1,
-	0x3f, 0x7f, 0xba, 0xa4, 0xf8, 0xd8, 0xbb, 0x6b, 0xab, 0xd7, 0x61, 0x45, 0x82, 0x47, 0xa0, 0xfe,
-	0xa4, 0x17, 0xf5, 0x0e, 0x8e, 0x47, 0x5d, 0x1f, 0x1d, 0x75, 0x51, 0x5c, 0x31, 0xb7, 0x46, 0x7c,
-	0x13, 0x43, 0x07, 0x45, 0x90, 0xa7, 0xc6, 0x87, 0x7c, 0x10, 0x93, 0x40, 0xfe, 0xc3, 0x14, 0xac,
-	0x56, 0x88, 0xf5, 0xf5, 0x96, 0x29, 0x4a, 0xdf, 0x68, 0x80, 0xca, 0x4b, 0x8d, 0x37, 0x41, 0xe3,
-	0x65, 0x7f, 0x2d, 0x2e, 0xea, 0x53, 0x2c, 0xea, 0x55, 0xce, 0x31, 0xb8, 0x35, 0xba, 0x0b, 0xd7,
-	0x74, 0xd3, 0x8c, 0x15, 0x9d, 0x60, 0xa2, 0x57, 0x74, 0xd3, 0x8c, 0x91, 0xbb, 0x0f, 0x28, 0xc8,
-	0xc5, 0x5a, 0xd7, 0x59, 0xe9, 0x21, 0xce, 0x9a, 0x09, 0x64, 0xca, 0xa1, 0xd3, 0xe6, 0x03, 0xa7,
-	0xc5, 0xec, 0xb7, 0xba, 0xc6, 0x6e, 0xe1, 0x64, 0xbf, 0x08, 0xff, 0xfd, 0x4a, 0x81, 0xc5, 0x90,
-	0x2f, 0x7a, 0x1b, 0xc8, 0x7d, 0x97, 0x78, 0xbd, 0xa4, 0x92, 0xaf, 0x97, 0x71, 0xe6, 0xc5, 0x0a,
-	0x2c, 0x25, 0xda, 0x2d, 0xb0, 0xbd, 0xc7, 0x27, 0x51, 0x7b, 0x98, 0x96, 0x0d, 0xc3, 0x0f, 0xcf,
-	0x9d, 0x9e, 0x67, 0x37, 0x1e, 0xd5, 0x2c, 0x4c, 0x76, 0xf4, 0x46, 0x1b, 0x8b, 0xbc, 0xe6, 0x1f,
-	0xe8, 0x0e, 0x64, 0x88, 0x6d, 0x39, 0xc1, 0x83, 0x23, 0x31, 0x5a, 0xf0, 0x6d, 0x5e, 0x0c, 0x2c,
-	0x16, 0x0b, 0x62, 0x8e, 0xd4, 0x6f, 0x8a, 0x30, 0xf4, 0x5f, 0x0a, 0xbc, 0x1c, 0x82, 0xd9, 0xc3,
-	0x8e, 0xb9, 0x83, 0x9d, 0x63, 0xff, 0x85, 0x90, 0x1b, 0x7b, 0x17, 0xae, 0x89, 0xf0, 0x35, 0xb1,
-	0x63, 0x77, 0x5b, 0xda, 0x30, 0x76, 0xaf, 0x70, 0xf2, 0x0e, 0xa3, 0x96, 0x03, 0x22, 0xba, 0x03,
-	0xb3, 0x7e, 0xe0, 0x0e, 0x08, 0xf1, 0xa8, 0x45, 0xba, 0x69, 0xf6, 0x4b, 0x44, 0x0e, 0x2e, 0x7d,
-	0xb6, 0x83, 0x5b, 0x82, 0x85, 0x04, 0xac, 0xc2, 0x1b, 0xbf, 0x56, 0x58, 0x81, 0x51, 0x36, 0xcd,
-	0xaf, 0x61, 0x5a, 0x26, 0x04, 0xd3, 0x6f, 0xf8, 0xa7, 0x30, 0x96, 0xfe, 0x7f, 0x0f, 0x2e, 0x39,
-	0xfe, 0xed, 0xed, 0xef, 0x5a, 0x63, 0x87, 0x1b, 0x4c, 0x33, 0xae, 0xc7, 0x3f, 0xe0, 0x11, 0x13,
-	0xc4, 0x6b, 0x30, 0xed, 0x44, 0xec, 0x8a, 0x2d, 0x92, 0x16, 0xd9, 0x89, 0xc6, 0x60, 0x10, 0x20,
-	0x7f, 0xab, 0xb0, 0x7b, 0xcb, 0x0f, 0x88, 0x5e, 0xb9, 0xfe, 0x3b, 0x3b, 0x1e, 0x6b, 0x77, 0x12,
-	0x93, 0x3a, 0xd5, 0x24, 0x66, 0xac, 0x89, 0xc8, 0x2f, 0x9a, 0x64, 0x20, 0x02, 0xf0, 0x2f, 0x15,
-	0x58, 0xab, 0x10, 0xab, 0xca, 0x22, 0xf2, 0x14, 0x98, 0x63, 0x26, 0x37, 0x3c, 0xc8, 0xfb, 0x26,
-	0x37, 0x63, 0xc5, 0x96, 0x87, 0x9b, 0xc3, 0x6c, 0x16, 0xf0, 0x7e, 0xc3, 0xef, 0xd1, 0xed, 0x03,
-	0xdd, 0xb1, 0x30, 0x1f, 0xae, 0x8e, 0x86, 0xab, 0x0c, 0xe0, 0xe0, 0xa3, 0x9a, 0x98, 0xdc, 0xa6,
-	0x46, 0x9e, 0xdc, 0x66, 0x1d, 0x7c, 0xc4, 0x7f, 0x7c, 0x01, 0xd7, 0x6a, 0x3c, 0x0c, 0x01, 0xf5,
-	0xfd, 0x14, 0x2b, 0x36, 0x82, 0x6e, 0xf6, 0x2d, 0x62, 0x78, 0xee, 0xd1, 0x68, 0x60, 0x8d, 0xb0,
-	0x04, 0x49, 0x0d, 0x6b, 0xcb, 0xef, 0x9c, 0xb4, 0x2d, 0x97, 0x14, 0x69, 0x13, 0x43, 0x8b, 0xb4,
-	0xf4, 0x38, 0x4a, 0x95, 0x24, 0x8f, 0x08, 0xbf, 0x3d, 0x0b, 0x53, 0x3e, 0xd2, 0x38, 0xf5, 0x7b,
-	0xee, 0x33, 0xea, 0x07, 0x4f, 0x5b, 0xb9, 0x4d, 0x27, 0x5d, 0x07, 0x09, 0x20, 0x85, 0x33, 0x7e,
-	0xc2, 0xe7, 0xbb, 0xfc, 0x19, 0x78, 0xa8, 0x7b, 0x7a, 0x33, 0xbc, 0xdf, 0x23, 0x96, 0x28, 0x23,
-	0x5b, 0x82, 0x36, 0x21, 0xd3, 0x62, 0x1b, 0x31, 0xf3, 0x73, 0xa5, 0x97, 0xe3, 0xb3, 0x88, 0x2b,
-	0x0b, 0x2e, 0x44, 0x2e, 0x31, 0x80, 0x82, 0x8f, 0x7a, 0xa3, 0xd6, 0x71, 0xcb, 0x4b, 0xff, 0x9e,
-	0x83, 0x89, 0x0a, 0xb1, 0x50, 0x0d, 0xa6, 0x82, 0x5e, 0x04, 0xe5, 0x13, 0x12, 0x76, 0x60, 0x24,
-	0xac, 0xbd, 0x32, 0x02, 0x27, 0x57, 0xe4, 0x2b, 0x08, 0x9a, 0x1c, 0x89, 0x82, 0xbe, 0xb1, 0xaf,
-	0x44, 0x41, 0xff, 0xe8, 0x16, 0x7d, 0x0b, 0x32, 0x7c, 0xa6, 0x8a, 0x6e, 0x26, 0x0a, 0x45, 0x06,
-	0xbb, 0xda, 0xad, 0xa1, 0x7c, 0xdd, 0xad, 0xf9, 0xd4, 0x54, 0xb2, 0x75, 0x64, 0x74, 0x2b, 0xd9,
-	0x3a, 0x3a, 0x7e, 0x45, 0x7b, 0x90, 0xae, 0xd8, 0x0e, 0x45, 0x37, 0x12, 0x05, 0x7a, 0x26, 0xb3,
-	0xda, 0xda, 0x10, 0xae, 0xee, 0xa6, 0x5b, 0x6d, 0xcf, 0x91, 0x6c, 0xda, 0x33, 0x55, 0x95, 0x6c,
-	0xda, 0x3b, 0xf6, 0x44, 0x75, 0xc8, 0x86, 0xbf, 0xd8, 0x40, 0x92, 0x73, 0xe9, 0xfb, 0x25, 0x8d,
-	0x76, 0x7b, 0x14, 0x56, 0xa1, 0xe3, 0x10, 0xce, 0xf7, 0xfe, 0x42, 0x02, 0xbd, 0x3a, 0xc4, 0x8d,
-	0x51, 0x4d, 0xeb, 0x23, 0x72, 0x77, 0x23, 0x32, 0xb8, 0xe3, 0x24, 0x11, 0xd9, 0x37, 0xe6, 0x95,
-	0x44, 0x64, 0xff, 0x40, 0x54, 0x78, 0x8c, 0xbf, 0x73, 0x72, 0x8f, 0x45, 0x66, 0x49, 0x72, 0x8f,
-	0x45, 0x27, 0x04, 0x3e, 0x88, 0xb0, 0x21, 0x49, 0x06, 0xd1, 0xd7, 0x04, 0x49, 0x40, 0xf4, 0xb7,
-	0x1d, 0xe8, 0x00, 0x72, 0x3d, 0x63, 0x40, 0xf4, 0xb9, 0x44, 0xc9, 0xc1, 0xa1, 0xa8, 0xf6, 0xea,
-	0x68, 0xcc, 0x42, 0xd3, 0x11, 0x5c, 0xea, 0xbf, 0x68, 0xd1, 0x9d, 0xc4, 0x1d, 0x12, 0x06, 0x90,
-	0xda, 0xc6, 0x09, 0x24, 0x84, 0xe2, 0x47, 0x30, 0x1d, 0xfd, 0x95, 0x38, 0x2a, 0x24, 0x6e, 0x12,
-	0xfb, 0x87, 0x00, 0x5a, 0x71, 0x64, 0x7e, 0xa1, 0xf2, 0x03, 0x05, 0xe6, 0x12, 0xc7, 0x3f, 0xe8,
-	0x0d, 0x59, 0x00, 0x48, 0xe7, 0x90, 0xda, 0xe6, 0x69, 0x44, 0x85, 0x51, 0xef, 0x29, 0x70, 0x35,
-	0x7e, 0x34, 0x83, 0xee, 0x26, 0x7b, 0x55, 0x36, 0x9b, 0xd2, 0x5e, 0x3f, 0xb1, 0xdc, 0x80, 0x2d,
-	0xfd, 0xc3, 0x92, 0xa1, 0xb6, 0x24, 0x4c, 0x8c, 0x86, 0xda, 0x92, 0x34, 0x95, 0x41, 0xdf, 0x57,
-	0x40, 0x4d, 0x1a, 0x3d, 0xa0, 0x7b, 0x89, 0xbb, 0x0e, 0x99, 0xe2, 0x68, 0x6f, 0x9c, 0x42, 0x52,
-	0x58, 0xf4, 0xae, 0x02, 0xb3, 0x71, 0xc3, 0x02, 0xf4, 0xf9, 0x21, 0x7b, 0xc6, 0xce, 0x44, 0xb4,
-	0x2f, 0x9c, 0x50, 0xaa, 0x9b, 0x37, 0xd1, 0x11, 0x80, 0x24, 0x6f, 0x62, 0xc7, 0x16, 0x92, 0xbc,
-	0x89, 0x9f, 0x2d, 0xa0, 0xef, 0x00, 0x1a, 0xec, 0xb5, 0x51, 0x69, 0x88, 0xfd, 0x31, 0x43, 0x08,
-	0xed, 0xb5, 0x13, 0xc9, 0x08, 0xf5, 0xef, 0xc0, 0xcc, 0x40, 0x13, 0x8c, 0x36, 0x64, 0x29, 0x17,
-	0xdb, 0xf4, 0x6b, 0xa5, 0x93, 0x88, 0xf4, 0x44, 0x61, 0x52, 0x5f, 0x2a, 0x89, 0xc2, 0x21, 0x3d,
-	0xb9, 0x24, 0x0a, 0x87, 0x35, 0xc1, 0xe8, 0x47, 0x0a, 0xcc, 0x4b, 0xba, 0x49, 0xf4, 0xc5, 0xc4,
-	0xad, 0x87, 0xf7, 0xcd, 0xda, 0x9b, 0xa7, 0x13, 0xee, 0x49, 0x90, 0xb8, 0xb6, 0x4f, 0x92, 0x20,
-	0x92, 0x66, 0x57, 0x92, 0x20, 0xb2, 0xde, 0x92, 0x5d, 0x62, 0xf1, 0x6d, 0x94, 0xe4, 0x12, 0x93,
-	0x76, 0xa2, 0x92, 0x4b, 0x4c, 0xde, 0xaf, 0x05, 0xe1, 0x13, 0xdb, 0xc7, 0xc8, 0xc3, 0x47, 0xd6,
-	0xdf, 0xc9, 0xc3, 0x47, 0xda, 0x34, 0xf9, 0xc5, 0x5e, 0x6f, 0x4b, 0x22, 0x29, 0xf6, 0x62, 0xfa,
-	0x2a, 0x49, 0xb1, 0x17, 0xd7, 0xe7, 0x68, 0x93, 0xdf, 0x7d, 0xfe, 0xe4, 0xb6, 0xb2, 0x65, 0x7d,
-	0xf4, 0x74, 0x51, 0xf9, 0xf8, 0xe9, 0xa2, 0xf2, 0xf7, 0xa7, 0x8b, 0xca, 0xfb, 0xcf, 0x16, 0xcf,
-	0x7d, 0xfc, 0x6c, 0xf1, 0xdc, 0x5f, 0x9e, 0x2d, 0x9e, 0x83, 0x6b, 0xb6, 0x1b, 0xbb, 0xe3, 0x43,
-	0xe5, 0xdb, 0xbd, 0xad, 0x68, 0x97, 0x65, 0xdd, 0x76, 0x7b, 0xbe, 0x8a, 0x8f, 0x83, 0x3f, 0x23,
-	0x64, 0x3d, 0x69, 0x3d, 0xc3, 0xfe, 0x52, 0xef, 0xb5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x2e, 0xee, 0x73, 0x9f, 0x29, 0x00, 0x00,
+func (m *MsgCreateDistributionRequest) Reset()         { *m = MsgCreateDistributionRequest{} }
+func (m *MsgCreateDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDistributionRequest) ProtoMessage()    {}
+func (*MsgCreateDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{56}
 }
-
-func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgCreateDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(&that1.Amount) {
-		return false
-	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
+}
+func (m *MsgCreateDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDistributionRequest.Merge(m, src)
+}
+func (m *MsgCreateDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDistributionRequest proto.InternalMessageInfo
+
+func (m *MsgCreateDistributionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Authority != that1.Authority {
-		return false
+	return ""
+}
+
+func (m *MsgCreateDistributionRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return true
+	return nil
 }
-func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgCreateDistributionRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgCreateDistributionResponse defines the Msg/CreateDistribution response type
+type MsgCreateDistributionResponse struct {
+	// distribution_id is the id of the newly created distribution.
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+}
+
+func (m *MsgCreateDistributionResponse) Reset()         { *m = MsgCreateDistributionResponse{} }
+func (m *MsgCreateDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDistributionResponse) ProtoMessage()    {}
+func (*MsgCreateDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{57}
+}
+func (m *MsgCreateDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsgCreateDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDistributionResponse.Merge(m, src)
+}
+func (m *MsgCreateDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDistributionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDistributionResponse) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgSetLockupRequest defines the Msg/SetLockup request type
+type MsgSetLockupRequest struct {
+	// denom is the marker denom to lock.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the account whose funds are locked.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// releases is the schedule of amounts that become unlocked. It replaces any existing schedule for the account.
+	Releases []LockupRelease `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetLockupRequest) Reset()         { *m = MsgSetLockupRequest{} }
+func (m *MsgSetLockupRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupRequest) ProtoMessage()    {}
+func (*MsgSetLockupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{58}
+}
+func (m *MsgSetLockupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
+}
+func (m *MsgSetLockupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupRequest.Merge(m, src)
+}
+func (m *MsgSetLockupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLockupRequest proto.InternalMessageInfo
+
+func (m *MsgSetLockupRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
-		return false
+	return ""
+}
+
+func (m *MsgSetLockupRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	for i := range this.RemoveRequiredAttributes {
-		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *MsgSetLockupRequest) GetReleases() []LockupRelease {
+	if m != nil {
+		return m.Releases
 	}
-	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
-		return false
+	return nil
+}
+
+func (m *MsgSetLockupRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	for i := range this.AddRequiredAttributes {
-		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
-			return false
+	return ""
+}
+
+// MsgSetLockupResponse defines the Msg/SetLockup response type
+type MsgSetLockupResponse struct {
+}
+
+func (m *MsgSetLockupResponse) Reset()         { *m = MsgSetLockupResponse{} }
+func (m *MsgSetLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupResponse) ProtoMessage()    {}
+func (*MsgSetLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{59}
+}
+func (m *MsgSetLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.TransferAuthority != that1.TransferAuthority {
-		return false
-	}
-	return true
 }
-func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupResponse.Merge(m, src)
+}
+func (m *MsgSetLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgUpdateForcedTransferRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateForcedTransferRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+var xxx_messageInfo_MsgSetLockupResponse proto.InternalMessageInfo
+
+// MsgRemoveLockupRequest defines the Msg/RemoveLockup request type
+type MsgRemoveLockupRequest struct {
+	// denom is the marker denom of the lockup.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the account whose lockup is being removed.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
-func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgSetAccountDataRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAccountDataRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgRemoveLockupRequest) Reset()         { *m = MsgRemoveLockupRequest{} }
+func (m *MsgRemoveLockupRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockupRequest) ProtoMessage()    {}
+func (*MsgRemoveLockupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{60}
+}
+func (m *MsgRemoveLockupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
+}
+func (m *MsgRemoveLockupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockupRequest.Merge(m, src)
+}
+func (m *MsgRemoveLockupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLockupRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveLockupRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Signer != that1.Signer {
-		return false
+	return ""
+}
+
+func (m *MsgRemoveLockupRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return true
+	return ""
 }
-func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgRemoveLockupRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgUpdateSendDenyListRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateSendDenyListRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgRemoveLockupResponse defines the Msg/RemoveLockup response type
+type MsgRemoveLockupResponse struct {
+}
+
+func (m *MsgRemoveLockupResponse) Reset()         { *m = MsgRemoveLockupResponse{} }
+func (m *MsgRemoveLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockupResponse) ProtoMessage()    {}
+func (*MsgRemoveLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{61}
+}
+func (m *MsgRemoveLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
-		return false
-	}
-	for i := range this.RemoveDeniedAddresses {
-		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
-			return false
+}
+func (m *MsgRemoveLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockupResponse.Merge(m, src)
+}
+func (m *MsgRemoveLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLockupResponse proto.InternalMessageInfo
+
+// MsgSplitMarkerRequest defines the Msg/SplitMarker request type
+type MsgSplitMarkerRequest struct {
+	// denom is the marker denom to split.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// numerator is the number of new units each holder receives for every denominator units held.
+	Numerator uint64 `protobuf:"varint,2,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	// denominator is the number of units held that are replaced by numerator units.
+	// A denominator larger than the numerator is a reverse split.
+	Denominator uint64 `protobuf:"varint,3,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSplitMarkerRequest) Reset()         { *m = MsgSplitMarkerRequest{} }
+func (m *MsgSplitMarkerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitMarkerRequest) ProtoMessage()    {}
+func (*MsgSplitMarkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{62}
+}
+func (m *MsgSplitMarkerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitMarkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitMarkerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
-		return false
+}
+func (m *MsgSplitMarkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitMarkerRequest.Merge(m, src)
+}
+func (m *MsgSplitMarkerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitMarkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitMarkerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitMarkerRequest proto.InternalMessageInfo
+
+func (m *MsgSplitMarkerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	for i := range this.AddDeniedAddresses {
-		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *MsgSplitMarkerRequest) GetNumerator() uint64 {
+	if m != nil {
+		return m.Numerator
 	}
-	if this.Authority != that1.Authority {
-		return false
+	return 0
+}
+
+func (m *MsgSplitMarkerRequest) GetDenominator() uint64 {
+	if m != nil {
+		return m.Denominator
 	}
-	return true
+	return 0
 }
-func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSplitMarkerRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgSetAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgSetAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgSplitMarkerResponse defines the Msg/SplitMarker response type
+type MsgSplitMarkerResponse struct {
+	// supply is the marker's supply after the split.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+}
+
+func (m *MsgSplitMarkerResponse) Reset()         { *m = MsgSplitMarkerResponse{} }
+func (m *MsgSplitMarkerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitMarkerResponse) ProtoMessage()    {}
+func (*MsgSplitMarkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{63}
+}
+func (m *MsgSplitMarkerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitMarkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitMarkerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.Access) != len(that1.Access) {
-		return false
-	}
-	for i := range this.Access {
-		if !this.Access[i].Equal(&that1.Access[i]) {
-			return false
+}
+func (m *MsgSplitMarkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitMarkerResponse.Merge(m, src)
+}
+func (m *MsgSplitMarkerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitMarkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitMarkerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitMarkerResponse proto.InternalMessageInfo
+
+// MsgSetRedemptionConfigRequest defines the Msg/SetRedemptionConfig request type
+type MsgSetRedemptionConfigRequest struct {
+	// denom is the marker denom to configure.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// config is the new redemption configuration. If not provided, redemptions of the denom are disabled.
+	Config *RedemptionConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetRedemptionConfigRequest) Reset()         { *m = MsgSetRedemptionConfigRequest{} }
+func (m *MsgSetRedemptionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionConfigRequest) ProtoMessage()    {}
+func (*MsgSetRedemptionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{64}
+}
+func (m *MsgSetRedemptionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Authority != that1.Authority {
-		return false
+}
+func (m *MsgSetRedemptionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionConfigRequest.Merge(m, src)
+}
+func (m *MsgSetRedemptionConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRedemptionConfigRequest proto.InternalMessageInfo
+
+func (m *MsgSetRedemptionConfigRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgSetRedemptionConfigRequest) GetConfig() *RedemptionConfig {
+	if m != nil {
+		return m.Config
 	}
+	return nil
+}
 
-	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
-		return false
-	}
-	for i := range this.RemovedAddress {
-		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
-			return false
-		}
-	}
-	if this.Authority != that1.Authority {
-		return false
+func (m *MsgSetRedemptionConfigRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return true
+	return ""
 }
-func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgChangeStatusProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgChangeStatusProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.NewStatus != that1.NewStatus {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+// MsgSetRedemptionConfigResponse defines the Msg/SetRedemptionConfig response type
+type MsgSetRedemptionConfigResponse struct {
 }
-func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
-	if !ok {
-		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
-	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+func (m *MsgSetRedemptionConfigResponse) Reset()         { *m = MsgSetRedemptionConfigResponse{} }
+func (m *MsgSetRedemptionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRedemptionConfigResponse) ProtoMessage()    {}
+func (*MsgSetRedemptionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{65}
+}
+func (m *MsgSetRedemptionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRedemptionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRedemptionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
+}
+func (m *MsgSetRedemptionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRedemptionConfigResponse.Merge(m, src)
+}
+func (m *MsgSetRedemptionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRedemptionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRedemptionConfigResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRedemptionConfigResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Finalize
-	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
-	// Delete
-	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	// Burn
-	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues set the net asset value for a marker
-	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgRedeemRequest defines the Msg/Redeem request type
+type MsgRedeemRequest struct {
+	// amount is the units of the marker's denom to redeem.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// redeemer is the account redeeming the units.
+	Redeemer string `protobuf:"bytes,2,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRedeemRequest) Reset()         { *m = MsgRedeemRequest{} }
+func (m *MsgRedeemRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemRequest) ProtoMessage()    {}
+func (*MsgRedeemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{66}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRedeemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
-	out := new(MsgFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRedeemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemRequest.Merge(m, src)
+}
+func (m *MsgRedeemRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
-	out := new(MsgActivateResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRedeemRequest proto.InternalMessageInfo
+
+func (m *MsgRedeemRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
-	out := new(MsgCancelResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemRequest) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
-	out := new(MsgDeleteResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRedeemResponse defines the Msg/Redeem response type
+type MsgRedeemResponse struct {
+	// redemption_id is the id assigned to the redemption.
+	RedemptionId uint64 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// payout is the amount paid to the redeemer. It is empty if the redemption requires approval.
+	Payout github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=payout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payout"`
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemResponse) Reset()         { *m = MsgRedeemResponse{} }
+func (m *MsgRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemResponse) ProtoMessage()    {}
+func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{67}
+}
+func (m *MsgRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemResponse.Merge(m, src)
+}
+func (m *MsgRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemResponse) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
-	out := new(MsgAddAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemResponse) GetPayout() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payout
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
-	out := new(MsgDeleteAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgApproveRedemptionRequest defines the Msg/ApproveRedemption request type
+type MsgApproveRedemptionRequest struct {
+	// denom is the marker denom being redeemed.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// redemption_id is the id of the redemption to approve.
+	RedemptionId uint64 `protobuf:"varint,2,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// The signer of the message.  Must have withdraw authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgApproveRedemptionRequest) Reset()         { *m = MsgApproveRedemptionRequest{} }
+func (m *MsgApproveRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRedemptionRequest) ProtoMessage()    {}
+func (*MsgApproveRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{68}
 }
-
-func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
-	out := new(MsgAddMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRedemptionRequest.Merge(m, src)
+}
+func (m *MsgApproveRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRedemptionRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
-	out := new(MsgTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveRedemptionRequest proto.InternalMessageInfo
+
+func (m *MsgApproveRedemptionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
-	out := new(MsgIbcTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveRedemptionRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
-	out := new(MsgSetDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveRedemptionRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
-	out := new(MsgGrantAllowanceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgApproveRedemptionResponse defines the Msg/ApproveRedemption response type
+type MsgApproveRedemptionResponse struct {
+	// payout is the amount paid to the redeemer.
+	Payout github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=payout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payout"`
 }
 
-func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	out := new(MsgAddFinalizeActivateMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveRedemptionResponse) Reset()         { *m = MsgApproveRedemptionResponse{} }
+func (m *MsgApproveRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRedemptionResponse) ProtoMessage()    {}
+func (*MsgApproveRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{69}
+}
+func (m *MsgApproveRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRedemptionResponse.Merge(m, src)
+}
+func (m *MsgApproveRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRedemptionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
-	out := new(MsgSupplyIncreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgApproveRedemptionResponse) GetPayout() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payout
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
-	out := new(MsgSupplyDecreaseProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectRedemptionRequest defines the Msg/RejectRedemption request type
+type MsgRejectRedemptionRequest struct {
+	// denom is the marker denom being redeemed.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// redemption_id is the id of the redemption to reject.
+	RedemptionId uint64 `protobuf:"varint,2,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// The signer of the message.  Must have withdraw authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
-	out := new(MsgUpdateRequiredAttributesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectRedemptionRequest) Reset()         { *m = MsgRejectRedemptionRequest{} }
+func (m *MsgRejectRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionRequest) ProtoMessage()    {}
+func (*MsgRejectRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{70}
+}
+func (m *MsgRejectRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionRequest.Merge(m, src)
+}
+func (m *MsgRejectRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
-	out := new(MsgUpdateForcedTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRejectRedemptionRequest proto.InternalMessageInfo
+
+func (m *MsgRejectRedemptionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
-	out := new(MsgSetAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectRedemptionRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
-	out := new(MsgUpdateSendDenyListResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectRedemptionRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
-	out := new(MsgAddNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectRedemptionResponse defines the Msg/RejectRedemption response type
+type MsgRejectRedemptionResponse struct {
 }
 
-func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
-	out := new(MsgSetAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectRedemptionResponse) Reset()         { *m = MsgRejectRedemptionResponse{} }
+func (m *MsgRejectRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRedemptionResponse) ProtoMessage()    {}
+func (*MsgRejectRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{71}
+}
+func (m *MsgRejectRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRedemptionResponse.Merge(m, src)
+}
+func (m *MsgRejectRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRedemptionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
-	out := new(MsgRemoveAdministratorProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRejectRedemptionResponse proto.InternalMessageInfo
+
+// MsgSetTransferLimitRequest defines the Msg/SetTransferLimit request type
+type MsgSetTransferLimitRequest struct {
+	// limit is the transfer limit to set. If its address is empty, it is the marker's default limit.
+	Limit TransferLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
-	out := new(MsgChangeStatusProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferLimitRequest) Reset()         { *m = MsgSetTransferLimitRequest{} }
+func (m *MsgSetTransferLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferLimitRequest) ProtoMessage()    {}
+func (*MsgSetTransferLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{72}
+}
+func (m *MsgSetTransferLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetTransferLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferLimitRequest.Merge(m, src)
+}
+func (m *MsgSetTransferLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferLimitRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
-	out := new(MsgWithdrawEscrowProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTransferLimitRequest proto.InternalMessageInfo
+
+func (m *MsgSetTransferLimitRequest) GetLimit() TransferLimit {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return TransferLimit{}
 }
 
-func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
-	out := new(MsgSetDenomMetadataProposalResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetTransferLimitResponse defines the Msg/SetTransferLimit response type
+type MsgSetTransferLimitResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Finalize
-	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
-	// Delete
-	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
-	// Burn
-	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
-	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
-	// The relayer is still needed to accomplish ibc middleware relays.
-	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
-	// GrantAllowance grants fee allowance to the grantee on the granter's
-	// account with the provided expiration time.
-	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
-	// AddFinalizeActivateMarker
-	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
-	// SupplyIncreaseProposal can only be called via gov proposal
-	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
-	// SupplyDecreaseProposal can only be called via gov proposal
-	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
-	// UpdateRequiredAttributes will only succeed if signer has transfer authority
-	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
-	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
-	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
-	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
-	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
-	// UpdateSendDenyList will only succeed if signer has admin authority
-	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
-	// AddNetAssetValues set the net asset value for a marker
-	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
-	// SetAdministratorProposal sets administrators with specific access on the marker
-	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
-	// RemoveAdministratorProposal removes administrators with specific access on the marker
-	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
-	// ChangeStatusProposal is a governance proposal change marker status
-	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
-	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
-	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
-	// SetDenomMetadataProposal is a governance proposal to set marker metadata
-	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetTransferLimitResponse) Reset()         { *m = MsgSetTransferLimitResponse{} }
+func (m *MsgSetTransferLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferLimitResponse) ProtoMessage()    {}
+func (*MsgSetTransferLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{73}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetTransferLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+func (m *MsgSetTransferLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+func (m *MsgSetTransferLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferLimitResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+func (m *MsgSetTransferLimitResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *MsgSetTransferLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferLimitResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+
+var xxx_messageInfo_MsgSetTransferLimitResponse proto.InternalMessageInfo
+
+// MsgRemoveTransferLimitRequest defines the Msg/RemoveTransferLimit request type
+type MsgRemoveTransferLimitRequest struct {
+	// denom is the marker denom of the limit.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the account whose limit is removed. If empty, the marker's default limit is removed.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+
+func (m *MsgRemoveTransferLimitRequest) Reset()         { *m = MsgRemoveTransferLimitRequest{} }
+func (m *MsgRemoveTransferLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTransferLimitRequest) ProtoMessage()    {}
+func (*MsgRemoveTransferLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{74}
 }
-func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+func (m *MsgRemoveTransferLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+func (m *MsgRemoveTransferLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTransferLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgRemoveTransferLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTransferLimitRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+func (m *MsgRemoveTransferLimitRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+func (m *MsgRemoveTransferLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTransferLimitRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
+
+var xxx_messageInfo_MsgRemoveTransferLimitRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveTransferLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+
+func (m *MsgRemoveTransferLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
+
+func (m *MsgRemoveTransferLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+
+// MsgRemoveTransferLimitResponse defines the Msg/RemoveTransferLimit response type
+type MsgRemoveTransferLimitResponse struct {
 }
-func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+
+func (m *MsgRemoveTransferLimitResponse) Reset()         { *m = MsgRemoveTransferLimitResponse{} }
+func (m *MsgRemoveTransferLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTransferLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveTransferLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{75}
 }
-func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+func (m *MsgRemoveTransferLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+func (m *MsgRemoveTransferLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTransferLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+func (m *MsgRemoveTransferLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTransferLimitResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+func (m *MsgRemoveTransferLimitResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+func (m *MsgRemoveTransferLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTransferLimitResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+
+var xxx_messageInfo_MsgRemoveTransferLimitResponse proto.InternalMessageInfo
+
+// MsgProposeRecoveryRequest defines the Msg/ProposeRecovery request type
+type MsgProposeRecoveryRequest struct {
+	// amount is the units of the marker's denom to recover.
+	Amount types1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// from_address is the account the funds are taken from.
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the account the funds are given to.
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// reason is why the funds are being recovered.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The signer of the message.  Must have force transfer authority to marker.
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
-func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+
+func (m *MsgProposeRecoveryRequest) Reset()         { *m = MsgProposeRecoveryRequest{} }
+func (m *MsgProposeRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRecoveryRequest) ProtoMessage()    {}
+func (*MsgProposeRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{76}
 }
-func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+func (m *MsgProposeRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+func (m *MsgProposeRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+func (m *MsgProposeRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRecoveryRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+func (m *MsgProposeRecoveryRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgProposeRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRecoveryRequest.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgProposeRecoveryRequest proto.InternalMessageInfo
 
-func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Finalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Finalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+func (m *MsgProposeRecoveryRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Activate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Activate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+func (m *MsgProposeRecoveryRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Cancel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Cancel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+func (m *MsgProposeRecoveryRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+func (m *MsgProposeRecoveryRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+func (m *MsgProposeRecoveryRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgProposeRecoveryResponse defines the Msg/ProposeRecovery response type
+type MsgProposeRecoveryResponse struct {
+	// recovery_id is the id assigned to the recovery.
+	RecoveryId uint64 `protobuf:"varint,1,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
 }
 
-func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+func (m *MsgProposeRecoveryResponse) Reset()         { *m = MsgProposeRecoveryResponse{} }
+func (m *MsgProposeRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRecoveryResponse) ProtoMessage()    {}
+func (*MsgProposeRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{77}
+}
+func (m *MsgProposeRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProposeRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRecoveryResponse.Merge(m, src)
+}
+func (m *MsgProposeRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRecoveryResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+var xxx_messageInfo_MsgProposeRecoveryResponse proto.InternalMessageInfo
+
+func (m *MsgProposeRecoveryResponse) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelRecoveryRequest defines the Msg/CancelRecovery request type
+type MsgCancelRecoveryRequest struct {
+	// denom is the marker denom being recovered.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recovery_id is the id of the recovery to cancel.
+	RecoveryId uint64 `protobuf:"varint,2,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	// The signer of the message.  Must be the account the funds would be taken from, or the recovery's proposer.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+func (m *MsgCancelRecoveryRequest) Reset()         { *m = MsgCancelRecoveryRequest{} }
+func (m *MsgCancelRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecoveryRequest) ProtoMessage()    {}
+func (*MsgCancelRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{78}
+}
+func (m *MsgCancelRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecoveryRequest.Merge(m, src)
+}
+func (m *MsgCancelRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecoveryRequest.DiscardUnknown(m)
 }
 
-func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Transfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Transfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+var xxx_messageInfo_MsgCancelRecoveryRequest proto.InternalMessageInfo
+
+func (m *MsgCancelRecoveryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIbcTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).IbcTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
+func (m *MsgCancelRecoveryRequest) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+func (m *MsgCancelRecoveryRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantAllowanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantAllowance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelRecoveryResponse defines the Msg/CancelRecovery response type
+type MsgCancelRecoveryResponse struct {
 }
 
-func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddFinalizeActivateMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
+func (m *MsgCancelRecoveryResponse) Reset()         { *m = MsgCancelRecoveryResponse{} }
+func (m *MsgCancelRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecoveryResponse) ProtoMessage()    {}
+func (*MsgCancelRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{79}
+}
+func (m *MsgCancelRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecoveryResponse.Merge(m, src)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecoveryResponse.DiscardUnknown(m)
 }
 
-func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyIncreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelRecoveryResponse proto.InternalMessageInfo
+
+// MsgExecuteRecoveryRequest defines the Msg/ExecuteRecovery request type
+type MsgExecuteRecoveryRequest struct {
+	// denom is the marker denom being recovered.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recovery_id is the id of the recovery to execute.
+	RecoveryId uint64 `protobuf:"varint,2,opt,name=recovery_id,json=recoveryId,proto3" json:"recovery_id,omitempty"`
+	// The signer of the message.  Must have force transfer authority to marker.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSupplyDecreaseProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+func (m *MsgExecuteRecoveryRequest) Reset()         { *m = MsgExecuteRecoveryRequest{} }
+func (m *MsgExecuteRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRecoveryRequest) ProtoMessage()    {}
+func (*MsgExecuteRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{80}
+}
+func (m *MsgExecuteRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExecuteRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRecoveryRequest.Merge(m, src)
+}
+func (m *MsgExecuteRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRecoveryRequest.DiscardUnknown(m)
 }
 
-func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRequiredAttributesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
+var xxx_messageInfo_MsgExecuteRecoveryRequest proto.InternalMessageInfo
+
+func (m *MsgExecuteRecoveryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateForcedTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
+func (m *MsgExecuteRecoveryRequest) GetRecoveryId() uint64 {
+	if m != nil {
+		return m.RecoveryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+func (m *MsgExecuteRecoveryRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSendDenyListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgExecuteRecoveryResponse defines the Msg/ExecuteRecovery response type
+type MsgExecuteRecoveryResponse struct {
 }
 
-func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddNetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+func (m *MsgExecuteRecoveryResponse) Reset()         { *m = MsgExecuteRecoveryResponse{} }
+func (m *MsgExecuteRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRecoveryResponse) ProtoMessage()    {}
+func (*MsgExecuteRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{81}
+}
+func (m *MsgExecuteRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExecuteRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRecoveryResponse.Merge(m, src)
+}
+func (m *MsgExecuteRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRecoveryResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgExecuteRecoveryResponse proto.InternalMessageInfo
+
+// MsgSetHolderLimitRequest defines the Msg/SetHolderLimit request type
+type MsgSetHolderLimitRequest struct {
+	// limit is the holder limit to set.
+	Limit HolderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAdministratorProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+func (m *MsgSetHolderLimitRequest) Reset()         { *m = MsgSetHolderLimitRequest{} }
+func (m *MsgSetHolderLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHolderLimitRequest) ProtoMessage()    {}
+func (*MsgSetHolderLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{82}
+}
+func (m *MsgSetHolderLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHolderLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHolderLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetHolderLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHolderLimitRequest.Merge(m, src)
+}
+func (m *MsgSetHolderLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHolderLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHolderLimitRequest.DiscardUnknown(m)
 }
 
-func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeStatusProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+var xxx_messageInfo_MsgSetHolderLimitRequest proto.InternalMessageInfo
+
+func (m *MsgSetHolderLimitRequest) GetLimit() HolderLimit {
+	if m != nil {
+		return m.Limit
 	}
-	return interceptor(ctx, in, info, handler)
+	return HolderLimit{}
 }
 
-func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawEscrowProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
+func (m *MsgSetHolderLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetHolderLimitResponse defines the Msg/SetHolderLimit response type
+type MsgSetHolderLimitResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+func (m *MsgSetHolderLimitResponse) Reset()         { *m = MsgSetHolderLimitResponse{} }
+func (m *MsgSetHolderLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHolderLimitResponse) ProtoMessage()    {}
+func (*MsgSetHolderLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{83}
+}
+func (m *MsgSetHolderLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHolderLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHolderLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetHolderLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHolderLimitResponse.Merge(m, src)
+}
+func (m *MsgSetHolderLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHolderLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHolderLimitResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Finalize",
-			Handler:    _Msg_Finalize_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Msg_Activate_Handler,
-		},
-		{
-			MethodName: "Cancel",
-			Handler:    _Msg_Cancel_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Msg_Delete_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "AddAccess",
-			Handler:    _Msg_AddAccess_Handler,
-		},
-		{
-			MethodName: "DeleteAccess",
-			Handler:    _Msg_DeleteAccess_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "AddMarker",
-			Handler:    _Msg_AddMarker_Handler,
-		},
-		{
-			MethodName: "Transfer",
-			Handler:    _Msg_Transfer_Handler,
-		},
-		{
-			MethodName: "IbcTransfer",
-			Handler:    _Msg_IbcTransfer_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadata",
-			Handler:    _Msg_SetDenomMetadata_Handler,
-		},
-		{
-			MethodName: "GrantAllowance",
-			Handler:    _Msg_GrantAllowance_Handler,
-		},
-		{
-			MethodName: "AddFinalizeActivateMarker",
-			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
-		},
-		{
-			MethodName: "SupplyIncreaseProposal",
-			Handler:    _Msg_SupplyIncreaseProposal_Handler,
-		},
-		{
-			MethodName: "SupplyDecreaseProposal",
-			Handler:    _Msg_SupplyDecreaseProposal_Handler,
-		},
-		{
-			MethodName: "UpdateRequiredAttributes",
-			Handler:    _Msg_UpdateRequiredAttributes_Handler,
-		},
-		{
-			MethodName: "UpdateForcedTransfer",
-			Handler:    _Msg_UpdateForcedTransfer_Handler,
-		},
-		{
-			MethodName: "SetAccountData",
-			Handler:    _Msg_SetAccountData_Handler,
-		},
-		{
-			MethodName: "UpdateSendDenyList",
-			Handler:    _Msg_UpdateSendDenyList_Handler,
-		},
-		{
-			MethodName: "AddNetAssetValues",
-			Handler:    _Msg_AddNetAssetValues_Handler,
-		},
-		{
-			MethodName: "SetAdministratorProposal",
-			Handler:    _Msg_SetAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "RemoveAdministratorProposal",
-			Handler:    _Msg_RemoveAdministratorProposal_Handler,
-		},
-		{
-			MethodName: "ChangeStatusProposal",
-			Handler:    _Msg_ChangeStatusProposal_Handler,
-		},
-		{
-			MethodName: "WithdrawEscrowProposal",
-			Handler:    _Msg_WithdrawEscrowProposal_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadataProposal",
-			Handler:    _Msg_SetDenomMetadataProposal_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/tx.proto",
-}
+var xxx_messageInfo_MsgSetHolderLimitResponse proto.InternalMessageInfo
 
-func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgRemoveHolderLimitRequest defines the Msg/RemoveHolderLimit request type
+type MsgRemoveHolderLimitRequest struct {
+	// denom is the marker denom of the limit.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveHolderLimitRequest) Reset()         { *m = MsgRemoveHolderLimitRequest{} }
+func (m *MsgRemoveHolderLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHolderLimitRequest) ProtoMessage()    {}
+func (*MsgRemoveHolderLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{84}
 }
-
-func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		{
-			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRemoveHolderLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHolderLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHolderLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveHolderLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHolderLimitRequest.Merge(m, src)
+}
+func (m *MsgRemoveHolderLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHolderLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHolderLimitRequest.DiscardUnknown(m)
 }
 
-func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveHolderLimitRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveHolderLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveHolderLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveHolderLimitResponse defines the Msg/RemoveHolderLimit response type
+type MsgRemoveHolderLimitResponse struct {
 }
 
-func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveHolderLimitResponse) Reset()         { *m = MsgRemoveHolderLimitResponse{} }
+func (m *MsgRemoveHolderLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHolderLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveHolderLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{85}
+}
+func (m *MsgRemoveHolderLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHolderLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHolderLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveHolderLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHolderLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveHolderLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHolderLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHolderLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveHolderLimitResponse proto.InternalMessageInfo
+
+// MsgSetAccountDataSchemaRequest defines the Msg/SetAccountDataSchema request type
+type MsgSetAccountDataSchemaRequest struct {
+	// denom is the marker denom of the schema.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// schema is the JSON schema that the marker's account data must conform to. An empty schema removes it.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The signer of the message.  Must have deposit authority to marker or be governance module account address.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x70
+func (m *MsgSetAccountDataSchemaRequest) Reset()         { *m = MsgSetAccountDataSchemaRequest{} }
+func (m *MsgSetAccountDataSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountDataSchemaRequest) ProtoMessage()    {}
+func (*MsgSetAccountDataSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{86}
+}
+func (m *MsgSetAccountDataSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountDataSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountDataSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgSetAccountDataSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountDataSchemaRequest.Merge(m, src)
+}
+func (m *MsgSetAccountDataSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountDataSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountDataSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountDataSchemaRequest proto.InternalMessageInfo
+
+func (m *MsgSetAccountDataSchemaRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccountDataSchemaRequest) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAccountDataSchemaRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetAccountDataSchemaResponse defines the Msg/SetAccountDataSchema response type
+type MsgSetAccountDataSchemaResponse struct {
 }
 
-func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccountDataSchemaResponse) Reset()         { *m = MsgSetAccountDataSchemaResponse{} }
+func (m *MsgSetAccountDataSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountDataSchemaResponse) ProtoMessage()    {}
+func (*MsgSetAccountDataSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{87}
+}
+func (m *MsgSetAccountDataSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountDataSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountDataSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAccountDataSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountDataSchemaResponse.Merge(m, src)
+}
+func (m *MsgSetAccountDataSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountDataSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountDataSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAccountDataSchemaResponse proto.InternalMessageInfo
+
+// MsgSetIbcChannelPolicyRequest defines the Msg/SetIbcChannelPolicy request type
+type MsgSetIbcChannelPolicyRequest struct {
+	// policy is the IBC channel policy to set.
+	Policy IbcChannelPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetIbcChannelPolicyRequest) Reset()         { *m = MsgSetIbcChannelPolicyRequest{} }
+func (m *MsgSetIbcChannelPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIbcChannelPolicyRequest) ProtoMessage()    {}
+func (*MsgSetIbcChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{88}
+}
+func (m *MsgSetIbcChannelPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIbcChannelPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIbcChannelPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetIbcChannelPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIbcChannelPolicyRequest.Merge(m, src)
+}
+func (m *MsgSetIbcChannelPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIbcChannelPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIbcChannelPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetIbcChannelPolicyRequest proto.InternalMessageInfo
+
+func (m *MsgSetIbcChannelPolicyRequest) GetPolicy() IbcChannelPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return IbcChannelPolicy{}
 }
 
-func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetIbcChannelPolicyRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetIbcChannelPolicyResponse defines the Msg/SetIbcChannelPolicy response type
+type MsgSetIbcChannelPolicyResponse struct {
 }
 
-func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIbcChannelPolicyResponse) Reset()         { *m = MsgSetIbcChannelPolicyResponse{} }
+func (m *MsgSetIbcChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIbcChannelPolicyResponse) ProtoMessage()    {}
+func (*MsgSetIbcChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{89}
+}
+func (m *MsgSetIbcChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIbcChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIbcChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetIbcChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIbcChannelPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetIbcChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIbcChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIbcChannelPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetIbcChannelPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveIbcChannelPolicyRequest defines the Msg/RemoveIbcChannelPolicy request type
+type MsgRemoveIbcChannelPolicyRequest struct {
+	// denom is the marker denom of the policy.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The signer of the message.  Must have admin authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedAddress) > 0 {
-		i -= len(m.RemovedAddress)
-		copy(dAtA[i:], m.RemovedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRemoveIbcChannelPolicyRequest) Reset()         { *m = MsgRemoveIbcChannelPolicyRequest{} }
+func (m *MsgRemoveIbcChannelPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIbcChannelPolicyRequest) ProtoMessage()    {}
+func (*MsgRemoveIbcChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{90}
 }
-
-func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveIbcChannelPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIbcChannelPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIbcChannelPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveIbcChannelPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIbcChannelPolicyRequest.Merge(m, src)
 }
-
-func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveIbcChannelPolicyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveIbcChannelPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIbcChannelPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgRemoveIbcChannelPolicyRequest proto.InternalMessageInfo
 
-func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRemoveIbcChannelPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveIbcChannelPolicyRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveIbcChannelPolicyResponse defines the Msg/RemoveIbcChannelPolicy response type
+type MsgRemoveIbcChannelPolicyResponse struct {
 }
 
-func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveIbcChannelPolicyResponse) Reset()         { *m = MsgRemoveIbcChannelPolicyResponse{} }
+func (m *MsgRemoveIbcChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIbcChannelPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveIbcChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{91}
 }
-
-func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveIbcChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIbcChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIbcChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveIbcChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIbcChannelPolicyResponse.Merge(m, src)
 }
-
-func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRemoveIbcChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveIbcChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIbcChannelPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgRemoveIbcChannelPolicyResponse proto.InternalMessageInfo
 
-func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
+	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
+	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
+	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
+	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
+	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
+	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
+	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
+	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
+	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
+	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
+	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
+	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
+	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
+	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
+	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
+	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
+	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
+	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgIbcTransferRequest)(nil), "provenance.marker.v1.MsgIbcTransferRequest")
+	proto.RegisterType((*MsgIbcTransferResponse)(nil), "provenance.marker.v1.MsgIbcTransferResponse")
+	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
+	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
+	proto.RegisterType((*MsgSupplyIncreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyIncreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyIncreaseProposalResponse")
+	proto.RegisterType((*MsgSupplyDecreaseProposalRequest)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalRequest")
+	proto.RegisterType((*MsgSupplyDecreaseProposalResponse)(nil), "provenance.marker.v1.MsgSupplyDecreaseProposalResponse")
+	proto.RegisterType((*MsgUpdateRequiredAttributesRequest)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesRequest")
+	proto.RegisterType((*MsgUpdateRequiredAttributesResponse)(nil), "provenance.marker.v1.MsgUpdateRequiredAttributesResponse")
+	proto.RegisterType((*MsgUpdateForcedTransferRequest)(nil), "provenance.marker.v1.MsgUpdateForcedTransferRequest")
+	proto.RegisterType((*MsgUpdateForcedTransferResponse)(nil), "provenance.marker.v1.MsgUpdateForcedTransferResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.marker.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.marker.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgUpdateSendDenyListRequest)(nil), "provenance.marker.v1.MsgUpdateSendDenyListRequest")
+	proto.RegisterType((*MsgUpdateSendDenyListResponse)(nil), "provenance.marker.v1.MsgUpdateSendDenyListResponse")
+	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.marker.v1.MsgAddNetAssetValuesRequest")
+	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.marker.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgSetAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgSetAdministratorProposalRequest")
+	proto.RegisterType((*MsgSetAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgSetAdministratorProposalResponse")
+	proto.RegisterType((*MsgRemoveAdministratorProposalRequest)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalRequest")
+	proto.RegisterType((*MsgRemoveAdministratorProposalResponse)(nil), "provenance.marker.v1.MsgRemoveAdministratorProposalResponse")
+	proto.RegisterType((*MsgChangeStatusProposalRequest)(nil), "provenance.marker.v1.MsgChangeStatusProposalRequest")
+	proto.RegisterType((*MsgChangeStatusProposalResponse)(nil), "provenance.marker.v1.MsgChangeStatusProposalResponse")
+	proto.RegisterType((*MsgWithdrawEscrowProposalRequest)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalRequest")
+	proto.RegisterType((*MsgWithdrawEscrowProposalResponse)(nil), "provenance.marker.v1.MsgWithdrawEscrowProposalResponse")
+	proto.RegisterType((*MsgSetDenomMetadataProposalRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalRequest")
+	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateDistributionRequest)(nil), "provenance.marker.v1.MsgCreateDistributionRequest")
+	proto.RegisterType((*MsgCreateDistributionResponse)(nil), "provenance.marker.v1.MsgCreateDistributionResponse")
+	proto.RegisterType((*MsgSetLockupRequest)(nil), "provenance.marker.v1.MsgSetLockupRequest")
+	proto.RegisterType((*MsgSetLockupResponse)(nil), "provenance.marker.v1.MsgSetLockupResponse")
+	proto.RegisterType((*MsgRemoveLockupRequest)(nil), "provenance.marker.v1.MsgRemoveLockupRequest")
+	proto.RegisterType((*MsgRemoveLockupResponse)(nil), "provenance.marker.v1.MsgRemoveLockupResponse")
+	proto.RegisterType((*MsgSplitMarkerRequest)(nil), "provenance.marker.v1.MsgSplitMarkerRequest")
+	proto.RegisterType((*MsgSplitMarkerResponse)(nil), "provenance.marker.v1.MsgSplitMarkerResponse")
+	proto.RegisterType((*MsgSetRedemptionConfigRequest)(nil), "provenance.marker.v1.MsgSetRedemptionConfigRequest")
+	proto.RegisterType((*MsgSetRedemptionConfigResponse)(nil), "provenance.marker.v1.MsgSetRedemptionConfigResponse")
+	proto.RegisterType((*MsgRedeemRequest)(nil), "provenance.marker.v1.MsgRedeemRequest")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "provenance.marker.v1.MsgRedeemResponse")
+	proto.RegisterType((*MsgApproveRedemptionRequest)(nil), "provenance.marker.v1.MsgApproveRedemptionRequest")
+	proto.RegisterType((*MsgApproveRedemptionResponse)(nil), "provenance.marker.v1.MsgApproveRedemptionResponse")
+	proto.RegisterType((*MsgRejectRedemptionRequest)(nil), "provenance.marker.v1.MsgRejectRedemptionRequest")
+	proto.RegisterType((*MsgRejectRedemptionResponse)(nil), "provenance.marker.v1.MsgRejectRedemptionResponse")
+	proto.RegisterType((*MsgSetTransferLimitRequest)(nil), "provenance.marker.v1.MsgSetTransferLimitRequest")
+	proto.RegisterType((*MsgSetTransferLimitResponse)(nil), "provenance.marker.v1.MsgSetTransferLimitResponse")
+	proto.RegisterType((*MsgRemoveTransferLimitRequest)(nil), "provenance.marker.v1.MsgRemoveTransferLimitRequest")
+	proto.RegisterType((*MsgRemoveTransferLimitResponse)(nil), "provenance.marker.v1.MsgRemoveTransferLimitResponse")
+	proto.RegisterType((*MsgProposeRecoveryRequest)(nil), "provenance.marker.v1.MsgProposeRecoveryRequest")
+	proto.RegisterType((*MsgProposeRecoveryResponse)(nil), "provenance.marker.v1.MsgProposeRecoveryResponse")
+	proto.RegisterType((*MsgCancelRecoveryRequest)(nil), "provenance.marker.v1.MsgCancelRecoveryRequest")
+	proto.RegisterType((*MsgCancelRecoveryResponse)(nil), "provenance.marker.v1.MsgCancelRecoveryResponse")
+	proto.RegisterType((*MsgExecuteRecoveryRequest)(nil), "provenance.marker.v1.MsgExecuteRecoveryRequest")
+	proto.RegisterType((*MsgExecuteRecoveryResponse)(nil), "provenance.marker.v1.MsgExecuteRecoveryResponse")
+	proto.RegisterType((*MsgSetHolderLimitRequest)(nil), "provenance.marker.v1.MsgSetHolderLimitRequest")
+	proto.RegisterType((*MsgSetHolderLimitResponse)(nil), "provenance.marker.v1.MsgSetHolderLimitResponse")
+	proto.RegisterType((*MsgRemoveHolderLimitRequest)(nil), "provenance.marker.v1.MsgRemoveHolderLimitRequest")
+	proto.RegisterType((*MsgRemoveHolderLimitResponse)(nil), "provenance.marker.v1.MsgRemoveHolderLimitResponse")
+	proto.RegisterType((*MsgSetAccountDataSchemaRequest)(nil), "provenance.marker.v1.MsgSetAccountDataSchemaRequest")
+	proto.RegisterType((*MsgSetAccountDataSchemaResponse)(nil), "provenance.marker.v1.MsgSetAccountDataSchemaResponse")
+	proto.RegisterType((*MsgSetIbcChannelPolicyRequest)(nil), "provenance.marker.v1.MsgSetIbcChannelPolicyRequest")
+	proto.RegisterType((*MsgSetIbcChannelPolicyResponse)(nil), "provenance.marker.v1.MsgSetIbcChannelPolicyResponse")
+	proto.RegisterType((*MsgRemoveIbcChannelPolicyRequest)(nil), "provenance.marker.v1.MsgRemoveIbcChannelPolicyRequest")
+	proto.RegisterType((*MsgRemoveIbcChannelPolicyResponse)(nil), "provenance.marker.v1.MsgRemoveIbcChannelPolicyResponse")
 }
 
-func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
 
-func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_bcb203fb73175ed3 = []byte{
+	// 3425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0xac, 0xd7, 0x1b, 0xfb, 0x6c, 0xe2, 0xd4, 0x13, 0xc7, 0xd9, 0x4c, 0x12, 0x7b, 0xb3,
+	0xa9, 0x13, 0x27, 0xd4, 0xbb, 0xf1, 0xe6, 0xaf, 0x71, 0x4b, 0x61, 0x6d, 0x37, 0xad, 0xa1, 0x0b,
+	0xd1, 0xba, 0x80, 0xe0, 0x65, 0x35, 0x3b, 0x73, 0x33, 0x1e, 0xbc, 0x33, 0xb3, 0x9d, 0x99, 0x75,
+	0xb2, 0x45, 0x48, 0x15, 0x7d, 0xea, 0x03, 0x22, 0x54, 0x02, 0x01, 0x42, 0xa2, 0x4f, 0x08, 0xf5,
+	0xa9, 0x40, 0xe1, 0x05, 0xf1, 0x82, 0x40, 0x14, 0x10, 0xa8, 0x2a, 0x2f, 0x15, 0x0f, 0x2d, 0x6a,
+	0x24, 0xca, 0x3b, 0xef, 0x80, 0x66, 0xee, 0x9d, 0xdf, 0xbd, 0x73, 0x77, 0x76, 0xbd, 0x6e, 0xfb,
+	0xd2, 0x7a, 0xee, 0x3d, 0xe7, 0xde, 0xf3, 0x9d, 0x7b, 0xee, 0xb9, 0x67, 0xcf, 0x39, 0x2d, 0x9c,
+	0xed, 0x98, 0xc6, 0x1e, 0xd2, 0x45, 0x5d, 0x42, 0x15, 0x4d, 0x34, 0x77, 0x91, 0x59, 0xd9, 0x5b,
+	0xad, 0xd8, 0xf7, 0xcb, 0x1d, 0xd3, 0xb0, 0x0d, 0x7e, 0x2e, 0x98, 0x2e, 0xe3, 0xe9, 0xf2, 0xde,
+	0xaa, 0x30, 0x2b, 0x6a, 0xaa, 0x6e, 0x54, 0xdc, 0x7f, 0x62, 0x42, 0xe1, 0x94, 0x62, 0x18, 0x4a,
+	0x1b, 0x55, 0xdc, 0xaf, 0x56, 0xf7, 0x6e, 0x45, 0xd4, 0x7b, 0xde, 0x94, 0x64, 0x58, 0x9a, 0x61,
+	0x35, 0xdd, 0xaf, 0x0a, 0xfe, 0x20, 0x53, 0x73, 0x8a, 0xa1, 0x18, 0x78, 0xdc, 0xf9, 0x8b, 0x8c,
+	0x2e, 0xc6, 0xd7, 0xb2, 0x55, 0x0d, 0x59, 0xb6, 0xa8, 0x75, 0x08, 0xc1, 0x02, 0x5e, 0xa4, 0xd2,
+	0x12, 0x2d, 0x54, 0xd9, 0x5b, 0x6d, 0x21, 0x5b, 0x5c, 0xad, 0x48, 0x86, 0xaa, 0xf7, 0xcd, 0xeb,
+	0xbb, 0xfe, 0xbc, 0xf3, 0x41, 0xe6, 0x4f, 0x92, 0x79, 0xcd, 0x52, 0x1c, 0xb4, 0x9a, 0xa5, 0x90,
+	0x89, 0x25, 0xb5, 0x25, 0x55, 0xc4, 0x4e, 0xa7, 0xad, 0x4a, 0xa2, 0xad, 0x1a, 0xba, 0x55, 0xb1,
+	0x4d, 0x51, 0xb7, 0xee, 0x46, 0xb5, 0x22, 0x9c, 0xa3, 0x2a, 0x0d, 0xff, 0x45, 0x48, 0x2e, 0x50,
+	0x49, 0x44, 0x49, 0x42, 0x96, 0xa5, 0x98, 0xa2, 0x6e, 0x13, 0xba, 0x8b, 0x54, 0xba, 0x1d, 0xa3,
+	0x2d, 0x23, 0xb3, 0xd9, 0x56, 0x35, 0xd5, 0x23, 0x5c, 0xa2, 0x12, 0xaa, 0x2d, 0xa9, 0xd9, 0x31,
+	0xda, 0xaa, 0xd4, 0x63, 0x8a, 0xd6, 0x36, 0xa4, 0xdd, 0x6e, 0x87, 0xb9, 0x92, 0x89, 0x64, 0xa4,
+	0x75, 0x1c, 0xd0, 0x84, 0xec, 0x12, 0x95, 0xcc, 0xd3, 0x47, 0x58, 0xb6, 0xd2, 0x5f, 0x38, 0x28,
+	0xd4, 0x2d, 0xe5, 0x19, 0x07, 0x57, 0xad, 0xdd, 0x36, 0xee, 0x39, 0x4c, 0x0d, 0xf4, 0x42, 0x17,
+	0x59, 0x36, 0x3f, 0x07, 0x93, 0x32, 0xd2, 0x0d, 0xad, 0xc0, 0x15, 0xb9, 0xe5, 0xe9, 0x06, 0xfe,
+	0xe0, 0x1f, 0x85, 0xa3, 0xa2, 0xac, 0xa9, 0xba, 0x6a, 0xd9, 0xa6, 0x68, 0x1b, 0x66, 0x21, 0xe3,
+	0xce, 0x46, 0x07, 0xf9, 0x02, 0x1c, 0x76, 0x95, 0x85, 0x50, 0x61, 0xc2, 0x9d, 0xf7, 0x3e, 0xf9,
+	0xa7, 0x61, 0x5a, 0xf4, 0x76, 0x2a, 0x64, 0x8b, 0xdc, 0x72, 0xbe, 0x3a, 0x57, 0xc6, 0x76, 0x53,
+	0xf6, 0xec, 0xa6, 0x5c, 0xd3, 0x7b, 0xeb, 0xb3, 0x7f, 0x7e, 0x73, 0xe5, 0xe8, 0x6d, 0x84, 0x7c,
+	0xb9, 0xb6, 0x1a, 0x01, 0xe7, 0x1a, 0xff, 0xad, 0x0f, 0xdf, 0xb8, 0x1c, 0xdd, 0xb4, 0x74, 0x1a,
+	0x4e, 0x51, 0xc0, 0x58, 0x1d, 0x43, 0xb7, 0x50, 0xe9, 0x7f, 0x59, 0x38, 0x5e, 0xb7, 0x94, 0x9a,
+	0x2c, 0xd7, 0x5d, 0x9d, 0x78, 0x28, 0x6f, 0x42, 0x4e, 0xd4, 0x8c, 0xae, 0x6e, 0xbb, 0x30, 0xf3,
+	0xd5, 0x53, 0x65, 0x62, 0xe8, 0x8e, 0x8d, 0x96, 0x89, 0x0d, 0x96, 0x37, 0x0c, 0x55, 0x5f, 0xcf,
+	0xbe, 0xf5, 0xde, 0xe2, 0xa1, 0x06, 0x21, 0x77, 0x20, 0x6a, 0xa2, 0x2e, 0x2a, 0xc8, 0xf4, 0x20,
+	0x92, 0x4f, 0xfe, 0x1c, 0x1c, 0xb9, 0x6b, 0x1a, 0x5a, 0x53, 0x94, 0x65, 0x13, 0x59, 0x96, 0x8b,
+	0x72, 0xba, 0x91, 0x77, 0xc6, 0x6a, 0x78, 0x88, 0x5f, 0x83, 0x9c, 0x65, 0x8b, 0x76, 0xd7, 0x2a,
+	0x4c, 0x16, 0xb9, 0xe5, 0x99, 0x6a, 0xa9, 0x4c, 0xbb, 0xaf, 0x65, 0x2c, 0xea, 0xb6, 0x4b, 0xd9,
+	0x20, 0x1c, 0x7c, 0x0d, 0xf2, 0x98, 0xa2, 0x69, 0xf7, 0x3a, 0xa8, 0x90, 0x73, 0x17, 0x28, 0xb2,
+	0x16, 0x78, 0xbe, 0xd7, 0x41, 0x0d, 0xd0, 0xfc, 0xbf, 0xf9, 0x67, 0x21, 0x8f, 0x2d, 0xba, 0xd9,
+	0x56, 0x2d, 0xbb, 0x70, 0xb8, 0x38, 0xb1, 0x9c, 0xaf, 0x9e, 0xa3, 0x2f, 0x51, 0x73, 0x09, 0x5d,
+	0xad, 0x12, 0x0d, 0x00, 0xe6, 0x7d, 0x4e, 0xb5, 0x6c, 0x07, 0xab, 0xd5, 0xed, 0x74, 0xda, 0xbd,
+	0xe6, 0x5d, 0xf5, 0x3e, 0x92, 0x0b, 0x53, 0x45, 0x6e, 0x79, 0xaa, 0x91, 0xc7, 0x63, 0xb7, 0x9d,
+	0x21, 0xfe, 0x71, 0x28, 0xb8, 0xe7, 0xd6, 0x54, 0x8c, 0x3d, 0x64, 0xba, 0xcb, 0x37, 0x25, 0x43,
+	0xb7, 0x4d, 0xa3, 0x5d, 0x98, 0x76, 0xc9, 0xe7, 0xdd, 0xf9, 0x67, 0xfc, 0xe9, 0x0d, 0x3c, 0xcb,
+	0x57, 0xe1, 0x04, 0xe6, 0xbc, 0x6b, 0x98, 0x12, 0x92, 0x9b, 0x9e, 0x0d, 0x17, 0xc0, 0x65, 0x3b,
+	0xee, 0x4e, 0xde, 0x76, 0xe7, 0x9e, 0x27, 0x53, 0x7c, 0x05, 0x8e, 0x9b, 0xe8, 0x85, 0xae, 0x6a,
+	0x22, 0xb9, 0x29, 0xda, 0xb6, 0xa9, 0xb6, 0xba, 0x36, 0xb2, 0x0a, 0xf9, 0xe2, 0xc4, 0xf2, 0x74,
+	0x83, 0xf7, 0xa6, 0x6a, 0xfe, 0x0c, 0xbf, 0x08, 0xd3, 0x5d, 0x4b, 0x6e, 0x4a, 0x48, 0xb7, 0xad,
+	0xc2, 0x91, 0x22, 0xb7, 0x9c, 0x5d, 0xcf, 0x14, 0xb8, 0xc6, 0x54, 0xd7, 0x92, 0x37, 0x9c, 0x31,
+	0x7e, 0x1e, 0x72, 0x7b, 0x46, 0xbb, 0xab, 0xa1, 0xc2, 0x51, 0x67, 0xb6, 0x41, 0xbe, 0xf8, 0xd3,
+	0x98, 0x51, 0x53, 0xdb, 0x6d, 0xab, 0x30, 0xe3, 0x4e, 0x39, 0x4c, 0x75, 0xe7, 0x7b, 0x6d, 0xd6,
+	0xb1, 0xcf, 0x88, 0x19, 0x94, 0xe6, 0x61, 0x2e, 0x6a, 0x80, 0xc4, 0x32, 0x7f, 0xca, 0x79, 0x96,
+	0x89, 0x55, 0x3d, 0x8e, 0xfb, 0xf7, 0x19, 0xc8, 0xe1, 0x43, 0x2a, 0x4c, 0x0c, 0x77, 0xb6, 0x84,
+	0x8d, 0x7a, 0xbf, 0x7c, 0x00, 0x9e, 0x9c, 0x04, 0xc0, 0x77, 0x39, 0x98, 0xaf, 0x5b, 0xca, 0x26,
+	0x6a, 0x23, 0x1b, 0x8d, 0x0f, 0xc3, 0x45, 0x38, 0x66, 0x22, 0xcd, 0xd8, 0x43, 0xb2, 0xa7, 0x42,
+	0x72, 0xd1, 0x66, 0xc8, 0x30, 0xb9, 0x4c, 0x54, 0x59, 0x4f, 0xc1, 0xc9, 0x3e, 0x91, 0x88, 0xb8,
+	0x32, 0xf0, 0x75, 0x4b, 0xb9, 0xad, 0xea, 0x62, 0x5b, 0x7d, 0x71, 0x1c, 0xde, 0x8e, 0x2a, 0xc0,
+	0x09, 0x38, 0x1e, 0xd9, 0x25, 0xb2, 0x79, 0x4d, 0xb2, 0xd5, 0x3d, 0xd1, 0x3e, 0xe0, 0xcd, 0x83,
+	0x5d, 0xc8, 0xe6, 0x2d, 0x78, 0xa4, 0x6e, 0x29, 0x1b, 0x8e, 0x11, 0xb4, 0x0f, 0x6a, 0xeb, 0xe3,
+	0x30, 0x1b, 0xda, 0x23, 0xb2, 0x31, 0x3e, 0x8d, 0x83, 0xdd, 0xd8, 0xdb, 0x83, 0x6c, 0xfc, 0x32,
+	0x07, 0x33, 0x75, 0x4b, 0xa9, 0xab, 0xba, 0xbd, 0x6f, 0x87, 0x3f, 0xba, 0x68, 0xb3, 0x70, 0xcc,
+	0x17, 0x22, 0x2a, 0xd8, 0x7a, 0xd7, 0xd4, 0x3f, 0x76, 0xc1, 0xb0, 0x10, 0x44, 0xb0, 0xff, 0x72,
+	0xae, 0x85, 0x7e, 0x45, 0xb5, 0x77, 0x64, 0x53, 0xbc, 0x37, 0x8e, 0x8b, 0x7c, 0x16, 0xc0, 0x36,
+	0x62, 0x77, 0x78, 0xda, 0x36, 0xbc, 0xb7, 0xb0, 0xe7, 0xe3, 0xce, 0x16, 0x27, 0xd8, 0xb8, 0x6f,
+	0x3b, 0xb8, 0x5f, 0x7f, 0x7f, 0x71, 0x59, 0x51, 0xed, 0x9d, 0x6e, 0xab, 0x2c, 0x19, 0x1a, 0x89,
+	0x4b, 0xc9, 0xbf, 0x56, 0x2c, 0x79, 0xb7, 0xe2, 0x3c, 0x8b, 0x96, 0xcb, 0x60, 0xfd, 0xc8, 0xf1,
+	0xc2, 0x6d, 0xa4, 0x88, 0x52, 0xaf, 0xe9, 0xc4, 0x99, 0xd6, 0xcf, 0x3e, 0x7c, 0xe3, 0x32, 0xe7,
+	0x69, 0x8e, 0x71, 0x77, 0x02, 0xfc, 0x44, 0x2f, 0x7f, 0xc2, 0x7a, 0xf1, 0xde, 0x99, 0xf1, 0x1f,
+	0xda, 0x04, 0x4d, 0x75, 0x29, 0x42, 0x89, 0xa8, 0x76, 0x27, 0x63, 0xda, 0x65, 0x40, 0x0c, 0xa0,
+	0x10, 0x88, 0xff, 0xe2, 0xe0, 0x44, 0xdd, 0x52, 0xb6, 0x5a, 0x52, 0x1c, 0xe5, 0xab, 0x1c, 0x4c,
+	0xf9, 0x8f, 0x2f, 0x06, 0x7a, 0xa9, 0xac, 0xb6, 0xa4, 0x72, 0x38, 0xe4, 0x2e, 0x7b, 0x14, 0x6e,
+	0xe0, 0x11, 0xac, 0xbf, 0xfe, 0x79, 0x07, 0xf8, 0x3f, 0xde, 0x5b, 0xdc, 0xe8, 0x3f, 0x35, 0xb5,
+	0x25, 0xad, 0x28, 0x46, 0x65, 0xef, 0xf1, 0x8a, 0x66, 0xc8, 0xdd, 0x36, 0xb2, 0x9c, 0x20, 0x3e,
+	0x14, 0xbc, 0xe3, 0xa3, 0x0c, 0x0b, 0xeb, 0xcb, 0xb1, 0x0f, 0xb3, 0x2f, 0xc0, 0x7c, 0x1c, 0x27,
+	0x51, 0xc1, 0x5f, 0x39, 0x10, 0xea, 0x96, 0xb2, 0x8d, 0xec, 0x4d, 0xc7, 0xc0, 0xeb, 0xc8, 0x16,
+	0x65, 0xd1, 0x16, 0x3d, 0x3d, 0x74, 0x61, 0x4a, 0x23, 0x43, 0x44, 0x0d, 0x67, 0x83, 0xf3, 0xd6,
+	0x77, 0xfd, 0xf3, 0xf6, 0xf8, 0xd6, 0xd7, 0x08, 0xf4, 0x2a, 0xd3, 0x60, 0xef, 0xe3, 0x1f, 0x3c,
+	0x04, 0xac, 0xb7, 0xa7, 0xbf, 0xd5, 0x3e, 0x90, 0x9e, 0x85, 0xd3, 0x54, 0x38, 0x04, 0xee, 0xdf,
+	0xb3, 0x70, 0x1e, 0x3f, 0xe9, 0xde, 0x43, 0xe5, 0xbd, 0x19, 0x9f, 0x84, 0x20, 0x39, 0x16, 0xe8,
+	0x4e, 0xee, 0x3f, 0xd0, 0xcd, 0x8d, 0x2f, 0xd0, 0x3d, 0x3c, 0x5c, 0xa0, 0x3b, 0x35, 0x5a, 0xa0,
+	0x3b, 0x3d, 0x74, 0xa0, 0x0b, 0xe9, 0x02, 0xdd, 0x3c, 0x33, 0xd0, 0x3d, 0x92, 0x1c, 0xe8, 0x1e,
+	0x1d, 0x1c, 0xe8, 0x5e, 0x80, 0x47, 0xd9, 0x46, 0x45, 0xac, 0xef, 0x6f, 0x1c, 0x14, 0x1d, 0xeb,
+	0x74, 0x55, 0xb8, 0xa5, 0x4b, 0x26, 0x12, 0x2d, 0x74, 0xc7, 0x34, 0x3a, 0x86, 0x25, 0xb6, 0xf7,
+	0x6d, 0x7a, 0x4b, 0x30, 0x63, 0x8b, 0xa6, 0x82, 0x6c, 0xdf, 0xc4, 0xc8, 0xad, 0xc1, 0xa3, 0x9e,
+	0x91, 0xdd, 0x80, 0x69, 0xb1, 0x6b, 0xef, 0x18, 0xa6, 0x6a, 0xf7, 0xb0, 0x8d, 0xae, 0x17, 0xde,
+	0x79, 0x73, 0x65, 0x8e, 0xec, 0x42, 0xc8, 0xb6, 0x6d, 0x53, 0xd5, 0x95, 0x46, 0x40, 0xba, 0xc6,
+	0xff, 0xfb, 0xb5, 0x45, 0xce, 0xc1, 0x1e, 0x8c, 0x95, 0xce, 0xc3, 0x39, 0x06, 0x1e, 0x82, 0xfa,
+	0x9d, 0x30, 0xea, 0x4d, 0x44, 0x47, 0xdd, 0x4a, 0x8f, 0xba, 0x42, 0x5c, 0xcc, 0xc5, 0x94, 0x6f,
+	0xa2, 0xaf, 0xa0, 0x08, 0xf2, 0xcc, 0xf8, 0x90, 0x6f, 0xa2, 0x04, 0xe4, 0xdf, 0xcf, 0x40, 0xa9,
+	0x6e, 0x29, 0x5f, 0xea, 0xc8, 0x24, 0xf4, 0x8d, 0x1a, 0x28, 0x3b, 0xd4, 0x78, 0x12, 0x04, 0x1c,
+	0xf6, 0x37, 0x69, 0x56, 0x9f, 0x71, 0xad, 0xbe, 0x80, 0x29, 0xfa, 0x97, 0xe6, 0x6f, 0xc0, 0x49,
+	0x51, 0x96, 0xa9, 0xac, 0x13, 0x2e, 0xeb, 0x09, 0x51, 0x96, 0x29, 0x7c, 0xcf, 0x00, 0xef, 0x27,
+	0x4e, 0x02, 0x65, 0x65, 0x07, 0x28, 0x6b, 0xd6, 0xe3, 0xa9, 0xf9, 0x4a, 0x3b, 0xed, 0x29, 0x8d,
+	0xb2, 0x5e, 0x69, 0x09, 0xce, 0x33, 0xf5, 0x42, 0xf4, 0xf7, 0x6b, 0x0e, 0x16, 0x7c, 0xba, 0xa8,
+	0x37, 0x60, 0xeb, 0x2e, 0xd1, 0xbd, 0x64, 0x92, 0xdd, 0xcb, 0x38, 0xef, 0xc5, 0x39, 0x58, 0x4c,
+	0x94, 0x9b, 0x60, 0x7b, 0x05, 0x67, 0xa2, 0xb6, 0x91, 0x5d, 0x93, 0x24, 0xc7, 0x3c, 0x37, 0x43,
+	0xcf, 0x2e, 0x1d, 0xd5, 0x1c, 0x4c, 0xee, 0x89, 0xed, 0x2e, 0x22, 0xf7, 0x1a, 0x7f, 0xf0, 0x57,
+	0x20, 0x67, 0xa9, 0x8a, 0x8e, 0xcc, 0x81, 0x42, 0x13, 0xba, 0xb5, 0x63, 0x9e, 0xc4, 0x64, 0x80,
+	0xe4, 0x91, 0xe2, 0xa2, 0x10, 0x41, 0x7f, 0x9f, 0x81, 0x33, 0x3e, 0x98, 0x6d, 0xa4, 0xcb, 0x9b,
+	0x48, 0xef, 0x39, 0x2f, 0x04, 0x5b, 0xd8, 0x1b, 0x70, 0x92, 0x98, 0xaf, 0x8c, 0x74, 0x35, 0xf8,
+	0x49, 0xeb, 0xdb, 0xee, 0x09, 0x3c, 0xbd, 0xe9, 0xce, 0xd6, 0xbc, 0x49, 0xfe, 0x0a, 0xcc, 0x39,
+	0x86, 0xdb, 0xc7, 0x84, 0xad, 0x96, 0x17, 0x65, 0x39, 0xce, 0x11, 0x39, 0xb8, 0x6c, 0xea, 0x83,
+	0x73, 0xbc, 0xbf, 0x73, 0x6d, 0x0d, 0x9d, 0xc4, 0x90, 0xe4, 0x8b, 0xff, 0x2c, 0x00, 0xba, 0xdf,
+	0x51, 0x4d, 0x37, 0xc8, 0x73, 0xb3, 0x4d, 0xf9, 0xaa, 0xd0, 0x97, 0xb1, 0x7b, 0xde, 0xcb, 0xf4,
+	0xae, 0x67, 0x1f, 0xbc, 0xbf, 0xc8, 0x35, 0x42, 0x3c, 0x54, 0x93, 0x58, 0x84, 0xb3, 0x09, 0x5a,
+	0x24, 0x7a, 0xfe, 0x1d, 0xe7, 0x86, 0x2e, 0x35, 0x59, 0xfe, 0x02, 0xb2, 0x6b, 0x96, 0x85, 0xec,
+	0x2f, 0x3b, 0xe7, 0x3b, 0x96, 0xcc, 0xc2, 0x36, 0x3c, 0xa2, 0x3b, 0xef, 0x82, 0xb3, 0x6a, 0xd3,
+	0x35, 0x1b, 0x2f, 0x4f, 0x72, 0x9e, 0x1e, 0x1a, 0x44, 0x44, 0x20, 0xef, 0xcc, 0x8c, 0x1e, 0x91,
+	0x8b, 0x1a, 0x7e, 0x2d, 0xc0, 0x19, 0x3a, 0x06, 0x02, 0xf2, 0x8f, 0x1c, 0x94, 0x88, 0xa9, 0x85,
+	0xf9, 0xe2, 0xaf, 0x01, 0x1d, 0x6b, 0x90, 0xe3, 0xc9, 0x8c, 0x94, 0xe3, 0x19, 0xeb, 0x15, 0xc7,
+	0x2e, 0x2c, 0x19, 0x08, 0x01, 0xfc, 0x0b, 0x0e, 0x96, 0xea, 0x96, 0xd2, 0x70, 0x6d, 0x7d, 0x04,
+	0xcc, 0x94, 0x9c, 0x10, 0xbe, 0x3e, 0xb1, 0x9c, 0xd0, 0x58, 0xb1, 0x2d, 0xc3, 0x85, 0x41, 0x32,
+	0x13, 0x78, 0x7f, 0xc0, 0x1e, 0x7a, 0x63, 0x47, 0xd4, 0x15, 0x84, 0xd3, 0xb6, 0xe9, 0x70, 0xd5,
+	0x00, 0x74, 0x74, 0xaf, 0x49, 0x72, 0xc2, 0x99, 0xd4, 0x39, 0xe1, 0x69, 0x1d, 0xdd, 0xc3, 0x7f,
+	0x1e, 0x80, 0xc3, 0xa6, 0xc3, 0x20, 0x50, 0x1f, 0x64, 0xa0, 0x18, 0xfa, 0x9d, 0xfc, 0xb4, 0x25,
+	0x99, 0xc6, 0xbd, 0x74, 0x60, 0x25, 0x3f, 0xb8, 0xc9, 0x0c, 0xfa, 0xc1, 0x7f, 0x65, 0xd8, 0x1f,
+	0xfc, 0x8c, 0xf0, 0x6f, 0x62, 0x60, 0xf8, 0x97, 0x1d, 0x47, 0x10, 0x94, 0xa4, 0x11, 0xa2, 0xb7,
+	0x87, 0xfe, 0x95, 0x8f, 0xfc, 0x24, 0x8b, 0x6b, 0xee, 0x63, 0xfa, 0xa5, 0x39, 0x6a, 0x4c, 0x38,
+	0x93, 0xe4, 0x0e, 0x12, 0x40, 0x12, 0x65, 0xfc, 0x18, 0x67, 0x8e, 0xf1, 0x33, 0x70, 0x47, 0x34,
+	0x45, 0xcd, 0xf7, 0xef, 0x11, 0x49, 0xb8, 0xf4, 0xcf, 0xd8, 0x1a, 0xe4, 0x3a, 0xee, 0x42, 0xae,
+	0xf8, 0xf9, 0xea, 0x19, 0xfa, 0x2d, 0xc2, 0x9b, 0x79, 0x0e, 0x11, 0x73, 0xf4, 0xa1, 0xc0, 0x49,
+	0xe4, 0xa8, 0x74, 0x5e, 0xfe, 0x0e, 0x87, 0x01, 0x1b, 0x26, 0x12, 0x6d, 0xb4, 0xa9, 0x5a, 0x38,
+	0x58, 0x53, 0x0d, 0x9d, 0x6d, 0xfa, 0xbd, 0xf4, 0xa6, 0x3f, 0xe6, 0x5c, 0x17, 0xff, 0x14, 0x35,
+	0xe1, 0xc4, 0x50, 0x6a, 0x8a, 0xf4, 0xc2, 0xb3, 0x70, 0x36, 0x41, 0x09, 0x58, 0x4d, 0x8e, 0xbf,
+	0x96, 0x43, 0xe3, 0x4d, 0x55, 0x76, 0xf5, 0x91, 0x6d, 0xcc, 0x84, 0x87, 0xb7, 0xe4, 0xd2, 0x7f,
+	0x70, 0x11, 0x64, 0x1b, 0xd9, 0xcf, 0xb9, 0x25, 0xcf, 0x41, 0x01, 0xed, 0xe1, 0xc8, 0x8f, 0x3a,
+	0x06, 0x0a, 0x8f, 0x90, 0xff, 0x1c, 0x4c, 0x99, 0xa8, 0x8d, 0x44, 0x6b, 0xd0, 0x63, 0xef, 0x09,
+	0xe0, 0xd2, 0xae, 0x4f, 0x3b, 0xc7, 0x80, 0x35, 0xe9, 0xf3, 0x8f, 0xec, 0x35, 0xe2, 0x06, 0x86,
+	0x2b, 0x2a, 0x21, 0xd0, 0xc4, 0xba, 0x5e, 0xc7, 0xf7, 0x02, 0x3f, 0x39, 0x07, 0xa5, 0x90, 0x51,
+	0x1f, 0x0c, 0xfa, 0x2d, 0x89, 0xca, 0x4a, 0x70, 0xfc, 0x0a, 0x67, 0x14, 0xb7, 0x3b, 0x6d, 0xd5,
+	0x8e, 0x66, 0x94, 0xe8, 0x30, 0xce, 0xc0, 0xb4, 0xde, 0xd5, 0x50, 0x10, 0xba, 0x65, 0x1b, 0xc1,
+	0x00, 0x5f, 0x84, 0xbc, 0x4b, 0xa6, 0xea, 0xbe, 0xfd, 0x66, 0x1b, 0xe1, 0xa1, 0xb1, 0x9d, 0xcb,
+	0x17, 0x61, 0x3e, 0x2e, 0x36, 0x31, 0xe8, 0xeb, 0x90, 0xc3, 0x29, 0x1f, 0xe2, 0x93, 0xce, 0x12,
+	0xb7, 0x7b, 0x02, 0x6f, 0x61, 0xc9, 0xbb, 0x65, 0xd5, 0xa8, 0x68, 0xa2, 0xbd, 0x53, 0xde, 0xd2,
+	0xed, 0x06, 0x21, 0x2e, 0xfd, 0x96, 0x73, 0x6f, 0xca, 0x36, 0xb2, 0x1b, 0x7e, 0xb9, 0x7e, 0xc3,
+	0xd0, 0xef, 0xaa, 0x0a, 0x5b, 0x21, 0x4f, 0x41, 0x4e, 0x72, 0xc9, 0x88, 0x37, 0xbb, 0x40, 0x37,
+	0xd9, 0xbe, 0x45, 0x09, 0xd7, 0xd8, 0xce, 0xb8, 0x08, 0x0b, 0x49, 0xe2, 0x93, 0xa3, 0xfe, 0x1e,
+	0xe7, 0xd6, 0x78, 0x9c, 0x79, 0xa4, 0x79, 0xa0, 0x9e, 0x4c, 0x9f, 0xc6, 0x08, 0xdd, 0x33, 0xcf,
+	0x63, 0x5d, 0x73, 0x6e, 0xac, 0xb3, 0x1c, 0xf9, 0xa5, 0xca, 0x92, 0xdd, 0xa7, 0x5c, 0x3b, 0xea,
+	0x88, 0xee, 0x7f, 0x96, 0x7e, 0xc9, 0xc1, 0x6c, 0x48, 0x2e, 0x72, 0x8c, 0xe7, 0xe1, 0x68, 0xd0,
+	0x37, 0x11, 0x78, 0xa5, 0x23, 0xc1, 0xe0, 0x96, 0xec, 0x38, 0xeb, 0x8e, 0xd8, 0x33, 0xba, 0x1f,
+	0xa5, 0xb3, 0xc6, 0x1b, 0x96, 0x5e, 0x23, 0xbf, 0x7e, 0x3a, 0xee, 0x71, 0x07, 0x4a, 0x67, 0x5b,
+	0x4b, 0x1f, 0xaa, 0x0c, 0x05, 0xd5, 0xb8, 0x4c, 0xe2, 0x87, 0x1c, 0x9c, 0xa1, 0x8b, 0x48, 0x74,
+	0x1c, 0xa8, 0x8f, 0xfb, 0xa8, 0xd5, 0xf7, 0x13, 0x9c, 0xc6, 0x6f, 0xa0, 0xaf, 0x23, 0xc9, 0xfe,
+	0x44, 0x6a, 0x0f, 0x27, 0xe6, 0xfb, 0x05, 0x0c, 0x1e, 0x00, 0x52, 0x87, 0xf0, 0x32, 0x25, 0xcf,
+	0xa9, 0x9a, 0xea, 0xe7, 0x18, 0x36, 0x61, 0xd2, 0x6d, 0xe3, 0x21, 0xd7, 0x2a, 0xe1, 0x21, 0x8b,
+	0xb0, 0x86, 0x2f, 0x18, 0x66, 0x1e, 0x5b, 0xb0, 0xe7, 0x17, 0x19, 0x62, 0xb2, 0x12, 0x2c, 0x3f,
+	0xc7, 0xbe, 0x0f, 0x3f, 0x10, 0x54, 0x38, 0x9f, 0xbc, 0x37, 0x0d, 0xfb, 0x3b, 0xaa, 0xc8, 0x04,
+	0xd5, 0x6f, 0x32, 0x6e, 0x96, 0x08, 0x87, 0xb4, 0xa8, 0x81, 0x24, 0x63, 0x0f, 0x99, 0xbd, 0xf1,
+	0x38, 0xbe, 0x27, 0x62, 0xb5, 0x91, 0x41, 0xf0, 0x23, 0x55, 0x93, 0x9b, 0xfd, 0xd5, 0x56, 0x96,
+	0x0e, 0x82, 0x3a, 0x6c, 0x90, 0x00, 0xca, 0x46, 0x12, 0x40, 0x7d, 0x81, 0xe3, 0xe4, 0xfe, 0x03,
+	0xc7, 0x4f, 0x83, 0x40, 0x53, 0x1e, 0xf1, 0x1c, 0x8b, 0x90, 0x37, 0xc9, 0x58, 0xe0, 0x9b, 0xc1,
+	0x1b, 0xda, 0x92, 0x4b, 0x0f, 0x70, 0xb6, 0xd0, 0xeb, 0x32, 0x88, 0xea, 0x9e, 0x6e, 0x4d, 0xb1,
+	0x35, 0x33, 0xf1, 0x35, 0x47, 0x48, 0x1c, 0xe6, 0xfb, 0x93, 0x86, 0x71, 0x89, 0x82, 0x16, 0x1f,
+	0x67, 0xf6, 0xe9, 0xfb, 0x48, 0xea, 0xda, 0x68, 0x4c, 0x02, 0x1f, 0x44, 0x40, 0x7f, 0x06, 0x04,
+	0x9a, 0x9c, 0x01, 0x0c, 0x92, 0xa4, 0x7d, 0xd6, 0xed, 0x73, 0x8c, 0x5c, 0xe2, 0xf5, 0xa8, 0x4f,
+	0x4a, 0xc8, 0x46, 0x85, 0x18, 0x0f, 0xd0, 0x23, 0xf9, 0x19, 0xdc, 0x88, 0x9c, 0x04, 0xc5, 0x37,
+	0xe0, 0xb4, 0x7f, 0xb7, 0x29, 0x38, 0x92, 0xf2, 0xb7, 0xe3, 0x91, 0x0c, 0x67, 0x04, 0x29, 0x9b,
+	0x13, 0xe1, 0xbe, 0xcd, 0xc1, 0x42, 0x5f, 0xf2, 0x79, 0x5b, 0xda, 0x41, 0xda, 0x80, 0x6c, 0xf8,
+	0x3c, 0xe4, 0x2c, 0x97, 0x0c, 0x4b, 0xd7, 0x20, 0x5f, 0xfb, 0x35, 0x6b, 0x9c, 0x09, 0xa2, 0x8b,
+	0x13, 0xe4, 0xf4, 0x48, 0x6c, 0xbb, 0xd5, 0x92, 0x9c, 0x9c, 0x91, 0x8e, 0xda, 0x77, 0xdc, 0xd6,
+	0x56, 0x4f, 0xe2, 0x2d, 0xc8, 0xe1, 0x5e, 0xd7, 0x02, 0xc7, 0x8a, 0x62, 0xe3, 0xec, 0x11, 0xd7,
+	0x88, 0x17, 0x18, 0xdb, 0x39, 0xf8, 0x01, 0x6d, 0xbf, 0xcc, 0x04, 0xd6, 0x4b, 0xb8, 0x4e, 0x87,
+	0x8f, 0x2a, 0x09, 0xd9, 0xc1, 0x1a, 0x0b, 0x4e, 0x28, 0x25, 0x49, 0x80, 0xe5, 0xac, 0xbe, 0x7b,
+	0x19, 0x26, 0xea, 0x96, 0xc2, 0x37, 0x61, 0xca, 0xab, 0xb7, 0xf2, 0xcb, 0x09, 0xa9, 0xc3, 0xbe,
+	0xb6, 0x37, 0xe1, 0x52, 0x0a, 0x4a, 0xe2, 0x95, 0x9b, 0x30, 0xe5, 0x15, 0x72, 0x19, 0x1b, 0xc4,
+	0x5a, 0xdb, 0x84, 0x4b, 0x29, 0x28, 0xc9, 0x06, 0x5f, 0x85, 0x1c, 0xf6, 0x9f, 0xfc, 0x85, 0x44,
+	0xa6, 0x48, 0xf3, 0x9a, 0x70, 0x71, 0x20, 0x5d, 0xb0, 0x34, 0xee, 0x0c, 0x63, 0x2c, 0x1d, 0x69,
+	0x4f, 0x13, 0x2e, 0x0e, 0xa4, 0x23, 0x4b, 0x6f, 0x43, 0xd6, 0xe9, 0xec, 0xe2, 0x1f, 0x4d, 0x64,
+	0x08, 0x75, 0x9f, 0x09, 0x4b, 0x03, 0xa8, 0x82, 0x45, 0x9d, 0xae, 0x2c, 0xc6, 0xa2, 0xa1, 0xce,
+	0x31, 0x61, 0x69, 0x00, 0x15, 0x59, 0xb4, 0x05, 0xd3, 0x7e, 0xf3, 0x26, 0xcf, 0x38, 0x97, 0x58,
+	0x23, 0xaa, 0x70, 0x39, 0x0d, 0x29, 0xd9, 0x63, 0x17, 0x8e, 0x84, 0x9b, 0x2e, 0xf9, 0xc7, 0x06,
+	0xa8, 0x31, 0xba, 0xd3, 0x4a, 0x4a, 0xea, 0xc0, 0x22, 0xbd, 0x6c, 0x2b, 0xc3, 0x22, 0x63, 0xad,
+	0x6c, 0xc2, 0xa5, 0x14, 0x94, 0x11, 0x8d, 0xe1, 0x14, 0x00, 0x5b, 0x63, 0x91, 0xec, 0x86, 0x70,
+	0x39, 0x0d, 0x69, 0x00, 0xc2, 0x2f, 0xba, 0x26, 0x83, 0x88, 0x15, 0x7a, 0x85, 0x4b, 0x29, 0x28,
+	0xc9, 0x06, 0x3b, 0x90, 0x0f, 0xb5, 0x3a, 0xf1, 0x9f, 0x4a, 0xe4, 0xec, 0x6f, 0xfc, 0x12, 0x1e,
+	0x4b, 0x47, 0x4c, 0x76, 0xba, 0x07, 0x8f, 0xc4, 0x53, 0xbe, 0xfc, 0x95, 0xc4, 0x15, 0x12, 0x9a,
+	0xac, 0x84, 0xd5, 0x21, 0x38, 0xc8, 0xc6, 0x2f, 0xc0, 0x4c, 0xb4, 0xed, 0x9f, 0x2f, 0x27, 0x2e,
+	0x42, 0xfd, 0x8f, 0x1d, 0x84, 0x4a, 0x6a, 0x7a, 0xb2, 0xe5, 0xab, 0x1c, 0x9c, 0x4a, 0x6c, 0x71,
+	0xe1, 0x6f, 0xb1, 0x0c, 0x80, 0xd9, 0x6b, 0x25, 0xac, 0x8d, 0xc2, 0x4a, 0x84, 0x7a, 0x85, 0x83,
+	0x79, 0x7a, 0xfb, 0x09, 0x7f, 0x23, 0x59, 0xab, 0xac, 0xfe, 0x1b, 0xe1, 0xe6, 0xd0, 0x7c, 0x7d,
+	0xb2, 0x6c, 0xa2, 0x21, 0x65, 0xd9, 0x44, 0xa3, 0xc9, 0x92, 0xd4, 0x79, 0xc2, 0x7f, 0x87, 0x83,
+	0x42, 0x52, 0x7b, 0x05, 0xff, 0x78, 0xe2, 0xaa, 0x03, 0x3a, 0x55, 0x84, 0x5b, 0x23, 0x70, 0x12,
+	0x89, 0x5e, 0xe6, 0x60, 0x8e, 0xd6, 0x10, 0xc1, 0x5f, 0x1b, 0xb0, 0x26, 0xb5, 0xef, 0x43, 0xb8,
+	0x3e, 0x24, 0x57, 0x70, 0x6f, 0xa2, 0xa1, 0x1d, 0xe3, 0xde, 0x50, 0x5b, 0x33, 0x84, 0x4a, 0x6a,
+	0x7a, 0xb2, 0xe5, 0x37, 0x81, 0xef, 0xaf, 0xfa, 0xf3, 0xd5, 0x01, 0xf2, 0x53, 0x1a, 0x2d, 0x84,
+	0xab, 0x43, 0xf1, 0x90, 0xed, 0x5f, 0x84, 0xd9, 0xbe, 0x72, 0x3c, 0xbf, 0xca, 0xba, 0x72, 0xd4,
+	0xf6, 0x03, 0xa1, 0x3a, 0x0c, 0x4b, 0xc8, 0x0a, 0x93, 0x2a, 0xe4, 0x0c, 0x2b, 0x1c, 0xd0, 0x1d,
+	0x20, 0xdc, 0x1a, 0x81, 0x93, 0x48, 0xf4, 0x03, 0x0e, 0x4e, 0x33, 0xea, 0xda, 0xfc, 0x13, 0x89,
+	0x4b, 0x0f, 0xae, 0xe0, 0x0b, 0x4f, 0x8e, 0xc6, 0x1c, 0xba, 0x20, 0xb4, 0x02, 0x34, 0xe3, 0x82,
+	0x30, 0xca, 0xee, 0xc2, 0xf5, 0x21, 0xb9, 0x42, 0x4e, 0x8c, 0x5e, 0xd0, 0x65, 0x38, 0x31, 0x66,
+	0x4d, 0x5c, 0xb8, 0x39, 0x34, 0x5f, 0xd4, 0x7c, 0xa8, 0x15, 0x55, 0xb6, 0xf9, 0xb0, 0x2a, 0xcd,
+	0xc2, 0xad, 0x11, 0x38, 0x83, 0x60, 0x2f, 0x5c, 0x1c, 0x65, 0x04, 0x7b, 0x94, 0x0a, 0xaf, 0xb0,
+	0x92, 0x92, 0x3a, 0x70, 0x1c, 0xfd, 0x85, 0x46, 0x86, 0xe3, 0x48, 0x2c, 0xcd, 0x0a, 0x57, 0x87,
+	0xe2, 0x09, 0x42, 0x41, 0xbf, 0x4e, 0xc7, 0x08, 0x05, 0xe3, 0x05, 0x4c, 0xe1, 0x72, 0x1a, 0xd2,
+	0x40, 0x9f, 0xe1, 0x32, 0x1a, 0x43, 0x9f, 0x94, 0xca, 0xa0, 0xb0, 0x92, 0x92, 0x3a, 0x08, 0x0b,
+	0x43, 0x05, 0x2e, 0x46, 0x58, 0xd8, 0x5f, 0xbd, 0x13, 0x1e, 0x4b, 0x47, 0x4c, 0x76, 0x7a, 0x89,
+	0x83, 0xe3, 0x94, 0xd2, 0x11, 0x7f, 0x95, 0xa5, 0x9a, 0x84, 0x3a, 0x99, 0x70, 0x6d, 0x38, 0xa6,
+	0xe0, 0xf7, 0x1f, 0xae, 0x00, 0x31, 0x7e, 0xff, 0x45, 0x4a, 0x57, 0xc2, 0xc5, 0x81, 0x74, 0xa1,
+	0x17, 0x25, 0x5e, 0x03, 0x61, 0xbd, 0x28, 0x09, 0x25, 0x1d, 0xa1, 0x3a, 0x0c, 0x4b, 0x10, 0x70,
+	0xc7, 0x4b, 0x08, 0x8c, 0x80, 0x3b, 0xa1, 0x1c, 0x22, 0xac, 0x0e, 0xc1, 0x11, 0x89, 0xf4, 0x23,
+	0x99, 0x71, 0x76, 0xa4, 0x4f, 0xcb, 0xfb, 0x0b, 0xab, 0x43, 0x70, 0x84, 0x6c, 0x89, 0x92, 0x96,
+	0x67, 0xd8, 0x52, 0x72, 0xdd, 0x41, 0xb8, 0x36, 0x1c, 0x13, 0x11, 0xc1, 0x86, 0x63, 0xb1, 0xc4,
+	0x35, 0x9f, 0x1c, 0x05, 0xd1, 0xeb, 0x03, 0xc2, 0x95, 0xf4, 0x0c, 0x41, 0xa8, 0x16, 0x4d, 0x2e,
+	0x33, 0x42, 0x35, 0x6a, 0x5e, 0x5c, 0xa8, 0xa4, 0xa6, 0x0f, 0x80, 0xc6, 0x32, 0xc1, 0x0c, 0xa0,
+	0xf4, 0xdc, 0xb6, 0x70, 0x25, 0x3d, 0x43, 0x24, 0x26, 0x0d, 0xe5, 0x46, 0xd9, 0x31, 0x69, 0x7f,
+	0x06, 0x57, 0xa8, 0xa4, 0xa6, 0x0f, 0xae, 0x70, 0x5f, 0x46, 0x96, 0x5f, 0x1d, 0x60, 0x1c, 0x94,
+	0x8d, 0xab, 0xc3, 0xb0, 0x84, 0xe2, 0x1c, 0x5a, 0x7a, 0x95, 0xbf, 0x96, 0x32, 0xb2, 0x8e, 0x24,
+	0x87, 0x85, 0xeb, 0x43, 0x72, 0x45, 0x5d, 0x74, 0x3c, 0xc9, 0xc8, 0x76, 0xd1, 0x09, 0x49, 0x51,
+	0xe1, 0xda, 0x70, 0x4c, 0xa1, 0x50, 0x8b, 0x9e, 0xea, 0x64, 0x84, 0x5a, 0xcc, 0xec, 0xac, 0x70,
+	0x73, 0x68, 0x3e, 0x2c, 0x8b, 0x30, 0xf9, 0x92, 0x93, 0x74, 0x5e, 0x57, 0xde, 0xfa, 0x60, 0x81,
+	0x7b, 0xfb, 0x83, 0x05, 0xee, 0x9f, 0x1f, 0x2c, 0x70, 0x0f, 0x1e, 0x2e, 0x1c, 0x7a, 0xfb, 0xe1,
+	0xc2, 0xa1, 0x77, 0x1f, 0x2e, 0x1c, 0x82, 0x93, 0xaa, 0x41, 0x5d, 0xfb, 0x0e, 0xf7, 0xb5, 0x70,
+	0x03, 0x5e, 0x40, 0xb2, 0xa2, 0x1a, 0xa1, 0xaf, 0xca, 0x7d, 0xef, 0xff, 0xcc, 0xe0, 0xd6, 0xb4,
+	0x5b, 0x39, 0xb7, 0x8f, 0xfa, 0xea, 0xff, 0x07, 0x00, 0xca, 0x7d, 0xe5, 0xd7, 0xd5, 0x43, 0x00,
+	0x00,
 }
 
-func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSupplyIncreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyIncreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if this.TargetAddress != that1.TargetAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgSupplyDecreaseProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*MsgSupplyDecreaseProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSupplyDecreaseProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateRequiredAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*MsgUpdateRequiredAttributesRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateRequiredAttributesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.RemoveRequiredAttributes) != len(that1.RemoveRequiredAttributes) {
+		return false
+	}
+	for i := range this.RemoveRequiredAttributes {
+		if this.RemoveRequiredAttributes[i] != that1.RemoveRequiredAttributes[i] {
+			return false
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if len(this.AddRequiredAttributes) != len(that1.AddRequiredAttributes) {
+		return false
+	}
+	for i := range this.AddRequiredAttributes {
+		if this.AddRequiredAttributes[i] != that1.AddRequiredAttributes[i] {
+			return false
+		}
+	}
+	if this.TransferAuthority != that1.TransferAuthority {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateForcedTransferRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateForcedTransferRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateForcedTransferRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.AllowForcedTransfer != that1.AllowForcedTransfer {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
+func (this *MsgSetAccountDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*MsgSetAccountDataRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAccountDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if this.Value != that1.Value {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Signer != that1.Signer {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgUpdateSendDenyListRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*MsgUpdateSendDenyListRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateSendDenyListRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Denom != that1.Denom {
+		return false
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.RemoveDeniedAddresses) != len(that1.RemoveDeniedAddresses) {
+		return false
+	}
+	for i := range this.RemoveDeniedAddresses {
+		if this.RemoveDeniedAddresses[i] != that1.RemoveDeniedAddresses[i] {
+			return false
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.AddDeniedAddresses) != len(that1.AddDeniedAddresses) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.AddDeniedAddresses {
+		if this.AddDeniedAddresses[i] != that1.AddDeniedAddresses[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
+	return true
 }
+func (this *MsgSetAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Transfer.Size()
-		i -= size
-		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MsgSetAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgSetAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Access) != len(that1.Access) {
+		return false
 	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.Access {
+		if !this.Access[i].Equal(&that1.Access[i]) {
+			return false
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Authority != that1.Authority {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgRemoveAdministratorProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x68
+	that1, ok := that.(*MsgRemoveAdministratorProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgRemoveAdministratorProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Volume != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x60
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.UsdCents != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
-		i--
-		dAtA[i] = 0x58
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
+	if len(this.RemovedAddress) != len(that1.RemovedAddress) {
+		return false
 	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.RemovedAddress {
+		if this.RemovedAddress[i] != that1.RemovedAddress[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x48
 	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	if this.Authority != that1.Authority {
+		return false
 	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return true
+}
+func (this *MsgChangeStatusProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+
+	that1, ok := that.(*MsgChangeStatusProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgChangeStatusProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
+	if this.Denom != that1.Denom {
+		return false
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
+	if this.NewStatus != that1.NewStatus {
+		return false
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if this.Authority != that1.Authority {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgWithdrawEscrowProposalRequest)
+	if !ok {
+		that2, ok := that.(MsgWithdrawEscrowProposalRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.TargetAddress != that1.TargetAddress {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
 }
 
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Finalize
+	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// Delete
+	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn
+	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error)
+	// AddNetAssetValues set the net asset value for a marker
+	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateDistribution pays funds held in a marker's escrow to the holders of the marker's denom, pro-rata.
+	CreateDistribution(ctx context.Context, in *MsgCreateDistributionRequest, opts ...grpc.CallOption) (*MsgCreateDistributionResponse, error)
+	// SetLockup sets the schedule of amounts of a marker's denom that an account cannot send until their release times.
+	SetLockup(ctx context.Context, in *MsgSetLockupRequest, opts ...grpc.CallOption) (*MsgSetLockupResponse, error)
+	// RemoveLockup removes an account's lockup of a marker's denom.
+	RemoveLockup(ctx context.Context, in *MsgRemoveLockupRequest, opts ...grpc.CallOption) (*MsgRemoveLockupResponse, error)
+	// SplitMarker multiplies every balance of a marker's denom, and the marker's supply, by a ratio.
+	SplitMarker(ctx context.Context, in *MsgSplitMarkerRequest, opts ...grpc.CallOption) (*MsgSplitMarkerResponse, error)
+	// SetRedemptionConfig sets or removes how holders can redeem a marker's denom for funds in the marker's escrow.
+	SetRedemptionConfig(ctx context.Context, in *MsgSetRedemptionConfigRequest, opts ...grpc.CallOption) (*MsgSetRedemptionConfigResponse, error)
+	// Redeem burns units of a marker's denom and pays the redeemer from the marker's escrow.
+	Redeem(ctx context.Context, in *MsgRedeemRequest, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	// ApproveRedemption completes a redemption that is awaiting approval.
+	ApproveRedemption(ctx context.Context, in *MsgApproveRedemptionRequest, opts ...grpc.CallOption) (*MsgApproveRedemptionResponse, error)
+	// RejectRedemption cancels a redemption that is awaiting approval, returning the units to the redeemer.
+	RejectRedemption(ctx context.Context, in *MsgRejectRedemptionRequest, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error)
+	// SetTransferLimit sets how much of a marker's denom can be sent per rolling day and week, either by default or by
+	// a specific account.
+	SetTransferLimit(ctx context.Context, in *MsgSetTransferLimitRequest, opts ...grpc.CallOption) (*MsgSetTransferLimitResponse, error)
+	// RemoveTransferLimit removes a marker's default transfer limit or an account's transfer limit.
+	RemoveTransferLimit(ctx context.Context, in *MsgRemoveTransferLimitRequest, opts ...grpc.CallOption) (*MsgRemoveTransferLimitResponse, error)
+	// ProposeRecovery proposes a forced transfer that can be executed once its challenge period ends.
+	ProposeRecovery(ctx context.Context, in *MsgProposeRecoveryRequest, opts ...grpc.CallOption) (*MsgProposeRecoveryResponse, error)
+	// CancelRecovery cancels a proposed recovery. It can be signed by the owner of the funds or the proposer.
+	CancelRecovery(ctx context.Context, in *MsgCancelRecoveryRequest, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error)
+	// ExecuteRecovery completes a proposed recovery whose challenge period has ended.
+	ExecuteRecovery(ctx context.Context, in *MsgExecuteRecoveryRequest, opts ...grpc.CallOption) (*MsgExecuteRecoveryResponse, error)
+	// SetHolderLimit sets the maximum number of accounts that can hold a marker's denom and the minimum they can hold.
+	SetHolderLimit(ctx context.Context, in *MsgSetHolderLimitRequest, opts ...grpc.CallOption) (*MsgSetHolderLimitResponse, error)
+	// RemoveHolderLimit removes a marker's holder limit.
+	RemoveHolderLimit(ctx context.Context, in *MsgRemoveHolderLimitRequest, opts ...grpc.CallOption) (*MsgRemoveHolderLimitResponse, error)
+	// SetAccountDataSchema sets or removes the JSON schema that a marker's account data must conform to.
+	SetAccountDataSchema(ctx context.Context, in *MsgSetAccountDataSchemaRequest, opts ...grpc.CallOption) (*MsgSetAccountDataSchemaResponse, error)
+	// SetIbcChannelPolicy sets the IBC channels that a restricted marker's denom can be transferred over.
+	SetIbcChannelPolicy(ctx context.Context, in *MsgSetIbcChannelPolicyRequest, opts ...grpc.CallOption) (*MsgSetIbcChannelPolicyResponse, error)
+	// RemoveIbcChannelPolicy removes a marker's IBC channel policy.
+	RemoveIbcChannelPolicy(ctx context.Context, in *MsgRemoveIbcChannelPolicyRequest, opts ...grpc.CallOption) (*MsgRemoveIbcChannelPolicyResponse, error)
 }
 
-func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
+	out := new(MsgFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
+	out := new(MsgActivateResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
+	out := new(MsgCancelResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
+	out := new(MsgDeleteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
+	out := new(MsgAddAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
+	out := new(MsgDeleteAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
+	out := new(MsgAddMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) IbcTransfer(ctx context.Context, in *MsgIbcTransferRequest, opts ...grpc.CallOption) (*MsgIbcTransferResponse, error) {
+	out := new(MsgIbcTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/IbcTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error) {
+	out := new(MsgGrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/GrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransferAuthority) > 0 {
-		i -= len(m.TransferAuthority)
-		copy(dAtA[i:], m.TransferAuthority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveRequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	out := new(MsgAddFinalizeActivateMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddFinalizeActivateMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SupplyIncreaseProposal(ctx context.Context, in *MsgSupplyIncreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyIncreaseProposalResponse, error) {
+	out := new(MsgSupplyIncreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyIncreaseProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SupplyDecreaseProposal(ctx context.Context, in *MsgSupplyDecreaseProposalRequest, opts ...grpc.CallOption) (*MsgSupplyDecreaseProposalResponse, error) {
+	out := new(MsgSupplyDecreaseProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SupplyDecreaseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateRequiredAttributes(ctx context.Context, in *MsgUpdateRequiredAttributesRequest, opts ...grpc.CallOption) (*MsgUpdateRequiredAttributesResponse, error) {
+	out := new(MsgUpdateRequiredAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateRequiredAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateForcedTransfer(ctx context.Context, in *MsgUpdateForcedTransferRequest, opts ...grpc.CallOption) (*MsgUpdateForcedTransferResponse, error) {
+	out := new(MsgUpdateForcedTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateForcedTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
+	out := new(MsgSetAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateSendDenyList(ctx context.Context, in *MsgUpdateSendDenyListRequest, opts ...grpc.CallOption) (*MsgUpdateSendDenyListResponse, error) {
+	out := new(MsgUpdateSendDenyListResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendDenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
+	out := new(MsgAddNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddNetAssetValues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAdministratorProposal(ctx context.Context, in *MsgSetAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgSetAdministratorProposalResponse, error) {
+	out := new(MsgSetAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAdministratorProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveAdministratorProposal(ctx context.Context, in *MsgRemoveAdministratorProposalRequest, opts ...grpc.CallOption) (*MsgRemoveAdministratorProposalResponse, error) {
+	out := new(MsgRemoveAdministratorProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveAdministratorProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangeStatusProposal(ctx context.Context, in *MsgChangeStatusProposalRequest, opts ...grpc.CallOption) (*MsgChangeStatusProposalResponse, error) {
+	out := new(MsgChangeStatusProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ChangeStatusProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawEscrowProposal(ctx context.Context, in *MsgWithdrawEscrowProposalRequest, opts ...grpc.CallOption) (*MsgWithdrawEscrowProposalResponse, error) {
+	out := new(MsgWithdrawEscrowProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/WithdrawEscrowProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error) {
+	out := new(MsgSetDenomMetadataProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadataProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateDistribution(ctx context.Context, in *MsgCreateDistributionRequest, opts ...grpc.CallOption) (*MsgCreateDistributionResponse, error) {
+	out := new(MsgCreateDistributionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CreateDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetLockup(ctx context.Context, in *MsgSetLockupRequest, opts ...grpc.CallOption) (*MsgSetLockupResponse, error) {
+	out := new(MsgSetLockupResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveLockup(ctx context.Context, in *MsgRemoveLockupRequest, opts ...grpc.CallOption) (*MsgRemoveLockupResponse, error) {
+	out := new(MsgRemoveLockupResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveLockup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SplitMarker(ctx context.Context, in *MsgSplitMarkerRequest, opts ...grpc.CallOption) (*MsgSplitMarkerResponse, error) {
+	out := new(MsgSplitMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SplitMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveDeniedAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetRedemptionConfig(ctx context.Context, in *MsgSetRedemptionConfigRequest, opts ...grpc.CallOption) (*MsgSetRedemptionConfigResponse, error) {
+	out := new(MsgSetRedemptionConfigResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetRedemptionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeemRequest, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Redeem", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ApproveRedemption(ctx context.Context, in *MsgApproveRedemptionRequest, opts ...grpc.CallOption) (*MsgApproveRedemptionResponse, error) {
+	out := new(MsgApproveRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ApproveRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RejectRedemption(ctx context.Context, in *MsgRejectRedemptionRequest, opts ...grpc.CallOption) (*MsgRejectRedemptionResponse, error) {
+	out := new(MsgRejectRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RejectRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTransferLimit(ctx context.Context, in *MsgSetTransferLimitRequest, opts ...grpc.CallOption) (*MsgSetTransferLimitResponse, error) {
+	out := new(MsgSetTransferLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetTransferLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveTransferLimit(ctx context.Context, in *MsgRemoveTransferLimitRequest, opts ...grpc.CallOption) (*MsgRemoveTransferLimitResponse, error) {
+	out := new(MsgRemoveTransferLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveTransferLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ProposeRecovery(ctx context.Context, in *MsgProposeRecoveryRequest, opts ...grpc.CallOption) (*MsgProposeRecoveryResponse, error) {
+	out := new(MsgProposeRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ProposeRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelRecovery(ctx context.Context, in *MsgCancelRecoveryRequest, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error) {
+	out := new(MsgCancelRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelRecovery", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExecuteRecovery(ctx context.Context, in *MsgExecuteRecoveryRequest, opts ...grpc.CallOption) (*MsgExecuteRecoveryResponse, error) {
+	out := new(MsgExecuteRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ExecuteRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetHolderLimit(ctx context.Context, in *MsgSetHolderLimitRequest, opts ...grpc.CallOption) (*MsgSetHolderLimitResponse, error) {
+	out := new(MsgSetHolderLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetHolderLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveHolderLimit(ctx context.Context, in *MsgRemoveHolderLimitRequest, opts ...grpc.CallOption) (*MsgRemoveHolderLimitResponse, error) {
+	out := new(MsgRemoveHolderLimitResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveHolderLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAccountDataSchema(ctx context.Context, in *MsgSetAccountDataSchemaRequest, opts ...grpc.CallOption) (*MsgSetAccountDataSchemaResponse, error) {
+	out := new(MsgSetAccountDataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetAccountDataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetIbcChannelPolicy(ctx context.Context, in *MsgSetIbcChannelPolicyRequest, opts ...grpc.CallOption) (*MsgSetIbcChannelPolicyResponse, error) {
+	out := new(MsgSetIbcChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetIbcChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveIbcChannelPolicy(ctx context.Context, in *MsgRemoveIbcChannelPolicyRequest, opts ...grpc.CallOption) (*MsgRemoveIbcChannelPolicyResponse, error) {
+	out := new(MsgRemoveIbcChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/RemoveIbcChannelPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Finalize
+	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
+	// Delete
+	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
+	// Burn
+	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
+	// Transfer over ibc any marker(including restricted markers) between ibc accounts.
+	// The relayer is still needed to accomplish ibc middleware relays.
+	IbcTransfer(context.Context, *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
+	// GrantAllowance grants fee allowance to the grantee on the granter's
+	// account with the provided expiration time.
+	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
+	// AddFinalizeActivateMarker
+	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SupplyIncreaseProposal can only be called via gov proposal
+	SupplyIncreaseProposal(context.Context, *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error)
+	// SupplyDecreaseProposal can only be called via gov proposal
+	SupplyDecreaseProposal(context.Context, *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error)
+	// UpdateRequiredAttributes will only succeed if signer has transfer authority
+	UpdateRequiredAttributes(context.Context, *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error)
+	// UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
+	UpdateForcedTransfer(context.Context, *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error)
+	// SetAccountData sets the accountdata for a denom. Signer must have deposit authority.
+	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// UpdateSendDenyList will only succeed if signer has admin authority
+	UpdateSendDenyList(context.Context, *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error)
+	// AddNetAssetValues set the net asset value for a marker
+	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// SetAdministratorProposal sets administrators with specific access on the marker
+	SetAdministratorProposal(context.Context, *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error)
+	// RemoveAdministratorProposal removes administrators with specific access on the marker
+	RemoveAdministratorProposal(context.Context, *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error)
+	// ChangeStatusProposal is a governance proposal change marker status
+	ChangeStatusProposal(context.Context, *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error)
+	// WithdrawEscrowProposal is a governance proposal to withdraw escrow coins from a marker
+	WithdrawEscrowProposal(context.Context, *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error)
+	// SetDenomMetadataProposal is a governance proposal to set marker metadata
+	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// CreateDistribution pays funds held in a marker's escrow to the holders of the marker's denom, pro-rata.
+	CreateDistribution(context.Context, *MsgCreateDistributionRequest) (*MsgCreateDistributionResponse, error)
+	// SetLockup sets the schedule of amounts of a marker's denom that an account cannot send until their release times.
+	SetLockup(context.Context, *MsgSetLockupRequest) (*MsgSetLockupResponse, error)
+	// RemoveLockup removes an account's lockup of a marker's denom.
+	RemoveLockup(context.Context, *MsgRemoveLockupRequest) (*MsgRemoveLockupResponse, error)
+	// SplitMarker multiplies every balance of a marker's denom, and the marker's supply, by a ratio.
+	SplitMarker(context.Context, *MsgSplitMarkerRequest) (*MsgSplitMarkerResponse, error)
+	// SetRedemptionConfig sets or removes how holders can redeem a marker's denom for funds in the marker's escrow.
+	SetRedemptionConfig(context.Context, *MsgSetRedemptionConfigRequest) (*MsgSetRedemptionConfigResponse, error)
+	// Redeem burns units of a marker's denom and pays the redeemer from the marker's escrow.
+	Redeem(context.Context, *MsgRedeemRequest) (*MsgRedeemResponse, error)
+	// ApproveRedemption completes a redemption that is awaiting approval.
+	ApproveRedemption(context.Context, *MsgApproveRedemptionRequest) (*MsgApproveRedemptionResponse, error)
+	// RejectRedemption cancels a redemption that is awaiting approval, returning the units to the redeemer.
+	RejectRedemption(context.Context, *MsgRejectRedemptionRequest) (*MsgRejectRedemptionResponse, error)
+	// SetTransferLimit sets how much of a marker's denom can be sent per rolling day and week, either by default or by
+	// a specific account.
+	SetTransferLimit(context.Context, *MsgSetTransferLimitRequest) (*MsgSetTransferLimitResponse, error)
+	// RemoveTransferLimit removes a marker's default transfer limit or an account's transfer limit.
+	RemoveTransferLimit(context.Context, *MsgRemoveTransferLimitRequest) (*MsgRemoveTransferLimitResponse, error)
+	// ProposeRecovery proposes a forced transfer that can be executed once its challenge period ends.
+	ProposeRecovery(context.Context, *MsgProposeRecoveryRequest) (*MsgProposeRecoveryResponse, error)
+	// CancelRecovery cancels a proposed recovery. It can be signed by the owner of the funds or the proposer.
+	CancelRecovery(context.Context, *MsgCancelRecoveryRequest) (*MsgCancelRecoveryResponse, error)
+	// ExecuteRecovery completes a proposed recovery whose challenge period has ended.
+	ExecuteRecovery(context.Context, *MsgExecuteRecoveryRequest) (*MsgExecuteRecoveryResponse, error)
+	// SetHolderLimit sets the maximum number of accounts that can hold a marker's denom and the minimum they can hold.
+	SetHolderLimit(context.Context, *MsgSetHolderLimitRequest) (*MsgSetHolderLimitResponse, error)
+	// RemoveHolderLimit removes a marker's holder limit.
+	RemoveHolderLimit(context.Context, *MsgRemoveHolderLimitRequest) (*MsgRemoveHolderLimitResponse, error)
+	// SetAccountDataSchema sets or removes the JSON schema that a marker's account data must conform to.
+	SetAccountDataSchema(context.Context, *MsgSetAccountDataSchemaRequest) (*MsgSetAccountDataSchemaResponse, error)
+	// SetIbcChannelPolicy sets the IBC channels that a restricted marker's denom can be transferred over.
+	SetIbcChannelPolicy(context.Context, *MsgSetIbcChannelPolicyRequest) (*MsgSetIbcChannelPolicyResponse, error)
+	// RemoveIbcChannelPolicy removes a marker's IBC channel policy.
+	RemoveIbcChannelPolicy(context.Context, *MsgRemoveIbcChannelPolicyRequest) (*MsgRemoveIbcChannelPolicyResponse, error)
 }
 
-func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
 }
-
-func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
-
-func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RemovedAddress) > 0 {
-		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedAddress[iNdEx])
-			copy(dAtA[i:], m.RemovedAddress[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
-
-func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-
-func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
 }
-
-func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
-
-func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
 }
-
-func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
 }
-
-func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.NewStatus != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
-
-func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
 }
-
-func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
-
-func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) IbcTransfer(ctx context.Context, req *MsgIbcTransferRequest) (*MsgIbcTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcTransfer not implemented")
 }
-
-func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
 }
-
-func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowance not implemented")
 }
-
-func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
+}
+func (*UnimplementedMsgServer) SupplyIncreaseProposal(ctx context.Context, req *MsgSupplyIncreaseProposalRequest) (*MsgSupplyIncreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyIncreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) SupplyDecreaseProposal(ctx context.Context, req *MsgSupplyDecreaseProposalRequest) (*MsgSupplyDecreaseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDecreaseProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRequiredAttributes(ctx context.Context, req *MsgUpdateRequiredAttributesRequest) (*MsgUpdateRequiredAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRequiredAttributes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateForcedTransfer(ctx context.Context, req *MsgUpdateForcedTransferRequest) (*MsgUpdateForcedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateForcedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSendDenyList(ctx context.Context, req *MsgUpdateSendDenyListRequest) (*MsgUpdateSendDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendDenyList not implemented")
+}
+func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+}
+func (*UnimplementedMsgServer) SetAdministratorProposal(ctx context.Context, req *MsgSetAdministratorProposalRequest) (*MsgSetAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAdministratorProposal(ctx context.Context, req *MsgRemoveAdministratorProposalRequest) (*MsgRemoveAdministratorProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdministratorProposal not implemented")
+}
+func (*UnimplementedMsgServer) ChangeStatusProposal(ctx context.Context, req *MsgChangeStatusProposalRequest) (*MsgChangeStatusProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatusProposal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawEscrowProposal(ctx context.Context, req *MsgWithdrawEscrowProposalRequest) (*MsgWithdrawEscrowProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEscrowProposal not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadataProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateDistribution(ctx context.Context, req *MsgCreateDistributionRequest) (*MsgCreateDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SetLockup(ctx context.Context, req *MsgSetLockupRequest) (*MsgSetLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockup not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLockup(ctx context.Context, req *MsgRemoveLockupRequest) (*MsgRemoveLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLockup not implemented")
+}
+func (*UnimplementedMsgServer) SplitMarker(ctx context.Context, req *MsgSplitMarkerRequest) (*MsgSplitMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitMarker not implemented")
+}
+func (*UnimplementedMsgServer) SetRedemptionConfig(ctx context.Context, req *MsgSetRedemptionConfigRequest) (*MsgSetRedemptionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRedemptionConfig not implemented")
+}
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeemRequest) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) ApproveRedemption(ctx context.Context, req *MsgApproveRedemptionRequest) (*MsgApproveRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRedemption not implemented")
+}
+func (*UnimplementedMsgServer) RejectRedemption(ctx context.Context, req *MsgRejectRedemptionRequest) (*MsgRejectRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRedemption not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferLimit(ctx context.Context, req *MsgSetTransferLimitRequest) (*MsgSetTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTransferLimit(ctx context.Context, req *MsgRemoveTransferLimitRequest) (*MsgRemoveTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransferLimit not implemented")
+}
+func (*UnimplementedMsgServer) ProposeRecovery(ctx context.Context, req *MsgProposeRecoveryRequest) (*MsgProposeRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRecovery not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecovery(ctx context.Context, req *MsgCancelRecoveryRequest) (*MsgCancelRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecovery not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteRecovery(ctx context.Context, req *MsgExecuteRecoveryRequest) (*MsgExecuteRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecovery not implemented")
+}
+func (*UnimplementedMsgServer) SetHolderLimit(ctx context.Context, req *MsgSetHolderLimitRequest) (*MsgSetHolderLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHolderLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveHolderLimit(ctx context.Context, req *MsgRemoveHolderLimitRequest) (*MsgRemoveHolderLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHolderLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountDataSchema(ctx context.Context, req *MsgSetAccountDataSchemaRequest) (*MsgSetAccountDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountDataSchema not implemented")
+}
+func (*UnimplementedMsgServer) SetIbcChannelPolicy(ctx context.Context, req *MsgSetIbcChannelPolicyRequest) (*MsgSetIbcChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIbcChannelPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveIbcChannelPolicy(ctx context.Context, req *MsgRemoveIbcChannelPolicyRequest) (*MsgRemoveIbcChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIbcChannelPolicy not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).Finalize(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Finalize",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Activate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccess(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAccessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarkerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarker(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgGrantAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Transfer",
 	}
-	return n
-}
-
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_IbcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIbcTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).IbcTransfer(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/IbcTransfer",
 	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IbcTransfer(ctx, req.(*MsgIbcTransferRequest))
 	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SupplyFixed {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowance(ctx, in)
 	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/GrantAllowance",
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowance(ctx, req.(*MsgGrantAllowanceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalizeActivateMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddFinalizeActivateMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalizeActivateMarker(ctx, req.(*MsgAddFinalizeActivateMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SupplyIncreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyIncreaseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, in)
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyIncreaseProposal",
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyIncreaseProposal(ctx, req.(*MsgSupplyIncreaseProposalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SupplyDecreaseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupplyDecreaseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SupplyDecreaseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupplyDecreaseProposal(ctx, req.(*MsgSupplyDecreaseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRequiredAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRequiredAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, in)
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateRequiredAttributes",
 	}
-	l = len(m.RemovedAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRequiredAttributes(ctx, req.(*MsgUpdateRequiredAttributesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateForcedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateForcedTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgFinalizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateForcedTransfer",
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateForcedTransfer(ctx, req.(*MsgUpdateForcedTransferRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountData(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAccountData",
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateSendDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendDenyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateSendDenyList",
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendDenyList(ctx, req.(*MsgUpdateSendDenyListRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).AddNetAssetValues(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddNetAssetValues",
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAdministratorProposal",
 	}
-	return n
-}
-
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAdministratorProposal(ctx, req.(*MsgSetAdministratorProposalRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveAdministratorProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAdministratorProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveAdministratorProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAdministratorProposal(ctx, req.(*MsgRemoveAdministratorProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ChangeStatusProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeStatusProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ChangeStatusProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeStatusProposal(ctx, req.(*MsgChangeStatusProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_WithdrawEscrowProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawEscrowProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, in)
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/WithdrawEscrowProposal",
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawEscrowProposal(ctx, req.(*MsgWithdrawEscrowProposalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetDenomMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadataProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadataProposal(ctx, req.(*MsgSetDenomMetadataProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateParams",
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgIbcTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDistribution(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Transfer.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CreateDistribution",
 	}
-	return n
-}
-
-func (m *MsgIbcTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDistribution(ctx, req.(*MsgCreateDistributionRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLockupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetLockup(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgSetDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetLockup",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLockup(ctx, req.(*MsgSetLockupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLockupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLockup(ctx, in)
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveLockup",
 	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLockup(ctx, req.(*MsgRemoveLockupRequest))
 	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SupplyFixed {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).SplitMarker(ctx, in)
 	}
-	if m.AllowGovernanceControl {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SplitMarker",
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitMarker(ctx, req.(*MsgSplitMarkerRequest))
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRedemptionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRedemptionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UsdCents != 0 {
-		n += 1 + sovTx(uint64(m.UsdCents))
+	if interceptor == nil {
+		return srv.(MsgServer).SetRedemptionConfig(ctx, in)
 	}
-	if m.Volume != 0 {
-		n += 1 + sovTx(uint64(m.Volume))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetRedemptionConfig",
 	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRedemptionConfig(ctx, req.(*MsgSetRedemptionConfigRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Redeem",
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeemRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApproveRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveRedemption(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ApproveRedemption",
 	}
-	return n
-}
-
-func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveRedemption(ctx, req.(*MsgApproveRedemptionRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RejectRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RemoveRequiredAttributes) > 0 {
-		for _, s := range m.RemoveRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRedemption(ctx, in)
 	}
-	if len(m.AddRequiredAttributes) > 0 {
-		for _, s := range m.AddRequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RejectRedemption",
 	}
-	l = len(m.TransferAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRedemption(ctx, req.(*MsgRejectRedemptionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetTransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetTransferLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferLimit(ctx, req.(*MsgSetTransferLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveTransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTransferLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTransferLimit(ctx, in)
 	}
-	if m.AllowForcedTransfer {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveTransferLimit",
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTransferLimit(ctx, req.(*MsgRemoveTransferLimitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ProposeRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ProposeRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRecovery(ctx, req.(*MsgProposeRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecovery(ctx, in)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelRecovery",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecovery(ctx, req.(*MsgCancelRecoveryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExecuteRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ExecuteRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteRecovery(ctx, req.(*MsgExecuteRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SetHolderLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHolderLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RemoveDeniedAddresses) > 0 {
-		for _, s := range m.RemoveDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHolderLimit(ctx, in)
 	}
-	if len(m.AddDeniedAddresses) > 0 {
-		for _, s := range m.AddDeniedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetHolderLimit",
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHolderLimit(ctx, req.(*MsgSetHolderLimitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveHolderLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHolderLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHolderLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveHolderLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHolderLimit(ctx, req.(*MsgRemoveHolderLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAccountDataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountDataSchema(ctx, in)
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetAccountDataSchema",
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountDataSchema(ctx, req.(*MsgSetAccountDataSchemaRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetIbcChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIbcChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetIbcChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetIbcChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIbcChannelPolicy(ctx, req.(*MsgSetIbcChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveIbcChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIbcChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveIbcChannelPolicy(ctx, in)
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/RemoveIbcChannelPolicy",
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveIbcChannelPolicy(ctx, req.(*MsgRemoveIbcChannelPolicyRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Finalize",
+			Handler:    _Msg_Finalize_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Msg_Activate_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Msg_Cancel_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Msg_Delete_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "AddAccess",
+			Handler:    _Msg_AddAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _Msg_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "AddMarker",
+			Handler:    _Msg_AddMarker_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+		{
+			MethodName: "IbcTransfer",
+			Handler:    _Msg_IbcTransfer_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "GrantAllowance",
+			Handler:    _Msg_GrantAllowance_Handler,
+		},
+		{
+			MethodName: "AddFinalizeActivateMarker",
+			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
+		},
+		{
+			MethodName: "SupplyIncreaseProposal",
+			Handler:    _Msg_SupplyIncreaseProposal_Handler,
+		},
+		{
+			MethodName: "SupplyDecreaseProposal",
+			Handler:    _Msg_SupplyDecreaseProposal_Handler,
+		},
+		{
+			MethodName: "UpdateRequiredAttributes",
+			Handler:    _Msg_UpdateRequiredAttributes_Handler,
+		},
+		{
+			MethodName: "UpdateForcedTransfer",
+			Handler:    _Msg_UpdateForcedTransfer_Handler,
+		},
+		{
+			MethodName: "SetAccountData",
+			Handler:    _Msg_SetAccountData_Handler,
+		},
+		{
+			MethodName: "UpdateSendDenyList",
+			Handler:    _Msg_UpdateSendDenyList_Handler,
+		},
+		{
+			MethodName: "AddNetAssetValues",
+			Handler:    _Msg_AddNetAssetValues_Handler,
+		},
+		{
+			MethodName: "SetAdministratorProposal",
+			Handler:    _Msg_SetAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "RemoveAdministratorProposal",
+			Handler:    _Msg_RemoveAdministratorProposal_Handler,
+		},
+		{
+			MethodName: "ChangeStatusProposal",
+			Handler:    _Msg_ChangeStatusProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawEscrowProposal",
+			Handler:    _Msg_WithdrawEscrowProposal_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadataProposal",
+			Handler:    _Msg_SetDenomMetadataProposal_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateDistribution",
+			Handler:    _Msg_CreateDistribution_Handler,
+		},
+		{
+			MethodName: "SetLockup",
+			Handler:    _Msg_SetLockup_Handler,
+		},
+		{
+			MethodName: "RemoveLockup",
+			Handler:    _Msg_RemoveLockup_Handler,
+		},
+		{
+			MethodName: "SplitMarker",
+			Handler:    _Msg_SplitMarker_Handler,
+		},
+		{
+			MethodName: "SetRedemptionConfig",
+			Handler:    _Msg_SetRedemptionConfig_Handler,
+		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "ApproveRedemption",
+			Handler:    _Msg_ApproveRedemption_Handler,
+		},
+		{
+			MethodName: "RejectRedemption",
+			Handler:    _Msg_RejectRedemption_Handler,
+		},
+		{
+			MethodName: "SetTransferLimit",
+			Handler:    _Msg_SetTransferLimit_Handler,
+		},
+		{
+			MethodName: "RemoveTransferLimit",
+			Handler:    _Msg_RemoveTransferLimit_Handler,
+		},
+		{
+			MethodName: "ProposeRecovery",
+			Handler:    _Msg_ProposeRecovery_Handler,
+		},
+		{
+			MethodName: "CancelRecovery",
+			Handler:    _Msg_CancelRecovery_Handler,
+		},
+		{
+			MethodName: "ExecuteRecovery",
+			Handler:    _Msg_ExecuteRecovery_Handler,
+		},
+		{
+			MethodName: "SetHolderLimit",
+			Handler:    _Msg_SetHolderLimit_Handler,
+		},
+		{
+			MethodName: "RemoveHolderLimit",
+			Handler:    _Msg_RemoveHolderLimit_Handler,
+		},
+		{
+			MethodName: "SetAccountDataSchema",
+			Handler:    _Msg_SetAccountDataSchema_Handler,
+		},
+		{
+			MethodName: "SetIbcChannelPolicy",
+			Handler:    _Msg_SetIbcChannelPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveIbcChannelPolicy",
+			Handler:    _Msg_RemoveIbcChannelPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/tx.proto",
 }
 
-func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RemovedAddress) > 0 {
-		for _, s := range m.RemovedAddress {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeStatusProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.NewStatus != 0 {
-		n += 1 + sovTx(uint64(m.NewStatus))
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
-}
-
-func (m *MsgChangeStatusProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedAddress) > 0 {
+		i -= len(m.RemovedAddress)
+		copy(dAtA[i:], m.RemovedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIbcTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIbcTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIbcTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Transfer.Size()
+		i -= size
+		if _, err := m.Transfer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIbcTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIbcTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIbcTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Volume != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.UsdCents != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdCents))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferAuthority) > 0 {
+		i -= len(m.TransferAuthority)
+		copy(dAtA[i:], m.TransferAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAuthority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for iNdEx := len(m.AddRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.AddRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for iNdEx := len(m.RemoveRequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveRequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RemoveRequiredAttributes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveRequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateForcedTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateForcedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateForcedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddDeniedAddresses) > 0 {
+		for iNdEx := len(m.AddDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for iNdEx := len(m.RemoveDeniedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDeniedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveDeniedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDeniedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemovedAddress) > 0 {
+		for iNdEx := len(m.RemovedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddress[iNdEx])
+			copy(dAtA[i:], m.RemovedAddress[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeStatusProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeStatusProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Denominator != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRedemptionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRedemptionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRedemptionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTransferLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTransferLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTransferLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTransferLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTransferLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTransferLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecoveryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecoveryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecoveryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHolderLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHolderLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHolderLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHolderLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHolderLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHolderLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHolderLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHolderLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHolderLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHolderLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHolderLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHolderLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountDataSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIbcChannelPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIbcChannelPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIbcChannelPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIbcChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIbcChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIbcChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIbcChannelPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIbcChannelPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIbcChannelPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIbcChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIbcChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIbcChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgAddMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RemovedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIbcTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIbcTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UsdCents != 0 {
+		n += 1 + sovTx(uint64(m.UsdCents))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovTx(uint64(m.Volume))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyIncreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupplyDecreaseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveRequiredAttributes) > 0 {
+		for _, s := range m.RemoveRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddRequiredAttributes) > 0 {
+		for _, s := range m.AddRequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TransferAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRequiredAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateForcedTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateForcedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSendDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemoveDeniedAddresses) > 0 {
+		for _, s := range m.RemoveDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddDeniedAddresses) > 0 {
+		for _, s := range m.AddDeniedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSendDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemovedAddress) > 0 {
+		for _, s := range m.RemovedAddress {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAdministratorProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeStatusProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovTx(uint64(m.NewStatus))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeStatusProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawEscrowProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *MsgSetLockupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveLockupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSplitMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Numerator != 0 {
+		n += 1 + sovTx(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovTx(uint64(m.Denominator))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRedemptionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRedemptionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgApproveRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRejectRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTransferLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveTransferLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTransferLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryId != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryId))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryId != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecoveryId != 0 {
+		n += 1 + sovTx(uint64(m.RecoveryId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHolderLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHolderLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveHolderLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveHolderLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccountDataSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIbcChannelPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIbcChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveIbcChannelPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveIbcChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
+			}
+			m.UsdCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdCents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7570,10 +15655,10 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7610,7 +15695,7 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7638,11 +15723,11 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7670,13 +15755,13 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7686,27 +15771,207 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &types.Any{}
+			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7729,7 +15994,7 @@ func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7752,10 +16017,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7779,7 +16044,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7802,48 +16067,15 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7871,11 +16103,11 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7903,143 +16135,11 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
-			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8067,65 +16167,8 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
-			}
-			m.UsdCents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8147,7 +16190,7 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8170,10 +16213,10 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8197,7 +16240,7 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8220,10 +16263,10 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8260,7 +16303,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8288,11 +16331,107 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8319,8 +16458,10 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8345,7 +16486,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8368,10 +16509,10 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8395,7 +16536,7 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8418,10 +16559,10 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8490,9 +16631,9 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8502,23 +16643,25 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8541,7 +16684,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8564,10 +16707,10 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8591,7 +16734,7 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8614,10 +16757,10 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8654,7 +16797,41 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8682,7 +16859,7 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8705,7 +16882,7 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8728,10 +16905,10 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8755,7 +16932,7 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8778,10 +16955,10 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8818,7 +16995,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8846,7 +17023,39 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8869,7 +17078,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8892,10 +17101,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8919,7 +17128,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8942,10 +17151,10 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8978,11 +17187,30 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9010,7 +17238,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9033,7 +17261,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9056,10 +17284,10 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9083,7 +17311,7 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9106,10 +17334,10 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9146,7 +17374,41 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9174,7 +17436,39 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9197,7 +17491,7 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9220,10 +17514,10 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9247,7 +17541,7 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9270,15 +17564,15 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9305,13 +17599,13 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9339,7 +17633,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9362,7 +17656,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9385,10 +17679,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9412,7 +17706,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9435,17 +17729,17 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9455,30 +17749,29 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9488,23 +17781,24 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9527,7 +17821,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9550,10 +17844,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9577,7 +17871,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9600,10 +17894,10 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9640,9 +17934,9 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9652,27 +17946,29 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9700,41 +17996,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9757,7 +18019,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9780,12 +18042,31 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9807,7 +18088,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLockupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9830,17 +18111,17 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLockupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLockupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9850,28 +18131,27 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9899,13 +18179,13 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9915,27 +18195,29 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Releases = append(m.Releases, LockupRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9963,7 +18245,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9986,7 +18268,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10009,10 +18291,10 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10036,7 +18318,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveLockupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10059,17 +18341,17 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveLockupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveLockupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10079,28 +18361,27 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10128,7 +18409,39 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10151,7 +18464,7 @@ func (m *MsgIbcTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10174,10 +18487,10 @@ func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIbcTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10201,7 +18514,7 @@ func (m *MsgIbcTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitMarkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10224,17 +18537,17 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitMarkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10244,28 +18557,65 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10293,7 +18643,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10316,7 +18666,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitMarkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10339,12 +18689,46 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitMarkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10366,7 +18750,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10379,90 +18763,25 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRedemptionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRedemptionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10490,30 +18809,11 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
-			}
-			m.MarkerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10540,74 +18840,16 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &RedemptionConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupplyFixed = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10635,65 +18877,8 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdCents", wireType)
-			}
-			m.UsdCents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdCents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
-			}
-			m.Volume = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10715,7 +18900,7 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRedemptionConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10738,10 +18923,10 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRedemptionConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalizeActivateMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRedemptionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10765,7 +18950,7 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10788,10 +18973,10 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10829,39 +19014,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10889,7 +19042,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10912,7 +19065,7 @@ func (m *MsgSupplyIncreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10922,25 +19075,78 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Payout = append(m.Payout, types1.Coin{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyIncreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10962,7 +19168,7 @@ func (m *MsgSupplyIncreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10985,17 +19191,17 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11005,26 +19211,44 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -11077,7 +19301,7 @@ func (m *MsgSupplyDecreaseProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11100,12 +19324,46 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSupplyDecreaseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = append(m.Payout, types1.Coin{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11127,7 +19385,7 @@ func (m *MsgSupplyDecreaseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11150,10 +19408,10 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11189,10 +19447,10 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRequiredAttributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var stringLen uint64
+			m.RedemptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11202,59 +19460,14 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RedemptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoveRequiredAttributes = append(m.RemoveRequiredAttributes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddRequiredAttributes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddRequiredAttributes = append(m.AddRequiredAttributes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11282,7 +19495,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferAuthority = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11305,7 +19518,7 @@ func (m *MsgUpdateRequiredAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11328,10 +19541,10 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRequiredAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11355,7 +19568,7 @@ func (m *MsgUpdateRequiredAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11378,17 +19591,17 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11398,45 +19611,26 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -11489,7 +19683,7 @@ func (m *MsgUpdateForcedTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11512,10 +19706,10 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateForcedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11539,7 +19733,7 @@ func (m *MsgUpdateForcedTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTransferLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11562,10 +19756,10 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTransferLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTransferLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11602,7 +19796,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11630,11 +19824,11 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11662,7 +19856,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11685,7 +19879,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTransferLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11708,10 +19902,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTransferLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTransferLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11735,7 +19929,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeRecoveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11758,15 +19952,48 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeRecoveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11794,11 +20021,11 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDeniedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11826,11 +20053,11 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveDeniedAddresses = append(m.RemoveDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddDeniedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11858,11 +20085,11 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddDeniedAddresses = append(m.AddDeniedAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11890,7 +20117,7 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11913,7 +20140,7 @@ func (m *MsgUpdateSendDenyListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeRecoveryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11936,12 +20163,31 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeRecoveryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSendDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
+			}
+			m.RecoveryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11963,7 +20209,7 @@ func (m *MsgUpdateSendDenyListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecoveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11986,10 +20232,10 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecoveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12025,10 +20271,10 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
 			}
-			var stringLen uint64
+			m.RecoveryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12038,29 +20284,16 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RecoveryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12070,25 +20303,23 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12111,7 +20342,7 @@ func (m *MsgAddNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecoveryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12134,10 +20365,10 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12161,7 +20392,7 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteRecoveryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12184,10 +20415,10 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteRecoveryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12223,10 +20454,10 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryId", wireType)
 			}
-			var msglen int
+			m.RecoveryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12236,29 +20467,14 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecoveryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12286,7 +20502,7 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12309,7 +20525,7 @@ func (m *MsgSetAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteRecoveryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12332,10 +20548,10 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteRecoveryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12359,7 +20575,7 @@ func (m *MsgSetAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetHolderLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12382,17 +20598,17 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetHolderLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetHolderLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12402,57 +20618,26 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -12505,7 +20690,7 @@ func (m *MsgRemoveAdministratorProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetHolderLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12528,10 +20713,10 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetHolderLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdministratorProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetHolderLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12555,7 +20740,7 @@ func (m *MsgRemoveAdministratorProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveHolderLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12578,10 +20763,10 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveHolderLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveHolderLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12617,25 +20802,6 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
-			}
-			m.NewStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewStatus |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -12688,7 +20854,7 @@ func (m *MsgChangeStatusProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveHolderLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12711,10 +20877,10 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveHolderLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeStatusProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveHolderLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12738,7 +20904,7 @@ func (m *MsgChangeStatusProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12761,10 +20927,10 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12801,41 +20967,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12863,11 +20995,11 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12895,7 +21027,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12918,7 +21050,7 @@ func (m *MsgWithdrawEscrowProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12941,10 +21073,10 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawEscrowProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12968,7 +21100,7 @@ func (m *MsgWithdrawEscrowProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIbcChannelPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12991,15 +21123,15 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIbcChannelPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIbcChannelPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13026,7 +21158,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13083,7 +21215,7 @@ func (m *MsgSetDenomMetadataProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIbcChannelPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13106,10 +21238,10 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIbcChannelPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIbcChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13133,7 +21265,7 @@ func (m *MsgSetDenomMetadataProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveIbcChannelPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13156,15 +21288,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveIbcChannelPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveIbcChannelPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13192,13 +21324,13 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13208,24 +21340,23 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13248,7 +21379,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveIbcChannelPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13271,10 +21402,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveIbcChannelPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveIbcChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -308,7 +310,7 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 		[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
 	)
 
-	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\",\"optional\":false}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\",\"require_party_rollup\":false}",
+	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\",\"optional\":false}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\",\"require_party_rollup\":false,\"parent_scope_id\":null}",
 		s.scopeID,
 		s.scopeSpecID,
 		s.user1AddrStr,
@@ -321,6 +323,7 @@ owners:
 - address: %s
   optional: false
   role: PARTY_TYPE_OWNER
+parent_scope_id: null
 require_party_rollup: false
 scope_id: %s
 specification_id: %s
@@ -359,7 +362,7 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}],\"specification_id\":\"%s\"}",
+	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\",\"schema_hash\":\"\",\"payload\":\"\"}],\"specification_id\":\"%s\"}",
 		s.sessionID,
 		s.recordSpecID,
 	)
@@ -371,6 +374,8 @@ specification_id: %s`,
 name: recordname
 outputs:
 - hash: notarealrecordoutputhash
+  payload: ""
+  schema_hash: ""
   status: RESULT_STATUS_PASS
 process:
   hash: notarealprocesshash
@@ -382,41 +387,46 @@ specification_id: %s`,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"version\":0,\"deprecated\":false,\"previous_spec_id\":null}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
 	)
 	s.scopeSpecAsText = fmt.Sprintf(`contract_spec_ids:
 - %s
+deprecated: false
 description: null
 owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
-specification_id: %s`,
+previous_spec_id: null
+specification_id: %s
+version: 0`,
 		s.contractSpecID,
 		s.user1AddrStr,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"version\":0,\"deprecated\":false}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
 	s.contractSpecAsText = fmt.Sprintf(`class_name: contractclassname
+deprecated: false
 description: null
 hash: notreallyasourcehash
 owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
-specification_id: %s`,
+specification_id: %s
+version: 0`,
 		s.user1AddrStr,
 		s.contractSpecID,
 	)
 
-	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"]}",
+	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"],\"output_schema\":null}",
 		s.recordSpecID,
 	)
 	s.recordSpecAsText = fmt.Sprintf(`inputs:
@@ -424,6 +434,7 @@ specification_id: %s`,
   name: inputname
   type_name: inputtypename
 name: recordname
+output_schema: null
 responsible_parties:
 - PARTY_TYPE_OWNER
 result_type: DEFINITION_TYPE_RECORD
@@ -438,19 +449,30 @@ type_name: recordtypename`,
 		if len(eKey) == 0 {
 			eKey = "\"\""
 		}
+		name := loc.Name
+		if len(name) == 0 {
+			name = "\"\""
+		}
 		return fmt.Sprintf(`encryption_key: %s
+encryption_key_versions: []
 locator_uri: %s
-owner: %s`,
+name: %s
+owner: %s
+primary: %t`,
 			eKey,
 			loc.LocatorUri,
+			name,
 			loc.Owner,
+			loc.Primary,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"name\":\"%s\",\"primary\":%t,\"encryption_key_versions\":[]}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
+			loc.Name,
+			loc.Primary,
 		)
 	}
 	s.ownerAddr1 = s.user1Addr
@@ -657,12 +679,12 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output",
 			args:   []string{s.asJson},
-			expOut: []string{"\"params\":{}"},
+			expOut: []string{"\"params\":{\"max_scope_history_entries\":100}"},
 		},
 		{
 			name:   "get params as text output",
 			args:   []string{s.asText},
-			expOut: []string{"params: {}"},
+			expOut: []string{"params:", "max_scope_history_entries: 100"},
 		},
 		{
 			name:   "get params - invalid args",
@@ -672,7 +694,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output including request",
 			args:   []string{s.asJson, s.includeRequest},
-			expOut: []string{"\"params\":{}", "\"request\":{\"include_request\":true}"},
+			expOut: []string{"\"params\":{\"max_scope_history_entries\":100}", "\"request\":{\"include_request\":true}"},
 		},
 		{
 			name:   "get locator params as json",
@@ -1802,6 +1824,19 @@ type txCmdTestCase struct {
 	expectedCode uint32
 }
 
+// writeBulkJSONFile writes the entries as a JSON array to a new file in a temp dir, and returns the file's path.
+func (s *IntegrationCLITestSuite) writeBulkJSONFile(name string, entries ...proto.Message) string {
+	jsonEntries := make([]string, len(entries))
+	for i, entry := range entries {
+		bz, err := s.cfg.Codec.MarshalJSON(entry)
+		s.Require().NoError(err, "MarshalJSON(entries[%d])", i)
+		jsonEntries[i] = string(bz)
+	}
+	file := filepath.Join(s.T().TempDir(), name)
+	s.Require().NoError(os.WriteFile(file, []byte("["+strings.Join(jsonEntries, ",")+"]"), 0o644), "WriteFile(%q)", file)
+	return file
+}
+
 func runTxCmdTestCases(s *IntegrationCLITestSuite, testCases []txCmdTestCase) {
 	s.T().Helper()
 	for _, tc := range testCases {
@@ -1817,6 +1852,11 @@ func runTxCmdTestCases(s *IntegrationCLITestSuite, testCases []txCmdTestCase) {
 func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
 	scopeSpecID := metadatatypes.ScopeSpecMetadataAddress(uuid.New()).String()
+	scopeSpecAddr, err := metadatatypes.MetadataAddressFromBech32(scopeSpecID)
+	s.Require().NoError(err, "MetadataAddressFromBech32(scopeSpecID)")
+	bulkScope1 := metadatatypes.NewScope(metadatatypes.ScopeMetadataAddress(uuid.New()), scopeSpecAddr, ownerPartyList(s.accountAddrStr), nil, "", false)
+	bulkScope2 := metadatatypes.NewScope(metadatatypes.ScopeMetadataAddress(uuid.New()), scopeSpecAddr, ownerPartyList(s.accountAddrStr), nil, "", false)
+	bulkScopesFile := s.writeBulkJSONFile("scopes.json", bulkScope1, bulkScope2)
 	testCases := []txCmdTestCase{
 		{
 			name: "should successfully add scope specification for test setup",
@@ -2108,6 +2148,68 @@ func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 			},
 			expectedCode: 18,
 		},
+		{
+			name: "should fail to write scopes from a file that does not exist",
+			cmd:  cli.WriteScopesCmd,
+			args: []string{
+				bulkScopesFile + ".missing",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: "could not read scopes file: open " + bulkScopesFile + ".missing: no such file or directory",
+		},
+		{
+			name: "should successfully write scopes",
+			cmd:  cli.WriteScopesCmd,
+			args: []string{
+				bulkScopesFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to remove scopes, not a scope id",
+			cmd:  cli.RemoveScopesCmd,
+			args: []string{
+				bulkScope1.ScopeId.String(),
+				scopeSpecID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: fmt.Sprintf("not a scope identifier: %q", scopeSpecID),
+		},
+		{
+			name: "should successfully remove scopes",
+			cmd:  cli.RemoveScopesCmd,
+			args: []string{
+				bulkScope1.ScopeId.String(),
+				bulkScope2.ScopeId.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to remove scopes that no longer exist",
+			cmd:  cli.RemoveScopesCmd,
+			args: []string{
+				bulkScope1.ScopeId.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 18,
+		},
 		{
 			name: "should fail to write scope with optional party but without rollup",
 			cmd:  cli.WriteScopeCmd,
@@ -2533,6 +2635,94 @@ func (s *IntegrationCLITestSuite) TestScopeSpecificationTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should successfully update scope specification version",
+			cmd:  addCommand,
+			args: []string{
+				specID.String(),
+				s.accountAddrStr,
+				"owner",
+				s.contractSpecID.String(),
+				fmt.Sprintf("--%s=2", cli.FlagSpecVersion),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to decrease scope specification version",
+			cmd:  addCommand,
+			args: []string{
+				specID.String(),
+				s.accountAddrStr,
+				"owner",
+				s.contractSpecID.String(),
+				fmt.Sprintf("--%s=1", cli.FlagSpecVersion),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 18,
+		},
+		{
+			name: "should fail to write scope specification, invalid previous spec id",
+			cmd:  addCommand,
+			args: []string{
+				specID.String(),
+				s.accountAddrStr,
+				"owner",
+				s.contractSpecID.String(),
+				fmt.Sprintf("--%s=2", cli.FlagSpecVersion),
+				fmt.Sprintf("--%s=notanid", cli.FlagPreviousSpecID),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: fmt.Sprintf("invalid --%s value %q: decoding bech32 failed: invalid bech32 string length 7", cli.FlagPreviousSpecID, "notanid"),
+		},
+		{
+			name: "should fail to migrate scopes, invalid scope spec id",
+			cmd:  cli.MigrateScopeSpecificationCmd,
+			args: []string{
+				s.scopeID.String(),
+				s.scopeID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: fmt.Sprintf("not a scope specification identifier: %q", s.scopeID.String()),
+		},
+		{
+			name: "should fail to migrate scopes, invalid scope id",
+			cmd:  cli.MigrateScopeSpecificationCmd,
+			args: []string{
+				specID.String(),
+				specID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: fmt.Sprintf("not a scope identifier: %q", specID.String()),
+		},
+		{
+			name: "should fail to migrate scope to its current scope specification",
+			cmd:  cli.MigrateScopeSpecificationCmd,
+			args: []string{
+				s.scopeSpecID.String(),
+				s.scopeID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 18,
+		},
 		{
 			name: "should fail to add scope specification, invalid spec id format",
 			cmd:  addCommand,
@@ -3049,6 +3239,62 @@ func (s *IntegrationCLITestSuite) TestRecordSpecificationTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should successfully add record specification with output schema",
+			cmd:  cmd,
+			args: []string{
+				specificationID.String(),
+				recordName,
+				"record1,typename1,hashvalue",
+				"typename",
+				"record_list",
+				"investor",
+				fmt.Sprintf("--%s=%s", cli.FlagOutputSchemaType, "proto_descriptor"),
+				fmt.Sprintf("--%s=%s", cli.FlagOutputSchemaHash, metadatatypes.HashOutputSchema([]byte("descriptor"))),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to add record specification, unknown output schema type",
+			cmd:  cmd,
+			args: []string{
+				specificationID.String(),
+				recordName,
+				"record1,typename1,hashvalue",
+				"typename",
+				"record_list",
+				"investor",
+				fmt.Sprintf("--%s=%s", cli.FlagOutputSchemaType, "xml"),
+				fmt.Sprintf("--%s=%s", cli.FlagOutputSchemaHash, metadatatypes.HashOutputSchema([]byte("descriptor"))),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: `unknown output schema type: "xml"`,
+		},
+		{
+			name: "should fail to add record specification, output schema without type",
+			cmd:  cmd,
+			args: []string{
+				specificationID.String(),
+				recordName,
+				"record1,typename1,hashvalue",
+				"typename",
+				"record_list",
+				"investor",
+				fmt.Sprintf("--%s=%s", cli.FlagOutputSchemaHash, metadatatypes.HashOutputSchema([]byte("descriptor"))),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: "the --output-schema-type flag is required when providing an output schema",
+		},
 		{
 			name: "should fail to add record specification, bad party type",
 			cmd:  cmd,
@@ -3205,6 +3451,12 @@ func (s *IntegrationCLITestSuite) TestRecordTxCommands() {
 
 	recordId := metadatatypes.RecordMetadataAddress(scopeUUID, recordName)
 
+	unknownSessionRecord := metadatatypes.NewRecord(recordName, metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New()),
+		*metadatatypes.NewProcess("processname", &metadatatypes.Process_Hash{Hash: "hashvalue"}, "methodname"),
+		nil, []metadatatypes.RecordOutput{*metadatatypes.NewRecordOutput("outputhashvalue", metadatatypes.ResultStatus_RESULT_STATUS_PASS)},
+		recSpecID)
+	unknownSessionRecordsFile := s.writeBulkJSONFile("records.json", unknownSessionRecord)
+
 	testCases := []txCmdTestCase{
 		{
 			name: "should successfully add contract specification with resource hash for test setup",
@@ -3382,7 +3634,7 @@ func (s *IntegrationCLITestSuite) TestRecordTxCommands() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 			},
-			expectErrMsg: `invalid record output "outputhashvalue": expected 2 parts, have 1`,
+			expectErrMsg: `invalid record output "outputhashvalue": expected 2 or 3 parts, have 1`,
 		},
 		{
 			name: "should fail to add record incorrect parties involved format",
@@ -3422,6 +3674,30 @@ func (s *IntegrationCLITestSuite) TestRecordTxCommands() {
 			},
 			expectErrMsg: fmt.Sprintf("id must be a contract or session id: %s", scopeID.String()),
 		},
+		{
+			name: "should fail to write records with an unknown session",
+			cmd:  cli.WriteRecordsCmd,
+			args: []string{
+				unknownSessionRecordsFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 18,
+		},
+		{
+			name: "should fail to remove records, not a record id",
+			cmd:  cli.RemoveRecordsCmd,
+			args: []string{
+				scopeID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: fmt.Sprintf("not a record identifier: %q", scopeID.String()),
+		},
 		{
 			name: "should successfully remove record",
 			cmd:  cli.RemoveRecordCmd,
@@ -3434,6 +3710,18 @@ func (s *IntegrationCLITestSuite) TestRecordTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should fail to remove records that no longer exist",
+			cmd:  cli.RemoveRecordsCmd,
+			args: []string{
+				recordId.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 18,
+		},
 	}
 	runTxCmdTestCases(s, testCases)
 }
@@ -200,7 +200,7 @@ func parseRecordOutputs(semicolonDelimitedString string) ([]types.RecordOutput,
 }
 
 // parseRecordOutput parses a comma delimited string into a RecordOutput.
-// Expected format: "<Hash>,<Status>"
+// Expected format: "<Hash>,<Status>[,<SchemaHash>]"
 // See also: parseResultStatus.
 func parseRecordOutput(commaDelimitedString string) (rv types.RecordOutput, err error) {
 	defer func() {
@@ -210,10 +210,13 @@ func parseRecordOutput(commaDelimitedString string) (rv types.RecordOutput, err
 	}()
 
 	parts := strings.Split(commaDelimitedString, ",")
-	if len(parts) != 2 {
-		return rv, fmt.Errorf("expected 2 parts, have %d", len(parts))
+	if len(parts) != 2 && len(parts) != 3 {
+		return rv, fmt.Errorf("expected 2 or 3 parts, have %d", len(parts))
 	}
 	rv.Hash = parts[0]
+	if len(parts) == 3 {
+		rv.SchemaHash = parts[2]
+	}
 	rv.Status, err = parseResultStatus(parts[1])
 	return rv, err
 }
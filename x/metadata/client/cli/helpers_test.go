@@ -623,7 +623,7 @@ func TestParseRecordOutputs(t *testing.T) {
 			name:   "one bad",
 			arg:    "bad",
 			exp:    nil,
-			expErr: `invalid record output "bad": expected 2 parts, have 1`,
+			expErr: `invalid record output "bad": expected 2 or 3 parts, have 1`,
 		},
 		{
 			name: "three good",
@@ -639,7 +639,7 @@ func TestParseRecordOutputs(t *testing.T) {
 			name:   "three with bad last",
 			arg:    "hash1,pass;hash2,skip;hash3",
 			exp:    nil,
-			expErr: `invalid record output "hash3": expected 2 parts, have 1`,
+			expErr: `invalid record output "hash3": expected 2 or 3 parts, have 1`,
 		},
 	}
 
@@ -663,13 +663,13 @@ func TestParseRecordOutput(t *testing.T) {
 			name:   "empty",
 			arg:    "",
 			exp:    types.RecordOutput{},
-			expErr: "expected 2 parts, have 1",
+			expErr: "expected 2 or 3 parts, have 1",
 		},
 		{
 			name:   "hash",
 			arg:    "hash1",
 			exp:    types.RecordOutput{},
-			expErr: "expected 2 parts, have 1",
+			expErr: "expected 2 or 3 parts, have 1",
 		},
 		{
 			name:   "hash,status",
@@ -696,10 +696,22 @@ func TestParseRecordOutput(t *testing.T) {
 			expErr: `unknown result status: "what"`,
 		},
 		{
-			name:   "three parts",
-			arg:    "part1,part2,part3",
+			name:   "hash,status,schema hash",
+			arg:    "hash4,pass,schemahash",
+			exp:    types.RecordOutput{Hash: "hash4", Status: types.ResultStatus_RESULT_STATUS_PASS, SchemaHash: "schemahash"},
+			expErr: "",
+		},
+		{
+			name:   "hash,bad,schema hash",
+			arg:    "hash5,what,schemahash",
+			exp:    types.RecordOutput{Hash: "hash5", SchemaHash: "schemahash"},
+			expErr: `unknown result status: "what"`,
+		},
+		{
+			name:   "four parts",
+			arg:    "part1,part2,part3,part4",
 			exp:    types.RecordOutput{},
-			expErr: "expected 2 parts, have 3",
+			expErr: "expected 2 or 3 parts, have 4",
 		},
 	}
 
@@ -60,6 +60,16 @@ func GetQueryCmd() *cobra.Command {
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetScopeHistoryCmd(),
+		GetScopeChildrenCmd(),
+		GetScopeAncestorsCmd(),
+		GetScopesBySpecificationCmd(),
+		GetScopesByPartyRoleCmd(),
+		GetScopesByDataAccessCmd(),
+		GetRecordsByOutputHashCmd(),
+		GetScopeLienCmd(),
+		GetScopeLiensByLienholderCmd(),
+		GetScopeValueHoldersCmd(),
 	)
 	return queryCmd
 }
@@ -517,19 +527,24 @@ func GetOSLocatorCmd() *cobra.Command {
 		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"}",
 		Aliases: []string{"l", "locators"},
 		Short:   "Query the current metadata for object store locators",
-		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locator for that owner.
+		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the primary object store locator for that owner.
+%[1]s locator {owner} --%[2]s {name} - gets the owner's object store locator with that name.
+%[1]s locator {owner} --%[3]s - gets all of the owner's object store locators.
+%[1]s locator {owner} --%[4]s {height} - gets the owner's object store locator(s) with the encryption key(s) in effect at that height.
 %[1]s locator {scope_id} - gets object store locators for all the owners of that scope.
 %[1]s locator {scope_uuid} - gets object store locators for all the owners of that scope.
 %[1]s locator {uri} - gets object store locators with that uri.
 %[1]s locator params - gets the object store locator params.
-%[1]s locator all - gets all object store locators.`, cmdStart),
+%[1]s locator all - gets all object store locators.`, cmdStart, FlagLocatorName, FlagAllNames, FlagKeyHeight),
 		Args: cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[2]s backup --%[3]s 1500000
+%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[4]s
 %[1]s locator scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s locator 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s locator https://provenance.io/
 %[1]s locator params
-%[1]s locator all`, cmdStart),
+%[1]s locator all`, cmdStart, FlagLocatorName, FlagKeyHeight, FlagAllNames),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			// First check if it's just the string "params".
@@ -548,6 +563,13 @@ func GetOSLocatorCmd() *cobra.Command {
 			// Okay... maybe check for a generic bech32.
 			_, _, bech32Err := bech32.DecodeAndConvert(arg0)
 			if bech32Err == nil {
+				allNames, err := cmd.Flags().GetBool(FlagAllNames)
+				if err != nil {
+					return err
+				}
+				if allNames {
+					return outputOSLocatorsByOwner(cmd, arg0)
+				}
 				return outputOSLocator(cmd, arg0)
 			}
 			// Well maybe a UUID?
@@ -560,6 +582,9 @@ func GetOSLocatorCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagLocatorName, "", "The name of the owner's locator to get (default is their primary locator)")
+	cmd.Flags().Bool(FlagAllNames, false, "Get all of the owner's locators")
+	cmd.Flags().Int64(FlagKeyHeight, 0, "Get the owner's locator(s) with the encryption key(s) in effect at this block height")
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
@@ -1037,10 +1062,40 @@ func outputOSLocator(cmd *cobra.Command, owner string) error {
 	if err != nil {
 		return err
 	}
+	name, err := cmd.Flags().GetString(FlagLocatorName)
+	if err != nil {
+		return err
+	}
+	keyHeight, err := cmd.Flags().GetInt64(FlagKeyHeight)
+	if err != nil {
+		return err
+	}
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.OSLocator(
 		cmd.Context(),
-		&types.OSLocatorRequest{Owner: owner, IncludeRequest: includeRequest},
+		&types.OSLocatorRequest{Owner: owner, Name: name, KeyHeight: keyHeight, IncludeRequest: includeRequest},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputOSLocatorsByOwner calls the OSLocatorsByOwner query and outputs the response.
+func outputOSLocatorsByOwner(cmd *cobra.Command, owner string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	keyHeight, err := cmd.Flags().GetInt64(FlagKeyHeight)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.OSLocatorsByOwner(
+		cmd.Context(),
+		&types.OSLocatorsByOwnerRequest{Owner: owner, KeyHeight: keyHeight, IncludeRequest: includeRequest},
 	)
 	if err != nil {
 		return err
@@ -1146,6 +1201,440 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 	return cmd
 }
 
+// GetScopeHistoryCmd returns the command handler for querying a scope's change log.
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-history {scope_id|scope_uuid}",
+		Aliases: []string{"history"},
+		Short:   "Get the change log of a scope",
+		Example: fmt.Sprintf(`%[1]s scope-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-history 91978ba2-5f35-459a-86a7-feca1b0512e0 --reverse`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeHistory(
+				cmd.Context(),
+				&types.ScopeHistoryRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scope history")
+
+	return cmd
+}
+
+// GetScopeChildrenCmd returns the command handler for metadata scope children querying.
+func GetScopeChildrenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-children {scope_id|scope_uuid}",
+		Aliases: []string{"children"},
+		Short:   "Get the ids of the scopes that have the given scope as their parent",
+		Example: fmt.Sprintf(`%[1]s scope-children scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-children 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeChildren(
+				cmd.Context(),
+				&types.ScopeChildrenRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scope children")
+
+	return cmd
+}
+
+// GetScopeAncestorsCmd returns the command handler for metadata scope ancestors querying.
+func GetScopeAncestorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-ancestors {scope_id|scope_uuid}",
+		Aliases: []string{"ancestors"},
+		Short:   "Get the ids of a scope's parent, its parent's parent, and so on up to the root scope",
+		Example: fmt.Sprintf(`%[1]s scope-ancestors scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-ancestors 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeAncestors(
+				cmd.Context(),
+				&types.ScopeAncestorsRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetScopesBySpecificationCmd returns the command handler for querying the scopes that use a scope specification.
+func GetScopesBySpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scopes-by-spec {scope_spec_id|scope_spec_uuid}",
+		Aliases: []string{"scopes-by-specification"},
+		Short:   "Get the ids of the scopes that use a scope specification",
+		Example: fmt.Sprintf(`%[1]s scopes-by-spec scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s scopes-by-spec dc83ea70-eacd-40fe-9adf-1cf6148bf8a2`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopesBySpecification(
+				cmd.Context(),
+				&types.ScopesBySpecificationRequest{
+					SpecificationId: strings.TrimSpace(args[0]),
+					IncludeRequest:  includeRequest,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// GetScopesByPartyRoleCmd returns the command handler for querying the scopes where an address has a party role.
+func GetScopesByPartyRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scopes-by-party-role <address> <role>",
+		Aliases: []string{"scopes-by-role"},
+		Short:   "Get the ids of the scopes where an address is a party with the given role",
+		Example: fmt.Sprintf(`%[1]s scopes-by-party-role pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 servicer
+%[1]s scopes-by-party-role pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 owner --%[2]s scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m`,
+			cmdStart, FlagSpecification),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			role, err := parsePartyType(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			specID, err := cmd.Flags().GetString(FlagSpecification)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopesByPartyRole(
+				cmd.Context(),
+				&types.ScopesByPartyRoleRequest{
+					Address:         strings.TrimSpace(args[0]),
+					Role:            role,
+					SpecificationId: strings.TrimSpace(specID),
+					IncludeRequest:  includeRequest,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	cmd.Flags().String(FlagSpecification, "", "Only include scopes that use this scope specification")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// GetScopesByDataAccessCmd returns the command handler for querying the scopes that an address has data access to.
+func GetScopesByDataAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scopes-by-data-access <address>",
+		Aliases: []string{"scopes-by-da"},
+		Short:   "Get the ids of the scopes that have an address in their data access list",
+		Example: fmt.Sprintf(`%[1]s scopes-by-data-access pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s scopes-by-data-access pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[2]s scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m`,
+			cmdStart, FlagSpecification),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			specID, err := cmd.Flags().GetString(FlagSpecification)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopesByDataAccess(
+				cmd.Context(),
+				&types.ScopesByDataAccessRequest{
+					Address:         strings.TrimSpace(args[0]),
+					SpecificationId: strings.TrimSpace(specID),
+					IncludeRequest:  includeRequest,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	cmd.Flags().String(FlagSpecification, "", "Only include scopes that use this scope specification")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// GetRecordsByOutputHashCmd returns the command handler for querying the records that have an output with a hash.
+func GetRecordsByOutputHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "records-by-output-hash <hash>",
+		Aliases: []string{"records-by-hash"},
+		Short:   "Get the ids of the records (and their scopes) that have an output with the given hash",
+		Example: fmt.Sprintf(`%[1]s records-by-output-hash 8b9b2fca7d2c1bba1f1bd1ec4e8f6c1bd4d70b5d8a4a6b3c9d1f7e2a5c6b4d3e`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordsByOutputHash(
+				cmd.Context(),
+				&types.RecordsByOutputHashRequest{
+					Hash:           strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
+// GetScopeLienCmd returns the command handler for querying the lien on a scope.
+func GetScopeLienCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-lien <scope-id>",
+		Short: "Get the lien on a scope",
+		Example: fmt.Sprintf(`%[1]s scope-lien scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-lien 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeLien(
+				cmd.Context(),
+				&types.ScopeLienRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetScopeLiensByLienholderCmd returns the command handler for querying the liens held by an address.
+func GetScopeLiensByLienholderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "liens-by-lienholder <lienholder>",
+		Aliases: []string{"liens"},
+		Short:   "Get the scope liens held by an address",
+		Example: fmt.Sprintf(`%[1]s liens-by-lienholder pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeLiensByLienholder(
+				cmd.Context(),
+				&types.ScopeLiensByLienholderRequest{
+					Lienholder:     strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liens")
+
+	return cmd
+}
+
+// GetScopeValueHoldersCmd returns the command handler for querying the accounts that hold the value of a scope.
+func GetScopeValueHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "value-holders <scope-id>",
+		Aliases: []string{"vh"},
+		Short:   "Get the accounts that hold the value units of a scope",
+		Example: fmt.Sprintf(`%[1]s value-holders scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s value-holders 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeValueHolders(
+				cmd.Context(),
+				&types.ScopeValueHoldersRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "value holders")
+
+	return cmd
+}
+
 // ------------ private generic helper functions ------------
 
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -15,17 +17,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 const (
-	FlagSigners            = "signers"
-	FlagRequirePartyRollup = "require-party-rollup"
-	AddSwitch              = "add"
-	RemoveSwitch           = "remove"
-	FlagUsdMills           = "usd-mills"
+	FlagSigners             = "signers"
+	FlagRequirePartyRollup  = "require-party-rollup"
+	AddSwitch               = "add"
+	RemoveSwitch            = "remove"
+	FlagUsdMills            = "usd-mills"
+	FlagParentScope         = "parent-scope"
+	FlagOutputSchemaType    = "output-schema-type"
+	FlagOutputSchemaHash    = "output-schema-hash"
+	FlagOutputSchemaFile    = "output-schema-file"
+	FlagSpecVersion         = "spec-version"
+	FlagDeprecated          = "deprecated"
+	FlagPreviousSpecID      = "previous-spec-id"
+	FlagSpecification       = "specification"
+	FlagReleaseTime         = "release-time"
+	FlagLocatorName         = "locator-name"
+	FlagPrimary             = "primary"
+	FlagEncryptionKey       = "encryption-key"
+	FlagKeyActivationHeight = "key-activation-height"
+	FlagAllNames            = "all-names"
+	FlagKeyHeight           = "key-height"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -46,6 +66,12 @@ func NewTxCmd() *cobra.Command {
 		AddRemoveScopeOwnersCmd(),
 		UpdateValueOwnersCmd(),
 		MigrateValueOwnerCmd(),
+		MigrateScopeSpecificationCmd(),
+		WriteScopesCmd(),
+		RemoveScopesCmd(),
+		AddScopeLienCmd(),
+		ReleaseScopeLienCmd(),
+		FractionalizeScopeValueCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
@@ -67,10 +93,14 @@ func NewTxCmd() *cobra.Command {
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+		WriteRecordsCmd(),
+		RemoveRecordsCmd(),
 
 		SetAccountDataCmd(),
 
 		GetCmdAddNetAssetValues(),
+
+		UpdateParamsCmd(),
 	)
 
 	return txCmd
@@ -139,6 +169,19 @@ func WriteScopeCmd() *cobra.Command {
 				requirePartyRollup,
 			)
 
+			parentScope, err := cmd.Flags().GetString(FlagParentScope)
+			if err != nil {
+				return err
+			}
+			if len(parentScope) > 0 {
+				var parentID types.MetadataAddress
+				parentID, err = types.MetadataAddressFromBech32(parentScope)
+				if err != nil {
+					return fmt.Errorf("invalid parent scope id: %w", err)
+				}
+				scope.ParentScopeId = &parentID
+			}
+
 			usdMills, err := cmd.Flags().GetUint64(FlagUsdMills)
 			if err != nil {
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: 0 or greater value Error: %w", FlagUsdMills, err)
@@ -150,6 +193,7 @@ func WriteScopeCmd() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(FlagRequirePartyRollup, false, "Indicates party rollup is required in this scope")
+	cmd.Flags().String(FlagParentScope, "", "The scope id of this scope's parent scope")
 	addSignersFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Uint64(FlagUsdMills, 0, "Indicates the net asset value of scope in usd mills, i.e. 1234 = $1.234")
@@ -389,13 +433,281 @@ func MigrateValueOwnerCmd() *cobra.Command {
 	return cmd
 }
 
+// MigrateScopeSpecificationCmd creates a command for moving one or more scopes to a newer scope specification.
+func MigrateScopeSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-scope-specification <scope spec id> <scope id> [<scope id 2> ...]",
+		Aliases: []string{"migrate-scope-spec", "mss"},
+		Short:   "Migrate one or more scopes to a newer scope specification.",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata migrate-scope-specification scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn scope1qqg3uff00wpy2yuf7xr0rp8aucqs902xhw`,
+			version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMigrateScopeSpecificationRequest{}
+
+			msg.SpecificationId, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope spec id %q: %w", args[0], err)
+			}
+			if !msg.SpecificationId.IsScopeSpecificationAddress() {
+				return fmt.Errorf("not a scope specification identifier: %q", args[0])
+			}
+
+			msg.ScopeIds = make([]types.MetadataAddress, len(args[1:]))
+			for i, arg := range args[1:] {
+				msg.ScopeIds[i], err = types.MetadataAddressFromBech32(arg)
+				if err != nil {
+					return fmt.Errorf("invalid scope id %d %q: %w", i+1, arg, err)
+				}
+				if !msg.ScopeIds[i].IsScopeAddress() {
+					return fmt.Errorf("not a scope identifier: %q", arg)
+				}
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AddScopeLienCmd creates a command for putting a lien on a scope.
+func AddScopeLienCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-scope-lien <scope id> <lienholder>",
+		Aliases: []string{"add-lien"},
+		Short:   "Put a lien on a scope.",
+		Long: `Put a lien on a scope.
+While the lien is in effect, the scope cannot be deleted, and its owners and value owner cannot be changed.
+The lien stays in effect until it is released by the lienholder, or until the optional release time has passed.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata add-scope-lien scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+$ %[1]s tx metadata add-scope-lien scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[2]s 2030-01-01T00:00:00Z`,
+			version.AppName, FlagReleaseTime),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddScopeLienRequest{}
+			msg.Lien.ScopeId, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			if !msg.Lien.ScopeId.IsScopeAddress() {
+				return fmt.Errorf("not a scope identifier: %q", args[0])
+			}
+
+			msg.Lien.Lienholder, err = validateAccAddress(args[1], "lienholder")
+			if err != nil {
+				return err
+			}
+
+			releaseTimeStr, err := cmd.Flags().GetString(FlagReleaseTime)
+			if err != nil {
+				return err
+			}
+			if len(releaseTimeStr) > 0 {
+				releaseTime, err := time.Parse(time.RFC3339, releaseTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid release time %q: %w", releaseTimeStr, err)
+				}
+				msg.Lien.ReleaseTime = &releaseTime
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReleaseTime, "", "The time (RFC 3339) at which the lien stops being in effect")
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReleaseScopeLienCmd creates a command for releasing the lien on a scope.
+func ReleaseScopeLienCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-scope-lien <scope id>",
+		Aliases: []string{"release-lien"},
+		Short:   "Release the lien on a scope. The lienholder must be a signer.",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata release-scope-lien scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReleaseScopeLienRequest{}
+			msg.ScopeId, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			if !msg.ScopeId.IsScopeAddress() {
+				return fmt.Errorf("not a scope identifier: %q", args[0])
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FractionalizeScopeValueCmd creates a command for splitting the value of a scope into multiple units.
+func FractionalizeScopeValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fractionalize-scope-value <scope id> <units>",
+		Aliases: []string{"split-scope-value"},
+		Short:   "Split the value of a scope into multiple units. The value owner must be a signer.",
+		Long: `Split the value of a scope into multiple units. The value owner must be a signer.
+All of the units are given to the scope's current value owner, who can then send them to other accounts.
+The value of a scope can only be split once.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata fractionalize-scope-value scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 100`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFractionalizeScopeValueRequest{}
+			msg.ScopeId, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+			if !msg.ScopeId.IsScopeAddress() {
+				return fmt.Errorf("not a scope identifier: %q", args[0])
+			}
+			msg.Units, err = strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid units %q: %w", args[1], err)
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteScopesCmd creates a command for adding or updating several metadata scopes at once.
+func WriteScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-scopes <scopes json file>",
+		Short: "Add or update several metadata scopes in the provenance blockchain",
+		Long: `Add or update several metadata scopes in the provenance blockchain.
+The file must contain a JSON array of scopes, each in the same format as the scope of a write-scope request.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-scopes scopes.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWriteScopesRequest{}
+			if err = readBulkJSONFile(clientCtx, args[0], "scopes", msg); err != nil {
+				return err
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveScopesCmd creates a command for removing several metadata scopes at once.
+func RemoveScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-scopes <scope id> [<scope id 2> ...]",
+		Short:   "Remove several metadata scopes from the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-scopes scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn scope1qqg3uff00wpy2yuf7xr0rp8aucqs902xhw`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteScopesRequest{}
+			msg.ScopeIds, err = parseMetadataAddresses(args, "scope", types.MetadataAddress.IsScopeAddress)
+			if err != nil {
+				return err
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "bind-locator [owner] [uri]",
+		Short: "Bind a uri to an owner address on the provenance blockchain",
+		Long: `Bind a uri to an owner address on the provenance blockchain.
+An owner can have multiple locators with different names. The owner's first locator is always their primary one.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://bar.com" --%[2]s backup --%[3]s`,
+			version.AppName, FlagLocatorName, FlagPrimary),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -407,8 +719,9 @@ func BindOsLocatorCmd() *cobra.Command {
 				return fmt.Errorf("invalid address: %w", errAddr)
 			}
 
-			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+			objectStoreLocator, err := readOSLocatorFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
 			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
@@ -416,6 +729,7 @@ func BindOsLocatorCmd() *cobra.Command {
 		},
 	}
 
+	addOSLocatorFlagsToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -424,10 +738,12 @@ func BindOsLocatorCmd() *cobra.Command {
 // RemoveOsLocatorCmd creates a command for removing an object store locator entry.
 func RemoveOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "remove-locator [owner] [uri]",
-		Short:   "Remove an os locator already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "remove-locator [owner] [uri]",
+		Short: "Remove an os locator already associated owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://bar.com" --%[2]s backup`,
+			version.AppName, FlagLocatorName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -439,8 +755,12 @@ func RemoveOsLocatorCmd() *cobra.Command {
 				return fmt.Errorf("invalid address: %w", errAddr)
 			}
 
+			name, err := cmd.Flags().GetString(FlagLocatorName)
+			if err != nil {
+				return err
+			}
 			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+				LocatorUri: args[1], Owner: args[0], Name: name,
 			}
 
 			deleteOSLocator := *types.NewMsgDeleteOSLocatorRequest(objectStoreLocator)
@@ -448,6 +768,7 @@ func RemoveOsLocatorCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagLocatorName, "", "The name of the locator to remove")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -456,10 +777,15 @@ func RemoveOsLocatorCmd() *cobra.Command {
 // ModifyOsLocatorCmd creates a command to modify the object store locator uri for an owner.
 func ModifyOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "modify-locator [owner] [uri]",
-		Short:   "Modify a uri already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "modify-locator [owner] [uri]",
+		Short: "Modify a uri already associated owner address on the provenance blockchain",
+		Long: `Modify a uri already associated owner address on the provenance blockchain.
+A new encryption key takes effect at the key activation height (or right away if not provided).
+The previous key stays in effect until then.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"
+$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com" --%[2]s pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[3]s 1500000`,
+			version.AppName, FlagEncryptionKey, FlagKeyActivationHeight),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -471,20 +797,50 @@ func ModifyOsLocatorCmd() *cobra.Command {
 				return fmt.Errorf("invalid address: %w", errAddr)
 			}
 
-			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+			objectStoreLocator, err := readOSLocatorFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
 			}
 
 			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
+			modifyOSLocator.KeyActivationHeight, err = cmd.Flags().GetInt64(FlagKeyActivationHeight)
+			if err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
 		},
 	}
 
+	addOSLocatorFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagKeyActivationHeight, 0, "The block height at which a new encryption key takes effect")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// addOSLocatorFlagsToCmd adds the flags used to define an object store locator to the provided command.
+func addOSLocatorFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagLocatorName, "", "The name of the locator")
+	cmd.Flags().Bool(FlagPrimary, false, "Make this the owner's primary locator")
+	cmd.Flags().String(FlagEncryptionKey, "", "The bech32 address of the owner's encryption key")
+}
+
+// readOSLocatorFlags creates an object store locator from the provided owner and uri, and the locator flags.
+func readOSLocatorFlags(cmd *cobra.Command, owner, uri string) (types.ObjectStoreLocator, error) {
+	rv := types.ObjectStoreLocator{LocatorUri: uri, Owner: owner}
+	var err error
+	if rv.Name, err = cmd.Flags().GetString(FlagLocatorName); err != nil {
+		return rv, err
+	}
+	if rv.Primary, err = cmd.Flags().GetBool(FlagPrimary); err != nil {
+		return rv, err
+	}
+	if rv.EncryptionKey, err = cmd.Flags().GetString(FlagEncryptionKey); err != nil {
+		return rv, err
+	}
+	return rv, nil
+}
+
 // WriteScopeSpecificationCmd creates a command for adding scope specificiation
 func WriteScopeSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -529,6 +885,21 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				PartiesInvolved: partyTypes,
 				ContractSpecIds: contractSpecIDs,
 			}
+			scopeSpec.Version, scopeSpec.Deprecated, err = parseSpecVersionFlags(cmd)
+			if err != nil {
+				return err
+			}
+			previousSpecID, err := cmd.Flags().GetString(FlagPreviousSpecID)
+			if err != nil {
+				return fmt.Errorf("invalid --%s value: %w", FlagPreviousSpecID, err)
+			}
+			if len(previousSpecID) > 0 {
+				previousID, err := types.MetadataAddressFromBech32(previousSpecID)
+				if err != nil {
+					return fmt.Errorf("invalid --%s value %q: %w", FlagPreviousSpecID, previousSpecID, err)
+				}
+				scopeSpec.PreviousSpecId = &previousID
+			}
 
 			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -536,6 +907,8 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 	}
 
 	addSignersFlagToCmd(cmd)
+	addSpecVersionFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPreviousSpecID, "", "The id of the scope specification that this one succeeds")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -579,6 +952,10 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 				PartiesInvolved: partiesInvolved,
 				ClassName:       args[4],
 			}
+			contractSpecification.Version, contractSpecification.Deprecated, err = parseSpecVersionFlags(cmd)
+			if err != nil {
+				return err
+			}
 			sourceValue := args[3]
 			var recordID sdk.AccAddress
 			recordID, err = sdk.AccAddressFromBech32(sourceValue)
@@ -602,6 +979,7 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 		},
 	}
 	addSignersFlagToCmd(cmd)
+	addSpecVersionFlagsToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -784,7 +1162,7 @@ record-spec-id    - associated record specification metaaddress
 name              - record name
 process           - comma delimited structure of process name, id (hash or bech32 address), and method: Example: processname,hashvalue,method
 inputs            - semicolon delimited list of input structures.  Example: name,soure-value(hash or metaaddress),typename,status(proposed,record);...
-outputs           - semicolon delimited list of outputs structures. Example: hash-value,status(pass,skip,fail);...
+outputs           - semicolon delimited list of outputs structures. Example: hash-value,status(pass,skip,fail)[,schema-hash];...
 parties-involved  - semicolon delimited list of party structures(address,role). Accepted roles: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
 contract-spec-id  - a bech32 address string for a contract specification - If provided, a new session will be created using this contract specification
 session-id        - a bech32 address string for the session this record belongs to
@@ -901,13 +1279,17 @@ func WriteRecordSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "write-record-specification [specification-id] [name] [input-specifications] [type-name] [result-types] [responsible-parties]",
 		Short: "Add/Update metadata record specification to the provenance blockchain",
-		Long: `Add/Update metadata record specification to the provenance blockchain.
+		Long: fmt.Sprintf(`Add/Update metadata record specification to the provenance blockchain.
 specification-id      - record specification metaaddress
 name                  - record name
 input-specifications  - semi-colon delimited list of input specifications <name>,<type-name>,<source-value>
 type-name             - contract specification type name
 result-types          - result definition type. Accepted values: proposed, record, record_list
-responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance`,
+responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
+
+An output schema can be provided using the --%[1]s flag along with either the --%[2]s or --%[3]s flag.
+When a json_schema file is provided, its contents are stored with the specification and its hash is computed.`,
+			FlagOutputSchemaType, FlagOutputSchemaHash, FlagOutputSchemaFile),
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record-specification recspec1qh... \
 recordname \
 inputname1,typename1,hashvalue; \
@@ -943,6 +1325,11 @@ owner,originator`, version.AppName),
 				return err
 			}
 
+			outputSchema, err := parseOutputSchema(cmd)
+			if err != nil {
+				return err
+			}
+
 			recordSpecification := types.RecordSpecification{
 				SpecificationId:    specificationID,
 				Name:               recordName,
@@ -950,6 +1337,7 @@ owner,originator`, version.AppName),
 				TypeName:           args[3],
 				ResultType:         resultType,
 				ResponsibleParties: partyTypes,
+				OutputSchema:       outputSchema,
 			}
 
 			msg := types.NewMsgWriteRecordSpecificationRequest(recordSpecification, signers)
@@ -958,6 +1346,7 @@ owner,originator`, version.AppName),
 	}
 
 	addSignersFlagToCmd(cmd)
+	addOutputSchemaFlagsToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1105,6 +1494,76 @@ func RemoveRecordCmd() *cobra.Command {
 	return cmd
 }
 
+// WriteRecordsCmd creates a command for adding or updating several records at once.
+func WriteRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-records <records json file>",
+		Short: "Add or update several records in the provenance blockchain",
+		Long: `Add or update several records in the provenance blockchain.
+The file must contain a JSON array of records, each in the same format as the record of a write-record request.
+Each record's session must already exist.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-records records.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWriteRecordsRequest{}
+			if err = readBulkJSONFile(clientCtx, args[0], "records", msg); err != nil {
+				return err
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveRecordsCmd creates a command for removing several records at once.
+func RemoveRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-records <record id> [<record id 2> ...]",
+		Short:   "Remove several records from the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-records record1qtjqgzrza7h5w8a4amnk9ru9s7236qz42yxp5uejah5tje7c6l0pwue0yn3 --from=mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteRecordsRequest{}
+			msg.RecordIds, err = parseMetadataAddresses(args, "record", types.MetadataAddress.IsRecordAddress)
+			if err != nil {
+				return err
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveRecordSpecificationCmd creates  a command to remove a record specification
 func RemoveRecordSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1291,6 +1750,139 @@ func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error)
 	return []string{client.GetFromAddress().String()}, nil
 }
 
+// readBulkJSONFile reads a file containing a JSON array and unmarshals it into the given field of the msg.
+func readBulkJSONFile(clientCtx client.Context, file, field string, msg proto.Message) error {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("could not read %s file: %w", field, err)
+	}
+	bz := []byte(fmt.Sprintf(`{%q:%s}`, field, contents))
+	if err = clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+		return fmt.Errorf("could not parse %s file: %w", field, err)
+	}
+	return nil
+}
+
+// parseMetadataAddresses parses each arg as a bech32 metadata address that must pass the isType check.
+func parseMetadataAddresses(args []string, name string, isType func(types.MetadataAddress) bool) ([]types.MetadataAddress, error) {
+	rv := make([]types.MetadataAddress, len(args))
+	for i, arg := range args {
+		var err error
+		rv[i], err = types.MetadataAddressFromBech32(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s id %d %q: %w", name, i+1, arg, err)
+		}
+		if !isType(rv[i]) {
+			return nil, fmt.Errorf("not a %s identifier: %q", name, arg)
+		}
+	}
+	return rv, nil
+}
+
+// addSpecVersionFlagsToCmd adds the --spec-version and --deprecated flags to a command.
+// See also: parseSpecVersionFlags.
+func addSpecVersionFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagSpecVersion, 0, "The version of the specification")
+	cmd.Flags().Bool(FlagDeprecated, false, "Mark the specification as deprecated")
+}
+
+// parseSpecVersionFlags reads the --spec-version and --deprecated flags.
+// See also: addSpecVersionFlagsToCmd.
+func parseSpecVersionFlags(cmd *cobra.Command) (uint32, bool, error) {
+	specVersion, err := cmd.Flags().GetUint32(FlagSpecVersion)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid --%s value: %w", FlagSpecVersion, err)
+	}
+	deprecated, err := cmd.Flags().GetBool(FlagDeprecated)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid --%s value: %w", FlagDeprecated, err)
+	}
+	return specVersion, deprecated, nil
+}
+
+// addOutputSchemaFlagsToCmd adds the --output-schema-type, --output-schema-hash, and --output-schema-file flags to a command.
+// See also: parseOutputSchema.
+func addOutputSchemaFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOutputSchemaType, "", "The type of the output schema. Accepted values: proto_descriptor, json_schema")
+	cmd.Flags().String(FlagOutputSchemaHash, "", "The hex encoded sha256 hash of the output schema")
+	cmd.Flags().String(FlagOutputSchemaFile, "", "A file containing the output schema (used to compute the hash, and stored if a json_schema)")
+}
+
+// parseOutputSchema reads the output schema flags and creates an OutputSchema from them.
+// Returns nil if no output schema type was provided.
+// See also: addOutputSchemaFlagsToCmd
+func parseOutputSchema(cmd *cobra.Command) (*types.OutputSchema, error) {
+	flagSet := cmd.Flags()
+	typeStr, _ := flagSet.GetString(FlagOutputSchemaType)
+	hash, _ := flagSet.GetString(FlagOutputSchemaHash)
+	file, _ := flagSet.GetString(FlagOutputSchemaFile)
+	if len(typeStr) == 0 {
+		if len(hash) > 0 || len(file) > 0 {
+			return nil, fmt.Errorf("the --%s flag is required when providing an output schema", FlagOutputSchemaType)
+		}
+		return nil, nil
+	}
+
+	schemaType := types.OutputSchemaType(types.OutputSchemaType_value["OUTPUT_SCHEMA_TYPE_"+strings.ToUpper(typeStr)])
+	if schemaType == types.OutputSchemaType_OUTPUT_SCHEMA_TYPE_UNSPECIFIED {
+		return nil, fmt.Errorf("unknown output schema type: %q", typeStr)
+	}
+	if len(file) == 0 {
+		if len(hash) == 0 {
+			return nil, fmt.Errorf("either the --%s or --%s flag is required with the --%s flag",
+				FlagOutputSchemaHash, FlagOutputSchemaFile, FlagOutputSchemaType)
+		}
+		return types.NewOutputSchema(schemaType, hash, ""), nil
+	}
+
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read output schema file: %w", err)
+	}
+	fileHash := types.HashOutputSchema(contents)
+	if len(hash) > 0 && !strings.EqualFold(hash, fileHash) {
+		return nil, fmt.Errorf("output schema hash %s does not match the hash of the file %s", hash, fileHash)
+	}
+	var jsonSchema string
+	if schemaType == types.OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA {
+		jsonSchema = string(contents)
+	}
+	return types.NewOutputSchema(schemaType, fileHash, jsonSchema), nil
+}
+
+// UpdateParamsCmd creates a command to update the metadata module's params via governance proposal.
+func UpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-params <max-scope-history-entries>",
+		Short:   "Update the metadata module's params via governance proposal",
+		Long:    "Submit an update params via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx metadata update-params 100 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			maxEntries, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max scope history entries: %w", err)
+			}
+			maxEntries32 := uint32(maxEntries) //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
+			msg := types.NewMsgUpdateParamsRequest(authority, maxEntries32)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // validateAccAddress makes sure the provided addr is a valid bech32.
 // If not, an error is returned indicating the argName field.
 // If it's valid, it's returned as the first arg.
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -45,6 +46,21 @@ func (k *MDBankKeeper) DenomOwner(ctx context.Context, denom string) (sdk.AccAdd
 	return rv, nil
 }
 
+// DenomHolders gets the balance of each account that owns some of a denom.
+// If no one owns the denom, this will return nil, nil.
+func (k *MDBankKeeper) DenomHolders(ctx context.Context, denom string) ([]*banktypes.DenomOwner, error) {
+	var rv []*banktypes.DenomOwner
+	ranger := collections.NewPrefixedPairRange[string, sdk.AccAddress](denom)
+	err := k.Balances.Indexes.Denom.Walk(ctx, ranger, func(_ string, addr sdk.AccAddress) (bool, error) {
+		rv = append(rv, &banktypes.DenomOwner{Address: addr.String(), Balance: k.GetBalance(ctx, addr, denom)})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
 // GetScopesForValueOwner will get the scopes owned by a specific value owner.
 // If the pageReq is nil, this will get all their scopes and the resulting PageResponse will be nil.
 // If a pageReq is provided, this will get just the requested page and it will return a PageResponse.
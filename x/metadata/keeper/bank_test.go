@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
@@ -212,6 +213,65 @@ func (s *BankTestSuite) TestDenomOwner() {
 	}
 }
 
+func (s *BankTestSuite) TestDenomHolders() {
+	addr1 := sdk.AccAddress("1_addr______________")
+	addr2 := sdk.AccAddress("2_addr______________")
+	addr3 := sdk.AccAddress("3_addr______________")
+
+	scopeDenom := s.scopeID("69012AF4-2FA4-44DA-BAE4-1C13480362C9").Denom()
+	otherDenom := s.scopeID("B2A1F0C4-6F8E-4E9B-9A59-3B7E0C6D2F11").Denom()
+
+	holder := func(addr sdk.AccAddress, amt int64) *banktypes.DenomOwner {
+		return &banktypes.DenomOwner{Address: addr.String(), Balance: sdk.NewInt64Coin(scopeDenom, amt)}
+	}
+
+	tests := []struct {
+		name     string
+		balances []balance
+		exp      []*banktypes.DenomOwner
+	}{
+		{
+			name:     "no holders",
+			balances: []balance{{addr: addr1, denom: otherDenom}},
+			exp:      nil,
+		},
+		{
+			name: "one holder",
+			balances: []balance{
+				{addr: addr1, denom: otherDenom},
+				{addr: addr2, denom: scopeDenom},
+			},
+			exp: []*banktypes.DenomOwner{holder(addr2, 1)},
+		},
+		{
+			name: "three holders",
+			balances: []balance{
+				{addr: addr1, denom: scopeDenom, amt: 5},
+				{addr: addr2, denom: scopeDenom, amt: 3},
+				{addr: addr3, denom: scopeDenom, amt: 2},
+				{addr: addr3, denom: otherDenom, amt: 7},
+			},
+			exp: []*banktypes.DenomOwner{holder(addr1, 5), holder(addr2, 3), holder(addr3, 2)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.setBalances(ctx, tc.balances)
+
+			var holders []*banktypes.DenomOwner
+			var err error
+			testFunc := func() {
+				holders, err = s.bk.DenomHolders(ctx, scopeDenom)
+			}
+			s.Require().NotPanics(testFunc, "DenomHolders(%q)", scopeDenom)
+			s.Assert().NoError(err, "error returned by DenomHolders(%q)", scopeDenom)
+			s.Assert().Equal(tc.exp, holders, "holders returned by DenomHolders(%q)", scopeDenom)
+		})
+	}
+}
+
 func (s *BankTestSuite) TestGetScopesForValueOwner() {
 	addr1 := sdk.AccAddress("1_addr______________") // cosmos1x90kzerywf047h6lta047h6lta047h6l258ny6
 	addr2 := sdk.AccAddress("2_addr______________") // cosmos1xf0kzerywf047h6lta047h6lta047h6lgww49l
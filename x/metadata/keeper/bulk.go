@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// bulkCacheContextKey is the key used in an sdk.Context to set/get the bulkCache.
+const bulkCacheContextKey = "metadataBulkCacheContextKey"
+
+// bulkCache holds spec lookups and signer validation results so that the entries of a bulk msg
+// (e.g. MsgWriteScopesRequest) can reuse them instead of redoing them for each entry.
+//
+// The bulk endpoints don't change any specifications, and every entry has the same signers,
+// so these results don't change while a bulk msg is being processed. Signer checks that were
+// satisfied using authz are not cached though, since each entry needs to use the authorization.
+type bulkCache struct {
+	scopeSpecs  map[string]*types.ScopeSpecification
+	recordSpecs map[string]*types.RecordSpecification
+	signers     map[string]signersResult
+	// usedAuthz is set whenever an authorization is used while checking signers.
+	usedAuthz bool
+}
+
+// signersResult is the result of checking that a set of parties have signed.
+type signersResult struct {
+	parties []*types.PartyDetails
+	err     error
+}
+
+// newBulkCache creates a new, empty bulkCache.
+func newBulkCache() *bulkCache {
+	return &bulkCache{
+		scopeSpecs:  make(map[string]*types.ScopeSpecification),
+		recordSpecs: make(map[string]*types.RecordSpecification),
+		signers:     make(map[string]signersResult),
+	}
+}
+
+// addBulkCacheToContext returns a new sdk.Context with a new (empty) bulkCache in it.
+func addBulkCacheToContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bulkCacheContextKey, newBulkCache())
+}
+
+// getBulkCache gets the bulkCache from the context. Returns nil if the context doesn't have one.
+func getBulkCache(ctx sdk.Context) *bulkCache {
+	cache, _ := ctx.Value(bulkCacheContextKey).(*bulkCache)
+	return cache
+}
+
+// getSigners returns the cached result for the key. If there isn't one yet, validate is called.
+// The result is only cached if validate succeeded without using any authorizations.
+func (c *bulkCache) getSigners(key string, validate func() ([]*types.PartyDetails, error)) ([]*types.PartyDetails, error) {
+	rv, known := c.signers[key]
+	if !known {
+		outerUsedAuthz := c.usedAuthz
+		c.usedAuthz = false
+		rv.parties, rv.err = validate()
+		usedAuthz := c.usedAuthz
+		c.usedAuthz = outerUsedAuthz || usedAuthz
+		if rv.err != nil || usedAuthz {
+			return rv.parties, rv.err
+		}
+		c.signers[key] = rv
+	}
+	// Clip the slice so that appending to it can't alter what's cached.
+	return slices.Clip(rv.parties), rv.err
+}
+
+// markAuthzUsed records (in the context's bulkCache, if it has one) that an authorization was used.
+func markAuthzUsed(ctx sdk.Context) {
+	if cache := getBulkCache(ctx); cache != nil {
+		cache.usedAuthz = true
+	}
+}
+
+// requiredSignedKey creates the bulkCache signers key for a validateAllRequiredSigned check.
+func requiredSignedKey(required []string) string {
+	sorted := slices.Clone(required)
+	sort.Strings(sorted)
+	return "signed:" + strings.Join(sorted, ",")
+}
+
+// partiesSignedKey creates the bulkCache signers key for a validateAllRequiredPartiesSigned check.
+func partiesSignedKey(reqParties, availableParties []types.Party, reqRoles []types.PartyType) string {
+	var sb strings.Builder
+	sb.WriteString("parties:")
+	for _, party := range reqParties {
+		sb.WriteString(fmt.Sprintf("%s/%d/%t,", party.Address, party.Role, party.Optional))
+	}
+	sb.WriteString("available:")
+	for _, party := range availableParties {
+		sb.WriteString(fmt.Sprintf("%s/%d/%t,", party.Address, party.Role, party.Optional))
+	}
+	sb.WriteString("roles:")
+	for _, role := range reqRoles {
+		sb.WriteString(fmt.Sprintf("%d,", role))
+	}
+	return sb.String()
+}
+
+// smartContractSignersKey creates the bulkCache signers key for a validateSmartContractSigners check.
+func smartContractSignersKey(usedSigners types.UsedSignersMap) string {
+	used := make([]string, 0, len(usedSigners))
+	for addr, isUsed := range usedSigners {
+		if isUsed {
+			used = append(used, addr)
+		}
+	}
+	sort.Strings(used)
+	return "smart contract:" + strings.Join(used, ",")
+}
+
+// getScopeSpecificationCached gets a scope specification, only looking it up once if the context has a bulkCache.
+func (k Keeper) getScopeSpecificationCached(ctx sdk.Context, specID types.MetadataAddress) (types.ScopeSpecification, bool) {
+	cache := getBulkCache(ctx)
+	if cache == nil {
+		return k.GetScopeSpecification(ctx, specID)
+	}
+	spec, known := cache.scopeSpecs[string(specID)]
+	if !known {
+		if s, found := k.GetScopeSpecification(ctx, specID); found {
+			spec = &s
+		}
+		cache.scopeSpecs[string(specID)] = spec
+	}
+	if spec == nil {
+		return types.ScopeSpecification{}, false
+	}
+	return *spec, true
+}
+
+// getRecordSpecificationCached gets a record specification, only looking it up once if the context has a bulkCache.
+func (k Keeper) getRecordSpecificationCached(ctx sdk.Context, specID types.MetadataAddress) (types.RecordSpecification, bool) {
+	cache := getBulkCache(ctx)
+	if cache == nil {
+		return k.GetRecordSpecification(ctx, specID)
+	}
+	spec, known := cache.recordSpecs[string(specID)]
+	if !known {
+		if s, found := k.GetRecordSpecification(ctx, specID); found {
+			spec = &s
+		}
+		cache.recordSpecs[string(specID)] = spec
+	}
+	if spec == nil {
+		return types.RecordSpecification{}, false
+	}
+	return *spec, true
+}
+
+// processBulkEntries calls process for each of the count entries of a bulk msg.
+//
+// Each entry is processed in its own cache context that is only written if that entry succeeds, so
+// later entries see the results of earlier successful ones. All entries are processed, even after a
+// failure, so that the returned error identifies every failed entry (e.g. when simulating).
+//
+// The authz cache is cleared for each entry so that each entry uses any authorizations it needs.
+func processBulkEntries(ctx sdk.Context, count int, process func(ctx sdk.Context, i int) error) error {
+	var errs []error
+	for i := 0; i < count; i++ {
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = types.AddAuthzCacheToContext(cacheCtx)
+		if err := process(cacheCtx, i); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		writeCache()
+	}
+	return errors.Join(errs...)
+}
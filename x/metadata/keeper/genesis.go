@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -14,6 +12,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if err := data.Validate(); err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, data.Params)
 	if data.Scopes != nil {
 		for _, s := range data.Scopes {
 			if err := k.SetScope(ctx, s); err != nil {
@@ -48,15 +47,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.ObjectStoreLocators != nil {
 		for _, s := range data.ObjectStoreLocators {
-			addr, err := sdk.AccAddressFromBech32(s.Owner)
-			if err != nil {
-				panic(err)
-			}
-			encryptionKey := sdk.AccAddress{}
-			if strings.TrimSpace(s.EncryptionKey) != "" {
-				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
-			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
+			err := k.ImportOSLocatorRecord(ctx, s)
 			if err != nil {
 				panic(err)
 			}
@@ -80,6 +71,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+
+	for _, change := range data.ScopeHistory {
+		if err := k.SetScopeChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, lien := range data.ScopeLiens {
+		if err := k.SetScopeLien(ctx, lien); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, units := range data.ScopeValueUnits {
+		if err := k.SetScopeValueUnits(ctx, units); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -168,5 +177,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	var scopeHistory []types.ScopeChange
+	err := k.IterateScopeHistory(ctx, func(change types.ScopeChange) bool {
+		scopeHistory = append(scopeHistory, change)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scopeLiens []types.ScopeLien
+	err = k.IterateScopeLiens(ctx, func(lien types.ScopeLien) bool {
+		scopeLiens = append(scopeLiens, lien)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scopeValueUnits []types.ScopeValueUnits
+	err = k.IterateScopeValueUnits(ctx, func(units types.ScopeValueUnits) bool {
+		scopeValueUnits = append(scopeValueUnits, units)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(k.GetParams(ctx), oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	genState.ScopeHistory = scopeHistory
+	genState.ScopeLiens = scopeLiens
+	genState.ScopeValueUnits = scopeValueUnits
+	return genState
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"net/url"
+	"strings"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -37,6 +39,9 @@ type Keeper struct {
 
 	// For managing value owners
 	bankKeeper BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
@@ -45,7 +50,7 @@ func NewKeeper(
 	authzKeeper AuthzKeeper, attrKeeper AttrKeeper, markerKeeper MarkerKeeper,
 	bankKeeper bankkeeper.BaseKeeper,
 ) Keeper {
-	return Keeper{
+	rv := Keeper{
 		storeKey:     key,
 		cdc:          cdc,
 		moduleAddr:   authtypes.NewModuleAddress(types.ModuleName),
@@ -54,7 +59,23 @@ func NewKeeper(
 		attrKeeper:   attrKeeper,
 		markerKeeper: markerKeeper,
 		bankKeeper:   NewMDBankKeeper(bankKeeper),
+		authority:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	}
+	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
+	return rv
+}
+
+// GetAuthority returns the address that can execute governance proposal messages for this module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if !strings.EqualFold(k.authority, addr) {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, addr)
 	}
+	return nil
 }
 
 // Logger returns a module-specific logger.
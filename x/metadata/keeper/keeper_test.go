@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -163,7 +165,7 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, s.user3Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice", "", false)
 		s.Require().Empty(err)
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
 		s.Require().NotEmpty(r)
@@ -172,7 +174,7 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 
 	s.Run("add os locator account does not exist.", func() {
 		// create account and check default values
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice", "", false)
 		s.Require().NotEmpty(err)
 	})
 
@@ -190,7 +192,7 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, user4Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, user4Addr, s.encryptionKey, "foo.com")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, user4Addr, s.encryptionKey, "foo.com", "", false)
 		s.Require().NotEmpty(err)
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, user4Addr)
 		s.Require().Empty(r)
@@ -201,7 +203,7 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 func (s *KeeperTestSuite) TestModifyOSLocator() {
 	s.Run("modify os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "https://bob.com/alice", "", false, 0)
 		s.Require().Empty(err)
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
 		s.Require().NotEmpty(r)
@@ -211,13 +213,13 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 	})
 	s.Run("modify os locator invalid uri", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "://bob.com/alice")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "://bob.com/alice", "", false, 0)
 		s.Require().NotEmpty(err)
 	})
 
 	s.Run("modify os locator invalid uri length", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8", "", false, 0)
 		s.Require().NotEmpty(err)
 		s.Require().Equal("uri length greater than allowed", err.Error())
 	})
@@ -226,7 +228,7 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	s.Run("delete os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr)
+		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "")
 		s.Require().Empty(err)
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
 		s.Require().Empty(r)
@@ -234,6 +236,158 @@ func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	})
 }
 
+func (s *KeeperTestSuite) TestNamedOSLocators() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user3Addr))
+	mk := s.app.MetadataKeeper
+
+	s.Require().NoError(mk.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://one.com", "one", false), "SetOSLocator one")
+	s.Require().NoError(mk.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://two.com", "two", false), "SetOSLocator two")
+	err := mk.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://three.com", "two", false)
+	s.Require().ErrorIs(err, types.ErrOSLocatorAlreadyBound, "SetOSLocator duplicate name")
+
+	primary, found := mk.GetOsLocatorRecord(s.ctx, s.user3Addr)
+	s.Require().True(found, "GetOsLocatorRecord found")
+	s.Assert().Equal("one", primary.Name, "primary locator name: first bound")
+
+	s.Require().NoError(mk.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://three.com", "three", true), "SetOSLocator three")
+	primary, _ = mk.GetOsLocatorRecord(s.ctx, s.user3Addr)
+	s.Assert().Equal("three", primary.Name, "primary locator name: bound as primary")
+
+	s.Require().NoError(mk.ModifyOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://two.com", "two", true, 0), "ModifyOSLocator two")
+	primary, _ = mk.GetOsLocatorRecord(s.ctx, s.user3Addr)
+	s.Assert().Equal("two", primary.Name, "primary locator name: modified to primary")
+
+	locators, err := mk.GetOSLocatorsByOwner(s.ctx, s.user3Addr)
+	s.Require().NoError(err, "GetOSLocatorsByOwner")
+	var names, primaries []string
+	for _, locator := range locators {
+		names = append(names, locator.Name)
+		if locator.Primary {
+			primaries = append(primaries, locator.Name)
+		}
+	}
+	s.Assert().Equal([]string{"one", "three", "two"}, names, "locator names")
+	s.Assert().Equal([]string{"two"}, primaries, "primary locator names")
+
+	err = mk.RemoveOSLocator(s.ctx, s.user3Addr, "two")
+	s.Assert().EqualError(err, `cannot remove primary locator "two" of `+s.user3+" while it has other locators", "RemoveOSLocator primary")
+	s.Require().NoError(mk.RemoveOSLocator(s.ctx, s.user3Addr, "one"), "RemoveOSLocator one")
+	s.Require().NoError(mk.RemoveOSLocator(s.ctx, s.user3Addr, "three"), "RemoveOSLocator three")
+	s.Require().NoError(mk.RemoveOSLocator(s.ctx, s.user3Addr, "two"), "RemoveOSLocator two (now the only one)")
+	s.Assert().False(mk.OSLocatorExists(s.ctx, s.user3Addr), "OSLocatorExists after removing all")
+}
+
+func (s *KeeperTestSuite) TestOSLocatorKeyRotation() {
+	mk := s.app.MetadataKeeper
+	key2 := sdk.AccAddress("encryption_key_2____")
+	key3 := sdk.AccAddress("encryption_key_3____")
+	ctx := s.ctx.WithBlockHeight(100)
+
+	// user2's locator came from genesis so it doesn't have any key versions yet.
+	err := mk.ModifyOSLocator(ctx, s.user2Addr, key2, s.uri1, "", false, 90)
+	s.Assert().EqualError(err, "key activation height 90 cannot be before the current block height 100", "activation height in the past")
+	err = mk.ModifyOSLocator(ctx, s.user2Addr, s.encryptionKey1, s.uri1, "", false, 150)
+	s.Assert().EqualError(err, "key activation height 150 provided without a new encryption key", "activation height without new key")
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	mk.SetScope(ctx, types.Scope{
+		ScopeId:           scopeID,
+		SpecificationId:   types.ScopeSpecMetadataAddress(uuid.New()),
+		Owners:            ownerPartyList(s.user2),
+		ValueOwnerAddress: s.user2,
+	})
+	// assertCurrentKey checks the encryption key of user2's locator in each query that doesn't take a height.
+	assertCurrentKey := func(ctx sdk.Context, expKey string) {
+		byScope, err := mk.OSLocatorsByScope(ctx, &types.OSLocatorsByScopeRequest{ScopeId: scopeID.String()})
+		if s.Assert().NoError(err, "OSLocatorsByScope") && s.Assert().Len(byScope.Locators, 1, "OSLocatorsByScope locators") {
+			s.Assert().Equal(expKey, byScope.Locators[0].EncryptionKey, "OSLocatorsByScope encryption key")
+		}
+		byURI, err := mk.OSLocatorsByURI(ctx, &types.OSLocatorsByURIRequest{Uri: s.uri1})
+		if s.Assert().NoError(err, "OSLocatorsByURI") && s.Assert().Len(byURI.Locators, 1, "OSLocatorsByURI locators") {
+			s.Assert().Equal(expKey, byURI.Locators[0].EncryptionKey, "OSLocatorsByURI encryption key")
+		}
+		all, err := mk.OSAllLocators(ctx, &types.OSAllLocatorsRequest{})
+		if s.Assert().NoError(err, "OSAllLocators") {
+			var keys []string
+			for _, locator := range all.Locators {
+				if locator.Owner == s.user2 {
+					keys = append(keys, locator.EncryptionKey)
+				}
+			}
+			s.Assert().Equal([]string{expKey}, keys, "OSAllLocators encryption keys")
+		}
+	}
+
+	s.Require().NoError(mk.ModifyOSLocator(ctx, s.user2Addr, key2, s.uri1, "", false, 150), "rotate to key2")
+	locator, found := mk.GetOsLocatorRecord(ctx, s.user2Addr)
+	s.Require().True(found, "GetOsLocatorRecord found")
+	s.Assert().Equal(s.encryptionKey1.String(), locator.EncryptionKey, "encryption key before key2 activation")
+	assertCurrentKey(ctx, s.encryptionKey1.String())
+	assertCurrentKey(ctx.WithBlockHeight(150), key2.String())
+	// Providing the pending key again doesn't add another version.
+	s.Require().NoError(mk.ModifyOSLocator(ctx, s.user2Addr, key2, s.uri1, "", false, 0), "pending key provided again")
+	err = mk.ModifyOSLocator(ctx, s.user2Addr, key3, s.uri1, "", false, 140)
+	s.Assert().EqualError(err, "key activation height 140 cannot be before the activation height 150 of encryption key version 2",
+		"activation height before previous version")
+	s.Require().NoError(mk.ModifyOSLocator(ctx.WithBlockHeight(160), s.user2Addr, key3, s.uri1, "", false, 0), "rotate to key3")
+
+	locator, found = mk.GetOsLocatorRecord(ctx, s.user2Addr)
+	s.Require().True(found, "GetOsLocatorRecord found")
+	s.Assert().Equal(key3.String(), locator.EncryptionKey, "encryption key")
+	assertCurrentKey(ctx, s.encryptionKey1.String())
+	assertCurrentKey(ctx.WithBlockHeight(155), key2.String())
+	s.Assert().Equal([]types.OSLocatorEncryptionKey{
+		{Version: 1, EncryptionKey: s.encryptionKey1.String(), ActivationHeight: 0},
+		{Version: 2, EncryptionKey: key2.String(), ActivationHeight: 150},
+		{Version: 3, EncryptionKey: key3.String(), ActivationHeight: 160},
+	}, locator.EncryptionKeyVersions, "encryption key versions")
+
+	tests := []struct {
+		height int64
+		expKey string
+	}{
+		{height: 100, expKey: s.encryptionKey1.String()},
+		{height: 149, expKey: s.encryptionKey1.String()},
+		{height: 150, expKey: key2.String()},
+		{height: 159, expKey: key2.String()},
+		{height: 160, expKey: key3.String()},
+		{height: 1000, expKey: key3.String()},
+	}
+	for _, tc := range tests {
+		s.Run(fmt.Sprintf("OSLocator at height %d", tc.height), func() {
+			resp, err := mk.OSLocator(ctx, &types.OSLocatorRequest{Owner: s.user2, KeyHeight: tc.height})
+			s.Require().NoError(err, "OSLocator")
+			s.Require().NotNil(resp.Locator, "OSLocator response locator")
+			s.Assert().Equal(tc.expKey, resp.Locator.EncryptionKey, "OSLocator encryption key")
+		})
+	}
+
+	s.Run("OSLocator at current height", func() {
+		resp, err := mk.OSLocator(ctx, &types.OSLocatorRequest{Owner: s.user2})
+		s.Require().NoError(err, "OSLocator")
+		s.Assert().Equal(s.encryptionKey1.String(), resp.Locator.EncryptionKey, "OSLocator encryption key")
+	})
+
+	s.Run("OSLocatorsByOwner", func() {
+		s.app.AccountKeeper.SetAccount(ctx, s.app.AccountKeeper.NewAccountWithAddress(ctx, s.user2Addr))
+		s.Require().NoError(mk.SetOSLocator(ctx.WithBlockHeight(200), s.user2Addr, key2, "https://backup.com", "backup", false), "SetOSLocator backup")
+
+		resp, err := mk.OSLocatorsByOwner(ctx, &types.OSLocatorsByOwnerRequest{Owner: s.user2, KeyHeight: 155})
+		s.Require().NoError(err, "OSLocatorsByOwner at height 155")
+		s.Require().Len(resp.Locators, 1, "OSLocatorsByOwner at height 155 locators")
+		s.Assert().Equal(key2.String(), resp.Locators[0].EncryptionKey, "OSLocatorsByOwner at height 155 encryption key")
+
+		resp, err = mk.OSLocatorsByOwner(ctx, &types.OSLocatorsByOwnerRequest{Owner: s.user2, KeyHeight: 200})
+		s.Require().NoError(err, "OSLocatorsByOwner at height 200")
+		s.Require().Len(resp.Locators, 2, "OSLocatorsByOwner at height 200 locators")
+		s.Assert().Equal("", resp.Locators[0].Name, "first locator name")
+		s.Assert().Equal("backup", resp.Locators[1].Name, "second locator name")
+
+		_, err = mk.OSLocator(ctx, &types.OSLocatorRequest{Owner: s.user2, Name: "backup", KeyHeight: 199})
+		s.Assert().ErrorIs(err, types.ErrAddressNotBound, "OSLocator backup before it was bound")
+	})
+}
+
 func (s *KeeperTestSuite) TestUnionDistinct() {
 	tests := []struct {
 		name   string
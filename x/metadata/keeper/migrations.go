@@ -1,5 +1,15 @@
 package keeper
 
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +19,64 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate4To5 adds the existing scopes to the secondary indexes used to look up
+// scopes by party address and role, and by data access address.
+// It also adds the existing records to the index used to look up records by output hash.
+func (m Migrator) Migrate4To5(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/metadata from 4 to 5.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ScopeKeyPrefix)
+	defer it.Close()
+
+	scopeCount := 0
+	for ; it.Valid(); it.Next() {
+		scopeCount++
+		var scope types.Scope
+		if err := m.keeper.cdc.Unmarshal(it.Value(), &scope); err != nil {
+			return fmt.Errorf("error reading scope %s from state: %w", types.MetadataAddress(it.Key()), err)
+		}
+
+		// Only the party role and data access entries are new, so only those are written here.
+		all := getScopeIndexValues(&scope)
+		newIndexes := scopeIndexValues{ScopeID: all.ScopeID, PartyRoles: all.PartyRoles, DataAccess: all.DataAccess}
+		for _, indexKey := range newIndexes.IndexKeys() {
+			store.Set(indexKey, []byte{0x01})
+		}
+
+		if scopeCount%10_000 == 0 {
+			logger.Info("Progress update:", "scopes", scopeCount)
+		}
+	}
+
+	recordCount, err := m.indexExistingRecords(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("Done migrating x/metadata from 4 to 5.", "scopes", scopeCount, "records", recordCount)
+	return nil
+}
+
+// indexExistingRecords adds all records in state to the record output hash index.
+func (m Migrator) indexExistingRecords(ctx sdk.Context) (int, error) {
+	logger := m.keeper.Logger(ctx)
+	store := ctx.KVStore(m.keeper.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.RecordKeyPrefix)
+	defer it.Close()
+
+	recordCount := 0
+	for ; it.Valid(); it.Next() {
+		recordCount++
+		var record types.Record
+		if err := m.keeper.cdc.Unmarshal(it.Value(), &record); err != nil {
+			return recordCount, fmt.Errorf("error reading record %s from state: %w", types.MetadataAddress(it.Key()), err)
+		}
+		indexRecord(store, it.Key(), &record, nil)
+
+		if recordCount%10_000 == 0 {
+			logger.Info("Progress update:", "records", recordCount)
+		}
+	}
+	return recordCount, nil
+}
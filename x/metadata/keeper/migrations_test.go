@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	kpr := app.MetadataKeeper
+
+	owner := sdk.AccAddress("owner_______________")
+	servicer := sdk.AccAddress("servicer____________")
+	reader := sdk.AccAddress("reader______________")
+	specID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	scopes := []types.Scope{
+		{
+			ScopeId:         types.ScopeMetadataAddress(uuid.New()),
+			SpecificationId: specID,
+			Owners: []types.Party{
+				{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER},
+				{Address: servicer.String(), Role: types.PartyType_PARTY_TYPE_SERVICER},
+			},
+			DataAccess: []string{reader.String()},
+		},
+		{
+			ScopeId:         types.ScopeMetadataAddress(uuid.New()),
+			SpecificationId: specID,
+			Owners:          []types.Party{{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER}},
+			DataAccess:      []string{reader.String(), servicer.String()},
+		},
+	}
+
+	sessionID := scopes[0].ScopeId.MustGetAsSessionAddress(uuid.New())
+	record := types.Record{
+		Name:      "record",
+		SessionId: sessionID,
+		Process:   *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "method"),
+		Outputs:   []types.RecordOutput{{Hash: "output-hash", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+	}
+	recordID := sessionID.MustGetAsRecordAddress(record.Name)
+
+	// Write the scopes and record, then delete the index entries that didn't exist before version 5.
+	store := ctx.KVStore(kpr.GetStoreKey())
+	kpr.SetRecord(ctx, record)
+	store.Delete(types.GetRecordOutputHashCacheKey("output-hash", recordID))
+	for i, scope := range scopes {
+		require.NoError(t, kpr.SetScope(ctx, scope), "[%d]: SetScope", i)
+		for _, party := range scope.Owners {
+			addr := sdk.MustAccAddressFromBech32(party.Address)
+			store.Delete(types.GetAddressRoleScopeCacheKey(addr, party.Role, scope.ScopeId))
+		}
+		for _, da := range scope.DataAccess {
+			store.Delete(types.GetDataAccessScopeCacheKey(sdk.MustAccAddressFromBech32(da), scope.ScopeId))
+		}
+	}
+
+	getRoleScopes := func(addr sdk.AccAddress, role types.PartyType) []string {
+		resp, err := kpr.ScopesByPartyRole(ctx, &types.ScopesByPartyRoleRequest{Address: addr.String(), Role: role})
+		require.NoError(t, err, "ScopesByPartyRole(%s, %s)", addr, role)
+		return resp.ScopeIds
+	}
+	getDataAccessScopes := func(addr sdk.AccAddress) []string {
+		resp, err := kpr.ScopesByDataAccess(ctx, &types.ScopesByDataAccessRequest{Address: addr.String()})
+		require.NoError(t, err, "ScopesByDataAccess(%s)", addr)
+		return resp.ScopeIds
+	}
+
+	getHashRecords := func(hash string) []types.OutputHashRecord {
+		resp, err := kpr.RecordsByOutputHash(ctx, &types.RecordsByOutputHashRequest{Hash: hash})
+		require.NoError(t, err, "RecordsByOutputHash(%q)", hash)
+		return resp.Records
+	}
+
+	require.Empty(t, getRoleScopes(owner, types.PartyType_PARTY_TYPE_OWNER), "owner scopes before migration")
+	require.Empty(t, getHashRecords("output-hash"), "output hash records before migration")
+	require.Empty(t, getDataAccessScopes(reader), "reader scopes before migration")
+
+	migrator := keeper.NewMigrator(kpr)
+	require.NoError(t, migrator.Migrate4To5(ctx), "Migrate4To5")
+
+	assert.ElementsMatch(t, []string{scopes[0].ScopeId.String(), scopes[1].ScopeId.String()},
+		getRoleScopes(owner, types.PartyType_PARTY_TYPE_OWNER), "owner scopes after migration")
+	assert.ElementsMatch(t, []string{scopes[0].ScopeId.String()},
+		getRoleScopes(servicer, types.PartyType_PARTY_TYPE_SERVICER), "servicer scopes after migration")
+	assert.Empty(t, getRoleScopes(servicer, types.PartyType_PARTY_TYPE_OWNER), "servicer owned scopes after migration")
+	assert.ElementsMatch(t, []string{scopes[0].ScopeId.String(), scopes[1].ScopeId.String()},
+		getDataAccessScopes(reader), "reader scopes after migration")
+	assert.ElementsMatch(t, []string{scopes[1].ScopeId.String()},
+		getDataAccessScopes(servicer), "servicer data access scopes after migration")
+	assert.Equal(t, []types.OutputHashRecord{{RecordId: recordID.String(), ScopeId: scopes[0].ScopeId.String()}},
+		getHashRecords("output-hash"), "output hash records after migration")
+}
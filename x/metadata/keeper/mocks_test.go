@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
@@ -621,6 +622,18 @@ func (k *MockBankKeeper) DenomOwner(_ context.Context, denom string) (sdk.AccAdd
 	return nil, nil
 }
 
+func (k *MockBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
+	panic("not implemented")
+}
+
+func (k *MockBankKeeper) DenomOwners(_ context.Context, _ *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	panic("not implemented")
+}
+
+func (k *MockBankKeeper) DenomHolders(_ context.Context, _ string) ([]*banktypes.DenomOwner, error) {
+	panic("not implemented")
+}
+
 func (k *MockBankKeeper) GetScopesForValueOwner(_ context.Context, _ sdk.AccAddress, _ *query.PageRequest) (types.AccMDLinks, *query.PageResponse, error) {
 	panic("not implemented")
 }
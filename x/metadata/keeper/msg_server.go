@@ -43,25 +43,44 @@ func (k msgServer) WriteScope(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if err = k.storeScope(ctx, msg.Scope, msg.UsdMills, transferAgents, msg); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSignerStrs()))
+	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
+}
+
+// storeScope writes an already validated scope (and its NAV if usdMills is positive) and records the change.
+func (k msgServer) storeScope(
+	ctx sdk.Context,
+	scope types.Scope,
+	usdMills uint64,
+	transferAgents []sdk.AccAddress,
+	msg types.MetadataMsg,
+) error {
+	before := k.getScopeSnapshot(ctx, scope.ScopeId)
+	var navs []types.NetAssetValue
+
 	// Do not set a NAV entry at this time unless a value greater than zero is specified.  This avoids the common case of
 	// not having a NAV entry value at hand during a scope write request.  A zero value can still be set explicitly with
 	// an add NAV call made separately.
-	if msg.UsdMills > 0 {
-		usdMills := sdkmath.NewIntFromUint64(msg.UsdMills)
-		nav := types.NewNetAssetValue(sdk.NewCoin(types.UsdDenom, usdMills), 1)
-		err = k.AddSetNetAssetValues(ctx, msg.Scope.ScopeId, []types.NetAssetValue{nav}, types.ModuleName)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if usdMills > 0 {
+		// The usd mills are the value of the whole scope, so the volume is all of the scope's value units.
+		volume := k.GetScopeTotalValueUnits(ctx, scope.ScopeId)
+		navs = append(navs, types.NewNetAssetValue(sdk.NewCoin(types.UsdDenom, sdkmath.NewIntFromUint64(usdMills)), volume))
+		if err := k.AddSetNetAssetValues(ctx, scope.ScopeId, navs, types.ModuleName); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
 	}
 
-	err = k.SetScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.Scope)
-	if err != nil {
-		return nil, fmt.Errorf("could not write scope %q: %w", msg.Scope.ScopeId, err)
+	if err := k.SetScope(markertypes.WithTransferAgents(ctx, transferAgents...), scope); err != nil {
+		return fmt.Errorf("could not write scope %q: %w", scope.ScopeId, err)
 	}
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSignerStrs()))
-	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
+	changes := types.ScopeDiff(before, k.getScopeSnapshot(ctx, scope.ScopeId))
+	k.AddScopeChange(ctx, scope.ScopeId, msg, append(changes, netAssetValueChanges(navs)...))
+	return nil
 }
 
 // DeleteScope deletes a scope and all associated Records, Sessions.
@@ -77,17 +96,89 @@ func (k msgServer) DeleteScope(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	err = k.RemoveScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.ScopeId)
-	if err != nil {
-		return nil, fmt.Errorf("could not delete scope %q: %w", msg.ScopeId, err)
+	if err = k.removeScope(ctx, msg.ScopeId, transferAgents, msg); err != nil {
+		return nil, err
 	}
 
-	k.RemoveNetAssetValues(ctx, msg.ScopeId)
-
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScope, msg.GetSignerStrs()))
 	return &types.MsgDeleteScopeResponse{}, nil
 }
 
+// removeScope deletes an already validated scope (and its NAVs) and records the change.
+func (k msgServer) removeScope(
+	ctx sdk.Context,
+	scopeID types.MetadataAddress,
+	transferAgents []sdk.AccAddress,
+	msg types.MetadataMsg,
+) error {
+	before := k.getScopeSnapshot(ctx, scopeID)
+	if err := k.RemoveScope(markertypes.WithTransferAgents(ctx, transferAgents...), scopeID); err != nil {
+		return fmt.Errorf("could not delete scope %q: %w", scopeID, err)
+	}
+
+	k.RemoveNetAssetValues(ctx, scopeID)
+	if before != nil {
+		k.AddScopeChange(ctx, scopeID, msg, types.ScopeDiff(before, nil))
+	}
+	return nil
+}
+
+// WriteScopes adds or updates several scopes at once.
+func (k msgServer) WriteScopes(
+	goCtx context.Context,
+	msg *types.MsgWriteScopesRequest,
+) (*types.MsgWriteScopesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteScopes")
+	ctx := addBulkCacheToContext(UnwrapMetadataContext(goCtx))
+
+	scopeIDs := make([]types.MetadataAddress, len(msg.Scopes))
+	err := processBulkEntries(ctx, len(msg.Scopes), func(ctx sdk.Context, i int) error {
+		scope := msg.Scopes[i]
+		scopeIDs[i] = scope.ScopeId
+		transferAgents, err := k.validateWriteScope(ctx, scope, msg)
+		if err == nil {
+			err = k.storeScope(ctx, scope, 0, transferAgents, msg)
+		}
+		if err != nil {
+			return fmt.Errorf("scopes[%d] %s: %w", i, scope.ScopeId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScopes, msg.GetSignerStrs()))
+	return types.NewMsgWriteScopesResponse(scopeIDs), nil
+}
+
+// DeleteScopes deletes several scopes (and all associated Records, Sessions) at once.
+func (k msgServer) DeleteScopes(
+	goCtx context.Context,
+	msg *types.MsgDeleteScopesRequest,
+) (*types.MsgDeleteScopesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteScopes")
+	ctx := addBulkCacheToContext(UnwrapMetadataContext(goCtx))
+
+	err := processBulkEntries(ctx, len(msg.ScopeIds), func(ctx sdk.Context, i int) error {
+		scopeID := msg.ScopeIds[i]
+		transferAgents, err := k.validateDeleteScope(ctx, scopeID, msg)
+		if err == nil {
+			err = k.removeScope(ctx, scopeID, transferAgents, msg)
+		}
+		if err != nil {
+			return fmt.Errorf("scope id[%d] %s: %w", i, scopeID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopes, msg.GetSignerStrs()))
+	return &types.MsgDeleteScopesResponse{}, nil
+}
+
 // AddScopeDataAccess adds data access AccAddress to scope
 func (k msgServer) AddScopeDataAccess(
 	goCtx context.Context,
@@ -105,12 +196,14 @@ func (k msgServer) AddScopeDataAccess(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	before := k.getScopeSnapshot(ctx, msg.ScopeId)
 	existing.AddDataAccess(msg.DataAccess)
 
 	err := k.SetScope(ctx, existing)
 	if err != nil {
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
+	k.addScopeDiff(ctx, msg.ScopeId, before, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, msg.GetSignerStrs()))
 	return &types.MsgAddScopeDataAccessResponse{}, nil
@@ -133,12 +226,14 @@ func (k msgServer) DeleteScopeDataAccess(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	before := k.getScopeSnapshot(ctx, msg.ScopeId)
 	existing.RemoveDataAccess(msg.DataAccess)
 
 	err := k.SetScope(ctx, existing)
 	if err != nil {
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
+	k.addScopeDiff(ctx, msg.ScopeId, before, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeDataAccess, msg.GetSignerStrs()))
 	return &types.MsgDeleteScopeDataAccessResponse{}, nil
@@ -171,10 +266,12 @@ func (k msgServer) AddScopeOwner(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	before := k.getScopeSnapshot(ctx, msg.ScopeId)
 	err := k.SetScope(ctx, proposed)
 	if err != nil {
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
+	k.addScopeDiff(ctx, msg.ScopeId, before, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeOwner, msg.GetSignerStrs()))
 	return &types.MsgAddScopeOwnerResponse{}, nil
@@ -207,10 +304,12 @@ func (k msgServer) DeleteScopeOwner(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	before := k.getScopeSnapshot(ctx, msg.ScopeId)
 	err := k.SetScope(ctx, proposed)
 	if err != nil {
 		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
 	}
+	k.addScopeDiff(ctx, msg.ScopeId, before, msg)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeOwner, msg.GetSignerStrs()))
 	return &types.MsgDeleteScopeOwnerResponse{}, nil
@@ -234,10 +333,14 @@ func (k msgServer) UpdateValueOwners(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	befores := k.getScopeSnapshots(ctx, links)
 	err = k.SetScopeValueOwners(markertypes.WithTransferAgents(ctx, signers...), links, msg.ValueOwnerAddress)
 	if err != nil {
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
+	for i, link := range links {
+		k.addScopeDiff(ctx, link.MDAddr, befores[i], msg)
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UpdateValueOwners, msg.GetSignerStrs()))
 	return &types.MsgUpdateValueOwnersResponse{}, nil
@@ -269,15 +372,113 @@ func (k msgServer) MigrateValueOwner(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	befores := k.getScopeSnapshots(ctx, links)
 	err = k.SetScopeValueOwners(markertypes.WithTransferAgents(ctx, signers...), links, msg.Proposed)
 	if err != nil {
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
+	for i, link := range links {
+		k.addScopeDiff(ctx, link.MDAddr, befores[i], msg)
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateValueOwner, msg.GetSignerStrs()))
 	return &types.MsgMigrateValueOwnerResponse{}, nil
 }
 
+// MigrateScopeSpecification moves one or more scopes to a newer version of their scope specification.
+func (k msgServer) MigrateScopeSpecification(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecificationRequest,
+) (*types.MsgMigrateScopeSpecificationResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "MigrateScopeSpecification")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	scopes, err := k.ValidateMigrateScopeSpecification(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	for _, scope := range scopes {
+		before := k.getScopeSnapshot(ctx, scope.ScopeId)
+		fromSpecID := scope.SpecificationId
+		scope.SpecificationId = msg.SpecificationId
+		if err = k.SetScope(ctx, scope); err != nil {
+			return nil, fmt.Errorf("could not write scope %q: %w", scope.ScopeId, err)
+		}
+		k.addScopeDiff(ctx, scope.ScopeId, before, msg)
+		k.EmitEvent(ctx, types.NewEventScopeSpecificationMigrated(scope.ScopeId, fromSpecID, msg.SpecificationId))
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpecification, msg.GetSignerStrs()))
+	return &types.MsgMigrateScopeSpecificationResponse{}, nil
+}
+
+// AddScopeLien puts a lien on a scope.
+func (k msgServer) AddScopeLien(
+	goCtx context.Context,
+	msg *types.MsgAddScopeLienRequest,
+) (*types.MsgAddScopeLienResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AddScopeLien")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.ValidateAddScopeLien(ctx, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := k.SetScopeLien(ctx, msg.Lien); err != nil {
+		return nil, fmt.Errorf("could not set lien on scope %q: %w", msg.Lien.ScopeId, err)
+	}
+
+	k.AddScopeChange(ctx, msg.Lien.ScopeId, msg, []string{fmt.Sprintf("lien added for %s", msg.Lien.Lienholder)})
+	k.EmitEvent(ctx, types.NewEventScopeLienAdded(msg.Lien.ScopeId, msg.Lien.Lienholder))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeLien, msg.GetSignerStrs()))
+	return &types.MsgAddScopeLienResponse{}, nil
+}
+
+// ReleaseScopeLien removes the lien from a scope.
+func (k msgServer) ReleaseScopeLien(
+	goCtx context.Context,
+	msg *types.MsgReleaseScopeLienRequest,
+) (*types.MsgReleaseScopeLienResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ReleaseScopeLien")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	lien, err := k.ValidateReleaseScopeLien(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.RemoveScopeLien(ctx, msg.ScopeId)
+
+	k.AddScopeChange(ctx, msg.ScopeId, msg, []string{fmt.Sprintf("lien released by %s", lien.Lienholder)})
+	k.EmitEvent(ctx, types.NewEventScopeLienReleased(msg.ScopeId, lien.Lienholder))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ReleaseScopeLien, msg.GetSignerStrs()))
+	return &types.MsgReleaseScopeLienResponse{}, nil
+}
+
+// FractionalizeScopeValue splits the value of a scope into multiple units.
+func (k msgServer) FractionalizeScopeValue(
+	goCtx context.Context,
+	msg *types.MsgFractionalizeScopeValueRequest,
+) (*types.MsgFractionalizeScopeValueResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "FractionalizeScopeValue")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	valueOwner, err := k.ValidateFractionalizeScopeValue(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.SplitScopeValue(ctx, msg.ScopeId, msg.Units, valueOwner); err != nil {
+		return nil, fmt.Errorf("failure splitting scope value: %w", err)
+	}
+
+	k.AddScopeChange(ctx, msg.ScopeId, msg, []string{fmt.Sprintf("value split into %d units", msg.Units)})
+	k.EmitEvent(ctx, types.NewEventScopeValueFractionalized(msg.ScopeId, valueOwner.String(), msg.Units))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_FractionalizeScopeValue, msg.GetSignerStrs()))
+	return &types.MsgFractionalizeScopeValueResponse{}, nil
+}
+
 // WriteSession adds or updates a session context.
 func (k msgServer) WriteSession(
 	goCtx context.Context,
@@ -303,6 +504,14 @@ func (k msgServer) WriteSession(
 
 	k.SetSession(ctx, msg.Session)
 
+	// The session id was validated in ValidateWriteSession, so we know it has a scope id.
+	scopeID, _ := msg.Session.SessionId.AsScopeAddress()
+	action := "created"
+	if existing != nil {
+		action = "updated"
+	}
+	k.AddScopeChange(ctx, scopeID, msg, []string{fmt.Sprintf("session %s %s", msg.Session.SessionId, action)})
+
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSignerStrs()))
 	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
 }
@@ -318,31 +527,47 @@ func (k msgServer) WriteRecord(
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
-	scopeUUID, err := msg.Record.SessionId.ScopeUUID()
+	recordID, err := k.writeRecord(ctx, &msg.Record, msg)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	recordID := types.RecordMetadataAddress(scopeUUID, msg.Record.Name)
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSignerStrs()))
+	return types.NewMsgWriteRecordResponse(recordID), nil
+}
+
+// writeRecord validates and writes a record, and records the change. Returns the id of the record.
+func (k msgServer) writeRecord(ctx sdk.Context, record *types.Record, msg types.MetadataMsg) (types.MetadataAddress, error) {
+	scopeUUID, err := record.SessionId.ScopeUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	recordID := types.RecordMetadataAddress(scopeUUID, record.Name)
 
 	var existing *types.Record
 	if e, found := k.GetRecord(ctx, recordID); found {
 		existing = &e
 	}
-	if err = k.ValidateWriteRecord(ctx, existing, msg); err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if err = k.validateWriteRecord(ctx, existing, record, msg); err != nil {
+		return nil, err
 	}
 
-	k.SetRecord(ctx, msg.Record)
+	k.SetRecord(ctx, *record)
 
 	// Remove the old session if it doesn't have any records in it anymore.
 	// Note that the RemoveSession does the record checking part.
-	if existing != nil && !existing.SessionId.Equals(msg.Record.SessionId) {
+	if existing != nil && !existing.SessionId.Equals(record.SessionId) {
 		k.RemoveSession(ctx, existing.SessionId)
 	}
 
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSignerStrs()))
-	return types.NewMsgWriteRecordResponse(recordID), nil
+	action := "created"
+	if existing != nil {
+		action = "updated"
+	}
+	k.AddScopeChange(ctx, types.ScopeMetadataAddress(scopeUUID), msg,
+		[]string{fmt.Sprintf("record %q %s in session %s", record.Name, action, record.SessionId)})
+	return recordID, nil
 }
 
 // DeleteRecord deletes a record.
@@ -353,16 +578,75 @@ func (k msgServer) DeleteRecord(
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteRecord")
 	ctx := UnwrapMetadataContext(goCtx)
 
-	if err := k.ValidateDeleteRecord(ctx, msg.RecordId, msg); err != nil {
+	if err := k.deleteRecord(ctx, msg.RecordId, msg); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	k.RemoveRecord(ctx, msg.RecordId)
-
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecord, msg.GetSignerStrs()))
 	return &types.MsgDeleteRecordResponse{}, nil
 }
 
+// deleteRecord validates and deletes a record, and records the change.
+func (k msgServer) deleteRecord(ctx sdk.Context, recordID types.MetadataAddress, msg types.MetadataMsg) error {
+	if err := k.ValidateDeleteRecord(ctx, recordID, msg); err != nil {
+		return err
+	}
+
+	// ValidateDeleteRecord made sure the record exists, so we know the id has a scope id.
+	record, _ := k.GetRecord(ctx, recordID)
+	scopeID, _ := recordID.AsScopeAddress()
+	k.RemoveRecord(ctx, recordID)
+	k.AddScopeChange(ctx, scopeID, msg, []string{fmt.Sprintf("record %q deleted", record.Name)})
+	return nil
+}
+
+// WriteRecords adds or updates several records at once.
+func (k msgServer) WriteRecords(
+	goCtx context.Context,
+	msg *types.MsgWriteRecordsRequest,
+) (*types.MsgWriteRecordsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "WriteRecords")
+	ctx := addBulkCacheToContext(UnwrapMetadataContext(goCtx))
+
+	recordIDs := make([]types.MetadataAddress, len(msg.Records))
+	err := processBulkEntries(ctx, len(msg.Records), func(ctx sdk.Context, i int) error {
+		var err error
+		recordIDs[i], err = k.writeRecord(ctx, &msg.Records[i], msg)
+		if err != nil {
+			return fmt.Errorf("records[%d] %s: %w", i, msg.Records[i].GetRecordAddress(), err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecords, msg.GetSignerStrs()))
+	return types.NewMsgWriteRecordsResponse(recordIDs), nil
+}
+
+// DeleteRecords deletes several records at once.
+func (k msgServer) DeleteRecords(
+	goCtx context.Context,
+	msg *types.MsgDeleteRecordsRequest,
+) (*types.MsgDeleteRecordsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "DeleteRecords")
+	ctx := addBulkCacheToContext(UnwrapMetadataContext(goCtx))
+
+	err := processBulkEntries(ctx, len(msg.RecordIds), func(ctx sdk.Context, i int) error {
+		if err := k.deleteRecord(ctx, msg.RecordIds[i], msg); err != nil {
+			return fmt.Errorf("record id[%d] %s: %w", i, msg.RecordIds[i], err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecords, msg.GetSignerStrs()))
+	return &types.MsgDeleteRecordsResponse{}, nil
+}
+
 // WriteScopeSpecification adds or updates a scope specification.
 func (k msgServer) WriteScopeSpecification(
 	goCtx context.Context,
@@ -384,6 +668,14 @@ func (k msgServer) WriteScopeSpecification(
 	if err := k.ValidateWriteScopeSpecification(ctx, existing, msg.Specification); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	// Declaring a spec to be the successor of another requires the owners of that other spec to sign.
+	previousSpecID := msg.Specification.GetPreviousSpecID()
+	if len(previousSpecID) > 0 && (existing == nil || len(existing.GetPreviousSpecID()) == 0) {
+		previous, _ := k.GetScopeSpecification(ctx, previousSpecID)
+		if err := k.ValidateSignersWithoutParties(ctx, previous.OwnerAddresses, msg); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
 
 	k.SetScopeSpecification(ctx, msg.Specification)
 
@@ -652,13 +944,13 @@ func (k msgServer) BindOSLocator(
 	if strings.TrimSpace(msg.Locator.EncryptionKey) != "" {
 		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
 	}
-	if k.Keeper.OSLocatorExists(ctx, ownerAddress) {
-		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner)
+	if _, found := k.Keeper.GetOSLocatorByName(ctx, ownerAddress, msg.Locator.Name); found {
+		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	// Bind owner to URI
-	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri, msg.Locator.Name, msg.Locator.Primary); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -683,8 +975,8 @@ func (k msgServer) DeleteOSLocator(
 	// already valid address, checked in ValidateBasic
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if _, found := k.Keeper.GetOSLocatorByName(ctx, ownerAddr, msg.Locator.Name); !found {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
@@ -694,7 +986,7 @@ func (k msgServer) DeleteOSLocator(
 	}
 
 	// Delete
-	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr); err != nil {
+	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr, msg.Locator.Name); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -723,8 +1015,8 @@ func (k msgServer) ModifyOSLocator(
 		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
 	}
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if _, found := k.Keeper.GetOSLocatorByName(ctx, ownerAddr, msg.Locator.Name); !found {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
@@ -733,7 +1025,8 @@ func (k msgServer) ModifyOSLocator(
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
-	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri,
+		msg.Locator.Name, msg.Locator.Primary, msg.KeyActivationHeight); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -758,6 +1051,12 @@ func (k msgServer) SetAccountData(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	change := "account data set"
+	if len(msg.Value) == 0 {
+		change = "account data removed"
+	}
+	k.AddScopeChange(ctx, msg.MetadataAddr, msg, []string{change})
+
 	return &types.MsgSetAccountDataResponse{}, nil
 }
 
@@ -784,6 +1083,21 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	k.AddScopeChange(ctx, scopeID, msg, netAssetValueChanges(msg.NetAssetValues))
 
 	return &types.MsgAddNetAssetValuesResponse{}, nil
 }
+
+// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UpdateParams, msg.GetSignerStrs()))
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/app"
@@ -2425,3 +2427,794 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestWriteScopeWithParent() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification")
+
+	rootID := types.ScopeMetadataAddress(uuid.New())
+	childID := types.ScopeMetadataAddress(uuid.New())
+	grandchildID := types.ScopeMetadataAddress(uuid.New())
+	newScope := func(scopeID, parentID types.MetadataAddress, owner string) types.Scope {
+		scope := *types.NewScope(scopeID, scopeSpecID, ownerPartyList(owner), []string{}, "", false)
+		if parentID != nil {
+			scope.ParentScopeId = &parentID
+		}
+		return scope
+	}
+	writeScope := func(scope types.Scope, signers ...string) error {
+		_, wErr := s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(scope, signers, 0))
+		return wErr
+	}
+
+	s.Require().NoError(writeScope(newScope(rootID, nil, s.user1), s.user1), "WriteScope root")
+
+	err = writeScope(newScope(childID, rootID, s.user2), s.user2)
+	s.Assert().EqualError(err, "missing signature: "+s.user1+": invalid request", "WriteScope child without parent owner signature")
+
+	err = writeScope(newScope(childID, types.ScopeMetadataAddress(uuid.New()), s.user2), s.user2)
+	s.Assert().ErrorContains(err, "parent scope not found with id", "WriteScope child with unknown parent")
+
+	s.Require().NoError(writeScope(newScope(childID, rootID, s.user2), s.user1, s.user2), "WriteScope child")
+	s.Require().NoError(writeScope(newScope(grandchildID, childID, s.user2), s.user2), "WriteScope grandchild")
+
+	// Updating a scope without changing its parent doesn't need the parent's owners.
+	child := newScope(childID, rootID, s.user2)
+	child.DataAccess = []string{s.user2}
+	s.Assert().NoError(writeScope(child, s.user2), "WriteScope child update")
+
+	err = writeScope(newScope(rootID, grandchildID, s.user1), s.user1, s.user2)
+	s.Assert().EqualError(err, "parent scope "+grandchildID.String()+" is a descendant of scope "+rootID.String()+": invalid request",
+		"WriteScope root with grandchild as parent")
+
+	children, err := s.app.MetadataKeeper.ScopeChildren(s.ctx, &types.ScopeChildrenRequest{ScopeId: rootID.String()})
+	s.Require().NoError(err, "ScopeChildren")
+	s.Assert().Equal([]string{childID.String()}, children.ScopeIds, "ScopeChildren ScopeIds")
+
+	ancestors, err := s.app.MetadataKeeper.ScopeAncestors(s.ctx, &types.ScopeAncestorsRequest{ScopeId: grandchildID.String()})
+	s.Require().NoError(err, "ScopeAncestors")
+	s.Assert().Equal([]string{childID.String(), rootID.String()}, ancestors.ScopeIds, "ScopeAncestors ScopeIds")
+
+	_, err = s.msgServer.DeleteScope(s.ctx, types.NewMsgDeleteScopeRequest(rootID, []string{s.user1}))
+	s.Assert().EqualError(err, "scope "+rootID.String()+" has child scopes and cannot be deleted: invalid request", "DeleteScope root")
+
+	// Moving the grandchild up to the root moves its index entry too.
+	s.Require().NoError(writeScope(newScope(grandchildID, rootID, s.user2), s.user1, s.user2), "WriteScope grandchild to root")
+	children, err = s.app.MetadataKeeper.ScopeChildren(s.ctx, &types.ScopeChildrenRequest{ScopeId: childID.String()})
+	s.Require().NoError(err, "ScopeChildren of child")
+	s.Assert().Empty(children.ScopeIds, "ScopeChildren of child ScopeIds")
+	_, err = s.msgServer.DeleteScope(s.ctx, types.NewMsgDeleteScopeRequest(childID, []string{s.user2}))
+	s.Assert().NoError(err, "DeleteScope child")
+}
+
+func (s *MsgServerTestSuite) TestMigrateScopeSpecification() {
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	otherCSpec := cSpec
+	otherCSpec.SpecificationId = types.ContractSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, otherCSpec)
+
+	newScopeSpec := func(version uint32, deprecated bool, cSpecID types.MetadataAddress, previousID types.MetadataAddress) types.ScopeSpecification {
+		spec := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			ContractSpecIds: []types.MetadataAddress{cSpecID},
+			Version:         version,
+			Deprecated:      deprecated,
+		}
+		if len(previousID) > 0 {
+			spec.PreviousSpecId = &previousID
+		}
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, spec)
+		return spec
+	}
+	specV1 := newScopeSpec(1, false, cSpec.SpecificationId, nil)
+	specV0 := newScopeSpec(0, false, cSpec.SpecificationId, nil)
+	specV2 := newScopeSpec(2, false, cSpec.SpecificationId, specV1.SpecificationId)
+	specV2Other := newScopeSpec(2, false, otherCSpec.SpecificationId, specV1.SpecificationId)
+	specV2Unrelated := newScopeSpec(2, false, cSpec.SpecificationId, specV0.SpecificationId)
+	specV3 := newScopeSpec(3, false, cSpec.SpecificationId, specV2.SpecificationId)
+	specV3Dep := newScopeSpec(3, true, cSpec.SpecificationId, specV2.SpecificationId)
+	unknownSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	scopeUUID := uuid.New()
+	scope := *types.NewScope(types.ScopeMetadataAddress(scopeUUID), specV1.SpecificationId, ownerPartyList(s.user1), nil, "", false)
+	_, err := s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+	session := *types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+	_, err = s.msgServer.WriteSession(s.ctx, types.NewMsgWriteSessionRequest(session, []string{s.user1}))
+	s.Require().NoError(err, "WriteSession")
+
+	migrate := func(ctx sdk.Context, specID types.MetadataAddress, signers ...string) error {
+		msg := types.NewMsgMigrateScopeSpecificationRequest([]types.MetadataAddress{scope.ScopeId}, specID, signers)
+		_, mErr := s.msgServer.MigrateScopeSpecification(ctx, msg)
+		return mErr
+	}
+	cannotMigrate := func(msg string) string {
+		return "cannot migrate scope " + scope.ScopeId.String() + ": " + msg + ": invalid request"
+	}
+
+	tests := []struct {
+		name   string
+		specID types.MetadataAddress
+		signer string
+		expErr string
+	}{
+		{
+			name:   "unknown scope spec",
+			specID: unknownSpecID,
+			signer: s.user1,
+			expErr: "scope specification " + unknownSpecID.String() + " not found: invalid request",
+		},
+		{
+			name:   "deprecated scope spec",
+			specID: specV3Dep.SpecificationId,
+			signer: s.user1,
+			expErr: "scope specification " + specV3Dep.SpecificationId.String() + " is deprecated: invalid request",
+		},
+		{
+			name:   "same scope spec",
+			specID: specV1.SpecificationId,
+			signer: s.user1,
+			expErr: cannotMigrate("scope already uses scope specification " + specV1.SpecificationId.String()),
+		},
+		{
+			name:   "older scope spec",
+			specID: specV0.SpecificationId,
+			signer: s.user1,
+			expErr: cannotMigrate("scope specification " + specV0.SpecificationId.String() + " version 0 is not newer than " +
+				"current scope specification " + specV1.SpecificationId.String() + " version 1"),
+		},
+		{
+			name:   "scope spec that does not succeed the current one",
+			specID: specV2Unrelated.SpecificationId,
+			signer: s.user1,
+			expErr: cannotMigrate("scope specification " + specV2Unrelated.SpecificationId.String() + " does not succeed " +
+				"current scope specification " + specV1.SpecificationId.String()),
+		},
+		{
+			name:   "session contract spec not allowed",
+			specID: specV2Other.SpecificationId,
+			signer: s.user1,
+			expErr: cannotMigrate("session " + session.SessionId.String() + " contract specification " +
+				cSpec.SpecificationId.String() + " is not allowed by scope specification " + specV2Other.SpecificationId.String()),
+		},
+		{
+			name:   "missing owner signature",
+			specID: specV2.SpecificationId,
+			signer: s.user2,
+			expErr: cannotMigrate("missing signature: " + s.user1),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err = migrate(s.ctx, tc.specID, tc.signer)
+			s.Assert().EqualError(err, tc.expErr, "MigrateScopeSpecification")
+		})
+	}
+
+	s.Run("migrated through a chain of successors", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		s.Require().NoError(migrate(ctx, specV3.SpecificationId, s.user1), "MigrateScopeSpecification")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventScopeUpdated(scope.ScopeId)),
+			s.untypeEvent(types.NewEventScopeSpecificationMigrated(scope.ScopeId, specV1.SpecificationId, specV3.SpecificationId)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpecification, []string{s.user1})),
+		}
+		s.Assert().Equal(expEvents, em.Events(), "MigrateScopeSpecification events")
+
+		migrated, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal(specV3.SpecificationId, migrated.SpecificationId, "migrated scope SpecificationId")
+
+		history, err := s.app.MetadataKeeper.ScopeHistory(s.ctx, &types.ScopeHistoryRequest{ScopeId: scope.ScopeId.String()})
+		s.Require().NoError(err, "ScopeHistory")
+		s.Require().NotEmpty(history.Changes, "ScopeHistory changes")
+		last := history.Changes[len(history.Changes)-1]
+		s.Assert().Equal(types.TypeURLMsgMigrateScopeSpecificationRequest, last.MsgTypeUrl, "last change MsgTypeUrl")
+		s.Assert().Equal([]string{fmt.Sprintf("specification_id: %q -> %q", specV1.SpecificationId, specV3.SpecificationId)},
+			last.Changes, "last change Changes")
+	})
+
+	s.Run("previous spec owners must sign", func() {
+		user3 := s.setNamedUserAccount("user3").String()
+		successor := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{user3},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			Version:         4,
+			PreviousSpecId:  &specV3.SpecificationId,
+		}
+		ctx, _ := s.ctx.CacheContext()
+		_, err := s.msgServer.WriteScopeSpecification(ctx, types.NewMsgWriteScopeSpecificationRequest(successor, []string{user3}))
+		s.Assert().EqualError(err, "missing signature: "+s.user1+": invalid request", "WriteScopeSpecification without previous owner")
+		_, err = s.msgServer.WriteScopeSpecification(ctx, types.NewMsgWriteScopeSpecificationRequest(successor, []string{user3, s.user1}))
+		s.Assert().NoError(err, "WriteScopeSpecification with previous owner")
+	})
+}
+
+func (s *MsgServerTestSuite) TestDeprecatedSpecifications() {
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+		Version:         2,
+	}
+	_, err := s.msgServer.WriteContractSpecification(s.ctx, types.NewMsgWriteContractSpecificationRequest(cSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteContractSpecification")
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+		Version:         2,
+	}
+	_, err = s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification")
+
+	scopeUUID := uuid.New()
+	scope := *types.NewScope(types.ScopeMetadataAddress(scopeUUID), scopeSpec.SpecificationId, ownerPartyList(s.user1), nil, "", false)
+	_, err = s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+	session := *types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+	_, err = s.msgServer.WriteSession(s.ctx, types.NewMsgWriteSessionRequest(session, []string{s.user1}))
+	s.Require().NoError(err, "WriteSession")
+
+	s.Run("spec versions cannot decrease", func() {
+		olderCSpec := cSpec
+		olderCSpec.Version = 1
+		_, err = s.msgServer.WriteContractSpecification(s.ctx, types.NewMsgWriteContractSpecificationRequest(olderCSpec, []string{s.user1}))
+		s.Assert().EqualError(err, "cannot decrease contract spec version. expected >= 2, got 1: invalid request", "WriteContractSpecification")
+
+		olderScopeSpec := scopeSpec
+		olderScopeSpec.Version = 1
+		_, err = s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(olderScopeSpec, []string{s.user1}))
+		s.Assert().EqualError(err, "cannot decrease scope spec version. expected >= 2, got 1: invalid request", "WriteScopeSpecification")
+	})
+
+	cSpec.Deprecated = true
+	_, err = s.msgServer.WriteContractSpecification(s.ctx, types.NewMsgWriteContractSpecificationRequest(cSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteContractSpecification deprecated")
+	scopeSpec.Deprecated = true
+	_, err = s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification deprecated")
+
+	s.Run("existing scope and session can still be updated", func() {
+		scope.DataAccess = []string{s.user2}
+		_, err = s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}, 0))
+		s.Assert().NoError(err, "WriteScope existing")
+		session.Name = "someclass"
+		session.Context = []byte("updated")
+		_, err = s.msgServer.WriteSession(s.ctx, types.NewMsgWriteSessionRequest(session, []string{s.user1}))
+		s.Assert().NoError(err, "WriteSession existing")
+	})
+
+	s.Run("new scope cannot use deprecated scope spec", func() {
+		newScope := *types.NewScope(types.ScopeMetadataAddress(uuid.New()), scopeSpec.SpecificationId, ownerPartyList(s.user1), nil, "", false)
+		_, err = s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(newScope, []string{s.user1}, 0))
+		s.Assert().EqualError(err, "scope specification "+scopeSpec.SpecificationId.String()+" is deprecated: invalid request", "WriteScope new")
+	})
+
+	s.Run("new session cannot use deprecated contract spec", func() {
+		newSession := *types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+		_, err = s.msgServer.WriteSession(s.ctx, types.NewMsgWriteSessionRequest(newSession, []string{s.user1}))
+		s.Assert().EqualError(err, "contract specification "+cSpec.SpecificationId.String()+" is deprecated: invalid request", "WriteSession new")
+	})
+}
+
+func (s *MsgServerTestSuite) TestWriteScopesCountAuthorization() {
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, scopeSpec)
+	granter := s.setNamedUserAccount("granter")
+	grantee := s.setNamedUserAccount("grantee")
+
+	// updatedScopes stores new scopes owned by the granter and returns updates to them that the granter must sign.
+	updatedScopes := func(ctx sdk.Context, count int) []types.Scope {
+		rv := make([]types.Scope, count)
+		for i := range rv {
+			rv[i] = *types.NewScope(types.ScopeMetadataAddress(uuid.New()), scopeSpec.SpecificationId, ownerPartyList(granter.String()), nil, "", false)
+			s.app.MetadataKeeper.SetScope(ctx, rv[i])
+			rv[i].DataAccess = []string{s.user2}
+		}
+		return rv
+	}
+	saveGrant := func(ctx sdk.Context, count int32) {
+		auth := authz.NewCountAuthorization(types.TypeURLMsgWriteScopeRequest, count)
+		s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, grantee, granter, auth, nil), "SaveGrant")
+	}
+	signers := []string{grantee.String()}
+
+	s.Run("more entries than grant uses", func() {
+		ctx, _ := s.ctx.CacheContext()
+		saveGrant(ctx, 2)
+		scopes := updatedScopes(ctx, 3)
+		_, err := s.msgServer.WriteScopes(ctx, types.NewMsgWriteScopesRequest(scopes, signers))
+		expErr := "scopes[2] " + scopes[2].ScopeId.String() + ": missing signature: " + granter.String() + ": invalid request"
+		s.Assert().EqualError(err, expErr, "WriteScopes")
+	})
+
+	s.Run("one grant use per entry", func() {
+		ctx, _ := s.ctx.CacheContext()
+		saveGrant(ctx, 3)
+		_, err := s.msgServer.WriteScopes(ctx, types.NewMsgWriteScopesRequest(updatedScopes(ctx, 2), signers))
+		s.Require().NoError(err, "WriteScopes")
+		auth, _ := s.app.AuthzKeeper.GetAuthorization(ctx, grantee, granter, types.TypeURLMsgWriteScopeRequest)
+		if s.Assert().NotNil(auth, "GetAuthorization after WriteScopes") {
+			s.Assert().Equal(1, int(auth.(*authz.CountAuthorization).AllowedAuthorizations), "uses left on authorization")
+		}
+	})
+}
+
+func (s *MsgServerTestSuite) TestWriteDeleteScopes() {
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, scopeSpec)
+	unknownSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	newScope := func(specID types.MetadataAddress, owner string) types.Scope {
+		return *types.NewScope(types.ScopeMetadataAddress(uuid.New()), specID, ownerPartyList(owner), nil, "", false)
+	}
+	scope1 := newScope(scopeSpec.SpecificationId, s.user1)
+	scope2 := newScope(scopeSpec.SpecificationId, s.user1)
+	otherOwnerScope := newScope(scopeSpec.SpecificationId, s.user2)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, otherOwnerScope), "SetScope(otherOwnerScope)")
+	otherOwnerScope.DataAccess = []string{s.user1}
+	unknownSpecScope := newScope(unknownSpecID, s.user1)
+	signers := []string{s.user1}
+
+	s.Run("write with failures", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgWriteScopesRequest([]types.Scope{scope1, otherOwnerScope, scope2, unknownSpecScope}, signers)
+		_, err := s.msgServer.WriteScopes(ctx, msg)
+		expErr := "scopes[1] " + otherOwnerScope.ScopeId.String() + ": missing signature: " + s.user2 + "\n" +
+			"scopes[3] " + unknownSpecScope.ScopeId.String() + ": scope specification " + unknownSpecID.String() + " not found" +
+			": invalid request"
+		s.Assert().EqualError(err, expErr, "WriteScopes")
+	})
+
+	s.Run("write", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		resp, err := s.msgServer.WriteScopes(ctx, types.NewMsgWriteScopesRequest([]types.Scope{scope1, scope2}, signers))
+		s.Require().NoError(err, "WriteScopes")
+		s.Assert().Equal(types.NewMsgWriteScopesResponse([]types.MetadataAddress{scope1.ScopeId, scope2.ScopeId}), resp, "WriteScopes response")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventScopeCreated(scope1.ScopeId)),
+			s.untypeEvent(types.NewEventScopeCreated(scope2.ScopeId)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_WriteScopes, signers)),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "WriteScopes events")
+
+		for _, scope := range []types.Scope{scope1, scope2} {
+			_, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+			s.Assert().True(found, "GetScope(%s) found", scope.ScopeId)
+			history, hErr := s.app.MetadataKeeper.ScopeHistory(s.ctx, &types.ScopeHistoryRequest{ScopeId: scope.ScopeId.String()})
+			if s.Assert().NoError(hErr, "ScopeHistory(%s)", scope.ScopeId) && s.Assert().Len(history.Changes, 1, "ScopeHistory(%s) changes", scope.ScopeId) {
+				s.Assert().Equal(types.TypeURLMsgWriteScopesRequest, history.Changes[0].MsgTypeUrl, "ScopeHistory(%s) MsgTypeUrl", scope.ScopeId)
+			}
+		}
+	})
+
+	s.Run("delete with failures", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgDeleteScopesRequest([]types.MetadataAddress{scope1.ScopeId, otherOwnerScope.ScopeId}, signers)
+		_, err := s.msgServer.DeleteScopes(ctx, msg)
+		expErr := "scope id[1] " + otherOwnerScope.ScopeId.String() + ": missing signature: " + s.user2 +
+			": invalid request"
+		s.Assert().EqualError(err, expErr, "DeleteScopes")
+	})
+
+	s.Run("delete", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		_, err := s.msgServer.DeleteScopes(ctx, types.NewMsgDeleteScopesRequest([]types.MetadataAddress{scope1.ScopeId, scope2.ScopeId}, signers))
+		s.Require().NoError(err, "DeleteScopes")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventScopeDeleted(scope1.ScopeId)),
+			s.untypeEvent(types.NewEventScopeDeleted(scope2.ScopeId)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_DeleteScopes, signers)),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "DeleteScopes events")
+
+		for _, scope := range []types.Scope{scope1, scope2} {
+			_, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+			s.Assert().False(found, "GetScope(%s) found", scope.ScopeId)
+		}
+	})
+}
+
+func (s *MsgServerTestSuite) TestAddReleaseScopeLien() {
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, scopeSpec)
+
+	blockTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	scope := *types.NewScope(types.ScopeMetadataAddress(uuid.New()), scopeSpec.SpecificationId, ownerPartyList(s.user1), nil, s.user1, false)
+	_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+
+	lienholder := s.user2
+	lien := types.NewScopeLien(scope.ScopeId, lienholder, nil)
+	hasLien := "scope " + scope.ScopeId.String() + " has a lien held by " + lienholder
+
+	s.Run("add without owner signature", func() {
+		_, err := s.msgServer.AddScopeLien(ctx, types.NewMsgAddScopeLienRequest(lien, []string{lienholder}))
+		s.AssertErrorValue(err, "missing signature: "+s.user1+": invalid request", "AddScopeLien")
+	})
+
+	s.Run("add with past release time", func() {
+		past := blockTime.Add(-1 * time.Hour)
+		msg := types.NewMsgAddScopeLienRequest(types.NewScopeLien(scope.ScopeId, lienholder, &past), []string{s.user1})
+		_, err := s.msgServer.AddScopeLien(ctx, msg)
+		s.AssertErrorValue(err, "lien release time 2025-06-01 11:00:00 +0000 UTC must be after the current "+
+			"block time 2025-06-01 12:00:00 +0000 UTC: invalid request", "AddScopeLien")
+	})
+
+	s.Run("add", func() {
+		em := sdk.NewEventManager()
+		_, err := s.msgServer.AddScopeLien(ctx.WithEventManager(em), types.NewMsgAddScopeLienRequest(lien, []string{s.user1}))
+		s.Require().NoError(err, "AddScopeLien")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventScopeLienAdded(scope.ScopeId, lienholder)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_AddScopeLien, []string{s.user1})),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "AddScopeLien events")
+
+		actual, found := s.app.MetadataKeeper.GetScopeLien(ctx, scope.ScopeId)
+		s.Assert().True(found, "GetScopeLien found")
+		s.Assert().Equal(lien, actual, "GetScopeLien")
+	})
+
+	s.Run("add second lien", func() {
+		msg := types.NewMsgAddScopeLienRequest(types.NewScopeLien(scope.ScopeId, s.user1, nil), []string{s.user1})
+		_, err := s.msgServer.AddScopeLien(ctx, msg)
+		s.AssertErrorValue(err, hasLien+": invalid request", "AddScopeLien")
+	})
+
+	s.Run("blocked while in effect", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := s.msgServer.DeleteScope(cacheCtx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1}))
+		s.AssertErrorValue(err, hasLien+": invalid request", "DeleteScope")
+
+		newOwner := types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER}
+		_, err = s.msgServer.AddScopeOwner(cacheCtx, types.NewMsgAddScopeOwnerRequest(scope.ScopeId, []types.Party{newOwner}, []string{s.user1}))
+		s.AssertErrorValue(err, hasLien+": invalid request", "AddScopeOwner")
+
+		newVOScope := scope
+		newVOScope.ValueOwnerAddress = s.user2
+		_, err = s.msgServer.WriteScope(cacheCtx, types.NewMsgWriteScopeRequest(newVOScope, []string{s.user1}, 0))
+		s.AssertErrorValue(err, hasLien+": invalid request", "WriteScope new value owner")
+
+		_, err = s.msgServer.UpdateValueOwners(cacheCtx, types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scope.ScopeId}, s.user2Addr, []string{s.user1}))
+		s.AssertErrorValue(err, hasLien+": invalid request", "UpdateValueOwners")
+
+		_, err = s.msgServer.MigrateValueOwner(cacheCtx, types.NewMsgMigrateValueOwnerRequest(s.user1Addr, s.user2Addr, []string{s.user1}))
+		s.AssertErrorValue(err, hasLien+": invalid request", "MigrateValueOwner")
+
+		daScope := scope
+		daScope.DataAccess = []string{s.user2}
+		_, err = s.msgServer.WriteScope(cacheCtx, types.NewMsgWriteScopeRequest(daScope, []string{s.user1}, 0))
+		s.Assert().NoError(err, "WriteScope new data access")
+
+		// A failed send still changes state (that a tx would roll back), so it gets its own cache context.
+		sendCtx, _ := ctx.CacheContext()
+		err = s.app.BankKeeper.SendCoins(sendCtx, s.user1Addr, s.user2Addr, sdk.NewCoins(scope.ScopeId.Coin()))
+		s.AssertErrorValue(err, "cannot send "+scope.ScopeId.Denom()+": "+hasLien, "SendCoins")
+	})
+
+	s.Run("expired", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		releaseTime := blockTime.Add(time.Hour)
+		s.Require().NoError(s.app.MetadataKeeper.SetScopeLien(cacheCtx, types.NewScopeLien(scope.ScopeId, lienholder, &releaseTime)), "SetScopeLien")
+		cacheCtx = cacheCtx.WithBlockTime(releaseTime)
+		_, err := s.msgServer.UpdateValueOwners(cacheCtx, types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scope.ScopeId}, s.user2Addr, []string{s.user1}))
+		s.Assert().NoError(err, "UpdateValueOwners after release time")
+	})
+
+	s.Run("release without lienholder signature", func() {
+		_, err := s.msgServer.ReleaseScopeLien(ctx, types.NewMsgReleaseScopeLienRequest(scope.ScopeId, []string{s.user1}))
+		s.AssertErrorValue(err, "missing signature: "+lienholder+": invalid request", "ReleaseScopeLien")
+	})
+
+	s.Run("release", func() {
+		em := sdk.NewEventManager()
+		_, err := s.msgServer.ReleaseScopeLien(ctx.WithEventManager(em), types.NewMsgReleaseScopeLienRequest(scope.ScopeId, []string{lienholder}))
+		s.Require().NoError(err, "ReleaseScopeLien")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventScopeLienReleased(scope.ScopeId, lienholder)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_ReleaseScopeLien, []string{lienholder})),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "ReleaseScopeLien events")
+
+		_, found := s.app.MetadataKeeper.GetScopeLien(ctx, scope.ScopeId)
+		s.Assert().False(found, "GetScopeLien found")
+	})
+
+	s.Run("release again", func() {
+		_, err := s.msgServer.ReleaseScopeLien(ctx, types.NewMsgReleaseScopeLienRequest(scope.ScopeId, []string{lienholder}))
+		s.AssertErrorValue(err, "scope "+scope.ScopeId.String()+" does not have a lien: invalid request", "ReleaseScopeLien")
+	})
+
+	s.Run("delete after release", func() {
+		_, err := s.msgServer.DeleteScope(ctx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1}))
+		s.Assert().NoError(err, "DeleteScope")
+	})
+}
+
+func (s *MsgServerTestSuite) TestFractionalizeScopeValue() {
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, scopeSpec)
+
+	ctx := s.ctx
+	scope := *types.NewScope(types.ScopeMetadataAddress(uuid.New()), scopeSpec.SpecificationId, ownerPartyList(s.user1), nil, s.user1, false)
+	_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+
+	user3Addr := s.setNamedUserAccount("user3")
+	user3 := user3Addr.String()
+	denom := scope.ScopeId.Denom()
+	units := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	assertBalances := func(msg string, user1Amt, user2Amt, user3Amt int64) {
+		s.T().Helper()
+		s.Assert().Equal(sdk.NewInt64Coin(denom, user1Amt), s.app.BankKeeper.GetBalance(ctx, s.user1Addr, denom), "%s: user1 balance", msg)
+		s.Assert().Equal(sdk.NewInt64Coin(denom, user2Amt), s.app.BankKeeper.GetBalance(ctx, s.user2Addr, denom), "%s: user2 balance", msg)
+		s.Assert().Equal(sdk.NewInt64Coin(denom, user3Amt), s.app.BankKeeper.GetBalance(ctx, user3Addr, denom), "%s: user3 balance", msg)
+	}
+
+	s.Run("unknown scope", func() {
+		unknownID := types.ScopeMetadataAddress(uuid.New())
+		_, err := s.msgServer.FractionalizeScopeValue(ctx, types.NewMsgFractionalizeScopeValueRequest(unknownID, 100, []string{s.user1}))
+		s.AssertErrorValue(err, "scope not found with id "+unknownID.String()+": invalid request", "FractionalizeScopeValue")
+	})
+
+	s.Run("without value owner signature", func() {
+		_, err := s.msgServer.FractionalizeScopeValue(ctx, types.NewMsgFractionalizeScopeValueRequest(scope.ScopeId, 100, []string{s.user2}))
+		s.AssertErrorValue(err, fmt.Sprintf("missing signature from existing value owner %q: invalid request", s.user1), "FractionalizeScopeValue")
+	})
+
+	usdNav := types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 1)
+	s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(ctx, scope.ScopeId, usdNav, "test"), "SetNetAssetValue before split")
+
+	s.Run("split", func() {
+		em := sdk.NewEventManager()
+		_, err := s.msgServer.FractionalizeScopeValue(ctx.WithEventManager(em), types.NewMsgFractionalizeScopeValueRequest(scope.ScopeId, 100, []string{s.user1}))
+		s.Require().NoError(err, "FractionalizeScopeValue")
+
+		events := em.Events()
+		s.Assert().Contains(events, s.untypeEvent(types.NewEventScopeValueFractionalized(scope.ScopeId, s.user1, 100)), "FractionalizeScopeValue events")
+		s.Assert().Contains(events, s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_FractionalizeScopeValue, []string{s.user1})), "FractionalizeScopeValue events")
+
+		actual, found := s.app.MetadataKeeper.GetScopeValueUnits(ctx, scope.ScopeId)
+		s.Assert().True(found, "GetScopeValueUnits found")
+		s.Assert().Equal(types.NewScopeValueUnits(scope.ScopeId, 100), actual, "GetScopeValueUnits")
+		assertBalances("after split", 100, 0, 0)
+
+		// The nav set before the split is still for the whole scope, which is now all of the units.
+		nav, err := s.app.MetadataKeeper.GetNetAssetValue(ctx, denom, "usd")
+		s.Require().NoError(err, "GetNetAssetValue after split")
+		s.Require().NotNil(nav, "GetNetAssetValue after split")
+		s.Assert().Equal("1000usd", nav.Price.String(), "nav price after split")
+		s.Assert().Equal(100, int(nav.Volume), "nav volume after split")
+	})
+
+	s.Run("nav without volume after split", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		s.Require().NoError(s.app.MetadataKeeper.SetNetAssetValue(cacheCtx, scope.ScopeId, types.NewNetAssetValue(sdk.NewInt64Coin("usd", 2000), 0), "test"), "SetNetAssetValue")
+		nav, err := s.app.MetadataKeeper.GetNetAssetValue(cacheCtx, denom, "usd")
+		s.Require().NoError(err, "GetNetAssetValue")
+		s.Require().NotNil(nav, "GetNetAssetValue")
+		s.Assert().Equal(100, int(nav.Volume), "nav volume")
+	})
+
+	s.Run("split again", func() {
+		_, err := s.msgServer.FractionalizeScopeValue(ctx, types.NewMsgFractionalizeScopeValueRequest(scope.ScopeId, 50, []string{s.user1}))
+		s.AssertErrorValue(err, "scope "+scope.ScopeId.String()+" value is already split into 100 units: invalid request", "FractionalizeScopeValue")
+	})
+
+	s.Run("send units", func() {
+		s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, s.user1Addr, s.user2Addr, units(30)), "SendCoins")
+		assertBalances("after send", 70, 30, 0)
+
+		vo, err := s.app.MetadataKeeper.GetScopeValueOwner(ctx, scope.ScopeId)
+		s.Assert().EqualError(err, "scope "+scope.ScopeId.String()+" value is split among 2 holders", "GetScopeValueOwner with multiple holders")
+		s.Assert().Empty(vo, "GetScopeValueOwner with multiple holders")
+	})
+
+	s.Run("update value owners with multiple holders", func() {
+		_, err := s.msgServer.UpdateValueOwners(ctx, types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scope.ScopeId}, user3Addr, []string{s.user1, s.user2}))
+		s.AssertErrorValue(err, "scope "+scope.ScopeId.String()+" value is split among 2 holders: invalid request", "UpdateValueOwners")
+		assertBalances("after failed update", 70, 30, 0)
+	})
+
+	s.Run("write value owner with multiple holders", func() {
+		newVOScope := scope
+		newVOScope.ValueOwnerAddress = user3
+		_, err := s.msgServer.WriteScope(ctx, types.NewMsgWriteScopeRequest(newVOScope, []string{s.user1, s.user2}, 0))
+		s.AssertErrorValue(err, "error identifying current value owner of \""+scope.ScopeId.String()+"\": scope "+scope.ScopeId.String()+" value is split among 2 holders: invalid request", "WriteScope")
+		assertBalances("after failed write", 70, 30, 0)
+	})
+
+	s.Run("set value owner with multiple holders", func() {
+		err := s.app.MetadataKeeper.SetScopeValueOwner(ctx, scope.ScopeId, user3)
+		s.Assert().EqualError(err, "cannot change the value owner of scope "+scope.ScopeId.String()+": its value is split among 2 holders", "SetScopeValueOwner")
+		assertBalances("after failed set", 70, 30, 0)
+	})
+
+	s.Run("migrate one holder", func() {
+		_, err := s.msgServer.MigrateValueOwner(ctx, types.NewMsgMigrateValueOwnerRequest(s.user2Addr, user3Addr, []string{s.user2}))
+		s.Require().NoError(err, "MigrateValueOwner")
+		assertBalances("after migrate", 70, 0, 30)
+	})
+
+	s.Run("delete without all holders", func() {
+		_, err := s.msgServer.DeleteScope(ctx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1}))
+		s.AssertErrorValue(err, fmt.Sprintf("missing signature from existing value owner %q: invalid request", user3), "DeleteScope")
+	})
+
+	s.Run("write value owner", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		s.Require().NoError(s.app.BankKeeper.SendCoins(cacheCtx, user3Addr, s.user1Addr, units(30)), "SendCoins")
+		newVOScope := scope
+		newVOScope.ValueOwnerAddress = s.user2
+		_, err := s.msgServer.WriteScope(cacheCtx, types.NewMsgWriteScopeRequest(newVOScope, []string{s.user1}, 0))
+		s.Require().NoError(err, "WriteScope new value owner")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(cacheCtx, s.user2Addr, denom), "user2 balance")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 0), s.app.BankKeeper.GetBalance(cacheCtx, s.user1Addr, denom), "user1 balance")
+	})
+
+	s.Run("delete", func() {
+		_, err := s.msgServer.DeleteScope(ctx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1, user3}))
+		s.Require().NoError(err, "DeleteScope")
+		assertBalances("after delete", 0, 0, 0)
+		s.Assert().True(s.app.BankKeeper.GetSupply(ctx, denom).IsZero(), "supply after delete")
+
+		_, found := s.app.MetadataKeeper.GetScopeValueUnits(ctx, scope.ScopeId)
+		s.Assert().False(found, "GetScopeValueUnits found")
+	})
+}
+
+func (s *MsgServerTestSuite) TestWriteDeleteRecords() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, scopeSpec)
+	newRecordSpec := func(name string) types.RecordSpecification {
+		rSpec := types.RecordSpecification{
+			SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, name),
+			Name:               name,
+			TypeName:           "string",
+			ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+			ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		}
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, rSpec)
+		return rSpec
+	}
+	rSpec1 := newRecordSpec("record1")
+	rSpec2 := newRecordSpec("record2")
+
+	scopeUUID := uuid.New()
+	scope := *types.NewScope(types.ScopeMetadataAddress(scopeUUID), scopeSpec.SpecificationId, ownerPartyList(s.user1), nil, s.user1, false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope")
+	session := *types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+	s.app.MetadataKeeper.SetSession(s.ctx, session)
+
+	newRecord := func(name string, specID types.MetadataAddress) types.Record {
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		output := types.NewRecordOutput("rout", types.ResultStatus_RESULT_STATUS_PASS)
+		return *types.NewRecord(name, session.SessionId, *process, nil, []types.RecordOutput{*output}, specID)
+	}
+	record1 := newRecord(rSpec1.Name, rSpec1.SpecificationId)
+	record2 := newRecord(rSpec2.Name, rSpec2.SpecificationId)
+	unknownSpecRecord := newRecord("record3", types.RecordSpecMetadataAddress(cSpecUUID, "record3"))
+	recordID1 := record1.GetRecordAddress()
+	recordID2 := record2.GetRecordAddress()
+	signers := []string{s.user1}
+
+	s.Run("write with failures", func() {
+		ctx, _ := s.ctx.CacheContext()
+		msg := types.NewMsgWriteRecordsRequest([]types.Record{record1, unknownSpecRecord, record2}, signers)
+		_, err := s.msgServer.WriteRecords(ctx, msg)
+		expErr := "records[1] " + unknownSpecRecord.GetRecordAddress().String() + ": record specification not found for " +
+			"record specification id " + unknownSpecRecord.SpecificationId.String() + " (contract spec id " +
+			cSpec.SpecificationId.String() + " and record name \"record3\")" +
+			": invalid request"
+		s.Assert().EqualError(err, expErr, "WriteRecords")
+	})
+
+	s.Run("write", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		resp, err := s.msgServer.WriteRecords(ctx, types.NewMsgWriteRecordsRequest([]types.Record{record1, record2}, signers))
+		s.Require().NoError(err, "WriteRecords")
+		s.Assert().Equal(types.NewMsgWriteRecordsResponse([]types.MetadataAddress{recordID1, recordID2}), resp, "WriteRecords response")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventRecordCreated(recordID1, session.SessionId)),
+			s.untypeEvent(types.NewEventRecordCreated(recordID2, session.SessionId)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_WriteRecords, signers)),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "WriteRecords events")
+
+		for _, recordID := range []types.MetadataAddress{recordID1, recordID2} {
+			_, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+			s.Assert().True(found, "GetRecord(%s) found", recordID)
+		}
+	})
+
+	s.Run("delete with failures", func() {
+		ctx, _ := s.ctx.CacheContext()
+		unknownRecordID := unknownSpecRecord.GetRecordAddress()
+		msg := types.NewMsgDeleteRecordsRequest([]types.MetadataAddress{unknownRecordID, recordID1}, signers)
+		_, err := s.msgServer.DeleteRecords(ctx, msg)
+		expErr := "record id[0] " + unknownRecordID.String() + ": record does not exist to delete: " + unknownRecordID.String() +
+			": invalid request"
+		s.Assert().EqualError(err, expErr, "DeleteRecords")
+	})
+
+	s.Run("delete", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		_, err := s.msgServer.DeleteRecords(ctx, types.NewMsgDeleteRecordsRequest([]types.MetadataAddress{recordID1, recordID2}, signers))
+		s.Require().NoError(err, "DeleteRecords")
+
+		expEvents := sdk.Events{
+			s.untypeEvent(types.NewEventRecordDeleted(recordID1)),
+			s.untypeEvent(types.NewEventRecordDeleted(recordID2)),
+			s.untypeEvent(types.NewEventSessionDeleted(session.SessionId)),
+			s.untypeEvent(types.NewEventTxCompleted(types.TxEndpoint_DeleteRecords, signers)),
+		}
+		s.AssertEqualEvents(expEvents, em.Events(), "DeleteRecords events")
+
+		for _, recordID := range []types.MetadataAddress{recordID1, recordID2} {
+			_, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+			s.Assert().False(found, "GetRecord(%s) found", recordID)
+		}
+	})
+}
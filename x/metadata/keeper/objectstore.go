@@ -10,9 +10,34 @@ import (
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
-// GetOsLocatorRecord Gets the object store locator entry from the kvstore for the given owner address.
+// GetOsLocatorRecord Gets the primary object store locator entry from the kvstore for the given owner address.
+// If none of the owner's locators are flagged as primary, the first one (by name) is returned.
 func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (osLocator types.ObjectStoreLocator, found bool) {
-	key := types.GetOSLocatorKey(ownerAddr)
+	locators, err := k.GetOSLocatorsByOwner(ctx, ownerAddr)
+	if err != nil {
+		ctx.Logger().Error("failed to unmarshal locator", "err", err)
+		return types.ObjectStoreLocator{}, false
+	}
+	if len(locators) == 0 {
+		return types.ObjectStoreLocator{}, false
+	}
+	return getPrimaryOSLocator(locators), true
+}
+
+// getPrimaryOSLocator returns the locator flagged as primary, or the first one if none are flagged.
+// The provided locators should all have the same owner, and there must be at least one.
+func getPrimaryOSLocator(locators []types.ObjectStoreLocator) types.ObjectStoreLocator {
+	for _, locator := range locators {
+		if locator.Primary {
+			return locator
+		}
+	}
+	return locators[0]
+}
+
+// GetOSLocatorByName gets the object store locator entry with the given name for the given owner address.
+func (k Keeper) GetOSLocatorByName(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) (osLocator types.ObjectStoreLocator, found bool) {
+	key := types.GetOSLocatorNameKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(key)
 	if b == nil {
@@ -26,17 +51,34 @@ func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (o
 	return osLocator, true
 }
 
+// GetOSLocatorsByOwner gets all the object store locator entries of the given owner address, ordered by name.
+func (k Keeper) GetOSLocatorsByOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) ([]types.ObjectStoreLocator, error) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetOSLocatorKey(ownerAddr))
+	defer it.Close()
+
+	var locators []types.ObjectStoreLocator
+	for ; it.Valid(); it.Next() {
+		record := types.ObjectStoreLocator{}
+		if err := k.cdc.Unmarshal(it.Value(), &record); err != nil {
+			return nil, err
+		}
+		locators = append(locators, record)
+	}
+	return locators, nil
+}
+
 // OSLocatorExists checks if the provided bech32 owner address has a OSL entry in the kvstore.
 func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool {
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(key)
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetOSLocatorKey(ownerAddr))
+	defer it.Close()
+	return it.Valid()
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
 // An error is returned if no account exists for the address.
-// An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+// An error is returned if an OS Locator with the same name already exists for the address.
+// The owner's first locator is always primary. If primary is true, the owner's other locators are no longer primary.
+func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri, name string, primary bool) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
@@ -44,20 +86,52 @@ func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAd
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
+	key := types.GetOSLocatorNameKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
 		return types.ErrOSLocatorAlreadyBound
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.Name = name
+	record.Primary = primary || !k.OSLocatorExists(ctx, ownerAddr)
+	record.EncryptionKeyVersions = []types.OSLocatorEncryptionKey{
+		{Version: 1, EncryptionKey: record.EncryptionKey, ActivationHeight: ctx.BlockHeight()},
+	}
+	if record.Primary {
+		if err = k.clearOSLocatorPrimary(ctx, ownerAddr); err != nil {
+			return err
+		}
+	}
+
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
 	store.Set(key, bz)
 
-	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(record.Owner))
+	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(record.Owner, record.Name))
+	return nil
+}
+
+// clearOSLocatorPrimary makes sure none of the owner's locators are flagged as primary.
+func (k Keeper) clearOSLocatorPrimary(ctx sdk.Context, ownerAddr sdk.AccAddress) error {
+	locators, err := k.GetOSLocatorsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, locator := range locators {
+		if !locator.Primary {
+			continue
+		}
+		locator.Primary = false
+		bz, err := k.cdc.Marshal(&locator)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetOSLocatorNameKey(ownerAddr, locator.Name), bz)
+	}
 	return nil
 }
 
@@ -81,7 +155,8 @@ func (k Keeper) IterateOSLocators(ctx sdk.Context, cb func(account types.ObjectS
 	return nil
 }
 
-// GetOSLocatorByScope gets all Object Store Locators associated with a scope.
+// GetOSLocatorByScope gets the primary Object Store Locators of the owners of a scope,
+// each with the encryption key that is currently in effect.
 func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error) {
 	scopeAddr, err := ParseScopeID(scopeID)
 	if err != nil {
@@ -111,57 +186,131 @@ func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.Ob
 		if !found {
 			continue
 		}
-		locators = append(locators, loc)
+		if loc, found = loc.AtHeight(ctx.BlockHeight()); found {
+			locators = append(locators, loc)
+		}
 	}
 	return locators, nil
 }
 
 // RemoveOSLocator removes an os locator record from the kvstore.
-func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error {
-	key := types.GetOSLocatorKey(ownerAddr)
+// An owner's primary locator cannot be removed while the owner has other locators.
+func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) error {
+	key := types.GetOSLocatorNameKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	if !store.Has(key) {
 		return types.ErrAddressNotBound
 	}
+
+	locators, err := k.GetOSLocatorsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return err
+	}
+	if len(locators) > 1 && getPrimaryOSLocator(locators).Name == name {
+		return fmt.Errorf("cannot remove primary locator %q of %s while it has other locators", name, ownerAddr)
+	}
+
 	store.Delete(key)
-	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String()))
+	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String(), name))
 	return nil
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
-func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+// A new encryption key is added as a new key version that takes effect at the keyActivationHeight (or the current
+// height if that is zero). The previous key stays in effect until then, and stays the locator's encryption key.
+// If primary is true, the locator becomes the owner's primary locator. A primary locator stays primary otherwise.
+func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri, name string, primary bool, keyActivationHeight int64) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	key := types.GetOSLocatorNameKey(ownerAddr, name)
+	record, found := k.GetOSLocatorByName(ctx, ownerAddr, name)
+	if !found {
 		return types.ErrAddressNotBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.LocatorUri = urlToPersist.String()
+	newKey := encryptionKey.String()
+	switch {
+	case newKey != getLatestOSLocatorKey(record):
+		record.EncryptionKeyVersions, err = newOSLocatorKeyVersions(ctx, record, newKey, keyActivationHeight)
+		if err != nil {
+			return err
+		}
+		// A key with a future activation height isn't used until then, so keep the key that's in effect now.
+		record, _ = record.AtHeight(ctx.BlockHeight())
+	case keyActivationHeight != 0:
+		return fmt.Errorf("key activation height %d provided without a new encryption key", keyActivationHeight)
+	}
+
+	if primary && !record.Primary {
+		if err = k.clearOSLocatorPrimary(ctx, ownerAddr); err != nil {
+			return err
+		}
+		record.Primary = true
+	}
+
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
-	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner, record.Name))
 	return nil
 }
 
+// getLatestOSLocatorKey returns the newest encryption key of the provided locator, even if it isn't in effect yet.
+func getLatestOSLocatorKey(record types.ObjectStoreLocator) string {
+	if len(record.EncryptionKeyVersions) == 0 {
+		return record.EncryptionKey
+	}
+	return record.EncryptionKeyVersions[len(record.EncryptionKeyVersions)-1].EncryptionKey
+}
+
+// newOSLocatorKeyVersions returns the encryption key versions of the provided locator with a new version added for
+// the new key. A locator without any key versions had its key in effect since it was bound, so that key becomes the
+// first version.
+func newOSLocatorKeyVersions(ctx sdk.Context, record types.ObjectStoreLocator, newKey string, activationHeight int64) ([]types.OSLocatorEncryptionKey, error) {
+	height := ctx.BlockHeight()
+	if activationHeight == 0 {
+		activationHeight = height
+	}
+	if activationHeight < height {
+		return nil, fmt.Errorf("key activation height %d cannot be before the current block height %d", activationHeight, height)
+	}
+
+	versions := record.EncryptionKeyVersions
+	if len(versions) == 0 {
+		versions = []types.OSLocatorEncryptionKey{{Version: 1, EncryptionKey: record.EncryptionKey, ActivationHeight: 0}}
+	}
+	last := versions[len(versions)-1]
+	if activationHeight < last.ActivationHeight {
+		return nil, fmt.Errorf("key activation height %d cannot be before the activation height %d of encryption key version %d",
+			activationHeight, last.ActivationHeight, last.Version)
+	}
+
+	rv := make([]types.OSLocatorEncryptionKey, len(versions), len(versions)+1)
+	copy(rv, versions)
+	rv = append(rv, types.OSLocatorEncryptionKey{Version: last.Version + 1, EncryptionKey: newKey, ActivationHeight: activationHeight})
+	return rv, nil
+}
+
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	key := types.GetOSLocatorKey(ownerAddr)
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, record types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+	key := types.GetOSLocatorNameKey(ownerAddr, record.Name)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
 		return types.ErrOSLocatorAlreadyBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
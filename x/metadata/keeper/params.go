@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetParams returns the metadata Params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsPrefix)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the metadata params in the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsPrefix, k.cdc.MustMarshal(&params))
+}
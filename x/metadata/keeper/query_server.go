@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -23,9 +24,9 @@ const defaultLimit = 100
 var _ types.QueryServer = Keeper{}
 
 // Params queries params of metadata module.
-func (k Keeper) Params(_ context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Params")
-	resp := &types.QueryParamsResponse{Params: types.Params{}}
+	resp := &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(c))}
 	if req != nil && req.IncludeRequest {
 		resp.Request = req
 	}
@@ -1055,16 +1056,54 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 	if err != nil {
 		return &retval, types.ErrInvalidAddress
 	}
+	height, err := getOSLocatorQueryHeight(ctx, request.KeyHeight)
+	if err != nil {
+		return &retval, err
+	}
 
-	record, exists := k.GetOsLocatorRecord(ctx, accAddr)
+	var record types.ObjectStoreLocator
+	var exists bool
+	if len(request.Name) == 0 {
+		record, exists = k.GetOsLocatorRecord(ctx, accAddr)
+	} else {
+		record, exists = k.GetOSLocatorByName(ctx, accAddr, request.Name)
+	}
 	if !exists {
 		return &retval, types.ErrAddressNotBound
 	}
+	record, exists = record.AtHeight(height)
+	if !exists {
+		return &retval, types.ErrAddressNotBound.Wrapf("locator %q did not have an encryption key in effect at height %d", record.Name, height)
+	}
 	retval.Locator = &record
 
 	return &retval, nil
 }
 
+// getOSLocatorQueryHeight returns the height to look up object store locator encryption keys at: the requested one
+// if provided, or the current block height.
+func getOSLocatorQueryHeight(ctx sdk.Context, height int64) (int64, error) {
+	if height < 0 {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("height %d cannot be negative", height)
+	}
+	if height == 0 {
+		return ctx.BlockHeight(), nil
+	}
+	return height, nil
+}
+
+// osLocatorsAtHeight returns each of the provided locators with the encryption key in effect at the provided height,
+// leaving out any that didn't have one in effect yet.
+func osLocatorsAtHeight(locators []types.ObjectStoreLocator, height int64) []types.ObjectStoreLocator {
+	var rv []types.ObjectStoreLocator
+	for _, locator := range locators {
+		if atHeight, inEffect := locator.AtHeight(height); inEffect {
+			rv = append(rv, atHeight)
+		}
+	}
+	return rv
+}
+
 func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsByURIRequest) (*types.OSLocatorsByURIResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "OSLocatorsByURI")
 	retval := types.OSLocatorsByURIResponse{}
@@ -1087,6 +1126,7 @@ func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsBy
 		return &retval, err
 	}
 	uriStr := uri.String()
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 
 	osLocatorStore := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.OSLocatorAddressKeyPrefix)
 	retval.Pagination, err = query.FilteredPaginate(osLocatorStore, request.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
@@ -1094,7 +1134,8 @@ func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsBy
 		if rerr := k.cdc.Unmarshal(value, &record); rerr != nil {
 			return false, rerr
 		}
-		if record.LocatorUri != uriStr {
+		record, inEffect := record.AtHeight(height)
+		if record.LocatorUri != uriStr || !inEffect {
 			return false, nil
 		}
 		if accumulate {
@@ -1146,6 +1187,7 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 		retval.Request = request
 	}
 
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 	osLocatorStore := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.OSLocatorAddressKeyPrefix)
 	var err error
 	retval.Pagination, err = query.Paginate(osLocatorStore, request.Pagination, func(_ []byte, value []byte) error {
@@ -1153,7 +1195,9 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 		if rerr := k.cdc.Unmarshal(value, &record); rerr != nil {
 			return rerr
 		}
-		retval.Locators = append(retval.Locators, record)
+		if atHeight, inEffect := record.AtHeight(height); inEffect {
+			retval.Locators = append(retval.Locators, atHeight)
+		}
 		return nil
 	})
 	if err != nil {
@@ -1163,6 +1207,36 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 	return &retval, nil
 }
 
+func (k Keeper) OSLocatorsByOwner(c context.Context, request *types.OSLocatorsByOwnerRequest) (*types.OSLocatorsByOwnerResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "OSLocatorsByOwner")
+	if request == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.OSLocatorsByOwnerResponse{}
+	if request.IncludeRequest {
+		retval.Request = request
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	accAddr, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return &retval, types.ErrInvalidAddress
+	}
+	height, err := getOSLocatorQueryHeight(ctx, request.KeyHeight)
+	if err != nil {
+		return &retval, err
+	}
+
+	locators, err := k.GetOSLocatorsByOwner(ctx, accAddr)
+	if err != nil {
+		return &retval, err
+	}
+	retval.Locators = osLocatorsAtHeight(locators, height)
+
+	return &retval, nil
+}
+
 func (k Keeper) AccountData(c context.Context, req *types.AccountDataRequest) (*types.AccountDataResponse, error) {
 	if req == nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
@@ -1355,6 +1429,392 @@ func (k Keeper) ScopeNetAssetValues(c context.Context, req *types.QueryScopeNetA
 	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// ScopeHistory returns the change log of a scope.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeHistoryResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeID, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeHistoryKeyPrefix(scopeID))
+	retval.Pagination, err = query.Paginate(historyStore, getPageRequest(req), func(_ []byte, value []byte) error {
+		var change types.ScopeChange
+		if vErr := k.cdc.Unmarshal(value, &change); vErr != nil {
+			return vErr
+		}
+		retval.Changes = append(retval.Changes, change)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopeChildren returns the ids of the scopes that have the given scope as their parent.
+func (k Keeper) ScopeChildren(c context.Context, req *types.ScopeChildrenRequest) (*types.ScopeChildrenResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeChildren")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeChildrenResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeID, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	childStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScopeChildCacheIteratorPrefix(scopeID))
+	retval.Pagination, err = query.Paginate(childStore, getPageRequest(req), func(key, _ []byte) error {
+		var childID types.MetadataAddress
+		if mErr := childID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		retval.ScopeIds = append(retval.ScopeIds, childID.String())
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopeAncestors returns the ids of a scope's parent, its parent's parent, and so on up to the root scope.
+func (k Keeper) ScopeAncestors(c context.Context, req *types.ScopeAncestorsRequest) (*types.ScopeAncestorsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeAncestors")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeAncestorsResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeID, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ancestors, err := k.GetScopeAncestors(ctx, scopeID)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	for _, ancestor := range ancestors {
+		retval.ScopeIds = append(retval.ScopeIds, ancestor.String())
+	}
+
+	return &retval, nil
+}
+
+// ScopesBySpecification returns the ids of the scopes that use a scope specification.
+func (k Keeper) ScopesBySpecification(c context.Context, req *types.ScopesBySpecificationRequest) (*types.ScopesBySpecificationResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopesBySpecification")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopesBySpecificationResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty specification id")
+	}
+	specID, err := ParseScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.ScopeIds, retval.Pagination, err = k.paginateScopeIndex(ctx, types.GetScopeSpecScopeCacheIteratorPrefix(specID), getPageRequest(req), nil)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopesByPartyRole returns the ids of the scopes that have the given address as a party with the given role.
+func (k Keeper) ScopesByPartyRole(c context.Context, req *types.ScopesByPartyRoleRequest) (*types.ScopesByPartyRoleResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopesByPartyRole")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopesByPartyRoleResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Address) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid address: %v", err)
+	}
+	if !req.Role.IsValid() || req.Role == types.PartyType_PARTY_TYPE_UNSPECIFIED {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid party role: %s", req.Role)
+	}
+	specID, err := parseOptionalScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.ScopeIds, retval.Pagination, err = k.paginateScopeIndex(ctx, types.GetAddressRoleScopeCacheIteratorPrefix(addr, req.Role), getPageRequest(req), specID)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopesByDataAccess returns the ids of the scopes that have the given address in their data access list.
+func (k Keeper) ScopesByDataAccess(c context.Context, req *types.ScopesByDataAccessRequest) (*types.ScopesByDataAccessResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopesByDataAccess")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopesByDataAccessResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Address) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid address: %v", err)
+	}
+	specID, err := parseOptionalScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.ScopeIds, retval.Pagination, err = k.paginateScopeIndex(ctx, types.GetDataAccessScopeCacheIteratorPrefix(addr), getPageRequest(req), specID)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// RecordsByOutputHash returns the ids of the records (and their scopes) that have an output with the given hash.
+func (k Keeper) RecordsByOutputHash(c context.Context, req *types.RecordsByOutputHashRequest) (*types.RecordsByOutputHashResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordsByOutputHash")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordsByOutputHashResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Hash) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty hash")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordOutputHashCacheIteratorPrefix(req.Hash))
+	var err error
+	retval.Pagination, err = query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var recordID types.MetadataAddress
+		if err := recordID.Unmarshal(key); err != nil {
+			return err
+		}
+		scopeID, err := recordID.AsScopeAddress()
+		if err != nil {
+			return err
+		}
+		retval.Records = append(retval.Records, types.OutputHashRecord{
+			RecordId: recordID.String(),
+			ScopeId:  scopeID.String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopeLien returns the lien on a scope.
+func (k Keeper) ScopeLien(c context.Context, req *types.ScopeLienRequest) (*types.ScopeLienResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeLien")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeLienResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if lien, found := k.GetScopeLien(ctx, scopeAddr); found {
+		retval.Lien = &lien
+		retval.InEffect = lien.InEffect(ctx.BlockTime())
+	}
+
+	return &retval, nil
+}
+
+// ScopeLiensByLienholder returns the liens held by an address.
+func (k Keeper) ScopeLiensByLienholder(c context.Context, req *types.ScopeLiensByLienholderRequest) (*types.ScopeLiensByLienholderResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeLiensByLienholder")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeLiensByLienholderResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Lienholder) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("lienholder cannot be empty")
+	}
+	lienholder, err := sdk.AccAddressFromBech32(req.Lienholder)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid lienholder: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetLienholderScopeCacheIteratorPrefix(lienholder))
+	retval.Pagination, err = query.Paginate(store, getPageRequest(req), func(key, _ []byte) error {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(key); err != nil {
+			return err
+		}
+		lien, found := k.GetScopeLien(ctx, scopeID)
+		if !found {
+			return fmt.Errorf("lien not found for scope %s", scopeID)
+		}
+		retval.Liens = append(retval.Liens, lien)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopeValueHolders returns the accounts that hold the value of a scope, and how many value units each one holds.
+func (k Keeper) ScopeValueHolders(c context.Context, req *types.ScopeValueHoldersRequest) (*types.ScopeValueHoldersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeValueHolders")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeValueHoldersResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval.TotalUnits = k.GetScopeTotalValueUnits(ctx, scopeAddr)
+	owners, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      scopeAddr.Denom(),
+		Pagination: getPageRequest(req),
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	for _, owner := range owners.DenomOwners {
+		retval.Holders = append(retval.Holders, types.NewScopeValueHolder(owner.Address, owner.Balance.Amount.Uint64()))
+	}
+	retval.Pagination = owners.Pagination
+
+	return &retval, nil
+}
+
+// parseOptionalScopeSpecID parses the provided scope spec id, returning nil if it's empty.
+func parseOptionalScopeSpecID(specID string) (types.MetadataAddress, error) {
+	if len(specID) == 0 {
+		return nil, nil
+	}
+	return ParseScopeSpecID(specID)
+}
+
+// paginateScopeIndex gets a page of the bech32 scope ids in the scope index entries with the given prefix.
+// If a specID is provided, only scopes that use that scope specification are included.
+func (k Keeper) paginateScopeIndex(
+	ctx sdk.Context,
+	indexPrefix []byte,
+	pageReq *query.PageRequest,
+	specID types.MetadataAddress,
+) ([]string, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	var scopeIDs []string
+	pageRes, err := query.FilteredPaginate(prefix.NewStore(store, indexPrefix), pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(key); err != nil {
+			return false, err
+		}
+		if len(specID) > 0 && !store.Has(types.GetScopeSpecScopeCacheKey(specID, scopeID)) {
+			return false, nil
+		}
+		if accumulate {
+			scopeIDs = append(scopeIDs, scopeID.String())
+		}
+		return true, nil
+	})
+	return scopeIDs, pageRes, err
+}
+
 // hasPageRequest is just for use with the getPageRequest func below.
 type hasPageRequest interface {
 	GetPagination() *query.PageRequest
@@ -2281,6 +2281,475 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 	}
 }
 
+func (s *QueryServerTestSuite) TestScopeIndexQueries() {
+	kpr, ctx, queryClient := s.app.MetadataKeeper, s.ctx, s.queryClient
+	specID1 := types.ScopeSpecMetadataAddress(uuid.New())
+	specID2 := types.ScopeSpecMetadataAddress(uuid.New())
+	specIDNF := types.ScopeSpecMetadataAddress(uuid.New())
+
+	scopeIDs := make([]types.MetadataAddress, 3)
+	for i := range scopeIDs {
+		scopeIDs[i] = types.ScopeMetadataAddress(newTestUUID(i + 1))
+	}
+	owner := func(addr string, role types.PartyType) types.Party {
+		return types.Party{Address: addr, Role: role}
+	}
+	scopes := []*types.Scope{
+		types.NewScope(scopeIDs[0], specID1,
+			[]types.Party{owner(s.user1, types.PartyType_PARTY_TYPE_OWNER), owner(s.user2, types.PartyType_PARTY_TYPE_SERVICER)},
+			[]string{s.user2}, "", false),
+		types.NewScope(scopeIDs[1], specID1,
+			[]types.Party{owner(s.user1, types.PartyType_PARTY_TYPE_SERVICER)},
+			[]string{s.user1, s.user2}, "", false),
+		types.NewScope(scopeIDs[2], specID2,
+			[]types.Party{owner(s.user1, types.PartyType_PARTY_TYPE_OWNER), owner(s.user1, types.PartyType_PARTY_TYPE_SERVICER)},
+			[]string{s.user1}, "", false),
+	}
+	for i, scope := range scopes {
+		s.Require().NoError(kpr.SetScope(ctx, *scope), "[%d]: SetScope", i)
+	}
+
+	badSpecErr := fmt.Sprintf("address [%s] is not a scope spec address: invalid request", s.scopeID)
+
+	// strs converts the given scope indexes into the scope ids expected in a query response.
+	strs := func(idxs ...int) []string {
+		var rv []string
+		for _, i := range idxs {
+			rv = append(rv, scopeIDs[i].String())
+		}
+		return rv
+	}
+
+	s.Run("ScopesBySpecification", func() {
+		tests := []struct {
+			name   string
+			req    *types.ScopesBySpecificationRequest
+			expErr string
+			expIDs []string
+		}{
+			{name: "nil request", req: nil, expErr: "empty request: invalid request"},
+			{name: "empty spec id", req: &types.ScopesBySpecificationRequest{}, expErr: "empty specification id: invalid request"},
+			{
+				name:   "invalid spec id",
+				req:    &types.ScopesBySpecificationRequest{SpecificationId: s.scopeID.String()},
+				expErr: badSpecErr,
+			},
+			{name: "spec 1", req: &types.ScopesBySpecificationRequest{SpecificationId: specID1.String()}, expIDs: strs(0, 1)},
+			{name: "spec 2", req: &types.ScopesBySpecificationRequest{SpecificationId: specID2.String()}, expIDs: strs(2)},
+			{name: "unused spec", req: &types.ScopesBySpecificationRequest{SpecificationId: specIDNF.String()}},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				resp, err := kpr.ScopesBySpecification(ctx, tc.req)
+				s.AssertErrorValue(err, tc.expErr, "ScopesBySpecification error")
+				if len(tc.expErr) == 0 {
+					s.Require().NotNil(resp, "ScopesBySpecification response")
+					s.Assert().ElementsMatch(tc.expIDs, resp.ScopeIds, "ScopesBySpecification ScopeIds")
+				}
+			})
+		}
+	})
+
+	s.Run("ScopesByPartyRole", func() {
+		tests := []struct {
+			name   string
+			req    *types.ScopesByPartyRoleRequest
+			expErr string
+			expIDs []string
+		}{
+			{name: "nil request", req: nil, expErr: "empty request: invalid request"},
+			{
+				name:   "empty address",
+				req:    &types.ScopesByPartyRoleRequest{Role: types.PartyType_PARTY_TYPE_OWNER},
+				expErr: "address cannot be empty: invalid request",
+			},
+			{
+				name:   "invalid address",
+				req:    &types.ScopesByPartyRoleRequest{Address: "notanaddress", Role: types.PartyType_PARTY_TYPE_OWNER},
+				expErr: "invalid address: decoding bech32 failed: invalid separator index -1: invalid request",
+			},
+			{
+				name:   "unspecified role",
+				req:    &types.ScopesByPartyRoleRequest{Address: s.user1},
+				expErr: "invalid party role: PARTY_TYPE_UNSPECIFIED: invalid request",
+			},
+			{
+				name: "invalid spec id",
+				req: &types.ScopesByPartyRoleRequest{
+					Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER, SpecificationId: s.scopeID.String(),
+				},
+				expErr: badSpecErr,
+			},
+			{
+				name:   "user1 owner",
+				req:    &types.ScopesByPartyRoleRequest{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+				expIDs: strs(0, 2),
+			},
+			{
+				name:   "user1 servicer",
+				req:    &types.ScopesByPartyRoleRequest{Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER},
+				expIDs: strs(1, 2),
+			},
+			{
+				name: "user1 servicer spec 1",
+				req: &types.ScopesByPartyRoleRequest{
+					Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER, SpecificationId: specID1.String(),
+				},
+				expIDs: strs(1),
+			},
+			{
+				name: "user1 owner unused spec",
+				req: &types.ScopesByPartyRoleRequest{
+					Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER, SpecificationId: specIDNF.String(),
+				},
+			},
+			{
+				name:   "user2 servicer",
+				req:    &types.ScopesByPartyRoleRequest{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER},
+				expIDs: strs(0),
+			},
+			{
+				name: "user2 owner",
+				req:  &types.ScopesByPartyRoleRequest{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				resp, err := kpr.ScopesByPartyRole(ctx, tc.req)
+				s.AssertErrorValue(err, tc.expErr, "ScopesByPartyRole error")
+				if len(tc.expErr) == 0 {
+					s.Require().NotNil(resp, "ScopesByPartyRole response")
+					s.Assert().ElementsMatch(tc.expIDs, resp.ScopeIds, "ScopesByPartyRole ScopeIds")
+				}
+			})
+		}
+	})
+
+	s.Run("ScopesByDataAccess", func() {
+		tests := []struct {
+			name   string
+			req    *types.ScopesByDataAccessRequest
+			expErr string
+			expIDs []string
+		}{
+			{name: "nil request", req: nil, expErr: "empty request: invalid request"},
+			{name: "empty address", req: &types.ScopesByDataAccessRequest{}, expErr: "address cannot be empty: invalid request"},
+			{
+				name:   "invalid address",
+				req:    &types.ScopesByDataAccessRequest{Address: "notanaddress"},
+				expErr: "invalid address: decoding bech32 failed: invalid separator index -1: invalid request",
+			},
+			{
+				name:   "invalid spec id",
+				req:    &types.ScopesByDataAccessRequest{Address: s.user1, SpecificationId: s.scopeID.String()},
+				expErr: badSpecErr,
+			},
+			{name: "user1", req: &types.ScopesByDataAccessRequest{Address: s.user1}, expIDs: strs(1, 2)},
+			{name: "user2", req: &types.ScopesByDataAccessRequest{Address: s.user2}, expIDs: strs(0, 1)},
+			{
+				name:   "user1 spec 2",
+				req:    &types.ScopesByDataAccessRequest{Address: s.user1, SpecificationId: specID2.String()},
+				expIDs: strs(2),
+			},
+			{
+				name: "user2 spec 2",
+				req:  &types.ScopesByDataAccessRequest{Address: s.user2, SpecificationId: specID2.String()},
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				resp, err := kpr.ScopesByDataAccess(ctx, tc.req)
+				s.AssertErrorValue(err, tc.expErr, "ScopesByDataAccess error")
+				if len(tc.expErr) == 0 {
+					s.Require().NotNil(resp, "ScopesByDataAccess response")
+					s.Assert().ElementsMatch(tc.expIDs, resp.ScopeIds, "ScopesByDataAccess ScopeIds")
+				}
+			})
+		}
+	})
+
+	s.Run("pagination", func() {
+		pageReq := &query.PageRequest{Limit: 1}
+		var actIDs []string
+		for i := 0; i < 3; i++ {
+			resp, err := queryClient.ScopesByPartyRole(gocontext.Background(), &types.ScopesByPartyRoleRequest{
+				Address:    s.user1,
+				Role:       types.PartyType_PARTY_TYPE_SERVICER,
+				Pagination: pageReq,
+			})
+			s.Require().NoError(err, "[%d]: ScopesByPartyRole", i)
+			actIDs = append(actIDs, resp.ScopeIds...)
+			if len(resp.Pagination.NextKey) == 0 {
+				break
+			}
+			pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+		}
+		s.Assert().ElementsMatch(strs(1, 2), actIDs, "ScopeIds from all pages")
+	})
+
+	s.Run("indexes updated with scope", func() {
+		updated := *scopes[2]
+		updated.Owners = []types.Party{owner(s.user2, types.PartyType_PARTY_TYPE_OWNER)}
+		updated.DataAccess = []string{s.user2}
+		s.Require().NoError(kpr.SetScope(ctx, updated), "SetScope updated scope 2")
+		s.Require().NoError(kpr.RemoveScope(ctx, scopeIDs[1]), "RemoveScope scope 1")
+
+		roleResp, err := kpr.ScopesByPartyRole(ctx, &types.ScopesByPartyRoleRequest{
+			Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER,
+		})
+		s.Require().NoError(err, "ScopesByPartyRole user1 servicer")
+		s.Assert().Empty(roleResp.ScopeIds, "ScopesByPartyRole user1 servicer ScopeIds")
+
+		roleResp, err = kpr.ScopesByPartyRole(ctx, &types.ScopesByPartyRoleRequest{
+			Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER,
+		})
+		s.Require().NoError(err, "ScopesByPartyRole user2 owner")
+		s.Assert().ElementsMatch(strs(2), roleResp.ScopeIds, "ScopesByPartyRole user2 owner ScopeIds")
+
+		daResp, err := kpr.ScopesByDataAccess(ctx, &types.ScopesByDataAccessRequest{Address: s.user1})
+		s.Require().NoError(err, "ScopesByDataAccess user1")
+		s.Assert().Empty(daResp.ScopeIds, "ScopesByDataAccess user1 ScopeIds")
+
+		daResp, err = kpr.ScopesByDataAccess(ctx, &types.ScopesByDataAccessRequest{Address: s.user2})
+		s.Require().NoError(err, "ScopesByDataAccess user2")
+		s.Assert().ElementsMatch(strs(0, 2), daResp.ScopeIds, "ScopesByDataAccess user2 ScopeIds")
+
+		specResp, err := kpr.ScopesBySpecification(ctx, &types.ScopesBySpecificationRequest{SpecificationId: specID1.String()})
+		s.Require().NoError(err, "ScopesBySpecification spec 1")
+		s.Assert().ElementsMatch(strs(0), specResp.ScopeIds, "ScopesBySpecification spec 1 ScopeIds")
+	})
+}
+
+func (s *QueryServerTestSuite) TestRecordsByOutputHashQuery() {
+	kpr, ctx, queryClient := s.app.MetadataKeeper, s.ctx, s.queryClient
+	scopeID1 := types.ScopeMetadataAddress(newTestUUID(1))
+	scopeID2 := types.ScopeMetadataAddress(newTestUUID(2))
+	sessionID1 := types.SessionMetadataAddress(newTestUUID(1), newTestUUID(11))
+	sessionID2 := types.SessionMetadataAddress(newTestUUID(2), newTestUUID(22))
+
+	newRecord := func(sessionID types.MetadataAddress, name string, hashes ...string) types.Record {
+		outputs := make([]types.RecordOutput, len(hashes))
+		for i, hash := range hashes {
+			outputs[i] = types.RecordOutput{Hash: hash, Status: types.ResultStatus_RESULT_STATUS_PASS}
+		}
+		return *types.NewRecord(name, sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "method"),
+			nil, outputs, nil)
+	}
+	records := []types.Record{
+		newRecord(sessionID1, "one", "shared", "only-one"),
+		newRecord(sessionID1, "two", "shared", "shared"),
+		newRecord(sessionID2, "three", "shared", "c2hhcmVkCg=="),
+	}
+	recordIDs := make([]types.MetadataAddress, len(records))
+	for i, record := range records {
+		kpr.SetRecord(ctx, record)
+		recordIDs[i] = record.SessionId.MustGetAsRecordAddress(record.Name)
+	}
+
+	entry := func(i int) types.OutputHashRecord {
+		scopeID := scopeID1
+		if records[i].SessionId.Equals(sessionID2) {
+			scopeID = scopeID2
+		}
+		return types.OutputHashRecord{RecordId: recordIDs[i].String(), ScopeId: scopeID.String()}
+	}
+	getRecords := func(hash string) []types.OutputHashRecord {
+		resp, err := kpr.RecordsByOutputHash(ctx, &types.RecordsByOutputHashRequest{Hash: hash})
+		s.Require().NoError(err, "RecordsByOutputHash(%q)", hash)
+		s.Require().NotNil(resp, "RecordsByOutputHash(%q) response", hash)
+		return resp.Records
+	}
+
+	s.Run("invalid requests", func() {
+		_, err := kpr.RecordsByOutputHash(ctx, nil)
+		s.AssertErrorValue(err, "empty request: invalid request", "RecordsByOutputHash(nil)")
+		_, err = kpr.RecordsByOutputHash(ctx, &types.RecordsByOutputHashRequest{})
+		s.AssertErrorValue(err, "empty hash: invalid request", "RecordsByOutputHash empty hash")
+	})
+
+	s.Run("lookups", func() {
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(0), entry(1), entry(2)}, getRecords("shared"), "shared")
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(0)}, getRecords("only-one"), "only-one")
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(2)}, getRecords("c2hhcmVkCg=="), "c2hhcmVkCg==")
+		s.Assert().Empty(getRecords("SHARED"), "SHARED")
+		s.Assert().Empty(getRecords("unknown"), "unknown")
+	})
+
+	s.Run("pagination", func() {
+		resp, err := queryClient.RecordsByOutputHash(gocontext.Background(), &types.RecordsByOutputHashRequest{
+			Hash:       "shared",
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		s.Require().NoError(err, "RecordsByOutputHash first page")
+		s.Assert().Len(resp.Records, 2, "first page Records")
+		s.Assert().Equal(3, int(resp.Pagination.Total), "first page Pagination.Total")
+		s.Require().NotEmpty(resp.Pagination.NextKey, "first page Pagination.NextKey")
+		firstPage := resp.Records
+
+		resp, err = queryClient.RecordsByOutputHash(gocontext.Background(), &types.RecordsByOutputHashRequest{
+			Hash:       "shared",
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+		})
+		s.Require().NoError(err, "RecordsByOutputHash second page")
+		s.Assert().Len(resp.Records, 1, "second page Records")
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(0), entry(1), entry(2)},
+			append(firstPage, resp.Records...), "Records from both pages")
+	})
+
+	s.Run("index updated with record", func() {
+		updated := records[0]
+		updated.Outputs = []types.RecordOutput{{Hash: "new-hash", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+		kpr.SetRecord(ctx, updated)
+		kpr.RemoveRecord(ctx, recordIDs[2])
+
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(1)}, getRecords("shared"), "shared after changes")
+		s.Assert().Empty(getRecords("only-one"), "only-one after changes")
+		s.Assert().Empty(getRecords("c2hhcmVkCg=="), "c2hhcmVkCg== after changes")
+		s.Assert().ElementsMatch([]types.OutputHashRecord{entry(0)}, getRecords("new-hash"), "new-hash after changes")
+	})
+}
+
+func (s *QueryServerTestSuite) TestScopeLienQueries() {
+	kpr := s.app.MetadataKeeper
+	blockTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	lienholder1 := sdk.AccAddress("lienholder1_________")
+	lienholder2 := sdk.AccAddress("lienholder2_________")
+	scopeID1 := types.ScopeMetadataAddress(newTestUUID(1))
+	scopeID2 := types.ScopeMetadataAddress(newTestUUID(2))
+	scopeID3 := types.ScopeMetadataAddress(newTestUUID(3))
+	scopeID4 := types.ScopeMetadataAddress(newTestUUID(4))
+	released := blockTime.Add(-1 * time.Hour)
+	future := blockTime.Add(time.Hour)
+
+	liens := []types.ScopeLien{
+		types.NewScopeLien(scopeID1, lienholder1.String(), nil),
+		types.NewScopeLien(scopeID2, lienholder1.String(), &released),
+		types.NewScopeLien(scopeID3, lienholder2.String(), &future),
+	}
+	for i, lien := range liens {
+		s.Require().NoError(kpr.SetScopeLien(ctx, lien), "[%d]: SetScopeLien", i)
+	}
+
+	s.Run("scope lien invalid requests", func() {
+		_, err := kpr.ScopeLien(ctx, nil)
+		s.AssertErrorValue(err, "empty request: invalid request", "ScopeLien(nil)")
+		_, err = kpr.ScopeLien(ctx, &types.ScopeLienRequest{})
+		s.AssertErrorValue(err, "empty scope id: invalid request", "ScopeLien empty scope id")
+	})
+
+	s.Run("scope lien", func() {
+		tests := []struct {
+			scopeID  types.MetadataAddress
+			expLien  *types.ScopeLien
+			inEffect bool
+		}{
+			{scopeID: scopeID1, expLien: &liens[0], inEffect: true},
+			{scopeID: scopeID2, expLien: &liens[1], inEffect: false},
+			{scopeID: scopeID3, expLien: &liens[2], inEffect: true},
+			{scopeID: scopeID4, expLien: nil, inEffect: false},
+		}
+		for i, tc := range tests {
+			resp, err := kpr.ScopeLien(ctx, &types.ScopeLienRequest{ScopeId: tc.scopeID.String()})
+			s.Require().NoError(err, "[%d]: ScopeLien", i)
+			s.Assert().Equal(tc.expLien, resp.Lien, "[%d]: ScopeLien lien", i)
+			s.Assert().Equal(tc.inEffect, resp.InEffect, "[%d]: ScopeLien in effect", i)
+		}
+	})
+
+	getLiens := func(lienholder sdk.AccAddress) []types.ScopeLien {
+		resp, err := kpr.ScopeLiensByLienholder(ctx, &types.ScopeLiensByLienholderRequest{Lienholder: lienholder.String()})
+		s.Require().NoError(err, "ScopeLiensByLienholder(%s)", lienholder)
+		return resp.Liens
+	}
+
+	s.Run("liens by lienholder invalid requests", func() {
+		_, err := kpr.ScopeLiensByLienholder(ctx, nil)
+		s.AssertErrorValue(err, "empty request: invalid request", "ScopeLiensByLienholder(nil)")
+		_, err = kpr.ScopeLiensByLienholder(ctx, &types.ScopeLiensByLienholderRequest{})
+		s.AssertErrorValue(err, "lienholder cannot be empty: invalid request", "ScopeLiensByLienholder empty lienholder")
+	})
+
+	s.Run("liens by lienholder", func() {
+		s.Assert().ElementsMatch([]types.ScopeLien{liens[0], liens[1]}, getLiens(lienholder1), "lienholder1 liens")
+		s.Assert().ElementsMatch([]types.ScopeLien{liens[2]}, getLiens(lienholder2), "lienholder2 liens")
+	})
+
+	s.Run("index updated with lien", func() {
+		replacement := types.NewScopeLien(scopeID2, lienholder2.String(), nil)
+		s.Require().NoError(kpr.SetScopeLien(ctx, replacement), "SetScopeLien replacement")
+		kpr.RemoveScopeLien(ctx, scopeID1)
+
+		s.Assert().Empty(getLiens(lienholder1), "lienholder1 liens after changes")
+		s.Assert().ElementsMatch([]types.ScopeLien{liens[2], replacement}, getLiens(lienholder2), "lienholder2 liens after changes")
+	})
+}
+
+func (s *QueryServerTestSuite) TestScopeValueHolders() {
+	kpr := s.app.MetadataKeeper
+	ctx, _ := s.ctx.CacheContext()
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	wholeID := types.ScopeMetadataAddress(uuid.New())
+	splitID := types.ScopeMetadataAddress(uuid.New())
+	noVOID := types.ScopeMetadataAddress(uuid.New())
+
+	for _, scopeID := range []types.MetadataAddress{wholeID, splitID} {
+		scope := types.NewScope(scopeID, nil, ownerPartyList(holder1.String()), nil, holder1.String(), false)
+		s.Require().NoError(kpr.SetScope(ctx, *scope), "SetScope(%s)", scopeID)
+	}
+	s.Require().NoError(kpr.SplitScopeValue(ctx, splitID, 10, holder1), "SplitScopeValue")
+	s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, holder1, holder2, sdk.NewCoins(sdk.NewInt64Coin(splitID.Denom(), 4))), "SendCoins")
+
+	s.Run("invalid requests", func() {
+		_, err := kpr.ScopeValueHolders(ctx, nil)
+		s.AssertErrorValue(err, "empty request: invalid request", "ScopeValueHolders(nil)")
+		_, err = kpr.ScopeValueHolders(ctx, &types.ScopeValueHoldersRequest{})
+		s.AssertErrorValue(err, "empty scope id: invalid request", "ScopeValueHolders empty scope id")
+	})
+
+	tests := []struct {
+		name       string
+		scopeID    types.MetadataAddress
+		expUnits   uint64
+		expHolders []types.ScopeValueHolder
+	}{
+		{
+			name:       "whole scope",
+			scopeID:    wholeID,
+			expUnits:   1,
+			expHolders: []types.ScopeValueHolder{types.NewScopeValueHolder(holder1.String(), 1)},
+		},
+		{
+			name:     "split scope",
+			scopeID:  splitID,
+			expUnits: 10,
+			expHolders: []types.ScopeValueHolder{
+				types.NewScopeValueHolder(holder1.String(), 6),
+				types.NewScopeValueHolder(holder2.String(), 4),
+			},
+		},
+		{
+			name:       "no value owner",
+			scopeID:    noVOID,
+			expUnits:   1,
+			expHolders: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := kpr.ScopeValueHolders(ctx, &types.ScopeValueHoldersRequest{ScopeId: tc.scopeID.String()})
+			s.Require().NoError(err, "ScopeValueHolders")
+			s.Assert().Equal(tc.expUnits, resp.TotalUnits, "ScopeValueHolders total units")
+			s.Assert().ElementsMatch(tc.expHolders, resp.Holders, "ScopeValueHolders holders")
+		})
+	}
+}
+
 // TODO: OSLocatorParams tests
 // TODO: OSLocator tests
 // TODO: OSLocatorsByURI tests
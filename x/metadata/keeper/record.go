@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	storetypes "cosmossdk.io/store/types"
@@ -64,11 +65,14 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
-	if store.Has(recordID) {
+	var oldRecord *types.Record
+	if existing, found := k.GetRecord(ctx, recordID); found {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
+		oldRecord = &existing
 	}
 
 	store.Set(recordID, b)
+	indexRecord(store, recordID, &record, oldRecord)
 	k.EmitEvent(ctx, event)
 }
 
@@ -83,12 +87,42 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	indexRecord(store, id, nil, &record)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 
 	// Remove the session too if there are no more records in it.
 	k.RemoveSession(ctx, record.SessionId)
 }
 
+// getRecordOutputHashes gets the unique output hashes of the provided record.
+func getRecordOutputHashes(record *types.Record) []string {
+	if record == nil {
+		return nil
+	}
+	var rv []string
+	for _, output := range record.Outputs {
+		if len(output.Hash) > 0 && !slices.Contains(rv, output.Hash) {
+			rv = append(rv, output.Hash)
+		}
+	}
+	return rv
+}
+
+// indexRecord updates the record output hash index entries for a record that is being changed from the oldRecord
+// to the newRecord. Either can be nil, e.g. the oldRecord is nil for a new record, and the newRecord is nil when
+// it's being deleted.
+func indexRecord(store storetypes.KVStore, recordID types.MetadataAddress, newRecord, oldRecord *types.Record) {
+	newHashes := getRecordOutputHashes(newRecord)
+	oldHashes := getRecordOutputHashes(oldRecord)
+
+	for _, hash := range provutils.FindMissing(newHashes, oldHashes) {
+		store.Set(types.GetRecordOutputHashCacheKey(hash, recordID), []byte{0x01})
+	}
+	for _, hash := range provutils.FindMissing(oldHashes, newHashes) {
+		store.Delete(types.GetRecordOutputHashCacheKey(hash, recordID))
+	}
+}
+
 // IterateRecords processes stored records with the given handler.
 // If the scopeID is an empty MetadataAddress, all records will be processed.
 // Otherwise, just the records for the given scopeID will be processed.
@@ -120,7 +154,17 @@ func (k Keeper) ValidateWriteRecord(
 	existing *types.Record,
 	msg *types.MsgWriteRecordRequest,
 ) error {
-	proposed := &msg.Record
+	return k.validateWriteRecord(ctx, existing, &msg.Record, msg)
+}
+
+// validateWriteRecord checks that the proposed record can be written by the signers of the provided msg.
+// If the proposed record doesn't have a SpecificationId, it is set.
+func (k Keeper) validateWriteRecord(
+	ctx sdk.Context,
+	existing *types.Record,
+	proposed *types.Record,
+	msg types.MetadataMsg,
+) error {
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
 	}
@@ -171,7 +215,7 @@ func (k Keeper) ValidateWriteRecord(
 		return fmt.Errorf("proposed specification id %s does not match expected specification id %s",
 			proposed.SpecificationId, recSpecID)
 	}
-	recSpec, found := k.GetRecordSpecification(ctx, recSpecID)
+	recSpec, found := k.getRecordSpecificationCached(ctx, recSpecID)
 	if !found {
 		return fmt.Errorf("record specification not found for record specification id %s (contract spec id %s and record name %q)",
 			recSpecID, session.SpecificationId, proposed.Name)
@@ -301,6 +345,15 @@ func (k Keeper) ValidateWriteRecord(
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
+	// Validate the outputs against the output schema (if defined).
+	if recSpec.OutputSchema != nil {
+		for i, output := range proposed.Outputs {
+			if err := recSpec.OutputSchema.ValidateOutput(output); err != nil {
+				return fmt.Errorf("invalid output at index %d: %w", i, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -334,7 +387,7 @@ func (k Keeper) ValidateDeleteRecord(ctx sdk.Context, proposedID types.MetadataA
 		// New:
 		//   - All roles required by the record spec must have a signer and associated party in the scope.
 		//   - All optional=false scope owners must be signers.
-		reqSpec, found := k.GetRecordSpecification(ctx, record.SpecificationId)
+		reqSpec, found := k.getRecordSpecificationCached(ctx, record.SpecificationId)
 		if !found {
 			// If the record spec doesn't exist, only check for optional=false signers.
 			if err := k.ValidateSignersWithoutParties(ctx, types.GetRequiredPartyAddresses(scope.Owners), msg); err != nil {
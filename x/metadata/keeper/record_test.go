@@ -15,6 +15,7 @@ import (
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/jsonschema"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -639,6 +640,23 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 	}
 	s.app.MetadataKeeper.SetRecordSpecification(ctx, rollupRecSpecAff)
 
+	schemaJSON := `{"type":"object","required":["amount"],"properties":{"amount":{"type":"integer"}}}`
+	schemaHash := types.HashOutputSchema([]byte(schemaJSON))
+	schemaRecSpec := types.RecordSpecification{
+		SpecificationId:    s.contractSpecID.MustGetAsRecordSpecAddress("with_schema"),
+		Name:               "with_schema",
+		Inputs:             []*types.InputSpecification{},
+		TypeName:           recordTypeName,
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD_LIST,
+		ResponsibleParties: []types.PartyType{owner},
+		OutputSchema:       types.NewOutputSchema(types.OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, schemaHash, schemaJSON),
+	}
+	s.app.MetadataKeeper.SetRecordSpecification(ctx, schemaRecSpec)
+	schemaRecord := func(outputs ...types.RecordOutput) *types.Record {
+		return types.NewRecord(schemaRecSpec.Name, sessionID, *process, []types.RecordInput{}, outputs, schemaRecSpec.SpecificationId)
+	}
+	pass := types.ResultStatus_RESULT_STATUS_PASS
+
 	user3 := sdk.AccAddress("user_three__________").String()
 	user4 := sdk.AccAddress("user_four___________").String()
 	rollupScope := types.Scope{
@@ -996,6 +1014,42 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 			signers:  []string{s.user1, user3},
 			errorMsg: "missing required signature: " + user4 + " (OWNER)",
 		},
+		"output schema: output without schema hash": {
+			proposed: schemaRecord(
+				types.RecordOutput{Hash: "output1", Status: pass, SchemaHash: schemaHash, Payload: `{"amount":3}`},
+				types.RecordOutput{Hash: "output2", Status: pass},
+			),
+			signers:  []string{s.user1},
+			errorMsg: "invalid output at index 1: missing schema hash, expected " + schemaHash,
+		},
+		"output schema: wrong schema hash": {
+			proposed: schemaRecord(types.RecordOutput{Hash: "output1", Status: pass, SchemaHash: "abcd"}),
+			signers:  []string{s.user1},
+			errorMsg: "invalid output at index 0: schema hash abcd does not match the output schema hash " + schemaHash,
+		},
+		"output schema: non-conforming payload": {
+			proposed: schemaRecord(types.RecordOutput{Hash: "output1", Status: pass, SchemaHash: schemaHash, Payload: `{"amount":"lots"}`}),
+			signers:  []string{s.user1},
+			errorMsg: "invalid output at index 0: payload does not conform to the output schema: " +
+				jsonschema.Validate(schemaJSON, `{"amount":"lots"}`).Error(),
+		},
+		"output schema: output without payload": {
+			proposed: schemaRecord(
+				types.RecordOutput{Hash: "output1", Status: pass, SchemaHash: schemaHash, Payload: `{"amount":3}`},
+				types.RecordOutput{Hash: "output2", Status: pass, SchemaHash: schemaHash},
+			),
+			signers:  []string{s.user1},
+			errorMsg: "invalid output at index 1: missing payload, required by the output schema's json schema",
+		},
+		"output schema: conforming outputs": {
+			proposed: schemaRecord(
+				types.RecordOutput{Hash: "output1", Status: pass, SchemaHash: schemaHash, Payload: `{"amount":3}`},
+				types.RecordOutput{Hash: "output2", Status: pass, SchemaHash: schemaHash, Payload: `{"amount":4}`},
+				types.RecordOutput{Status: types.ResultStatus_RESULT_STATUS_SKIP},
+			),
+			signers:  []string{s.user1},
+			errorMsg: "",
+		},
 	}
 
 	for name, tc := range cases {
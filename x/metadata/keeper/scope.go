@@ -67,6 +67,55 @@ func (k Keeper) IterateScopesForScopeSpec(ctx sdk.Context, scopeSpecID types.Met
 	return nil
 }
 
+// IterateScopeChildren processes the ids of the scopes that have the provided parent scope with the given handler.
+func (k Keeper) IterateScopeChildren(ctx sdk.Context, parentScopeID types.MetadataAddress,
+	handler func(scopeID types.MetadataAddress) (stop bool),
+) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetScopeChildCacheIteratorPrefix(parentScopeID)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(scopeID) {
+			break
+		}
+	}
+	return nil
+}
+
+// HasScopeChildren returns true if there is at least one scope with the provided parent scope.
+func (k Keeper) HasScopeChildren(ctx sdk.Context, parentScopeID types.MetadataAddress) bool {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetScopeChildCacheIteratorPrefix(parentScopeID))
+	defer it.Close()
+	return it.Valid()
+}
+
+// GetScopeAncestors returns the ids of a scope's parent, its parent's parent, and so on, ending with the root scope.
+func (k Keeper) GetScopeAncestors(ctx sdk.Context, scopeID types.MetadataAddress) ([]types.MetadataAddress, error) {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	var rv []types.MetadataAddress
+	visited := map[string]bool{string(scopeID): true}
+	for parentID := scope.GetParentScopeID(); !parentID.Empty(); parentID = scope.GetParentScopeID() {
+		if visited[string(parentID)] {
+			return rv, fmt.Errorf("scope %s has cyclic ancestry at %s", scopeID, parentID)
+		}
+		visited[string(parentID)] = true
+		rv = append(rv, parentID)
+		if scope, found = k.GetScope(ctx, parentID); !found {
+			return rv, fmt.Errorf("parent scope not found with id %s", parentID)
+		}
+	}
+	return rv, nil
+}
+
 // GetScope returns the scope with the given id. The ValueOwnerAddress field will always be empty from this method.
 // See also: GetScopeWithValueOwner, PopulateScopeValueOwner and GetScopeValueOwner.
 func (k Keeper) GetScope(ctx sdk.Context, id types.MetadataAddress) (types.Scope, bool) {
@@ -192,16 +241,22 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) error {
 	}
 
 	k.indexScope(store, nil, &scope)
+	k.RemoveScopeLien(ctx, id)
+	k.RemoveScopeValueUnits(ctx, id)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	return nil
 }
 
 // GetScopeValueOwner gets the value owner of a given scope.
+// An error is returned if the scope's value units are split among multiple holders.
 func (k Keeper) GetScopeValueOwner(ctx sdk.Context, id types.MetadataAddress) (sdk.AccAddress, error) {
 	if !id.IsScopeAddress() {
 		return nil, fmt.Errorf("cannot get value owner for non-scope metadata address %q", id)
 	}
+	if err := k.validateScopeValueNotSplit(ctx, id); err != nil {
+		return nil, err
+	}
 	return k.bankKeeper.DenomOwner(ctx, id.Denom())
 }
 
@@ -224,6 +279,8 @@ func (k Keeper) GetScopeValueOwners(ctx sdk.Context, ids []types.MetadataAddress
 // SetScopeValueOwner updates the value owner of a scope.
 // If there's no current value owner, the coin will be minted for the scope.
 // If there's no new value owner, the coin will be burned for the scope.
+// If the scope's value has been split into multiple units, all of them are given to the new value owner,
+// which is only allowed if they have a single holder (or if they are being burned).
 func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddress, newValueOwner string) error {
 	if err := scopeID.ValidateIsScopeAddress(); err != nil {
 		return err
@@ -247,6 +304,10 @@ func (k Keeper) SetScopeValueOwner(ctx sdk.Context, scopeID types.MetadataAddres
 		}
 	}
 
+	if units, found := k.GetScopeValueUnits(ctx, scopeID); found {
+		return k.setFractionalScopeValueOwner(ctx, units, toAddr, doBurn)
+	}
+
 	coin := scopeID.Coin()
 	fromAddr, err := k.bankKeeper.DenomOwner(ctx, coin.Denom)
 	if err != nil {
@@ -302,6 +363,10 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, links types.AccMDLinks, new
 	fromAddrAmts := make(map[string]sdk.Coins)
 	for _, link := range links {
 		coin := link.MDAddr.Coin()
+		if _, found := k.GetScopeValueUnits(ctx, link.MDAddr); found {
+			// Each account sends all of the scope's value units that it holds.
+			coin = k.bankKeeper.GetBalance(ctx, link.AccAddr, coin.Denom)
+		}
 		cur, seen := fromAddrAmts[string(link.AccAddr)]
 		if !seen {
 			fromAddrs = append(fromAddrs, link.AccAddr)
@@ -325,6 +390,15 @@ type scopeIndexValues struct {
 	ScopeID         types.MetadataAddress
 	Addresses       []sdk.AccAddress
 	SpecificationID types.MetadataAddress
+	ParentScopeID   types.MetadataAddress
+	PartyRoles      []addressRole
+	DataAccess      []sdk.AccAddress
+}
+
+// addressRole is an address paired with a role that address has in a scope.
+type addressRole struct {
+	Address sdk.AccAddress
+	Role    types.PartyType
 }
 
 // getScopeIndexValues extracts the values used to index a scope.
@@ -335,23 +409,32 @@ func getScopeIndexValues(scope *types.Scope) *scopeIndexValues {
 	rv := scopeIndexValues{
 		ScopeID:         scope.ScopeId,
 		SpecificationID: scope.SpecificationId,
+		ParentScopeID:   scope.GetParentScopeID(),
 	}
 	knownAddrs := make(map[string]bool)
 	for _, dataAccess := range scope.DataAccess {
 		if !knownAddrs[dataAccess] {
 			if addr, err := sdk.AccAddressFromBech32(dataAccess); err == nil {
 				rv.Addresses = append(rv.Addresses, addr)
+				rv.DataAccess = append(rv.DataAccess, addr)
 			}
 			knownAddrs[dataAccess] = true
 		}
 	}
+	knownRoles := make(map[string]bool)
 	for _, owner := range scope.Owners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
 		if !knownAddrs[owner.Address] {
-			if addr, err := sdk.AccAddressFromBech32(owner.Address); err == nil {
+			if err == nil {
 				rv.Addresses = append(rv.Addresses, addr)
 			}
 			knownAddrs[owner.Address] = true
 		}
+		roleKey := owner.Address + "/" + owner.Role.String()
+		if err == nil && !knownRoles[roleKey] {
+			rv.PartyRoles = append(rv.PartyRoles, addressRole{Address: addr, Role: owner.Role})
+			knownRoles[roleKey] = true
+		}
 	}
 	return &rv
 }
@@ -369,9 +452,18 @@ func getMissingScopeIndexValues(required, found *scopeIndexValues) *scopeIndexVa
 	rv.Addresses = provutils.FindMissingFunc(required.Addresses, found.Addresses, func(a1, a2 sdk.AccAddress) bool {
 		return a1.Equals(a2)
 	})
+	rv.DataAccess = provutils.FindMissingFunc(required.DataAccess, found.DataAccess, func(a1, a2 sdk.AccAddress) bool {
+		return a1.Equals(a2)
+	})
+	rv.PartyRoles = provutils.FindMissingFunc(required.PartyRoles, found.PartyRoles, func(r1, r2 addressRole) bool {
+		return r1.Role == r2.Role && r1.Address.Equals(r2.Address)
+	})
 	if !required.SpecificationID.Equals(found.SpecificationID) {
 		rv.SpecificationID = required.SpecificationID
 	}
+	if !required.ParentScopeID.Equals(found.ParentScopeID) {
+		rv.ParentScopeID = required.ParentScopeID
+	}
 	return rv
 }
 
@@ -380,13 +472,22 @@ func (v scopeIndexValues) IndexKeys() [][]byte {
 	if v.ScopeID.Empty() {
 		return nil
 	}
-	rv := make([][]byte, 0, len(v.Addresses)+2)
+	rv := make([][]byte, 0, len(v.Addresses)+len(v.PartyRoles)+len(v.DataAccess)+3)
 	for _, addr := range v.Addresses {
 		rv = append(rv, types.GetAddressScopeCacheKey(addr, v.ScopeID))
 	}
+	for _, pr := range v.PartyRoles {
+		rv = append(rv, types.GetAddressRoleScopeCacheKey(pr.Address, pr.Role, v.ScopeID))
+	}
+	for _, addr := range v.DataAccess {
+		rv = append(rv, types.GetDataAccessScopeCacheKey(addr, v.ScopeID))
+	}
 	if !v.SpecificationID.Empty() {
 		rv = append(rv, types.GetScopeSpecScopeCacheKey(v.SpecificationID, v.ScopeID))
 	}
+	if !v.ParentScopeID.Empty() {
+		rv = append(rv, types.GetScopeChildCacheKey(v.ParentScopeID, v.ScopeID))
+	}
 	return rv
 }
 
@@ -425,13 +526,22 @@ func (k Keeper) ValidateWriteScope(
 	ctx sdk.Context,
 	msg *types.MsgWriteScopeRequest,
 ) ([]sdk.AccAddress, error) {
-	proposed := msg.Scope
+	return k.validateWriteScope(ctx, msg.Scope, msg)
+}
+
+// validateWriteScope checks that the proposed scope can be written by the signers of the provided msg.
+// Returns the addresses allowed to act as transfer agents.
+func (k Keeper) validateWriteScope(
+	ctx sdk.Context,
+	proposed types.Scope,
+	msg types.MetadataMsg,
+) ([]sdk.AccAddress, error) {
 	if err := proposed.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
 	var existing *types.Scope
-	if e, found := k.GetScope(ctx, msg.Scope.ScopeId); found {
+	if e, found := k.GetScope(ctx, proposed.ScopeId); found {
 		existing = &e
 	}
 
@@ -452,6 +562,14 @@ func (k Keeper) ValidateWriteScope(
 		}
 	}
 
+	// A lien on the scope prevents changes to its owners and value owner.
+	if existing != nil && (!types.EqualParties(existing.Owners, proposed.Owners) ||
+		(len(proposed.ValueOwnerAddress) > 0 && existing.ValueOwnerAddress != proposed.ValueOwnerAddress)) {
+		if err := k.validateNoScopeLien(ctx, proposed.ScopeId); err != nil {
+			return nil, err
+		}
+	}
+
 	// Existing owners are not required to sign when the ONLY change is from one value owner to another.
 	// Signatures from existing owners are required if:
 	//   - Anything other than the value owner is changing.
@@ -469,10 +587,14 @@ func (k Keeper) ValidateWriteScope(
 	var validatedParties []*types.PartyDetails
 
 	if !onlyChangeIsValueOwner {
-		scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
+		scopeSpec, found := k.getScopeSpecificationCached(ctx, proposed.SpecificationId)
 		if !found {
 			return nil, fmt.Errorf("scope specification %s not found", proposed.SpecificationId)
 		}
+		// Scopes can stay on a deprecated spec, but they can't be newly put on one.
+		if scopeSpec.Deprecated && (existing == nil || !existing.SpecificationId.Equals(proposed.SpecificationId)) {
+			return nil, fmt.Errorf("scope specification %s is deprecated", proposed.SpecificationId)
+		}
 
 		if err = validateRolesPresent(proposed.Owners, scopeSpec.PartiesInvolved); err != nil {
 			return nil, err
@@ -508,6 +630,15 @@ func (k Keeper) ValidateWriteScope(
 		}
 	}
 
+	// Assigning a scope to a parent requires the parent's owners to sign too.
+	if parentID := proposed.GetParentScopeID(); !parentID.Empty() && (existing == nil || !existing.GetParentScopeID().Equals(parentID)) {
+		var parentParties []*types.PartyDetails
+		if parentParties, err = k.validateParentScope(ctx, proposed, msg); err != nil {
+			return nil, err
+		}
+		validatedParties = append(validatedParties, parentParties...)
+	}
+
 	transferAgents, usedSigners, err := k.ValidateScopeValueOwnersSigners(ctx, existingVOAddrs, proposed.ValueOwnerAddress, msg)
 	if err != nil {
 		return nil, err
@@ -520,14 +651,59 @@ func (k Keeper) ValidateWriteScope(
 	return transferAgents, nil
 }
 
+// validateParentScope makes sure that the parent of the provided scope exists, that the scope isn't one of the
+// parent's ancestors, and that the owners of the parent have signed the msg.
+func (k Keeper) validateParentScope(ctx sdk.Context, scope types.Scope, msg types.MetadataMsg) ([]*types.PartyDetails, error) {
+	parent, found := k.GetScope(ctx, scope.GetParentScopeID())
+	if !found {
+		return nil, fmt.Errorf("parent scope not found with id %s", scope.GetParentScopeID())
+	}
+
+	ancestors, err := k.GetScopeAncestors(ctx, parent.ScopeId)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.Equals(scope.ScopeId) {
+			return nil, fmt.Errorf("parent scope %s is a descendant of scope %s", parent.ScopeId, scope.ScopeId)
+		}
+	}
+
+	if !parent.RequirePartyRollup {
+		return k.validateAllRequiredSigned(ctx, parent.GetAllOwnerAddresses(), msg)
+	}
+	parentSpec, found := k.GetScopeSpecification(ctx, parent.SpecificationId)
+	if !found {
+		return k.validateAllRequiredSigned(ctx, types.GetRequiredPartyAddresses(parent.Owners), msg)
+	}
+	return k.validateAllRequiredPartiesSigned(ctx, parent.Owners, parent.Owners, parentSpec.PartiesInvolved, msg)
+}
+
 // ValidateDeleteScope checks the current scope and the proposed removal scope to determine if the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRequest) ([]sdk.AccAddress, error) {
-	scope, found := k.GetScope(ctx, msg.ScopeId)
+	return k.validateDeleteScope(ctx, msg.ScopeId, msg)
+}
+
+// validateDeleteScope checks that the scope with the provided id can be deleted by the signers of the provided msg.
+// Returns the addresses allowed to act as transfer agents.
+func (k Keeper) validateDeleteScope(ctx sdk.Context, scopeID types.MetadataAddress, msg types.MetadataMsg) ([]sdk.AccAddress, error) {
+	scope, found := k.GetScope(ctx, scopeID)
 	if !found {
-		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	if k.HasScopeChildren(ctx, scope.ScopeId) {
+		return nil, fmt.Errorf("scope %s has child scopes and cannot be deleted", scope.ScopeId)
+	}
+	if err := k.validateNoScopeLien(ctx, scope.ScopeId); err != nil {
+		return nil, err
 	}
+	return k.validateScopeOwnersSigned(ctx, scope, msg)
+}
 
+// validateScopeOwnersSigned checks that the owners and value owner of the provided (existing) scope have signed the
+// provided msg, i.e. that the signers are allowed to delete the scope. Returns the addresses allowed to act as transfer agents.
+func (k Keeper) validateScopeOwnersSigned(ctx sdk.Context, scope types.Scope, msg types.MetadataMsg) ([]sdk.AccAddress, error) {
 	var err error
 	var validatedParties []*types.PartyDetails
 
@@ -549,7 +725,7 @@ func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRe
 		//     associated party from the existing scope.
 		//   - Value owner signer restrictions are applied.
 		// We don't care about that first one, and only care about the roles one if the spec exists.
-		scopeSpec, specFound := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		scopeSpec, specFound := k.getScopeSpecificationCached(ctx, scope.SpecificationId)
 		if !specFound {
 			if validatedParties, err = k.validateAllRequiredSigned(ctx, types.GetRequiredPartyAddresses(scope.Owners), msg); err != nil {
 				return nil, err
@@ -562,14 +738,22 @@ func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRe
 	}
 
 	var existingVOAddrs []sdk.AccAddress
-	vo, err := k.GetScopeValueOwner(ctx, scope.ScopeId)
-	if err != nil {
-		return nil, fmt.Errorf("error identifying current value owner of %q: %w", scope.ScopeId, err)
-	}
-	// It is possible for older scopes to not have a value owner.
-	if len(vo) > 0 {
-		scope.ValueOwnerAddress = vo.String()
-		existingVOAddrs = append(existingVOAddrs, vo)
+	if _, fractional := k.GetScopeValueUnits(ctx, scope.ScopeId); fractional {
+		// When the value is split into multiple units, everyone holding some of them must sign.
+		existingVOAddrs, err = k.getScopeValueHolderAddrs(ctx, scope.ScopeId)
+		if err != nil {
+			return nil, fmt.Errorf("error identifying current value holders of %q: %w", scope.ScopeId, err)
+		}
+	} else {
+		vo, err := k.GetScopeValueOwner(ctx, scope.ScopeId)
+		if err != nil {
+			return nil, fmt.Errorf("error identifying current value owner of %q: %w", scope.ScopeId, err)
+		}
+		// It is possible for older scopes to not have a value owner.
+		if len(vo) > 0 {
+			scope.ValueOwnerAddress = vo.String()
+			existingVOAddrs = append(existingVOAddrs, vo)
+		}
 	}
 
 	transferAgents, usedSigners, err := k.ValidateScopeValueOwnersSigners(ctx, existingVOAddrs, "", msg)
@@ -749,6 +933,9 @@ func (k Keeper) ValidateUpdateScopeOwners(
 	if err := proposed.ValidateOwnersBasic(); err != nil {
 		return err
 	}
+	if err := k.validateNoScopeLien(ctx, existing.ScopeId); err != nil {
+		return err
+	}
 
 	scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
 	if !found {
@@ -807,6 +994,11 @@ func (k Keeper) ValidateUpdateValueOwners(
 	if err := links.ValidateForScopes(); err != nil {
 		return nil, err
 	}
+	for _, link := range links {
+		if err := k.validateNoScopeLien(ctx, link.MDAddr); err != nil {
+			return nil, err
+		}
+	}
 	if ids := links.GetMDAddrsForAccAddr(proposed); len(ids) > 0 {
 		if len(ids) == 1 {
 			return nil, fmt.Errorf("scope %q already has the proposed value owner %q", ids[0], proposed)
@@ -818,6 +1010,124 @@ func (k Keeper) ValidateUpdateValueOwners(
 	return transferAgents, err
 }
 
+// ValidateMigrateScopeSpecification makes sure that each of the msg's scopes can be migrated to the msg's scope
+// specification, and that the scopes' owners have signed. Returns the scopes (as they are now) in the msg's order.
+func (k Keeper) ValidateMigrateScopeSpecification(
+	ctx sdk.Context,
+	msg *types.MsgMigrateScopeSpecificationRequest,
+) ([]types.Scope, error) {
+	newSpec, found := k.GetScopeSpecification(ctx, msg.SpecificationId)
+	if !found {
+		return nil, fmt.Errorf("scope specification %s not found", msg.SpecificationId)
+	}
+	if newSpec.Deprecated {
+		return nil, fmt.Errorf("scope specification %s is deprecated", newSpec.SpecificationId)
+	}
+
+	allowedCSpecs := make(map[string]bool, len(newSpec.ContractSpecIds))
+	for _, cSpecID := range newSpec.ContractSpecIds {
+		allowedCSpecs[string(cSpecID)] = true
+	}
+
+	scopes := make([]types.Scope, len(msg.ScopeIds))
+	usedSigners := types.NewUsedSignersMap()
+	for i, scopeID := range msg.ScopeIds {
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return nil, fmt.Errorf("scope not found with id %s", scopeID)
+		}
+		parties, err := k.validateScopeSpecMigration(ctx, scope, newSpec, allowedCSpecs, msg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot migrate scope %s: %w", scopeID, err)
+		}
+		usedSigners.AlsoUse(types.GetUsedSigners(parties))
+		scopes[i] = scope
+	}
+
+	if err := k.validateSmartContractSigners(ctx, usedSigners, msg); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+// validateScopeSpecMigration checks that a scope can be moved to the provided scope specification:
+// the new spec must be a newer version of the scope's current spec, the scope's owners must satisfy it, each of the
+// scope's sessions must use a contract spec allowed by it, and each of the scope's records must have a record spec.
+// It also makes sure the scope's owners have signed (the same way as if the scope were being updated).
+func (k Keeper) validateScopeSpecMigration(
+	ctx sdk.Context,
+	scope types.Scope,
+	newSpec types.ScopeSpecification,
+	allowedCSpecs map[string]bool,
+	msg types.MetadataMsg,
+) ([]*types.PartyDetails, error) {
+	if scope.SpecificationId.Equals(newSpec.SpecificationId) {
+		return nil, fmt.Errorf("scope already uses scope specification %s", newSpec.SpecificationId)
+	}
+	curSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+	if !found {
+		return nil, fmt.Errorf("scope specification %s not found", scope.SpecificationId)
+	}
+	if newSpec.Version <= curSpec.Version {
+		return nil, fmt.Errorf("scope specification %s version %d is not newer than current scope specification %s version %d",
+			newSpec.SpecificationId, newSpec.Version, curSpec.SpecificationId, curSpec.Version)
+	}
+	if !k.isScopeSpecSuccessor(ctx, newSpec, curSpec.SpecificationId) {
+		return nil, fmt.Errorf("scope specification %s does not succeed current scope specification %s",
+			newSpec.SpecificationId, curSpec.SpecificationId)
+	}
+	if err := validateRolesPresent(scope.Owners, newSpec.PartiesInvolved); err != nil {
+		return nil, err
+	}
+
+	var incompatErr error
+	err := k.IterateSessions(ctx, scope.ScopeId, func(session types.Session) bool {
+		if !allowedCSpecs[string(session.SpecificationId)] {
+			incompatErr = fmt.Errorf("session %s contract specification %s is not allowed by scope specification %s",
+				session.SessionId, session.SpecificationId, newSpec.SpecificationId)
+		}
+		return incompatErr != nil
+	})
+	if err == nil && incompatErr == nil {
+		err = k.IterateRecords(ctx, scope.ScopeId, func(record types.Record) bool {
+			if _, found = k.GetRecordSpecification(ctx, record.SpecificationId); !found {
+				incompatErr = fmt.Errorf("record %s record specification %s not found",
+					record.GetRecordAddress(), record.SpecificationId)
+			}
+			return incompatErr != nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if incompatErr != nil {
+		return nil, incompatErr
+	}
+
+	// Make sure everyone has signed.
+	if !scope.RequirePartyRollup {
+		return k.validateAllRequiredSigned(ctx, scope.GetAllOwnerAddresses(), msg)
+	}
+	return k.validateAllRequiredPartiesSigned(ctx, scope.Owners, scope.Owners, curSpec.PartiesInvolved, msg)
+}
+
+// isScopeSpecSuccessor returns true if the spec's chain of previous specs leads to the one with the provided id.
+func (k Keeper) isScopeSpecSuccessor(ctx sdk.Context, spec types.ScopeSpecification, ancestorID types.MetadataAddress) bool {
+	seen := make(map[string]bool)
+	for previousID := spec.GetPreviousSpecID(); len(previousID) > 0 && !seen[string(previousID)]; previousID = spec.GetPreviousSpecID() {
+		if previousID.Equals(ancestorID) {
+			return true
+		}
+		seen[string(previousID)] = true
+		var found bool
+		spec, found = k.GetScopeSpecification(ctx, previousID)
+		if !found {
+			return false
+		}
+	}
+	return false
+}
+
 // AddSetNetAssetValues adds a set of net asset values to a scope
 func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, scopeID types.MetadataAddress, netAssetValues []types.NetAssetValue, source string) error {
 	for _, nav := range netAssetValues {
@@ -871,9 +1181,10 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, scopeID types.MetadataAddress,
 		return err
 	}
 
-	// Since this field was added we need to ensure the default value matches the previous behavior of always presuming one is used.
+	// Since this field was added we need to ensure the default value matches the previous behavior of always presuming
+	// the whole scope is used. If the scope's value has been split, that's all of its units.
 	if netAssetValue.Volume < 1 {
-		netAssetValue.Volume = 1
+		netAssetValue.Volume = k.GetScopeTotalValueUnits(ctx, scopeID)
 	}
 
 	setNetAssetValueEvent := types.NewEventSetNetAssetValue(scopeID, netAssetValue.Price, netAssetValue.Volume, source)
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// AddScopeChange appends an entry to a scope's change log, then prunes its oldest entries so that
+// no more than the max_scope_history_entries param are kept. Nothing is recorded if that param is zero.
+func (k Keeper) AddScopeChange(ctx sdk.Context, scopeID types.MetadataAddress, msg types.MetadataMsg, changes []string) {
+	maxEntries := uint64(k.GetParams(ctx).MaxScopeHistoryEntries)
+	if maxEntries == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	sequence := k.getLastScopeChangeSequence(store, scopeID) + 1
+	change := types.NewScopeChange(scopeID, sequence, sdk.MsgTypeURL(msg), msg.GetSignerStrs(), ctx.BlockHeight(), changes)
+	store.Set(types.ScopeHistoryKey(scopeID, sequence), k.cdc.MustMarshal(&change))
+
+	if sequence > maxEntries {
+		k.pruneScopeHistory(store, scopeID, sequence-maxEntries)
+	}
+}
+
+// SetScopeChange stores a scope change log entry as is.
+func (k Keeper) SetScopeChange(ctx sdk.Context, change types.ScopeChange) error {
+	if err := change.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ScopeHistoryKey(change.ScopeId, change.Sequence), bz)
+	return nil
+}
+
+// IterateScopeHistory iterates over the change log entries of all scopes with the given handler function.
+func (k Keeper) IterateScopeHistory(ctx sdk.Context, handler func(change types.ScopeChange) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeHistoryPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.ScopeChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if handler(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// getLastScopeChangeSequence returns the sequence of the newest entry in a scope's change log, or 0 if it has none.
+func (k Keeper) getLastScopeChangeSequence(store storetypes.KVStore, scopeID types.MetadataAddress) uint64 {
+	it := storetypes.KVStoreReversePrefixIterator(store, types.ScopeHistoryKeyPrefix(scopeID))
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	key := it.Key()
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// pruneScopeHistory deletes the entries of a scope's change log that have a sequence up to and including the one given.
+func (k Keeper) pruneScopeHistory(store storetypes.KVStore, scopeID types.MetadataAddress, through uint64) {
+	it := store.Iterator(types.ScopeHistoryKeyPrefix(scopeID), types.ScopeHistoryKey(scopeID, through+1))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getScopeSnapshot returns a scope (with its value owner) for use in a change summary, or nil if it doesn't exist.
+func (k Keeper) getScopeSnapshot(ctx sdk.Context, scopeID types.MetadataAddress) *types.Scope {
+	scope, found := k.GetScopeWithValueOwner(ctx, scopeID)
+	if !found {
+		return nil
+	}
+	return &scope
+}
+
+// getScopeSnapshots returns a snapshot (see getScopeSnapshot) of each linked scope.
+func (k Keeper) getScopeSnapshots(ctx sdk.Context, links types.AccMDLinks) []*types.Scope {
+	rv := make([]*types.Scope, len(links))
+	for i, link := range links {
+		rv[i] = k.getScopeSnapshot(ctx, link.MDAddr)
+	}
+	return rv
+}
+
+// addScopeDiff records a change log entry for a scope describing how it differs from the provided previous version.
+func (k Keeper) addScopeDiff(ctx sdk.Context, scopeID types.MetadataAddress, before *types.Scope, msg types.MetadataMsg) {
+	k.AddScopeChange(ctx, scopeID, msg, types.ScopeDiff(before, k.getScopeSnapshot(ctx, scopeID)))
+}
+
+// netAssetValueChanges returns the change summary of setting some net asset values on a scope.
+func netAssetValueChanges(netAssetValues []types.NetAssetValue) []string {
+	rv := make([]string, len(netAssetValues))
+	for i, nav := range netAssetValues {
+		rv[i] = fmt.Sprintf("net asset value set: %s for volume %d", nav.Price, nav.Volume)
+	}
+	return rv
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *MsgServerTestSuite) TestScopeHistory() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "", false)
+
+	_, err := s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "WriteScopeSpecification")
+
+	getHistory := func(pageReq *query.PageRequest) []types.ScopeChange {
+		resp, qErr := s.app.MetadataKeeper.ScopeHistory(s.ctx, &types.ScopeHistoryRequest{ScopeId: scopeID.String(), Pagination: pageReq})
+		s.Require().NoError(qErr, "ScopeHistory")
+		return resp.Changes
+	}
+
+	_, err = s.msgServer.WriteScope(s.ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}, 0))
+	s.Require().NoError(err, "WriteScope")
+	_, err = s.msgServer.AddScopeDataAccess(s.ctx, types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}))
+	s.Require().NoError(err, "AddScopeDataAccess")
+	_, err = s.msgServer.AddScopeOwner(s.ctx, types.NewMsgAddScopeOwnerRequest(scopeID, ownerPartyList(s.user2), []string{s.user1}))
+	s.Require().NoError(err, "AddScopeOwner")
+
+	changes := getHistory(nil)
+	s.Require().Len(changes, 3, "scope history after three msgs")
+	s.Assert().Equal(uint64(1), changes[0].Sequence, "[0].Sequence")
+	s.Assert().Equal(types.TypeURLMsgWriteScopeRequest, changes[0].MsgTypeUrl, "[0].MsgTypeUrl")
+	s.Assert().Equal([]string{s.user1}, changes[0].Signers, "[0].Signers")
+	s.Assert().Equal(s.ctx.BlockHeight(), changes[0].BlockHeight, "[0].BlockHeight")
+	s.Assert().Equal("scope created", changes[0].Changes[0], "[0].Changes[0]")
+	s.Assert().Equal(types.TypeURLMsgAddScopeDataAccessRequest, changes[1].MsgTypeUrl, "[1].MsgTypeUrl")
+	s.Assert().Equal([]string{"data_access added: " + s.user2}, changes[1].Changes, "[1].Changes")
+	s.Assert().Equal(types.TypeURLMsgAddScopeOwnerRequest, changes[2].MsgTypeUrl, "[2].MsgTypeUrl")
+	s.Assert().Equal([]string{"owner added: " + ownerPartyList(s.user2)[0].String()}, changes[2].Changes, "[2].Changes")
+
+	newest := getHistory(&query.PageRequest{Limit: 1, Reverse: true})
+	s.Require().Len(newest, 1, "newest scope history entries")
+	s.Assert().Equal(uint64(3), newest[0].Sequence, "newest entry sequence")
+
+	s.Run("pruned down to max entries", func() {
+		params := s.app.MetadataKeeper.GetParams(s.ctx)
+		defer s.app.MetadataKeeper.SetParams(s.ctx, params)
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(2))
+
+		_, err = s.msgServer.DeleteScopeDataAccess(s.ctx, types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1, s.user2}))
+		s.Require().NoError(err, "DeleteScopeDataAccess")
+
+		changes = getHistory(nil)
+		s.Require().Len(changes, 2, "scope history after pruning")
+		s.Assert().Equal(uint64(3), changes[0].Sequence, "[0].Sequence")
+		s.Assert().Equal(uint64(4), changes[1].Sequence, "[1].Sequence")
+		s.Assert().Equal([]string{"data_access removed: " + s.user2}, changes[1].Changes, "[1].Changes")
+	})
+
+	s.Run("not recorded when disabled", func() {
+		params := s.app.MetadataKeeper.GetParams(s.ctx)
+		defer s.app.MetadataKeeper.SetParams(s.ctx, params)
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0))
+
+		_, err = s.msgServer.DeleteScope(s.ctx, types.NewMsgDeleteScopeRequest(scopeID, []string{s.user1, s.user2}))
+		s.Require().NoError(err, "DeleteScope")
+
+		changes = getHistory(nil)
+		s.Assert().Len(changes, 2, "scope history after delete while disabled")
+	})
+}
+
+func (s *MsgServerTestSuite) TestUpdateParams() {
+	authority := s.app.MetadataKeeper.GetAuthority()
+
+	s.Run("wrong authority", func() {
+		_, err := s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParamsRequest(s.user1, 5))
+		s.Assert().ErrorContains(err, fmt.Sprintf("expected %q got %q", authority, s.user1), "UpdateParams")
+		s.Assert().Equal(types.DefaultParams(), s.app.MetadataKeeper.GetParams(s.ctx), "params after failed update")
+	})
+
+	s.Run("correct authority", func() {
+		_, err := s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParamsRequest(authority, 5))
+		s.Require().NoError(err, "UpdateParams")
+		s.Assert().Equal(types.NewParams(5), s.app.MetadataKeeper.GetParams(s.ctx), "params after update")
+	})
+}
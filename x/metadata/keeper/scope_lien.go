@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeLien returns the lien on a scope (if it has one).
+// The lien is returned even if it is no longer in effect.
+func (k Keeper) GetScopeLien(ctx sdk.Context, scopeID types.MetadataAddress) (lien types.ScopeLien, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeLienKey(scopeID))
+	if len(bz) == 0 {
+		return lien, false
+	}
+	k.cdc.MustUnmarshal(bz, &lien)
+	return lien, true
+}
+
+// GetScopeLienInEffect returns the lien on a scope if it has one that is in effect, or nil if it doesn't.
+func (k Keeper) GetScopeLienInEffect(ctx sdk.Context, scopeID types.MetadataAddress) *types.ScopeLien {
+	lien, found := k.GetScopeLien(ctx, scopeID)
+	if !found || !lien.InEffect(ctx.BlockTime()) {
+		return nil
+	}
+	return &lien
+}
+
+// SetScopeLien stores a lien on a scope, replacing any lien the scope already has.
+func (k Keeper) SetScopeLien(ctx sdk.Context, lien types.ScopeLien) error {
+	if err := lien.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&lien)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetScopeLien(ctx, lien.ScopeId); found {
+		k.removeScopeLienIndex(store, existing)
+	}
+	store.Set(types.ScopeLienKey(lien.ScopeId), bz)
+	lienholder := sdk.MustAccAddressFromBech32(lien.Lienholder)
+	store.Set(types.GetLienholderScopeCacheKey(lienholder, lien.ScopeId), []byte{0x01})
+	return nil
+}
+
+// RemoveScopeLien deletes the lien on a scope (if it has one).
+func (k Keeper) RemoveScopeLien(ctx sdk.Context, scopeID types.MetadataAddress) {
+	lien, found := k.GetScopeLien(ctx, scopeID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	k.removeScopeLienIndex(store, lien)
+	store.Delete(types.ScopeLienKey(scopeID))
+}
+
+// removeScopeLienIndex deletes the lienholder index entry of the provided lien.
+func (k Keeper) removeScopeLienIndex(store storetypes.KVStore, lien types.ScopeLien) {
+	if lienholder, err := sdk.AccAddressFromBech32(lien.Lienholder); err == nil {
+		store.Delete(types.GetLienholderScopeCacheKey(lienholder, lien.ScopeId))
+	}
+}
+
+// IterateScopeLiens iterates over all scope liens with the given handler function.
+func (k Keeper) IterateScopeLiens(ctx sdk.Context, handler func(lien types.ScopeLien) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeLienKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var lien types.ScopeLien
+		if err := k.cdc.Unmarshal(it.Value(), &lien); err != nil {
+			return err
+		}
+		if handler(lien) {
+			break
+		}
+	}
+	return nil
+}
+
+// validateNoScopeLien returns an error if the scope has a lien that is in effect.
+func (k Keeper) validateNoScopeLien(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if lien := k.GetScopeLienInEffect(ctx, scopeID); lien != nil {
+		return fmt.Errorf("scope %s has a lien held by %s", scopeID, lien.Lienholder)
+	}
+	return nil
+}
+
+// ValidateAddScopeLien checks that the lien in the provided msg can be put on its scope.
+// The signers must be the same as those needed to delete the scope.
+func (k Keeper) ValidateAddScopeLien(ctx sdk.Context, msg *types.MsgAddScopeLienRequest) error {
+	scope, found := k.GetScope(ctx, msg.Lien.ScopeId)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", msg.Lien.ScopeId)
+	}
+	if err := k.validateNoScopeLien(ctx, scope.ScopeId); err != nil {
+		return err
+	}
+	if msg.Lien.ReleaseTime != nil && !msg.Lien.ReleaseTime.After(ctx.BlockTime()) {
+		return fmt.Errorf("lien release time %s must be after the current block time %s",
+			msg.Lien.ReleaseTime.UTC(), ctx.BlockTime().UTC())
+	}
+	_, err := k.validateScopeOwnersSigned(ctx, scope, msg)
+	return err
+}
+
+// ValidateReleaseScopeLien checks that the lienholder has signed the provided msg.
+// Returns the lien being released.
+func (k Keeper) ValidateReleaseScopeLien(ctx sdk.Context, msg *types.MsgReleaseScopeLienRequest) (*types.ScopeLien, error) {
+	lien, found := k.GetScopeLien(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope %s does not have a lien", msg.ScopeId)
+	}
+	if err := k.ValidateSignersWithoutParties(ctx, []string{lien.Lienholder}, msg); err != nil {
+		return nil, err
+	}
+	return &lien, nil
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
+
+// SendRestrictionFn prevents scope value owner coins from being moved while their scope has a lien in effect.
+func (k Keeper) SendRestrictionFn(goCtx context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, scopeDenomPrefix) {
+			continue
+		}
+		scopeID, err := types.MetadataAddressFromDenom(coin.Denom)
+		if err != nil {
+			continue
+		}
+		if err = k.validateNoScopeLien(ctx, scopeID); err != nil {
+			return nil, fmt.Errorf("cannot send %s: %w", coin.Denom, err)
+		}
+	}
+	return toAddr, nil
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeValueUnits returns the value units of a scope (if its value has been split into multiple units).
+func (k Keeper) GetScopeValueUnits(ctx sdk.Context, scopeID types.MetadataAddress) (units types.ScopeValueUnits, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScopeValueUnitsKey(scopeID))
+	if len(bz) == 0 {
+		return units, false
+	}
+	k.cdc.MustUnmarshal(bz, &units)
+	return units, true
+}
+
+// GetScopeTotalValueUnits returns the total number of value units that a scope has.
+// This is one unless the scope's value has been split into multiple units.
+func (k Keeper) GetScopeTotalValueUnits(ctx sdk.Context, scopeID types.MetadataAddress) uint64 {
+	if units, found := k.GetScopeValueUnits(ctx, scopeID); found {
+		return units.Units
+	}
+	return 1
+}
+
+// SetScopeValueUnits stores the value units of a scope.
+// This only records the units; it does not mint or move any of the scope's value owner coins.
+func (k Keeper) SetScopeValueUnits(ctx sdk.Context, units types.ScopeValueUnits) error {
+	if err := units.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&units)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ScopeValueUnitsKey(units.ScopeId), bz)
+	return nil
+}
+
+// RemoveScopeValueUnits deletes the value units of a scope (if it has them).
+func (k Keeper) RemoveScopeValueUnits(ctx sdk.Context, scopeID types.MetadataAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ScopeValueUnitsKey(scopeID))
+}
+
+// IterateScopeValueUnits iterates over the value units of all scopes with fractional value ownership.
+func (k Keeper) IterateScopeValueUnits(ctx sdk.Context, handler func(units types.ScopeValueUnits) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeValueUnitsKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var units types.ScopeValueUnits
+		if err := k.cdc.Unmarshal(it.Value(), &units); err != nil {
+			return err
+		}
+		if handler(units) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetScopeValueHolders gets each account that holds some of a scope's value units, and how many they hold.
+func (k Keeper) GetScopeValueHolders(ctx sdk.Context, scopeID types.MetadataAddress) ([]types.ScopeValueHolder, error) {
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("cannot get value holders for non-scope metadata address %q", scopeID)
+	}
+	owners, err := k.bankKeeper.DenomHolders(ctx, scopeID.Denom())
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]types.ScopeValueHolder, len(owners))
+	for i, owner := range owners {
+		rv[i] = types.NewScopeValueHolder(owner.Address, owner.Balance.Amount.Uint64())
+	}
+	return rv, nil
+}
+
+// getScopeValueHolderAddrs gets the addresses of the accounts that hold some of a scope's value units.
+func (k Keeper) getScopeValueHolderAddrs(ctx sdk.Context, scopeID types.MetadataAddress) ([]sdk.AccAddress, error) {
+	holders, err := k.GetScopeValueHolders(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]sdk.AccAddress, 0, len(holders))
+	for _, holder := range holders {
+		addr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value holder address %q: %w", holder.Address, err)
+		}
+		rv = append(rv, addr)
+	}
+	return rv, nil
+}
+
+// validateScopeValueNotSplit returns an error if a scope's value units are held by more than one account.
+// The scope then doesn't have a single value owner, and each holder has to move their own units.
+func (k Keeper) validateScopeValueNotSplit(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if _, found := k.GetScopeValueUnits(ctx, scopeID); !found {
+		return nil
+	}
+	holders, err := k.bankKeeper.DenomHolders(ctx, scopeID.Denom())
+	if err != nil {
+		return fmt.Errorf("could not get current value holders of %q: %w", scopeID, err)
+	}
+	if len(holders) > 1 {
+		return fmt.Errorf("scope %s value is split among %d holders", scopeID, len(holders))
+	}
+	return nil
+}
+
+// setFractionalScopeValueOwner moves all the value units of a scope to the provided address.
+// If doBurn is true, the units are burned once they've been moved (toAddr should be the module account in that case).
+// Unless burning them, the units must all be held by a single account.
+func (k Keeper) setFractionalScopeValueOwner(ctx sdk.Context, units types.ScopeValueUnits, toAddr sdk.AccAddress, doBurn bool) error {
+	holders, err := k.bankKeeper.DenomHolders(ctx, units.ScopeId.Denom())
+	if err != nil {
+		return fmt.Errorf("could not get current value holders of %q: %w", units.ScopeId, err)
+	}
+	if len(holders) > 1 && !doBurn {
+		return fmt.Errorf("cannot change the value owner of scope %s: its value is split among %d holders", units.ScopeId, len(holders))
+	}
+
+	if len(holders) == 0 {
+		if doBurn {
+			// Nothing to move or burn.
+			return nil
+		}
+		// If no one holds any of the units, we'll mint them and send them from the module account.
+		coins := sdk.Coins{units.Coin()}
+		if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("could not mint scope coins %q: %w", coins, err)
+		}
+		if err = k.bankKeeper.SendCoins(ctx, k.moduleAddr, toAddr, coins); err != nil {
+			return fmt.Errorf("could not send scope coins %q from %s to %s: %w", coins, k.moduleAddr, toAddr, err)
+		}
+		return nil
+	}
+
+	for _, holder := range holders {
+		fromAddr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			return fmt.Errorf("invalid value holder address %q: %w", holder.Address, err)
+		}
+		if fromAddr.Equals(toAddr) {
+			continue
+		}
+		coins := sdk.Coins{holder.Balance}
+		if err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, coins); err != nil {
+			return fmt.Errorf("could not send scope coins %q from %s to %s: %w", coins, fromAddr, toAddr, err)
+		}
+	}
+
+	if doBurn {
+		coins := sdk.Coins{units.Coin()}
+		if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("could not burn scope coins %q: %w", coins, err)
+		}
+	}
+
+	return nil
+}
+
+// rescaleNetAssetValues multiplies the volume of each of a scope's NAVs by the number of units its value is split into.
+// The price of a NAV stays the price of the same share of the scope, which is now made up of that many units.
+func (k Keeper) rescaleNetAssetValues(ctx sdk.Context, scopeID types.MetadataAddress, units uint64) error {
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) bool {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("could not read net asset values of %q: %w", scopeID, err)
+	}
+	for _, nav := range navs {
+		// NAVs written before the Volume field was added are for a single unit.
+		if nav.Volume < 1 {
+			nav.Volume = 1
+		}
+		if nav.Volume > math.MaxUint64/units {
+			return fmt.Errorf("cannot split the value of scope %s into %d units: its net asset value volume %d in %s would overflow",
+				scopeID, units, nav.Volume, nav.Price.Denom)
+		}
+		nav.Volume *= units
+		if err = k.SetNetAssetValueWithBlockHeight(ctx, scopeID, nav, types.ModuleName, nav.UpdatedBlockHeight); err != nil {
+			return fmt.Errorf("could not update net asset value of %q in %s: %w", scopeID, nav.Price.Denom, err)
+		}
+	}
+	return nil
+}
+
+// ValidateFractionalizeScopeValue checks that the value of the msg's scope can be split into the msg's units,
+// and that the scope's value owner has signed. Returns the value owner that will receive the units.
+func (k Keeper) ValidateFractionalizeScopeValue(ctx sdk.Context, msg *types.MsgFractionalizeScopeValueRequest) (sdk.AccAddress, error) {
+	if _, found := k.GetScope(ctx, msg.ScopeId); !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	if units, found := k.GetScopeValueUnits(ctx, msg.ScopeId); found {
+		return nil, fmt.Errorf("scope %s value is already split into %d units", msg.ScopeId, units.Units)
+	}
+	if err := k.validateNoScopeLien(ctx, msg.ScopeId); err != nil {
+		return nil, err
+	}
+
+	valueOwner, err := k.GetScopeValueOwner(ctx, msg.ScopeId)
+	if err != nil {
+		return nil, fmt.Errorf("error identifying current value owner of %q: %w", msg.ScopeId, err)
+	}
+	if len(valueOwner) == 0 {
+		return nil, fmt.Errorf("scope %s does not have a value owner", msg.ScopeId)
+	}
+
+	if _, _, err = k.ValidateScopeValueOwnersSigners(ctx, []sdk.AccAddress{valueOwner}, "", msg); err != nil {
+		return nil, err
+	}
+	return valueOwner, nil
+}
+
+// SplitScopeValue splits the value of a scope into the provided number of units.
+// The value owner already holds one unit (the scope's value owner coin), so the rest are minted and given to them.
+func (k Keeper) SplitScopeValue(ctx sdk.Context, scopeID types.MetadataAddress, units uint64, valueOwner sdk.AccAddress) error {
+	scopeUnits := types.NewScopeValueUnits(scopeID, units)
+	if err := k.SetScopeValueUnits(ctx, scopeUnits); err != nil {
+		return err
+	}
+	if err := k.rescaleNetAssetValues(ctx, scopeID, units); err != nil {
+		return err
+	}
+
+	coins := sdk.Coins{scopeUnits.Coin().Sub(scopeID.Coin())}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return fmt.Errorf("could not mint scope coins %q: %w", coins, err)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, k.moduleAddr, valueOwner, coins); err != nil {
+		return fmt.Errorf("could not send scope coins %q from %s to %s: %w", coins, k.moduleAddr, valueOwner, err)
+	}
+	return nil
+}
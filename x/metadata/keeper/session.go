@@ -139,6 +139,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 	if !found {
 		return fmt.Errorf("cannot find contract specification %s", proposed.SpecificationId)
 	}
+	if existing == nil && contractSpec.Deprecated {
+		return fmt.Errorf("contract specification %s is deprecated", proposed.SpecificationId)
+	}
 
 	scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
 	if !found {
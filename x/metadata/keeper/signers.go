@@ -65,6 +65,21 @@ func (k Keeper) validateAllRequiredPartiesSigned(
 	reqParties, availableParties []types.Party,
 	reqRoles []types.PartyType,
 	msg types.MetadataMsg,
+) ([]*types.PartyDetails, error) {
+	if cache := getBulkCache(ctx); cache != nil {
+		return cache.getSigners(partiesSignedKey(reqParties, availableParties, reqRoles), func() ([]*types.PartyDetails, error) {
+			return k.checkAllRequiredPartiesSigned(ctx, reqParties, availableParties, reqRoles, msg)
+		})
+	}
+	return k.checkAllRequiredPartiesSigned(ctx, reqParties, availableParties, reqRoles, msg)
+}
+
+// checkAllRequiredPartiesSigned does the checks of validateAllRequiredPartiesSigned without using a bulkCache.
+func (k Keeper) checkAllRequiredPartiesSigned(
+	ctx sdk.Context,
+	reqParties, availableParties []types.Party,
+	reqRoles []types.PartyType,
+	msg types.MetadataMsg,
 ) ([]*types.PartyDetails, error) {
 	parties := types.BuildPartyDetails(reqParties, availableParties)
 	signers := NewSignersWrapper(msg.GetSignerStrs())
@@ -200,6 +215,14 @@ func getAuthzMessageTypeURLs(msgTypeURL string) []string {
 		urls = append(urls, types.TypeURLMsgWriteScopeRequest)
 	case types.TypeURLMsgWriteRecordRequest:
 		urls = append(urls, types.TypeURLMsgWriteSessionRequest)
+	case types.TypeURLMsgWriteScopesRequest:
+		urls = append(urls, types.TypeURLMsgWriteScopeRequest)
+	case types.TypeURLMsgDeleteScopesRequest:
+		urls = append(urls, types.TypeURLMsgDeleteScopeRequest)
+	case types.TypeURLMsgWriteRecordsRequest:
+		urls = append(urls, types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest)
+	case types.TypeURLMsgDeleteRecordsRequest:
+		urls = append(urls, types.TypeURLMsgDeleteRecordRequest)
 	case types.TypeURLMsgAddContractSpecToScopeSpecRequest, types.TypeURLMsgDeleteContractSpecFromScopeSpecRequest:
 		urls = append(urls, types.TypeURLMsgWriteScopeSpecificationRequest)
 	case types.TypeURLMsgWriteRecordSpecificationRequest:
@@ -229,6 +252,7 @@ func (k Keeper) findAuthzGrantee(
 		for _, msgType := range msgTypes {
 			prevAuth := cache.GetAcceptable(grantee, granter, msgType)
 			if prevAuth != nil {
+				markAuthzUsed(ctx)
 				return grantee, nil
 			}
 			authorization, exp := k.authzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
@@ -249,6 +273,7 @@ func (k Keeper) findAuthzGrantee(
 						}
 					}
 					cache.SetAcceptable(grantee, granter, msgType, authorization)
+					markAuthzUsed(ctx)
 					return grantee, nil
 				}
 			}
@@ -373,6 +398,17 @@ func (k Keeper) isWasmAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
 // The usedSigners map has bech32 keys and value indicating whether that address was
 // used as a signer in some capacity (e.g. they're a party).
 func (k Keeper) validateSmartContractSigners(ctx sdk.Context, usedSigners types.UsedSignersMap, msg types.MetadataMsg) error {
+	if cache := getBulkCache(ctx); cache != nil {
+		_, err := cache.getSigners(smartContractSignersKey(usedSigners), func() ([]*types.PartyDetails, error) {
+			return nil, k.checkSmartContractSigners(ctx, usedSigners, msg)
+		})
+		return err
+	}
+	return k.checkSmartContractSigners(ctx, usedSigners, msg)
+}
+
+// checkSmartContractSigners does the checks of validateSmartContractSigners without using a bulkCache.
+func (k Keeper) checkSmartContractSigners(ctx sdk.Context, usedSigners types.UsedSignersMap, msg types.MetadataMsg) error {
 	// When a smart contract is a signer, they must either be used as a signer
 	// already, or must be authorized by all signers after it.
 	// The wasm encoders (hopefully) put the smart contract as the first signer
@@ -538,6 +574,16 @@ func (k Keeper) ValidateSignersWithoutParties(
 //
 // If you call this, you will probably also need to call validateSmartContractSigners on your own.
 func (k Keeper) validateAllRequiredSigned(ctx sdk.Context, required []string, msg types.MetadataMsg) ([]*types.PartyDetails, error) {
+	if cache := getBulkCache(ctx); cache != nil {
+		return cache.getSigners(requiredSignedKey(required), func() ([]*types.PartyDetails, error) {
+			return k.checkAllRequiredSigned(ctx, required, msg)
+		})
+	}
+	return k.checkAllRequiredSigned(ctx, required, msg)
+}
+
+// checkAllRequiredSigned does the checks of validateAllRequiredSigned without using a bulkCache.
+func (k Keeper) checkAllRequiredSigned(ctx sdk.Context, required []string, msg types.MetadataMsg) ([]*types.PartyDetails, error) {
 	details := make([]*types.PartyDetails, len(required))
 	for i, addr := range required {
 		details[i] = types.WrapRequiredParty(types.Party{
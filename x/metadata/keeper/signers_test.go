@@ -2480,6 +2480,8 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		},
 		newCase(types.TypeURLMsgWriteScopeRequest),
 		newCase(types.TypeURLMsgDeleteScopeRequest),
+		newCase(types.TypeURLMsgWriteScopesRequest, types.TypeURLMsgWriteScopeRequest),
+		newCase(types.TypeURLMsgDeleteScopesRequest, types.TypeURLMsgDeleteScopeRequest),
 		newCase(types.TypeURLMsgAddScopeDataAccessRequest, types.TypeURLMsgWriteScopeRequest),
 		newCase(types.TypeURLMsgDeleteScopeDataAccessRequest, types.TypeURLMsgWriteScopeRequest),
 		newCase(types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgWriteScopeRequest),
@@ -2489,6 +2491,8 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgDeleteRecordRequest),
+		newCase(types.TypeURLMsgWriteRecordsRequest, types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest),
+		newCase(types.TypeURLMsgDeleteRecordsRequest, types.TypeURLMsgDeleteRecordRequest),
 		newCase(types.TypeURLMsgWriteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgDeleteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgWriteContractSpecificationRequest),
@@ -358,6 +358,10 @@ func (k Keeper) ValidateWriteContractSpecification(_ sdk.Context, existing *type
 		return fmt.Errorf("cannot update contract spec identifier. expected %s, got %s",
 			existing.SpecificationId, proposed.SpecificationId)
 	}
+	if existing != nil && proposed.Version < existing.Version {
+		return fmt.Errorf("cannot decrease contract spec version. expected >= %d, got %d",
+			existing.Version, proposed.Version)
+	}
 
 	return nil
 }
@@ -592,6 +596,27 @@ func (k Keeper) ValidateWriteScopeSpecification(ctx sdk.Context, existing *types
 		return fmt.Errorf("cannot update scope spec identifier. expected %s, got %s",
 			existing.SpecificationId, proposed.SpecificationId)
 	}
+	if existing != nil && proposed.Version < existing.Version {
+		return fmt.Errorf("cannot decrease scope spec version. expected >= %d, got %d",
+			existing.Version, proposed.Version)
+	}
+
+	// The previous spec can be set once, but not changed. It must exist and have a lower version.
+	previousSpecID := proposed.GetPreviousSpecID()
+	if existing != nil && len(existing.GetPreviousSpecID()) > 0 && !existing.GetPreviousSpecID().Equals(previousSpecID) {
+		return fmt.Errorf("cannot update scope spec previous spec id. expected %s, got %s",
+			existing.GetPreviousSpecID(), previousSpecID)
+	}
+	if len(previousSpecID) > 0 {
+		previous, found := k.GetScopeSpecification(ctx, previousSpecID)
+		if !found {
+			return fmt.Errorf("previous scope specification %s not found", previousSpecID)
+		}
+		if previous.Version >= proposed.Version {
+			return fmt.Errorf("scope spec version %d is not newer than previous scope spec %s version %d",
+				proposed.Version, previous.SpecificationId, previous.Version)
+		}
+	}
 
 	// Make sure newly added contract spec ids exist.
 	// If the spec is new, gotta check all of the contract spec ids.
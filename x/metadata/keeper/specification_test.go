@@ -1632,6 +1632,32 @@ func (s *SpecKeeperTestSuite) TestValidateWriteScopeSpecification() {
 
 	otherScopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
 	otherContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	previousSpec := types.NewScopeSpecification(
+		types.ScopeSpecMetadataAddress(uuid.New()),
+		nil,
+		[]string{s.user1Addr.String()},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		nil,
+	)
+	previousSpec.Version = 2
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *previousSpec)
+	// withPrevious returns a copy of a scope spec with the provided version and previous spec id.
+	withPrevious := func(spec *types.ScopeSpecification, version uint32, previousID types.MetadataAddress) *types.ScopeSpecification {
+		rv := *spec
+		rv.Version = version
+		rv.PreviousSpecId = nil
+		if len(previousID) > 0 {
+			rv.PreviousSpecId = &previousID
+		}
+		return &rv
+	}
+	baseSpec := types.NewScopeSpecification(
+		s.scopeSpecID,
+		nil,
+		[]string{s.user1Addr.String()},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		[]types.MetadataAddress{s.contractSpecID1},
+	)
 	tests := []struct {
 		name     string
 		existing *types.ScopeSpecification
@@ -1748,6 +1774,42 @@ func (s *SpecKeeperTestSuite) TestValidateWriteScopeSpecification() {
 			),
 			"",
 		},
+		{
+			"new with previous spec - ok",
+			nil,
+			withPrevious(baseSpec, 3, previousSpec.SpecificationId),
+			"",
+		},
+		{
+			"setting previous spec on existing - ok",
+			withPrevious(baseSpec, 3, nil),
+			withPrevious(baseSpec, 3, previousSpec.SpecificationId),
+			"",
+		},
+		{
+			"unknown previous spec - error",
+			nil,
+			withPrevious(baseSpec, 3, otherScopeSpecID),
+			fmt.Sprintf("previous scope specification %s not found", otherScopeSpecID),
+		},
+		{
+			"previous spec not older - error",
+			nil,
+			withPrevious(baseSpec, 2, previousSpec.SpecificationId),
+			fmt.Sprintf("scope spec version 2 is not newer than previous scope spec %s version 2", previousSpec.SpecificationId),
+		},
+		{
+			"changing previous spec - error",
+			withPrevious(baseSpec, 3, previousSpec.SpecificationId),
+			withPrevious(baseSpec, 3, otherScopeSpecID),
+			fmt.Sprintf("cannot update scope spec previous spec id. expected %s, got %s", previousSpec.SpecificationId, otherScopeSpecID),
+		},
+		{
+			"removing previous spec - error",
+			withPrevious(baseSpec, 3, previousSpec.SpecificationId),
+			withPrevious(baseSpec, 3, nil),
+			fmt.Sprintf("cannot update scope spec previous spec id. expected %s, got ", previousSpec.SpecificationId),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1765,6 +1827,7 @@ func (s *SpecKeeperTestSuite) TestValidateWriteScopeSpecification() {
 	// So just to be on the safe side...
 	store.Delete(s.contractSpecID1)
 	store.Delete(s.contractSpecID2)
+	s.Require().NoError(s.app.MetadataKeeper.RemoveScopeSpecification(ctx, previousSpec.SpecificationId), "RemoveScopeSpecification")
 }
 
 func (s *SpecKeeperTestSuite) TestScopeSpecIndexing() {
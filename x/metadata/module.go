@@ -125,6 +125,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3To4); err != nil {
 		panic(fmt.Sprintf("failed to register x/metadata migration from version 3 to 4: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
+		panic(fmt.Sprintf("failed to register x/metadata migration from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the metadata module. It returns no validator updates.
@@ -167,4 +170,4 @@ func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.Weig
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
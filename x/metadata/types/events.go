@@ -10,19 +10,27 @@ import (
 type TxEndpoint string
 
 const (
-	TxEndpoint_WriteScope            TxEndpoint = "WriteScope"
-	TxEndpoint_DeleteScope           TxEndpoint = "DeleteScope"
-	TxEndpoint_AddScopeDataAccess    TxEndpoint = "AddScopeDataAccess"
-	TxEndpoint_DeleteScopeDataAccess TxEndpoint = "DeleteScopeDataAccess"
-	TxEndpoint_AddScopeOwner         TxEndpoint = "AddScopeOwner"
-	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
-	TxEndpoint_UpdateValueOwners     TxEndpoint = "UpdateValueOwners"
-	TxEndpoint_MigrateValueOwner     TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_WriteScope                TxEndpoint = "WriteScope"
+	TxEndpoint_DeleteScope               TxEndpoint = "DeleteScope"
+	TxEndpoint_WriteScopes               TxEndpoint = "WriteScopes"
+	TxEndpoint_DeleteScopes              TxEndpoint = "DeleteScopes"
+	TxEndpoint_AddScopeDataAccess        TxEndpoint = "AddScopeDataAccess"
+	TxEndpoint_DeleteScopeDataAccess     TxEndpoint = "DeleteScopeDataAccess"
+	TxEndpoint_AddScopeOwner             TxEndpoint = "AddScopeOwner"
+	TxEndpoint_DeleteScopeOwner          TxEndpoint = "DeleteScopeOwner"
+	TxEndpoint_UpdateValueOwners         TxEndpoint = "UpdateValueOwners"
+	TxEndpoint_MigrateValueOwner         TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_MigrateScopeSpecification TxEndpoint = "MigrateScopeSpecification"
+	TxEndpoint_AddScopeLien              TxEndpoint = "AddScopeLien"
+	TxEndpoint_ReleaseScopeLien          TxEndpoint = "ReleaseScopeLien"
+	TxEndpoint_FractionalizeScopeValue   TxEndpoint = "FractionalizeScopeValue"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
-	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
-	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
+	TxEndpoint_WriteRecord   TxEndpoint = "WriteRecord"
+	TxEndpoint_DeleteRecord  TxEndpoint = "DeleteRecord"
+	TxEndpoint_WriteRecords  TxEndpoint = "WriteRecords"
+	TxEndpoint_DeleteRecords TxEndpoint = "DeleteRecords"
 
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
@@ -39,6 +47,8 @@ const (
 	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
 	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
 	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+
+	TxEndpoint_UpdateParams TxEndpoint = "UpdateParams"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []string) *EventTxCompleted {
@@ -67,6 +77,36 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeSpecificationMigrated(scopeID, fromSpecID, toSpecID MetadataAddress) *EventScopeSpecificationMigrated {
+	return &EventScopeSpecificationMigrated{
+		ScopeAddr:             scopeID.String(),
+		FromSpecificationAddr: fromSpecID.String(),
+		ToSpecificationAddr:   toSpecID.String(),
+	}
+}
+
+func NewEventScopeLienAdded(scopeID MetadataAddress, lienholder string) *EventScopeLienAdded {
+	return &EventScopeLienAdded{
+		ScopeAddr:  scopeID.String(),
+		Lienholder: lienholder,
+	}
+}
+
+func NewEventScopeLienReleased(scopeID MetadataAddress, lienholder string) *EventScopeLienReleased {
+	return &EventScopeLienReleased{
+		ScopeAddr:  scopeID.String(),
+		Lienholder: lienholder,
+	}
+}
+
+func NewEventScopeValueFractionalized(scopeID MetadataAddress, valueOwner string, units uint64) *EventScopeValueFractionalized {
+	return &EventScopeValueFractionalized{
+		ScopeAddr:  scopeID.String(),
+		ValueOwner: valueOwner,
+		Units:      strconv.FormatUint(units, 10),
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -168,21 +208,24 @@ func NewEventRecordSpecificationDeleted(recordSpecificationID MetadataAddress) *
 	}
 }
 
-func NewEventOSLocatorCreated(owner string) *EventOSLocatorCreated {
+func NewEventOSLocatorCreated(owner, name string) *EventOSLocatorCreated {
 	return &EventOSLocatorCreated{
 		Owner: owner,
+		Name:  name,
 	}
 }
 
-func NewEventOSLocatorUpdated(owner string) *EventOSLocatorUpdated {
+func NewEventOSLocatorUpdated(owner, name string) *EventOSLocatorUpdated {
 	return &EventOSLocatorUpdated{
 		Owner: owner,
+		Name:  name,
 	}
 }
 
-func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
+func NewEventOSLocatorDeleted(owner, name string) *EventOSLocatorDeleted {
 	return &EventOSLocatorDeleted{
 		Owner: owner,
+		Name:  name,
 	}
 }
 
@@ -224,6 +224,245 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventScopeSpecificationMigrated is an event message indicating a scope has been migrated to another scope
+// specification.
+type EventScopeSpecificationMigrated struct {
+	// scope_addr is the bech32 address string of the scope id that was migrated.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// from_specification_addr is the bech32 address string of the scope specification the scope used to have.
+	FromSpecificationAddr string `protobuf:"bytes,2,opt,name=from_specification_addr,json=fromSpecificationAddr,proto3" json:"from_specification_addr,omitempty"`
+	// to_specification_addr is the bech32 address string of the scope specification the scope now has.
+	ToSpecificationAddr string `protobuf:"bytes,3,opt,name=to_specification_addr,json=toSpecificationAddr,proto3" json:"to_specification_addr,omitempty"`
+}
+
+func (m *EventScopeSpecificationMigrated) Reset()         { *m = EventScopeSpecificationMigrated{} }
+func (m *EventScopeSpecificationMigrated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationMigrated) ProtoMessage()    {}
+func (*EventScopeSpecificationMigrated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{4}
+}
+func (m *EventScopeSpecificationMigrated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecificationMigrated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecificationMigrated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeSpecificationMigrated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationMigrated.Merge(m, src)
+}
+func (m *EventScopeSpecificationMigrated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecificationMigrated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationMigrated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeSpecificationMigrated proto.InternalMessageInfo
+
+func (m *EventScopeSpecificationMigrated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeSpecificationMigrated) GetFromSpecificationAddr() string {
+	if m != nil {
+		return m.FromSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventScopeSpecificationMigrated) GetToSpecificationAddr() string {
+	if m != nil {
+		return m.ToSpecificationAddr
+	}
+	return ""
+}
+
+// EventScopeLienAdded is an event message indicating a lien has been put on a scope.
+type EventScopeLienAdded struct {
+	// scope_addr is the bech32 address string of the scope id that the lien is on.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// lienholder is the bech32 address string of the account that holds the lien.
+	Lienholder string `protobuf:"bytes,2,opt,name=lienholder,proto3" json:"lienholder,omitempty"`
+}
+
+func (m *EventScopeLienAdded) Reset()         { *m = EventScopeLienAdded{} }
+func (m *EventScopeLienAdded) String() string { return proto.CompactTextString(m) }
+func (*EventScopeLienAdded) ProtoMessage()    {}
+func (*EventScopeLienAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{5}
+}
+func (m *EventScopeLienAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeLienAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeLienAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeLienAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeLienAdded.Merge(m, src)
+}
+func (m *EventScopeLienAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeLienAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeLienAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeLienAdded proto.InternalMessageInfo
+
+func (m *EventScopeLienAdded) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeLienAdded) GetLienholder() string {
+	if m != nil {
+		return m.Lienholder
+	}
+	return ""
+}
+
+// EventScopeLienReleased is an event message indicating a lien has been released from a scope.
+type EventScopeLienReleased struct {
+	// scope_addr is the bech32 address string of the scope id that the lien was on.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// lienholder is the bech32 address string of the account that held the lien.
+	Lienholder string `protobuf:"bytes,2,opt,name=lienholder,proto3" json:"lienholder,omitempty"`
+}
+
+func (m *EventScopeLienReleased) Reset()         { *m = EventScopeLienReleased{} }
+func (m *EventScopeLienReleased) String() string { return proto.CompactTextString(m) }
+func (*EventScopeLienReleased) ProtoMessage()    {}
+func (*EventScopeLienReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{6}
+}
+func (m *EventScopeLienReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeLienReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeLienReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeLienReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeLienReleased.Merge(m, src)
+}
+func (m *EventScopeLienReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeLienReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeLienReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeLienReleased proto.InternalMessageInfo
+
+func (m *EventScopeLienReleased) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeLienReleased) GetLienholder() string {
+	if m != nil {
+		return m.Lienholder
+	}
+	return ""
+}
+
+// EventScopeValueFractionalized is an event message indicating the value of a scope has been split into multiple units.
+type EventScopeValueFractionalized struct {
+	// scope_addr is the bech32 address string of the scope id that was split.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// value_owner is the bech32 address string of the account that received the units.
+	ValueOwner string `protobuf:"bytes,2,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+	// units is the total number of value units that the scope now has.
+	Units string `protobuf:"bytes,3,opt,name=units,proto3" json:"units,omitempty"`
+}
+
+func (m *EventScopeValueFractionalized) Reset()         { *m = EventScopeValueFractionalized{} }
+func (m *EventScopeValueFractionalized) String() string { return proto.CompactTextString(m) }
+func (*EventScopeValueFractionalized) ProtoMessage()    {}
+func (*EventScopeValueFractionalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{7}
+}
+func (m *EventScopeValueFractionalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeValueFractionalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeValueFractionalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeValueFractionalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeValueFractionalized.Merge(m, src)
+}
+func (m *EventScopeValueFractionalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeValueFractionalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeValueFractionalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeValueFractionalized proto.InternalMessageInfo
+
+func (m *EventScopeValueFractionalized) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeValueFractionalized) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+func (m *EventScopeValueFractionalized) GetUnits() string {
+	if m != nil {
+		return m.Units
+	}
+	return ""
+}
+
 // EventSessionCreated is an event message indicating a session has been created.
 type EventSessionCreated struct {
 	// session_addr is the bech32 address string of the session id that was created.
@@ -236,7 +475,7 @@ func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
 func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionCreated) ProtoMessage()    {}
 func (*EventSessionCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{4}
+	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
 func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +530,7 @@ func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
 func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionUpdated) ProtoMessage()    {}
 func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{5}
+	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
 func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +585,7 @@ func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
 func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventSessionDeleted) ProtoMessage()    {}
 func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{6}
+	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
 func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +642,7 @@ func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
 func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordCreated) ProtoMessage()    {}
 func (*EventRecordCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{7}
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
 }
 func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +706,7 @@ func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
 func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordUpdated) ProtoMessage()    {}
 func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{8}
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
 }
 func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +768,7 @@ func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
 func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordDeleted) ProtoMessage()    {}
 func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{9}
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
 }
 func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +822,7 @@ func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationCreated) ProtoMessage()    {}
 func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{10}
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
 func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +869,7 @@ func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
 func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
 func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +916,7 @@ func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
 func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
 func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +963,7 @@ func (m *EventContractSpecificationCreated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationCreated) ProtoMessage()    {}
 func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
 func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1010,7 @@ func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationUpdated) ProtoMessage()    {}
 func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
 func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1057,7 @@ func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContract
 func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationDeleted) ProtoMessage()    {}
 func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
 func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1107,7 @@ func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationCreated) ProtoMessage()    {}
 func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
 func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1164,7 @@ func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
 func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
 func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1221,7 @@ func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
 func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
 func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,13 +1268,15 @@ func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string
 type EventOSLocatorCreated struct {
 	// owner is the owner in the object store locator that was created.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was created.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
 func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorCreated) ProtoMessage()    {}
 func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
 func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,17 +1312,26 @@ func (m *EventOSLocatorCreated) GetOwner() string {
 	return ""
 }
 
+func (m *EventOSLocatorCreated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
 type EventOSLocatorUpdated struct {
 	// owner is the owner in the object store locator that was updated.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was updated.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
 func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorUpdated) ProtoMessage()    {}
 func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
 func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,17 +1367,26 @@ func (m *EventOSLocatorUpdated) GetOwner() string {
 	return ""
 }
 
+func (m *EventOSLocatorUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
 type EventOSLocatorDeleted struct {
 	// owner is the owner in the object store locator that was deleted.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was deleted.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
 func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorDeleted) ProtoMessage()    {}
 func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
 }
 func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,6 +1422,13 @@ func (m *EventOSLocatorDeleted) GetOwner() string {
 	return ""
 }
 
+func (m *EventOSLocatorDeleted) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // EventSetNetAssetValue event emitted when Net Asset Value for a scope is update or added
 type EventSetNetAssetValue struct {
 	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
@@ -1175,7 +1441,7 @@ func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
 func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*EventSetNetAssetValue) ProtoMessage()    {}
 func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{22}
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
 }
 func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,6 +1503,10 @@ func init() {
 	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
 	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
 	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeSpecificationMigrated)(nil), "provenance.metadata.v1.EventScopeSpecificationMigrated")
+	proto.RegisterType((*EventScopeLienAdded)(nil), "provenance.metadata.v1.EventScopeLienAdded")
+	proto.RegisterType((*EventScopeLienReleased)(nil), "provenance.metadata.v1.EventScopeLienReleased")
+	proto.RegisterType((*EventScopeValueFractionalized)(nil), "provenance.metadata.v1.EventScopeValueFractionalized")
 	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
 	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
 	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
@@ -1263,43 +1533,51 @@ func init() {
 }
 
 var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0xcd, 0x26, 0xd0, 0x36, 0x53, 0x0e, 0xb0, 0x40, 0xd8, 0x80, 0xd8, 0x7e, 0x70, 0xe9, 0xa5,
-	0x89, 0x0a, 0x1c, 0x10, 0x07, 0xa4, 0x12, 0x38, 0x20, 0x21, 0x40, 0x49, 0x01, 0xa9, 0x17, 0x70,
-	0xed, 0xa1, 0x58, 0x24, 0xeb, 0x95, 0xed, 0xa4, 0xe1, 0x5f, 0xf0, 0x07, 0xf8, 0x3f, 0x1c, 0x7b,
-	0xe4, 0x88, 0x92, 0x3f, 0x82, 0xd6, 0x1f, 0x64, 0xdb, 0xa4, 0x2c, 0x10, 0x0a, 0x3d, 0xbe, 0xf1,
-	0xbc, 0xf7, 0xc6, 0x6f, 0x27, 0x91, 0xe1, 0x56, 0x2a, 0xc5, 0x00, 0x13, 0x92, 0x50, 0x6c, 0xf6,
-	0x50, 0x13, 0x46, 0x34, 0x69, 0x0e, 0xb6, 0x9a, 0x38, 0xc0, 0x44, 0xab, 0x46, 0x2a, 0x85, 0x16,
-	0x61, 0x6d, 0xd2, 0xd4, 0xf0, 0x4d, 0x8d, 0xc1, 0xd6, 0xfa, 0x5b, 0xb8, 0xf8, 0x38, 0xeb, 0xdb,
-	0x19, 0xb6, 0x44, 0x2f, 0xed, 0xa2, 0x46, 0x16, 0xd6, 0x60, 0xa1, 0x27, 0x58, 0xbf, 0x8b, 0x51,
-	0xb0, 0x1a, 0x6c, 0x54, 0xdb, 0x0e, 0x85, 0xd7, 0x61, 0x09, 0x13, 0x96, 0x0a, 0x9e, 0xe8, 0xa8,
-	0x6c, 0x4e, 0x7e, 0xe0, 0x30, 0x82, 0x45, 0xc5, 0xf7, 0x13, 0x94, 0x2a, 0xaa, 0xac, 0x56, 0x36,
-	0xaa, 0x6d, 0x0f, 0xd7, 0x6f, 0xc3, 0x25, 0xe3, 0xd0, 0xa1, 0x22, 0xc5, 0x96, 0x44, 0x92, 0x59,
-	0xdc, 0x04, 0x50, 0x19, 0x7e, 0x43, 0x18, 0x93, 0xce, 0xa6, 0x6a, 0x2a, 0xdb, 0x8c, 0xc9, 0xa3,
-	0x9c, 0x97, 0x29, 0xfb, 0x6d, 0xce, 0x23, 0xb4, 0x57, 0x29, 0xe0, 0xbc, 0x86, 0xcb, 0x96, 0x83,
-	0x4a, 0x71, 0x91, 0xf8, 0xe9, 0xd6, 0xe0, 0x82, 0xb2, 0x95, 0x3c, 0x6f, 0xd9, 0xd5, 0x32, 0xe6,
-	0x31, 0xe1, 0x72, 0x81, 0xb0, 0xbf, 0xc2, 0x5f, 0x17, 0xf6, 0xf7, 0x9c, 0x5f, 0xf8, 0x00, 0x42,
-	0x23, 0xdc, 0x46, 0x2a, 0x24, 0xf3, 0x49, 0xac, 0xc0, 0xb2, 0x34, 0x85, 0xbc, 0x2c, 0xd8, 0x92,
-	0x51, 0x3d, 0x6e, 0x5c, 0x2e, 0x32, 0xae, 0xfc, 0xdc, 0xd8, 0x27, 0xf5, 0x0f, 0x8c, 0x77, 0x8e,
-	0x18, 0xfb, 0x24, 0x0b, 0x8d, 0x0b, 0x54, 0x77, 0x21, 0x9e, 0xac, 0x61, 0x27, 0x45, 0xca, 0xdf,
-	0x71, 0x4a, 0x74, 0x6e, 0xbb, 0xee, 0x41, 0x64, 0x05, 0x54, 0xfe, 0x34, 0x6f, 0x57, 0x53, 0x53,
-	0xe4, 0x02, 0x6d, 0x1f, 0xdb, 0x69, 0x68, 0xfb, 0x64, 0xfe, 0x5c, 0x9b, 0xc2, 0x9a, 0xd1, 0x6e,
-	0x89, 0x44, 0x4b, 0x42, 0xf5, 0xcc, 0x58, 0x1e, 0xc0, 0x0d, 0xea, 0xce, 0x4f, 0x76, 0xa8, 0xd3,
-	0x59, 0x12, 0xc5, 0x26, 0x3e, 0x9f, 0x53, 0x35, 0xf1, 0x41, 0xcd, 0x6b, 0xf2, 0x39, 0x80, 0x95,
-	0xdc, 0x66, 0xce, 0x4c, 0xeb, 0x3e, 0xd4, 0xdd, 0x9a, 0x9e, 0xe8, 0x70, 0x4d, 0x4e, 0xd3, 0xcd,
-	0x06, 0x17, 0xcc, 0x57, 0x9e, 0x67, 0x3e, 0x1f, 0xf4, 0x59, 0x9d, 0xcf, 0x7f, 0xa3, 0xff, 0x39,
-	0xdf, 0x26, 0x5c, 0x35, 0xe3, 0x3d, 0xef, 0x3c, 0x15, 0x94, 0x68, 0x21, 0xfd, 0x47, 0xbd, 0x02,
-	0xe7, 0xc5, 0x41, 0x82, 0x7e, 0x00, 0x0b, 0xa6, 0xdb, 0x7d, 0xc6, 0xbf, 0xd8, 0xee, 0xaf, 0x3c,
-	0xbb, 0x7d, 0xe8, 0xda, 0x3b, 0xa8, 0x9f, 0xa1, 0xde, 0x56, 0x0a, 0xf5, 0x2b, 0xd2, 0xed, 0x63,
-	0x58, 0x87, 0x25, 0xfb, 0x73, 0xe7, 0xcc, 0x31, 0x16, 0x0d, 0x7e, 0x62, 0x94, 0x52, 0xc9, 0x29,
-	0xba, 0xab, 0x5a, 0x90, 0x3d, 0x1b, 0x94, 0xe8, 0x4b, 0x8a, 0xee, 0x4f, 0xd1, 0xa1, 0xac, 0x3e,
-	0x10, 0xdd, 0x7e, 0x0f, 0xa3, 0x73, 0xb6, 0x6e, 0xd1, 0xc3, 0x0f, 0x5f, 0x46, 0x71, 0x70, 0x38,
-	0x8a, 0x83, 0x6f, 0xa3, 0x38, 0xf8, 0x34, 0x8e, 0x4b, 0x87, 0xe3, 0xb8, 0xf4, 0x75, 0x1c, 0x97,
-	0xa0, 0xce, 0x45, 0x63, 0xf6, 0x7b, 0xe5, 0x45, 0xb0, 0x7b, 0x77, 0x9f, 0xeb, 0xf7, 0xfd, 0xbd,
-	0x06, 0x15, 0xbd, 0xe6, 0xa4, 0x69, 0x93, 0x8b, 0x1c, 0x6a, 0x0e, 0x27, 0x2f, 0x21, 0xfd, 0x31,
-	0x45, 0xb5, 0xb7, 0x60, 0x9e, 0x41, 0x77, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd9, 0xf3,
-	0xfa, 0x2d, 0x09, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x5f, 0x4f, 0x13, 0x4d,
+	0x14, 0xc6, 0xd9, 0x96, 0xbf, 0x87, 0xf7, 0xe2, 0x7d, 0x17, 0x28, 0xed, 0x6b, 0x58, 0xa0, 0xde,
+	0x70, 0x63, 0x1b, 0xd0, 0x18, 0xe3, 0x85, 0x09, 0xa2, 0x26, 0x26, 0x28, 0xa6, 0x45, 0x49, 0xb8,
+	0xc1, 0x65, 0xf6, 0x00, 0x13, 0x77, 0x67, 0x36, 0x33, 0xb3, 0x05, 0xfd, 0x14, 0x7e, 0x01, 0xbf,
+	0x81, 0x1f, 0xc4, 0x4b, 0x2e, 0xbd, 0x34, 0xf0, 0x45, 0xcc, 0xce, 0xec, 0xb8, 0x2d, 0x2d, 0x2e,
+	0x5a, 0x51, 0xef, 0x7a, 0xce, 0xce, 0xf9, 0x3d, 0xa7, 0xcf, 0x9e, 0x9d, 0x19, 0xb8, 0x19, 0x0b,
+	0xde, 0x41, 0xe6, 0x33, 0x82, 0xcd, 0x08, 0x95, 0x1f, 0xf8, 0xca, 0x6f, 0x76, 0x56, 0x9b, 0xd8,
+	0x41, 0xa6, 0x64, 0x23, 0x16, 0x5c, 0x71, 0xb7, 0x92, 0x2f, 0x6a, 0xd8, 0x45, 0x8d, 0xce, 0x6a,
+	0xfd, 0x35, 0xfc, 0xfb, 0x38, 0x5d, 0xb7, 0x7d, 0xb2, 0xc1, 0xa3, 0x38, 0x44, 0x85, 0x81, 0x5b,
+	0x81, 0xf1, 0x88, 0x07, 0x49, 0x88, 0x55, 0x67, 0xc9, 0x59, 0x99, 0x6a, 0x65, 0x91, 0xfb, 0x3f,
+	0x4c, 0x22, 0x0b, 0x62, 0x4e, 0x99, 0xaa, 0x96, 0xf4, 0x93, 0x6f, 0xb1, 0x5b, 0x85, 0x09, 0x49,
+	0x0f, 0x19, 0x0a, 0x59, 0x2d, 0x2f, 0x95, 0x57, 0xa6, 0x5a, 0x36, 0xac, 0xaf, 0xc1, 0x7f, 0x5a,
+	0xa1, 0x4d, 0x78, 0x8c, 0x1b, 0x02, 0xfd, 0x54, 0x62, 0x01, 0x40, 0xa6, 0xf1, 0x9e, 0x1f, 0x04,
+	0x22, 0x93, 0x99, 0xd2, 0x99, 0xf5, 0x20, 0x10, 0xbd, 0x35, 0x2f, 0xe3, 0xe0, 0x87, 0x6b, 0x1e,
+	0x61, 0x88, 0x57, 0xa8, 0xf9, 0xe8, 0xc0, 0x62, 0x5e, 0xd4, 0x8e, 0x91, 0xd0, 0x03, 0x4a, 0x7c,
+	0x45, 0x39, 0x7b, 0x46, 0x0f, 0xc5, 0x15, 0x64, 0xdd, 0xbb, 0x30, 0x7f, 0x20, 0x78, 0xb4, 0x27,
+	0xbb, 0x8b, 0xcd, 0x5a, 0xe3, 0xd1, 0x5c, 0xfa, 0xb8, 0x07, 0xad, 0xeb, 0xd6, 0x60, 0x4e, 0xf1,
+	0x41, 0x55, 0x65, 0x5d, 0x35, 0xa3, 0x78, 0x5f, 0x4d, 0x7d, 0x1b, 0x66, 0xf2, 0x6e, 0x37, 0x29,
+	0xa6, 0xd9, 0xe2, 0x0e, 0x3d, 0x80, 0x90, 0x22, 0x3b, 0xe2, 0x61, 0x80, 0xb6, 0xa9, 0xae, 0x4c,
+	0x7d, 0x07, 0x2a, 0xbd, 0xd4, 0x16, 0x86, 0xe8, 0xcb, 0xe1, 0xc1, 0x09, 0x2c, 0xe4, 0xe0, 0x57,
+	0x7e, 0x98, 0xe0, 0x13, 0xe1, 0x93, 0xf4, 0xdf, 0xf8, 0x21, 0x7d, 0x57, 0xcc, 0x5f, 0x84, 0xe9,
+	0x4e, 0x5a, 0xb5, 0xc7, 0x8f, 0x59, 0x2e, 0xa0, 0x53, 0x5b, 0x69, 0xc6, 0x9d, 0x85, 0xb1, 0x84,
+	0x51, 0x25, 0x33, 0xcf, 0x4c, 0x50, 0xdf, 0xb1, 0x2e, 0xa1, 0x94, 0x94, 0x33, 0x3b, 0x72, 0xcb,
+	0xf0, 0x8f, 0x34, 0x99, 0x6e, 0xb9, 0xe9, 0x2c, 0xa7, 0x05, 0x7b, 0xfb, 0x29, 0x5d, 0x9c, 0x96,
+	0x0b, 0x60, 0x3b, 0x97, 0xbf, 0x1c, 0x6c, 0x87, 0x77, 0x78, 0xf0, 0x31, 0xb8, 0x1a, 0xdc, 0x42,
+	0xc2, 0x45, 0x60, 0x9d, 0x58, 0x84, 0x69, 0xa1, 0x13, 0xdd, 0x58, 0x30, 0x29, 0x4d, 0xbd, 0x28,
+	0x5c, 0x2a, 0x12, 0x2e, 0x7f, 0x5f, 0xd8, 0x3a, 0xf5, 0x1b, 0x84, 0xb7, 0x7b, 0x84, 0xad, 0x93,
+	0x85, 0xc2, 0x05, 0xd4, 0x5d, 0xf0, 0x2e, 0xd9, 0x26, 0xac, 0xa7, 0xf7, 0xa0, 0x6a, 0x00, 0x03,
+	0xbe, 0x68, 0x23, 0x57, 0x91, 0x7d, 0xc5, 0x05, 0x6c, 0x6b, 0xdb, 0x75, 0xb0, 0xad, 0x33, 0x3f,
+	0xcf, 0x26, 0xb0, 0xac, 0xd9, 0x1b, 0x9c, 0xa9, 0xf4, 0xb3, 0x1e, 0x68, 0xcb, 0x03, 0xb8, 0x41,
+	0xb2, 0xe7, 0x97, 0x2b, 0xd4, 0xc8, 0x20, 0x44, 0xb1, 0x88, 0xf5, 0xe7, 0x5a, 0x45, 0xac, 0x51,
+	0xc3, 0x8a, 0x7c, 0xb0, 0x47, 0x8d, 0x99, 0xcc, 0x81, 0x6e, 0xdd, 0x87, 0x5a, 0x36, 0xa6, 0x97,
+	0x2a, 0xcc, 0x8b, 0xfe, 0x72, 0x3d, 0xc1, 0x05, 0xfd, 0x95, 0x86, 0xe9, 0xcf, 0x1a, 0xfd, 0xb7,
+	0xf6, 0x67, 0xdf, 0xd1, 0x9f, 0xec, 0x6f, 0x1d, 0xe6, 0x74, 0x7b, 0x5b, 0xed, 0x4d, 0x4e, 0x7c,
+	0xc5, 0x85, 0x7d, 0xa9, 0xb3, 0x30, 0x66, 0xce, 0x2f, 0xd3, 0x80, 0x09, 0x5c, 0x17, 0x46, 0x99,
+	0x1f, 0x61, 0xc6, 0xd5, 0xbf, 0xfb, 0x11, 0xd6, 0xf7, 0x21, 0x10, 0xd6, 0x9a, 0xab, 0x23, 0x4e,
+	0x32, 0x44, 0x1b, 0xd5, 0x73, 0x54, 0xeb, 0x52, 0xa2, 0xd2, 0x67, 0xb7, 0x5b, 0x83, 0x49, 0xb3,
+	0x55, 0xd0, 0x20, 0xa3, 0x4c, 0xe8, 0xf8, 0xa9, 0xa6, 0xc7, 0x82, 0x12, 0x0b, 0x32, 0x41, 0x7a,
+	0x8f, 0x94, 0x3c, 0x11, 0x04, 0xb3, 0x0d, 0x35, 0x8b, 0xd2, 0x7c, 0x87, 0x87, 0x49, 0x84, 0xd5,
+	0x51, 0x93, 0x37, 0xd1, 0xc3, 0x37, 0x9f, 0xce, 0x3c, 0xe7, 0xf4, 0xcc, 0x73, 0xbe, 0x9c, 0x79,
+	0xce, 0xfb, 0x73, 0x6f, 0xe4, 0xf4, 0xdc, 0x1b, 0xf9, 0x7c, 0xee, 0x8d, 0x40, 0x8d, 0xf2, 0xc6,
+	0xe0, 0x0b, 0xec, 0x0b, 0x67, 0xf7, 0xce, 0x21, 0x55, 0x47, 0xc9, 0x7e, 0x83, 0xf0, 0xa8, 0x99,
+	0x2f, 0xba, 0x45, 0x79, 0x57, 0xd4, 0x3c, 0xc9, 0xaf, 0xc6, 0xea, 0x6d, 0x8c, 0x72, 0x7f, 0x5c,
+	0xdf, 0x8b, 0x6f, 0x7f, 0x1d, 0x00, 0xc5, 0x6c, 0x19, 0x98, 0x3e, 0x0b, 0x00, 0x00,
 }
 
 func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
@@ -1438,7 +1716,7 @@ func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationMigrated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1448,34 +1726,41 @@ func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationMigrated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationMigrated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ToSpecificationAddr) > 0 {
+		i -= len(m.ToSpecificationAddr)
+		copy(dAtA[i:], m.ToSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromSpecificationAddr) > 0 {
+		i -= len(m.FromSpecificationAddr)
+		copy(dAtA[i:], m.FromSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ScopeAddr) > 0 {
 		i -= len(m.ScopeAddr)
 		copy(dAtA[i:], m.ScopeAddr)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeLienAdded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1485,34 +1770,34 @@ func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeLienAdded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeLienAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Lienholder) > 0 {
+		i -= len(m.Lienholder)
+		copy(dAtA[i:], m.Lienholder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Lienholder)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ScopeAddr) > 0 {
 		i -= len(m.ScopeAddr)
 		copy(dAtA[i:], m.ScopeAddr)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeLienReleased) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1522,34 +1807,34 @@ func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeLienReleased) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeLienReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Lienholder) > 0 {
+		i -= len(m.Lienholder)
+		copy(dAtA[i:], m.Lienholder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Lienholder)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ScopeAddr) > 0 {
 		i -= len(m.ScopeAddr)
 		copy(dAtA[i:], m.ScopeAddr)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeValueFractionalized) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1559,34 +1844,189 @@ func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeValueFractionalized) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeValueFractionalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+	if len(m.Units) > 0 {
+		i -= len(m.Units)
+		copy(dAtA[i:], m.Units)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Units)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1985,6 +2425,13 @@ func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -2015,6 +2462,13 @@ func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -2045,6 +2499,13 @@ func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -2179,6 +2640,82 @@ func (m *EventScopeDeleted) Size() (n int) {
 	return n
 }
 
+func (m *EventScopeSpecificationMigrated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FromSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeLienAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Lienholder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeLienReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Lienholder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeValueFractionalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Units)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSessionCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2428,6 +2965,10 @@ func (m *EventOSLocatorCreated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2441,6 +2982,10 @@ func (m *EventOSLocatorUpdated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2454,6 +2999,10 @@ func (m *EventOSLocatorDeleted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2479,16 +3028,408 @@ func (m *EventSetNetAssetValue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationMigrated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,15 +3452,15 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationMigrated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationMigrated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,11 +3488,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,11 +3520,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.FromSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2611,7 +3552,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ToSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2634,7 +3575,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeLienAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2657,10 +3598,10 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeLienAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeLienAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2695,6 +3636,38 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lienholder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lienholder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2716,7 +3689,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeLienReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,10 +3712,10 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeLienReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeLienReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2777,6 +3750,38 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lienholder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lienholder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2798,7 +3803,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeValueFractionalized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,10 +3826,10 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeValueFractionalized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeValueFractionalized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2859,6 +3864,70 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Units = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4523,6 +5592,38 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4605,6 +5706,38 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4687,6 +5820,38 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1,7 +1,82 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	seen := make(map[string]bool, len(state.ScopeHistory))
+	for i, change := range state.ScopeHistory {
+		if err := change.Validate(); err != nil {
+			return fmt.Errorf("invalid scope history entry [%d]: %w", i, err)
+		}
+		key := string(ScopeHistoryKey(change.ScopeId, change.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate scope history entry [%d]: scope %s sequence %d", i, change.ScopeId, change.Sequence)
+		}
+		seen[key] = true
+	}
+	liened := make(map[string]bool, len(state.ScopeLiens))
+	for i, lien := range state.ScopeLiens {
+		if err := lien.Validate(); err != nil {
+			return fmt.Errorf("invalid scope lien [%d]: %w", i, err)
+		}
+		if liened[string(lien.ScopeId)] {
+			return fmt.Errorf("duplicate scope lien [%d]: scope %s", i, lien.ScopeId)
+		}
+		liened[string(lien.ScopeId)] = true
+	}
+	fractional := make(map[string]bool, len(state.ScopeValueUnits))
+	for i, units := range state.ScopeValueUnits {
+		if err := units.Validate(); err != nil {
+			return fmt.Errorf("invalid scope value units [%d]: %w", i, err)
+		}
+		if fractional[string(units.ScopeId)] {
+			return fmt.Errorf("duplicate scope value units [%d]: scope %s", i, units.ScopeId)
+		}
+		fractional[string(units.ScopeId)] = true
+	}
+	locators := make(map[string]bool, len(state.ObjectStoreLocators))
+	primaries := make(map[string]bool, len(state.ObjectStoreLocators))
+	for i, locator := range state.ObjectStoreLocators {
+		if err := locator.Validate(); err != nil {
+			return fmt.Errorf("invalid object store locator [%d]: %w", i, err)
+		}
+		key := locator.Owner + "/" + locator.Name
+		if locators[key] {
+			return fmt.Errorf("duplicate object store locator [%d]: owner %s name %q", i, locator.Owner, locator.Name)
+		}
+		locators[key] = true
+		if locator.Primary {
+			if primaries[locator.Owner] {
+				return fmt.Errorf("object store locator [%d]: owner %s has more than one primary locator", i, locator.Owner)
+			}
+			primaries[locator.Owner] = true
+		}
+	}
+	return ValidateScopeHierarchy(state.Scopes)
+}
+
+// ValidateScopeHierarchy makes sure that the parent of each provided scope is also provided,
+// and that no scope is its own ancestor.
+func ValidateScopeHierarchy(scopes []Scope) error {
+	parents := make(map[string]MetadataAddress, len(scopes))
+	for _, scope := range scopes {
+		parents[string(scope.ScopeId)] = scope.GetParentScopeID()
+	}
+	for _, scope := range scopes {
+		visited := map[string]bool{string(scope.ScopeId): true}
+		for cur := scope.GetParentScopeID(); !cur.Empty(); {
+			if visited[string(cur)] {
+				return fmt.Errorf("scope %s has cyclic ancestry at %s", scope.ScopeId, cur)
+			}
+			visited[string(cur)] = true
+			next, found := parents[string(cur)]
+			if !found {
+				return fmt.Errorf("scope %s has an ancestor %s that does not exist", scope.ScopeId, cur)
+			}
+			cur = next
+		}
+	}
 	return nil
 }
 
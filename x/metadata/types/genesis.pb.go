@@ -38,6 +38,12 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// The change log entries of scopes.
+	ScopeHistory []ScopeChange `protobuf:"bytes,11,rep,name=scope_history,json=scopeHistory,proto3" json:"scope_history"`
+	// The liens on scopes.
+	ScopeLiens []ScopeLien `protobuf:"bytes,12,rep,name=scope_liens,json=scopeLiens,proto3" json:"scope_liens"`
+	// The scopes with fractional value ownership.
+	ScopeValueUnits []ScopeValueUnits `protobuf:"bytes,13,rep,name=scope_value_units,json=scopeValueUnits,proto3" json:"scope_value_units"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,42 +130,47 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x5b, 0x17, 0x97, 0x65, 0x16, 0xfc, 0x33, 0x2e, 0x58, 0x49, 0xec, 0xae, 0x28, 0x91,
+	0xa0, 0xb4, 0x01, 0x3d, 0xa9, 0x31, 0x01, 0x0e, 0x72, 0x40, 0x20, 0x6c, 0x30, 0x91, 0x98, 0x34,
+	0xc3, 0xec, 0xb0, 0x8c, 0x2c, 0x9d, 0x66, 0xde, 0x61, 0x23, 0xdf, 0xc0, 0xa3, 0x7e, 0x03, 0x3e,
+	0x0e, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0xc4, 0xf8, 0x31, 0x4c, 0x67, 0x5a, 0x96, 0xb2, 0x6d, 0x6f,
+	0xdb, 0x99, 0xe7, 0xf7, 0x3c, 0xf3, 0xce, 0xfb, 0xee, 0xa0, 0x67, 0x91, 0x14, 0x7d, 0x16, 0x92,
+	0x90, 0x32, 0xff, 0x88, 0x29, 0xd2, 0x21, 0x8a, 0xf8, 0xfd, 0x45, 0xbf, 0xcb, 0x42, 0x06, 0x1c,
+	0xbc, 0x48, 0x0a, 0x25, 0xf0, 0xd4, 0x40, 0xe5, 0xa5, 0x2a, 0xaf, 0xbf, 0x38, 0xdd, 0xe8, 0x8a,
+	0xae, 0xd0, 0x12, 0x3f, 0xfe, 0x65, 0xd4, 0xd3, 0xb3, 0x05, 0x9e, 0x57, 0xa4, 0x91, 0xcd, 0x14,
+	0xc8, 0x80, 0x8a, 0x88, 0x25, 0x9a, 0xf9, 0x22, 0x4d, 0xc4, 0x28, 0xdf, 0xe7, 0x94, 0x28, 0x2e,
+	0xc2, 0x44, 0x3b, 0x57, 0xa0, 0x15, 0x7b, 0x5f, 0x19, 0x55, 0xa0, 0x84, 0x4c, 0x5c, 0x67, 0xfe,
+	0xd6, 0xd0, 0xf8, 0x07, 0x53, 0x60, 0x5b, 0x11, 0xc5, 0xf0, 0x3b, 0x54, 0x8d, 0x88, 0x24, 0x47,
+	0xe0, 0xd8, 0x2d, 0x7b, 0xae, 0xbe, 0xe4, 0x7a, 0xf9, 0x05, 0x7b, 0x5b, 0x5a, 0xb5, 0x32, 0x72,
+	0xf6, 0xbb, 0x69, 0x6d, 0x27, 0x0c, 0x7e, 0x8b, 0xaa, 0xfa, 0xcc, 0xe0, 0xdc, 0x6a, 0x55, 0xe6,
+	0xea, 0x4b, 0x8f, 0x8b, 0xe8, 0x76, 0xac, 0x4a, 0x61, 0x83, 0xe0, 0x65, 0x54, 0x03, 0x06, 0xc0,
+	0x45, 0x08, 0x4e, 0x45, 0xe3, 0xcd, 0x42, 0xdc, 0xe8, 0x12, 0x83, 0x2b, 0x0c, 0xbf, 0x47, 0xa3,
+	0x92, 0x51, 0x21, 0x3b, 0xe0, 0x8c, 0xb4, 0x2a, 0x65, 0xc7, 0xdf, 0xd6, 0xb2, 0xc4, 0x20, 0x85,
+	0x30, 0x45, 0x0d, 0x7d, 0x98, 0x20, 0x73, 0xab, 0xe0, 0xdc, 0xd6, 0x66, 0xf3, 0xa5, 0xd5, 0xb4,
+	0xaf, 0x23, 0x89, 0xf1, 0x03, 0x18, 0xda, 0x01, 0xdc, 0x43, 0x0f, 0xa9, 0x08, 0x95, 0x24, 0x54,
+	0xdd, 0xcc, 0xa9, 0xea, 0x9c, 0x85, 0xa2, 0x9c, 0xd5, 0x04, 0xcb, 0x8b, 0x9a, 0xa2, 0x79, 0x9b,
+	0x80, 0xf7, 0xd1, 0xa4, 0xa9, 0xee, 0x66, 0xd6, 0xa8, 0xce, 0x7a, 0x51, 0x7e, 0x41, 0x79, 0x49,
+	0x0d, 0x39, 0xbc, 0x05, 0x78, 0x17, 0x61, 0x11, 0x40, 0xd0, 0x13, 0x94, 0x28, 0x21, 0x83, 0x64,
+	0x88, 0x6a, 0x7a, 0x88, 0x9e, 0x17, 0x85, 0x6c, 0xb6, 0xd7, 0x8d, 0x3e, 0x33, 0x4d, 0x77, 0x45,
+	0x76, 0x19, 0x77, 0xd0, 0xa4, 0x19, 0xdd, 0x40, 0xcf, 0x6e, 0x1a, 0x02, 0xce, 0x58, 0x79, 0x5f,
+	0x36, 0x35, 0xd4, 0x8e, 0x99, 0xc4, 0x30, 0xed, 0x8b, 0x18, 0xda, 0x01, 0xfc, 0x05, 0xdd, 0x0b,
+	0x99, 0x0a, 0x08, 0x00, 0x53, 0x41, 0x9f, 0xf4, 0x8e, 0x19, 0x38, 0x48, 0x07, 0xbc, 0x2c, 0x0a,
+	0xf8, 0x48, 0xe4, 0x21, 0x93, 0x1b, 0x4c, 0x2d, 0xc7, 0xd0, 0x27, 0xcd, 0x24, 0x11, 0x77, 0xc2,
+	0xcc, 0x2a, 0xde, 0x40, 0x13, 0x66, 0xb4, 0x0e, 0x78, 0x5c, 0xc4, 0x89, 0x53, 0xd7, 0xd6, 0x4f,
+	0x4b, 0x67, 0x6a, 0xf5, 0x80, 0x84, 0xdd, 0xf4, 0x7f, 0x32, 0xae, 0xf9, 0x35, 0x83, 0xe3, 0x35,
+	0x54, 0x37, 0x7e, 0x3d, 0xce, 0x42, 0x70, 0xc6, 0xb5, 0xdb, 0x93, 0x52, 0xb7, 0x75, 0xce, 0xd2,
+	0x1e, 0x22, 0x48, 0x17, 0x00, 0x7f, 0x46, 0xf7, 0x8d, 0x93, 0xae, 0x39, 0x38, 0x0e, 0xb9, 0x02,
+	0x67, 0xa2, 0x55, 0x29, 0x6b, 0x9c, 0xf6, 0xd3, 0x95, 0xed, 0xc4, 0xf2, 0xb4, 0x71, 0x90, 0x5d,
+	0x7e, 0x53, 0xfb, 0x7e, 0xda, 0xb4, 0xfe, 0x9d, 0x36, 0xad, 0x99, 0x9f, 0x36, 0x6a, 0xe4, 0xdd,
+	0x16, 0x76, 0xd0, 0x28, 0xe9, 0x74, 0x24, 0x03, 0xf3, 0xe2, 0x8c, 0x6d, 0xa7, 0x9f, 0x78, 0x27,
+	0xa7, 0x1f, 0xe6, 0x59, 0x99, 0x2d, 0x3a, 0x56, 0xc6, 0x3b, 0xbf, 0x11, 0x83, 0x33, 0xad, 0x1c,
+	0x9e, 0x5d, 0xb8, 0xf6, 0xf9, 0x85, 0x6b, 0xff, 0xb9, 0x70, 0xed, 0x1f, 0x97, 0xae, 0x75, 0x7e,
+	0xe9, 0x5a, 0xbf, 0x2e, 0x5d, 0x0b, 0x3d, 0xe2, 0xa2, 0x20, 0x62, 0xcb, 0xde, 0x7d, 0xdd, 0xe5,
+	0xea, 0xe0, 0x78, 0xcf, 0xa3, 0xe2, 0xc8, 0x1f, 0x88, 0x16, 0xb8, 0xb8, 0xf6, 0xe5, 0x7f, 0x1b,
+	0x3c, 0xbc, 0xea, 0x24, 0x62, 0xb0, 0x57, 0xd5, 0x0f, 0xee, 0xab, 0xff, 0x03, 0x00, 0x80, 0x41,
+	0xfe, 0xf8, 0x67, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +193,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeValueUnits) > 0 {
+		for iNdEx := len(m.ScopeValueUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeValueUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScopeLiens) > 0 {
+		for iNdEx := len(m.ScopeLiens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeLiens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScopeHistory) > 0 {
+		for iNdEx := len(m.ScopeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -430,6 +483,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeHistory) > 0 {
+		for _, e := range m.ScopeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeLiens) > 0 {
+		for _, e := range m.ScopeLiens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeValueUnits) > 0 {
+		for _, e := range m.ScopeValueUnits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -825,6 +896,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeHistory = append(m.ScopeHistory, ScopeChange{})
+			if err := m.ScopeHistory[len(m.ScopeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeLiens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeLiens = append(m.ScopeLiens, ScopeLien{})
+			if err := m.ScopeLiens[len(m.ScopeLiens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeValueUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeValueUnits = append(m.ScopeValueUnits, ScopeValueUnits{})
+			if err := m.ScopeValueUnits[len(m.ScopeValueUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
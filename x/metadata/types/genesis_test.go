@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateScopeHierarchy(t *testing.T) {
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+	scopeID3 := ScopeMetadataAddress(uuid.New())
+	scopeIDMissing := ScopeMetadataAddress(uuid.New())
+	ns := func(scopeID, parentID MetadataAddress) Scope {
+		scope := Scope{ScopeId: scopeID}
+		if parentID != nil {
+			scope.ParentScopeId = &parentID
+		}
+		return scope
+	}
+
+	tests := []struct {
+		name   string
+		scopes []Scope
+		expErr string
+	}{
+		{
+			name:   "no scopes",
+			scopes: nil,
+		},
+		{
+			name:   "no parents",
+			scopes: []Scope{ns(scopeID1, nil), ns(scopeID2, nil)},
+		},
+		{
+			name:   "three levels",
+			scopes: []Scope{ns(scopeID3, scopeID2), ns(scopeID2, scopeID1), ns(scopeID1, nil)},
+		},
+		{
+			name:   "parent not provided",
+			scopes: []Scope{ns(scopeID1, nil), ns(scopeID2, scopeIDMissing)},
+			expErr: "scope " + scopeID2.String() + " has an ancestor " + scopeIDMissing.String() + " that does not exist",
+		},
+		{
+			name:   "two scope cycle",
+			scopes: []Scope{ns(scopeID1, scopeID2), ns(scopeID2, scopeID1)},
+			expErr: "scope " + scopeID1.String() + " has cyclic ancestry at " + scopeID1.String(),
+		},
+		{
+			name:   "cycle above a scope",
+			scopes: []Scope{ns(scopeID1, scopeID2), ns(scopeID2, scopeID3), ns(scopeID3, scopeID2)},
+			expErr: "scope " + scopeID1.String() + " has cyclic ancestry at " + scopeID2.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateScopeHierarchy(tc.scopes)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateScopeHierarchy")
+			} else {
+				assert.NoError(t, err, "ValidateScopeHierarchy")
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -34,7 +37,15 @@ const (
 //
 // - 0x05<session_specification_key_bytes><record_spec_name_hash>: RecordSpecification
 //
-// - 0x21<owner_address>: ObjectStoreLocator
+// - 0x21<owner_address><name>: ObjectStoreLocator
+//
+// - 0x24: Params
+//
+// - 0x25<scope_id><sequence>: ScopeChange
+//
+// - 0x2A<scope_id>: ScopeLien
+//
+// - 0x2C<scope_id>: ScopeValueUnits
 //
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
@@ -52,6 +63,16 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x26<parent_scope_id><scope_id>: 0x01
+//
+// - 0x27<party_address><role><scope_id>: 0x01
+//
+// - 0x28<data_access_address><scope_id>: 0x01
+//
+// - 0x29<output_hash_sha256><record_id>: 0x01
+//
+// - 0x2B<lienholder_address><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -86,6 +107,31 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// ParamsPrefix is the key for the metadata module params
+	ParamsPrefix = []byte{0x24}
+
+	// ScopeHistoryPrefix prefix for the change log entries of scopes
+	ScopeHistoryPrefix = []byte{0x25}
+
+	// ScopeChildCacheKeyPrefix for child scope lookup by parent scope
+	ScopeChildCacheKeyPrefix = []byte{0x26}
+
+	// AddressRoleScopeCacheKeyPrefix for scope lookup by party address and role
+	AddressRoleScopeCacheKeyPrefix = []byte{0x27}
+	// DataAccessScopeCacheKeyPrefix for scope lookup by data access address
+	DataAccessScopeCacheKeyPrefix = []byte{0x28}
+
+	// RecordOutputHashCacheKeyPrefix for record lookup by output hash
+	RecordOutputHashCacheKeyPrefix = []byte{0x29}
+
+	// ScopeLienKeyPrefix prefix for the liens on scopes
+	ScopeLienKeyPrefix = []byte{0x2A}
+	// LienholderScopeCacheKeyPrefix for scope lien lookup by lienholder
+	LienholderScopeCacheKeyPrefix = []byte{0x2B}
+
+	// ScopeValueUnitsKeyPrefix prefix for the value units of scopes with fractional value ownership
+	ScopeValueUnitsKeyPrefix = []byte{0x2C}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -108,6 +154,69 @@ func GetScopeSpecScopeCacheKey(scopeSpecID MetadataAddress, scopeID MetadataAddr
 	return append(GetScopeSpecScopeCacheIteratorPrefix(scopeSpecID), scopeID.Bytes()...)
 }
 
+// GetScopeChildCacheIteratorPrefix returns an iterator prefix for all child scope cache entries of a given parent scope
+func GetScopeChildCacheIteratorPrefix(parentScopeID MetadataAddress) []byte {
+	return append(ScopeChildCacheKeyPrefix, parentScopeID.Bytes()...)
+}
+
+// GetScopeChildCacheKey returns the store key for a parent scope + child scope cache entry
+func GetScopeChildCacheKey(parentScopeID MetadataAddress, scopeID MetadataAddress) []byte {
+	return append(GetScopeChildCacheIteratorPrefix(parentScopeID), scopeID.Bytes()...)
+}
+
+// GetAddressRoleScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries where the given address has the given role
+func GetAddressRoleScopeCacheIteratorPrefix(addr sdk.AccAddress, role PartyType) []byte {
+	rv := append(AddressRoleScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+	return binary.BigEndian.AppendUint32(rv, uint32(role))
+}
+
+// GetAddressRoleScopeCacheKey returns the store key for an address + role + scope cache entry
+func GetAddressRoleScopeCacheKey(addr sdk.AccAddress, role PartyType, scopeID MetadataAddress) []byte {
+	return append(GetAddressRoleScopeCacheIteratorPrefix(addr, role), scopeID.Bytes()...)
+}
+
+// GetDataAccessScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries where the given address has data access
+func GetDataAccessScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(DataAccessScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetDataAccessScopeCacheKey returns the store key for a data access address + scope cache entry
+func GetDataAccessScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byte {
+	return append(GetDataAccessScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
+}
+
+// GetRecordOutputHashCacheIteratorPrefix returns an iterator prefix for all record cache entries with an output with the given hash.
+// Output hashes do not have a maximum length, so the sha256 checksum of the hash is used in the key.
+func GetRecordOutputHashCacheIteratorPrefix(hash string) []byte {
+	hashSum := sha256.Sum256([]byte(hash))
+	return append(RecordOutputHashCacheKeyPrefix, hashSum[:]...)
+}
+
+// GetRecordOutputHashCacheKey returns the store key for an output hash + record cache entry
+func GetRecordOutputHashCacheKey(hash string, recordID MetadataAddress) []byte {
+	return append(GetRecordOutputHashCacheIteratorPrefix(hash), recordID.Bytes()...)
+}
+
+// ScopeLienKey returns the store key for the lien on a scope
+func ScopeLienKey(scopeID MetadataAddress) []byte {
+	return append(ScopeLienKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetLienholderScopeCacheIteratorPrefix returns an iterator prefix for all scope lien cache entries held by a given address
+func GetLienholderScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(LienholderScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetLienholderScopeCacheKey returns the store key for a lienholder + scope cache entry
+func GetLienholderScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byte {
+	return append(GetLienholderScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
+}
+
+// ScopeValueUnitsKey returns the store key for the value units of a scope
+func ScopeValueUnitsKey(scopeID MetadataAddress) []byte {
+	return append(ScopeValueUnitsKeyPrefix, scopeID.Bytes()...)
+}
+
 // GetAddressScopeSpecCacheIteratorPrefix returns an iterator prefix for all scope spec cache entries assigned to a given address
 func GetAddressScopeSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(AddressScopeSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -139,10 +248,16 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 }
 
 // GetOSLocatorKey returns a store key for an object store locator entry
+// This is the key of the owner's locator without a name, and is also the prefix of the keys of all the owner's locators.
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
 
+// GetOSLocatorNameKey returns a store key for an owner's object store locator entry with the given name.
+func GetOSLocatorNameKey(addr sdk.AccAddress, name string) []byte {
+	return append(GetOSLocatorKey(addr), name...)
+}
+
 // NetAssetValueKeyPrefix returns the [prefix][scope address] part of a scope net asset values key.
 func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 	return append(NetAssetValuePrefix, address.MustLengthPrefix(scopeAddr.Bytes())...)
@@ -152,3 +267,13 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// ScopeHistoryKeyPrefix returns the [prefix][scope address] part of a scope change log key.
+func ScopeHistoryKeyPrefix(scopeAddr MetadataAddress) []byte {
+	return append(ScopeHistoryPrefix, address.MustLengthPrefix(scopeAddr.Bytes())...)
+}
+
+// ScopeHistoryKey returns key [prefix][scope address][sequence] for an entry in a scope's change log.
+func ScopeHistoryKey(scopeAddr MetadataAddress, sequence uint64) []byte {
+	return append(ScopeHistoryKeyPrefix(scopeAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -25,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// max_scope_history_entries is the maximum number of change log entries kept for each scope.
+	// When a scope has more entries than this, its oldest ones are pruned. Zero turns off the scope change log.
+	MaxScopeHistoryEntries uint32 `protobuf:"varint,1,opt,name=max_scope_history_entries,json=maxScopeHistoryEntries,proto3" json:"max_scope_history_entries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,6 +63,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxScopeHistoryEntries() uint32 {
+	if m != nil {
+		return m.MaxScopeHistoryEntries
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -561,53 +571,55 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0x87, 0xe3, 0xc0, 0x0d, 0xe4, 0xe4, 0x2f, 0x73, 0x03, 0xe4, 0xa2, 0x8b, 0x03, 0x41, 0xf7,
-	0x2a, 0x42, 0x25, 0x51, 0x28, 0xed, 0x82, 0xaa, 0xaa, 0xa0, 0x0b, 0x8a, 0xaa, 0x56, 0x28, 0xa8,
-	0x9b, 0x4a, 0x15, 0x32, 0xf6, 0x00, 0x56, 0x15, 0x8f, 0xe5, 0x71, 0x10, 0x7d, 0x0b, 0xd4, 0x27,
-	0xe8, 0x5b, 0x74, 0xd3, 0x07, 0x60, 0xc9, 0xaa, 0xaa, 0xba, 0x40, 0x15, 0x6c, 0xba, 0xe8, 0x43,
-	0x54, 0x1e, 0x8f, 0x3d, 0x67, 0x30, 0x48, 0x51, 0x77, 0x33, 0x67, 0x7e, 0xdf, 0x91, 0xe7, 0x8b,
-	0x8f, 0x01, 0xfe, 0xf3, 0x03, 0x76, 0x4a, 0x3d, 0xcb, 0xb3, 0x69, 0x6f, 0x48, 0x43, 0xcb, 0xb1,
-	0x42, 0xab, 0x77, 0xda, 0x4f, 0xd7, 0x5d, 0x3f, 0x60, 0x21, 0x23, 0x73, 0x2a, 0xd6, 0x4d, 0x8f,
-	0x4e, 0xfb, 0x0b, 0x8d, 0x63, 0x76, 0xcc, 0x44, 0xa4, 0x17, 0xad, 0xe2, 0x74, 0xbb, 0x0a, 0x85,
-	0x3d, 0x2b, 0xb0, 0x86, 0x7c, 0x73, 0xf2, 0xe7, 0xa7, 0x96, 0xd1, 0xfe, 0x6a, 0x40, 0x69, 0xdf,
-	0x66, 0x3e, 0xdd, 0x75, 0x76, 0xbd, 0x23, 0x46, 0xd6, 0x61, 0x9a, 0x47, 0xdb, 0x03, 0xd7, 0x69,
-	0x1a, 0x4b, 0x46, 0xa7, 0xbc, 0x3d, 0x7f, 0x71, 0xd5, 0xca, 0x7d, 0xbf, 0x6a, 0xd5, 0x5e, 0xc9,
-	0xe6, 0x5b, 0x8e, 0x13, 0x50, 0xce, 0x07, 0x53, 0x3c, 0xe6, 0xc8, 0xff, 0x50, 0x4b, 0x98, 0x03,
-	0x3f, 0xa0, 0x47, 0xee, 0x59, 0x33, 0x1f, 0xa1, 0x83, 0x8a, 0x4c, 0xec, 0x89, 0x22, 0x59, 0x83,
-	0xbf, 0xd3, 0x5c, 0xbc, 0x18, 0x8d, 0x5c, 0xa7, 0x39, 0x21, 0xb2, 0x75, 0x99, 0x15, 0x0f, 0xf3,
-	0x66, 0xe4, 0x3a, 0x64, 0x11, 0x20, 0x4e, 0x59, 0x8e, 0x13, 0x34, 0x27, 0x97, 0x8c, 0x4e, 0x71,
-	0x50, 0x14, 0x95, 0xe8, 0x09, 0xd4, 0xb1, 0x68, 0xf2, 0x17, 0x3a, 0x8e, 0xe8, 0xf6, 0xaf, 0x3c,
-	0x54, 0xf6, 0x29, 0xe7, 0x2e, 0xf3, 0xe4, 0xd5, 0x1e, 0x03, 0xf0, 0xb8, 0x30, 0xc6, 0xe5, 0x8a,
-	0x3c, 0x61, 0xc9, 0x2a, 0xcc, 0x28, 0x4e, 0xbf, 0x60, 0x2d, 0x4d, 0xc9, 0x2b, 0xf6, 0x61, 0x16,
-	0x65, 0x33, 0x97, 0x24, 0x69, 0x5e, 0x5d, 0xf3, 0x11, 0xcc, 0x63, 0x44, 0x2e, 0x05, 0x34, 0x29,
-	0xa0, 0x86, 0x82, 0xe2, 0x85, 0xc0, 0x96, 0xa1, 0x9c, 0x64, 0x85, 0x9f, 0x58, 0x40, 0x49, 0xd6,
-	0x84, 0x21, 0x14, 0x11, 0xed, 0x0a, 0x5a, 0x44, 0x74, 0xd9, 0x81, 0x4a, 0xfa, 0x93, 0xb8, 0xde,
-	0x11, 0x6b, 0x4e, 0x2d, 0x19, 0x9d, 0xd2, 0xfa, 0x4a, 0xf7, 0xee, 0x97, 0xaa, 0x8b, 0x5e, 0x95,
-	0x41, 0x89, 0xab, 0x4d, 0xfb, 0x4b, 0x1e, 0xca, 0x03, 0x6a, 0xb3, 0xc0, 0x91, 0xb6, 0x37, 0xa0,
-	0x18, 0x88, 0xfd, 0x18, 0xb2, 0xa7, 0x03, 0x49, 0x92, 0x0e, 0xd4, 0x53, 0x4a, 0x57, 0x5d, 0x4d,
-	0x32, 0xd2, 0x74, 0x0f, 0x1a, 0x2a, 0x99, 0x11, 0x3d, 0x93, 0xa4, 0x95, 0xe7, 0x3e, 0xcc, 0x2a,
-	0xe0, 0xc4, 0xe2, 0x27, 0xd4, 0x39, 0xf0, 0xac, 0x21, 0x95, 0x96, 0x49, 0x42, 0xbc, 0x10, 0x47,
-	0xaf, 0xad, 0x21, 0x25, 0x2d, 0x28, 0x49, 0x04, 0x29, 0x86, 0xb8, 0x24, 0x0c, 0x67, 0xf4, 0x15,
-	0xfe, 0x50, 0xdf, 0x79, 0x1e, 0x6a, 0xe2, 0x70, 0xdf, 0xa7, 0xb6, 0x34, 0xf8, 0x24, 0x69, 0xce,
-	0x7d, 0x6a, 0x8f, 0x61, 0x31, 0x6e, 0x18, 0x37, 0x88, 0xf4, 0x68, 0xb0, 0x2e, 0x73, 0x06, 0x45,
-	0xa5, 0xcf, 0x67, 0xb0, 0xa8, 0x03, 0x68, 0x87, 0xc4, 0x36, 0x11, 0x99, 0x3e, 0xb0, 0xf0, 0x9b,
-	0x7e, 0x05, 0x04, 0x82, 0x66, 0xb6, 0x92, 0x22, 0xc2, 0x99, 0x9e, 0x43, 0xc3, 0xab, 0x72, 0x62,
-	0x80, 0x3f, 0xe7, 0x81, 0x3c, 0x67, 0x5e, 0x18, 0x58, 0x76, 0x88, 0xac, 0x6c, 0x41, 0xdd, 0x96,
-	0xd5, 0x71, 0xc5, 0x54, 0x6d, 0xad, 0x4d, 0x34, 0x71, 0xb7, 0x5b, 0xe8, 0x7a, 0x1a, 0x3a, 0x20,
-	0x0d, 0xbd, 0x84, 0x95, 0x0c, 0xa6, 0x17, 0x90, 0x27, 0x53, 0x6f, 0x81, 0x2f, 0x22, 0x6c, 0x3d,
-	0x00, 0xa2, 0xb3, 0x48, 0x58, 0x1d, 0xb3, 0xc2, 0x59, 0x26, 0x8d, 0xb4, 0x69, 0x69, 0x61, 0xee,
-	0xe3, 0x04, 0xd4, 0xe3, 0x59, 0x44, 0xde, 0x9e, 0x82, 0x9c, 0xa0, 0x71, 0xad, 0x95, 0x03, 0xd4,
-	0x02, 0x4d, 0xcf, 0x9d, 0xc6, 0x08, 0x0e, 0x4b, 0x5f, 0x3b, 0xb0, 0x7c, 0x0b, 0xb9, 0xd7, 0xd6,
-	0xbf, 0x18, 0xcf, 0xb8, 0xda, 0x84, 0x85, 0x5b, 0x8d, 0xb2, 0xe3, 0x3b, 0x87, 0x3b, 0xa0, 0x11,
-	0x56, 0x1f, 0x14, 0x65, 0x39, 0xf6, 0x56, 0x55, 0x84, 0x70, 0xfc, 0x0e, 0x66, 0x33, 0x3f, 0x2f,
-	0x9a, 0xe9, 0xd5, 0xfb, 0x66, 0x3a, 0xfb, 0x8e, 0x0e, 0x88, 0x9d, 0xa9, 0x6d, 0xbf, 0xbf, 0xb8,
-	0x36, 0x8d, 0xcb, 0x6b, 0xd3, 0xf8, 0x71, 0x6d, 0x1a, 0xe7, 0x37, 0x66, 0xee, 0xf2, 0xc6, 0xcc,
-	0x7d, 0xbb, 0x31, 0x73, 0xf0, 0x8f, 0xcb, 0xee, 0xe9, 0xbd, 0x67, 0xbc, 0xdd, 0x38, 0x76, 0xc3,
-	0x93, 0xd1, 0x61, 0xd7, 0x66, 0xc3, 0x9e, 0x0a, 0xad, 0xb9, 0x0c, 0xed, 0x7a, 0x67, 0xea, 0xff,
-	0x83, 0xf0, 0x83, 0x4f, 0xf9, 0x61, 0x41, 0xfc, 0xb1, 0x7f, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xea, 0x47, 0x7e, 0x34, 0x43, 0x08, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xd1, 0x4a, 0x1b, 0x4d,
+	0x14, 0xc7, 0xb3, 0xd1, 0x2f, 0x9a, 0x93, 0xc4, 0xc4, 0xf9, 0xa2, 0x46, 0xf9, 0x4c, 0x34, 0xf2,
+	0x95, 0x20, 0x35, 0x21, 0xd6, 0x16, 0x6a, 0x29, 0x45, 0x4b, 0x51, 0x29, 0x2d, 0x12, 0xe9, 0x4d,
+	0xa1, 0x84, 0x75, 0x77, 0x34, 0x4b, 0xc9, 0xce, 0xb2, 0xb3, 0x91, 0xf8, 0x16, 0xd2, 0x27, 0xe8,
+	0x5b, 0xf4, 0xa6, 0x0f, 0xe0, 0xa5, 0x57, 0xa5, 0xf4, 0x42, 0x8a, 0xde, 0xf4, 0xa2, 0x0f, 0x51,
+	0x76, 0x76, 0x76, 0xe7, 0x6c, 0x56, 0x21, 0xf4, 0x6e, 0xe6, 0x9c, 0xff, 0xff, 0xcf, 0xcc, 0x6f,
+	0xf7, 0x2c, 0x0b, 0xff, 0x3b, 0x2e, 0x3b, 0xa3, 0xb6, 0x6e, 0x1b, 0xb4, 0xd5, 0xa7, 0x9e, 0x6e,
+	0xea, 0x9e, 0xde, 0x3a, 0x6b, 0x47, 0xeb, 0xa6, 0xe3, 0x32, 0x8f, 0x91, 0x79, 0x25, 0x6b, 0x46,
+	0xad, 0xb3, 0xf6, 0x52, 0xf9, 0x94, 0x9d, 0x32, 0x21, 0x69, 0xf9, 0xab, 0x40, 0x5d, 0x3f, 0x80,
+	0xcc, 0xa1, 0xee, 0xea, 0x7d, 0x4e, 0x9e, 0xc2, 0x62, 0x5f, 0x1f, 0x76, 0xb9, 0xc1, 0x1c, 0xda,
+	0xed, 0x59, 0xdc, 0x63, 0xee, 0x79, 0x97, 0xda, 0x9e, 0x6b, 0x51, 0x5e, 0xd1, 0x56, 0xb4, 0x46,
+	0xa1, 0x33, 0xdf, 0xd7, 0x87, 0x47, 0x7e, 0x7f, 0x3f, 0x68, 0xbf, 0x0a, 0xba, 0xdb, 0x93, 0xbf,
+	0x3e, 0xd7, 0xb4, 0xfa, 0x37, 0x0d, 0x72, 0xa2, 0x7b, 0x60, 0x1e, 0xd8, 0x27, 0x8c, 0x6c, 0xc2,
+	0x74, 0x10, 0x66, 0x99, 0xc2, 0x9f, 0xdf, 0x5d, 0xb8, 0xbc, 0xae, 0xa5, 0x7e, 0x5c, 0xd7, 0x8a,
+	0x6f, 0xe4, 0xb9, 0x76, 0x4c, 0xd3, 0xa5, 0x9c, 0x77, 0xa6, 0x78, 0xe0, 0x23, 0x0f, 0xa0, 0x18,
+	0x7a, 0xba, 0x8e, 0x4b, 0x4f, 0xac, 0x61, 0x25, 0xed, 0x5b, 0x3b, 0x05, 0xa9, 0x38, 0x14, 0x45,
+	0xb2, 0x01, 0xff, 0x46, 0xba, 0x60, 0x31, 0x18, 0x58, 0x66, 0x65, 0x42, 0x68, 0x4b, 0x52, 0x2b,
+	0x0e, 0xf3, 0x6e, 0x60, 0x99, 0x64, 0x19, 0x20, 0x50, 0xe9, 0xa6, 0xe9, 0x56, 0x26, 0x57, 0xb4,
+	0x46, 0xb6, 0x93, 0x15, 0x15, 0xff, 0x04, 0xaa, 0x2d, 0x42, 0xfe, 0x41, 0x6d, 0xdf, 0x5d, 0xff,
+	0x9d, 0x86, 0xc2, 0x11, 0xe5, 0xdc, 0x62, 0xb6, 0xbc, 0xda, 0x13, 0x00, 0x1e, 0x14, 0xc6, 0xb8,
+	0x5c, 0x96, 0x87, 0x5e, 0xb2, 0x0e, 0xb3, 0xca, 0x17, 0xbf, 0x60, 0x31, 0x52, 0xc9, 0x2b, 0xb6,
+	0x61, 0x0e, 0x69, 0x13, 0x97, 0x24, 0x91, 0x5e, 0x5d, 0xf3, 0x31, 0x2c, 0x60, 0x8b, 0x5c, 0x0a,
+	0xd3, 0xa4, 0x30, 0x95, 0x95, 0x29, 0x58, 0x08, 0xdb, 0x2a, 0xe4, 0x43, 0xad, 0xe0, 0x13, 0x00,
+	0xc8, 0xc9, 0x9a, 0x20, 0x84, 0x24, 0x22, 0x2e, 0x13, 0x93, 0x88, 0x94, 0x3d, 0x28, 0x44, 0x8f,
+	0xc4, 0xb2, 0x4f, 0x58, 0x65, 0x6a, 0x45, 0x6b, 0xe4, 0x36, 0xd7, 0x9a, 0x77, 0xbf, 0x8f, 0x4d,
+	0xf4, 0xaa, 0x74, 0x72, 0x5c, 0x6d, 0xea, 0x5f, 0xd3, 0x90, 0xef, 0x50, 0x83, 0xb9, 0xa6, 0xa4,
+	0xbd, 0x05, 0x59, 0x57, 0xec, 0xc7, 0x80, 0x3d, 0xed, 0x4a, 0x27, 0x69, 0x40, 0x29, 0x72, 0xc5,
+	0x51, 0xcf, 0x84, 0x1a, 0x49, 0xba, 0x05, 0x65, 0xa5, 0x4c, 0x80, 0x9e, 0x0d, 0xd5, 0x8a, 0x73,
+	0x1b, 0xe6, 0x94, 0xa1, 0xa7, 0xf3, 0x1e, 0x35, 0xbb, 0xb6, 0xde, 0xa7, 0x92, 0x32, 0x09, 0x1d,
+	0xfb, 0xa2, 0xf5, 0x56, 0xef, 0x53, 0x52, 0x83, 0x9c, 0xb4, 0x20, 0xc4, 0x10, 0x94, 0x04, 0xe1,
+	0x04, 0xbe, 0xcc, 0x5f, 0xe2, 0xbb, 0x48, 0x43, 0x51, 0x34, 0x8f, 0x1c, 0x6a, 0x48, 0x82, 0xcf,
+	0xc2, 0x70, 0xee, 0x50, 0x63, 0x0c, 0x8a, 0x39, 0xae, 0x02, 0x7c, 0x3c, 0x31, 0x73, 0x1c, 0xe6,
+	0x2c, 0x92, 0x4a, 0x9e, 0x2f, 0x60, 0x39, 0x6e, 0x40, 0x3b, 0x04, 0xb6, 0x82, 0x9c, 0xd1, 0x81,
+	0x05, 0xdf, 0xe8, 0x2b, 0x20, 0x2c, 0x68, 0x66, 0x0b, 0x91, 0x45, 0x30, 0x8b, 0xeb, 0xd0, 0xf0,
+	0x16, 0x38, 0xce, 0xab, 0x7f, 0x49, 0x03, 0x79, 0xc9, 0x6c, 0xcf, 0xd5, 0x0d, 0x0f, 0x51, 0xd9,
+	0x81, 0x92, 0x21, 0xab, 0xe3, 0x82, 0x99, 0x31, 0x62, 0x31, 0xfe, 0xc4, 0x8d, 0x46, 0xc4, 0xf1,
+	0x94, 0xe3, 0x06, 0x49, 0xe8, 0x35, 0xac, 0x25, 0x6c, 0xf1, 0x02, 0xe2, 0x54, 0x8d, 0x47, 0xe0,
+	0x8b, 0x08, 0x5a, 0x0f, 0x81, 0xc4, 0xbd, 0x08, 0x58, 0x09, 0x7b, 0x05, 0xb3, 0x84, 0x1a, 0x61,
+	0x2b, 0x19, 0x23, 0xd9, 0xf5, 0x4f, 0x13, 0x50, 0x0a, 0x66, 0x11, 0x71, 0x7b, 0x0e, 0x72, 0x82,
+	0xc6, 0xa5, 0x96, 0x77, 0x51, 0x04, 0x9a, 0x9e, 0x3b, 0x89, 0x11, 0x2c, 0x96, 0xbc, 0xf6, 0x60,
+	0x75, 0xc4, 0x72, 0x2f, 0xad, 0xff, 0xb0, 0x3d, 0xc1, 0x6a, 0x1b, 0x96, 0x46, 0x82, 0x92, 0xe3,
+	0x3b, 0x8f, 0x13, 0xd0, 0x08, 0xab, 0x0f, 0x8a, 0xa2, 0x1c, 0x70, 0x9b, 0x51, 0x0e, 0xc1, 0xf8,
+	0x03, 0xcc, 0x25, 0x1e, 0x2f, 0x9a, 0xe9, 0xf5, 0xfb, 0x66, 0x3a, 0xf9, 0x8e, 0x76, 0x88, 0x91,
+	0xa8, 0xed, 0x7e, 0xbc, 0xbc, 0xa9, 0x6a, 0x57, 0x37, 0x55, 0xed, 0xe7, 0x4d, 0x55, 0xbb, 0xb8,
+	0xad, 0xa6, 0xae, 0x6e, 0xab, 0xa9, 0xef, 0xb7, 0xd5, 0x14, 0x2c, 0x5a, 0xec, 0x9e, 0xec, 0x43,
+	0xed, 0xfd, 0xd6, 0xa9, 0xe5, 0xf5, 0x06, 0xc7, 0x4d, 0x83, 0xf5, 0x5b, 0x4a, 0xb4, 0x61, 0x31,
+	0xb4, 0x6b, 0x0d, 0xd5, 0xaf, 0x85, 0x77, 0xee, 0x50, 0x7e, 0x9c, 0x11, 0xff, 0x09, 0x8f, 0xfe,
+	0x0c, 0x00, 0x81, 0x95, 0xb1, 0xa8, 0x7e, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -629,6 +641,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxScopeHistoryEntries != that1.MaxScopeHistoryEntries {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -651,6 +666,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxScopeHistoryEntries != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeHistoryEntries))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1080,6 +1100,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxScopeHistoryEntries != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeHistoryEntries))
+	}
 	return n
 }
 
@@ -1296,6 +1319,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeHistoryEntries", wireType)
+			}
+			m.MaxScopeHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -14,15 +14,23 @@ import (
 const (
 	TypeURLMsgWriteScopeRequest                      = "/provenance.metadata.v1.MsgWriteScopeRequest"
 	TypeURLMsgDeleteScopeRequest                     = "/provenance.metadata.v1.MsgDeleteScopeRequest"
+	TypeURLMsgWriteScopesRequest                     = "/provenance.metadata.v1.MsgWriteScopesRequest"
+	TypeURLMsgDeleteScopesRequest                    = "/provenance.metadata.v1.MsgDeleteScopesRequest"
 	TypeURLMsgAddScopeDataAccessRequest              = "/provenance.metadata.v1.MsgAddScopeDataAccessRequest"
 	TypeURLMsgDeleteScopeDataAccessRequest           = "/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest"
 	TypeURLMsgAddScopeOwnerRequest                   = "/provenance.metadata.v1.MsgAddScopeOwnerRequest"
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
 	TypeURLMsgUpdateValueOwnersRequest               = "/provenance.metadata.v1.MsgUpdateValueOwnersRequest"
 	TypeURLMsgMigrateValueOwnerRequest               = "/provenance.metadata.v1.MsgMigrateValueOwnerRequest"
+	TypeURLMsgMigrateScopeSpecificationRequest       = "/provenance.metadata.v1.MsgMigrateScopeSpecificationRequest"
+	TypeURLMsgAddScopeLienRequest                    = "/provenance.metadata.v1.MsgAddScopeLienRequest"
+	TypeURLMsgReleaseScopeLienRequest                = "/provenance.metadata.v1.MsgReleaseScopeLienRequest"
+	TypeURLMsgFractionalizeScopeValueRequest         = "/provenance.metadata.v1.MsgFractionalizeScopeValueRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
+	TypeURLMsgWriteRecordsRequest                    = "/provenance.metadata.v1.MsgWriteRecordsRequest"
+	TypeURLMsgDeleteRecordsRequest                   = "/provenance.metadata.v1.MsgDeleteRecordsRequest"
 	TypeURLMsgWriteScopeSpecificationRequest         = "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest"
 	TypeURLMsgDeleteScopeSpecificationRequest        = "/provenance.metadata.v1.MsgDeleteScopeSpecificationRequest"
 	TypeURLMsgWriteContractSpecificationRequest      = "/provenance.metadata.v1.MsgWriteContractSpecificationRequest"
@@ -51,15 +59,23 @@ type MetadataMsg interface {
 var AllRequestMsgs = []MetadataMsg{
 	(*MsgWriteScopeRequest)(nil),
 	(*MsgDeleteScopeRequest)(nil),
+	(*MsgWriteScopesRequest)(nil),
+	(*MsgDeleteScopesRequest)(nil),
 	(*MsgAddScopeDataAccessRequest)(nil),
 	(*MsgDeleteScopeDataAccessRequest)(nil),
 	(*MsgAddScopeOwnerRequest)(nil),
 	(*MsgDeleteScopeOwnerRequest)(nil),
 	(*MsgUpdateValueOwnersRequest)(nil),
 	(*MsgMigrateValueOwnerRequest)(nil),
+	(*MsgMigrateScopeSpecificationRequest)(nil),
+	(*MsgAddScopeLienRequest)(nil),
+	(*MsgReleaseScopeLienRequest)(nil),
+	(*MsgFractionalizeScopeValueRequest)(nil),
 	(*MsgWriteSessionRequest)(nil),
 	(*MsgWriteRecordRequest)(nil),
 	(*MsgDeleteRecordRequest)(nil),
+	(*MsgWriteRecordsRequest)(nil),
+	(*MsgDeleteRecordsRequest)(nil),
 
 	(*MsgWriteScopeSpecificationRequest)(nil),
 	(*MsgDeleteScopeSpecificationRequest)(nil),
@@ -80,6 +96,8 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgSetAccountDataRequest)(nil),
 
 	(*MsgAddNetAssetValuesRequest)(nil),
+
+	(*MsgUpdateParamsRequest)(nil),
 }
 
 // We still need these deprecated messages to be sdk.Msg for the codec.
@@ -180,6 +198,81 @@ func (msg MsgDeleteScopeRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgWriteScopesRequest  ------------------
+
+// NewMsgWriteScopesRequest creates a new msg instance
+func NewMsgWriteScopesRequest(scopes []Scope, signers []string) *MsgWriteScopesRequest {
+	return &MsgWriteScopesRequest{Scopes: scopes, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgWriteScopesRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgWriteScopesRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.Scopes) == 0 {
+		return fmt.Errorf("at least one scope is required")
+	}
+	seen := make(map[string]bool, len(msg.Scopes))
+	for i, scope := range msg.Scopes {
+		if err := scope.ValidateBasic(); err != nil {
+			return fmt.Errorf("scopes[%d]: %w", i, err)
+		}
+		if seen[string(scope.ScopeId)] {
+			return fmt.Errorf("scopes[%d]: duplicate scope id %s", i, scope.ScopeId)
+		}
+		seen[string(scope.ScopeId)] = true
+	}
+	return nil
+}
+
+// NewMsgWriteScopesResponse creates a new response with the ids of the provided scopes.
+func NewMsgWriteScopesResponse(scopeIDs []MetadataAddress) *MsgWriteScopesResponse {
+	rv := &MsgWriteScopesResponse{ScopeIdInfos: make([]*ScopeIdInfo, len(scopeIDs))}
+	for i, scopeID := range scopeIDs {
+		rv.ScopeIdInfos[i] = GetScopeIDInfo(scopeID)
+	}
+	return rv
+}
+
+// ------------------  MsgDeleteScopesRequest  ------------------
+
+// NewMsgDeleteScopesRequest creates a new msg instance
+func NewMsgDeleteScopesRequest(scopeIDs []MetadataAddress, signers []string) *MsgDeleteScopesRequest {
+	return &MsgDeleteScopesRequest{ScopeIds: scopeIDs, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgDeleteScopesRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgDeleteScopesRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.ScopeIds) == 0 {
+		return fmt.Errorf("at least one scope id is required")
+	}
+	seen := make(map[string]bool, len(msg.ScopeIds))
+	for i, scopeID := range msg.ScopeIds {
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("scope id[%d]: %q: invalid scope id", i, scopeID.String())
+		}
+		if seen[string(scopeID)] {
+			return fmt.Errorf("scope id[%d]: %q: duplicate scope id", i, scopeID.String())
+		}
+		seen[string(scopeID)] = true
+	}
+	return nil
+}
+
 // ------------------  MsgAddScopeDataAccessRequest  ------------------
 
 // NewMsgAddScopeDataAccessRequest creates a new msg instance
@@ -391,6 +484,134 @@ func (msg MsgMigrateValueOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgMigrateScopeSpecificationRequest  ------------------
+
+// NewMsgMigrateScopeSpecificationRequest creates a new msg instance
+func NewMsgMigrateScopeSpecificationRequest(scopeIDs []MetadataAddress, specificationID MetadataAddress, signers []string) *MsgMigrateScopeSpecificationRequest {
+	return &MsgMigrateScopeSpecificationRequest{
+		ScopeIds:        scopeIDs,
+		SpecificationId: specificationID,
+		Signers:         signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgMigrateScopeSpecificationRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgMigrateScopeSpecificationRequest) ValidateBasic() error {
+	if len(msg.ScopeIds) == 0 {
+		return fmt.Errorf("at least one scope id is required")
+	}
+	seen := make(map[string]bool, len(msg.ScopeIds))
+	for i, scopeID := range msg.ScopeIds {
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("scope id[%d]: %q: invalid scope id", i, scopeID.String())
+		}
+		if seen[string(scopeID)] {
+			return fmt.Errorf("scope id[%d]: %q: duplicate scope id", i, scopeID.String())
+		}
+		seen[string(scopeID)] = true
+	}
+
+	if !msg.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid scope specification id: %q", msg.SpecificationId.String())
+	}
+
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+
+	return nil
+}
+
+// ------------------  MsgAddScopeLienRequest  ------------------
+
+// NewMsgAddScopeLienRequest creates a new msg instance
+func NewMsgAddScopeLienRequest(lien ScopeLien, signers []string) *MsgAddScopeLienRequest {
+	return &MsgAddScopeLienRequest{
+		Lien:    lien,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgAddScopeLienRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgAddScopeLienRequest) ValidateBasic() error {
+	if err := msg.Lien.Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+
+	return nil
+}
+
+// ------------------  MsgReleaseScopeLienRequest  ------------------
+
+// NewMsgReleaseScopeLienRequest creates a new msg instance
+func NewMsgReleaseScopeLienRequest(scopeID MetadataAddress, signers []string) *MsgReleaseScopeLienRequest {
+	return &MsgReleaseScopeLienRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgReleaseScopeLienRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgReleaseScopeLienRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid scope id: %q", msg.ScopeId.String())
+	}
+
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+
+	return nil
+}
+
+// ------------------  MsgFractionalizeScopeValueRequest  ------------------
+
+// NewMsgFractionalizeScopeValueRequest creates a new msg instance
+func NewMsgFractionalizeScopeValueRequest(scopeID MetadataAddress, units uint64, signers []string) *MsgFractionalizeScopeValueRequest {
+	return &MsgFractionalizeScopeValueRequest{
+		ScopeId: scopeID,
+		Units:   units,
+		Signers: signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgFractionalizeScopeValueRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgFractionalizeScopeValueRequest) ValidateBasic() error {
+	if err := NewScopeValueUnits(msg.ScopeId, msg.Units).Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -540,6 +761,82 @@ func (msg MsgDeleteRecordRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgWriteRecordsRequest  ------------------
+
+// NewMsgWriteRecordsRequest creates a new msg instance
+func NewMsgWriteRecordsRequest(records []Record, signers []string) *MsgWriteRecordsRequest {
+	return &MsgWriteRecordsRequest{Records: records, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgWriteRecordsRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgWriteRecordsRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.Records) == 0 {
+		return fmt.Errorf("at least one record is required")
+	}
+	seen := make(map[string]bool, len(msg.Records))
+	for i, record := range msg.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("records[%d]: %w", i, err)
+		}
+		recordID := record.GetRecordAddress()
+		if seen[string(recordID)] {
+			return fmt.Errorf("records[%d]: duplicate record id %s", i, recordID)
+		}
+		seen[string(recordID)] = true
+	}
+	return nil
+}
+
+// NewMsgWriteRecordsResponse creates a new response with the ids of the provided records.
+func NewMsgWriteRecordsResponse(recordIDs []MetadataAddress) *MsgWriteRecordsResponse {
+	rv := &MsgWriteRecordsResponse{RecordIdInfos: make([]*RecordIdInfo, len(recordIDs))}
+	for i, recordID := range recordIDs {
+		rv.RecordIdInfos[i] = GetRecordIDInfo(recordID)
+	}
+	return rv
+}
+
+// ------------------  MsgDeleteRecordsRequest  ------------------
+
+// NewMsgDeleteRecordsRequest creates a new msg instance
+func NewMsgDeleteRecordsRequest(recordIDs []MetadataAddress, signers []string) *MsgDeleteRecordsRequest {
+	return &MsgDeleteRecordsRequest{RecordIds: recordIDs, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgDeleteRecordsRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgDeleteRecordsRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.RecordIds) == 0 {
+		return fmt.Errorf("at least one record id is required")
+	}
+	seen := make(map[string]bool, len(msg.RecordIds))
+	for i, recordID := range msg.RecordIds {
+		if !recordID.IsRecordAddress() {
+			return fmt.Errorf("record id[%d]: %q: invalid record id", i, recordID.String())
+		}
+		if seen[string(recordID)] {
+			return fmt.Errorf("record id[%d]: %q: duplicate record id", i, recordID.String())
+		}
+		seen[string(recordID)] = true
+	}
+	return nil
+}
+
 // ------------------  MsgWriteScopeSpecificationRequest  ------------------
 
 // NewMsgWriteScopeSpecificationRequest creates a new msg instance
@@ -879,6 +1176,9 @@ func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if msg.KeyActivationHeight < 0 {
+		return fmt.Errorf("key activation height %d cannot be negative", msg.KeyActivationHeight)
+	}
 
 	return nil
 }
@@ -1000,3 +1300,26 @@ func (msg *SessionIdComponents) GetSessionAddr() (MetadataAddress, error) {
 	ma := SessionMetadataAddress(*scopeUUID, *sessionUUID)
 	return ma, nil
 }
+
+// ------------------  MsgUpdateParamsRequest  ------------------
+
+// NewMsgUpdateParamsRequest creates a new UpdateParamsRequest message.
+func NewMsgUpdateParamsRequest(authority string, maxScopeHistoryEntries uint32) *MsgUpdateParamsRequest {
+	return &MsgUpdateParamsRequest{
+		Authority: authority,
+		Params:    NewParams(maxScopeHistoryEntries),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdateParamsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgUpdateParamsRequest) GetSignerStrs() []string {
+	return []string{msg.Authority}
+}
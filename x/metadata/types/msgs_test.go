@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -54,20 +55,29 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
 		func(signers []string) sdk.Msg { return &MsgWriteScopeRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgWriteScopesRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgDeleteScopesRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgAddScopeDataAccessRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeDataAccessRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgAddScopeOwnerRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeOwnerRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgUpdateValueOwnersRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgMigrateValueOwnerRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgMigrateScopeSpecificationRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgAddScopeLienRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgReleaseScopeLienRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgFractionalizeScopeValueRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteSessionRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteRecordRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteRecordRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgWriteRecordsRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgDeleteRecordsRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteContractSpecificationRequest{Signers: signers} },
@@ -104,7 +114,8 @@ func TestWriteScopeRoute(t *testing.T) {
 		"\"owners\":[{\"address\":\"data_owner\",\"role\":\"PARTY_TYPE_OWNER\",\"optional\":false}]," +
 		"\"data_access\":[\"data_accessor\"]," +
 		"\"value_owner_address\":\"value_owner\"," +
-		"\"require_party_rollup\":false" +
+		"\"require_party_rollup\":false," +
+		"\"parent_scope_id\":null" +
 		"}," +
 		"\"signers\":[]," +
 		"\"scope_uuid\":\"\"," +
@@ -119,6 +130,7 @@ func TestWriteScopeRoute(t *testing.T) {
   - address: data_owner
     optional: false
     role: PARTY_TYPE_OWNER
+  parent_scope_id: null
   require_party_rollup: false
   scope_id: scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp
   specification_id: scopespec1qs30c9axgrw5669ft0kffe6h9gysfe58v3
@@ -651,6 +663,446 @@ func TestMsgMigrateValueOwnerRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMigrateScopeSpecificationRequest_ValidateBasic(t *testing.T) {
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	signers := []string{sdk.AccAddress("signer______________").String()}
+
+	tests := []struct {
+		name string
+		msg  MsgMigrateScopeSpecificationRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1, scopeID2}, scopeSpecID, signers),
+			exp:  "",
+		},
+		{
+			name: "no scope ids",
+			msg:  *NewMsgMigrateScopeSpecificationRequest(nil, scopeSpecID, signers),
+			exp:  "at least one scope id is required",
+		},
+		{
+			name: "bad scope id",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1, scopeSpecID}, scopeSpecID, signers),
+			exp:  fmt.Sprintf("scope id[1]: %q: invalid scope id", scopeSpecID.String()),
+		},
+		{
+			name: "duplicate scope id",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1, scopeID2, scopeID1}, scopeSpecID, signers),
+			exp:  fmt.Sprintf("scope id[2]: %q: duplicate scope id", scopeID1.String()),
+		},
+		{
+			name: "no scope spec id",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1}, nil, signers),
+			exp:  `invalid scope specification id: ""`,
+		},
+		{
+			name: "scope id as scope spec id",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1}, scopeID2, signers),
+			exp:  fmt.Sprintf("invalid scope specification id: %q", scopeID2.String()),
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgMigrateScopeSpecificationRequest([]MetadataAddress{scopeID1}, scopeSpecID, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgAddScopeLienRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	lienholder := sdk.AccAddress("lienholder__________").String()
+	signers := []string{sdk.AccAddress("signer______________").String()}
+	releaseTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	var zeroTime time.Time
+
+	tests := []struct {
+		name string
+		msg  MsgAddScopeLienRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeID, lienholder, nil), signers),
+			exp:  "",
+		},
+		{
+			name: "with release time",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeID, lienholder, &releaseTime), signers),
+			exp:  "",
+		},
+		{
+			name: "no scope id",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(nil, lienholder, nil), signers),
+			exp:  "invalid scope lien scope id: invalid scope metadata address MetadataAddress(nil): address is empty",
+		},
+		{
+			name: "scope spec id",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeSpecID, lienholder, nil), signers),
+			exp:  fmt.Sprintf("invalid scope lien scope id: invalid scope id %q: wrong type", scopeSpecID.String()),
+		},
+		{
+			name: "no lienholder",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeID, "", nil), signers),
+			exp:  `invalid scope lien lienholder "": empty address string is not allowed`,
+		},
+		{
+			name: "zero release time",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeID, lienholder, &zeroTime), signers),
+			exp:  "invalid scope lien release time: cannot be zero",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgAddScopeLienRequest(NewScopeLien(scopeID, lienholder, nil), nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgReleaseScopeLienRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	signers := []string{sdk.AccAddress("signer______________").String()}
+
+	tests := []struct {
+		name string
+		msg  MsgReleaseScopeLienRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgReleaseScopeLienRequest(scopeID, signers),
+			exp:  "",
+		},
+		{
+			name: "no scope id",
+			msg:  *NewMsgReleaseScopeLienRequest(nil, signers),
+			exp:  `invalid scope id: ""`,
+		},
+		{
+			name: "scope spec id",
+			msg:  *NewMsgReleaseScopeLienRequest(scopeSpecID, signers),
+			exp:  fmt.Sprintf("invalid scope id: %q", scopeSpecID.String()),
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgReleaseScopeLienRequest(scopeID, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgFractionalizeScopeValueRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	signers := []string{sdk.AccAddress("signer______________").String()}
+
+	tests := []struct {
+		name string
+		msg  MsgFractionalizeScopeValueRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeID, 100, signers),
+			exp:  "",
+		},
+		{
+			name: "two units",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeID, 2, signers),
+			exp:  "",
+		},
+		{
+			name: "no scope id",
+			msg:  *NewMsgFractionalizeScopeValueRequest(nil, 100, signers),
+			exp:  "invalid scope value units scope id: invalid scope metadata address MetadataAddress(nil): address is empty",
+		},
+		{
+			name: "scope spec id",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeSpecID, 100, signers),
+			exp:  fmt.Sprintf("invalid scope value units scope id: invalid scope id %q: wrong type", scopeSpecID.String()),
+		},
+		{
+			name: "zero units",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeID, 0, signers),
+			exp:  "invalid scope value units: must be more than one",
+		},
+		{
+			name: "one unit",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeID, 1, signers),
+			exp:  "invalid scope value units: must be more than one",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgFractionalizeScopeValueRequest(scopeID, 100, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgWriteScopesRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	signers := []string{owner}
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	newScope := func() Scope {
+		return *NewScope(ScopeMetadataAddress(uuid.New()), specID, OwnerPartyList(owner), nil, owner, false)
+	}
+	scope1 := newScope()
+	scope2 := newScope()
+	badScope := newScope()
+	badScope.Owners = nil
+
+	tests := []struct {
+		name string
+		msg  MsgWriteScopesRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgWriteScopesRequest([]Scope{scope1, scope2}, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgWriteScopesRequest([]Scope{scope1}, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "no scopes",
+			msg:  *NewMsgWriteScopesRequest(nil, signers),
+			exp:  "at least one scope is required",
+		},
+		{
+			name: "invalid scope",
+			msg:  *NewMsgWriteScopesRequest([]Scope{scope1, badScope}, signers),
+			exp:  "scopes[1]: invalid scope owners: at least one party is required",
+		},
+		{
+			name: "duplicate scope",
+			msg:  *NewMsgWriteScopesRequest([]Scope{scope1, scope2, scope1}, signers),
+			exp:  "scopes[2]: duplicate scope id " + scope1.ScopeId.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgDeleteScopesRequest_ValidateBasic(t *testing.T) {
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+	recordID := RecordMetadataAddress(uuid.New(), "record")
+	signers := []string{sdk.AccAddress("signer______________").String()}
+
+	tests := []struct {
+		name string
+		msg  MsgDeleteScopesRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgDeleteScopesRequest([]MetadataAddress{scopeID1, scopeID2}, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgDeleteScopesRequest([]MetadataAddress{scopeID1}, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "no scope ids",
+			msg:  *NewMsgDeleteScopesRequest(nil, signers),
+			exp:  "at least one scope id is required",
+		},
+		{
+			name: "bad scope id",
+			msg:  *NewMsgDeleteScopesRequest([]MetadataAddress{scopeID1, recordID}, signers),
+			exp:  fmt.Sprintf("scope id[1]: %q: invalid scope id", recordID.String()),
+		},
+		{
+			name: "duplicate scope id",
+			msg:  *NewMsgDeleteScopesRequest([]MetadataAddress{scopeID1, scopeID2, scopeID1}, signers),
+			exp:  fmt.Sprintf("scope id[2]: %q: duplicate scope id", scopeID1.String()),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgWriteRecordsRequest_ValidateBasic(t *testing.T) {
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	signers := []string{sdk.AccAddress("signer______________").String()}
+	input := NewRecordInput("ri_name", &RecordInput_Hash{"hash"}, "ri_type", RecordInputStatus_Proposed)
+	output := NewRecordOutput("ro_hash", ResultStatus_RESULT_STATUS_PASS)
+	process := NewProcess("process_name", &Process_Hash{"address"}, "method")
+	newRecord := func(name string) Record {
+		return *NewRecord(name, sessionID, *process, []RecordInput{*input}, []RecordOutput{*output}, nil)
+	}
+	record1 := newRecord("record1")
+	record2 := newRecord("record2")
+	badRecord := newRecord("bad")
+	badRecord.SessionId = nil
+
+	tests := []struct {
+		name string
+		msg  MsgWriteRecordsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgWriteRecordsRequest([]Record{record1, record2}, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgWriteRecordsRequest([]Record{record1}, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "no records",
+			msg:  *NewMsgWriteRecordsRequest(nil, signers),
+			exp:  "at least one record is required",
+		},
+		{
+			name: "invalid record",
+			msg:  *NewMsgWriteRecordsRequest([]Record{record1, badRecord}, signers),
+			exp:  "records[1]: " + badRecord.ValidateBasic().Error(),
+		},
+		{
+			name: "duplicate record",
+			msg:  *NewMsgWriteRecordsRequest([]Record{record1, record2, record1}, signers),
+			exp:  "records[2]: duplicate record id " + record1.GetRecordAddress().String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgDeleteRecordsRequest_ValidateBasic(t *testing.T) {
+	scopeUUID := uuid.New()
+	recordID1 := RecordMetadataAddress(scopeUUID, "record1")
+	recordID2 := RecordMetadataAddress(scopeUUID, "record2")
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	signers := []string{sdk.AccAddress("signer______________").String()}
+
+	tests := []struct {
+		name string
+		msg  MsgDeleteRecordsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgDeleteRecordsRequest([]MetadataAddress{recordID1, recordID2}, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgDeleteRecordsRequest([]MetadataAddress{recordID1}, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "no record ids",
+			msg:  *NewMsgDeleteRecordsRequest(nil, signers),
+			exp:  "at least one record id is required",
+		},
+		{
+			name: "bad record id",
+			msg:  *NewMsgDeleteRecordsRequest([]MetadataAddress{recordID1, scopeID}, signers),
+			exp:  fmt.Sprintf("record id[1]: %q: invalid record id", scopeID.String()),
+		},
+		{
+			name: "duplicate record id",
+			msg:  *NewMsgDeleteRecordsRequest([]MetadataAddress{recordID1, recordID2, recordID1}, signers),
+			exp:  fmt.Sprintf("record id[2]: %q: duplicate record id", recordID1.String()),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -752,7 +1204,7 @@ func TestBindOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgBindOSLocatorRequest", sdk.MsgTypeURL(bindRequestMsg))
 
 	bz, _ := GetCdc(t).MarshalJSON(bindRequestMsg)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"name\":\"\",\"primary\":false,\"encryption_key_versions\":[]}}", string(bz))
 }
 
 func TestModifyOSLocator(t *testing.T) {
@@ -764,7 +1216,7 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgModifyOSLocatorRequest", sdk.MsgTypeURL(modifyRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(modifyRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"name\":\"\",\"primary\":false,\"encryption_key_versions\":[]},\"key_activation_height\":\"0\"}", string(bz))
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -776,7 +1228,7 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgDeleteOSLocatorRequest", sdk.MsgTypeURL(deleteRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(deleteRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"name\":\"\",\"primary\":false,\"encryption_key_versions\":[]}}", string(bz))
 }
 
 func TestBindOSLocatorInvalid(t *testing.T) {
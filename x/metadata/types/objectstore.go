@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxOSLocatorNameLength is the maximum length of the name of an object store locator.
+const MaxOSLocatorNameLength = 64
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator {
 	return ObjectStoreLocator{
@@ -40,5 +43,48 @@ func (r ObjectStoreLocator) Validate() error {
 				r.Owner, r.EncryptionKey)
 		}
 	}
+
+	if len(r.Name) > MaxOSLocatorNameLength {
+		return fmt.Errorf("locator name %q length %d exceeds maximum length of %d", r.Name, len(r.Name), MaxOSLocatorNameLength)
+	}
+	if strings.TrimSpace(r.Name) != r.Name {
+		return fmt.Errorf("locator name %q cannot have leading or trailing whitespace", r.Name)
+	}
+
+	for i, keyVer := range r.EncryptionKeyVersions {
+		if keyVer.Version != uint32(i+1) {
+			return fmt.Errorf("invalid encryption key version %d at index %d: expected %d", keyVer.Version, i, i+1)
+		}
+		if keyVer.ActivationHeight < 0 {
+			return fmt.Errorf("invalid encryption key version %d: activation height %d cannot be negative",
+				keyVer.Version, keyVer.ActivationHeight)
+		}
+		if i > 0 && keyVer.ActivationHeight < r.EncryptionKeyVersions[i-1].ActivationHeight {
+			return fmt.Errorf("invalid encryption key version %d: activation height %d cannot be before the previous version's activation height %d",
+				keyVer.Version, keyVer.ActivationHeight, r.EncryptionKeyVersions[i-1].ActivationHeight)
+		}
+		if len(keyVer.EncryptionKey) > 0 {
+			if _, err := sdk.AccAddressFromBech32(keyVer.EncryptionKey); err != nil {
+				return fmt.Errorf("invalid encryption key version %d: invalid encryption key address: %s",
+					keyVer.Version, keyVer.EncryptionKey)
+			}
+		}
+	}
+
 	return nil
 }
+
+// AtHeight returns a copy of this locator with the encryption key that was in effect at the provided height.
+// The returned bool is false if none of this locator's encryption key versions had taken effect by that height.
+func (r ObjectStoreLocator) AtHeight(height int64) (ObjectStoreLocator, bool) {
+	if len(r.EncryptionKeyVersions) == 0 {
+		return r, true
+	}
+	for i := len(r.EncryptionKeyVersions) - 1; i >= 0; i-- {
+		if r.EncryptionKeyVersions[i].ActivationHeight <= height {
+			r.EncryptionKey = r.EncryptionKeyVersions[i].EncryptionKey
+			return r, true
+		}
+	}
+	return r, false
+}
@@ -32,7 +32,17 @@ type ObjectStoreLocator struct {
 	// locator endpoint uri
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
+	// This is the key that was in effect when the locator was last updated. A key with a later activation height is only
+	// in encryption_key_versions until then. In query responses, it is the key in effect at the height being queried.
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// name distinguishes between the locators of an owner. An owner can have one locator without a name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// primary is whether this is the locator to use for the owner when a locator name isn't given.
+	// An owner has at most one locator flagged as primary.
+	Primary bool `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
+	// encryption_key_versions is the history of this locator's encryption key, ordered by version.
+	// If there are none, the encryption_key has been in effect since the locator was bound.
+	EncryptionKeyVersions []OSLocatorEncryptionKey `protobuf:"bytes,6,rep,name=encryption_key_versions,json=encryptionKeyVersions,proto3" json:"encryption_key_versions"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -89,6 +99,92 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ObjectStoreLocator) GetPrimary() bool {
+	if m != nil {
+		return m.Primary
+	}
+	return false
+}
+
+func (m *ObjectStoreLocator) GetEncryptionKeyVersions() []OSLocatorEncryptionKey {
+	if m != nil {
+		return m.EncryptionKeyVersions
+	}
+	return nil
+}
+
+// OSLocatorEncryptionKey is one version of the encryption key of an object store locator.
+type OSLocatorEncryptionKey struct {
+	// version is the version number of this key. The first version is 1.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// encryption_key is the owner's encryption key address for this version. It can be empty.
+	EncryptionKey string `protobuf:"bytes,2,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// activation_height is the block height at which this version takes effect.
+	// The previous version stays in effect until then.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *OSLocatorEncryptionKey) Reset()         { *m = OSLocatorEncryptionKey{} }
+func (m *OSLocatorEncryptionKey) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorEncryptionKey) ProtoMessage()    {}
+func (*OSLocatorEncryptionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+}
+func (m *OSLocatorEncryptionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorEncryptionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorEncryptionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorEncryptionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorEncryptionKey.Merge(m, src)
+}
+func (m *OSLocatorEncryptionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorEncryptionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorEncryptionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorEncryptionKey proto.InternalMessageInfo
+
+func (m *OSLocatorEncryptionKey) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OSLocatorEncryptionKey) GetEncryptionKey() string {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return ""
+}
+
+func (m *OSLocatorEncryptionKey) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length"`
@@ -98,7 +194,7 @@ func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
 func (m *OSLocatorParams) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParams) ProtoMessage()    {}
 func (*OSLocatorParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+	return fileDescriptor_3d17fc5ccfa1c263, []int{2}
 }
 func (m *OSLocatorParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,6 +225,7 @@ var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
+	proto.RegisterType((*OSLocatorEncryptionKey)(nil), "provenance.metadata.v1.OSLocatorEncryptionKey")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
 }
 
@@ -137,28 +234,35 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0xef, 0x34, 0x92, 0x58, 0x05, 0x93, 0x0b, 0x51, 0x64, 0x38, 0x0c, 0x89, 0x09, 0x31,
-	0xf1, 0x1a, 0x84, 0xc9, 0x91, 0xc5, 0x41, 0x12, 0x08, 0x84, 0xc5, 0x85, 0x94, 0xb3, 0x29, 0x15,
-	0xda, 0x77, 0x69, 0x0b, 0x72, 0x8b, 0x83, 0x9f, 0xc0, 0x8f, 0xe2, 0xc7, 0x60, 0x64, 0x34, 0x0e,
-	0xc4, 0xc0, 0xe0, 0xd7, 0x30, 0xd7, 0xc3, 0x9c, 0x83, 0xdb, 0x7b, 0xff, 0xfe, 0xde, 0xff, 0xb5,
-	0xff, 0xa2, 0x5a, 0xa4, 0x60, 0x4e, 0x25, 0x91, 0x21, 0xc5, 0x82, 0x1a, 0xf2, 0x48, 0x0c, 0xc1,
-	0xf3, 0x3a, 0x86, 0xd1, 0x13, 0x0d, 0x8d, 0x36, 0xa0, 0x68, 0x10, 0x29, 0x30, 0xe0, 0x9d, 0x66,
-	0x64, 0xf0, 0x4b, 0x06, 0xf3, 0x7a, 0xf9, 0x2c, 0x04, 0x2d, 0x40, 0x63, 0xa1, 0x59, 0x32, 0x28,
-	0x34, 0x4b, 0x07, 0xca, 0x45, 0x06, 0x0c, 0x6c, 0x89, 0x93, 0x2a, 0x55, 0xab, 0x2f, 0xc8, 0xeb,
-	0x58, 0xef, 0x7e, 0xe2, 0xdd, 0x86, 0x90, 0x18, 0x50, 0x5e, 0x11, 0x1d, 0xc0, 0xb3, 0xa4, 0xaa,
-	0xe4, 0x5e, 0xb8, 0xb5, 0xc3, 0x5e, 0xda, 0x78, 0x15, 0x74, 0x34, 0x4d, 0x81, 0xe1, 0x4c, 0xf1,
-	0xd2, 0x9e, 0x3d, 0x43, 0x3b, 0x69, 0xa0, 0xb8, 0x77, 0x89, 0x0a, 0x54, 0x86, 0x2a, 0x8e, 0x0c,
-	0x07, 0x39, 0x9c, 0xd0, 0xb8, 0xb4, 0x6f, 0x99, 0x7c, 0xa6, 0xde, 0xd3, 0xf8, 0x16, 0xbd, 0x7e,
-	0xbf, 0x5f, 0xa5, 0x9e, 0xd5, 0x3b, 0x74, 0xd2, 0xe9, 0xef, 0xd6, 0x76, 0x89, 0x22, 0x42, 0x7b,
-	0x4d, 0x54, 0x10, 0x64, 0x91, 0xac, 0x18, 0x4e, 0xa9, 0x64, 0x66, 0x6c, 0x6f, 0x91, 0x6f, 0x15,
-	0x96, 0xeb, 0x8a, 0xf3, 0xb9, 0xae, 0xe4, 0x66, 0x5c, 0x9a, 0xc6, 0x4d, 0xef, 0x58, 0x90, 0xc5,
-	0x40, 0xf1, 0xb6, 0x65, 0x5a, 0x93, 0xe5, 0xc6, 0x77, 0x57, 0x1b, 0xdf, 0xfd, 0xda, 0xf8, 0xee,
-	0xdb, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0x0e, 0x3a, 0xe7, 0xf6, 0xb1, 0xff, 0x84,
-	0xd5, 0x75, 0x1f, 0x9a, 0x8c, 0x9b, 0xf1, 0x6c, 0x14, 0x84, 0x20, 0x70, 0x06, 0x5d, 0x73, 0xf8,
-	0xd3, 0xe1, 0x45, 0xf6, 0x17, 0x26, 0x8e, 0xa8, 0x1e, 0xe5, 0x6c, 0x78, 0x8d, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x78, 0x72, 0x38, 0xaf, 0x01, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x69, 0x80, 0x2d, 0x0d, 0xb0, 0x2a, 0xad, 0xe9, 0xc1, 0x89, 0x22, 0x21, 0x45,
+	0x20, 0x6c, 0xf5, 0xe3, 0xc4, 0x31, 0x12, 0x02, 0x89, 0x4a, 0xad, 0x5c, 0x95, 0x03, 0x17, 0x6b,
+	0x63, 0x56, 0xf6, 0xd2, 0xec, 0x8e, 0xb5, 0xbb, 0x31, 0xf1, 0x95, 0x03, 0x67, 0xce, 0xfc, 0x0a,
+	0x7e, 0x46, 0x8f, 0x3d, 0x22, 0x0e, 0x15, 0x4a, 0x0e, 0xfc, 0x0d, 0xe4, 0xb5, 0x83, 0x5b, 0xc9,
+	0xbd, 0xcd, 0xbc, 0x79, 0xfb, 0xfc, 0xe6, 0x79, 0xf0, 0x38, 0x55, 0x90, 0x31, 0x49, 0x65, 0xc4,
+	0x7c, 0xc1, 0x0c, 0xfd, 0x44, 0x0d, 0xf5, 0xb3, 0x7d, 0x1f, 0xa6, 0x9f, 0x59, 0x64, 0xb4, 0x01,
+	0xc5, 0xbc, 0x54, 0x81, 0x01, 0xb2, 0x53, 0x33, 0xbd, 0x35, 0xd3, 0xcb, 0xf6, 0xf7, 0x76, 0x23,
+	0xd0, 0x02, 0xb4, 0x2f, 0x74, 0x5c, 0x3c, 0x14, 0x3a, 0x2e, 0x1f, 0xec, 0x6d, 0xc7, 0x10, 0x83,
+	0x2d, 0xfd, 0xa2, 0x2a, 0xd1, 0xd1, 0x8f, 0x36, 0x26, 0x27, 0x56, 0xfc, 0xac, 0x10, 0x3f, 0x86,
+	0x88, 0x1a, 0x50, 0x64, 0x1b, 0x6f, 0xc0, 0x17, 0xc9, 0x94, 0x83, 0x86, 0x68, 0xfc, 0x20, 0x28,
+	0x1b, 0x32, 0xc0, 0x9b, 0xb3, 0x92, 0x10, 0xce, 0x15, 0x77, 0xda, 0x76, 0x86, 0x2b, 0xe8, 0x5c,
+	0x71, 0xf2, 0x1c, 0xf7, 0x99, 0x8c, 0x54, 0x9e, 0x1a, 0x0e, 0x32, 0xbc, 0x60, 0xb9, 0xd3, 0xb1,
+	0x9c, 0xad, 0x1a, 0x7d, 0xcf, 0x72, 0x42, 0x70, 0x57, 0x52, 0xc1, 0x9c, 0xae, 0x1d, 0xda, 0x9a,
+	0x38, 0xf8, 0x5e, 0xaa, 0xb8, 0xa0, 0x2a, 0x77, 0x36, 0x86, 0x68, 0x7c, 0x3f, 0x58, 0xb7, 0x64,
+	0x86, 0x77, 0x6f, 0x8b, 0x86, 0x19, 0x53, 0x9a, 0x83, 0xd4, 0x4e, 0x6f, 0xd8, 0x19, 0x6f, 0x1e,
+	0x78, 0x5e, 0x73, 0x16, 0xde, 0xc9, 0x59, 0xb5, 0xcf, 0x9b, 0x9b, 0x9f, 0x9f, 0x74, 0x2f, 0xaf,
+	0x07, 0xad, 0xe0, 0xe9, 0x2d, 0x4f, 0x1f, 0x2a, 0xc9, 0xd7, 0xf8, 0xeb, 0xdf, 0x9f, 0x2f, 0xca,
+	0x7d, 0x47, 0xdf, 0x10, 0xde, 0x69, 0xd6, 0x28, 0xec, 0x56, 0x2e, 0x6c, 0x44, 0x5b, 0xc1, 0xba,
+	0x6d, 0xc8, 0xa0, 0xdd, 0x94, 0xc1, 0x4b, 0xfc, 0x84, 0x46, 0x86, 0x67, 0xd4, 0xd2, 0x12, 0xc6,
+	0xe3, 0xc4, 0xd8, 0xb4, 0x3a, 0xc1, 0xe3, 0x7a, 0xf0, 0xce, 0xe2, 0xa3, 0xb7, 0xf8, 0xd1, 0x7f,
+	0x1f, 0xa7, 0x54, 0x51, 0xa1, 0xc9, 0x11, 0xee, 0x0b, 0xba, 0x28, 0xfe, 0x43, 0x38, 0x63, 0x32,
+	0x36, 0x49, 0xe9, 0x63, 0xd2, 0x2f, 0x96, 0xfb, 0x7d, 0x3d, 0xe8, 0xcd, 0xb9, 0x34, 0x87, 0x07,
+	0xc1, 0x43, 0x41, 0x17, 0xe7, 0x8a, 0x1f, 0x5b, 0xce, 0xe4, 0xe2, 0x72, 0xe9, 0xa2, 0xab, 0xa5,
+	0x8b, 0xfe, 0x2c, 0x5d, 0xf4, 0x7d, 0xe5, 0xb6, 0xae, 0x56, 0x6e, 0xeb, 0xd7, 0xca, 0x6d, 0xe1,
+	0x67, 0x1c, 0xee, 0x88, 0xf1, 0x14, 0x7d, 0x3c, 0x8a, 0xb9, 0x49, 0xe6, 0x53, 0x2f, 0x02, 0xe1,
+	0xd7, 0xa4, 0x57, 0x1c, 0x6e, 0x74, 0xfe, 0xa2, 0xbe, 0x58, 0x93, 0xa7, 0x4c, 0x4f, 0x7b, 0xf6,
+	0xc4, 0x0e, 0xff, 0x0d, 0x00, 0x5e, 0x98, 0xcf, 0x8c, 0xd5, 0x02, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -181,6 +285,37 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncryptionKeyVersions) > 0 {
+		for iNdEx := len(m.EncryptionKeyVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptionKeyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Primary {
+		i--
+		if m.Primary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -205,6 +340,46 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OSLocatorEncryptionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorEncryptionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorEncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OSLocatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +437,38 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.Primary {
+		n += 2
+	}
+	if len(m.EncryptionKeyVersions) > 0 {
+		for _, e := range m.EncryptionKeyVersions {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OSLocatorEncryptionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovObjectstore(uint64(m.Version))
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -408,6 +615,212 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Primary = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyVersions = append(m.EncryptionKeyVersions, OSLocatorEncryptionKey{})
+			if err := m.EncryptionKeyVersions[len(m.EncryptionKeyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSLocatorEncryptionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorEncryptionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorEncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
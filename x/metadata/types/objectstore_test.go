@@ -0,0 +1,184 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestObjectStoreLocatorValidate(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	key1 := sdk.AccAddress("encryption_key_1____").String()
+	key2 := sdk.AccAddress("encryption_key_2____").String()
+	longName := strings.Repeat("n", MaxOSLocatorNameLength+1)
+
+	keyVer := func(version uint32, key string, height int64) OSLocatorEncryptionKey {
+		return OSLocatorEncryptionKey{Version: version, EncryptionKey: key, ActivationHeight: height}
+	}
+	newLocator := func(name string, versions ...OSLocatorEncryptionKey) ObjectStoreLocator {
+		return ObjectStoreLocator{
+			Owner:                 owner,
+			LocatorUri:            "https://example.com",
+			Name:                  name,
+			EncryptionKeyVersions: versions,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		locator ObjectStoreLocator
+		expErr  string
+	}{
+		{
+			name:    "unnamed without versions",
+			locator: newLocator(""),
+		},
+		{
+			name:    "named with versions",
+			locator: newLocator("backup", keyVer(1, key1, 5), keyVer(2, key2, 5), keyVer(3, "", 7)),
+		},
+		{
+			name:    "name at max length",
+			locator: newLocator(longName[1:]),
+		},
+		{
+			name:    "name too long",
+			locator: newLocator(longName),
+			expErr:  `locator name "` + longName + `" length 65 exceeds maximum length of 64`,
+		},
+		{
+			name:    "name with trailing whitespace",
+			locator: newLocator("backup "),
+			expErr:  `locator name "backup " cannot have leading or trailing whitespace`,
+		},
+		{
+			name:    "first version not one",
+			locator: newLocator("", keyVer(2, key1, 5)),
+			expErr:  "invalid encryption key version 2 at index 0: expected 1",
+		},
+		{
+			name:    "skipped version",
+			locator: newLocator("", keyVer(1, key1, 5), keyVer(3, key2, 6)),
+			expErr:  "invalid encryption key version 3 at index 1: expected 2",
+		},
+		{
+			name:    "negative activation height",
+			locator: newLocator("", keyVer(1, key1, -1)),
+			expErr:  "invalid encryption key version 1: activation height -1 cannot be negative",
+		},
+		{
+			name:    "activation height before previous version",
+			locator: newLocator("", keyVer(1, key1, 5), keyVer(2, key2, 4)),
+			expErr:  "invalid encryption key version 2: activation height 4 cannot be before the previous version's activation height 5",
+		},
+		{
+			name:    "invalid version encryption key",
+			locator: newLocator("", keyVer(1, "notakey", 5)),
+			expErr:  "invalid encryption key version 1: invalid encryption key address: notakey",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locator.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestObjectStoreLocatorAtHeight(t *testing.T) {
+	locator := ObjectStoreLocator{
+		EncryptionKey: "key3",
+		EncryptionKeyVersions: []OSLocatorEncryptionKey{
+			{Version: 1, EncryptionKey: "key1", ActivationHeight: 10},
+			{Version: 2, EncryptionKey: "key2", ActivationHeight: 20},
+			{Version: 3, EncryptionKey: "key3", ActivationHeight: 30},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		locator  ObjectStoreLocator
+		height   int64
+		expKey   string
+		expFound bool
+	}{
+		{name: "no versions", locator: ObjectStoreLocator{EncryptionKey: "key"}, height: 1, expKey: "key", expFound: true},
+		{name: "before first version", locator: locator, height: 9, expKey: "key3", expFound: false},
+		{name: "at first version", locator: locator, height: 10, expKey: "key1", expFound: true},
+		{name: "between versions", locator: locator, height: 25, expKey: "key2", expFound: true},
+		{name: "at last version", locator: locator, height: 30, expKey: "key3", expFound: true},
+		{name: "after last version", locator: locator, height: 500, expKey: "key3", expFound: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, found := tc.locator.AtHeight(tc.height)
+			assert.Equal(t, tc.expFound, found, "AtHeight found")
+			assert.Equal(t, tc.expKey, act.EncryptionKey, "AtHeight encryption key")
+			assert.Equal(t, tc.locator.EncryptionKeyVersions, act.EncryptionKeyVersions, "AtHeight encryption key versions")
+		})
+	}
+}
+
+func TestGenesisStateValidateObjectStoreLocators(t *testing.T) {
+	owner1 := sdk.AccAddress("owner_1_____________").String()
+	owner2 := sdk.AccAddress("owner_2_____________").String()
+	newLocator := func(owner, name string, primary bool) ObjectStoreLocator {
+		return ObjectStoreLocator{Owner: owner, LocatorUri: "https://example.com", Name: name, Primary: primary}
+	}
+
+	tests := []struct {
+		name     string
+		locators []ObjectStoreLocator
+		expErr   string
+	}{
+		{
+			name:     "no locators",
+			locators: nil,
+		},
+		{
+			name: "multiple locators for multiple owners",
+			locators: []ObjectStoreLocator{
+				newLocator(owner1, "", false),
+				newLocator(owner1, "backup", true),
+				newLocator(owner2, "", true),
+				newLocator(owner2, "backup", false),
+			},
+		},
+		{
+			name:     "invalid locator",
+			locators: []ObjectStoreLocator{newLocator(owner1, "", false), newLocator("", "", false)},
+			expErr:   "invalid object store locator [1]: owner address cannot be empty",
+		},
+		{
+			name:     "duplicate name",
+			locators: []ObjectStoreLocator{newLocator(owner1, "backup", false), newLocator(owner1, "backup", false)},
+			expErr:   `duplicate object store locator [1]: owner ` + owner1 + ` name "backup"`,
+		},
+		{
+			name:     "two primary locators",
+			locators: []ObjectStoreLocator{newLocator(owner1, "", true), newLocator(owner1, "backup", true)},
+			expErr:   "object store locator [1]: owner " + owner1 + " has more than one primary locator",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := GenesisState{ObjectStoreLocators: tc.locators}
+			err := state.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package types
 
+// DefaultMaxScopeHistoryEntries is the default maximum number of change log entries kept for each scope.
+const DefaultMaxScopeHistoryEntries uint32 = 100
+
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(maxScopeHistoryEntries uint32) Params {
+	return Params{
+		MaxScopeHistoryEntries: maxScopeHistoryEntries,
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxScopeHistoryEntries)
 }
@@ -2888,6 +2888,11 @@ func (m *OSLocatorParamsResponse) GetRequest() *OSLocatorParamsRequest {
 // OSLocatorRequest is the request type for the Query/OSLocator RPC method.
 type OSLocatorRequest struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the locator to get. If empty, the owner's primary locator is returned.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// key_height is the block height to get the locator's encryption key at. If zero, the current height is used.
+	// Only the encryption key is looked up at this height. The uri, name, and primary flag are always the current ones.
+	KeyHeight int64 `protobuf:"varint,3,opt,name=key_height,json=keyHeight,proto3" json:"key_height,omitempty"`
 	// include_request is a flag for whether to include this request in your result.
 	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
 }
@@ -2932,6 +2937,20 @@ func (m *OSLocatorRequest) GetOwner() string {
 	return ""
 }
 
+func (m *OSLocatorRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OSLocatorRequest) GetKeyHeight() int64 {
+	if m != nil {
+		return m.KeyHeight
+	}
+	return 0
+}
+
 func (m *OSLocatorRequest) GetIncludeRequest() bool {
 	if m != nil {
 		return m.IncludeRequest
@@ -3523,3904 +3542,3399 @@ func (m *QueryScopeNetAssetValuesResponse) GetNetAssetValues() []NetAssetValue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
-	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
-	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
-	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
-	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
-	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
-	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
-	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
-	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
-	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
-	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
-	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
-	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
-	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
-	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
-	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
-	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
-	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
-	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
-	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
-	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
-	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
-	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
-	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
-	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
-	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
-	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
-	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
-	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
-	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
-	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
-	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
-	proto.RegisterType((*GetByAddrRequest)(nil), "provenance.metadata.v1.GetByAddrRequest")
-	proto.RegisterType((*GetByAddrResponse)(nil), "provenance.metadata.v1.GetByAddrResponse")
-	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
-	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
-	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
-	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
-	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
-	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
-	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
-	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
-	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
-	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
-	proto.RegisterType((*AccountDataRequest)(nil), "provenance.metadata.v1.AccountDataRequest")
-	proto.RegisterType((*AccountDataResponse)(nil), "provenance.metadata.v1.AccountDataResponse")
-	proto.RegisterType((*QueryScopeNetAssetValuesRequest)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesRequest")
-	proto.RegisterType((*QueryScopeNetAssetValuesResponse)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesResponse")
+// ScopeHistoryRequest is the request type for the Query/ScopeHistory RPC method.
+type ScopeHistoryRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
+func (m *ScopeHistoryRequest) Reset()         { *m = ScopeHistoryRequest{} }
+func (m *ScopeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryRequest) ProtoMessage()    {}
+func (*ScopeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{54}
 }
-
-var fileDescriptor_a68790bc0b96eeb9 = []byte{
-	// 2894 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
-	0x19, 0xce, 0x99, 0x8d, 0x63, 0xfb, 0xf7, 0x35, 0xbf, 0x2f, 0xb1, 0xb7, 0x8d, 0xed, 0x6e, 0x12,
-	0x5f, 0xe2, 0x64, 0x37, 0xbe, 0xe4, 0xd6, 0xa6, 0x0d, 0x76, 0x6e, 0xb8, 0xce, 0x75, 0xdd, 0x50,
-	0xc9, 0x08, 0xac, 0xf1, 0xee, 0xc4, 0x5d, 0x6a, 0xcf, 0x6c, 0x67, 0x66, 0xd3, 0x46, 0x96, 0x1f,
-	0x40, 0x08, 0x84, 0x88, 0x50, 0x80, 0x52, 0x71, 0x51, 0x45, 0x55, 0x94, 0x07, 0x4a, 0x10, 0x2a,
-	0x12, 0x82, 0xaa, 0xea, 0x03, 0xaa, 0x2a, 0x45, 0x82, 0x87, 0x52, 0x5e, 0x10, 0x0f, 0x11, 0x4a,
-	0x78, 0xe0, 0x81, 0xe7, 0x4a, 0xf0, 0x02, 0xda, 0x73, 0x99, 0x9d, 0xeb, 0xce, 0xcc, 0x66, 0x37,
-	0x90, 0xbe, 0x79, 0xcf, 0x9c, 0xff, 0x3f, 0xff, 0xf9, 0xff, 0xef, 0x7c, 0xe7, 0x9c, 0xff, 0xfc,
-	0x86, 0x54, 0x51, 0xd7, 0xae, 0x2b, 0xaa, 0xac, 0xe6, 0x94, 0xcc, 0x86, 0x62, 0xca, 0x79, 0xd9,
-	0x94, 0x33, 0xd7, 0xa7, 0x32, 0xaf, 0x94, 0x14, 0xfd, 0x46, 0xba, 0xa8, 0x6b, 0xa6, 0x86, 0xfd,
-	0x95, 0x3e, 0x69, 0xd1, 0x27, 0x7d, 0x7d, 0x2a, 0xd9, 0xbb, 0xa6, 0xad, 0x69, 0xb4, 0x4b, 0xa6,
-	0xfc, 0x17, 0xeb, 0x9d, 0xdc, 0x9f, 0xd3, 0x8c, 0x0d, 0xcd, 0xc8, 0xac, 0xca, 0x86, 0xc2, 0xd4,
-	0x64, 0xae, 0x4f, 0xad, 0x2a, 0xa6, 0x3c, 0x95, 0x29, 0xca, 0x6b, 0x05, 0x55, 0x36, 0x0b, 0x9a,
-	0xca, 0xfb, 0x3e, 0xb9, 0xa6, 0x69, 0x6b, 0xeb, 0x4a, 0x46, 0x2e, 0x16, 0x32, 0xb2, 0xaa, 0x6a,
-	0x26, 0xfd, 0x68, 0xf0, 0xaf, 0xfb, 0x02, 0x6c, 0xb3, 0x6c, 0x60, 0xdd, 0x82, 0xa6, 0x60, 0xe4,
-	0xb4, 0xa2, 0x22, 0x8c, 0x0a, 0xea, 0x53, 0x54, 0x72, 0x85, 0x6b, 0x85, 0x9c, 0xdd, 0xa8, 0xf1,
-	0x80, 0xbe, 0xda, 0xea, 0x57, 0x94, 0x9c, 0x69, 0x98, 0x9a, 0xce, 0xb5, 0xa6, 0x9e, 0x05, 0xbc,
-	0x52, 0x9e, 0xe0, 0x65, 0x59, 0x97, 0x37, 0x8c, 0xac, 0xf2, 0x4a, 0x49, 0x31, 0x4c, 0x1c, 0x83,
-	0xae, 0x82, 0x9a, 0x5b, 0x2f, 0xe5, 0x95, 0x15, 0x9d, 0x35, 0x0d, 0xac, 0x8e, 0x90, 0xf1, 0x96,
-	0x6c, 0x27, 0x6f, 0xe6, 0x1d, 0x53, 0x3f, 0x22, 0xd0, 0xe3, 0x90, 0x37, 0x8a, 0x9a, 0x6a, 0x28,
-	0x78, 0x02, 0x76, 0x14, 0x69, 0xcb, 0x00, 0x19, 0x21, 0xe3, 0x6d, 0xd3, 0x43, 0x69, 0xff, 0x00,
-	0xa4, 0x99, 0xdc, 0xfc, 0xf6, 0xbb, 0xf7, 0x86, 0xb7, 0x65, 0xb9, 0x0c, 0x9e, 0x86, 0x66, 0xfb,
-	0xb0, 0x6d, 0xd3, 0xfb, 0x83, 0xc4, 0xbd, 0xb6, 0x67, 0x85, 0x68, 0xea, 0x7b, 0x12, 0xb4, 0x2f,
-	0x95, 0x1d, 0x28, 0x66, 0x35, 0x08, 0x2d, 0xd4, 0xa1, 0x2b, 0x85, 0x3c, 0x35, 0xab, 0x35, 0xdb,
-	0x4c, 0x7f, 0x2f, 0xe4, 0xf1, 0x29, 0x68, 0x37, 0x14, 0xc3, 0x28, 0x68, 0xea, 0x8a, 0x9c, 0xcf,
-	0xeb, 0x03, 0x12, 0xfd, 0xdc, 0xc6, 0xdb, 0xe6, 0xf2, 0x79, 0x1d, 0x87, 0xa1, 0x4d, 0x57, 0x72,
-	0x9a, 0x9e, 0x67, 0x3d, 0x12, 0xb4, 0x07, 0xb0, 0x26, 0xda, 0x61, 0x02, 0xba, 0x85, 0xd3, 0xb8,
-	0x9c, 0x31, 0x00, 0xd4, 0x6b, 0xc2, 0x99, 0x4b, 0xbc, 0xd9, 0xe9, 0xdf, 0xb2, 0x02, 0x63, 0xa0,
-	0xcd, 0xe5, 0x5f, 0xda, 0x8a, 0xa3, 0xd0, 0xa5, 0xbc, 0xc6, 0x3a, 0x16, 0xf2, 0x2b, 0x05, 0xf5,
-	0x9a, 0x36, 0xd0, 0x4e, 0x3b, 0x76, 0xf0, 0xe6, 0x85, 0xfc, 0x82, 0x7a, 0x4d, 0x8b, 0x1e, 0xb0,
-	0x5b, 0x12, 0x74, 0x70, 0xa7, 0xf0, 0x50, 0x3d, 0x0d, 0x4d, 0xd4, 0x0b, 0x3c, 0x52, 0x7b, 0x83,
-	0x5c, 0x4d, 0xa5, 0x5e, 0xd4, 0xe5, 0x62, 0x51, 0xd1, 0xb3, 0x4c, 0x04, 0xe7, 0xa1, 0xc5, 0x9a,
-	0xaa, 0x34, 0x92, 0x18, 0x6f, 0x9b, 0x1e, 0x0d, 0x14, 0x67, 0xfd, 0x84, 0x02, 0x4b, 0x0e, 0x4f,
-	0x96, 0x83, 0xcd, 0x7c, 0x90, 0xa0, 0x2a, 0xf6, 0x05, 0xa9, 0x60, 0x4e, 0x11, 0x1a, 0x84, 0x14,
-	0x3e, 0xe7, 0x46, 0x4b, 0xf5, 0x29, 0x78, 0x70, 0x72, 0x9f, 0x70, 0x9c, 0x70, 0xcd, 0x38, 0xe3,
-	0xf4, 0xc8, 0xee, 0xea, 0xea, 0xb8, 0x2b, 0xce, 0x41, 0x87, 0x00, 0x17, 0x8b, 0x93, 0x44, 0x85,
-	0xf7, 0x54, 0x15, 0x66, 0xd1, 0xcb, 0xb6, 0x19, 0x95, 0x1f, 0xf8, 0x02, 0x20, 0x53, 0x54, 0x5e,
-	0xd8, 0x96, 0xb6, 0x04, 0xd5, 0x36, 0x56, 0x55, 0xdb, 0x52, 0x51, 0xc9, 0x71, 0x8d, 0x5d, 0x86,
-	0xb3, 0x21, 0xf5, 0x0b, 0x02, 0xdd, 0xb4, 0x93, 0x31, 0xb7, 0xbe, 0x2e, 0x16, 0x44, 0xbd, 0xd1,
-	0x85, 0x67, 0x01, 0x2a, 0x04, 0x39, 0x90, 0xa3, 0x36, 0x8f, 0xa6, 0x19, 0x9b, 0xa6, 0xcb, 0x6c,
-	0x9a, 0x66, 0xa4, 0xcc, 0xd9, 0x34, 0x7d, 0x59, 0x5e, 0xb3, 0xe2, 0x61, 0x93, 0x4c, 0xdd, 0x23,
-	0xb0, 0xd3, 0x66, 0x6d, 0x85, 0x54, 0xe8, 0xb4, 0xca, 0xa4, 0x92, 0x88, 0x0c, 0x55, 0x2e, 0x83,
-	0xf3, 0x6e, 0x98, 0x8c, 0x57, 0x15, 0xb7, 0xf9, 0xc9, 0x82, 0x0a, 0x9e, 0xf3, 0x99, 0xdf, 0x58,
-	0xe8, 0xfc, 0x98, 0xf9, 0x8e, 0x09, 0xde, 0x91, 0xa0, 0x4b, 0xb0, 0x41, 0x04, 0x7a, 0xda, 0x0d,
-	0x20, 0xe8, 0xa9, 0x90, 0xe7, 0xe4, 0xd4, 0xca, 0x5b, 0x16, 0xf2, 0xe1, 0xd4, 0x54, 0xe9, 0xa0,
-	0xca, 0x1b, 0xca, 0xc0, 0x76, 0x7b, 0x87, 0x8b, 0xf2, 0x86, 0x82, 0x7b, 0xa0, 0xc3, 0xe2, 0x2e,
-	0x0a, 0x7d, 0x46, 0x5c, 0xed, 0x82, 0xb8, 0x28, 0xc4, 0xff, 0x77, 0xac, 0xf5, 0x86, 0x04, 0xdd,
-	0x15, 0x77, 0x7d, 0x56, 0x88, 0x6b, 0xce, 0x8d, 0xc8, 0xb1, 0x10, 0x1b, 0xbc, 0x7b, 0xdc, 0xbf,
-	0x08, 0x74, 0x3a, 0x0d, 0xc4, 0xe3, 0xd0, 0xcc, 0x4d, 0xe4, 0x8e, 0x19, 0x0e, 0xd1, 0x9a, 0x15,
-	0xfd, 0xf1, 0x02, 0x74, 0x55, 0x60, 0x66, 0x67, 0xb1, 0x7d, 0x21, 0x2a, 0x38, 0xeb, 0x74, 0x18,
-	0xf6, 0x9f, 0xf8, 0x25, 0xe8, 0xcb, 0x69, 0xaa, 0xa9, 0xcb, 0x39, 0xd3, 0x8f, 0xcc, 0x02, 0x37,
-	0xf5, 0x53, 0x5c, 0xc8, 0xc6, 0x67, 0x98, 0xf3, 0xb4, 0xa5, 0x7e, 0x49, 0x00, 0x85, 0x63, 0x1e,
-	0x07, 0x52, 0xfb, 0x07, 0x81, 0x1e, 0x87, 0xbd, 0x1c, 0xc7, 0x76, 0x2c, 0x92, 0x1a, 0xb1, 0x18,
-	0xfd, 0xc4, 0xe4, 0xf5, 0x58, 0x03, 0xe8, 0xed, 0x2d, 0x09, 0x3a, 0x39, 0x19, 0x08, 0x2f, 0xba,
-	0x38, 0x8a, 0x78, 0x38, 0xca, 0x4e, 0x7f, 0x52, 0x35, 0xfa, 0x4b, 0xb8, 0xe9, 0x0f, 0x61, 0xbb,
-	0x8d, 0xd6, 0xe8, 0xdf, 0xd1, 0x08, 0xcd, 0xef, 0xc4, 0xd6, 0xe6, 0x7f, 0x62, 0xab, 0x3b, 0xa5,
-	0xbd, 0x2e, 0x41, 0x97, 0xe5, 0xa2, 0xcf, 0x0a, 0xa3, 0x7d, 0xce, 0x0d, 0xc3, 0xd1, 0xea, 0x0a,
-	0xbc, 0x84, 0xf6, 0x4f, 0x02, 0x1d, 0x0e, 0xe5, 0x78, 0x04, 0x76, 0x30, 0xf5, 0x61, 0x57, 0x09,
-	0x26, 0x96, 0xe5, 0xbd, 0xf1, 0x79, 0xe8, 0xe4, 0x80, 0x73, 0x72, 0xd9, 0xde, 0xea, 0xf2, 0x9c,
-	0x70, 0xda, 0x75, 0xdb, 0x2f, 0x7c, 0x11, 0x7a, 0xb8, 0x2e, 0x1f, 0x1e, 0x1b, 0xaf, 0xae, 0xd0,
-	0xc6, 0x62, 0xdd, 0xba, 0xab, 0x25, 0x75, 0x87, 0xc0, 0x4e, 0xee, 0x8a, 0xc7, 0x81, 0xc2, 0x1e,
-	0x10, 0x40, 0xbb, 0xb9, 0x1c, 0xb7, 0x36, 0xdc, 0x90, 0x9a, 0x70, 0x73, 0xca, 0x8d, 0x9b, 0x89,
-	0x10, 0xdc, 0x34, 0x94, 0xbd, 0xde, 0x24, 0xd0, 0x7d, 0xe9, 0x55, 0x55, 0xd1, 0x8d, 0x97, 0x0a,
-	0x45, 0xe1, 0xc2, 0x01, 0x68, 0x2e, 0x13, 0x97, 0x62, 0x18, 0xe2, 0x70, 0xc6, 0x7f, 0x3e, 0xfa,
-	0x28, 0xfc, 0x9e, 0xc0, 0x4e, 0x9b, 0x7d, 0x3c, 0x08, 0xc3, 0xc0, 0xae, 0x11, 0x2b, 0xa5, 0x52,
-	0x81, 0x07, 0xa2, 0x35, 0x0b, 0xb4, 0xe9, 0x6a, 0xb9, 0x25, 0xc6, 0x01, 0xd8, 0x3d, 0xf9, 0x06,
-	0xf8, 0xf8, 0x6d, 0x02, 0x7d, 0x5f, 0x90, 0xd7, 0x4b, 0xca, 0xff, 0xb3, 0xa3, 0xff, 0x40, 0xa0,
-	0xdf, 0x6d, 0x64, 0x54, 0x6f, 0x9f, 0x73, 0x7b, 0xfb, 0x60, 0x90, 0xb7, 0x7d, 0xdd, 0xd0, 0x00,
-	0x97, 0xff, 0x87, 0xc0, 0xa0, 0x75, 0x4f, 0xb4, 0x32, 0x46, 0xc2, 0x67, 0x13, 0xd0, 0xed, 0xc8,
-	0x24, 0x55, 0x6e, 0x21, 0x5d, 0x8e, 0xf6, 0x85, 0x3c, 0xce, 0x42, 0xbf, 0x88, 0x83, 0xe3, 0x7c,
-	0x27, 0xd2, 0x1d, 0xbd, 0xfc, 0xab, 0xfd, 0x1c, 0x67, 0xe0, 0x21, 0xe8, 0x75, 0xde, 0x1e, 0xb8,
-	0x0c, 0xdb, 0x70, 0xd1, 0x71, 0x85, 0x60, 0x12, 0x75, 0xdf, 0x73, 0xbf, 0x9a, 0x80, 0xa4, 0x9f,
-	0x07, 0x78, 0x4c, 0x57, 0xa1, 0xa7, 0x72, 0xf3, 0xb6, 0x3e, 0xf3, 0x6d, 0x67, 0x2a, 0xf4, 0xea,
-	0x6d, 0x49, 0x08, 0x7a, 0x43, 0xc3, 0xf3, 0x09, 0xbf, 0x08, 0x9d, 0x2e, 0x9f, 0xb1, 0xcd, 0x7a,
-	0x36, 0xca, 0x61, 0xd8, 0x33, 0x42, 0x47, 0xce, 0xe1, 0xe2, 0xab, 0xd0, 0xee, 0x70, 0x2d, 0xdb,
-	0xc4, 0xa7, 0xc3, 0xf7, 0x27, 0x8f, 0xe2, 0x36, 0xdd, 0x16, 0x87, 0x45, 0x37, 0x94, 0x63, 0xf8,
-	0xc2, 0xb3, 0xc1, 0x7f, 0xe8, 0x8b, 0x42, 0xb1, 0xd9, 0x5f, 0x86, 0x0e, 0x3f, 0xe7, 0xef, 0x8f,
-	0x31, 0xa0, 0x53, 0x41, 0x40, 0x3a, 0x45, 0x7a, 0xc8, 0x74, 0xca, 0xef, 0x08, 0xec, 0xf6, 0x8e,
-	0xfd, 0x58, 0xec, 0xe1, 0x6f, 0x49, 0x30, 0x14, 0x64, 0x3a, 0x5f, 0x08, 0x79, 0xe8, 0xf5, 0x59,
-	0x08, 0x62, 0x73, 0xaf, 0x61, 0x25, 0xf4, 0x78, 0x57, 0x82, 0x81, 0x97, 0xdc, 0xb0, 0x3a, 0x1c,
-	0x5d, 0x71, 0x63, 0x0f, 0x00, 0x7f, 0x24, 0xf0, 0xa4, 0xef, 0xba, 0xab, 0x81, 0x2c, 0x83, 0x68,
-	0x0f, 0x1e, 0x1d, 0xed, 0x7d, 0x24, 0xc1, 0xee, 0x80, 0xe9, 0xf0, 0x80, 0xbf, 0x0c, 0xfd, 0x0e,
-	0x56, 0x72, 0xaf, 0xbf, 0xda, 0xd8, 0xa9, 0x2f, 0xe7, 0xf7, 0x15, 0xd7, 0xa0, 0xcf, 0xe6, 0x09,
-	0x1b, 0xbc, 0x6a, 0xa7, 0xab, 0x5e, 0xdd, 0xfb, 0xcd, 0xc0, 0x8b, 0x6e, 0x80, 0xc5, 0x9b, 0x86,
-	0x87, 0xba, 0x3e, 0x09, 0x82, 0x85, 0x60, 0xaf, 0x25, 0x7f, 0xf6, 0x3a, 0x18, 0x6f, 0x58, 0x17,
-	0x81, 0x05, 0x66, 0x51, 0xa4, 0xba, 0x64, 0x51, 0xde, 0x27, 0x30, 0xe2, 0x6b, 0xc7, 0x63, 0x41,
-	0x66, 0xbf, 0x92, 0xe0, 0xa9, 0x2a, 0xd6, 0x73, 0x78, 0x6f, 0xc0, 0x2e, 0x7f, 0x78, 0x0b, 0x4a,
-	0xab, 0x0d, 0xdf, 0xfd, 0xbe, 0xf8, 0x36, 0x30, 0xeb, 0xc6, 0xdd, 0xb1, 0x58, 0xea, 0x1b, 0xcb,
-	0x6d, 0xef, 0x12, 0x98, 0xf1, 0x59, 0x49, 0xc6, 0x59, 0x4d, 0xaf, 0x17, 0xe5, 0xd5, 0x9d, 0xc0,
-	0xbe, 0x91, 0x80, 0xd9, 0x78, 0x36, 0xf3, 0xc0, 0x07, 0x52, 0x0d, 0xa9, 0x33, 0xd5, 0x3c, 0x07,
-	0x4f, 0xf8, 0x23, 0x8c, 0xde, 0x0f, 0x78, 0x3e, 0x6b, 0xd0, 0x17, 0x2f, 0xe5, 0xeb, 0x42, 0x15,
-	0x79, 0x5b, 0x46, 0xdf, 0x5f, 0x9e, 0x26, 0xcf, 0x14, 0x37, 0xe4, 0x16, 0x63, 0x4c, 0x2d, 0x2c,
-	0xf6, 0x15, 0x06, 0xbc, 0x43, 0x20, 0xe9, 0xa3, 0xa0, 0x06, 0x8c, 0x88, 0x9c, 0x9d, 0x64, 0xcb,
-	0xd9, 0xd5, 0x1d, 0x37, 0x9f, 0x10, 0x78, 0xc2, 0xd7, 0x5c, 0x0e, 0x0f, 0x05, 0x7a, 0xfd, 0xe0,
-	0xc1, 0x69, 0xbb, 0x16, 0x74, 0xf4, 0xf8, 0xa0, 0x03, 0xcf, 0xbb, 0x83, 0x13, 0x47, 0xb3, 0x27,
-	0x06, 0x77, 0xfd, 0x63, 0x20, 0xf6, 0xa0, 0x2b, 0xfe, 0x7b, 0xd0, 0x64, 0x9c, 0x21, 0x5d, 0x3b,
-	0x50, 0x40, 0xf6, 0x4b, 0x7a, 0xe8, 0xec, 0xd7, 0x7b, 0x04, 0x86, 0xfc, 0xf0, 0xf8, 0x38, 0xec,
-	0x3c, 0xb7, 0x25, 0x18, 0x0e, 0xb4, 0xfd, 0x51, 0xd3, 0xcf, 0x65, 0x37, 0xc2, 0x8e, 0xc4, 0x59,
-	0xfe, 0x0d, 0xdd, 0x6f, 0xc6, 0xa1, 0xfb, 0x9c, 0x62, 0xce, 0xdf, 0x28, 0xd3, 0x94, 0x88, 0x41,
-	0x2f, 0x34, 0x95, 0x69, 0x4d, 0xa4, 0x4d, 0xd8, 0x8f, 0xd4, 0x9f, 0x12, 0xb0, 0xd3, 0xd6, 0x95,
-	0xfb, 0xf0, 0xb0, 0xeb, 0xd1, 0x37, 0xe4, 0x35, 0x5e, 0xbc, 0xf6, 0x3e, 0xe3, 0x49, 0x87, 0x87,
-	0x3e, 0x83, 0x55, 0xf2, 0xe0, 0xc7, 0xdc, 0x79, 0xf0, 0xb0, 0x9c, 0xb3, 0x95, 0xc8, 0x5c, 0x14,
-	0x69, 0x21, 0x76, 0xc8, 0xdf, 0x4e, 0xa5, 0xe3, 0xdc, 0x5e, 0xc1, 0xba, 0x29, 0x19, 0xf8, 0x82,
-	0x27, 0x57, 0xd0, 0x44, 0xf5, 0xc5, 0x3d, 0x4f, 0x3a, 0x93, 0x04, 0x17, 0x5d, 0x49, 0x82, 0x1d,
-	0x54, 0x67, 0x2c, 0x7e, 0x70, 0x64, 0x07, 0x9e, 0x80, 0x56, 0x55, 0x33, 0x57, 0xae, 0x69, 0x25,
-	0x35, 0x3f, 0xd0, 0x4c, 0x03, 0xda, 0xa2, 0x6a, 0xe6, 0xd9, 0xf2, 0xef, 0xd4, 0x1c, 0xf4, 0x5f,
-	0x5a, 0x3a, 0xaf, 0xe5, 0x64, 0x53, 0xd3, 0x6b, 0x2c, 0x31, 0x7a, 0x87, 0xc0, 0x2e, 0x8f, 0x0e,
-	0x0e, 0x8e, 0x33, 0xae, 0x32, 0xa3, 0xc0, 0x0b, 0xbd, 0x4b, 0x81, 0xab, 0xde, 0xe8, 0xf3, 0xee,
-	0xe5, 0x93, 0x8e, 0xa8, 0xc7, 0x43, 0xce, 0x57, 0xa0, 0xdb, 0xea, 0x62, 0x43, 0xbb, 0xf6, 0xaa,
-	0xaa, 0x88, 0x37, 0x2f, 0xf6, 0x23, 0xfa, 0xfc, 0xdf, 0x24, 0xb0, 0xd3, 0xa6, 0x93, 0xcf, 0xfc,
-	0x34, 0x34, 0xaf, 0xb3, 0xa6, 0xb0, 0x14, 0xc9, 0x25, 0x5a, 0xf3, 0xb5, 0x64, 0x6a, 0xba, 0x22,
-	0x94, 0x08, 0xd1, 0x38, 0x29, 0x61, 0xd7, 0xac, 0x2a, 0x53, 0xfe, 0x09, 0xb1, 0xc5, 0xd8, 0x98,
-	0xbf, 0x71, 0x35, 0xbb, 0x20, 0x66, 0xde, 0x0d, 0x89, 0x92, 0x5e, 0xe0, 0xf3, 0x2e, 0xff, 0xf9,
-	0xe8, 0x69, 0xfa, 0xdf, 0x76, 0xf4, 0x08, 0xeb, 0xb8, 0x0f, 0xcf, 0x43, 0x0b, 0x77, 0x84, 0x20,
-	0x97, 0x18, 0x4e, 0xe4, 0x10, 0xb2, 0x34, 0xd4, 0x02, 0x22, 0x87, 0xb7, 0x1a, 0xc0, 0xbd, 0x5f,
-	0x86, 0x01, 0xfb, 0x58, 0x51, 0x8b, 0xe1, 0x22, 0x43, 0xf3, 0x37, 0x04, 0x06, 0x7d, 0x06, 0x68,
-	0x88, 0x7b, 0x9f, 0x77, 0xbb, 0xf7, 0x50, 0x14, 0xf7, 0xfa, 0x57, 0x7c, 0x7d, 0x93, 0x40, 0xef,
-	0xa5, 0xa5, 0xb9, 0xf5, 0x75, 0xd1, 0x31, 0x2e, 0x29, 0xd5, 0x0d, 0x9e, 0x9f, 0x12, 0xe8, 0x73,
-	0x59, 0xd2, 0x10, 0xef, 0x9d, 0x75, 0x7b, 0xef, 0x40, 0xb0, 0xf7, 0xbc, 0x7e, 0x69, 0x00, 0x34,
-	0xb3, 0x80, 0x73, 0xb9, 0x9c, 0x56, 0x52, 0xcd, 0xd3, 0xb2, 0x29, 0x0b, 0xb7, 0x9e, 0x80, 0x0e,
-	0x61, 0x4b, 0xa5, 0x4c, 0xa0, 0x7d, 0x7e, 0x57, 0x79, 0x36, 0x7f, 0xbd, 0x37, 0xdc, 0x75, 0x81,
-	0x7f, 0x9c, 0x63, 0x2f, 0x42, 0xd9, 0xf6, 0x0d, 0x5b, 0x43, 0x6a, 0x12, 0x7a, 0x1c, 0x3a, 0xb9,
-	0x27, 0x7b, 0xa1, 0xe9, 0xba, 0xbc, 0x5e, 0x52, 0x04, 0xff, 0xd2, 0x1f, 0xa9, 0x29, 0x18, 0xa6,
-	0xc5, 0xa3, 0x14, 0x21, 0x17, 0x15, 0x73, 0xce, 0x30, 0x14, 0x93, 0x3e, 0xc5, 0x58, 0x68, 0xe8,
-	0x04, 0xc9, 0x5a, 0x1c, 0x52, 0x21, 0x9f, 0xba, 0x01, 0x23, 0xc1, 0x22, 0x7c, 0xb0, 0xab, 0xd0,
-	0xad, 0x2a, 0xe6, 0x8a, 0x5c, 0xfe, 0xb4, 0x42, 0x47, 0x0a, 0x7d, 0x13, 0x75, 0x68, 0xe2, 0x91,
-	0xeb, 0x54, 0x1d, 0xea, 0xa7, 0x3f, 0x18, 0x85, 0x26, 0x3a, 0x36, 0x7e, 0x8b, 0xc0, 0x0e, 0xb6,
-	0xf9, 0x60, 0x8c, 0xaa, 0xd8, 0xe4, 0x64, 0xa4, 0xbe, 0x6c, 0x12, 0xa9, 0xd1, 0xaf, 0xfd, 0xf9,
-	0xef, 0xdf, 0x97, 0x46, 0x70, 0x28, 0x13, 0x50, 0x47, 0xcc, 0xf7, 0xcd, 0x4f, 0x09, 0x34, 0xb1,
-	0x4a, 0x8a, 0x48, 0x25, 0x97, 0xc9, 0x7d, 0x21, 0xbd, 0xf8, 0xf0, 0x3f, 0x25, 0x74, 0xfc, 0x1f,
-	0x92, 0xe5, 0x23, 0x38, 0x1b, 0x64, 0x02, 0x3f, 0xac, 0x65, 0x36, 0xed, 0x75, 0xbb, 0x5b, 0xac,
-	0x62, 0x7a, 0x79, 0x16, 0xa7, 0x83, 0xe4, 0xd8, 0xd1, 0x25, 0xb3, 0x69, 0x2b, 0x46, 0xe1, 0x52,
-	0x38, 0x9e, 0xa9, 0x56, 0x86, 0x9d, 0xd9, 0x14, 0x7c, 0xb9, 0x85, 0x37, 0x09, 0xb4, 0x5a, 0x55,
-	0x82, 0x18, 0xb9, 0x90, 0x30, 0x39, 0x11, 0xa1, 0x27, 0x77, 0xc2, 0x7e, 0xea, 0x83, 0xbd, 0x98,
-	0xaa, 0x6a, 0x94, 0x91, 0x91, 0xd7, 0xd7, 0xf1, 0x66, 0x02, 0x5a, 0x2a, 0xb5, 0xc5, 0x11, 0x8b,
-	0xc8, 0x92, 0xe3, 0xe1, 0x1d, 0xb9, 0x2d, 0x77, 0x24, 0x6a, 0xcc, 0x6d, 0x69, 0x79, 0x06, 0xa7,
-	0xa2, 0x3a, 0x49, 0x44, 0xc8, 0x58, 0x3e, 0x89, 0xcf, 0xc6, 0x15, 0xaa, 0x84, 0xb5, 0x90, 0xdf,
-	0xaa, 0x06, 0x03, 0xff, 0x70, 0x32, 0xd9, 0xe5, 0x73, 0x78, 0x26, 0xf2, 0xc0, 0x2e, 0x45, 0xaa,
-	0xbc, 0xa1, 0x58, 0x8a, 0xf0, 0x40, 0x64, 0x14, 0x96, 0xd1, 0xf1, 0x3a, 0x81, 0x36, 0x5b, 0x99,
-	0x15, 0xc6, 0xa8, 0xc5, 0x0a, 0x5e, 0xa7, 0x3e, 0x95, 0x63, 0xa9, 0x03, 0x34, 0x2c, 0xa3, 0xb8,
-	0x37, 0xc4, 0x3c, 0x86, 0x92, 0xef, 0x6c, 0x87, 0x66, 0xab, 0x42, 0x33, 0x5a, 0x5d, 0x4e, 0x72,
-	0x2c, 0xb4, 0x1f, 0x37, 0xe5, 0xdd, 0x04, 0xb5, 0xe5, 0x9d, 0xc4, 0xf2, 0x34, 0x1e, 0x8a, 0xe9,
-	0x74, 0x63, 0xf9, 0x18, 0x1e, 0x89, 0x1d, 0x28, 0x1a, 0xa1, 0x58, 0x21, 0xf6, 0x0b, 0x96, 0x65,
-	0xc2, 0x05, 0x5c, 0xac, 0x87, 0x22, 0x61, 0x57, 0x1c, 0xe6, 0xb2, 0x9b, 0x71, 0x02, 0x9f, 0xae,
-	0x41, 0x8e, 0x8f, 0x1a, 0x8c, 0x53, 0xbf, 0x65, 0x82, 0xb7, 0x08, 0x40, 0xa5, 0x9e, 0x06, 0xa3,
-	0xd7, 0xdc, 0x24, 0xf7, 0x47, 0xe9, 0xca, 0x91, 0x31, 0x49, 0x81, 0xb1, 0x0f, 0xf7, 0x54, 0xb7,
-	0x8d, 0x61, 0xf4, 0x07, 0x04, 0x5a, 0xad, 0x52, 0x08, 0x8c, 0x5c, 0xa0, 0x12, 0x4c, 0xac, 0x9e,
-	0xca, 0x8d, 0xd4, 0x0c, 0xb5, 0xe7, 0x20, 0x4e, 0x06, 0xd9, 0xa3, 0x09, 0x91, 0xcc, 0x26, 0xaf,
-	0x3c, 0xd9, 0xc2, 0x9f, 0x13, 0xe8, 0x74, 0xd6, 0x69, 0x60, 0xbc, 0x7a, 0x8e, 0x64, 0x3a, 0x6a,
-	0x77, 0x6e, 0xe6, 0x31, 0x6a, 0x66, 0x95, 0xc5, 0x44, 0x0f, 0x17, 0x7e, 0xb6, 0xbe, 0x47, 0x00,
-	0xbd, 0x99, 0x05, 0x8c, 0xff, 0x68, 0x9f, 0x9c, 0x8e, 0x23, 0xc2, 0xed, 0x3e, 0x41, 0xed, 0xae,
-	0x06, 0x7f, 0xba, 0x6f, 0x15, 0x95, 0x5c, 0x66, 0xd3, 0x9d, 0x2c, 0xde, 0xc2, 0xdf, 0x12, 0xe8,
-	0xf7, 0x7f, 0xed, 0xc5, 0xda, 0x5e, 0x87, 0x93, 0x47, 0xe2, 0x8a, 0xf1, 0x79, 0xa4, 0xe9, 0x3c,
-	0xc6, 0x71, 0x34, 0x74, 0x1e, 0x0c, 0xb9, 0x1f, 0x11, 0xe8, 0xf3, 0xcd, 0xbf, 0x60, 0x4d, 0xaf,
-	0x8e, 0xc9, 0xc3, 0x31, 0xa5, 0xb8, 0xd9, 0x27, 0xa9, 0xd9, 0xc7, 0xf1, 0x68, 0x90, 0xd9, 0x22,
-	0x19, 0x14, 0x14, 0x81, 0x0f, 0x09, 0x0c, 0x06, 0x3e, 0x4b, 0x61, 0xcd, 0x2f, 0x59, 0xc9, 0xe3,
-	0x35, 0x48, 0xf2, 0x39, 0x4d, 0xd1, 0x39, 0x4d, 0xe2, 0x44, 0x94, 0x39, 0xb1, 0x68, 0xbc, 0x21,
-	0xc1, 0x81, 0x38, 0x2f, 0x1d, 0x58, 0xcf, 0xf7, 0x92, 0xe4, 0xf9, 0xfa, 0x28, 0xe3, 0xd3, 0x5f,
-	0xa4, 0xd3, 0x3f, 0x83, 0xa7, 0x6a, 0x0c, 0xa9, 0x20, 0x58, 0x9a, 0xad, 0xbb, 0x29, 0x41, 0x8f,
-	0x8f, 0x15, 0x58, 0xc3, 0x93, 0x44, 0x72, 0x26, 0x96, 0x0c, 0x9f, 0xcd, 0xb7, 0xd9, 0xe1, 0xfe,
-	0xeb, 0x64, 0x79, 0x11, 0x17, 0x1e, 0x7e, 0x46, 0x62, 0xe7, 0x3b, 0x1c, 0xb2, 0xbb, 0x04, 0xa0,
-	0xfd, 0x7d, 0x02, 0xbb, 0x02, 0x52, 0xe2, 0x58, 0x63, 0x0e, 0x3d, 0x79, 0x34, 0xb6, 0x1c, 0x77,
-	0x4d, 0x86, 0x7a, 0x66, 0x02, 0xc7, 0xc2, 0xe7, 0xc2, 0x4f, 0x74, 0x04, 0x5a, 0xad, 0x8c, 0x79,
-	0xf0, 0x6e, 0xe9, 0xce, 0xbf, 0x07, 0xef, 0x96, 0x9e, 0xf4, 0x7b, 0xf8, 0x11, 0xb3, 0xbc, 0xed,
-	0xb0, 0xcd, 0xc7, 0xd8, 0xc2, 0xb7, 0x09, 0x74, 0xb9, 0x52, 0xa4, 0x18, 0x33, 0x97, 0x9a, 0xcc,
-	0x44, 0xee, 0x1f, 0x95, 0xa9, 0x79, 0x16, 0x44, 0xdc, 0x5a, 0xbf, 0x5b, 0x3e, 0x63, 0x08, 0x5d,
-	0x18, 0x39, 0xe3, 0x59, 0xe5, 0x8c, 0xe1, 0xce, 0xce, 0x86, 0x47, 0x52, 0x98, 0xb4, 0x49, 0x37,
-	0xf0, 0x2d, 0xbc, 0x6d, 0x77, 0x1c, 0x4b, 0x0b, 0x62, 0xcc, 0xfc, 0x61, 0x04, 0xc7, 0x39, 0xf3,
-	0x9f, 0xe1, 0xbc, 0x2a, 0xac, 0x2c, 0xe9, 0x85, 0xcc, 0x66, 0x49, 0x2f, 0x6c, 0xe1, 0xaf, 0xed,
-	0xc9, 0x68, 0x91, 0x5f, 0xc3, 0xd8, 0xa9, 0xb8, 0xe4, 0x54, 0x0c, 0x89, 0xa8, 0x07, 0x22, 0x61,
-	0xad, 0xe7, 0xb6, 0xfe, 0x63, 0x02, 0x1d, 0x8e, 0xb4, 0x16, 0xc6, 0xca, 0x7e, 0x25, 0x0f, 0x46,
-	0xec, 0x1d, 0x75, 0xc9, 0x88, 0xac, 0x1c, 0x5d, 0xc3, 0x3f, 0x23, 0xd0, 0x66, 0xcb, 0x5a, 0x05,
-	0x5f, 0x16, 0xbd, 0xe9, 0xb2, 0xe0, 0xcb, 0xa2, 0x4f, 0x1a, 0x2c, 0xf5, 0x0c, 0x35, 0xeb, 0x30,
-	0xce, 0x04, 0xae, 0x64, 0x26, 0x44, 0x7f, 0x6e, 0x3a, 0xd2, 0x70, 0x5b, 0xf8, 0x01, 0x81, 0x1e,
-	0x9f, 0xb4, 0x17, 0x1e, 0xad, 0x9a, 0x56, 0x0a, 0xce, 0xad, 0x25, 0x8f, 0xc5, 0x17, 0x8c, 0x7a,
-	0x7e, 0x57, 0x15, 0x93, 0xa6, 0xdf, 0x58, 0xf6, 0x2d, 0xb3, 0x59, 0xc8, 0x6f, 0xcd, 0xbf, 0x7c,
-	0xf7, 0xfe, 0x10, 0xf9, 0xf8, 0xfe, 0x10, 0xf9, 0xdb, 0xfd, 0x21, 0x72, 0xeb, 0xc1, 0xd0, 0xb6,
-	0x8f, 0x1f, 0x0c, 0x6d, 0xfb, 0xcb, 0x83, 0xa1, 0x6d, 0x30, 0x58, 0xd0, 0x02, 0x4c, 0xb9, 0x4c,
-	0x96, 0x67, 0xd7, 0x0a, 0xe6, 0x4b, 0xa5, 0xd5, 0x74, 0x4e, 0xdb, 0xb0, 0x8d, 0x76, 0xb0, 0xa0,
-	0xd9, 0xc7, 0x7e, 0xad, 0x32, 0xba, 0x79, 0xa3, 0xa8, 0x18, 0xab, 0x3b, 0xe8, 0xbf, 0xd6, 0xcf,
-	0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x3d, 0xe8, 0xaf, 0x99, 0x40, 0x00, 0x00,
+func (m *ScopeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryRequest.Merge(m, src)
+}
+func (m *ScopeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ScopeHistoryRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ScopeHistoryRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/metadata module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
-	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	//
-	// By default, the contract and record specifications are not included.
-	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
-	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
-	// GetByAddr retrieves metadata given any address(es).
-	GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
-	// AccountData gets the account data associated with a metadata address.
-	// Currently, only scope ids are supported.
-	AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error)
-	// ScopeNetAssetValues returns net asset values for scope
-	ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error)
+func (m *ScopeHistoryRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ScopeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// ScopeHistoryResponse is the response type for the Query/ScopeHistory RPC method.
+type ScopeHistoryResponse struct {
+	// changes are the requested entries of the scope's change log.
+	Changes []ScopeChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeHistoryRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) Reset()         { *m = ScopeHistoryResponse{} }
+func (m *ScopeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryResponse) ProtoMessage()    {}
+func (*ScopeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{55}
+}
+func (m *ScopeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryResponse.Merge(m, src)
+}
+func (m *ScopeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
-	out := new(ScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeHistoryResponse proto.InternalMessageInfo
+
+func (m *ScopeHistoryResponse) GetChanges() []ScopeChange {
+	if m != nil {
+		return m.Changes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
-	out := new(ScopesAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) GetRequest() *ScopeHistoryRequest {
+	if m != nil {
+		return m.Request
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
-	out := new(SessionsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
-	out := new(SessionsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScopeChildrenRequest is the request type for the Query/ScopeChildren RPC method.
+type ScopeChildrenRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
-	out := new(RecordsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenRequest) Reset()         { *m = ScopeChildrenRequest{} }
+func (m *ScopeChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeChildrenRequest) ProtoMessage()    {}
+func (*ScopeChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{56}
+}
+func (m *ScopeChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeChildrenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeChildrenRequest.Merge(m, src)
+}
+func (m *ScopeChildrenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeChildrenRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
-	out := new(RecordsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeChildrenRequest proto.InternalMessageInfo
+
+func (m *ScopeChildrenRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
-	out := new(OwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
-	out := new(ValueOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
-	out := new(ScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScopeChildrenResponse is the response type for the Query/ScopeChildren RPC method.
+type ScopeChildrenResponse struct {
+	// scope_ids are the bech32 addresses of the child scopes.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeChildrenRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
-	out := new(ScopeSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenResponse) Reset()         { *m = ScopeChildrenResponse{} }
+func (m *ScopeChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeChildrenResponse) ProtoMessage()    {}
+func (*ScopeChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{57}
+}
+func (m *ScopeChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeChildrenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeChildrenResponse.Merge(m, src)
+}
+func (m *ScopeChildrenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeChildrenResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
-	out := new(ContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeChildrenResponse proto.InternalMessageInfo
+
+func (m *ScopeChildrenResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
-	out := new(ContractSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenResponse) GetRequest() *ScopeChildrenRequest {
+	if m != nil {
+		return m.Request
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	out := new(RecordSpecificationsForContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeChildrenResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
-	out := new(RecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScopeAncestorsRequest is the request type for the Query/ScopeAncestors RPC method.
+type ScopeAncestorsRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
 }
 
-func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
-	out := new(RecordSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeAncestorsRequest) Reset()         { *m = ScopeAncestorsRequest{} }
+func (m *ScopeAncestorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeAncestorsRequest) ProtoMessage()    {}
+func (*ScopeAncestorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{58}
+}
+func (m *ScopeAncestorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeAncestorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeAncestorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeAncestorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeAncestorsRequest.Merge(m, src)
+}
+func (m *ScopeAncestorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeAncestorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeAncestorsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error) {
-	out := new(GetByAddrResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/GetByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeAncestorsRequest proto.InternalMessageInfo
+
+func (m *ScopeAncestorsRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
-	out := new(OSLocatorParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeAncestorsRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
-	out := new(OSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScopeAncestorsResponse is the response type for the Query/ScopeAncestors RPC method.
+type ScopeAncestorsResponse struct {
+	// scope_ids are the bech32 addresses of the ancestor scopes, starting with the parent and ending with the root.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeAncestorsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
-	out := new(OSLocatorsByURIResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeAncestorsResponse) Reset()         { *m = ScopeAncestorsResponse{} }
+func (m *ScopeAncestorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeAncestorsResponse) ProtoMessage()    {}
+func (*ScopeAncestorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{59}
+}
+func (m *ScopeAncestorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeAncestorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeAncestorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopeAncestorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeAncestorsResponse.Merge(m, src)
+}
+func (m *ScopeAncestorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeAncestorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeAncestorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
-	out := new(OSLocatorsByScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeAncestorsResponse proto.InternalMessageInfo
+
+func (m *ScopeAncestorsResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
-	out := new(OSAllLocatorsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScopeAncestorsResponse) GetRequest() *ScopeAncestorsRequest {
+	if m != nil {
+		return m.Request
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error) {
-	out := new(AccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/AccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ScopesBySpecificationRequest is the request type for the Query/ScopesBySpecification RPC method.
+type ScopesBySpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
+	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopesBySpecificationRequest) Reset()         { *m = ScopesBySpecificationRequest{} }
+func (m *ScopesBySpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopesBySpecificationRequest) ProtoMessage()    {}
+func (*ScopesBySpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{60}
+}
+func (m *ScopesBySpecificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesBySpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesBySpecificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScopesBySpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesBySpecificationRequest.Merge(m, src)
+}
+func (m *ScopesBySpecificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesBySpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesBySpecificationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error) {
-	out := new(QueryScopeNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopesBySpecificationRequest proto.InternalMessageInfo
+
+func (m *ScopesBySpecificationRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/metadata module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
-	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	//
-	// By default, the contract and record specifications are not included.
-	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
-	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
-	// GetByAddr retrieves metadata given any address(es).
-	GetByAddr(context.Context, *GetByAddrRequest) (*GetByAddrResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
-	// AccountData gets the account data associated with a metadata address.
-	// Currently, only scope ids are supported.
-	AccountData(context.Context, *AccountDataRequest) (*AccountDataResponse, error)
-	// ScopeNetAssetValues returns net asset values for scope
-	ScopeNetAssetValues(context.Context, *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error)
+func (m *ScopesBySpecificationRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *ScopesBySpecificationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// ScopesBySpecificationResponse is the response type for the Query/ScopesBySpecification RPC method.
+type ScopesBySpecificationResponse struct {
+	// scope_ids are the bech32 addresses of the scopes that use the scope specification.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopesBySpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
+
+func (m *ScopesBySpecificationResponse) Reset()         { *m = ScopesBySpecificationResponse{} }
+func (m *ScopesBySpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopesBySpecificationResponse) ProtoMessage()    {}
+func (*ScopesBySpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{61}
 }
-func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
+func (m *ScopesBySpecificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
+func (m *ScopesBySpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesBySpecificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
+func (m *ScopesBySpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesBySpecificationResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+func (m *ScopesBySpecificationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+func (m *ScopesBySpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesBySpecificationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+
+var xxx_messageInfo_ScopesBySpecificationResponse proto.InternalMessageInfo
+
+func (m *ScopesBySpecificationResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+
+func (m *ScopesBySpecificationResponse) GetRequest() *ScopesBySpecificationRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+
+func (m *ScopesBySpecificationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+
+// ScopesByPartyRoleRequest is the request type for the Query/ScopesByPartyRole RPC method.
+type ScopesByPartyRoleRequest struct {
+	// address is the bech32 address of the party.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// role is the role the party must have in the scope.
+	Role PartyType `protobuf:"varint,2,opt,name=role,proto3,enum=provenance.metadata.v1.PartyType" json:"role,omitempty"`
+	// specification_id is an optional scope specification uuid or bech32 address to limit the results to.
+	SpecificationId string `protobuf:"bytes,3,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+
+func (m *ScopesByPartyRoleRequest) Reset()         { *m = ScopesByPartyRoleRequest{} }
+func (m *ScopesByPartyRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopesByPartyRoleRequest) ProtoMessage()    {}
+func (*ScopesByPartyRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{62}
 }
-func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+func (m *ScopesByPartyRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
-}
-func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
-}
-func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+func (m *ScopesByPartyRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesByPartyRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetByAddr(ctx context.Context, req *GetByAddrRequest) (*GetByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByAddr not implemented")
+func (m *ScopesByPartyRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesByPartyRoleRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+func (m *ScopesByPartyRoleRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+func (m *ScopesByPartyRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesByPartyRoleRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+
+var xxx_messageInfo_ScopesByPartyRoleRequest proto.InternalMessageInfo
+
+func (m *ScopesByPartyRoleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+
+func (m *ScopesByPartyRoleRequest) GetRole() PartyType {
+	if m != nil {
+		return m.Role
+	}
+	return PartyType_PARTY_TYPE_UNSPECIFIED
 }
-func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+
+func (m *ScopesByPartyRoleRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *AccountDataRequest) (*AccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+
+func (m *ScopesByPartyRoleRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
 }
-func (*UnimplementedQueryServer) ScopeNetAssetValues(ctx context.Context, req *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeNetAssetValues not implemented")
+
+func (m *ScopesByPartyRoleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// ScopesByPartyRoleResponse is the response type for the Query/ScopesByPartyRole RPC method.
+type ScopesByPartyRoleResponse struct {
+	// scope_ids are the bech32 addresses of the scopes where the address has the role.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopesByPartyRoleRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *ScopesByPartyRoleResponse) Reset()         { *m = ScopesByPartyRoleResponse{} }
+func (m *ScopesByPartyRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopesByPartyRoleResponse) ProtoMessage()    {}
+func (*ScopesByPartyRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{63}
+}
+func (m *ScopesByPartyRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesByPartyRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesByPartyRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopesByPartyRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesByPartyRoleResponse.Merge(m, src)
+}
+func (m *ScopesByPartyRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesByPartyRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesByPartyRoleResponse.DiscardUnknown(m)
 }
 
-func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Scope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Scope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
+var xxx_messageInfo_ScopesByPartyRoleResponse proto.InternalMessageInfo
+
+func (m *ScopesByPartyRoleResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopesAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+func (m *ScopesByPartyRoleResponse) GetRequest() *ScopesByPartyRoleRequest {
+	if m != nil {
+		return m.Request
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sessions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Sessions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
+func (m *ScopesByPartyRoleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SessionsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopesByDataAccessRequest is the request type for the Query/ScopesByDataAccess RPC method.
+type ScopesByDataAccessRequest struct {
+	// address is the bech32 address in the data access lists.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// specification_id is an optional scope specification uuid or bech32 address to limit the results to.
+	SpecificationId string `protobuf:"bytes,2,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Records(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Records",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+func (m *ScopesByDataAccessRequest) Reset()         { *m = ScopesByDataAccessRequest{} }
+func (m *ScopesByDataAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopesByDataAccessRequest) ProtoMessage()    {}
+func (*ScopesByDataAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{64}
+}
+func (m *ScopesByDataAccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesByDataAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesByDataAccessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopesByDataAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesByDataAccessRequest.Merge(m, src)
+}
+func (m *ScopesByDataAccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesByDataAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesByDataAccessRequest.DiscardUnknown(m)
 }
 
-func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+var xxx_messageInfo_ScopesByDataAccessRequest proto.InternalMessageInfo
+
+func (m *ScopesByDataAccessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Ownership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Ownership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
+func (m *ScopesByDataAccessRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValueOwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValueOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
+func (m *ScopesByDataAccessRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
+func (m *ScopesByDataAccessRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScopesByDataAccessResponse is the response type for the Query/ScopesByDataAccess RPC method.
+type ScopesByDataAccessResponse struct {
+	// scope_ids are the bech32 addresses of the scopes the address has data access to.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopesByDataAccessRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
+func (m *ScopesByDataAccessResponse) Reset()         { *m = ScopesByDataAccessResponse{} }
+func (m *ScopesByDataAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopesByDataAccessResponse) ProtoMessage()    {}
+func (*ScopesByDataAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{65}
+}
+func (m *ScopesByDataAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesByDataAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesByDataAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScopesByDataAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesByDataAccessResponse.Merge(m, src)
+}
+func (m *ScopesByDataAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesByDataAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesByDataAccessResponse.DiscardUnknown(m)
 }
 
-func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
+var xxx_messageInfo_ScopesByDataAccessResponse proto.InternalMessageInfo
+
+func (m *ScopesByDataAccessResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsForContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+func (m *ScopesByDataAccessResponse) GetRequest() *ScopesByDataAccessRequest {
+	if m != nil {
+		return m.Request
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
+func (m *ScopesByDataAccessResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RecordsByOutputHashRequest is the request type for the Query/RecordsByOutputHash RPC method.
+type RecordsByOutputHashRequest struct {
+	// hash is the record output hash to look up.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/GetByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetByAddr(ctx, req.(*GetByAddrRequest))
+func (m *RecordsByOutputHashRequest) Reset()         { *m = RecordsByOutputHashRequest{} }
+func (m *RecordsByOutputHashRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordsByOutputHashRequest) ProtoMessage()    {}
+func (*RecordsByOutputHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{66}
+}
+func (m *RecordsByOutputHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByOutputHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByOutputHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RecordsByOutputHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByOutputHashRequest.Merge(m, src)
+}
+func (m *RecordsByOutputHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByOutputHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByOutputHashRequest.DiscardUnknown(m)
 }
 
-func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
+var xxx_messageInfo_RecordsByOutputHashRequest proto.InternalMessageInfo
+
+func (m *RecordsByOutputHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
+func (m *RecordsByOutputHashRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
+	return false
+}
+
+func (m *RecordsByOutputHashRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByURIRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// RecordsByOutputHashResponse is the response type for the Query/RecordsByOutputHash RPC method.
+type RecordsByOutputHashResponse struct {
+	// records identifies the records that have an output with the requested hash.
+	Records []OutputHashRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// request is a copy of the request that generated these results.
+	Request *RecordsByOutputHashRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RecordsByOutputHashResponse) Reset()         { *m = RecordsByOutputHashResponse{} }
+func (m *RecordsByOutputHashResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordsByOutputHashResponse) ProtoMessage()    {}
+func (*RecordsByOutputHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{67}
+}
+func (m *RecordsByOutputHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordsByOutputHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordsByOutputHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
+}
+func (m *RecordsByOutputHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordsByOutputHashResponse.Merge(m, src)
+}
+func (m *RecordsByOutputHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordsByOutputHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordsByOutputHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordsByOutputHashResponse proto.InternalMessageInfo
+
+func (m *RecordsByOutputHashResponse) GetRecords() []OutputHashRecord {
+	if m != nil {
+		return m.Records
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
+	return nil
+}
+
+func (m *RecordsByOutputHashResponse) GetRequest() *RecordsByOutputHashRequest {
+	if m != nil {
+		return m.Request
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
+	return nil
+}
+
+func (m *RecordsByOutputHashResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// OutputHashRecord identifies a record that has an output with a specific hash.
+type OutputHashRecord struct {
+	// record_id is the bech32 address of the record.
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// scope_id is the bech32 address of the scope that the record is in.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
 }
 
-func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSAllLocatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSAllLocators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
+func (m *OutputHashRecord) Reset()         { *m = OutputHashRecord{} }
+func (m *OutputHashRecord) String() string { return proto.CompactTextString(m) }
+func (*OutputHashRecord) ProtoMessage()    {}
+func (*OutputHashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{68}
+}
+func (m *OutputHashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputHashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputHashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *OutputHashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputHashRecord.Merge(m, src)
+}
+func (m *OutputHashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputHashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputHashRecord.DiscardUnknown(m)
 }
 
-func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/AccountData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountData(ctx, req.(*AccountDataRequest))
+var xxx_messageInfo_OutputHashRecord proto.InternalMessageInfo
+
+func (m *OutputHashRecord) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ScopeNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScopeNetAssetValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeNetAssetValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeNetAssetValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeNetAssetValues(ctx, req.(*QueryScopeNetAssetValuesRequest))
+func (m *OutputHashRecord) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Scope",
-			Handler:    _Query_Scope_Handler,
-		},
-		{
-			MethodName: "ScopesAll",
-			Handler:    _Query_ScopesAll_Handler,
-		},
-		{
-			MethodName: "Sessions",
-			Handler:    _Query_Sessions_Handler,
-		},
-		{
-			MethodName: "SessionsAll",
-			Handler:    _Query_SessionsAll_Handler,
-		},
-		{
-			MethodName: "Records",
-			Handler:    _Query_Records_Handler,
-		},
-		{
-			MethodName: "RecordsAll",
-			Handler:    _Query_RecordsAll_Handler,
-		},
-		{
-			MethodName: "Ownership",
-			Handler:    _Query_Ownership_Handler,
-		},
-		{
-			MethodName: "ValueOwnership",
-			Handler:    _Query_ValueOwnership_Handler,
-		},
-		{
-			MethodName: "ScopeSpecification",
-			Handler:    _Query_ScopeSpecification_Handler,
-		},
-		{
-			MethodName: "ScopeSpecificationsAll",
-			Handler:    _Query_ScopeSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "ContractSpecification",
-			Handler:    _Query_ContractSpecification_Handler,
-		},
-		{
-			MethodName: "ContractSpecificationsAll",
-			Handler:    _Query_ContractSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsForContractSpecification",
-			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecification",
-			Handler:    _Query_RecordSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsAll",
-			Handler:    _Query_RecordSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "GetByAddr",
-			Handler:    _Query_GetByAddr_Handler,
-		},
-		{
-			MethodName: "OSLocatorParams",
-			Handler:    _Query_OSLocatorParams_Handler,
-		},
-		{
-			MethodName: "OSLocator",
-			Handler:    _Query_OSLocator_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByURI",
-			Handler:    _Query_OSLocatorsByURI_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByScope",
-			Handler:    _Query_OSLocatorsByScope_Handler,
-		},
-		{
-			MethodName: "OSAllLocators",
-			Handler:    _Query_OSAllLocators_Handler,
-		},
-		{
-			MethodName: "AccountData",
-			Handler:    _Query_AccountData_Handler,
-		},
-		{
-			MethodName: "ScopeNetAssetValues",
-			Handler:    _Query_ScopeNetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/query.proto",
+// ScopeLienRequest is the request type for the Query/ScopeLien RPC method.
+type ScopeLienRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeLienRequest) Reset()         { *m = ScopeLienRequest{} }
+func (m *ScopeLienRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeLienRequest) ProtoMessage()    {}
+func (*ScopeLienRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{69}
+}
+func (m *ScopeLienRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLienRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLienRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeLienRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLienRequest.Merge(m, src)
+}
+func (m *ScopeLienRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLienRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLienRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+var xxx_messageInfo_ScopeLienRequest proto.InternalMessageInfo
+
+func (m *ScopeLienRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeLienRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScopeLienResponse is the response type for the Query/ScopeLien RPC method.
+type ScopeLienResponse struct {
+	// lien is the lien on the scope. It is not set if the scope does not have a lien.
+	Lien *ScopeLien `protobuf:"bytes,1,opt,name=lien,proto3" json:"lien,omitempty"`
+	// in_effect is true if the lien currently prevents changes to the scope, i.e. it exists and its release time, if
+	// it has one, has not yet passed.
+	InEffect bool `protobuf:"varint,2,opt,name=in_effect,json=inEffect,proto3" json:"in_effect,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeLienRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ScopeLienResponse) Reset()         { *m = ScopeLienResponse{} }
+func (m *ScopeLienResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeLienResponse) ProtoMessage()    {}
+func (*ScopeLienResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{70}
+}
+func (m *ScopeLienResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLienResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLienResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ScopeLienResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLienResponse.Merge(m, src)
+}
+func (m *ScopeLienResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLienResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLienResponse.DiscardUnknown(m)
 }
 
-func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeLienResponse proto.InternalMessageInfo
+
+func (m *ScopeLienResponse) GetLien() *ScopeLien {
+	if m != nil {
+		return m.Lien
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeLienResponse) GetInEffect() bool {
+	if m != nil {
+		return m.InEffect
+	}
+	return false
 }
 
-func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+func (m *ScopeLienResponse) GetRequest() *ScopeLienRequest {
+	if m != nil {
+		return m.Request
 	}
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// ScopeLiensByLienholderRequest is the request type for the Query/ScopeLiensByLienholder RPC method.
+type ScopeLiensByLienholderRequest struct {
+	// lienholder is the bech32 address of the account that holds the liens.
+	Lienholder string `protobuf:"bytes,1,opt,name=lienholder,proto3" json:"lienholder,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeLiensByLienholderRequest) Reset()         { *m = ScopeLiensByLienholderRequest{} }
+func (m *ScopeLiensByLienholderRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeLiensByLienholderRequest) ProtoMessage()    {}
+func (*ScopeLiensByLienholderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{71}
+}
+func (m *ScopeLiensByLienholderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLiensByLienholderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLiensByLienholderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ScopeLiensByLienholderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLiensByLienholderRequest.Merge(m, src)
+}
+func (m *ScopeLiensByLienholderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLiensByLienholderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLiensByLienholderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLiensByLienholderRequest proto.InternalMessageInfo
+
+func (m *ScopeLiensByLienholderRequest) GetLienholder() string {
+	if m != nil {
+		return m.Lienholder
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ScopeLiensByLienholderRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeLiensByLienholderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScopeLiensByLienholderResponse is the response type for the Query/ScopeLiensByLienholder RPC method.
+type ScopeLiensByLienholderResponse struct {
+	// liens are the liens held by the lienholder.
+	Liens []ScopeLien `protobuf:"bytes,1,rep,name=liens,proto3" json:"liens"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeLiensByLienholderRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ScopeLiensByLienholderResponse) Reset()         { *m = ScopeLiensByLienholderResponse{} }
+func (m *ScopeLiensByLienholderResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeLiensByLienholderResponse) ProtoMessage()    {}
+func (*ScopeLiensByLienholderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{72}
+}
+func (m *ScopeLiensByLienholderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLiensByLienholderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLiensByLienholderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScopeLiensByLienholderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLiensByLienholderResponse.Merge(m, src)
+}
+func (m *ScopeLiensByLienholderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLiensByLienholderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLiensByLienholderResponse.DiscardUnknown(m)
 }
 
-func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeLiensByLienholderResponse proto.InternalMessageInfo
+
+func (m *ScopeLiensByLienholderResponse) GetLiens() []ScopeLien {
+	if m != nil {
+		return m.Liens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeLiensByLienholderResponse) GetRequest() *ScopeLiensByLienholderRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *ScopeLiensByLienholderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return nil
+}
+
+// ScopeValueHoldersRequest is the request type for the Query/ScopeValueHolders RPC method.
+type ScopeValueHoldersRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeValueHoldersRequest) Reset()         { *m = ScopeValueHoldersRequest{} }
+func (m *ScopeValueHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueHoldersRequest) ProtoMessage()    {}
+func (*ScopeValueHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{73}
+}
+func (m *ScopeValueHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeValueHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeValueHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScopeValueHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueHoldersRequest.Merge(m, src)
+}
+func (m *ScopeValueHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeValueHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueHoldersRequest.DiscardUnknown(m)
 }
 
-func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScopeValueHoldersRequest proto.InternalMessageInfo
+
+func (m *ScopeValueHoldersRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeValueHoldersRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
 }
 
-func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (m *ScopeValueHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// ScopeValueHoldersResponse is the response type for the Query/ScopeValueHolders RPC method.
+type ScopeValueHoldersResponse struct {
+	// total_units is the total number of value units that the scope has.
+	// It is one unless the scope's value has been split into multiple units.
+	TotalUnits uint64 `protobuf:"varint,1,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	// holders are the accounts that hold the scope's value units.
+	Holders []ScopeValueHolder `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeValueHoldersRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopeValueHoldersResponse) Reset()         { *m = ScopeValueHoldersResponse{} }
+func (m *ScopeValueHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueHoldersResponse) ProtoMessage()    {}
+func (*ScopeValueHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{74}
+}
+func (m *ScopeValueHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeValueHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeValueHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+		return b[:n], nil
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+}
+func (m *ScopeValueHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueHoldersResponse.Merge(m, src)
+}
+func (m *ScopeValueHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeValueHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueHoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeValueHoldersResponse proto.InternalMessageInfo
+
+func (m *ScopeValueHoldersResponse) GetTotalUnits() uint64 {
+	if m != nil {
+		return m.TotalUnits
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScopeValueHoldersResponse) GetHolders() []ScopeValueHolder {
+	if m != nil {
+		return m.Holders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScopeValueHoldersResponse) GetRequest() *ScopeValueHoldersRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (m *ScopeValueHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return nil
+}
+
+// OSLocatorsByOwnerRequest is the request type for the Query/OSLocatorsByOwner RPC method.
+type OSLocatorsByOwnerRequest struct {
+	// owner is the bech32 address of the owner of the locators.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// key_height is the block height to get the locators' encryption keys at. If zero, the current height is used.
+	// Only the encryption keys are looked up at this height. The uris, names, and primary flags are always the current ones.
+	KeyHeight int64 `protobuf:"varint,2,opt,name=key_height,json=keyHeight,proto3" json:"key_height,omitempty"`
+	// include_request is a flag for whether to include this request in your result.
+	IncludeRequest bool `protobuf:"varint,98,opt,name=include_request,json=includeRequest,proto3" json:"include_request,omitempty"`
+}
+
+func (m *OSLocatorsByOwnerRequest) Reset()         { *m = OSLocatorsByOwnerRequest{} }
+func (m *OSLocatorsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByOwnerRequest) ProtoMessage()    {}
+func (*OSLocatorsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{75}
+}
+func (m *OSLocatorsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+		return b[:n], nil
 	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *OSLocatorsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByOwnerRequest.Merge(m, src)
+}
+func (m *OSLocatorsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorsByOwnerRequest proto.InternalMessageInfo
+
+func (m *OSLocatorsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByOwnerRequest) GetKeyHeight() int64 {
+	if m != nil {
+		return m.KeyHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OSLocatorsByOwnerRequest) GetIncludeRequest() bool {
+	if m != nil {
+		return m.IncludeRequest
+	}
+	return false
 }
 
-func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecordName) > 0 {
-		i -= len(m.RecordName)
-		copy(dAtA[i:], m.RecordName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// OSLocatorsByOwnerResponse is the response type for the Query/OSLocatorsByOwner RPC method.
+type OSLocatorsByOwnerResponse struct {
+	// locators are the owner's locators, ordered by name.
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorsByOwnerRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OSLocatorsByOwnerResponse) Reset()         { *m = OSLocatorsByOwnerResponse{} }
+func (m *OSLocatorsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByOwnerResponse) ProtoMessage()    {}
+func (*OSLocatorsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{76}
 }
-
-func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OSLocatorsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *OSLocatorsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OSLocatorsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByOwnerResponse.Merge(m, src)
 }
-
-func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OSLocatorsByOwnerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Session != nil {
-		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *OSLocatorsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OSLocatorsByOwnerResponse proto.InternalMessageInfo
+
+func (m *OSLocatorsByOwnerResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OSLocatorsByOwnerResponse) GetRequest() *OSLocatorsByOwnerRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
+	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
+	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
+	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
+	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
+	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
+	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
+	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
+	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
+	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
+	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
+	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
+	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
+	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
+	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
+	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
+	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
+	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
+	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
+	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
+	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
+	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
+	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
+	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
+	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
+	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
+	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
+	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
+	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
+	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
+	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
+	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
+	proto.RegisterType((*GetByAddrRequest)(nil), "provenance.metadata.v1.GetByAddrRequest")
+	proto.RegisterType((*GetByAddrResponse)(nil), "provenance.metadata.v1.GetByAddrResponse")
+	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
+	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
+	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
+	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
+	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
+	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
+	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
+	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
+	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
+	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
+	proto.RegisterType((*AccountDataRequest)(nil), "provenance.metadata.v1.AccountDataRequest")
+	proto.RegisterType((*AccountDataResponse)(nil), "provenance.metadata.v1.AccountDataResponse")
+	proto.RegisterType((*QueryScopeNetAssetValuesRequest)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesRequest")
+	proto.RegisterType((*QueryScopeNetAssetValuesResponse)(nil), "provenance.metadata.v1.QueryScopeNetAssetValuesResponse")
+	proto.RegisterType((*ScopeHistoryRequest)(nil), "provenance.metadata.v1.ScopeHistoryRequest")
+	proto.RegisterType((*ScopeHistoryResponse)(nil), "provenance.metadata.v1.ScopeHistoryResponse")
+	proto.RegisterType((*ScopeChildrenRequest)(nil), "provenance.metadata.v1.ScopeChildrenRequest")
+	proto.RegisterType((*ScopeChildrenResponse)(nil), "provenance.metadata.v1.ScopeChildrenResponse")
+	proto.RegisterType((*ScopeAncestorsRequest)(nil), "provenance.metadata.v1.ScopeAncestorsRequest")
+	proto.RegisterType((*ScopeAncestorsResponse)(nil), "provenance.metadata.v1.ScopeAncestorsResponse")
+	proto.RegisterType((*ScopesBySpecificationRequest)(nil), "provenance.metadata.v1.ScopesBySpecificationRequest")
+	proto.RegisterType((*ScopesBySpecificationResponse)(nil), "provenance.metadata.v1.ScopesBySpecificationResponse")
+	proto.RegisterType((*ScopesByPartyRoleRequest)(nil), "provenance.metadata.v1.ScopesByPartyRoleRequest")
+	proto.RegisterType((*ScopesByPartyRoleResponse)(nil), "provenance.metadata.v1.ScopesByPartyRoleResponse")
+	proto.RegisterType((*ScopesByDataAccessRequest)(nil), "provenance.metadata.v1.ScopesByDataAccessRequest")
+	proto.RegisterType((*ScopesByDataAccessResponse)(nil), "provenance.metadata.v1.ScopesByDataAccessResponse")
+	proto.RegisterType((*RecordsByOutputHashRequest)(nil), "provenance.metadata.v1.RecordsByOutputHashRequest")
+	proto.RegisterType((*RecordsByOutputHashResponse)(nil), "provenance.metadata.v1.RecordsByOutputHashResponse")
+	proto.RegisterType((*OutputHashRecord)(nil), "provenance.metadata.v1.OutputHashRecord")
+	proto.RegisterType((*ScopeLienRequest)(nil), "provenance.metadata.v1.ScopeLienRequest")
+	proto.RegisterType((*ScopeLienResponse)(nil), "provenance.metadata.v1.ScopeLienResponse")
+	proto.RegisterType((*ScopeLiensByLienholderRequest)(nil), "provenance.metadata.v1.ScopeLiensByLienholderRequest")
+	proto.RegisterType((*ScopeLiensByLienholderResponse)(nil), "provenance.metadata.v1.ScopeLiensByLienholderResponse")
+	proto.RegisterType((*ScopeValueHoldersRequest)(nil), "provenance.metadata.v1.ScopeValueHoldersRequest")
+	proto.RegisterType((*ScopeValueHoldersResponse)(nil), "provenance.metadata.v1.ScopeValueHoldersResponse")
+	proto.RegisterType((*OSLocatorsByOwnerRequest)(nil), "provenance.metadata.v1.OSLocatorsByOwnerRequest")
+	proto.RegisterType((*OSLocatorsByOwnerResponse)(nil), "provenance.metadata.v1.OSLocatorsByOwnerResponse")
 }
 
-func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
 }
 
-func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_a68790bc0b96eeb9 = []byte{
+	// 3783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0x5b, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xf6, 0x90, 0xb2, 0x25, 0x1d, 0x5d, 0x7d, 0x24, 0xcb, 0xf2, 0x3a, 0x96, 0x65, 0xc6, 0x17,
+	0xc9, 0xb2, 0x49, 0x4b, 0xb2, 0x7c, 0x49, 0xec, 0xe4, 0x97, 0x1c, 0xdf, 0x62, 0xc7, 0x76, 0xe8,
+	0x38, 0x01, 0x14, 0xfc, 0xbf, 0xb0, 0x26, 0xd7, 0x12, 0x7f, 0x53, 0x5c, 0x66, 0x77, 0xe9, 0x3f,
+	0x82, 0xa0, 0x1f, 0x68, 0x11, 0x34, 0x28, 0x6a, 0x14, 0x69, 0x9b, 0xa6, 0x97, 0x20, 0x68, 0x2e,
+	0x48, 0x8b, 0x26, 0x2e, 0x9a, 0x14, 0x28, 0x92, 0x34, 0xed, 0x43, 0x51, 0xa4, 0x48, 0xd1, 0x16,
+	0x4d, 0x52, 0x14, 0x28, 0xfa, 0x10, 0x14, 0x76, 0x1f, 0x0a, 0xb4, 0xcf, 0x01, 0xd2, 0x87, 0xb6,
+	0xd8, 0xd9, 0x99, 0xe5, 0xde, 0x39, 0x4b, 0x93, 0xae, 0x9d, 0x17, 0x5b, 0x3b, 0x7b, 0xce, 0xd9,
+	0x33, 0xdf, 0x39, 0x73, 0x66, 0xe6, 0xcc, 0x19, 0x42, 0xaa, 0xac, 0xa9, 0x57, 0x94, 0x92, 0x5c,
+	0xca, 0x29, 0x99, 0x45, 0xc5, 0x90, 0xf3, 0xb2, 0x21, 0x67, 0xae, 0x8c, 0x67, 0x9e, 0xa8, 0x28,
+	0xda, 0x52, 0xba, 0xac, 0xa9, 0x86, 0x8a, 0x03, 0x55, 0x9a, 0x34, 0xa7, 0x49, 0x5f, 0x19, 0x97,
+	0xfa, 0xe7, 0xd5, 0x79, 0x95, 0x92, 0x64, 0xcc, 0xbf, 0x2c, 0x6a, 0x69, 0x67, 0x4e, 0xd5, 0x17,
+	0x55, 0x3d, 0x73, 0x51, 0xd6, 0x15, 0x4b, 0x4c, 0xe6, 0xca, 0xf8, 0x45, 0xc5, 0x90, 0xc7, 0x33,
+	0x65, 0x79, 0xbe, 0x50, 0x92, 0x8d, 0x82, 0x5a, 0x62, 0xb4, 0x77, 0xcd, 0xab, 0xea, 0x7c, 0x51,
+	0xc9, 0xc8, 0xe5, 0x42, 0x46, 0x2e, 0x95, 0x54, 0x83, 0xbe, 0xd4, 0xd9, 0xdb, 0x6d, 0x21, 0xba,
+	0xd9, 0x3a, 0x58, 0x64, 0x61, 0x5d, 0xd0, 0x73, 0x6a, 0x59, 0xe1, 0x4a, 0x85, 0xd1, 0x94, 0x95,
+	0x5c, 0xe1, 0x52, 0x21, 0xe7, 0x54, 0x6a, 0x24, 0x84, 0x56, 0xbd, 0xf8, 0xbf, 0x4a, 0xce, 0xd0,
+	0x0d, 0x55, 0x63, 0x52, 0x53, 0x87, 0x01, 0x1f, 0x36, 0x3b, 0x78, 0x4e, 0xd6, 0xe4, 0x45, 0x3d,
+	0xab, 0x3c, 0x51, 0x51, 0x74, 0x03, 0x77, 0x40, 0x4f, 0xa1, 0x94, 0x2b, 0x56, 0xf2, 0xca, 0x9c,
+	0x66, 0x35, 0x0d, 0x5e, 0x1c, 0x26, 0x23, 0x6d, 0xd9, 0x6e, 0xd6, 0xcc, 0x08, 0x53, 0xdf, 0x22,
+	0xd0, 0xe7, 0xe2, 0xd7, 0xcb, 0x6a, 0x49, 0x57, 0xf0, 0x10, 0xac, 0x29, 0xd3, 0x96, 0x41, 0x32,
+	0x4c, 0x46, 0x3a, 0x26, 0x86, 0xd2, 0xc1, 0x06, 0x48, 0x5b, 0x7c, 0x33, 0x2d, 0xef, 0x7f, 0xbc,
+	0x79, 0x55, 0x96, 0xf1, 0xe0, 0x03, 0xd0, 0xea, 0xfc, 0x6c, 0xc7, 0xc4, 0xce, 0x30, 0x76, 0xbf,
+	0xee, 0x59, 0xce, 0x9a, 0xfa, 0x6a, 0x02, 0x3a, 0xcf, 0x9b, 0x00, 0xf2, 0x5e, 0x6d, 0x80, 0x36,
+	0x0a, 0xe8, 0x5c, 0x21, 0x4f, 0xd5, 0x6a, 0xcf, 0xb6, 0xd2, 0xe7, 0x93, 0x79, 0xdc, 0x02, 0x9d,
+	0xba, 0xa2, 0xeb, 0x05, 0xb5, 0x34, 0x27, 0xe7, 0xf3, 0xda, 0x60, 0x82, 0xbe, 0xee, 0x60, 0x6d,
+	0xd3, 0xf9, 0xbc, 0x86, 0x9b, 0xa1, 0x43, 0x53, 0x72, 0xaa, 0x96, 0xb7, 0x28, 0x92, 0x94, 0x02,
+	0xac, 0x26, 0x4a, 0x30, 0x0a, 0xbd, 0x1c, 0x34, 0xc6, 0xa7, 0x0f, 0x02, 0x45, 0x8d, 0x83, 0x79,
+	0x9e, 0x35, 0xbb, 0xf1, 0x35, 0x05, 0xe8, 0x83, 0x1d, 0x1e, 0x7c, 0x69, 0x2b, 0x6e, 0x87, 0x1e,
+	0xe5, 0x49, 0x8b, 0xb0, 0x90, 0x9f, 0x2b, 0x94, 0x2e, 0xa9, 0x83, 0x9d, 0x94, 0xb0, 0x8b, 0x35,
+	0x9f, 0xcc, 0x9f, 0x2c, 0x5d, 0x52, 0xc5, 0x0d, 0xf6, 0x4c, 0x02, 0xba, 0x18, 0x28, 0xcc, 0x54,
+	0xf7, 0xc0, 0x6a, 0x8a, 0x02, 0xb3, 0xd4, 0xd6, 0x30, 0xa8, 0x29, 0xd7, 0x63, 0x9a, 0x5c, 0x2e,
+	0x2b, 0x5a, 0xd6, 0x62, 0xc1, 0x19, 0x68, 0xb3, 0xbb, 0x9a, 0x18, 0x4e, 0x8e, 0x74, 0x4c, 0x6c,
+	0x0f, 0x65, 0xb7, 0xe8, 0xb8, 0x00, 0x9b, 0x0f, 0xef, 0x37, 0x8d, 0x6d, 0x61, 0x90, 0xa4, 0x22,
+	0xb6, 0x85, 0x89, 0xb0, 0x40, 0xe1, 0x12, 0x38, 0x17, 0xde, 0xe7, 0xf5, 0x96, 0xe8, 0x2e, 0xf8,
+	0xfc, 0xe4, 0x3a, 0x61, 0x7e, 0xc2, 0x24, 0xe3, 0xa4, 0x1b, 0x91, 0x4d, 0xd1, 0xe2, 0x18, 0x14,
+	0xc7, 0xa1, 0x8b, 0x3b, 0x97, 0x65, 0xa7, 0x04, 0x65, 0xbe, 0x3b, 0x92, 0xd9, 0xb2, 0x5e, 0xb6,
+	0x43, 0xaf, 0x3e, 0xe0, 0x23, 0x80, 0x96, 0x20, 0x73, 0x60, 0xdb, 0xd2, 0x92, 0x54, 0xda, 0x8e,
+	0x48, 0x69, 0xe7, 0xcb, 0x4a, 0x8e, 0x49, 0xec, 0xd1, 0xdd, 0x0d, 0xa9, 0xd7, 0x09, 0xf4, 0x52,
+	0x22, 0x7d, 0xba, 0x58, 0xe4, 0x03, 0xa2, 0xd1, 0xde, 0x85, 0xc7, 0x00, 0xaa, 0x01, 0x72, 0x30,
+	0x47, 0x75, 0xde, 0x9e, 0xb6, 0xa2, 0x69, 0xda, 0x8c, 0xa6, 0x69, 0x2b, 0x28, 0xb3, 0x68, 0x9a,
+	0x3e, 0x27, 0xcf, 0xdb, 0xf6, 0x70, 0x70, 0xa6, 0x3e, 0x26, 0xb0, 0xd6, 0xa1, 0x6d, 0x35, 0xa8,
+	0xd0, 0x6e, 0x99, 0x41, 0x25, 0x29, 0xec, 0xaa, 0x8c, 0x07, 0x67, 0xbc, 0x6e, 0x32, 0x12, 0xc9,
+	0xee, 0xc0, 0xc9, 0x76, 0x15, 0x3c, 0x1e, 0xd0, 0xbf, 0x1d, 0x35, 0xfb, 0x67, 0xa9, 0xef, 0xea,
+	0xe0, 0xb5, 0x04, 0xf4, 0xf0, 0x68, 0x20, 0x10, 0x9e, 0x36, 0x01, 0xf0, 0xf0, 0x54, 0xc8, 0xb3,
+	0xe0, 0xd4, 0xce, 0x5a, 0x4e, 0xe6, 0x6b, 0x87, 0xa6, 0x2a, 0x41, 0x49, 0x5e, 0x54, 0x06, 0x5b,
+	0x9c, 0x04, 0x67, 0xe4, 0x45, 0x05, 0xef, 0x86, 0x2e, 0x3b, 0x76, 0x51, 0xd7, 0xb7, 0x02, 0x57,
+	0x27, 0x0f, 0x5c, 0xd4, 0xc5, 0xff, 0x73, 0x51, 0xeb, 0xb9, 0x04, 0xf4, 0x56, 0xe1, 0xfa, 0xac,
+	0x04, 0xae, 0x69, 0xaf, 0x47, 0xee, 0xa8, 0xa1, 0x83, 0x7f, 0x8e, 0xfb, 0x94, 0x40, 0xb7, 0x5b,
+	0x41, 0x3c, 0x08, 0xad, 0x4c, 0x45, 0x06, 0xcc, 0xe6, 0x1a, 0x52, 0xb3, 0x9c, 0x1e, 0x1f, 0x82,
+	0x9e, 0xaa, 0x9b, 0x39, 0xa3, 0xd8, 0xb6, 0x1a, 0x22, 0x58, 0xd4, 0xe9, 0xd2, 0x9d, 0x8f, 0xf8,
+	0xdf, 0xb0, 0x2e, 0xa7, 0x96, 0x0c, 0x4d, 0xce, 0x19, 0x41, 0xc1, 0x2c, 0x74, 0x52, 0x3f, 0xc2,
+	0x98, 0x1c, 0xf1, 0x0c, 0x73, 0xbe, 0xb6, 0xd4, 0x0f, 0x08, 0x20, 0x07, 0xe6, 0x4e, 0x08, 0x6a,
+	0x7f, 0x25, 0xd0, 0xe7, 0xd2, 0x97, 0xf9, 0xb1, 0xd3, 0x17, 0x49, 0x9d, 0xbe, 0x28, 0xbe, 0x62,
+	0xf2, 0x23, 0xd6, 0x84, 0xf0, 0xf6, 0x62, 0x02, 0xba, 0x59, 0x30, 0xe0, 0x28, 0x7a, 0x62, 0x14,
+	0xf1, 0xc5, 0x28, 0x67, 0xf8, 0x4b, 0x44, 0x85, 0xbf, 0xa4, 0x37, 0xfc, 0x21, 0xb4, 0x38, 0xc2,
+	0x5a, 0x4b, 0x49, 0x38, 0xa0, 0x05, 0xad, 0xd8, 0x3a, 0x82, 0x57, 0x6c, 0x0d, 0x0f, 0x69, 0xcf,
+	0x26, 0xa0, 0xc7, 0x86, 0xe8, 0xb3, 0x12, 0xd1, 0xfe, 0xcb, 0xeb, 0x86, 0xdb, 0xa3, 0x05, 0xf8,
+	0x03, 0xda, 0xdf, 0x09, 0x74, 0xb9, 0x84, 0xe3, 0x3e, 0x58, 0x63, 0x89, 0xaf, 0xb5, 0x95, 0xb0,
+	0xd8, 0xb2, 0x8c, 0x1a, 0x1f, 0x84, 0x6e, 0xe6, 0x70, 0xee, 0x58, 0xb6, 0x35, 0x9a, 0x9f, 0x05,
+	0x9c, 0x4e, 0xcd, 0xf1, 0x84, 0x8f, 0x41, 0x1f, 0x93, 0x15, 0x10, 0xc7, 0x46, 0xa2, 0x05, 0x3a,
+	0xa2, 0x58, 0xaf, 0xe6, 0x69, 0x49, 0x5d, 0x23, 0xb0, 0x96, 0x41, 0x71, 0x27, 0x84, 0xb0, 0x1b,
+	0x04, 0xd0, 0xa9, 0x2e, 0xf3, 0x5b, 0x87, 0xdf, 0x90, 0xba, 0xfc, 0xe6, 0x88, 0xd7, 0x6f, 0x46,
+	0x6b, 0xf8, 0x4d, 0x53, 0xa3, 0xd7, 0x0b, 0x04, 0x7a, 0xcf, 0xfe, 0x5f, 0x49, 0xd1, 0xf4, 0x85,
+	0x42, 0x99, 0x43, 0x38, 0x08, 0xad, 0x66, 0xe0, 0x52, 0x74, 0x9d, 0x2f, 0xce, 0xd8, 0xe3, 0xad,
+	0xb7, 0xc2, 0xcf, 0x09, 0xac, 0x75, 0xe8, 0xc7, 0x8c, 0xb0, 0x19, 0xac, 0x6d, 0xc4, 0x5c, 0xa5,
+	0x52, 0x60, 0x86, 0x68, 0xcf, 0x02, 0x6d, 0xba, 0x60, 0xb6, 0xc4, 0x58, 0x00, 0x7b, 0x3b, 0xdf,
+	0x04, 0x8c, 0x5f, 0x26, 0xb0, 0xee, 0x51, 0xb9, 0x58, 0x51, 0x6e, 0x67, 0xa0, 0x7f, 0x4d, 0x60,
+	0xc0, 0xab, 0xa4, 0x28, 0xda, 0xc7, 0xbd, 0x68, 0xef, 0x0e, 0x43, 0x3b, 0x10, 0x86, 0x26, 0x40,
+	0xfe, 0x2f, 0x02, 0x1b, 0xec, 0x7d, 0xa2, 0x9d, 0x31, 0xe2, 0x98, 0x8d, 0x42, 0xaf, 0x2b, 0x93,
+	0x54, 0xdd, 0x85, 0xf4, 0xb8, 0xda, 0x4f, 0xe6, 0x71, 0x2f, 0x0c, 0x70, 0x3b, 0xb8, 0xd6, 0x77,
+	0x3c, 0xdd, 0xd1, 0xcf, 0xde, 0x3a, 0xd7, 0x71, 0x3a, 0xee, 0x81, 0x7e, 0xf7, 0xee, 0x81, 0xf1,
+	0x58, 0x13, 0x2e, 0xba, 0xb6, 0x10, 0x16, 0x47, 0xc3, 0xe7, 0xdc, 0xcf, 0x25, 0x41, 0x0a, 0x42,
+	0x80, 0xd9, 0xf4, 0x22, 0xf4, 0x55, 0x77, 0xde, 0xf6, 0x6b, 0x36, 0xed, 0x8c, 0xd7, 0xdc, 0x7a,
+	0xdb, 0x1c, 0x3c, 0xbc, 0xa1, 0xee, 0x7b, 0x85, 0x8f, 0x43, 0xb7, 0x07, 0x33, 0x6b, 0xb2, 0xde,
+	0x2b, 0xb2, 0x18, 0xf6, 0x7d, 0xa1, 0x2b, 0xe7, 0x82, 0xf8, 0x02, 0x74, 0xba, 0xa0, 0xb5, 0x26,
+	0xf1, 0x89, 0xda, 0xf3, 0x93, 0x4f, 0x70, 0x87, 0xe6, 0xb0, 0xc3, 0x29, 0xaf, 0x2b, 0xc7, 0xc0,
+	0xc2, 0x37, 0xc1, 0xff, 0x22, 0xd0, 0x0b, 0xf9, 0x64, 0x7f, 0x0e, 0xba, 0x82, 0xc0, 0xdf, 0x19,
+	0xe3, 0x83, 0x6e, 0x01, 0x21, 0xe9, 0x94, 0xc4, 0x4d, 0xa6, 0x53, 0xde, 0x26, 0xb0, 0xc9, 0xff,
+	0xed, 0x3b, 0x62, 0x0e, 0x7f, 0x31, 0x01, 0x43, 0x61, 0xaa, 0xb3, 0x81, 0x90, 0x87, 0xfe, 0x80,
+	0x81, 0xc0, 0x27, 0xf7, 0x3a, 0x46, 0x42, 0x9f, 0x7f, 0x24, 0xe8, 0x78, 0xd6, 0xeb, 0x56, 0x53,
+	0xe2, 0x82, 0x9b, 0xbb, 0x00, 0xf8, 0x0d, 0x81, 0xbb, 0x02, 0xc7, 0x5d, 0x1d, 0xc1, 0x32, 0x2c,
+	0xec, 0xc1, 0xad, 0x0b, 0x7b, 0xef, 0x25, 0x60, 0x53, 0x48, 0x77, 0x98, 0xc1, 0x2f, 0xc3, 0x80,
+	0x2b, 0x2a, 0x79, 0xc7, 0x5f, 0x7d, 0xd1, 0x69, 0x5d, 0x2e, 0xe8, 0x2d, 0xce, 0xc3, 0x3a, 0x07,
+	0x12, 0x0e, 0xf7, 0xaa, 0x3f, 0x5c, 0xf5, 0x6b, 0xfe, 0x77, 0x3a, 0x9e, 0xf1, 0x3a, 0x58, 0xbc,
+	0x6e, 0xf8, 0x42, 0xd7, 0x47, 0x61, 0x6e, 0xc1, 0xa3, 0xd7, 0xf9, 0xe0, 0xe8, 0xb5, 0x3b, 0xde,
+	0x67, 0x3d, 0x01, 0x2c, 0x34, 0x8b, 0x92, 0x68, 0x48, 0x16, 0xe5, 0x5d, 0x02, 0xc3, 0x81, 0x7a,
+	0xdc, 0x11, 0xc1, 0xec, 0x87, 0x09, 0xd8, 0x12, 0xa1, 0x3d, 0x73, 0xef, 0x45, 0x58, 0x1f, 0xec,
+	0xde, 0x3c, 0xa4, 0xd5, 0xe7, 0xdf, 0x03, 0x81, 0xfe, 0xad, 0x63, 0xd6, 0xeb, 0x77, 0x07, 0x62,
+	0x89, 0x6f, 0x6e, 0x6c, 0x7b, 0x93, 0xc0, 0x64, 0xc0, 0x48, 0xd2, 0x8f, 0xa9, 0x5a, 0xa3, 0x42,
+	0x5e, 0xc3, 0x03, 0xd8, 0x17, 0x92, 0xb0, 0x37, 0x9e, 0xce, 0xcc, 0xf0, 0xa1, 0xa1, 0x86, 0x34,
+	0x38, 0xd4, 0xdc, 0x07, 0x1b, 0x83, 0x3d, 0x8c, 0xee, 0x0f, 0x58, 0x3e, 0x6b, 0x43, 0xa0, 0xbf,
+	0x98, 0xdb, 0x85, 0x08, 0x7e, 0x47, 0x46, 0x3f, 0x98, 0x9f, 0x26, 0xcf, 0x14, 0xaf, 0xcb, 0x9d,
+	0x8a, 0xd1, 0xb5, 0x5a, 0xb6, 0xaf, 0x46, 0xc0, 0x6b, 0x04, 0xa4, 0x00, 0x01, 0x75, 0xf8, 0x08,
+	0xcf, 0xd9, 0x25, 0x1c, 0x39, 0xbb, 0x86, 0xfb, 0xcd, 0x47, 0x04, 0x36, 0x06, 0xaa, 0xcb, 0xdc,
+	0x43, 0x81, 0xfe, 0x20, 0xf7, 0x60, 0x61, 0xbb, 0x1e, 0xef, 0xe8, 0x0b, 0xf0, 0x0e, 0x3c, 0xed,
+	0x35, 0x4e, 0x1c, 0xc9, 0x3e, 0x1b, 0xbc, 0x1f, 0x6c, 0x03, 0x3e, 0x07, 0x3d, 0x1c, 0x3c, 0x07,
+	0x8d, 0xc5, 0xf9, 0xa4, 0x67, 0x06, 0x0a, 0xc9, 0x7e, 0x25, 0x6e, 0x3a, 0xfb, 0xf5, 0x0e, 0x81,
+	0xa1, 0x20, 0x7f, 0xbc, 0x13, 0x66, 0x9e, 0x57, 0x13, 0xb0, 0x39, 0x54, 0xf7, 0x5b, 0x1d, 0x7e,
+	0xce, 0x79, 0x3d, 0x6c, 0x5f, 0x9c, 0xe1, 0xdf, 0xd4, 0xf9, 0x66, 0x04, 0x7a, 0x8f, 0x2b, 0xc6,
+	0xcc, 0x92, 0x19, 0xa6, 0xb8, 0x0d, 0xfa, 0x61, 0xb5, 0x19, 0xd6, 0x78, 0xda, 0xc4, 0x7a, 0x48,
+	0x7d, 0x98, 0x84, 0xb5, 0x0e, 0x52, 0x86, 0xe1, 0x94, 0xe7, 0xd0, 0xb7, 0xc6, 0x69, 0x3c, 0x23,
+	0xc6, 0x7b, 0x7d, 0xe9, 0xf0, 0x9a, 0xc7, 0x60, 0x36, 0x03, 0x1e, 0xf0, 0xe6, 0xc1, 0x6b, 0xe5,
+	0x9c, 0x39, 0x39, 0x9e, 0xe2, 0x69, 0x21, 0x6b, 0x91, 0xdf, 0x32, 0x9c, 0x8c, 0x5a, 0xa2, 0x05,
+	0xec, 0x5e, 0xc1, 0xde, 0x29, 0xe9, 0xf8, 0x88, 0x2f, 0x57, 0xb0, 0x7a, 0x38, 0x59, 0xc7, 0x7a,
+	0xd2, 0x9d, 0x24, 0x38, 0xe3, 0x49, 0x12, 0xac, 0x19, 0x4e, 0xc6, 0x8d, 0x0f, 0xae, 0xec, 0xc0,
+	0x46, 0x68, 0x2f, 0xa9, 0xc6, 0xdc, 0x25, 0xb5, 0x52, 0xca, 0x0f, 0xb6, 0x52, 0x83, 0xb6, 0x95,
+	0x54, 0xe3, 0x98, 0xf9, 0x9c, 0x9a, 0x86, 0x81, 0xb3, 0xe7, 0x4f, 0xab, 0x39, 0xd9, 0x50, 0xb5,
+	0x3a, 0x4b, 0x8c, 0x5e, 0x23, 0xb0, 0xde, 0x27, 0x83, 0x39, 0xc7, 0x51, 0x4f, 0x99, 0x51, 0xe8,
+	0x86, 0xde, 0x23, 0xc0, 0x53, 0x6f, 0x74, 0xc2, 0x3b, 0x7c, 0xd2, 0x82, 0x72, 0x7c, 0xc1, 0xf9,
+	0x29, 0x33, 0x75, 0xcc, 0x69, 0x1c, 0xee, 0xae, 0x9a, 0xe9, 0x3d, 0x36, 0x17, 0x5a, 0x0f, 0x81,
+	0x33, 0xe0, 0x26, 0x80, 0xcb, 0xca, 0xd2, 0xdc, 0x82, 0x52, 0x98, 0x5f, 0x30, 0xe8, 0xac, 0x9f,
+	0xcc, 0xb6, 0x5f, 0x56, 0x96, 0x4e, 0xd0, 0x06, 0x71, 0xcc, 0x5e, 0x30, 0x33, 0xc4, 0x55, 0x35,
+	0x18, 0x5a, 0x0f, 0x40, 0x6b, 0xd1, 0x6a, 0xaa, 0x95, 0x56, 0x39, 0x4b, 0xeb, 0xc4, 0xce, 0x1b,
+	0xaa, 0xa6, 0x70, 0x21, 0x9c, 0x35, 0x4e, 0x1a, 0xd9, 0x03, 0x44, 0x15, 0xa6, 0xe7, 0x89, 0xc3,
+	0x2f, 0xf4, 0x99, 0xa5, 0x0b, 0xd9, 0x93, 0x1c, 0xac, 0x5e, 0x48, 0x56, 0xb4, 0x02, 0x83, 0xca,
+	0xfc, 0xf3, 0xd6, 0x87, 0xf6, 0x7f, 0x38, 0x3d, 0x8e, 0x6b, 0xc7, 0x30, 0x3c, 0x0d, 0x6d, 0x0c,
+	0x08, 0x1e, 0x90, 0x62, 0x80, 0xc8, 0xdc, 0xce, 0x96, 0x50, 0x8f, 0xe3, 0xb9, 0xd0, 0x6a, 0x42,
+	0xbc, 0xfe, 0x1f, 0x18, 0x74, 0x7e, 0x4b, 0xb4, 0x80, 0x4e, 0xd8, 0x35, 0x7f, 0x4c, 0x60, 0x43,
+	0xc0, 0x07, 0x9a, 0x02, 0xef, 0x83, 0x5e, 0x78, 0xf7, 0x88, 0xc0, 0x1b, 0x5c, 0x25, 0xf6, 0x34,
+	0x81, 0xfe, 0xb3, 0xe7, 0xa7, 0x8b, 0x45, 0x4e, 0x18, 0x37, 0x90, 0x35, 0xcc, 0x3d, 0x3f, 0x21,
+	0xb0, 0xce, 0xa3, 0x49, 0x53, 0xd0, 0x3b, 0xe6, 0x45, 0x6f, 0x57, 0x38, 0x7a, 0x7e, 0x5c, 0x9a,
+	0xe0, 0x9a, 0x59, 0xc0, 0xe9, 0x5c, 0x4e, 0xad, 0x94, 0x8c, 0x07, 0x64, 0x43, 0xe6, 0xb0, 0x1e,
+	0x82, 0x2e, 0xae, 0x4b, 0xb5, 0xb4, 0xa0, 0x73, 0x66, 0xbd, 0xd9, 0x9b, 0x3f, 0x7d, 0xbc, 0xb9,
+	0xe7, 0x21, 0xf6, 0x72, 0xda, 0x3a, 0x45, 0xca, 0x76, 0x2e, 0x3a, 0x1a, 0x52, 0x63, 0xd0, 0xe7,
+	0x92, 0xc9, 0x90, 0xec, 0x87, 0xd5, 0x57, 0xcc, 0x63, 0x19, 0x1e, 0xb2, 0xe9, 0x43, 0x6a, 0x1c,
+	0x36, 0xd3, 0x82, 0x53, 0xea, 0x21, 0x67, 0x14, 0x63, 0x5a, 0xd7, 0x15, 0x83, 0x1e, 0xdf, 0xd8,
+	0xde, 0xd0, 0x0d, 0x09, 0x7b, 0x70, 0x24, 0x0a, 0xf9, 0xd4, 0x12, 0x0c, 0x87, 0xb3, 0xb0, 0x8f,
+	0x5d, 0x80, 0xde, 0x92, 0x62, 0xcc, 0xc9, 0xe6, 0xab, 0x39, 0xfa, 0xa5, 0x9a, 0xe7, 0xa8, 0x2e,
+	0x49, 0xcc, 0x72, 0xdd, 0x25, 0x97, 0xf8, 0xd4, 0x4b, 0x66, 0xbd, 0x89, 0xf9, 0xd9, 0x13, 0x05,
+	0xdd, 0x50, 0xb5, 0xa5, 0x06, 0x8e, 0xe2, 0x86, 0xf9, 0xf2, 0xdf, 0x08, 0xf4, 0xbb, 0x75, 0x64,
+	0x98, 0x1c, 0x81, 0xd6, 0xdc, 0x82, 0x5c, 0x9a, 0xb7, 0xa1, 0x88, 0x2e, 0xa4, 0x3c, 0x42, 0x69,
+	0x19, 0x10, 0x9c, 0x13, 0x8f, 0x7a, 0x3d, 0x78, 0x2c, 0x52, 0x88, 0x1b, 0xa7, 0xe6, 0x1c, 0x7a,
+	0xf6, 0x33, 0x75, 0x0b, 0xc5, 0xbc, 0xa6, 0x94, 0x6e, 0x47, 0x93, 0xbc, 0x47, 0x60, 0x9d, 0x47,
+	0x49, 0x66, 0x93, 0x8d, 0xd0, 0xce, 0xb5, 0xe4, 0x4b, 0xf7, 0x36, 0xa6, 0x66, 0x9c, 0x68, 0x11,
+	0x84, 0x40, 0x13, 0xc0, 0x7e, 0x9c, 0x75, 0x63, 0xba, 0x94, 0x53, 0x74, 0x67, 0xc0, 0x6e, 0xc4,
+	0x2c, 0xf6, 0xff, 0x30, 0xe0, 0x15, 0x2e, 0x02, 0x92, 0xf8, 0xa1, 0x70, 0xa0, 0xea, 0xd5, 0xd9,
+	0xe8, 0x27, 0x04, 0xee, 0xa2, 0x24, 0xe6, 0x7c, 0x75, 0x93, 0xa9, 0x98, 0x5b, 0xee, 0x61, 0x7f,
+	0xe0, 0x87, 0x67, 0x7e, 0xe5, 0x45, 0x40, 0x14, 0x4f, 0xeb, 0x47, 0x21, 0xd4, 0x04, 0x8f, 0x7b,
+	0x3a, 0x01, 0x83, 0xfc, 0x93, 0xe7, 0x64, 0xcd, 0x58, 0xca, 0xaa, 0x45, 0xa5, 0x76, 0x59, 0xc3,
+	0x14, 0xb4, 0x68, 0x6a, 0xd1, 0xda, 0x08, 0x74, 0x4f, 0x6c, 0x89, 0xb8, 0x29, 0x61, 0x2c, 0x3d,
+	0xb2, 0x54, 0x56, 0xb2, 0x94, 0x3c, 0xd0, 0xc2, 0xc9, 0xdb, 0xc4, 0xc2, 0xbf, 0xe5, 0x87, 0xbc,
+	0x6e, 0x24, 0x44, 0xac, 0x2b, 0xbe, 0x66, 0x0b, 0x83, 0xba, 0x09, 0x96, 0xfd, 0xd0, 0xd1, 0x1f,
+	0x73, 0x9d, 0x30, 0x9d, 0xcb, 0x29, 0xba, 0x5e, 0xdb, 0xb4, 0x41, 0x36, 0x4a, 0xdc, 0x26, 0x36,
+	0xfa, 0x1d, 0x01, 0x29, 0xa8, 0x4f, 0x22, 0x46, 0x8a, 0x59, 0x11, 0x10, 0x84, 0x5a, 0x13, 0xac,
+	0xf4, 0x92, 0x9d, 0x19, 0xd5, 0x67, 0x96, 0xce, 0x56, 0x8c, 0x72, 0xc5, 0x38, 0x21, 0xeb, 0x0b,
+	0x1c, 0x38, 0x84, 0x96, 0x05, 0x59, 0x5f, 0x60, 0x36, 0xa2, 0x7f, 0xdf, 0x7a, 0xd4, 0xff, 0x69,
+	0xa7, 0xa4, 0x3d, 0x3a, 0x32, 0xd8, 0x4f, 0x78, 0x4b, 0xe9, 0xc2, 0x77, 0xd7, 0x0e, 0x66, 0x93,
+	0x81, 0x2f, 0x7e, 0x18, 0x7b, 0xec, 0xac, 0x73, 0x10, 0x66, 0x4d, 0x30, 0xd2, 0x83, 0xd0, 0xeb,
+	0xd5, 0xdc, 0xf4, 0x35, 0xbb, 0x54, 0x93, 0x99, 0xa7, 0x8d, 0xd7, 0x5f, 0x46, 0xd4, 0x05, 0xa7,
+	0x1e, 0x65, 0x77, 0x5a, 0x4e, 0x17, 0x1a, 0xba, 0x94, 0x4a, 0xbd, 0xce, 0xaf, 0x9f, 0x58, 0x82,
+	0xed, 0x4c, 0x64, 0x4b, 0xb1, 0xa0, 0xf0, 0x84, 0xfa, 0x96, 0x48, 0x8f, 0xa7, 0x8c, 0x94, 0xdc,
+	0xec, 0x5c, 0xa1, 0x34, 0xa7, 0x5c, 0xba, 0xa4, 0xe4, 0x0c, 0xda, 0x81, 0xb6, 0x6c, 0x5b, 0xa1,
+	0x74, 0x94, 0x3e, 0xc7, 0xbd, 0x94, 0xe2, 0xe8, 0x68, 0x75, 0x2d, 0xf0, 0x06, 0x9f, 0x4e, 0xcd,
+	0xb7, 0xfa, 0xcc, 0x92, 0xf9, 0xdf, 0x82, 0x5a, 0xcc, 0x2b, 0x76, 0x02, 0x6a, 0x08, 0xa0, 0x68,
+	0x37, 0xf2, 0xd2, 0xeb, 0x6a, 0xcb, 0xad, 0x1f, 0x05, 0x9f, 0x12, 0x56, 0x83, 0x12, 0xa0, 0x32,
+	0x43, 0xfb, 0x30, 0xac, 0x36, 0x35, 0xe4, 0xc3, 0xa0, 0x36, 0xdc, 0xcc, 0xff, 0x2d, 0xae, 0xb8,
+	0xc5, 0x25, 0x61, 0xd0, 0x35, 0x61, 0x00, 0x7c, 0x97, 0xb0, 0x55, 0x02, 0xdd, 0xa6, 0x9d, 0xa0,
+	0x5f, 0xd3, 0x6f, 0xc7, 0x8d, 0xc0, 0xf3, 0x09, 0xd8, 0x10, 0xa0, 0x68, 0xb5, 0x00, 0xd2, 0x50,
+	0x0d, 0xb9, 0x38, 0x57, 0x29, 0x15, 0x0c, 0x6b, 0xe2, 0x6b, 0xc9, 0x02, 0x6d, 0xba, 0x60, 0xb6,
+	0x98, 0x91, 0xcc, 0x82, 0x92, 0x27, 0xe0, 0xa3, 0x5d, 0xdb, 0xf1, 0x11, 0x1e, 0xc9, 0x18, 0x7b,
+	0xdc, 0x25, 0x41, 0x00, 0xae, 0x4d, 0x30, 0xe3, 0x93, 0xee, 0x3c, 0x19, 0xad, 0xdf, 0x8c, 0x4e,
+	0xf8, 0xba, 0x93, 0xbb, 0x89, 0xba, 0x93, 0xbb, 0xde, 0x0c, 0x1a, 0xfb, 0xf4, 0x6d, 0x94, 0x41,
+	0x73, 0x82, 0x61, 0x43, 0x3f, 0x71, 0x75, 0x1f, 0xac, 0xa6, 0xb9, 0x10, 0xfc, 0x22, 0x81, 0x35,
+	0x56, 0x02, 0x1d, 0x63, 0xdc, 0xec, 0x95, 0xc6, 0x84, 0x68, 0x2d, 0x1c, 0x52, 0xdb, 0x3f, 0xff,
+	0xfb, 0xbf, 0x7c, 0x2d, 0x31, 0x8c, 0x43, 0x99, 0x90, 0xbb, 0xd0, 0x2c, 0xf7, 0xff, 0x09, 0x81,
+	0xd5, 0xd6, 0x6d, 0x10, 0xa1, 0x6b, 0xa3, 0xd2, 0xb6, 0x1a, 0x54, 0xec, 0xf3, 0xdf, 0x21, 0xf4,
+	0xfb, 0xdf, 0x24, 0x38, 0x92, 0x89, 0xba, 0xdc, 0x9d, 0x59, 0xe6, 0xe3, 0x7d, 0x65, 0x76, 0x1f,
+	0xee, 0x0d, 0xa5, 0xb5, 0x8e, 0xa6, 0x32, 0xcb, 0xce, 0x5b, 0xca, 0x2b, 0x96, 0x88, 0xd9, 0xbd,
+	0x38, 0x11, 0xc6, 0x67, 0xcd, 0xa2, 0x99, 0x65, 0xc7, 0xd5, 0x1b, 0xc6, 0x85, 0x57, 0x09, 0xb4,
+	0xdb, 0x37, 0x1d, 0x51, 0xf8, 0x32, 0xa4, 0x34, 0x2a, 0x40, 0xc9, 0x40, 0xd8, 0x49, 0x31, 0xd8,
+	0x8a, 0xa9, 0x48, 0x08, 0xf4, 0x8c, 0x5c, 0x2c, 0xe2, 0xd5, 0x24, 0xb4, 0x55, 0xef, 0x47, 0x0b,
+	0x5e, 0x84, 0x93, 0x46, 0x6a, 0x13, 0x32, 0x5d, 0xae, 0x25, 0xa8, 0x32, 0xaf, 0x26, 0x70, 0x97,
+	0x30, 0xc8, 0xa6, 0x51, 0x26, 0x71, 0x5c, 0xd4, 0x80, 0x5c, 0x80, 0x3e, 0x7b, 0x3f, 0x1e, 0x8e,
+	0xcb, 0xe4, 0xfe, 0x6a, 0x84, 0x2b, 0x04, 0x9b, 0xd4, 0xe2, 0x9d, 0x3d, 0x8e, 0x47, 0x85, 0x3f,
+	0xec, 0x11, 0x54, 0x92, 0x17, 0x15, 0x5b, 0x10, 0x3e, 0x4b, 0xa0, 0xc3, 0x71, 0x55, 0x0c, 0x63,
+	0xdc, 0x27, 0x93, 0xc6, 0x84, 0x68, 0x99, 0x5d, 0x76, 0x51, 0xb3, 0x6c, 0xc7, 0xad, 0x35, 0xac,
+	0x62, 0x79, 0xc9, 0x97, 0x5b, 0xa0, 0xd5, 0xbe, 0x65, 0x2a, 0x76, 0xb7, 0x48, 0xda, 0x51, 0x93,
+	0x8e, 0xa9, 0xf2, 0x66, 0x92, 0xea, 0xf2, 0x5a, 0x32, 0xdc, 0x45, 0x82, 0xc0, 0x9f, 0x9d, 0xc0,
+	0x3d, 0x31, 0x41, 0xd7, 0x67, 0x0f, 0xe0, 0xbe, 0xd8, 0x86, 0xa2, 0x16, 0x8a, 0x65, 0xe2, 0x20,
+	0xdf, 0xb2, 0x55, 0x78, 0x08, 0x4f, 0x35, 0x42, 0x10, 0xd7, 0x2b, 0x4e, 0xf4, 0x72, 0xaa, 0x71,
+	0x08, 0xef, 0xa9, 0x83, 0x8f, 0x7d, 0x15, 0x9f, 0x21, 0x00, 0xd5, 0x3b, 0x41, 0x28, 0x7e, 0x6f,
+	0x48, 0xda, 0x29, 0x42, 0xca, 0x3c, 0x63, 0x8c, 0x3a, 0xc6, 0x36, 0xbc, 0x3b, 0xda, 0x2f, 0x2c,
+	0x1f, 0xfd, 0x3a, 0x81, 0x76, 0xfb, 0x3a, 0x07, 0x0a, 0x5f, 0xb2, 0x91, 0x46, 0x05, 0x28, 0x99,
+	0x3e, 0x93, 0x54, 0x9f, 0xdd, 0x38, 0x16, 0xa6, 0x8f, 0xca, 0x59, 0x32, 0xcb, 0x2c, 0x17, 0xb1,
+	0x82, 0xdf, 0x27, 0xd0, 0xed, 0xbe, 0x6b, 0x82, 0xf1, 0xee, 0xa4, 0x48, 0x69, 0x51, 0x72, 0xa6,
+	0xe6, 0x01, 0xaa, 0x66, 0xc4, 0xf0, 0xa0, 0x87, 0x1d, 0x41, 0xba, 0xbe, 0x63, 0xde, 0xed, 0xf5,
+	0xdf, 0x9e, 0x88, 0x7f, 0xf1, 0x40, 0x9a, 0x88, 0xc3, 0xc2, 0xf4, 0x3e, 0x44, 0xf5, 0x8e, 0x72,
+	0x68, 0x93, 0x57, 0x2f, 0x2b, 0xb9, 0xcc, 0xb2, 0x37, 0xbf, 0xb3, 0x82, 0x6f, 0x11, 0x18, 0xf0,
+	0x0b, 0xa7, 0xee, 0x59, 0x5f, 0x85, 0xbb, 0xb4, 0x2f, 0x2e, 0x1b, 0xeb, 0x47, 0x9a, 0xf6, 0x63,
+	0x04, 0xb7, 0xd7, 0xec, 0x87, 0xe5, 0xb9, 0x66, 0xea, 0x3f, 0xb0, 0x86, 0x04, 0xeb, 0xaa, 0x9c,
+	0x96, 0xa6, 0x62, 0x72, 0x31, 0xb5, 0xef, 0xa7, 0x6a, 0x1f, 0xc4, 0xfd, 0x61, 0x6a, 0xf3, 0x82,
+	0x96, 0x30, 0x0b, 0x98, 0x77, 0x4c, 0x42, 0x4b, 0x6b, 0xb1, 0xee, 0x6a, 0x5c, 0xe9, 0x60, 0x1d,
+	0x9c, 0xac, 0x4f, 0xe3, 0xb4, 0x4f, 0x63, 0x38, 0x2a, 0xd2, 0x27, 0xcb, 0x1a, 0xcf, 0x25, 0x60,
+	0x57, 0x9c, 0x6a, 0x4d, 0x6c, 0x64, 0xcd, 0xa7, 0x74, 0xba, 0x31, 0xc2, 0x58, 0xf7, 0x4f, 0xd1,
+	0xee, 0x1f, 0xc5, 0x23, 0x75, 0x9a, 0x94, 0x07, 0x58, 0x5a, 0x71, 0x74, 0x35, 0x01, 0x7d, 0x01,
+	0x5a, 0x60, 0x1d, 0x65, 0x95, 0xd2, 0x64, 0x2c, 0x1e, 0xd6, 0x9b, 0x2f, 0x59, 0x8b, 0xfb, 0xa7,
+	0x08, 0x4e, 0xd5, 0x98, 0x10, 0x82, 0x7b, 0x33, 0x7b, 0x0a, 0x4f, 0xde, 0x3c, 0x10, 0x7c, 0x0a,
+	0x7c, 0x97, 0xc0, 0xfa, 0x90, 0xb2, 0x3e, 0xac, 0xb3, 0x0e, 0x50, 0xda, 0x1f, 0x9b, 0x8f, 0x41,
+	0x93, 0xa1, 0xc8, 0x8c, 0xe2, 0x8e, 0xda, 0xc0, 0xb0, 0x15, 0x1d, 0x81, 0x76, 0xbb, 0xea, 0x2f,
+	0x7c, 0xb6, 0xf4, 0xd6, 0x10, 0x4a, 0xa3, 0x02, 0x94, 0xa2, 0x4b, 0x4c, 0x73, 0xda, 0xb1, 0x26,
+	0x1f, 0x7d, 0x05, 0x5f, 0x26, 0xd0, 0xe3, 0x29, 0xf3, 0xc2, 0x98, 0xf5, 0x60, 0x52, 0x46, 0x98,
+	0x5e, 0x34, 0x52, 0xb3, 0x1d, 0x39, 0xdf, 0xb5, 0x7e, 0xc5, 0x5c, 0x63, 0x70, 0x59, 0x28, 0x5c,
+	0x81, 0x25, 0x8d, 0x0a, 0x50, 0x8a, 0x5a, 0x92, 0xab, 0xb4, 0x4c, 0x27, 0xf0, 0x15, 0x7c, 0xd5,
+	0x09, 0x9c, 0x55, 0xa6, 0x84, 0x31, 0xeb, 0x99, 0xa4, 0x8c, 0x30, 0xbd, 0x68, 0x5c, 0xe5, 0x5a,
+	0x56, 0xb4, 0x42, 0x66, 0xb9, 0xa2, 0x15, 0x56, 0xf0, 0x47, 0xce, 0xe2, 0x38, 0x5e, 0xef, 0x83,
+	0xb1, 0x4b, 0x83, 0xa4, 0xf1, 0x18, 0x1c, 0xa2, 0x0b, 0x22, 0xae, 0xad, 0x77, 0x01, 0x8e, 0xdf,
+	0x26, 0xd0, 0xe5, 0x2a, 0xb3, 0xc1, 0x58, 0xd5, 0x38, 0xd2, 0x6e, 0x41, 0x6a, 0xd1, 0x21, 0xc3,
+	0x14, 0xb5, 0xc6, 0xf0, 0x1b, 0x1e, 0x44, 0xe9, 0x4a, 0x10, 0x63, 0xa7, 0x8a, 0xa4, 0xf1, 0x18,
+	0x1c, 0x4c, 0xd1, 0x7d, 0x54, 0xd1, 0x3d, 0x98, 0xae, 0xa9, 0x28, 0xf5, 0x52, 0xdb, 0x59, 0x5f,
+	0x21, 0xd0, 0xe1, 0x28, 0xfc, 0x09, 0xdf, 0xdf, 0xfa, 0x2b, 0x8e, 0xa4, 0x31, 0x21, 0x5a, 0xa6,
+	0xe0, 0xbd, 0x54, 0xc1, 0x29, 0x9c, 0x0c, 0x0d, 0x3e, 0x16, 0x13, 0x7d, 0x5c, 0x76, 0x55, 0x32,
+	0xad, 0xe0, 0xcf, 0x78, 0x09, 0x8f, 0xbb, 0x72, 0x08, 0xf7, 0x47, 0x66, 0xc2, 0xc2, 0xcb, 0x93,
+	0xa4, 0x03, 0xf1, 0x19, 0x45, 0xb7, 0x1c, 0x25, 0xc5, 0x90, 0x4d, 0x3e, 0xab, 0x80, 0x29, 0xb3,
+	0x6c, 0x7a, 0xed, 0x2b, 0xfc, 0xa7, 0xd5, 0x58, 0x69, 0x0d, 0xc6, 0x29, 0xc0, 0x91, 0x76, 0x89,
+	0x11, 0x8b, 0x8e, 0x2d, 0xdf, 0xa6, 0x76, 0x81, 0x29, 0xf5, 0x3d, 0x02, 0x5d, 0xae, 0xa2, 0x14,
+	0x8c, 0x55, 0xbb, 0x22, 0xed, 0x16, 0xa4, 0x66, 0x8a, 0x1e, 0xa4, 0x8a, 0xc6, 0xc9, 0x2b, 0xe5,
+	0xb8, 0x5e, 0xaf, 0x9b, 0x3f, 0xf7, 0xe4, 0xaa, 0x0c, 0xc1, 0x78, 0x15, 0x24, 0x52, 0x5a, 0x94,
+	0x9c, 0x29, 0x7b, 0x0f, 0x55, 0x36, 0x22, 0xc3, 0xe8, 0x53, 0x56, 0xb6, 0x55, 0xfb, 0x15, 0xaf,
+	0x24, 0xf2, 0x96, 0x60, 0x60, 0x5d, 0x15, 0x1b, 0xd2, 0x54, 0x4c, 0x2e, 0xd6, 0x85, 0x23, 0xb4,
+	0x0b, 0x87, 0xf1, 0xde, 0x7a, 0x76, 0x73, 0xec, 0x25, 0xfe, 0xd4, 0xfe, 0x45, 0x3a, 0x47, 0xc1,
+	0x01, 0xc6, 0xae, 0x4d, 0x90, 0xc6, 0x63, 0x70, 0x30, 0xfd, 0x67, 0xa8, 0xfe, 0x11, 0x69, 0x92,
+	0xb2, 0xc9, 0x52, 0xdd, 0x3c, 0x67, 0x34, 0xb5, 0xa8, 0x64, 0x96, 0xcd, 0x7f, 0x6d, 0xf5, 0xdf,
+	0xe6, 0xfb, 0x69, 0xd7, 0x51, 0x3c, 0xc6, 0x3f, 0xb6, 0x97, 0x26, 0xe2, 0xb0, 0x88, 0xc6, 0x40,
+	0xf3, 0x7f, 0x99, 0xf2, 0x38, 0xba, 0x51, 0x55, 0xbd, 0x2f, 0xe0, 0x84, 0x1a, 0xeb, 0x38, 0xce,
+	0x96, 0x26, 0x63, 0xf1, 0x88, 0x06, 0x16, 0x96, 0x91, 0x52, 0x29, 0xab, 0x59, 0x56, 0x90, 0x59,
+	0x36, 0xff, 0x5d, 0xc1, 0x6f, 0xf0, 0x14, 0xbb, 0x79, 0xae, 0x88, 0xc2, 0x47, 0xbb, 0xd2, 0xa8,
+	0x00, 0x25, 0x53, 0x6e, 0x8a, 0x2a, 0x97, 0xc1, 0xdd, 0xc2, 0xe3, 0x93, 0x1e, 0x4a, 0xff, 0x92,
+	0xe7, 0x28, 0x7c, 0x07, 0x9f, 0x58, 0xdf, 0x41, 0x69, 0x8d, 0x1c, 0x45, 0xe8, 0x39, 0x6f, 0xea,
+	0x30, 0xed, 0xc0, 0xfe, 0xf0, 0x9d, 0x54, 0xf5, 0x9c, 0x3a, 0xb3, 0x5c, 0xfd, 0xdb, 0xea, 0x87,
+	0x8e, 0x6f, 0xf1, 0x71, 0xe9, 0x3c, 0xf5, 0xc3, 0xd8, 0x07, 0x84, 0xd2, 0x78, 0x0c, 0x0e, 0x51,
+	0xcd, 0x7d, 0xd0, 0xd3, 0xa9, 0xd1, 0xd2, 0x5f, 0x9f, 0xb9, 0xfc, 0xfe, 0xf5, 0x21, 0xf2, 0xc1,
+	0xf5, 0x21, 0xf2, 0xe7, 0xeb, 0x43, 0xe4, 0x99, 0x1b, 0x43, 0xab, 0x3e, 0xb8, 0x31, 0xb4, 0xea,
+	0x8f, 0x37, 0x86, 0x56, 0xc1, 0x86, 0x82, 0x1a, 0xa2, 0xcd, 0x39, 0x32, 0xbb, 0x77, 0xbe, 0x60,
+	0x2c, 0x54, 0x2e, 0xa6, 0x73, 0xea, 0xa2, 0xe3, 0xbb, 0xbb, 0x0b, 0xaa, 0x53, 0x8b, 0x27, 0xab,
+	0x7a, 0x18, 0x4b, 0x65, 0x45, 0xbf, 0xb8, 0x86, 0xfe, 0xda, 0xef, 0xe4, 0xbf, 0x07, 0x00, 0xe3,
+	0x71, 0x86, 0xe3, 0x2c, 0x59, 0x00, 0x00,
 }
 
-func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/metadata module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
+	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
+	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	//
+	// By default, the contract and record specifications are not included.
+	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
+	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
+	// GetByAddr retrieves metadata given any address(es).
+	GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address, with the encryption key in effect at a given height.
+	// If no name is given, the owner's primary locator is returned.
+	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
+	// OSLocatorsByOwner returns all ObjectStoreLocator entries of an owner, with the encryption keys in effect at a given
+	// height.
+	OSLocatorsByOwner(ctx context.Context, in *OSLocatorsByOwnerRequest, opts ...grpc.CallOption) (*OSLocatorsByOwnerResponse, error)
+	// AccountData gets the account data associated with a metadata address.
+	// Currently, only scope ids are supported.
+	AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error)
+	// ScopeNetAssetValues returns net asset values for scope
+	ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error)
+	// ScopeHistory returns the change log of a scope, oldest entries first.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Use pagination.reverse to get the newest entries first.
+	ScopeHistory(ctx context.Context, in *ScopeHistoryRequest, opts ...grpc.CallOption) (*ScopeHistoryResponse, error)
+	// ScopeChildren returns the ids of the scopes that have the given scope as their parent.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeChildren(ctx context.Context, in *ScopeChildrenRequest, opts ...grpc.CallOption) (*ScopeChildrenResponse, error)
+	// ScopeAncestors returns the ids of a scope's parent, its parent's parent, and so on up to the root scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeAncestors(ctx context.Context, in *ScopeAncestorsRequest, opts ...grpc.CallOption) (*ScopeAncestorsResponse, error)
+	// ScopesBySpecification returns the ids of the scopes that use a scope specification.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	ScopesBySpecification(ctx context.Context, in *ScopesBySpecificationRequest, opts ...grpc.CallOption) (*ScopesBySpecificationResponse, error)
+	// ScopesByPartyRole returns the ids of the scopes that have the given address as a party with the given role.
+	//
+	// The results can optionally be limited to scopes that use a specific scope specification.
+	ScopesByPartyRole(ctx context.Context, in *ScopesByPartyRoleRequest, opts ...grpc.CallOption) (*ScopesByPartyRoleResponse, error)
+	// ScopesByDataAccess returns the ids of the scopes that have the given address in their data access list.
+	//
+	// The results can optionally be limited to scopes that use a specific scope specification.
+	ScopesByDataAccess(ctx context.Context, in *ScopesByDataAccessRequest, opts ...grpc.CallOption) (*ScopesByDataAccessResponse, error)
+	// RecordsByOutputHash returns the ids of the records (and their scopes) that have an output with the given hash.
+	//
+	// The hash must exactly match the hash in the record output.
+	RecordsByOutputHash(ctx context.Context, in *RecordsByOutputHashRequest, opts ...grpc.CallOption) (*RecordsByOutputHashResponse, error)
+	// ScopeLien returns the lien on a scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeLien(ctx context.Context, in *ScopeLienRequest, opts ...grpc.CallOption) (*ScopeLienResponse, error)
+	// ScopeLiensByLienholder returns the liens held by an address.
+	ScopeLiensByLienholder(ctx context.Context, in *ScopeLiensByLienholderRequest, opts ...grpc.CallOption) (*ScopeLiensByLienholderResponse, error)
+	// ScopeValueHolders returns the accounts that hold the value of a scope, and how many value units each one holds.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeValueHolders(ctx context.Context, in *ScopeValueHoldersRequest, opts ...grpc.CallOption) (*ScopeValueHoldersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return out, nil
+}
+
+func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
+	out := new(ScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
+	out := new(ScopesAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
+	out := new(SessionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
+	out := new(SessionsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
+	out := new(RecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
+	out := new(RecordsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
+	out := new(OwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
+	out := new(ValueOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
+	out := new(ScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
+	out := new(ScopeSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
+	out := new(ContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
+	out := new(ContractSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	out := new(RecordSpecificationsForContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
+	out := new(RecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
+	out := new(RecordSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetByAddr(ctx context.Context, in *GetByAddrRequest, opts ...grpc.CallOption) (*GetByAddrResponse, error) {
+	out := new(GetByAddrResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/GetByAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
+	out := new(OSLocatorParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	return out, nil
+}
+
+func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
+	out := new(OSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
+	out := new(OSLocatorsByURIResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
+	out := new(OSLocatorsByScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
+	out := new(OSAllLocatorsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) OSLocatorsByOwner(ctx context.Context, in *OSLocatorsByOwnerRequest, opts ...grpc.CallOption) (*OSLocatorsByOwnerResponse, error) {
+	out := new(OSLocatorsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	return out, nil
+}
+
+func (c *queryClient) AccountData(ctx context.Context, in *AccountDataRequest, opts ...grpc.CallOption) (*AccountDataResponse, error) {
+	out := new(AccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/AccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) ScopeNetAssetValues(ctx context.Context, in *QueryScopeNetAssetValuesRequest, opts ...grpc.CallOption) (*QueryScopeNetAssetValuesResponse, error) {
+	out := new(QueryScopeNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeNetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeHistory(ctx context.Context, in *ScopeHistoryRequest, opts ...grpc.CallOption) (*ScopeHistoryResponse, error) {
+	out := new(ScopeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeChildren(ctx context.Context, in *ScopeChildrenRequest, opts ...grpc.CallOption) (*ScopeChildrenResponse, error) {
+	out := new(ScopeChildrenResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) ScopeAncestors(ctx context.Context, in *ScopeAncestorsRequest, opts ...grpc.CallOption) (*ScopeAncestorsResponse, error) {
+	out := new(ScopeAncestorsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	return out, nil
+}
+
+func (c *queryClient) ScopesBySpecification(ctx context.Context, in *ScopesBySpecificationRequest, opts ...grpc.CallOption) (*ScopesBySpecificationResponse, error) {
+	out := new(ScopesBySpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesBySpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) ScopesByPartyRole(ctx context.Context, in *ScopesByPartyRoleRequest, opts ...grpc.CallOption) (*ScopesByPartyRoleResponse, error) {
+	out := new(ScopesByPartyRoleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesByPartyRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopesByDataAccess(ctx context.Context, in *ScopesByDataAccessRequest, opts ...grpc.CallOption) (*ScopesByDataAccessResponse, error) {
+	out := new(ScopesByDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesByDataAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordsByOutputHash(ctx context.Context, in *RecordsByOutputHashRequest, opts ...grpc.CallOption) (*RecordsByOutputHashResponse, error) {
+	out := new(RecordsByOutputHashResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsByOutputHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+func (c *queryClient) ScopeLien(ctx context.Context, in *ScopeLienRequest, opts ...grpc.CallOption) (*ScopeLienResponse, error) {
+	out := new(ScopeLienResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeLien", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	return out, nil
+}
+
+func (c *queryClient) ScopeLiensByLienholder(ctx context.Context, in *ScopeLiensByLienholderRequest, opts ...grpc.CallOption) (*ScopeLiensByLienholderResponse, error) {
+	out := new(ScopeLiensByLienholderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeLiensByLienholder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeContractSpecs {
-		i--
-		if m.IncludeContractSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopeValueHolders(ctx context.Context, in *ScopeValueHoldersRequest, opts ...grpc.CallOption) (*ScopeValueHoldersResponse, error) {
+	out := new(ScopeValueHoldersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeValueHolders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/metadata module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
+	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
+	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	//
+	// By default, the contract and record specifications are not included.
+	// Set include_contract_specs and/or include_record_specs to true to include contract and/or record specifications.
+	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
+	// GetByAddr retrieves metadata given any address(es).
+	GetByAddr(context.Context, *GetByAddrRequest) (*GetByAddrResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address, with the encryption key in effect at a given height.
+	// If no name is given, the owner's primary locator is returned.
+	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
+	// OSLocatorsByOwner returns all ObjectStoreLocator entries of an owner, with the encryption keys in effect at a given
+	// height.
+	OSLocatorsByOwner(context.Context, *OSLocatorsByOwnerRequest) (*OSLocatorsByOwnerResponse, error)
+	// AccountData gets the account data associated with a metadata address.
+	// Currently, only scope ids are supported.
+	AccountData(context.Context, *AccountDataRequest) (*AccountDataResponse, error)
+	// ScopeNetAssetValues returns net asset values for scope
+	ScopeNetAssetValues(context.Context, *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error)
+	// ScopeHistory returns the change log of a scope, oldest entries first.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Use pagination.reverse to get the newest entries first.
+	ScopeHistory(context.Context, *ScopeHistoryRequest) (*ScopeHistoryResponse, error)
+	// ScopeChildren returns the ids of the scopes that have the given scope as their parent.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeChildren(context.Context, *ScopeChildrenRequest) (*ScopeChildrenResponse, error)
+	// ScopeAncestors returns the ids of a scope's parent, its parent's parent, and so on up to the root scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeAncestors(context.Context, *ScopeAncestorsRequest) (*ScopeAncestorsResponse, error)
+	// ScopesBySpecification returns the ids of the scopes that use a scope specification.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	ScopesBySpecification(context.Context, *ScopesBySpecificationRequest) (*ScopesBySpecificationResponse, error)
+	// ScopesByPartyRole returns the ids of the scopes that have the given address as a party with the given role.
+	//
+	// The results can optionally be limited to scopes that use a specific scope specification.
+	ScopesByPartyRole(context.Context, *ScopesByPartyRoleRequest) (*ScopesByPartyRoleResponse, error)
+	// ScopesByDataAccess returns the ids of the scopes that have the given address in their data access list.
+	//
+	// The results can optionally be limited to scopes that use a specific scope specification.
+	ScopesByDataAccess(context.Context, *ScopesByDataAccessRequest) (*ScopesByDataAccessResponse, error)
+	// RecordsByOutputHash returns the ids of the records (and their scopes) that have an output with the given hash.
+	//
+	// The hash must exactly match the hash in the record output.
+	RecordsByOutputHash(context.Context, *RecordsByOutputHashRequest) (*RecordsByOutputHashResponse, error)
+	// ScopeLien returns the lien on a scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeLien(context.Context, *ScopeLienRequest) (*ScopeLienResponse, error)
+	// ScopeLiensByLienholder returns the liens held by an address.
+	ScopeLiensByLienholder(context.Context, *ScopeLiensByLienholderRequest) (*ScopeLiensByLienholderResponse, error)
+	// ScopeValueHolders returns the accounts that hold the value of a scope, and how many value units each one holds.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeValueHolders(context.Context, *ScopeValueHoldersRequest) (*ScopeValueHoldersResponse, error)
 }
 
-func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.RecordSpecs) > 0 {
-		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.ContractSpecs) > 0 {
-		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ScopeSpecification != nil {
-		{
-			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
+}
+func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
+}
+func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
+}
+func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
+}
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+}
+func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+}
+func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) GetByAddr(ctx context.Context, req *GetByAddrRequest) (*GetByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByAddr not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+}
+func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+}
+func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByOwner(ctx context.Context, req *OSLocatorsByOwnerRequest) (*OSLocatorsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *AccountDataRequest) (*AccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
+}
+func (*UnimplementedQueryServer) ScopeNetAssetValues(ctx context.Context, req *QueryScopeNetAssetValuesRequest) (*QueryScopeNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeNetAssetValues not implemented")
+}
+func (*UnimplementedQueryServer) ScopeHistory(ctx context.Context, req *ScopeHistoryRequest) (*ScopeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeHistory not implemented")
+}
+func (*UnimplementedQueryServer) ScopeChildren(ctx context.Context, req *ScopeChildrenRequest) (*ScopeChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeChildren not implemented")
+}
+func (*UnimplementedQueryServer) ScopeAncestors(ctx context.Context, req *ScopeAncestorsRequest) (*ScopeAncestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeAncestors not implemented")
+}
+func (*UnimplementedQueryServer) ScopesBySpecification(ctx context.Context, req *ScopesBySpecificationRequest) (*ScopesBySpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesBySpecification not implemented")
+}
+func (*UnimplementedQueryServer) ScopesByPartyRole(ctx context.Context, req *ScopesByPartyRoleRequest) (*ScopesByPartyRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesByPartyRole not implemented")
+}
+func (*UnimplementedQueryServer) ScopesByDataAccess(ctx context.Context, req *ScopesByDataAccessRequest) (*ScopesByDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesByDataAccess not implemented")
+}
+func (*UnimplementedQueryServer) RecordsByOutputHash(ctx context.Context, req *RecordsByOutputHashRequest) (*RecordsByOutputHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByOutputHash not implemented")
+}
+func (*UnimplementedQueryServer) ScopeLien(ctx context.Context, req *ScopeLienRequest) (*ScopeLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeLien not implemented")
+}
+func (*UnimplementedQueryServer) ScopeLiensByLienholder(ctx context.Context, req *ScopeLiensByLienholderRequest) (*ScopeLiensByLienholderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeLiensByLienholder not implemented")
+}
+func (*UnimplementedQueryServer) ScopeValueHolders(ctx context.Context, req *ScopeValueHoldersRequest) (*ScopeValueHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeValueHolders not implemented")
 }
 
-func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Scope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Scope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesAll(ctx, in)
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Sessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Sessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
 	}
-	if len(m.ScopeSpecifications) > 0 {
-		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Records",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(QueryServer).Ownership(ctx, in)
 	}
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Ownership",
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueOwnershipRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).ValueOwnership(ctx, in)
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
 	}
-	if m.ContractSpecification != nil {
-		{
-			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecification(ctx, in)
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationsAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsForContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
 	}
-	if len(m.ContractSpecifications) > 0 {
-		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+func _Query_GetByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(QueryServer).GetByAddr(ctx, in)
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/GetByAddr",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetByAddr(ctx, req.(*GetByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
 	}
-	if len(m.ContractSpecificationUuid) > 0 {
-		i -= len(m.ContractSpecificationUuid)
-		copy(dAtA[i:], m.ContractSpecificationUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSAllLocatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OSAllLocators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+func _Query_OSLocatorsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByOwner(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByOwner",
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByOwner(ctx, req.(*OSLocatorsByOwnerRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/AccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountData(ctx, req.(*AccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ScopeNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScopeNetAssetValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeNetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeNetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeNetAssetValues(ctx, req.(*QueryScopeNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func _Query_ScopeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RecordSpecification != nil {
-		{
-			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeHistory(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeHistory(ctx, req.(*ScopeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeChildrenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeChildren(ctx, req.(*ScopeChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ScopeAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeAncestors(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeAncestors(ctx, req.(*ScopeAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopesBySpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesBySpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesBySpecification(ctx, in)
 	}
-	if m.IncludeRequest {
-		i--
-		if m.IncludeRequest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x90
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesBySpecification",
 	}
-	if m.ExcludeIdInfo {
-		i--
-		if m.ExcludeIdInfo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesBySpecification(ctx, req.(*ScopesBySpecificationRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopesByPartyRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesByPartyRoleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesByPartyRole(ctx, in)
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesByPartyRole",
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesByPartyRole(ctx, req.(*ScopesByPartyRoleRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopesByDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesByDataAccessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesByDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesByDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesByDataAccess(ctx, req.(*ScopesByDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RecordsByOutputHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsByOutputHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByOutputHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordsByOutputHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByOutputHash(ctx, req.(*RecordsByOutputHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addrs[iNdEx])
-			copy(dAtA[i:], m.Addrs[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addrs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_ScopeLien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeLien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeLien",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeLien(ctx, req.(*ScopeLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ScopeLiensByLienholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeLiensByLienholderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeLiensByLienholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeLiensByLienholder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeLiensByLienholder(ctx, req.(*ScopeLiensByLienholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ScopeValueHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeValueHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeValueHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeValueHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeValueHolders(ctx, req.(*ScopeValueHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NotFound) > 0 {
-		for iNdEx := len(m.NotFound) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NotFound[iNdEx])
-			copy(dAtA[i:], m.NotFound[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFound[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.RecordSpecs) > 0 {
-		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ContractSpecs) > 0 {
-		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ScopeSpecs) > 0 {
-		for iNdEx := len(m.ScopeSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Scope",
+			Handler:    _Query_Scope_Handler,
+		},
+		{
+			MethodName: "ScopesAll",
+			Handler:    _Query_ScopesAll_Handler,
+		},
+		{
+			MethodName: "Sessions",
+			Handler:    _Query_Sessions_Handler,
+		},
+		{
+			MethodName: "SessionsAll",
+			Handler:    _Query_SessionsAll_Handler,
+		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
+		{
+			MethodName: "RecordsAll",
+			Handler:    _Query_RecordsAll_Handler,
+		},
+		{
+			MethodName: "Ownership",
+			Handler:    _Query_Ownership_Handler,
+		},
+		{
+			MethodName: "ValueOwnership",
+			Handler:    _Query_ValueOwnership_Handler,
+		},
+		{
+			MethodName: "ScopeSpecification",
+			Handler:    _Query_ScopeSpecification_Handler,
+		},
+		{
+			MethodName: "ScopeSpecificationsAll",
+			Handler:    _Query_ScopeSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "ContractSpecification",
+			Handler:    _Query_ContractSpecification_Handler,
+		},
+		{
+			MethodName: "ContractSpecificationsAll",
+			Handler:    _Query_ContractSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsForContractSpecification",
+			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecification",
+			Handler:    _Query_RecordSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsAll",
+			Handler:    _Query_RecordSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "GetByAddr",
+			Handler:    _Query_GetByAddr_Handler,
+		},
+		{
+			MethodName: "OSLocatorParams",
+			Handler:    _Query_OSLocatorParams_Handler,
+		},
+		{
+			MethodName: "OSLocator",
+			Handler:    _Query_OSLocator_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByURI",
+			Handler:    _Query_OSLocatorsByURI_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByScope",
+			Handler:    _Query_OSLocatorsByScope_Handler,
+		},
+		{
+			MethodName: "OSAllLocators",
+			Handler:    _Query_OSAllLocators_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByOwner",
+			Handler:    _Query_OSLocatorsByOwner_Handler,
+		},
+		{
+			MethodName: "AccountData",
+			Handler:    _Query_AccountData_Handler,
+		},
+		{
+			MethodName: "ScopeNetAssetValues",
+			Handler:    _Query_ScopeNetAssetValues_Handler,
+		},
+		{
+			MethodName: "ScopeHistory",
+			Handler:    _Query_ScopeHistory_Handler,
+		},
+		{
+			MethodName: "ScopeChildren",
+			Handler:    _Query_ScopeChildren_Handler,
+		},
+		{
+			MethodName: "ScopeAncestors",
+			Handler:    _Query_ScopeAncestors_Handler,
+		},
+		{
+			MethodName: "ScopesBySpecification",
+			Handler:    _Query_ScopesBySpecification_Handler,
+		},
+		{
+			MethodName: "ScopesByPartyRole",
+			Handler:    _Query_ScopesByPartyRole_Handler,
+		},
+		{
+			MethodName: "ScopesByDataAccess",
+			Handler:    _Query_ScopesByDataAccess_Handler,
+		},
+		{
+			MethodName: "RecordsByOutputHash",
+			Handler:    _Query_RecordsByOutputHash_Handler,
+		},
+		{
+			MethodName: "ScopeLien",
+			Handler:    _Query_ScopeLien_Handler,
+		},
+		{
+			MethodName: "ScopeLiensByLienholder",
+			Handler:    _Query_ScopeLiensByLienholder_Handler,
+		},
+		{
+			MethodName: "ScopeValueHolders",
+			Handler:    _Query_ScopeValueHolders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/query.proto",
 }
 
-func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7430,12 +6944,12 @@ func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7455,7 +6969,7 @@ func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7465,12 +6979,12 @@ func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7502,7 +7016,7 @@ func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7512,12 +7026,12 @@ func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7534,32 +7048,76 @@ func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x90
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if m.ExcludeIdInfo {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7578,9 +7136,37 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.Locator != nil {
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
 		{
-			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7593,7 +7179,7 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7603,12 +7189,71 @@ func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7639,17 +7284,20 @@ func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x90
 	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+	if m.ExcludeIdInfo {
 		i--
-		dAtA[i] = 0xa
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7659,12 +7307,12 @@ func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7697,10 +7345,10 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7714,7 +7362,7 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7724,12 +7372,12 @@ func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7746,6 +7394,57 @@ func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x90
 	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeScope {
+		i--
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordName) > 0 {
+		i -= len(m.RecordName)
+		copy(dAtA[i:], m.RecordName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ScopeId) > 0 {
 		i -= len(m.ScopeId)
 		copy(dAtA[i:], m.ScopeId)
@@ -7756,7 +7455,7 @@ func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7766,12 +7465,12 @@ func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7790,10 +7489,10 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7801,15 +7500,41 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -7817,12 +7542,71 @@ func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7853,10 +7637,20 @@ func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x90
 	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7866,12 +7660,12 @@ func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7904,10 +7698,10 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7921,7 +7715,7 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7931,90 +7725,90 @@ func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MetadataAddr.Size()
-		i -= size
-		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *AccountDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if m.IncludeSessions {
 		i--
-		dAtA[i] = 0xa
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryScopeNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.IncludeScope {
+		i--
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryScopeNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryScopeNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8024,1204 +7818,8362 @@ func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryScopeNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryScopeNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		n += 3
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeSessions {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	return n
+func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopesAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopesAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	return n
+func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
 	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.IncludeRequest {
-		n += 3
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeSessions {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueOwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	return n
-}
-
-func (m *ValueOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	var l int
-	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.IncludeContractSpecs {
+		i--
+		if m.IncludeContractSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeContractSpecs {
-		n += 2
-	}
-	if m.IncludeRecordSpecs {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecification != nil {
-		l = m.ScopeSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.ContractSpecs) > 0 {
-		for _, e := range m.ContractSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
 	if len(m.RecordSpecs) > 0 {
-		for _, e := range m.RecordSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecs) > 0 {
+		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.ScopeSpecification != nil {
+		{
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecifications) > 0 {
-		for _, e := range m.ScopeSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRecordSpecs {
-		n += 2
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecification != nil {
-		l = m.ContractSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecifications) > 0 {
-		for _, e := range m.ContractSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	l = len(m.ContractSpecificationUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordSpecification != nil {
-		l = m.RecordSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if m.ContractSpecification != nil {
+		{
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExcludeIdInfo {
-		n += 2
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if len(m.ScopeSpecs) > 0 {
-		for _, e := range m.ScopeSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractSpecs) > 0 {
-		for _, e := range m.ContractSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.RecordSpecs) > 0 {
-		for _, e := range m.RecordSpecs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if len(m.NotFound) > 0 {
-		for _, s := range m.NotFound {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IncludeRequest {
-		n += 3
+func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.IncludeRequest {
-		n += 3
-	}
-	return n
-}
-
-func (m *OSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	var l int
-	_ = l
-	if m.Locator != nil {
-		l = m.Locator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRequest {
-		n += 3
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ContractSpecificationUuid) > 0 {
+		i -= len(m.ContractSpecificationUuid)
+		copy(dAtA[i:], m.ContractSpecificationUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRequest {
-		n += 3
+func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IncludeRequest {
-		n += 3
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.RecordSpecification != nil {
+		{
+			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MetadataAddr.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ExcludeIdInfo {
+		i--
+		if m.ExcludeIdInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addrs[iNdEx])
+			copy(dAtA[i:], m.Addrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NotFound) > 0 {
+		for iNdEx := len(m.NotFound) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotFound[iNdEx])
+			copy(dAtA[i:], m.NotFound[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFound[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RecordSpecs) > 0 {
+		for iNdEx := len(m.RecordSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ContractSpecs) > 0 {
+		for iNdEx := len(m.ContractSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ScopeSpecs) > 0 {
+		for iNdEx := len(m.ScopeSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.KeyHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Locator != nil {
+		{
+			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MetadataAddr.Size()
+		i -= size
+		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopeNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopeNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeAncestorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeAncestorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeAncestorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeAncestorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeAncestorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeAncestorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesBySpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesBySpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesBySpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesBySpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesBySpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesBySpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesByPartyRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesByPartyRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesByPartyRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesByPartyRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesByPartyRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesByPartyRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesByDataAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesByDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesByDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesByDataAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesByDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesByDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByOutputHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByOutputHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByOutputHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsByOutputHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsByOutputHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsByOutputHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputHashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputHashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputHashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLienRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLienRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLienRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLienResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLienResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLienResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.InEffect {
+		i--
+		if m.InEffect {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lien != nil {
+		{
+			size, err := m.Lien.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLiensByLienholderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLiensByLienholderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLiensByLienholderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Lienholder) > 0 {
+		i -= len(m.Lienholder)
+		copy(dAtA[i:], m.Lienholder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lienholder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLiensByLienholderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLiensByLienholderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLiensByLienholderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Liens) > 0 {
+		for iNdEx := len(m.Liens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeValueHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeValueHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeValueHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeValueHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeValueHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeValueHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalUnits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalUnits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		i--
+		if m.IncludeRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.KeyHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *SessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeContractSpecs {
+		n += 2
+	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ContractSpecs) > 0 {
+		for _, e := range m.ContractSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecordSpecs) > 0 {
+		for _, e := range m.RecordSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecification != nil {
+		l = m.ContractSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecificationUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *RecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecification != nil {
+		l = m.RecordSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExcludeIdInfo {
+		n += 2
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecs) > 0 {
+		for _, e := range m.ScopeSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContractSpecs) > 0 {
+		for _, e := range m.ContractSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecordSpecs) > 0 {
+		for _, e := range m.RecordSpecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NotFound) > 0 {
+		for _, s := range m.NotFound {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OSLocatorParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyHeight != 0 {
+		n += 1 + sovQuery(uint64(m.KeyHeight))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locator != nil {
+		l = m.Locator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataAddr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeAncestorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeAncestorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesBySpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesBySpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesByPartyRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesByPartyRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesByDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesByDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsByOutputHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsByOutputHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputHashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeLienRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *ScopeLienResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lien != nil {
+		l = m.Lien.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InEffect {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeLiensByLienholderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lienholder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeLiensByLienholderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liens) > 0 {
+		for _, e := range m.Liens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeValueHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeValueHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalUnits != 0 {
+		n += 1 + sovQuery(uint64(m.TotalUnits))
+	}
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyHeight != 0 {
+		n += 1 + sovQuery(uint64(m.KeyHeight))
+	}
+	if m.IncludeRequest {
+		n += 3
+	}
+	return n
+}
+
+func (m *OSLocatorsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &QueryParamsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopesAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RecordsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Record{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			}
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RecordsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryScopeNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryScopeNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &OwnershipRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9244,12 +16196,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -9269,7 +16253,43 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9291,7 +16311,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9314,15 +16334,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9349,13 +16401,16 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &ValueOwnershipRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9382,10 +16437,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &QueryParamsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9410,7 +16465,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9433,15 +16488,15 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9469,13 +16524,13 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeContractSpecs", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9485,29 +16540,57 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.IncludeContractSpecs = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IncludeRecordSpecs = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9517,29 +16600,67 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.IncludeRequest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			var v int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9549,17 +16670,33 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeSessions = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecification == nil {
+				m.ScopeSpecification = &ScopeSpecificationWrapper{}
+			}
+			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9569,17 +16706,31 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRecords = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecificationWrapper{})
+			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9589,17 +16740,31 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9609,12 +16774,28 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeSpecificationRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9636,7 +16817,7 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9659,15 +16840,15 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9694,16 +16875,16 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
+			if m.Specification == nil {
+				m.Specification = &ScopeSpecification{}
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9730,16 +16911,68 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9749,29 +16982,35 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 98:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9798,10 +17037,10 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ScopeRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9826,7 +17065,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9849,15 +17088,15 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9884,16 +17123,14 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &Scope{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
+			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9920,16 +17157,16 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if m.Request == nil {
+				m.Request = &ScopeSpecificationsAllRequest{}
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9956,10 +17193,10 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9984,7 +17221,7 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10007,15 +17244,47 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10032,10 +17301,10 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
+			m.IncludeRecordSpecs = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10052,12 +17321,12 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10067,28 +17336,12 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10110,7 +17363,7 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10133,15 +17386,15 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10168,14 +17421,16 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &ScopeWrapper{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContractSpecification == nil {
+				m.ContractSpecification = &ContractSpecificationWrapper{}
+			}
+			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10202,16 +17457,14 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ScopesAllRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10238,10 +17491,10 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &ContractSpecificationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10266,7 +17519,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10289,17 +17542,17 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10309,29 +17562,33 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if m.Specification == nil {
+				m.Specification = &ContractSpecification{}
+			}
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10341,91 +17598,81 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10442,10 +17689,10 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10462,12 +17709,12 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRecords = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10477,32 +17724,28 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IncludeRequest = bool(v != 0)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10524,7 +17767,7 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10547,15 +17790,15 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10582,16 +17825,14 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
+			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10618,14 +17859,16 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &ContractSpecificationsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10652,16 +17895,68 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10671,28 +17966,64 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SessionsRequest{}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10714,7 +18045,7 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10737,15 +18068,15 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10772,18 +18103,16 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &Session{}
-			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10793,31 +18122,59 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10844,10 +18201,10 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			if m.Request == nil {
+				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
 			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10872,7 +18229,7 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10895,17 +18252,49 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10915,15 +18304,27 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10940,12 +18341,12 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10955,28 +18356,12 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10998,7 +18383,7 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11021,15 +18406,15 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11056,8 +18441,10 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RecordSpecification == nil {
+				m.RecordSpecification = &RecordSpecificationWrapper{}
+			}
+			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11091,48 +18478,12 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &SessionsAllRequest{}
+				m.Request = &RecordSpecificationRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11154,7 +18505,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11177,17 +18528,17 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11197,29 +18548,33 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			if m.Specification == nil {
+				m.Specification = &RecordSpecification{}
+			}
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11229,111 +18584,81 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11350,10 +18675,10 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeSessions = bool(v != 0)
-		case 12:
+			m.ExcludeIdInfo = bool(v != 0)
+		case 98:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11370,12 +18695,12 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11385,12 +18710,28 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11412,7 +18753,7 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11435,15 +18776,15 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11470,16 +18811,14 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11506,14 +18845,16 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &RecordSpecificationsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11540,16 +18881,68 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11559,27 +18952,23 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11602,7 +18991,7 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11625,15 +19014,117 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &Scope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11660,16 +19151,14 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeSpecs = append(m.ScopeSpecs, &ScopeSpecification{})
+			if err := m.ScopeSpecs[len(m.ScopeSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11696,16 +19185,14 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
-			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecification{})
+			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11732,13 +19219,43 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
-			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecification{})
+			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotFound = append(m.NotFound, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11760,7 +19277,7 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11783,32 +19300,12 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -11829,42 +19326,6 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11886,7 +19347,7 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11909,15 +19370,15 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11944,8 +19405,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11979,48 +19439,12 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordsAllRequest{}
+				m.Request = &OSLocatorParamsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12042,7 +19466,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12065,15 +19489,15 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12101,13 +19525,13 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12117,17 +19541,29 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHeight", wireType)
+			}
+			m.KeyHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12137,28 +19573,31 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.KeyHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12180,7 +19619,7 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12203,47 +19642,15 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12270,16 +19677,16 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OwnershipRequest{}
+			if m.Locator == nil {
+				m.Locator = &ObjectStoreLocator{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12306,10 +19713,10 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &OSLocatorRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12334,7 +19741,7 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12357,15 +19764,15 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12393,7 +19800,7 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
 			if wireType != 0 {
@@ -12472,7 +19879,7 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12495,17 +19902,17 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12515,23 +19922,25 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -12563,7 +19972,7 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ValueOwnershipRequest{}
+				m.Request = &OSLocatorsByURIRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12626,7 +20035,7 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12649,15 +20058,15 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12685,68 +20094,8 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeContractSpecs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeContractSpecs = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRecordSpecs = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -12776,120 +20125,50 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScopeSpecification == nil {
-				m.ScopeSpecification = &ScopeSpecificationWrapper{}
-			}
-			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecificationWrapper{})
-			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12916,8 +20195,8 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12951,7 +20230,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeSpecificationRequest{}
+				m.Request = &OSLocatorsByScopeRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12978,7 +20257,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13001,17 +20280,17 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13021,31 +20300,15 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Specification == nil {
-				m.Specification = &ScopeSpecification{}
-			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13072,10 +20335,10 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13100,7 +20363,7 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13123,17 +20386,17 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13143,17 +20406,31 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13163,12 +20440,28 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &OSAllLocatorsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -13199,7 +20492,7 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13226,7 +20519,7 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13249,17 +20542,17 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13269,67 +20562,80 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
-			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ScopeSpecificationsAllRequest{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 99:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13339,27 +20645,23 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13382,7 +20684,7 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13405,15 +20707,15 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13441,53 +20743,63 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.IncludeRecordSpecs = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13497,12 +20809,26 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -13524,7 +20850,7 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13547,17 +20873,17 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13567,33 +20893,29 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecification == nil {
-				m.ContractSpecification = &ContractSpecificationWrapper{}
-			}
-			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13603,29 +20925,15 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13652,10 +20960,10 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ContractSpecificationRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13680,7 +20988,7 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *ScopeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13703,15 +21011,15 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13738,16 +21046,50 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &ContractSpecification{}
+			m.Changes = append(m.Changes, ScopeChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeHistoryRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13774,10 +21116,10 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13802,7 +21144,7 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeChildrenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13825,17 +21167,17 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeChildrenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13845,12 +21187,24 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -13928,7 +21282,7 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeChildrenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13951,17 +21305,17 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeChildrenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13971,25 +21325,23 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
-			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -14021,7 +21373,7 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ContractSpecificationsAllRequest{}
+				m.Request = &ScopeChildrenRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14084,7 +21436,7 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeAncestorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14107,15 +21459,15 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeAncestorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeAncestorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14143,28 +21495,8 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -14206,7 +21538,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeAncestorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14229,81 +21561,15 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeAncestorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeAncestorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14331,7 +21597,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -14363,7 +21629,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
+				m.Request = &ScopeAncestorsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14390,7 +21656,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopesBySpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14413,10 +21679,10 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesBySpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesBySpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14451,133 +21717,11 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExcludeIdInfo = bool(v != 0)
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRequest = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14587,31 +21731,15 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordSpecification == nil {
-				m.RecordSpecification = &RecordSpecificationWrapper{}
-			}
-			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14638,10 +21766,10 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordSpecificationRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14666,7 +21794,7 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *ScopesBySpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14689,15 +21817,47 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesBySpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesBySpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14724,16 +21884,16 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &RecordSpecification{}
+			if m.Request == nil {
+				m.Request = &ScopesBySpecificationRequest{}
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14760,10 +21920,10 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14788,7 +21948,7 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopesByPartyRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14811,17 +21971,49 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesByPartyRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesByPartyRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 12:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var v int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14831,12 +22023,43 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Role |= PartyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeIdInfo = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -14914,7 +22137,7 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopesByPartyRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14937,17 +22160,17 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesByPartyRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesByPartyRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14957,25 +22180,23 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -15007,7 +22228,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordSpecificationsAllRequest{}
+				m.Request = &ScopesByPartyRoleRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -15070,7 +22291,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopesByDataAccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15093,17 +22314,101 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesByDataAccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesByDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRequest = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15113,23 +22418,27 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15152,7 +22461,7 @@ func (m *GetByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopesByDataAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15175,17 +22484,17 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopesByDataAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopesByDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15195,29 +22504,27 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &Scope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15244,14 +22551,16 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &Session{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &ScopesByDataAccessRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15278,50 +22587,68 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsByOutputHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecs = append(m.ScopeSpecs, &ScopeSpecification{})
-			if err := m.ScopeSpecs[len(m.ScopeSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsByOutputHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsByOutputHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15331,31 +22658,29 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecs = append(m.ContractSpecs, &ContractSpecification{})
-			if err := m.ContractSpecs[len(m.ContractSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecs", wireType)
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15365,31 +22690,17 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecs = append(m.RecordSpecs, &RecordSpecification{})
-			if err := m.RecordSpecs[len(m.RecordSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.IncludeRequest = bool(v != 0)
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15399,23 +22710,27 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotFound = append(m.NotFound, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15438,7 +22753,7 @@ func (m *GetByAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsByOutputHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15461,17 +22776,17 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsByOutputHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsByOutputHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15481,65 +22796,29 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Records = append(m.Records, OutputHashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15566,13 +22845,16 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &RecordsByOutputHashRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15599,10 +22881,10 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorParamsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15627,7 +22909,7 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *OutputHashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15650,15 +22932,15 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutputHashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutputHashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15686,13 +22968,13 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15702,12 +22984,24 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15729,7 +23023,7 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeLienRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15752,17 +23046,17 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeLienRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeLienRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15772,33 +23066,29 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locator == nil {
-				m.Locator = &ObjectStoreLocator{}
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15808,28 +23098,12 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &OSLocatorRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15851,7 +23125,7 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeLienResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15874,17 +23148,17 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeLienResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeLienResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lien", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15894,27 +23168,31 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			if m.Lien == nil {
+				m.Lien = &ScopeLien{}
+			}
+			if err := m.Lien.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 98:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InEffect", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15931,10 +23209,10 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeRequest = bool(v != 0)
-		case 99:
+			m.InEffect = bool(v != 0)
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15961,10 +23239,10 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Request == nil {
+				m.Request = &ScopeLienRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15989,7 +23267,7 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeLiensByLienholderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16012,17 +23290,17 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeLiensByLienholderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeLiensByLienholderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lienholder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16032,31 +23310,29 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Lienholder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16066,28 +23342,12 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &OSLocatorsByURIRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -16118,7 +23378,7 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -16145,7 +23405,7 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeLiensByLienholderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16168,17 +23428,17 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeLiensByLienholderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeLiensByLienholderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16188,97 +23448,29 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 98:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRequest = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Liens = append(m.Liens, ScopeLien{})
+			if err := m.Liens[len(m.Liens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16305,14 +23497,16 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &ScopeLiensByLienholderRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16339,10 +23533,10 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OSLocatorsByScopeRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16367,7 +23561,7 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeValueHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16390,12 +23584,44 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeValueHoldersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeValueHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 98:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
@@ -16473,7 +23699,7 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeValueHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16496,15 +23722,34 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeValueHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeValueHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnits", wireType)
+			}
+			m.TotalUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16531,8 +23776,8 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holders = append(m.Holders, ScopeValueHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16566,7 +23811,7 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &OSAllLocatorsRequest{}
+				m.Request = &ScopeValueHoldersRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -16629,7 +23874,7 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16652,17 +23897,17 @@ func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16672,80 +23917,29 @@ func (m *AccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetadataAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccountDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHeight", wireType)
 			}
-			var stringLen uint64
+			m.KeyHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16755,24 +23949,31 @@ func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeyHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 98:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRequest", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IncludeRequest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -16794,7 +23995,7 @@ func (m *AccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16817,17 +24018,17 @@ func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16837,77 +24038,29 @@ func (m *QueryScopeNetAssetValuesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScopeNetAssetValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16934,8 +24087,10 @@ func (m *QueryScopeNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &OSLocatorsByOwnerRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -13,7 +14,9 @@ import (
 const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
-	UsdDenom              = "usd"
+	// Default max length for a RecordOutput.Payload
+	maxRecordOutputPayloadLength = 10000
+	UsdDenom                     = "usd"
 )
 
 // NewScope creates a new instance.
@@ -41,7 +44,8 @@ func (s Scope) Equals(t Scope) bool {
 		EqualParties(s.Owners, t.Owners) &&
 		equivalentDataAssessors(s.DataAccess, t.DataAccess) &&
 		s.ValueOwnerAddress == t.ValueOwnerAddress &&
-		s.RequirePartyRollup == t.RequirePartyRollup
+		s.RequirePartyRollup == t.RequirePartyRollup &&
+		s.GetParentScopeID().Equals(t.GetParentScopeID())
 }
 
 // ValidateBasic performs basic format checking of data within a scope
@@ -68,9 +72,25 @@ func (s Scope) ValidateBasic() error {
 			return fmt.Errorf("invalid value owner address on scope: %w", err)
 		}
 	}
+	if parentID := s.GetParentScopeID(); !parentID.Empty() {
+		if err = parentID.ValidateIsScopeAddress(); err != nil {
+			return fmt.Errorf("invalid parent scope id on scope: %w", err)
+		}
+		if parentID.Equals(s.ScopeId) {
+			return errors.New("invalid parent scope id on scope: a scope cannot be its own parent")
+		}
+	}
 	return nil
 }
 
+// GetParentScopeID returns the id of this scope's parent scope, or nil if it doesn't have one.
+func (s Scope) GetParentScopeID() MetadataAddress {
+	if s.ParentScopeId == nil {
+		return nil
+	}
+	return *s.ParentScopeId
+}
+
 func (s Scope) ValidateOwnersBasic() error {
 	if err := ValidatePartiesBasic(s.Owners); err != nil {
 		return fmt.Errorf("invalid scope owners: %w", err)
@@ -376,6 +396,15 @@ func (ro RecordOutput) ValidateBasic() error {
 	if len(ro.Hash) < 1 {
 		return fmt.Errorf("missing required hash")
 	}
+	if len(ro.Payload) > 0 {
+		if len(ro.Payload) > maxRecordOutputPayloadLength {
+			return fmt.Errorf("record output payload exceeds maximum length (expected <= %d got: %d)",
+				maxRecordOutputPayloadLength, len(ro.Payload))
+		}
+		if !json.Valid([]byte(ro.Payload)) {
+			return errors.New("record output payload is not valid JSON")
+		}
+	}
 	return nil
 }
 
@@ -128,6 +128,12 @@ type Scope struct {
 	// Whether all parties in this scope and its sessions must be present in this scope's owners field.
 	// This also enables use of optional=true scope owners and session parties.
 	RequirePartyRollup bool `protobuf:"varint,6,opt,name=require_party_rollup,json=requirePartyRollup,proto3" json:"require_party_rollup,omitempty"`
+	// The id of this scope's parent scope, if it has one.
+	//
+	// A scope cannot be its own ancestor, and a scope with children cannot be deleted.
+	// When a scope is assigned to a parent (either on creation or by changing this field), the owners of the
+	// parent scope must also be signers.
+	ParentScopeId *MetadataAddress `protobuf:"bytes,7,opt,name=parent_scope_id,json=parentScopeId,proto3,customtype=MetadataAddress" json:"parent_scope_id,omitempty"`
 }
 
 func (m *Scope) Reset()      { *m = Scope{} }
@@ -562,6 +568,12 @@ type RecordOutput struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	// Status of the process execution associated with this output indicating success,failure, or pending
 	Status ResultStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.metadata.v1.ResultStatus" json:"status,omitempty"`
+	// Hash of the schema that this output conforms to. Required on passing outputs when the record specification
+	// has an output schema, in which case it must equal that schema's hash.
+	SchemaHash string `protobuf:"bytes,3,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// The output data itself as a JSON document. Required on passing outputs when the record specification's output
+	// schema includes a JSON schema document, in which case the payload must conform to it.
+	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
@@ -610,6 +622,20 @@ func (m *RecordOutput) GetStatus() ResultStatus {
 	return ResultStatus_RESULT_STATUS_UNSPECIFIED
 }
 
+func (m *RecordOutput) GetSchemaHash() string {
+	if m != nil {
+		return m.SchemaHash
+	}
+	return ""
+}
+
+func (m *RecordOutput) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
 // A Party is an address with/in a given role associated with a contract
 type Party struct {
 	// address of the account (on chain)
@@ -830,6 +856,254 @@ func (m *NetAssetValue) GetVolume() uint64 {
 	return 0
 }
 
+// ScopeChange is an entry in a scope's change log. One is recorded for each message that changes a scope or
+// something in it (sessions, records, account data or net asset values).
+type ScopeChange struct {
+	// scope_id is the id of the scope that was changed.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// sequence is the position of this entry in the scope's change log, starting at 1.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// msg_type_url is the type url of the message that made the change.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// signers are the bech32 addresses of the message's signers.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// block_height is the height of the block that the change was made in.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// changes is a summary of what changed, one entry per change.
+	Changes []string `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *ScopeChange) Reset()         { *m = ScopeChange{} }
+func (m *ScopeChange) String() string { return proto.CompactTextString(m) }
+func (*ScopeChange) ProtoMessage()    {}
+func (*ScopeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *ScopeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeChange.Merge(m, src)
+}
+func (m *ScopeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeChange proto.InternalMessageInfo
+
+func (m *ScopeChange) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ScopeChange) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ScopeChange) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ScopeChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScopeChange) GetChanges() []string {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// ScopeLien is a claim on a scope, e.g. when it is pledged as collateral. While a lien is in effect, the scope cannot
+// be deleted, and its owners and value owner cannot be changed.
+type ScopeLien struct {
+	// scope_id is the id of the scope that the lien is on.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// lienholder is the bech32 address of the account that holds the lien. Only the lienholder can release it.
+	Lienholder string `protobuf:"bytes,2,opt,name=lienholder,proto3" json:"lienholder,omitempty"`
+	// release_time is an optional time at which the lien stops being in effect.
+	// If not provided, the lien stays in effect until the lienholder releases it.
+	ReleaseTime *time.Time `protobuf:"bytes,3,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time,omitempty"`
+}
+
+func (m *ScopeLien) Reset()         { *m = ScopeLien{} }
+func (m *ScopeLien) String() string { return proto.CompactTextString(m) }
+func (*ScopeLien) ProtoMessage()    {}
+func (*ScopeLien) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *ScopeLien) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLien) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLien.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeLien) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLien.Merge(m, src)
+}
+func (m *ScopeLien) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLien) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLien.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLien proto.InternalMessageInfo
+
+func (m *ScopeLien) GetLienholder() string {
+	if m != nil {
+		return m.Lienholder
+	}
+	return ""
+}
+
+func (m *ScopeLien) GetReleaseTime() *time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return nil
+}
+
+// ScopeValueUnits records that the value of a scope has been split into multiple units, e.g. for participations.
+// Each unit is one of the scope's value owner coins, so the value can be held by several accounts at once.
+type ScopeValueUnits struct {
+	// scope_id is the id of the scope that has fractional value ownership.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// units is the total number of value units that the scope has. It is always more than one.
+	Units uint64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+}
+
+func (m *ScopeValueUnits) Reset()         { *m = ScopeValueUnits{} }
+func (m *ScopeValueUnits) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueUnits) ProtoMessage()    {}
+func (*ScopeValueUnits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{11}
+}
+func (m *ScopeValueUnits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeValueUnits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeValueUnits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeValueUnits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueUnits.Merge(m, src)
+}
+func (m *ScopeValueUnits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeValueUnits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueUnits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeValueUnits proto.InternalMessageInfo
+
+func (m *ScopeValueUnits) GetUnits() uint64 {
+	if m != nil {
+		return m.Units
+	}
+	return 0
+}
+
+// ScopeValueHolder is an account that holds some (or all) of the value units of a scope.
+type ScopeValueHolder struct {
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// units is the number of the scope's value units held by the account.
+	Units uint64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+}
+
+func (m *ScopeValueHolder) Reset()         { *m = ScopeValueHolder{} }
+func (m *ScopeValueHolder) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueHolder) ProtoMessage()    {}
+func (*ScopeValueHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{12}
+}
+func (m *ScopeValueHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeValueHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeValueHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeValueHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueHolder.Merge(m, src)
+}
+func (m *ScopeValueHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeValueHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeValueHolder proto.InternalMessageInfo
+
+func (m *ScopeValueHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ScopeValueHolder) GetUnits() uint64 {
+	if m != nil {
+		return m.Units
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
@@ -842,6 +1116,10 @@ func init() {
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
 	proto.RegisterType((*NetAssetValue)(nil), "provenance.metadata.v1.NetAssetValue")
+	proto.RegisterType((*ScopeChange)(nil), "provenance.metadata.v1.ScopeChange")
+	proto.RegisterType((*ScopeLien)(nil), "provenance.metadata.v1.ScopeLien")
+	proto.RegisterType((*ScopeValueUnits)(nil), "provenance.metadata.v1.ScopeValueUnits")
+	proto.RegisterType((*ScopeValueHolder)(nil), "provenance.metadata.v1.ScopeValueHolder")
 }
 
 func init() {
@@ -849,79 +1127,92 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0xbf, 0x9f, 0x5d, 0xea, 0x4e, 0xab, 0xe2, 0x1a, 0x6a, 0x1b, 0xc3, 0xc1, 0x44,
-	0x62, 0xdd, 0x18, 0x8a, 0x44, 0x01, 0x21, 0xbb, 0x49, 0xa9, 0x45, 0x49, 0xac, 0x71, 0xc2, 0x81,
-	0xcb, 0x6a, 0xbd, 0x3b, 0xb5, 0x57, 0xb1, 0x77, 0x96, 0x9d, 0x59, 0xb7, 0x86, 0x0b, 0xe7, 0x9c,
-	0xc2, 0x8d, 0x4b, 0x24, 0xf8, 0x14, 0x7c, 0x85, 0x72, 0xeb, 0x11, 0x01, 0x2a, 0x28, 0xb9, 0xf2,
-	0x21, 0xd0, 0xcc, 0xce, 0xfa, 0x0f, 0x71, 0xa2, 0x44, 0xe2, 0xb6, 0xef, 0xbd, 0xdf, 0x9b, 0xf7,
-	0xde, 0xef, 0xbd, 0x37, 0xb3, 0x50, 0xf7, 0x7c, 0x3a, 0x25, 0xae, 0xe9, 0x5a, 0xa4, 0x39, 0x21,
-	0xdc, 0xb4, 0x4d, 0x6e, 0x36, 0xa7, 0x9b, 0x4d, 0x66, 0x51, 0x8f, 0xe8, 0x9e, 0x4f, 0x39, 0x45,
-	0xb7, 0x17, 0x18, 0x3d, 0xc2, 0xe8, 0xd3, 0xcd, 0x72, 0xc5, 0xa2, 0x6c, 0x42, 0x59, 0x73, 0x60,
-	0x32, 0xd2, 0x9c, 0x6e, 0x0e, 0x08, 0x37, 0x37, 0x9b, 0x16, 0x75, 0xdc, 0xd0, 0xaf, 0x7c, 0x6b,
-	0x48, 0x87, 0x54, 0x7e, 0x36, 0xc5, 0x97, 0xd2, 0x56, 0x87, 0x94, 0x0e, 0xc7, 0xa4, 0x29, 0xa5,
-	0x41, 0xf0, 0xb4, 0xc9, 0x9d, 0x09, 0x61, 0xdc, 0x9c, 0x78, 0x0a, 0x50, 0xfb, 0x2f, 0xc0, 0x26,
-	0xcc, 0xf2, 0x1d, 0x8f, 0x53, 0x5f, 0x21, 0x36, 0xce, 0x4b, 0xda, 0x23, 0x96, 0xf3, 0xd4, 0xb1,
-	0x4c, 0xee, 0x50, 0x95, 0x44, 0xfd, 0xd7, 0x38, 0xa4, 0xfa, 0xa2, 0x18, 0xd4, 0x82, 0xac, 0xac,
-	0xca, 0x70, 0xec, 0x92, 0x56, 0xd3, 0x1a, 0x85, 0xce, 0xeb, 0x2f, 0x5e, 0x55, 0x63, 0xbf, 0xbf,
-	0xaa, 0x5e, 0xff, 0x52, 0x1d, 0xd2, 0xb6, 0x6d, 0x9f, 0x30, 0x86, 0x33, 0x12, 0xd8, 0xb5, 0x51,
-	0x07, 0x8a, 0x2b, 0x87, 0x0a, 0xdf, 0xf8, 0xc5, 0xbe, 0xd7, 0x57, 0x1c, 0xba, 0x36, 0xfa, 0x18,
-	0xd2, 0xf4, 0x99, 0x4b, 0x7c, 0x56, 0x4a, 0xd4, 0x12, 0x8d, 0x7c, 0xeb, 0xae, 0xbe, 0x9e, 0x4f,
-	0xbd, 0x67, 0xfa, 0x7c, 0xd6, 0x49, 0x8a, 0x83, 0xb1, 0x72, 0x41, 0x55, 0xc8, 0x0b, 0xb3, 0x61,
-	0x5a, 0x16, 0x61, 0xac, 0x94, 0xac, 0x25, 0x1a, 0x39, 0x0c, 0x32, 0x9e, 0xd4, 0x20, 0x1d, 0x6e,
-	0x4e, 0xcd, 0x71, 0x40, 0x0c, 0xe9, 0x60, 0x98, 0x61, 0x16, 0xa5, 0x54, 0x4d, 0x6b, 0xe4, 0xf0,
-	0x0d, 0x69, 0xda, 0x15, 0x16, 0x95, 0x1e, 0xba, 0x07, 0xb7, 0x7c, 0xf2, 0x4d, 0xe0, 0xf8, 0xc4,
-	0xf0, 0x44, 0x3c, 0xc3, 0xa7, 0xe3, 0x71, 0xe0, 0x95, 0xd2, 0x35, 0xad, 0x91, 0xc5, 0x48, 0xd9,
-	0x64, 0x2a, 0x58, 0x5a, 0x1e, 0x64, 0x7f, 0xfc, 0xa9, 0x1a, 0xfb, 0xfe, 0xcf, 0x9a, 0x56, 0xff,
-	0x25, 0x0e, 0x99, 0x3e, 0x61, 0xcc, 0xa1, 0x2e, 0xfa, 0x10, 0x80, 0x85, 0x9f, 0x97, 0xe0, 0x33,
-	0xa7, 0xa0, 0xff, 0x13, 0xa3, 0x9f, 0x42, 0x46, 0xe4, 0xee, 0x90, 0x2b, 0x51, 0x1a, 0xf9, 0x20,
-	0x04, 0x49, 0xd7, 0x9c, 0x90, 0x52, 0x52, 0x72, 0x24, 0xbf, 0x51, 0x09, 0x32, 0x16, 0x75, 0x39,
-	0x79, 0xce, 0x25, 0x75, 0x05, 0x1c, 0x89, 0xe8, 0x23, 0x48, 0x99, 0x81, 0xed, 0xf0, 0x92, 0x55,
-	0xd3, 0x1a, 0xf9, 0xd6, 0xdb, 0xe7, 0x85, 0x6a, 0x0b, 0xd0, 0x23, 0x87, 0x8c, 0x6d, 0x86, 0x43,
-	0x8f, 0x25, 0xe6, 0xfe, 0x89, 0x43, 0x1a, 0x13, 0x8b, 0xfa, 0xf6, 0x3c, 0xba, 0xb6, 0x14, 0x7d,
-	0x95, 0xcc, 0xf8, 0xa5, 0xc9, 0xfc, 0x0c, 0x32, 0x9e, 0x4f, 0xe5, 0x64, 0x24, 0x64, 0x76, 0xd5,
-	0x73, 0x89, 0x08, 0x61, 0x73, 0x2a, 0x42, 0x11, 0xb5, 0x21, 0xed, 0xb8, 0x5e, 0xc0, 0xc3, 0xc9,
-	0xba, 0xa0, 0xba, 0x30, 0xf9, 0xae, 0xc0, 0x46, 0x13, 0x1a, 0x3a, 0xa2, 0x2d, 0xc8, 0xd0, 0x80,
-	0xcb, 0x33, 0x52, 0xf2, 0x8c, 0x77, 0x2e, 0x3e, 0x63, 0x57, 0x82, 0xa3, 0x44, 0x94, 0xeb, 0xda,
-	0xb1, 0x48, 0x5f, 0x6d, 0x2c, 0x96, 0xe8, 0xfe, 0x0e, 0x32, 0xaa, 0x60, 0x54, 0x86, 0x4c, 0xb4,
-	0x13, 0x92, 0xf1, 0xc7, 0x31, 0x1c, 0x29, 0xd0, 0x2d, 0x48, 0x8e, 0x4c, 0x36, 0x92, 0x84, 0x0b,
-	0x83, 0x94, 0xe6, 0x0d, 0x4a, 0x2c, 0x35, 0xe8, 0x36, 0xa4, 0x27, 0x84, 0x8f, 0xa8, 0xad, 0x86,
-	0x46, 0x49, 0x0f, 0x92, 0x22, 0x64, 0xa7, 0x00, 0xa0, 0x08, 0x35, 0x1c, 0xbb, 0xfe, 0x87, 0x06,
-	0xf9, 0x25, 0xba, 0xd6, 0x36, 0xbc, 0x05, 0x39, 0x5f, 0x42, 0x16, 0xfd, 0xbe, 0xb9, 0xa6, 0xc6,
-	0xc7, 0x31, 0x9c, 0x0d, 0x71, 0x5d, 0x7b, 0x9e, 0x6d, 0x62, 0x25, 0xdb, 0x37, 0x20, 0xc7, 0x67,
-	0x1e, 0x31, 0x96, 0x26, 0x3a, 0x2b, 0x14, 0x3b, 0x22, 0x4c, 0x1b, 0xd2, 0x8c, 0x9b, 0x3c, 0x08,
-	0xef, 0x83, 0xd7, 0x5a, 0xef, 0x5e, 0xa2, 0xbd, 0x7d, 0xe9, 0x80, 0x95, 0xa3, 0xaa, 0x30, 0x0b,
-	0x69, 0x46, 0x03, 0xdf, 0x22, 0xf5, 0xa7, 0x50, 0x58, 0xee, 0xa3, 0xa8, 0x4e, 0x66, 0xa5, 0xaa,
-	0x93, 0x39, 0x7d, 0x32, 0x0f, 0x1b, 0x97, 0x61, 0x2f, 0x98, 0x08, 0x16, 0x8c, 0xd7, 0x46, 0xac,
-	0x7f, 0x0b, 0x29, 0xb9, 0xbc, 0x62, 0x33, 0x57, 0x1a, 0xb8, 0x68, 0xdf, 0x7d, 0x48, 0xfa, 0x74,
-	0x4c, 0x54, 0x90, 0xb7, 0x2e, 0xbc, 0x03, 0xf6, 0x66, 0x1e, 0xc1, 0x12, 0x8e, 0xca, 0x90, 0xa5,
-	0x9e, 0x18, 0x19, 0x73, 0x2c, 0xb9, 0xcc, 0xe2, 0xb9, 0xac, 0x62, 0xff, 0x10, 0x87, 0xfc, 0xd2,
-	0x3a, 0xa3, 0xcf, 0xa1, 0x60, 0xf9, 0xc4, 0xe4, 0xc4, 0x36, 0x6c, 0x93, 0x87, 0x9d, 0xcc, 0xb7,
-	0xca, 0x7a, 0xf8, 0x50, 0xe9, 0xd1, 0x43, 0xa5, 0xef, 0x45, 0x2f, 0x59, 0x27, 0x2b, 0x86, 0xf6,
-	0xe8, 0xaf, 0xaa, 0x86, 0xf3, 0xca, 0x73, 0xcb, 0xe4, 0x04, 0xdd, 0x05, 0x88, 0x0e, 0x1a, 0xcc,
-	0xc2, 0xb1, 0xc3, 0x39, 0xa5, 0xe9, 0xcc, 0x44, 0x9c, 0xc0, 0xb3, 0x17, 0x71, 0x12, 0x57, 0x89,
-	0xa3, 0x3c, 0xa3, 0x38, 0xd1, 0x41, 0x83, 0x99, 0x9a, 0x8a, 0x9c, 0xd2, 0x74, 0x24, 0xa5, 0x53,
-	0xe2, 0x8b, 0x3b, 0x44, 0xce, 0xc5, 0x35, 0x1c, 0x89, 0xc2, 0x32, 0x21, 0x8c, 0x99, 0x43, 0x22,
-	0xb7, 0x2f, 0x87, 0x23, 0xb1, 0x7e, 0xa4, 0xc1, 0xb5, 0x1d, 0xc2, 0xdb, 0x8c, 0x11, 0xfe, 0x95,
-	0x78, 0x55, 0xd0, 0x7d, 0x48, 0x79, 0xbe, 0x63, 0x45, 0x74, 0xdc, 0xd1, 0xc3, 0xdf, 0x01, 0x5d,
-	0xfc, 0x0e, 0xe8, 0xea, 0x77, 0x40, 0x7f, 0x48, 0x1d, 0x57, 0xed, 0x7a, 0x88, 0x16, 0x0f, 0xd0,
-	0x3c, 0xb7, 0x31, 0xb5, 0x0e, 0x8c, 0x11, 0x71, 0x86, 0x23, 0x2e, 0xd9, 0x48, 0x62, 0x14, 0x65,
-	0x29, 0x4c, 0x8f, 0xa5, 0x45, 0x2c, 0xdf, 0x94, 0x8e, 0x03, 0xb5, 0x92, 0x49, 0xac, 0xa4, 0x8d,
-	0x9f, 0x35, 0xb8, 0x71, 0x66, 0x70, 0xd1, 0x3d, 0xa8, 0xe2, 0xed, 0x87, 0xbb, 0x78, 0xcb, 0xe8,
-	0xee, 0xf4, 0xf6, 0xf7, 0x8c, 0xfe, 0x5e, 0x7b, 0x6f, 0xbf, 0x6f, 0xec, 0xef, 0xf4, 0x7b, 0xdb,
-	0x0f, 0xbb, 0x8f, 0xba, 0xdb, 0x5b, 0xc5, 0x58, 0x39, 0x7f, 0x78, 0x5c, 0xcb, 0xec, 0xbb, 0x07,
-	0x2e, 0x7d, 0xe6, 0x22, 0x1d, 0xde, 0x5c, 0xe7, 0xd1, 0xc3, 0xbb, 0xbd, 0xdd, 0xfe, 0xf6, 0x56,
-	0x51, 0x2b, 0x17, 0x0e, 0x8f, 0x6b, 0xd9, 0x9e, 0x4f, 0x3d, 0xca, 0x88, 0x8d, 0x36, 0xa0, 0xbc,
-	0x0e, 0x1f, 0xea, 0x8a, 0xf1, 0x32, 0x1c, 0x1e, 0xd7, 0xd4, 0x6d, 0xbf, 0x11, 0x88, 0x75, 0x59,
-	0x0c, 0x39, 0xba, 0x0b, 0x77, 0xf0, 0x76, 0x7f, 0xff, 0xc9, 0xfa, 0xbc, 0xd0, 0x6d, 0x40, 0xab,
-	0xe6, 0x5e, 0xbb, 0xdf, 0x2f, 0x6a, 0x67, 0xf5, 0xfd, 0x2f, 0xba, 0xbd, 0x62, 0xfc, 0xac, 0xfe,
-	0x51, 0xbb, 0xfb, 0xa4, 0x98, 0xe8, 0x1c, 0xbc, 0x38, 0xa9, 0x68, 0x2f, 0x4f, 0x2a, 0xda, 0xdf,
-	0x27, 0x15, 0xed, 0xe8, 0xb4, 0x12, 0x7b, 0x79, 0x5a, 0x89, 0xfd, 0x76, 0x5a, 0x89, 0xc1, 0x1d,
-	0x87, 0x9e, 0xb3, 0x28, 0x3d, 0xed, 0xeb, 0x0f, 0x86, 0x0e, 0x1f, 0x05, 0x03, 0xdd, 0xa2, 0x93,
-	0xe6, 0x02, 0xf4, 0x9e, 0x43, 0x97, 0xa4, 0xe6, 0xf3, 0xc5, 0x3f, 0x97, 0xb8, 0x68, 0xd8, 0x20,
-	0x2d, 0x07, 0xf3, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x50, 0x80, 0x91, 0x4c, 0x0a,
-	0x00, 0x00,
+	// 1352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6f, 0x1b, 0x55,
+	0x17, 0xf7, 0xd8, 0x8e, 0x1f, 0xc7, 0xee, 0x17, 0xf7, 0x36, 0xea, 0xe7, 0xfa, 0xfb, 0x6a, 0xbb,
+	0x86, 0x45, 0x88, 0xc4, 0xb8, 0x09, 0x14, 0x89, 0x16, 0x84, 0xec, 0x24, 0x25, 0x16, 0x25, 0xb1,
+	0xc6, 0x31, 0x0b, 0x58, 0x8c, 0xc6, 0x33, 0xb7, 0xf6, 0xa8, 0xe3, 0xb9, 0xd3, 0xb9, 0x77, 0xdc,
+	0x1a, 0x36, 0xac, 0x58, 0x74, 0x55, 0x76, 0x6c, 0x2a, 0x51, 0x89, 0xff, 0x81, 0x7f, 0xa1, 0xcb,
+	0xae, 0x10, 0x02, 0x54, 0x50, 0xbb, 0xe5, 0x8f, 0x40, 0xf7, 0x31, 0x7e, 0xb4, 0x4e, 0x94, 0x56,
+	0xec, 0xe6, 0x9c, 0x7b, 0x1e, 0xbf, 0xf3, 0x3b, 0xe7, 0x3e, 0x06, 0x1a, 0x41, 0x48, 0x26, 0xd8,
+	0xb7, 0x7c, 0x1b, 0x37, 0xc7, 0x98, 0x59, 0x8e, 0xc5, 0xac, 0xe6, 0x64, 0xbb, 0x49, 0x6d, 0x12,
+	0x60, 0x3d, 0x08, 0x09, 0x23, 0xe8, 0xe2, 0xdc, 0x46, 0x8f, 0x6d, 0xf4, 0xc9, 0x76, 0xa5, 0x6a,
+	0x13, 0x3a, 0x26, 0xb4, 0x39, 0xb0, 0x28, 0x6e, 0x4e, 0xb6, 0x07, 0x98, 0x59, 0xdb, 0x4d, 0x9b,
+	0xb8, 0xbe, 0xf4, 0xab, 0x6c, 0x0c, 0xc9, 0x90, 0x88, 0xcf, 0x26, 0xff, 0x52, 0xda, 0xda, 0x90,
+	0x90, 0xa1, 0x87, 0x9b, 0x42, 0x1a, 0x44, 0xb7, 0x9b, 0xcc, 0x1d, 0x63, 0xca, 0xac, 0x71, 0xa0,
+	0x0c, 0xea, 0x2f, 0x1b, 0x38, 0x98, 0xda, 0xa1, 0x1b, 0x30, 0x12, 0x2a, 0x8b, 0xad, 0x93, 0x40,
+	0x07, 0xd8, 0x76, 0x6f, 0xbb, 0xb6, 0xc5, 0x5c, 0xa2, 0x40, 0x34, 0xbe, 0x4b, 0xc1, 0x5a, 0x8f,
+	0x17, 0x83, 0x76, 0x20, 0x27, 0xaa, 0x32, 0x5d, 0xa7, 0xac, 0xd5, 0xb5, 0xcd, 0x62, 0xfb, 0xbf,
+	0x4f, 0x9e, 0xd5, 0x12, 0xbf, 0x3d, 0xab, 0xad, 0x7f, 0xae, 0x82, 0xb4, 0x1c, 0x27, 0xc4, 0x94,
+	0x1a, 0x59, 0x61, 0xd8, 0x71, 0x50, 0x1b, 0x4a, 0x4b, 0x41, 0xb9, 0x6f, 0xf2, 0x74, 0xdf, 0xf5,
+	0x25, 0x87, 0x8e, 0x83, 0x6e, 0x40, 0x86, 0xdc, 0xf3, 0x71, 0x48, 0xcb, 0xa9, 0x7a, 0x6a, 0xb3,
+	0xb0, 0x73, 0x59, 0x5f, 0xcd, 0xa7, 0xde, 0xb5, 0x42, 0x36, 0x6d, 0xa7, 0x79, 0x60, 0x43, 0xb9,
+	0xa0, 0x1a, 0x14, 0xf8, 0xb2, 0x69, 0xd9, 0x36, 0xa6, 0xb4, 0x9c, 0xae, 0xa7, 0x36, 0xf3, 0x06,
+	0x88, 0x7c, 0x42, 0x83, 0x74, 0xb8, 0x30, 0xb1, 0xbc, 0x08, 0x9b, 0xc2, 0xc1, 0xb4, 0x24, 0x8a,
+	0xf2, 0x5a, 0x5d, 0xdb, 0xcc, 0x1b, 0xe7, 0xc5, 0xd2, 0x11, 0x5f, 0x51, 0xf0, 0xd0, 0x55, 0xd8,
+	0x08, 0xf1, 0xdd, 0xc8, 0x0d, 0xb1, 0x19, 0xf0, 0x7c, 0x66, 0x48, 0x3c, 0x2f, 0x0a, 0xca, 0x99,
+	0xba, 0xb6, 0x99, 0x33, 0x90, 0x5a, 0x13, 0x50, 0x0c, 0xb1, 0x82, 0x6e, 0xc0, 0x7a, 0x60, 0x85,
+	0xd8, 0x67, 0xe6, 0x8c, 0xbe, 0xac, 0xa0, 0xe0, 0xc2, 0xaa, 0xf2, 0xcf, 0x49, 0xdb, 0x9e, 0x24,
+	0xf0, 0x7a, 0xee, 0x87, 0x1f, 0x6b, 0x89, 0x6f, 0xff, 0xa8, 0x6b, 0x8d, 0x9f, 0x93, 0x90, 0xed,
+	0x61, 0x4a, 0x5d, 0xe2, 0xa3, 0x0f, 0x00, 0xa8, 0xfc, 0x3c, 0x43, 0x33, 0xf2, 0xca, 0xf4, 0x5f,
+	0x6a, 0xc7, 0xc7, 0x90, 0xe5, 0x85, 0xbb, 0xf8, 0xb5, 0xfa, 0x11, 0xfb, 0x20, 0x04, 0x69, 0xdf,
+	0x1a, 0xe3, 0x72, 0x5a, 0x10, 0x2c, 0xbe, 0x51, 0x19, 0xb2, 0x36, 0xf1, 0x19, 0xbe, 0xcf, 0x04,
+	0xef, 0x45, 0x23, 0x16, 0xd1, 0x87, 0xb0, 0x66, 0x45, 0x8e, 0xcb, 0xca, 0x76, 0x5d, 0xdb, 0x2c,
+	0xec, 0xbc, 0x75, 0x52, 0xaa, 0x16, 0x37, 0xba, 0xe9, 0x62, 0xcf, 0xa1, 0x86, 0xf4, 0x58, 0x60,
+	0xee, 0xef, 0x24, 0x64, 0x0c, 0x6c, 0x93, 0xd0, 0x99, 0x65, 0xd7, 0x16, 0xb2, 0x2f, 0x93, 0x99,
+	0x3c, 0x33, 0x99, 0x9f, 0x40, 0x36, 0x08, 0x89, 0x18, 0xab, 0x94, 0x40, 0x57, 0x3b, 0x91, 0x08,
+	0x69, 0x36, 0xa3, 0x42, 0x8a, 0xa8, 0x05, 0x19, 0xd7, 0x0f, 0x22, 0x26, 0xc7, 0xf2, 0x94, 0xea,
+	0x24, 0xf8, 0x0e, 0xb7, 0x8d, 0xc7, 0x5b, 0x3a, 0xa2, 0x3d, 0xc8, 0x92, 0x88, 0x89, 0x18, 0x6b,
+	0x22, 0xc6, 0xdb, 0xa7, 0xc7, 0x38, 0x8a, 0xd8, 0x3c, 0x48, 0xec, 0xba, 0x72, 0x2c, 0x32, 0xaf,
+	0x37, 0x16, 0x0b, 0x74, 0x7f, 0x03, 0x59, 0x55, 0x30, 0xaa, 0x40, 0x36, 0xde, 0x50, 0x82, 0xf1,
+	0x83, 0x84, 0x11, 0x2b, 0xd0, 0x06, 0xa4, 0x47, 0x16, 0x1d, 0x95, 0x93, 0x6a, 0x41, 0x48, 0xb3,
+	0x06, 0xa5, 0x16, 0x1a, 0x74, 0x11, 0x32, 0x63, 0xcc, 0x46, 0xc4, 0x51, 0x43, 0xa3, 0xa4, 0xeb,
+	0x69, 0x9e, 0xb2, 0x5d, 0x04, 0x50, 0x84, 0x9a, 0xae, 0xd3, 0xf8, 0x5d, 0x83, 0xc2, 0x02, 0x5d,
+	0x2b, 0x1b, 0xbe, 0x03, 0xf9, 0x50, 0x98, 0xcc, 0xfb, 0xbd, 0x6a, 0x2b, 0x1e, 0x24, 0x8c, 0x9c,
+	0xb4, 0xeb, 0x38, 0x33, 0xb4, 0xa9, 0x25, 0xb4, 0xff, 0x83, 0x3c, 0x9b, 0x06, 0xd8, 0x5c, 0x98,
+	0xe8, 0x1c, 0x57, 0x1c, 0xf2, 0x34, 0x2d, 0xc8, 0x50, 0x66, 0xb1, 0x48, 0x1e, 0x26, 0xff, 0xd9,
+	0x79, 0xe7, 0x0c, 0xed, 0xed, 0x09, 0x07, 0x43, 0x39, 0xaa, 0x0a, 0x73, 0x90, 0xa1, 0x24, 0x0a,
+	0x6d, 0xdc, 0x78, 0xac, 0x41, 0x71, 0xb1, 0x91, 0xbc, 0x3c, 0x01, 0x4b, 0x95, 0x27, 0x40, 0x7d,
+	0x34, 0xcb, 0x9b, 0x14, 0x79, 0x4f, 0x19, 0x09, 0x1a, 0x79, 0x2f, 0xa5, 0xe4, 0x07, 0x26, 0xb5,
+	0x47, 0x78, 0x6c, 0x99, 0xf3, 0x7a, 0x0d, 0x90, 0xaa, 0x03, 0x1e, 0xbe, 0xcc, 0xf7, 0xff, 0xd4,
+	0x23, 0x56, 0xdc, 0x8e, 0x58, 0x94, 0x68, 0x1b, 0x5f, 0xc3, 0x9a, 0xd8, 0xf8, 0xdc, 0x70, 0xa9,
+	0xf9, 0xf3, 0xd6, 0x5f, 0x83, 0x74, 0x48, 0x3c, 0xac, 0xf0, 0x5d, 0x39, 0xf5, 0xfc, 0x38, 0x9e,
+	0x06, 0xd8, 0x10, 0xe6, 0xa8, 0x02, 0x39, 0x12, 0xf0, 0x71, 0xb3, 0x3c, 0x81, 0x2b, 0x67, 0xcc,
+	0x64, 0x95, 0xfb, 0xfb, 0x24, 0x14, 0x16, 0x8e, 0x02, 0xf4, 0x29, 0x14, 0xed, 0x10, 0x5b, 0x0c,
+	0x3b, 0xa6, 0x63, 0x31, 0x39, 0x05, 0x85, 0x9d, 0x8a, 0x2e, 0x6f, 0x48, 0x3d, 0xbe, 0x21, 0xf5,
+	0xe3, 0xf8, 0x0a, 0x6d, 0xe7, 0xf8, 0xc0, 0x3f, 0xfc, 0xb3, 0xa6, 0x19, 0x05, 0xe5, 0xb9, 0x67,
+	0x31, 0x8c, 0x2e, 0x03, 0xc4, 0x81, 0x06, 0x53, 0x39, 0xb2, 0x46, 0x5e, 0x69, 0xda, 0x53, 0x9e,
+	0x27, 0x0a, 0x9c, 0x79, 0x9e, 0xd4, 0xeb, 0xe4, 0x51, 0x9e, 0x71, 0x9e, 0x38, 0xd0, 0x60, 0xaa,
+	0xf8, 0xcd, 0x2b, 0x4d, 0x5b, 0x50, 0x3a, 0xc1, 0x21, 0x3f, 0x7f, 0xc4, 0x4c, 0x9d, 0x33, 0x62,
+	0x91, 0xaf, 0x8c, 0x31, 0xa5, 0xd6, 0x10, 0x8b, 0x9d, 0x9b, 0x37, 0x62, 0xb1, 0xf1, 0x50, 0x83,
+	0x73, 0x87, 0x98, 0xb5, 0x28, 0xc5, 0xec, 0x0b, 0x7e, 0x9d, 0xa1, 0x6b, 0xb0, 0x16, 0x84, 0xae,
+	0x1d, 0xd3, 0x71, 0x49, 0x97, 0xef, 0x10, 0x9d, 0xbf, 0x43, 0x74, 0xf5, 0x0e, 0xd1, 0x77, 0x89,
+	0xeb, 0xab, 0x73, 0x42, 0x5a, 0xf3, 0x9b, 0x6f, 0x86, 0xcd, 0x23, 0xf6, 0x1d, 0x73, 0x84, 0xdd,
+	0xe1, 0x88, 0x09, 0x36, 0xd2, 0x06, 0x8a, 0x51, 0xf2, 0xa5, 0x03, 0xb1, 0xc2, 0x37, 0xee, 0x84,
+	0x78, 0x91, 0xda, 0xce, 0x69, 0x43, 0x49, 0x8d, 0x5f, 0x34, 0x28, 0x88, 0x0b, 0x6e, 0x77, 0x64,
+	0xf9, 0xc3, 0x37, 0x7b, 0x59, 0x54, 0x20, 0x47, 0xf1, 0xdd, 0x08, 0xfb, 0x36, 0x56, 0x08, 0x66,
+	0x32, 0xaa, 0x43, 0x71, 0x4c, 0x87, 0xa6, 0xd8, 0x9a, 0x51, 0xe8, 0xc5, 0x43, 0x3c, 0xa6, 0x43,
+	0x3e, 0x4d, 0xfd, 0xd0, 0xe3, 0x74, 0x51, 0x77, 0x28, 0x1e, 0x15, 0xf2, 0x49, 0x10, 0x8b, 0xe8,
+	0x0a, 0x14, 0x97, 0xaa, 0xe3, 0x3c, 0xa7, 0x8c, 0xc2, 0x60, 0xa1, 0x2c, 0x7e, 0x5d, 0x09, 0xe0,
+	0xb4, 0x9c, 0x91, 0xce, 0x4a, 0x6c, 0xfc, 0xa4, 0x41, 0x5e, 0x14, 0x76, 0xcb, 0xc5, 0xfe, 0x1b,
+	0x95, 0x55, 0x05, 0xf0, 0x5c, 0xec, 0x8f, 0x88, 0xe7, 0xe0, 0x50, 0x0d, 0xda, 0x82, 0x06, 0xed,
+	0x42, 0x31, 0xc4, 0x1e, 0xb6, 0x28, 0x36, 0xf9, 0xbb, 0xef, 0x0c, 0x93, 0x96, 0x96, 0x53, 0xa6,
+	0xbc, 0xb8, 0xbe, 0xf1, 0x15, 0xac, 0x0b, 0x94, 0x62, 0x1c, 0xfa, 0xbe, 0xcb, 0xe8, 0x1b, 0x61,
+	0xdd, 0x80, 0xb5, 0x88, 0x3b, 0x2b, 0xfe, 0xa5, 0xd0, 0x68, 0x43, 0x69, 0x1e, 0xfc, 0x40, 0xa2,
+	0x3e, 0xf9, 0x28, 0x58, 0x19, 0x63, 0xeb, 0xb1, 0x06, 0xe7, 0x5f, 0x39, 0x15, 0xd1, 0x55, 0xa8,
+	0x19, 0xfb, 0xbb, 0x47, 0xc6, 0x9e, 0xd9, 0x39, 0xec, 0xf6, 0x8f, 0xcd, 0xde, 0x71, 0xeb, 0xb8,
+	0xdf, 0x33, 0xfb, 0x87, 0xbd, 0xee, 0xfe, 0x6e, 0xe7, 0x66, 0x67, 0x7f, 0xaf, 0x94, 0xa8, 0x14,
+	0x1e, 0x3c, 0xaa, 0x67, 0xfb, 0xfe, 0x1d, 0x9f, 0xdc, 0xf3, 0x91, 0x0e, 0xff, 0x5f, 0xe5, 0xd1,
+	0x35, 0x8e, 0xba, 0x47, 0xbd, 0xfd, 0xbd, 0x92, 0x56, 0x29, 0x3e, 0x78, 0x54, 0xcf, 0x75, 0x43,
+	0x12, 0x10, 0x8a, 0x1d, 0xb4, 0x05, 0x95, 0x55, 0xf6, 0x52, 0x57, 0x4a, 0x56, 0xe0, 0xc1, 0xa3,
+	0xba, 0x7a, 0x4a, 0x6c, 0x45, 0x50, 0x5c, 0x3c, 0x40, 0xd1, 0x65, 0xb8, 0x64, 0xec, 0xf7, 0xfa,
+	0xb7, 0x56, 0xe3, 0x42, 0x17, 0x01, 0x2d, 0x2f, 0x77, 0x5b, 0xbd, 0x5e, 0x49, 0x7b, 0x55, 0xdf,
+	0xfb, 0xac, 0xd3, 0x2d, 0x25, 0x5f, 0xd5, 0xdf, 0x6c, 0x75, 0x6e, 0x95, 0x52, 0xed, 0x3b, 0x4f,
+	0x9e, 0x57, 0xb5, 0xa7, 0xcf, 0xab, 0xda, 0x5f, 0xcf, 0xab, 0xda, 0xc3, 0x17, 0xd5, 0xc4, 0xd3,
+	0x17, 0xd5, 0xc4, 0xaf, 0x2f, 0xaa, 0x09, 0xb8, 0xe4, 0x92, 0x13, 0x4e, 0xd2, 0xae, 0xf6, 0xe5,
+	0xfb, 0x43, 0x97, 0x8d, 0xa2, 0x81, 0x6e, 0x93, 0x71, 0x73, 0x6e, 0xf4, 0xae, 0x4b, 0x16, 0xa4,
+	0xe6, 0xfd, 0xf9, 0xdf, 0x00, 0xdf, 0x3b, 0x74, 0x90, 0x11, 0xf3, 0xf4, 0xde, 0x3f, 0x03, 0x00,
+	0x51, 0xa7, 0x9d, 0x61, 0xe6, 0x0c, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -944,6 +1235,18 @@ func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ParentScopeId != nil {
+		{
+			size := m.ParentScopeId.Size()
+			i -= size
+			if _, err := m.ParentScopeId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.RequirePartyRollup {
 		i--
 		if m.RequirePartyRollup {
@@ -1358,6 +1661,20 @@ func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Status != 0 {
 		i = encodeVarintScope(dAtA, i, uint64(m.Status))
 		i--
@@ -1526,68 +1843,263 @@ func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScopeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Scope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScopeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Changes[iNdEx])
+			copy(dAtA[i:], m.Changes[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Changes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovScope(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RequirePartyRollup {
-		n += 2
+	if m.Sequence != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Session) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopeLien) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLien) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLien) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	if m.ReleaseTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime):])
+		if err6 != nil {
+			return 0, err6
 		}
+		i -= n6
+		i = encodeVarintScope(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if len(m.Lienholder) > 0 {
+		i -= len(m.Lienholder)
+		copy(dAtA[i:], m.Lienholder)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Lienholder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeValueUnits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeValueUnits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeValueUnits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Units != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Units))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeValueHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeValueHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeValueHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Units != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Units))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.RequirePartyRollup {
+		n += 2
+	}
+	if m.ParentScopeId != nil {
+		l = m.ParentScopeId.Size()
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
 	}
 	l = len(m.Context)
 	if l > 0 {
@@ -1727,6 +2239,14 @@ func (m *RecordOutput) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovScope(uint64(m.Status))
 	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
 	return n
 }
 
@@ -1794,6 +2314,88 @@ func (m *NetAssetValue) Size() (n int) {
 	return n
 }
 
+func (m *ScopeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovScope(uint64(m.Sequence))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.BlockHeight))
+	}
+	if len(m.Changes) > 0 {
+		for _, s := range m.Changes {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeLien) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Lienholder)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.ReleaseTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime)
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeValueUnits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Units != 0 {
+		n += 1 + sovScope(uint64(m.Units))
+	}
+	return n
+}
+
+func (m *ScopeValueHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Units != 0 {
+		n += 1 + sovScope(uint64(m.Units))
+	}
+	return n
+}
+
 func sovScope(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1816,6 +2418,7 @@ func (this *Scope) String() string {
 		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
 		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
 		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
+		`ParentScopeId:` + fmt.Sprintf("%v", this.ParentScopeId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2086,6 +2689,41 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequirePartyRollup = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ParentScopeId = &v
+			if err := m.ParentScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -3067,20 +3705,84 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthScope
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -3561,6 +4263,577 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeLien) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeLien: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeLien: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lienholder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lienholder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleaseTime == nil {
+				m.ReleaseTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeValueUnits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeValueUnits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeValueUnits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			m.Units = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Units |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeValueHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeValueHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeValueHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			m.Units = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Units |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScope(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
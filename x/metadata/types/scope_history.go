@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewScopeChange creates a new ScopeChange.
+func NewScopeChange(scopeID MetadataAddress, sequence uint64, msgTypeURL string, signers []string, blockHeight int64, changes []string) ScopeChange {
+	return ScopeChange{
+		ScopeId:     scopeID,
+		Sequence:    sequence,
+		MsgTypeUrl:  msgTypeURL,
+		Signers:     signers,
+		BlockHeight: blockHeight,
+		Changes:     changes,
+	}
+}
+
+// Validate returns an error if this scope change log entry is not well formed.
+func (c ScopeChange) Validate() error {
+	if err := c.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope change scope id: %w", err)
+	}
+	if c.Sequence == 0 {
+		return errors.New("invalid scope change sequence: cannot be zero")
+	}
+	if len(c.MsgTypeUrl) == 0 {
+		return errors.New("invalid scope change msg type url: cannot be empty")
+	}
+	if c.BlockHeight < 0 {
+		return fmt.Errorf("invalid scope change block height: %d", c.BlockHeight)
+	}
+	return nil
+}
+
+// ScopeDiff returns a summary of the differences between two versions of a scope, one entry per change.
+// A nil before indicates that the scope was created, and a nil after indicates that it was deleted.
+// Both scopes should have their ValueOwnerAddress populated.
+func ScopeDiff(before, after *Scope) []string {
+	switch {
+	case before == nil && after == nil:
+		return nil
+	case after == nil:
+		return []string{"scope deleted"}
+	case before == nil:
+		before = &Scope{}
+		rv := []string{"scope created"}
+		return append(rv, ScopeDiff(before, after)...)
+	}
+
+	var rv []string
+	if !before.SpecificationId.Equals(after.SpecificationId) {
+		rv = append(rv, fieldChange("specification_id", before.SpecificationId.String(), after.SpecificationId.String()))
+	}
+	for _, owner := range before.Owners {
+		if !containsParty(after.Owners, owner) {
+			rv = append(rv, "owner removed: "+partyDesc(owner))
+		}
+	}
+	for _, owner := range after.Owners {
+		if !containsParty(before.Owners, owner) {
+			rv = append(rv, "owner added: "+partyDesc(owner))
+		}
+	}
+	for _, addr := range before.DataAccess {
+		if !containsString(after.DataAccess, addr) {
+			rv = append(rv, "data_access removed: "+addr)
+		}
+	}
+	for _, addr := range after.DataAccess {
+		if !containsString(before.DataAccess, addr) {
+			rv = append(rv, "data_access added: "+addr)
+		}
+	}
+	if before.ValueOwnerAddress != after.ValueOwnerAddress {
+		rv = append(rv, fieldChange("value_owner_address", before.ValueOwnerAddress, after.ValueOwnerAddress))
+	}
+	if before.RequirePartyRollup != after.RequirePartyRollup {
+		rv = append(rv, fieldChange("require_party_rollup", fmt.Sprintf("%t", before.RequirePartyRollup), fmt.Sprintf("%t", after.RequirePartyRollup)))
+	}
+	if !before.GetParentScopeID().Equals(after.GetParentScopeID()) {
+		rv = append(rv, fieldChange("parent_scope_id", before.GetParentScopeID().String(), after.GetParentScopeID().String()))
+	}
+	return rv
+}
+
+// fieldChange returns a summary of a field changing from one value to another.
+func fieldChange(field, from, to string) string {
+	return fmt.Sprintf("%s: %q -> %q", field, from, to)
+}
+
+// partyDesc returns a description of a party for use in a change summary.
+func partyDesc(party Party) string {
+	if party.Optional {
+		return party.String() + " (optional)"
+	}
+	return party.String()
+}
+
+// containsParty returns true if the provided parties contain one equal to the given party.
+func containsParty(parties []Party, party Party) bool {
+	for _, p := range parties {
+		if p.Equals(&party) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString returns true if the provided values contain the given one.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeDiff(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID1 := ScopeSpecMetadataAddress(uuid.New())
+	specID2 := ScopeSpecMetadataAddress(uuid.New())
+	parentID := ScopeMetadataAddress(uuid.New())
+	owner1 := Party{Address: "owner1", Role: PartyType_PARTY_TYPE_OWNER}
+	owner2 := Party{Address: "owner2", Role: PartyType_PARTY_TYPE_SERVICER, Optional: true}
+
+	base := func() *Scope {
+		return &Scope{
+			ScopeId:           scopeID,
+			SpecificationId:   specID1,
+			Owners:            []Party{owner1},
+			DataAccess:        []string{"da1"},
+			ValueOwnerAddress: "vo1",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		before *Scope
+		after  func() *Scope
+		exp    []string
+	}{
+		{
+			name:   "both nil",
+			before: nil,
+			after:  func() *Scope { return nil },
+			exp:    nil,
+		},
+		{
+			name:   "deleted",
+			before: base(),
+			after:  func() *Scope { return nil },
+			exp:    []string{"scope deleted"},
+		},
+		{
+			name:   "created",
+			before: nil,
+			after:  base,
+			exp: []string{
+				"scope created",
+				`specification_id: "" -> "` + specID1.String() + `"`,
+				"owner added: " + owner1.String(),
+				"data_access added: da1",
+				`value_owner_address: "" -> "vo1"`,
+			},
+		},
+		{
+			name:   "no changes",
+			before: base(),
+			after:  base,
+			exp:    nil,
+		},
+		{
+			name:   "spec changed",
+			before: base(),
+			after: func() *Scope {
+				rv := base()
+				rv.SpecificationId = specID2
+				return rv
+			},
+			exp: []string{`specification_id: "` + specID1.String() + `" -> "` + specID2.String() + `"`},
+		},
+		{
+			name:   "owners changed",
+			before: base(),
+			after: func() *Scope {
+				rv := base()
+				rv.Owners = []Party{owner2}
+				return rv
+			},
+			exp: []string{
+				"owner removed: " + owner1.String(),
+				"owner added: " + owner2.String() + " (optional)",
+			},
+		},
+		{
+			name:   "data access changed",
+			before: base(),
+			after: func() *Scope {
+				rv := base()
+				rv.DataAccess = []string{"da2", "da3"}
+				return rv
+			},
+			exp: []string{
+				"data_access removed: da1",
+				"data_access added: da2",
+				"data_access added: da3",
+			},
+		},
+		{
+			name:   "value owner and rollup changed",
+			before: base(),
+			after: func() *Scope {
+				rv := base()
+				rv.ValueOwnerAddress = "vo2"
+				rv.RequirePartyRollup = true
+				return rv
+			},
+			exp: []string{
+				`value_owner_address: "vo1" -> "vo2"`,
+				`require_party_rollup: "false" -> "true"`,
+			},
+		},
+		{
+			name:   "parent changed",
+			before: base(),
+			after: func() *Scope {
+				rv := base()
+				rv.ParentScopeId = &parentID
+				return rv
+			},
+			exp: []string{`parent_scope_id: "" -> "` + parentID.String() + `"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := ScopeDiff(tc.before, tc.after())
+			assert.Equal(t, tc.exp, actual, "ScopeDiff")
+		})
+	}
+}
+
+func TestScopeChangeValidate(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	tests := []struct {
+		name   string
+		change ScopeChange
+		expErr string
+	}{
+		{
+			name:   "valid",
+			change: NewScopeChange(scopeID, 1, "/some.Msg", []string{"signer"}, 5, []string{"scope created"}),
+		},
+		{
+			name:   "not a scope id",
+			change: NewScopeChange(ScopeSpecMetadataAddress(uuid.New()), 1, "/some.Msg", nil, 5, nil),
+			expErr: "invalid scope change scope id: ",
+		},
+		{
+			name:   "zero sequence",
+			change: NewScopeChange(scopeID, 0, "/some.Msg", nil, 5, nil),
+			expErr: "invalid scope change sequence: cannot be zero",
+		},
+		{
+			name:   "no msg type url",
+			change: NewScopeChange(scopeID, 1, "", nil, 5, nil),
+			expErr: "invalid scope change msg type url: cannot be empty",
+		},
+		{
+			name:   "negative block height",
+			change: NewScopeChange(scopeID, 1, "/some.Msg", nil, -1, nil),
+			expErr: "invalid scope change block height: -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if len(tc.expErr) > 0 {
+				require.Error(t, err, "Validate")
+				assert.Contains(t, err.Error(), tc.expErr, "Validate error")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
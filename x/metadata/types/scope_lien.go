@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewScopeLien creates a new ScopeLien.
+func NewScopeLien(scopeID MetadataAddress, lienholder string, releaseTime *time.Time) ScopeLien {
+	return ScopeLien{
+		ScopeId:     scopeID,
+		Lienholder:  lienholder,
+		ReleaseTime: releaseTime,
+	}
+}
+
+// Validate returns an error if this scope lien is not well formed.
+func (l ScopeLien) Validate() error {
+	if err := l.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope lien scope id: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Lienholder); err != nil {
+		return fmt.Errorf("invalid scope lien lienholder %q: %w", l.Lienholder, err)
+	}
+	if l.ReleaseTime != nil && l.ReleaseTime.IsZero() {
+		return fmt.Errorf("invalid scope lien release time: cannot be zero")
+	}
+	return nil
+}
+
+// InEffect returns true if this lien still restricts its scope at the provided block time,
+// i.e. it either has no release time, or its release time is after the block time.
+func (l ScopeLien) InEffect(blockTime time.Time) bool {
+	return l.ReleaseTime == nil || l.ReleaseTime.After(blockTime)
+}
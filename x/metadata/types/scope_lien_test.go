@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScopeLienInEffect(t *testing.T) {
+	blockTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	before := blockTime.Add(-1 * time.Second)
+	after := blockTime.Add(time.Second)
+
+	tests := []struct {
+		name        string
+		releaseTime *time.Time
+		exp         bool
+	}{
+		{name: "no release time", releaseTime: nil, exp: true},
+		{name: "release time before block time", releaseTime: &before, exp: false},
+		{name: "release time equals block time", releaseTime: &blockTime, exp: false},
+		{name: "release time after block time", releaseTime: &after, exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lien := NewScopeLien(ScopeMetadataAddress(uuid.New()), "lienholder", tc.releaseTime)
+			act := lien.InEffect(blockTime)
+			assert.Equal(t, tc.exp, act, "InEffect")
+		})
+	}
+}
+
+func TestGenesisStateValidateScopeLiens(t *testing.T) {
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+	lienholder := sdk.AccAddress("lienholder__________").String()
+
+	tests := []struct {
+		name   string
+		liens  []ScopeLien
+		expErr string
+	}{
+		{
+			name:  "no liens",
+			liens: nil,
+		},
+		{
+			name:  "two liens",
+			liens: []ScopeLien{NewScopeLien(scopeID1, lienholder, nil), NewScopeLien(scopeID2, lienholder, nil)},
+		},
+		{
+			name:   "invalid lien",
+			liens:  []ScopeLien{NewScopeLien(scopeID1, lienholder, nil), NewScopeLien(scopeID2, "", nil)},
+			expErr: `invalid scope lien [1]: invalid scope lien lienholder "": empty address string is not allowed`,
+		},
+		{
+			name:   "two liens on one scope",
+			liens:  []ScopeLien{NewScopeLien(scopeID1, lienholder, nil), NewScopeLien(scopeID1, lienholder, nil)},
+			expErr: "duplicate scope lien [1]: scope " + scopeID1.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := GenesisState{ScopeLiens: tc.liens}
+			err := state.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
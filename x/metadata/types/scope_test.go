@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -51,6 +52,9 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 		s.Require().NoError(err, "uuid.Parse(%q)", uid)
 		return rv
 	}
+	addrPtr := func(addr MetadataAddress) *MetadataAddress {
+		return &addr
+	}
 	ns := func(scopeID, scopeSpecification MetadataAddress, owners []Party, dataAccess []string, valueOwner string) *Scope {
 		return &Scope{
 			ScopeId:            scopeID,
@@ -134,6 +138,36 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 			},
 			expErr: "parties can only be optional when require_party_rollup = true",
 		},
+		{
+			name: "valid parent scope",
+			scope: &Scope{
+				ScopeId:         ScopeMetadataAddress(newUUID("1")),
+				SpecificationId: ScopeSpecMetadataAddress(newUUID("2")),
+				Owners:          OwnerPartyList(s.Addr),
+				ParentScopeId:   addrPtr(ScopeMetadataAddress(newUUID("3"))),
+			},
+			expErr: "",
+		},
+		{
+			name: "parent scope id is not a scope id",
+			scope: &Scope{
+				ScopeId:         ScopeMetadataAddress(newUUID("1")),
+				SpecificationId: ScopeSpecMetadataAddress(newUUID("2")),
+				Owners:          OwnerPartyList(s.Addr),
+				ParentScopeId:   addrPtr(ScopeSpecMetadataAddress(newUUID("3"))),
+			},
+			expErr: "invalid parent scope id on scope: invalid scope id \"" + ScopeSpecMetadataAddress(newUUID("3")).String() + "\": wrong type",
+		},
+		{
+			name: "scope is its own parent",
+			scope: &Scope{
+				ScopeId:         ScopeMetadataAddress(newUUID("1")),
+				SpecificationId: ScopeSpecMetadataAddress(newUUID("2")),
+				Owners:          OwnerPartyList(s.Addr),
+				ParentScopeId:   addrPtr(ScopeMetadataAddress(newUUID("1"))),
+			},
+			expErr: "invalid parent scope id on scope: a scope cannot be its own parent",
+		},
 	}
 
 	for _, tc := range tests {
@@ -504,6 +538,7 @@ func (s *ScopeTestSuite) TestScopeString() {
 		"DataAccess:[]," +
 		"ValueOwnerAddress:," +
 		"RequirePartyRollup:false," +
+		"ParentScopeId:<nil>," +
 		"}"
 	var actual string
 	testFunc := func() {
@@ -675,6 +710,31 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 			"",
 			false,
 		},
+		{
+			"Valid record, record output with payload",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, SchemaHash: "schemahash", Payload: `{"a":1}`}}, nil),
+			"",
+			false,
+		},
+		{
+			"Invalid record, record output payload not json",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, Payload: `{"a":`}}, nil),
+			"invalid record output: record output payload is not valid JSON",
+			true,
+		},
+		{
+			"Invalid record, record output payload too long",
+			NewRecord("name", sessionID, *validPs,
+				[]RecordInput{*validRI},
+				[]RecordOutput{{Hash: "hash", Status: ResultStatus_RESULT_STATUS_PASS, Payload: `"` + strings.Repeat("p", maxRecordOutputPayloadLength) + `"`}}, nil),
+			fmt.Sprintf("invalid record output: record output payload exceeds maximum length (expected <= %d got: %d)",
+				maxRecordOutputPayloadLength, maxRecordOutputPayloadLength+2),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewScopeValueUnits creates a new ScopeValueUnits.
+func NewScopeValueUnits(scopeID MetadataAddress, units uint64) ScopeValueUnits {
+	return ScopeValueUnits{
+		ScopeId: scopeID,
+		Units:   units,
+	}
+}
+
+// Validate returns an error if these scope value units are not well formed.
+func (u ScopeValueUnits) Validate() error {
+	if err := u.ScopeId.ValidateIsScopeAddress(); err != nil {
+		return fmt.Errorf("invalid scope value units scope id: %w", err)
+	}
+	if u.Units < 2 {
+		return errors.New("invalid scope value units: must be more than one")
+	}
+	return nil
+}
+
+// Coin creates the coin representing all of the value units of the scope.
+func (u ScopeValueUnits) Coin() sdk.Coin {
+	return sdk.NewCoin(u.ScopeId.Denom(), sdkmath.NewIntFromUint64(u.Units))
+}
+
+// NewScopeValueHolder creates a new ScopeValueHolder.
+func NewScopeValueHolder(address string, units uint64) ScopeValueHolder {
+	return ScopeValueHolder{
+		Address: address,
+		Units:   units,
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScopeValueUnitsCoin(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	units := NewScopeValueUnits(scopeID, 250)
+	assert.Equal(t, sdk.NewInt64Coin(scopeID.Denom(), 250), units.Coin(), "Coin()")
+}
+
+func TestGenesisStateValidateScopeValueUnits(t *testing.T) {
+	scopeID1 := ScopeMetadataAddress(uuid.New())
+	scopeID2 := ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name   string
+		units  []ScopeValueUnits
+		expErr string
+	}{
+		{
+			name:  "no value units",
+			units: nil,
+		},
+		{
+			name:  "two scopes",
+			units: []ScopeValueUnits{NewScopeValueUnits(scopeID1, 10), NewScopeValueUnits(scopeID2, 2)},
+		},
+		{
+			name:   "invalid units",
+			units:  []ScopeValueUnits{NewScopeValueUnits(scopeID1, 10), NewScopeValueUnits(scopeID2, 1)},
+			expErr: "invalid scope value units [1]: invalid scope value units: must be more than one",
+		},
+		{
+			name:   "one scope twice",
+			units:  []ScopeValueUnits{NewScopeValueUnits(scopeID1, 10), NewScopeValueUnits(scopeID1, 20)},
+			expErr: "duplicate scope value units [1]: scope " + scopeID1.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := GenesisState{ScopeValueUnits: tc.units}
+			err := state.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
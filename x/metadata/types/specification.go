@@ -1,12 +1,16 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/jsonschema"
 )
 
 const (
@@ -28,6 +32,8 @@ const (
 	maxInputSpecificationTypeNameLength = 1000
 	// Default max url length
 	maxURLLength = 2048
+	// Default max length for an OutputSchema.JsonSchema
+	maxOutputSchemaJSONSchemaLength = 10000
 )
 
 var (
@@ -91,9 +97,29 @@ func (s ScopeSpecification) ValidateBasic() error {
 				i, PrefixContractSpecification, prefix)
 		}
 	}
+	if previousSpecID := s.GetPreviousSpecID(); len(previousSpecID) > 0 {
+		prefix, err = VerifyMetadataAddressFormat(previousSpecID)
+		if err != nil {
+			return fmt.Errorf("invalid previous scope specification id: %w", err)
+		}
+		if prefix != PrefixScopeSpecification {
+			return fmt.Errorf("invalid previous scope specification id prefix (expected: %s, got %s)", PrefixScopeSpecification, prefix)
+		}
+		if previousSpecID.Equals(s.SpecificationId) {
+			return errors.New("a scope specification cannot be its own previous scope specification")
+		}
+	}
 	return nil
 }
 
+// GetPreviousSpecID returns the id of the scope specification that this one succeeds, or nil if it doesn't have one.
+func (s ScopeSpecification) GetPreviousSpecID() MetadataAddress {
+	if s.PreviousSpecId == nil {
+		return nil
+	}
+	return *s.PreviousSpecId
+}
+
 // NewContractSpecification creates a new ContractSpecification instance.
 func NewContractSpecification(
 	specificationID MetadataAddress,
@@ -236,7 +262,82 @@ func (s RecordSpecification) ValidateBasic() error {
 	if s.ResultType == DefinitionType_DEFINITION_TYPE_UNSPECIFIED {
 		return errors.New("record specification result type cannot be unspecified")
 	}
+	if s.OutputSchema != nil {
+		if err := s.OutputSchema.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid output schema: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NewOutputSchema creates a new OutputSchema instance.
+// If a jsonSchema is provided, the hash should be HashOutputSchema([]byte(jsonSchema)).
+func NewOutputSchema(schemaType OutputSchemaType, hash string, jsonSchema string) *OutputSchema {
+	return &OutputSchema{
+		Type:       schemaType,
+		Hash:       hash,
+		JsonSchema: jsonSchema,
+	}
+}
+
+// HashOutputSchema returns the hex encoded sha256 hash of the provided schema document.
+func HashOutputSchema(schema []byte) string {
+	sum := sha256.Sum256(schema)
+	return hex.EncodeToString(sum[:])
+}
 
+// ValidateBasic performs basic format checking of data in an OutputSchema
+func (s OutputSchema) ValidateBasic() error {
+	if _, found := OutputSchemaType_name[int32(s.Type)]; !found || s.Type == OutputSchemaType_OUTPUT_SCHEMA_TYPE_UNSPECIFIED {
+		return fmt.Errorf("output schema type must be %s or %s",
+			OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA)
+	}
+	hash, err := hex.DecodeString(s.Hash)
+	if err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("output schema hash %q is not a hex encoded sha256 hash", s.Hash)
+	}
+	if len(s.JsonSchema) == 0 {
+		return nil
+	}
+	if s.Type != OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA {
+		return fmt.Errorf("output schema json schema cannot be provided with type %s", s.Type)
+	}
+	if len(s.JsonSchema) > maxOutputSchemaJSONSchemaLength {
+		return fmt.Errorf("output schema json schema exceeds maximum length (expected <= %d got: %d)",
+			maxOutputSchemaJSONSchemaLength, len(s.JsonSchema))
+	}
+	if actual := HashOutputSchema([]byte(s.JsonSchema)); !strings.EqualFold(actual, s.Hash) {
+		return fmt.Errorf("output schema hash %s does not match json schema hash %s", s.Hash, actual)
+	}
+	if err = jsonschema.ValidateSchema(s.JsonSchema); err != nil {
+		return fmt.Errorf("invalid output schema json schema: %w", err)
+	}
+	return nil
+}
+
+// ValidateOutput makes sure the provided record output conforms to this output schema.
+// Skipped outputs are not checked. Passing outputs must reference this schema's hash and,
+// if a json schema is available, must carry a payload that conforms to it.
+func (s OutputSchema) ValidateOutput(output RecordOutput) error {
+	if output.Status != ResultStatus_RESULT_STATUS_PASS {
+		return nil
+	}
+	if len(output.SchemaHash) == 0 {
+		return fmt.Errorf("missing schema hash, expected %s", s.Hash)
+	}
+	if !strings.EqualFold(output.SchemaHash, s.Hash) {
+		return fmt.Errorf("schema hash %s does not match the output schema hash %s", output.SchemaHash, s.Hash)
+	}
+	if len(s.JsonSchema) == 0 {
+		return nil
+	}
+	if len(output.Payload) == 0 {
+		return errors.New("missing payload, required by the output schema's json schema")
+	}
+	if err := jsonschema.Validate(s.JsonSchema, output.Payload); err != nil {
+		return fmt.Errorf("payload does not conform to the output schema: %w", err)
+	}
 	return nil
 }
 
@@ -63,6 +63,38 @@ func (DefinitionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1e2d1042057ea889, []int{0}
 }
 
+// OutputSchemaType indicates the kind of schema that record outputs must conform to
+type OutputSchemaType int32
+
+const (
+	// OUTPUT_SCHEMA_TYPE_UNSPECIFIED indicates an unknown/invalid value
+	OutputSchemaType_OUTPUT_SCHEMA_TYPE_UNSPECIFIED OutputSchemaType = 0
+	// OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR indicates the schema is a protobuf FileDescriptorSet
+	OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR OutputSchemaType = 1
+	// OUTPUT_SCHEMA_TYPE_JSON_SCHEMA indicates the schema is a JSON schema document
+	OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA OutputSchemaType = 2
+)
+
+var OutputSchemaType_name = map[int32]string{
+	0: "OUTPUT_SCHEMA_TYPE_UNSPECIFIED",
+	1: "OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR",
+	2: "OUTPUT_SCHEMA_TYPE_JSON_SCHEMA",
+}
+
+var OutputSchemaType_value = map[string]int32{
+	"OUTPUT_SCHEMA_TYPE_UNSPECIFIED":      0,
+	"OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR": 1,
+	"OUTPUT_SCHEMA_TYPE_JSON_SCHEMA":      2,
+}
+
+func (x OutputSchemaType) String() string {
+	return proto.EnumName(OutputSchemaType_name, int32(x))
+}
+
+func (OutputSchemaType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{1}
+}
+
 // PartyType are the different roles parties on a contract may use
 type PartyType int32
 
@@ -124,7 +156,7 @@ func (x PartyType) String() string {
 }
 
 func (PartyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{1}
+	return fileDescriptor_1e2d1042057ea889, []int{2}
 }
 
 // ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
@@ -139,6 +171,14 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids"`
+	// The version of this scope specification. Scopes can only be migrated to a scope specification with a higher version.
+	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether this scope specification is deprecated. New scopes cannot use a deprecated scope specification.
+	Deprecated bool `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// The id of the scope specification that this one succeeds. Scopes can only be migrated to a scope specification
+	// that succeeds their current one, either directly or through a chain of successors.
+	// Once set, it cannot be changed, and setting it requires signatures from the owners of the previous specification.
+	PreviousSpecId *MetadataAddress `protobuf:"bytes,8,opt,name=previous_spec_id,json=previousSpecId,proto3,customtype=MetadataAddress" json:"previous_spec_id,omitempty"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -194,6 +234,20 @@ func (m *ScopeSpecification) GetPartiesInvolved() []PartyType {
 	return nil
 }
 
+func (m *ScopeSpecification) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ScopeSpecification) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ContractSpecification struct {
 	// unique identifier for this specification on chain
@@ -208,12 +262,15 @@ type ContractSpecification struct {
 	// contract
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*ContractSpecification_ResourceId
 	//	*ContractSpecification_Hash
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// The version of this contract specification.
+	Version uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether this contract specification is deprecated. New sessions cannot use a deprecated contract specification.
+	Deprecated bool `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -313,6 +370,20 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ContractSpecification) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -335,6 +406,8 @@ type RecordSpecification struct {
 	ResultType DefinitionType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=provenance.metadata.v1.DefinitionType" json:"result_type,omitempty"`
 	// Type of party responsible for this record
 	ResponsibleParties []PartyType `protobuf:"varint,6,rep,packed,name=responsible_parties,json=responsibleParties,proto3,enum=provenance.metadata.v1.PartyType" json:"responsible_parties,omitempty"`
+	// The schema that the outputs of records using this specification must conform to (optional)
+	OutputSchema *OutputSchema `protobuf:"bytes,7,opt,name=output_schema,json=outputSchema,proto3" json:"output_schema,omitempty"`
 }
 
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
@@ -404,6 +477,78 @@ func (m *RecordSpecification) GetResponsibleParties() []PartyType {
 	return nil
 }
 
+func (m *RecordSpecification) GetOutputSchema() *OutputSchema {
+	if m != nil {
+		return m.OutputSchema
+	}
+	return nil
+}
+
+// OutputSchema identifies the schema that the outputs of a record must conform to.
+type OutputSchema struct {
+	// The kind of schema
+	Type OutputSchemaType `protobuf:"varint,1,opt,name=type,proto3,enum=provenance.metadata.v1.OutputSchemaType" json:"type,omitempty"`
+	// The hex encoded sha256 hash of the schema, i.e. of a serialized FileDescriptorSet or of a JSON schema document
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The JSON schema document itself (optional, only allowed with OUTPUT_SCHEMA_TYPE_JSON_SCHEMA). When provided, it
+	// must hash to the hash field, and record output payloads are validated against it.
+	JsonSchema string `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+}
+
+func (m *OutputSchema) Reset()         { *m = OutputSchema{} }
+func (m *OutputSchema) String() string { return proto.CompactTextString(m) }
+func (*OutputSchema) ProtoMessage()    {}
+func (*OutputSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{3}
+}
+func (m *OutputSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutputSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputSchema.Merge(m, src)
+}
+func (m *OutputSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputSchema proto.InternalMessageInfo
+
+func (m *OutputSchema) GetType() OutputSchemaType {
+	if m != nil {
+		return m.Type
+	}
+	return OutputSchemaType_OUTPUT_SCHEMA_TYPE_UNSPECIFIED
+}
+
+func (m *OutputSchema) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *OutputSchema) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
 // InputSpecification defines a name, type_name, and source reference (either on or off chain) to define an input
 // parameter
 type InputSpecification struct {
@@ -414,7 +559,6 @@ type InputSpecification struct {
 	// source is either on chain (record_id) or off-chain (hash)
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*InputSpecification_RecordId
 	//	*InputSpecification_Hash
 	Source isInputSpecification_Source `protobuf_oneof:"source"`
@@ -423,7 +567,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +664,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,10 +723,12 @@ func (m *Description) GetIconUrl() string {
 
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
+	proto.RegisterEnum("provenance.metadata.v1.OutputSchemaType", OutputSchemaType_name, OutputSchemaType_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
+	proto.RegisterType((*OutputSchema)(nil), "provenance.metadata.v1.OutputSchema")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
 }
@@ -592,63 +738,75 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x4d, 0x5e, 0x50, 0x6b, 0xa6, 0xdd, 0xae, 0xbb, 0x0b, 0x49, 0x28, 0x12,
-	0x44, 0x95, 0x9a, 0xa8, 0x81, 0x13, 0x37, 0x27, 0x71, 0xb7, 0x23, 0x65, 0xed, 0x68, 0xe2, 0x14,
-	0x2d, 0x17, 0xcb, 0xb5, 0x67, 0x5b, 0x6b, 0x13, 0x8f, 0xe5, 0x71, 0xb2, 0xf4, 0x04, 0x3f, 0x80,
-	0x03, 0x47, 0x8e, 0x48, 0x48, 0xfc, 0x06, 0x7e, 0xc2, 0x1e, 0xf7, 0x88, 0x10, 0xaa, 0x50, 0x2b,
-	0x7e, 0x02, 0x17, 0x4e, 0xc8, 0x63, 0xb7, 0x71, 0x42, 0x8a, 0x38, 0x70, 0xe4, 0xd4, 0x99, 0xf7,
-	0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x37, 0xe3, 0x06, 0x0e, 0x83, 0x90, 0xcd, 0xa9, 0x6f, 0xfb, 0x0e,
-	0x6d, 0x4f, 0x69, 0x64, 0xbb, 0x76, 0x64, 0xb7, 0xe7, 0xc7, 0x6d, 0x1e, 0x50, 0xc7, 0x7b, 0xe9,
-	0x39, 0x76, 0xe4, 0x31, 0xbf, 0x15, 0x84, 0x2c, 0x62, 0x68, 0x6f, 0xc1, 0x6d, 0xdd, 0x71, 0x5b,
-	0xf3, 0xe3, 0x27, 0xbb, 0x17, 0xec, 0x82, 0x09, 0x4a, 0x3b, 0x5e, 0x25, 0xec, 0x83, 0xdf, 0xf3,
-	0x80, 0x46, 0x0e, 0x0b, 0xe8, 0x28, 0x5b, 0x0a, 0x75, 0x41, 0x5e, 0xaa, 0x6d, 0x79, 0xae, 0x22,
-	0x35, 0xa4, 0xe6, 0x3b, 0xdd, 0xc7, 0x6f, 0xae, 0xeb, 0xb9, 0x5f, 0xae, 0xeb, 0xdb, 0xcf, 0xd3,
-	0xda, 0xaa, 0xeb, 0x86, 0x94, 0x73, 0xb2, 0xbd, 0x94, 0x80, 0x5d, 0xa4, 0x41, 0xd5, 0xa5, 0xdc,
-	0x09, 0xbd, 0x20, 0x0e, 0x28, 0xf9, 0x86, 0xd4, 0xac, 0x76, 0x3e, 0x6c, 0xad, 0x6f, 0xaf, 0xd5,
-	0x5f, 0x50, 0x49, 0x36, 0x0f, 0x7d, 0x0c, 0xdb, 0xec, 0xb5, 0x4f, 0x43, 0xcb, 0x4e, 0x0e, 0xa2,
-	0x5c, 0x29, 0x34, 0x0a, 0xcd, 0x0a, 0xd9, 0x12, 0x61, 0xf5, 0x2e, 0x8a, 0x06, 0x20, 0x07, 0x76,
-	0x18, 0x79, 0x94, 0x5b, 0x9e, 0x3f, 0x67, 0x93, 0x39, 0x75, 0x95, 0x62, 0xa3, 0xd0, 0xdc, 0xea,
-	0x7c, 0xf0, 0xd0, 0xa1, 0x43, 0x3b, 0x8c, 0xae, 0xcc, 0xab, 0x80, 0x92, 0xed, 0x34, 0x15, 0xa7,
-	0x99, 0xa8, 0x07, 0xef, 0x3a, 0xcc, 0x8f, 0x42, 0xdb, 0x89, 0xac, 0x78, 0x32, 0xcb, 0x73, 0xb9,
-	0xb2, 0xd1, 0x28, 0xfc, 0xa3, 0x04, 0x77, 0x19, 0xb1, 0x98, 0xd8, 0xe5, 0x9f, 0x95, 0xbf, 0xfb,
-	0xbe, 0x9e, 0xfb, 0xfa, 0xd7, 0x86, 0x74, 0xf0, 0x53, 0x01, 0x1e, 0xf5, 0x32, 0xe8, 0xff, 0x52,
-	0x2f, 0xa4, 0x36, 0xa1, 0x1a, 0x52, 0xce, 0x66, 0xa1, 0x43, 0xe3, 0xe1, 0x37, 0xc4, 0xf0, 0xc7,
-	0x7f, 0x5e, 0xd7, 0x8f, 0x2e, 0xbc, 0xe8, 0x72, 0x76, 0xde, 0x72, 0xd8, 0xb4, 0xed, 0x30, 0x3e,
-	0x65, 0x3c, 0xfd, 0x73, 0xc4, 0xdd, 0x57, 0xed, 0xe8, 0x2a, 0xa0, 0xbc, 0xa5, 0x3a, 0x4e, 0xda,
-	0xd7, 0x69, 0x8e, 0xc0, 0x5d, 0x1d, 0xec, 0xa2, 0x5d, 0x28, 0x5e, 0xda, 0xfc, 0x52, 0x29, 0x35,
-	0xa4, 0x66, 0xe5, 0x34, 0x47, 0xc4, 0x0e, 0xbd, 0x0f, 0xe0, 0x4c, 0x6c, 0xce, 0x2d, 0xdf, 0x9e,
-	0x52, 0x65, 0x33, 0xc6, 0x48, 0x45, 0x44, 0x74, 0x7b, 0x4a, 0x17, 0x86, 0x75, 0xcb, 0x50, 0x4a,
-	0x4a, 0x1d, 0xfc, 0x91, 0x87, 0x1d, 0x42, 0x1d, 0x16, 0xba, 0xff, 0xbd, 0x71, 0x08, 0x8a, 0xa2,
-	0x91, 0xbc, 0x68, 0x44, 0xac, 0x51, 0x17, 0x4a, 0x9e, 0x1f, 0xcc, 0xa2, 0x44, 0xfc, 0x6a, 0xe7,
-	0xf0, 0x21, 0x49, 0x71, 0xcc, 0x5a, 0xea, 0x89, 0xa4, 0x99, 0xe8, 0x29, 0x54, 0x62, 0x79, 0x92,
-	0x29, 0x8b, 0xa2, 0x78, 0x39, 0x0e, 0xc4, 0x43, 0xa2, 0x67, 0x42, 0xef, 0xd9, 0x24, 0xb2, 0xe2,
-	0x90, 0xd0, 0x7b, 0xab, 0xf3, 0xd1, 0xc3, 0xb7, 0xe5, 0xa5, 0xe7, 0x7b, 0x71, 0x75, 0xe1, 0x1e,
-	0x24, 0xa9, 0xf1, 0x1a, 0x11, 0xd8, 0x09, 0x29, 0x0f, 0x98, 0xcf, 0xbd, 0xf3, 0x09, 0xb5, 0x52,
-	0x5f, 0x95, 0xd2, 0xbf, 0xbd, 0x09, 0x28, 0x93, 0x3d, 0x4c, 0x92, 0x33, 0x4f, 0xe6, 0x07, 0x09,
-	0xd0, 0xdf, 0x47, 0xbc, 0x97, 0x4c, 0xca, 0x48, 0xb6, 0x34, 0x6e, 0x7e, 0x65, 0xdc, 0x0e, 0x54,
-	0x42, 0x61, 0x5f, 0x6c, 0x50, 0x41, 0x18, 0xb4, 0xb3, 0xc6, 0x9c, 0xd3, 0x1c, 0x29, 0x27, 0xbc,
-	0xcc, 0xe5, 0x29, 0x66, 0x2f, 0xcf, 0xda, 0xdb, 0xf1, 0x15, 0x54, 0x33, 0xef, 0x69, 0x6d, 0x77,
-	0x8d, 0xe5, 0xd7, 0x59, 0x10, 0xd0, 0xd2, 0xc3, 0xab, 0x43, 0xf5, 0x35, 0x3d, 0xe7, 0x5e, 0x44,
-	0xad, 0x59, 0x38, 0x49, 0x0d, 0x83, 0x34, 0x34, 0x0e, 0x27, 0x68, 0x1f, 0xca, 0x9e, 0xc3, 0x7c,
-	0x81, 0x6e, 0x08, 0x74, 0x33, 0xde, 0x8f, 0xc3, 0xc9, 0xe1, 0x37, 0x12, 0x6c, 0x2d, 0x7b, 0x84,
-	0xea, 0xf0, 0xb4, 0xaf, 0x9d, 0x60, 0x1d, 0x9b, 0xd8, 0xd0, 0x2d, 0xf3, 0xc5, 0x50, 0xb3, 0xc6,
-	0xfa, 0x68, 0xa8, 0xf5, 0xf0, 0x09, 0xd6, 0xfa, 0x72, 0x0e, 0xbd, 0x07, 0xca, 0x2a, 0x61, 0x48,
-	0x8c, 0xa1, 0x31, 0xd2, 0xfa, 0xb2, 0x84, 0x9e, 0xc0, 0xde, 0x2a, 0x4a, 0xb4, 0x9e, 0x41, 0xfa,
-	0x72, 0x7e, 0x5d, 0xe9, 0x04, 0xb3, 0x06, 0x78, 0x64, 0xca, 0x85, 0xc3, 0x1f, 0xf3, 0x50, 0xb9,
-	0x77, 0x38, 0x2e, 0x35, 0x54, 0x89, 0xf9, 0x62, 0x5d, 0x13, 0xfb, 0xf0, 0x28, 0x83, 0x19, 0x04,
-	0x3f, 0xc3, 0xba, 0x6a, 0x1a, 0x44, 0x96, 0xd0, 0x63, 0xd8, 0xc9, 0x40, 0x23, 0x8d, 0x9c, 0xe1,
-	0x9e, 0x46, 0xe4, 0xfc, 0x0a, 0x80, 0xf5, 0x33, 0x6d, 0x14, 0x67, 0x14, 0x90, 0x02, 0xbb, 0x19,
-	0xa0, 0x37, 0x1e, 0x99, 0x46, 0x1f, 0xab, 0xba, 0x5c, 0x44, 0xbb, 0x20, 0x67, 0x8f, 0xf9, 0x5c,
-	0xd7, 0x88, 0xbc, 0xb1, 0xc2, 0x57, 0x4f, 0x4e, 0xf0, 0x00, 0xab, 0xa6, 0x26, 0x97, 0xd0, 0x1e,
-	0xa0, 0x2c, 0xff, 0xb9, 0x8e, 0xbb, 0xe3, 0x91, 0xbc, 0xb9, 0xd2, 0xee, 0x90, 0x18, 0x67, 0x9a,
-	0xae, 0xea, 0x3d, 0x4d, 0x2e, 0xaf, 0x40, 0x3d, 0x43, 0x37, 0x89, 0x31, 0x18, 0x68, 0x44, 0x86,
-	0x95, 0x73, 0xce, 0xd4, 0x01, 0xee, 0x8b, 0x19, 0xab, 0xdd, 0x57, 0x6f, 0x6e, 0x6a, 0xd2, 0xdb,
-	0x9b, 0x9a, 0xf4, 0xdb, 0x4d, 0x4d, 0xfa, 0xf6, 0xb6, 0x96, 0x7b, 0x7b, 0x5b, 0xcb, 0xfd, 0x7c,
-	0x5b, 0xcb, 0xc1, 0xbe, 0xc7, 0x1e, 0x78, 0x3b, 0x43, 0xe9, 0x8b, 0x4f, 0x33, 0xdf, 0xc4, 0x05,
-	0xe9, 0xc8, 0x63, 0x99, 0x5d, 0xfb, 0xcb, 0xc5, 0xaf, 0x04, 0xf1, 0x95, 0x3c, 0x2f, 0x89, 0xff,
-	0xf6, 0x9f, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xe1, 0xcd, 0x6d, 0x49, 0x08, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x34, 0x4d, 0x5e, 0xba, 0xad, 0x99, 0x76, 0xbb, 0xe9, 0x2e, 0x24, 0xa1, 0x8b,
+	0xd8, 0xa8, 0x52, 0x13, 0x35, 0x70, 0x42, 0x70, 0xc8, 0x0f, 0x77, 0x6b, 0x94, 0xda, 0xd6, 0xd8,
+	0x29, 0x5a, 0x2e, 0x96, 0x6b, 0xcf, 0xb6, 0x66, 0x13, 0x8f, 0xe5, 0x71, 0xb2, 0xf4, 0x04, 0x88,
+	0x2b, 0x07, 0x8e, 0x1c, 0x91, 0x90, 0xf8, 0x3b, 0x38, 0xee, 0x71, 0x8f, 0x08, 0xa1, 0x0a, 0xb5,
+	0xff, 0x01, 0x47, 0x4e, 0xc8, 0xe3, 0x64, 0xe3, 0x84, 0x64, 0xb5, 0x07, 0x8e, 0x7b, 0xca, 0xcc,
+	0x7b, 0xdf, 0xfb, 0x35, 0xdf, 0x37, 0x13, 0xc3, 0x81, 0x1f, 0xd0, 0x31, 0xf1, 0x2c, 0xcf, 0x26,
+	0x8d, 0x21, 0x09, 0x2d, 0xc7, 0x0a, 0xad, 0xc6, 0xf8, 0xa8, 0xc1, 0x7c, 0x62, 0xbb, 0x4f, 0x5d,
+	0xdb, 0x0a, 0x5d, 0xea, 0xd5, 0xfd, 0x80, 0x86, 0x14, 0xed, 0xce, 0xb0, 0xf5, 0x29, 0xb6, 0x3e,
+	0x3e, 0xba, 0xbf, 0x73, 0x41, 0x2f, 0x28, 0x87, 0x34, 0xa2, 0x55, 0x8c, 0xde, 0xbf, 0xce, 0x00,
+	0xd2, 0x6d, 0xea, 0x13, 0x3d, 0x99, 0x0a, 0xb5, 0x41, 0x9c, 0xcb, 0x6d, 0xba, 0x4e, 0x49, 0xa8,
+	0x0a, 0xb5, 0x8d, 0xf6, 0xbd, 0x17, 0xd7, 0x95, 0xd4, 0x1f, 0xd7, 0x95, 0xad, 0xd3, 0x49, 0xee,
+	0x96, 0xe3, 0x04, 0x84, 0x31, 0xbc, 0x35, 0x17, 0x20, 0x3b, 0x48, 0x82, 0xa2, 0x43, 0x98, 0x1d,
+	0xb8, 0x7e, 0x64, 0x28, 0xa5, 0xab, 0x42, 0xad, 0xd8, 0x7c, 0x58, 0x5f, 0xde, 0x5e, 0xbd, 0x3b,
+	0x83, 0xe2, 0x64, 0x1c, 0x7a, 0x04, 0x5b, 0xf4, 0xb9, 0x47, 0x02, 0xd3, 0x8a, 0x0b, 0x11, 0x56,
+	0xca, 0x54, 0x33, 0xb5, 0x02, 0xde, 0xe4, 0xe6, 0xd6, 0xd4, 0x8a, 0x7a, 0x20, 0xfa, 0x56, 0x10,
+	0xba, 0x84, 0x99, 0xae, 0x37, 0xa6, 0x83, 0x31, 0x71, 0x4a, 0xd9, 0x6a, 0xa6, 0xb6, 0xd9, 0x7c,
+	0x7f, 0x55, 0x51, 0xcd, 0x0a, 0xc2, 0x2b, 0xe3, 0xca, 0x27, 0x78, 0x6b, 0x12, 0x2a, 0x4f, 0x22,
+	0x51, 0x07, 0xde, 0xb1, 0xa9, 0x17, 0x06, 0x96, 0x1d, 0x9a, 0xd1, 0x64, 0xa6, 0xeb, 0xb0, 0xd2,
+	0x5a, 0x35, 0xf3, 0xda, 0x23, 0x98, 0x46, 0x44, 0x87, 0x29, 0x3b, 0x0c, 0x95, 0x60, 0x7d, 0x4c,
+	0x02, 0x16, 0x8d, 0x9f, 0xab, 0x0a, 0xb5, 0x3b, 0x78, 0xba, 0x45, 0x65, 0x00, 0x87, 0xf8, 0x01,
+	0xb1, 0xad, 0x90, 0x38, 0xa5, 0xf5, 0xaa, 0x50, 0xcb, 0xe3, 0x84, 0x05, 0x7d, 0x06, 0xa2, 0x1f,
+	0x90, 0xb1, 0x4b, 0x47, 0x6c, 0x5a, 0xbe, 0x94, 0xe7, 0x04, 0x6c, 0x2f, 0xab, 0xbc, 0x39, 0x05,
+	0xc7, 0x95, 0x3f, 0xc9, 0xff, 0xf4, 0x73, 0x25, 0xf5, 0xed, 0x9f, 0x55, 0x61, 0xff, 0xef, 0x0c,
+	0xdc, 0xed, 0x24, 0xda, 0x7a, 0xcb, 0xf1, 0x8c, 0x63, 0x03, 0x8a, 0x01, 0x61, 0x74, 0x14, 0xd8,
+	0x24, 0x1a, 0x7e, 0x8d, 0x0f, 0x7f, 0xf4, 0xcf, 0x75, 0xe5, 0xf0, 0xc2, 0x0d, 0x2f, 0x47, 0xe7,
+	0x75, 0x9b, 0x0e, 0x1b, 0x36, 0x65, 0x43, 0xca, 0x26, 0x3f, 0x87, 0xcc, 0x79, 0xd6, 0x08, 0xaf,
+	0x7c, 0xc2, 0xea, 0x2d, 0xdb, 0x9e, 0xf4, 0x75, 0x92, 0xc2, 0x30, 0xcd, 0x23, 0x3b, 0x68, 0x07,
+	0xb2, 0x97, 0x16, 0xbb, 0xe4, 0x8c, 0x17, 0x4e, 0x52, 0x98, 0xef, 0xd0, 0x7b, 0x00, 0xf6, 0xc0,
+	0x62, 0xcc, 0xf4, 0xac, 0x21, 0xe1, 0x84, 0x17, 0x70, 0x81, 0x5b, 0x14, 0x6b, 0x48, 0x92, 0x4a,
+	0xc9, 0xbf, 0x4e, 0x29, 0x85, 0x45, 0xa5, 0xcc, 0xa8, 0x6e, 0xe7, 0x21, 0x17, 0x37, 0xb1, 0xff,
+	0x5b, 0x06, 0xb6, 0x31, 0xb1, 0x69, 0xe0, 0xfc, 0xff, 0x94, 0x23, 0xc8, 0xf2, 0x11, 0xd2, 0x7c,
+	0x04, 0xbe, 0x46, 0x6d, 0xc8, 0xb9, 0x9e, 0x3f, 0x0a, 0x63, 0xda, 0x8a, 0xcd, 0x83, 0x55, 0x64,
+	0xc8, 0x11, 0x6a, 0xae, 0x27, 0x3c, 0x89, 0x44, 0x0f, 0xa0, 0x10, 0x1d, 0x6c, 0x7c, 0x3e, 0x59,
+	0x9e, 0x3c, 0x1f, 0x19, 0xf8, 0xf1, 0x3c, 0xe6, 0x4c, 0x8d, 0x06, 0xa1, 0x19, 0x99, 0x38, 0x53,
+	0x9b, 0xcd, 0x0f, 0x57, 0xeb, 0xec, 0xa9, 0xeb, 0xb9, 0x51, 0x76, 0xce, 0x3b, 0xc4, 0xa1, 0xd1,
+	0x1a, 0x61, 0xd8, 0x0e, 0x08, 0xf3, 0xa9, 0xc7, 0xdc, 0xf3, 0x01, 0x31, 0x27, 0x8a, 0x28, 0xe5,
+	0xde, 0x54, 0x43, 0x28, 0x11, 0xad, 0xc5, 0xc1, 0x48, 0x86, 0x3b, 0x74, 0x14, 0xfa, 0xa3, 0xd0,
+	0x64, 0xf6, 0x25, 0x19, 0x5a, 0x9c, 0xdd, 0x62, 0xf3, 0x83, 0x55, 0xd9, 0x54, 0x0e, 0xd6, 0x39,
+	0x16, 0x6f, 0xd0, 0xc4, 0x2e, 0x71, 0x6f, 0xbf, 0x13, 0x60, 0x23, 0x09, 0x44, 0x9f, 0x42, 0x96,
+	0xcf, 0x2e, 0xf0, 0xd9, 0x6b, 0x6f, 0x92, 0x9c, 0x77, 0xcc, 0xa3, 0x22, 0xd6, 0xb8, 0x28, 0x27,
+	0xac, 0x45, 0x6b, 0x54, 0x81, 0xe2, 0x57, 0x8c, 0x7a, 0xd3, 0xae, 0x33, 0xdc, 0x05, 0x91, 0x29,
+	0x0e, 0xdf, 0xff, 0x45, 0x00, 0xf4, 0x5f, 0xc6, 0x5e, 0x29, 0x40, 0x48, 0x28, 0x60, 0x8e, 0xbd,
+	0xf4, 0x02, 0x7b, 0x4d, 0x28, 0x04, 0x5c, 0x8d, 0x91, 0xde, 0x32, 0x2b, 0x5f, 0xb1, 0x93, 0x14,
+	0xce, 0xc7, 0xb8, 0xc4, 0x2d, 0xca, 0x26, 0x6f, 0xd1, 0x52, 0xb1, 0x7f, 0x03, 0xc5, 0xc4, 0xc3,
+	0xb2, 0xb4, 0xbb, 0xea, 0xfc, 0x33, 0x15, 0x4f, 0x9a, 0x34, 0x45, 0x67, 0xf1, 0x9c, 0x9c, 0x33,
+	0x37, 0x24, 0xe6, 0x28, 0x18, 0x4c, 0xf4, 0x07, 0x13, 0x53, 0x3f, 0x18, 0xa0, 0x3d, 0xc8, 0xbb,
+	0x36, 0xf5, 0xb8, 0x77, 0x8d, 0x7b, 0xd7, 0xa3, 0x7d, 0x3f, 0x18, 0x1c, 0xfc, 0x20, 0xc0, 0xe6,
+	0xbc, 0xe4, 0x50, 0x05, 0x1e, 0x74, 0xa5, 0x63, 0x59, 0x91, 0x0d, 0x59, 0x55, 0x4c, 0xe3, 0x89,
+	0x26, 0x99, 0x7d, 0x45, 0xd7, 0xa4, 0x8e, 0x7c, 0x2c, 0x4b, 0x5d, 0x31, 0x85, 0xde, 0x85, 0xd2,
+	0x22, 0x40, 0xc3, 0xaa, 0xa6, 0xea, 0x52, 0x57, 0x14, 0xd0, 0x7d, 0xd8, 0x5d, 0xf4, 0x62, 0xa9,
+	0xa3, 0xe2, 0xae, 0x98, 0x5e, 0x96, 0x3a, 0xf6, 0x99, 0x3d, 0x59, 0x37, 0xc4, 0xcc, 0xc1, 0xf7,
+	0x02, 0x88, 0x8b, 0x2a, 0x40, 0xfb, 0x50, 0x56, 0xfb, 0x86, 0xd6, 0x37, 0x4c, 0xbd, 0x73, 0x22,
+	0x9d, 0xb6, 0x96, 0xf5, 0xf4, 0x08, 0x1e, 0x2e, 0xc1, 0x68, 0x58, 0x35, 0x54, 0xb3, 0x2b, 0xe9,
+	0x1d, 0x2c, 0x6b, 0x86, 0x8a, 0x45, 0x61, 0x45, 0xb2, 0xcf, 0x75, 0x55, 0x99, 0x18, 0xc4, 0xf4,
+	0xc1, 0xaf, 0x69, 0x28, 0xbc, 0xba, 0x36, 0xd1, 0x40, 0x5a, 0x0b, 0x1b, 0x4f, 0x96, 0x95, 0xdd,
+	0x83, 0xbb, 0x09, 0x9f, 0x8a, 0xe5, 0xc7, 0xb2, 0xd2, 0x8a, 0x0b, 0xdd, 0x83, 0xed, 0x84, 0x4b,
+	0x97, 0xf0, 0x99, 0xdc, 0x91, 0xb0, 0x98, 0x5e, 0x70, 0xc8, 0xca, 0x99, 0xa4, 0x47, 0x11, 0x19,
+	0x54, 0x82, 0x9d, 0x84, 0xa3, 0xd3, 0xd7, 0x0d, 0xb5, 0x2b, 0xb7, 0x14, 0x31, 0x8b, 0x76, 0x40,
+	0x4c, 0x96, 0xf9, 0x42, 0x91, 0xb0, 0xb8, 0xb6, 0x80, 0x6f, 0x1d, 0x1f, 0xcb, 0x3d, 0xb9, 0x65,
+	0x48, 0x62, 0x0e, 0xed, 0x02, 0x4a, 0xe2, 0x4f, 0x15, 0xb9, 0xdd, 0xd7, 0xc5, 0xf5, 0x85, 0x76,
+	0x35, 0xac, 0x9e, 0x49, 0x4a, 0x4b, 0xe9, 0x48, 0x62, 0x7e, 0xc1, 0xd5, 0x51, 0x15, 0x03, 0xab,
+	0xbd, 0x9e, 0x84, 0x45, 0x58, 0xa8, 0x73, 0xd6, 0xea, 0xc9, 0x5d, 0x3e, 0x63, 0xb1, 0xfd, 0xec,
+	0xc5, 0x4d, 0x59, 0x78, 0x79, 0x53, 0x16, 0xfe, 0xba, 0x29, 0x0b, 0x3f, 0xde, 0x96, 0x53, 0x2f,
+	0x6f, 0xcb, 0xa9, 0xdf, 0x6f, 0xcb, 0x29, 0xd8, 0x73, 0xe9, 0x8a, 0x5b, 0xae, 0x09, 0x5f, 0x7e,
+	0x9c, 0xf8, 0x8b, 0x9a, 0x81, 0x0e, 0x5d, 0x9a, 0xd8, 0x35, 0xbe, 0x9e, 0x7d, 0x2d, 0xf2, 0x3f,
+	0xad, 0xf3, 0x1c, 0xff, 0xea, 0xfb, 0xe8, 0xdf, 0x01, 0x00, 0xd1, 0xe4, 0xbf, 0x51, 0x51, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -671,6 +829,33 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreviousSpecId != nil {
+		{
+			size := m.PreviousSpecId.Size()
+			i -= size
+			if _, err := m.PreviousSpecId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSpecification(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Version != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -757,6 +942,21 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Version != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -875,21 +1075,33 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutputSchema != nil {
+		{
+			size, err := m.OutputSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSpecification(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ResponsibleParties) > 0 {
-		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.ResponsibleParties)*10)
+		var j8 int
 		for _, num := range m.ResponsibleParties {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSpecification(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSpecification(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -939,6 +1151,48 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutputSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.JsonSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InputSpecification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1111,6 +1365,16 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	if m.Version != 0 {
+		n += 1 + sovSpecification(uint64(m.Version))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	if m.PreviousSpecId != nil {
+		l = m.PreviousSpecId.Size()
+		n += 1 + l + sovSpecification(uint64(l))
+	}
 	return n
 }
 
@@ -1146,6 +1410,12 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovSpecification(uint64(m.Version))
+	}
+	if m.Deprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -1203,6 +1473,30 @@ func (m *RecordSpecification) Size() (n int) {
 		}
 		n += 1 + sovSpecification(uint64(l)) + l
 	}
+	if m.OutputSchema != nil {
+		l = m.OutputSchema.Size()
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSpecification(uint64(m.Type))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
 	return n
 }
 
@@ -1287,6 +1581,9 @@ func (this *ScopeSpecification) String() string {
 		`OwnerAddresses:` + fmt.Sprintf("%v", this.OwnerAddresses) + `,`,
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`ContractSpecIds:` + fmt.Sprintf("%v", this.ContractSpecIds) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
+		`PreviousSpecId:` + fmt.Sprintf("%v", this.PreviousSpecId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1302,6 +1599,8 @@ func (this *ContractSpecification) String() string {
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`ClassName:` + fmt.Sprintf("%v", this.ClassName) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1342,6 +1641,7 @@ func (this *RecordSpecification) String() string {
 		`TypeName:` + fmt.Sprintf("%v", this.TypeName) + `,`,
 		`ResultType:` + fmt.Sprintf("%v", this.ResultType) + `,`,
 		`ResponsibleParties:` + fmt.Sprintf("%v", this.ResponsibleParties) + `,`,
+		`OutputSchema:` + strings.Replace(fmt.Sprintf("%v", this.OutputSchema), "OutputSchema", "OutputSchema", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1620,6 +1920,80 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSpecId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.PreviousSpecId = &v
+			if err := m.PreviousSpecId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -1937,6 +2311,45 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -2206,6 +2619,175 @@ func (m *RecordSpecification) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResponsibleParties", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputSchema == nil {
+				m.OutputSchema = &OutputSchema{}
+			}
+			if err := m.OutputSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OutputSchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
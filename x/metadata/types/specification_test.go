@@ -39,6 +39,12 @@ func (s *SpecificationTestSuite) SetupSuite() {
 }
 
 func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
+	withPrevious := func(specID, previousID MetadataAddress) *ScopeSpecification {
+		spec := NewScopeSpecification(specID, nil, []string{specTestBech32}, []PartyType{PartyType_PARTY_TYPE_OWNER}, nil)
+		spec.PreviousSpecId = &previousID
+		return spec
+	}
+	selfSpecID := ScopeSpecMetadataAddress(uuid.New())
 	tests := []struct {
 		name string
 		spec *ScopeSpecification
@@ -159,6 +165,27 @@ func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
 			),
 			"invalid contract specification id prefix at index 2 (expected: contractspec, got scope)",
 		},
+		// previous spec id - optional, but must be a different scope spec id
+		{
+			"previous spec id - wrong address type",
+			withPrevious(ScopeSpecMetadataAddress(uuid.New()), MetadataAddress(specTestAddr)),
+			"invalid previous scope specification id: invalid metadata address type: 133",
+		},
+		{
+			"previous spec id - wrong prefix",
+			withPrevious(ScopeSpecMetadataAddress(uuid.New()), ContractSpecMetadataAddress(uuid.New())),
+			"invalid previous scope specification id prefix (expected: scopespec, got contractspec)",
+		},
+		{
+			"previous spec id - same as specification id",
+			withPrevious(selfSpecID, selfSpecID),
+			"a scope specification cannot be its own previous scope specification",
+		},
+		{
+			"previous spec id - valid",
+			withPrevious(ScopeSpecMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New())),
+			"",
+		},
 		// Simple valid case
 		{
 			"simple valid case",
@@ -691,6 +718,34 @@ func (s *SpecificationTestSuite) TestRecordSpecValidateBasic() {
 			},
 			"record specification result type cannot be unspecified",
 		},
+
+		// OutputSchema tests
+		{
+			"output schema - invalid",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				OutputSchema:       NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, "nothex", ""),
+			},
+			`invalid output schema: output schema hash "nothex" is not a hex encoded sha256 hash`,
+		},
+		{
+			"output schema - valid",
+			&RecordSpecification{
+				SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, "recspecname"),
+				Name:               "recspecname",
+				Inputs:             []*InputSpecification{},
+				TypeName:           "recspectypename",
+				ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				OutputSchema:       NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, HashOutputSchema([]byte("descriptor")), ""),
+			},
+			"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -706,6 +761,174 @@ func (s *SpecificationTestSuite) TestRecordSpecValidateBasic() {
 	}
 }
 
+func (s *SpecificationTestSuite) TestOutputSchemaValidateBasic() {
+	jsonSchema := `{"type":"object","required":["amount"],"properties":{"amount":{"type":"integer"}}}`
+	jsonSchemaHash := HashOutputSchema([]byte(jsonSchema))
+	protoHash := HashOutputSchema([]byte("descriptor"))
+
+	tests := []struct {
+		name   string
+		schema *OutputSchema
+		exp    string
+	}{
+		{
+			name:   "unspecified type",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_UNSPECIFIED, protoHash, ""),
+			exp:    "output schema type must be OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR or OUTPUT_SCHEMA_TYPE_JSON_SCHEMA",
+		},
+		{
+			name:   "unknown type",
+			schema: NewOutputSchema(OutputSchemaType(5), protoHash, ""),
+			exp:    "output schema type must be OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR or OUTPUT_SCHEMA_TYPE_JSON_SCHEMA",
+		},
+		{
+			name:   "empty hash",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, "", ""),
+			exp:    `output schema hash "" is not a hex encoded sha256 hash`,
+		},
+		{
+			name:   "hash wrong length",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, "abcdef", ""),
+			exp:    `output schema hash "abcdef" is not a hex encoded sha256 hash`,
+		},
+		{
+			name:   "proto descriptor hash only",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, protoHash, ""),
+			exp:    "",
+		},
+		{
+			name:   "json schema hash only",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, strings.ToUpper(jsonSchemaHash), ""),
+			exp:    "",
+		},
+		{
+			name:   "json schema with proto descriptor type",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR, jsonSchemaHash, jsonSchema),
+			exp:    "output schema json schema cannot be provided with type OUTPUT_SCHEMA_TYPE_PROTO_DESCRIPTOR",
+		},
+		{
+			name:   "json schema hash mismatch",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, protoHash, jsonSchema),
+			exp:    fmt.Sprintf("output schema hash %s does not match json schema hash %s", protoHash, jsonSchemaHash),
+		},
+		{
+			name: "json schema too long",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, jsonSchemaHash,
+				strings.Repeat(" ", maxOutputSchemaJSONSchemaLength+1)),
+			exp: fmt.Sprintf("output schema json schema exceeds maximum length (expected <= %d got: %d)",
+				maxOutputSchemaJSONSchemaLength, maxOutputSchemaJSONSchemaLength+1),
+		},
+		{
+			name:   "json schema not an object",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, HashOutputSchema([]byte(`"str"`)), `"str"`),
+			exp:    "invalid output schema json schema: ",
+		},
+		{
+			name:   "json schema",
+			schema: NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, jsonSchemaHash, jsonSchema),
+			exp:    "",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := tc.schema.ValidateBasic()
+			if len(tc.exp) > 0 {
+				s.Assert().ErrorContains(err, tc.exp, "ValidateBasic")
+			} else {
+				s.Assert().NoError(err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func (s *SpecificationTestSuite) TestOutputSchemaValidateOutput() {
+	jsonSchema := `{"type":"object","required":["amount"],"properties":{"amount":{"type":"integer"}}}`
+	jsonSchemaHash := HashOutputSchema([]byte(jsonSchema))
+	withJSON := NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, jsonSchemaHash, jsonSchema)
+	hashOnly := NewOutputSchema(OutputSchemaType_OUTPUT_SCHEMA_TYPE_JSON_SCHEMA, jsonSchemaHash, "")
+	pass := ResultStatus_RESULT_STATUS_PASS
+
+	tests := []struct {
+		name   string
+		schema *OutputSchema
+		output RecordOutput
+		exp    string
+	}{
+		{
+			name:   "skipped output",
+			schema: withJSON,
+			output: RecordOutput{Status: ResultStatus_RESULT_STATUS_SKIP},
+			exp:    "",
+		},
+		{
+			name:   "failed output",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: ResultStatus_RESULT_STATUS_FAIL},
+			exp:    "",
+		},
+		{
+			name:   "no schema hash",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass},
+			exp:    "missing schema hash, expected " + jsonSchemaHash,
+		},
+		{
+			name:   "wrong schema hash",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: "other"},
+			exp:    "schema hash other does not match the output schema hash " + jsonSchemaHash,
+		},
+		{
+			name:   "schema hash different case",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: strings.ToUpper(jsonSchemaHash), Payload: `{"amount":5}`},
+			exp:    "",
+		},
+		{
+			name:   "no payload with json schema",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: jsonSchemaHash},
+			exp:    "missing payload, required by the output schema's json schema",
+		},
+		{
+			name:   "no payload without json schema",
+			schema: hashOnly,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: jsonSchemaHash},
+			exp:    "",
+		},
+		{
+			name:   "conforming payload",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: jsonSchemaHash, Payload: `{"amount":5}`},
+			exp:    "",
+		},
+		{
+			name:   "non-conforming payload",
+			schema: withJSON,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: jsonSchemaHash, Payload: `{"amount":"five"}`},
+			exp:    "payload does not conform to the output schema: ",
+		},
+		{
+			name:   "payload without json schema",
+			schema: hashOnly,
+			output: RecordOutput{Hash: "hash", Status: pass, SchemaHash: jsonSchemaHash, Payload: `{"amount":"five"}`},
+			exp:    "",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := tc.schema.ValidateOutput(tc.output)
+			if len(tc.exp) > 0 {
+				s.Assert().ErrorContains(err, tc.exp, "ValidateOutput")
+			} else {
+				s.Assert().NoError(err, "ValidateOutput")
+			}
+		})
+	}
+}
+
 func (s *SpecificationTestSuite) TestInputSpecValidateBasic() {
 	tests := []struct {
 		name string
@@ -1158,6 +1381,9 @@ func (s *SpecificationTestSuite) TestScopeSpecString() {
 		"OwnerAddresses:[cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck]," +
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"ContractSpecIds:[contractspec1qd2qmt038k7yc0azq46htdlhgwzqg6cr9l]," +
+		"Version:0," +
+		"Deprecated:false," +
+		"PreviousSpecId:<nil>," +
 		"}"
 
 	var actual string
@@ -1195,6 +1421,8 @@ func (s *SpecificationTestSuite) TestContractSpecString() {
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"Source:<nil>," +
 		"ClassName:CS 201: Intro to Blockchain," +
+		"Version:0," +
+		"Deprecated:false," +
 		"}"
 	var actual string
 	testFunc := func() {
@@ -1239,6 +1467,7 @@ func (s *SpecificationTestSuite) TestRecordSpecString() {
 		"TypeName:sometype," +
 		"ResultType:DEFINITION_TYPE_RECORD," +
 		"ResponsibleParties:[PARTY_TYPE_CUSTODIAN PARTY_TYPE_INVESTOR]," +
+		"OutputSchema:<nil>," +
 		"}"
 
 	var actual string
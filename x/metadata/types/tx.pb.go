@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -207,6 +208,172 @@ func (m *MsgDeleteScopeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteScopeResponse proto.InternalMessageInfo
 
+// MsgWriteScopesRequest is the request type for the Msg/WriteScopes RPC method.
+type MsgWriteScopesRequest struct {
+	// scopes are the Scopes you want added or updated.
+	Scopes []Scope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgWriteScopesRequest) Reset()         { *m = MsgWriteScopesRequest{} }
+func (m *MsgWriteScopesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopesRequest) ProtoMessage()    {}
+func (*MsgWriteScopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{4}
+}
+func (m *MsgWriteScopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopesRequest.Merge(m, src)
+}
+func (m *MsgWriteScopesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopesRequest proto.InternalMessageInfo
+
+// MsgWriteScopesResponse is the response type for the Msg/WriteScopes RPC method.
+type MsgWriteScopesResponse struct {
+	// scope_id_infos contains information about the id/address of each scope that was added or updated.
+	// They are in the same order as the scopes in the request.
+	ScopeIdInfos []*ScopeIdInfo `protobuf:"bytes,1,rep,name=scope_id_infos,json=scopeIdInfos,proto3" json:"scope_id_infos,omitempty"`
+}
+
+func (m *MsgWriteScopesResponse) Reset()         { *m = MsgWriteScopesResponse{} }
+func (m *MsgWriteScopesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopesResponse) ProtoMessage()    {}
+func (*MsgWriteScopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{5}
+}
+func (m *MsgWriteScopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopesResponse.Merge(m, src)
+}
+func (m *MsgWriteScopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopesResponse proto.InternalMessageInfo
+
+func (m *MsgWriteScopesResponse) GetScopeIdInfos() []*ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfos
+	}
+	return nil
+}
+
+// MsgDeleteScopesRequest is the request type for the Msg/DeleteScopes RPC method.
+type MsgDeleteScopesRequest struct {
+	// scope_ids are the ids of the scopes to delete.
+	ScopeIds []MetadataAddress `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgDeleteScopesRequest) Reset()         { *m = MsgDeleteScopesRequest{} }
+func (m *MsgDeleteScopesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopesRequest) ProtoMessage()    {}
+func (*MsgDeleteScopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{6}
+}
+func (m *MsgDeleteScopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteScopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteScopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteScopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopesRequest.Merge(m, src)
+}
+func (m *MsgDeleteScopesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteScopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteScopesRequest proto.InternalMessageInfo
+
+// MsgDeleteScopesResponse is the response type for the Msg/DeleteScopes RPC method.
+type MsgDeleteScopesResponse struct {
+}
+
+func (m *MsgDeleteScopesResponse) Reset()         { *m = MsgDeleteScopesResponse{} }
+func (m *MsgDeleteScopesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopesResponse) ProtoMessage()    {}
+func (*MsgDeleteScopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{7}
+}
+func (m *MsgDeleteScopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteScopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteScopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteScopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopesResponse.Merge(m, src)
+}
+func (m *MsgDeleteScopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteScopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteScopesResponse proto.InternalMessageInfo
+
 // MsgAddScopeDataAccessRequest is the request to add data access AccAddress to scope
 type MsgAddScopeDataAccessRequest struct {
 	// scope MetadataAddress for updating data access
@@ -221,7 +388,7 @@ func (m *MsgAddScopeDataAccessRequest) Reset()         { *m = MsgAddScopeDataAcc
 func (m *MsgAddScopeDataAccessRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeDataAccessRequest) ProtoMessage()    {}
 func (*MsgAddScopeDataAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{4}
+	return fileDescriptor_3a3a0892f91e3036, []int{8}
 }
 func (m *MsgAddScopeDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +425,7 @@ func (m *MsgAddScopeDataAccessResponse) Reset()         { *m = MsgAddScopeDataAc
 func (m *MsgAddScopeDataAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeDataAccessResponse) ProtoMessage()    {}
 func (*MsgAddScopeDataAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{5}
+	return fileDescriptor_3a3a0892f91e3036, []int{9}
 }
 func (m *MsgAddScopeDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +468,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Reset()         { *m = MsgDeleteScopeD
 func (m *MsgDeleteScopeDataAccessRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeDataAccessRequest) ProtoMessage()    {}
 func (*MsgDeleteScopeDataAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{6}
+	return fileDescriptor_3a3a0892f91e3036, []int{10}
 }
 func (m *MsgDeleteScopeDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +505,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Reset()         { *m = MsgDeleteScope
 func (m *MsgDeleteScopeDataAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeDataAccessResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeDataAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{7}
+	return fileDescriptor_3a3a0892f91e3036, []int{11}
 }
 func (m *MsgDeleteScopeDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +548,7 @@ func (m *MsgAddScopeOwnerRequest) Reset()         { *m = MsgAddScopeOwnerRequest
 func (m *MsgAddScopeOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeOwnerRequest) ProtoMessage()    {}
 func (*MsgAddScopeOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{8}
+	return fileDescriptor_3a3a0892f91e3036, []int{12}
 }
 func (m *MsgAddScopeOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +585,7 @@ func (m *MsgAddScopeOwnerResponse) Reset()         { *m = MsgAddScopeOwnerRespon
 func (m *MsgAddScopeOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeOwnerResponse) ProtoMessage()    {}
 func (*MsgAddScopeOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{9}
+	return fileDescriptor_3a3a0892f91e3036, []int{13}
 }
 func (m *MsgAddScopeOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +628,7 @@ func (m *MsgDeleteScopeOwnerRequest) Reset()         { *m = MsgDeleteScopeOwnerR
 func (m *MsgDeleteScopeOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeOwnerRequest) ProtoMessage()    {}
 func (*MsgDeleteScopeOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{10}
+	return fileDescriptor_3a3a0892f91e3036, []int{14}
 }
 func (m *MsgDeleteScopeOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +665,7 @@ func (m *MsgDeleteScopeOwnerResponse) Reset()         { *m = MsgDeleteScopeOwner
 func (m *MsgDeleteScopeOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeOwnerResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{11}
+	return fileDescriptor_3a3a0892f91e3036, []int{15}
 }
 func (m *MsgDeleteScopeOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,7 +708,7 @@ func (m *MsgUpdateValueOwnersRequest) Reset()         { *m = MsgUpdateValueOwner
 func (m *MsgUpdateValueOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateValueOwnersRequest) ProtoMessage()    {}
 func (*MsgUpdateValueOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{12}
+	return fileDescriptor_3a3a0892f91e3036, []int{16}
 }
 func (m *MsgUpdateValueOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +745,7 @@ func (m *MsgUpdateValueOwnersResponse) Reset()         { *m = MsgUpdateValueOwne
 func (m *MsgUpdateValueOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateValueOwnersResponse) ProtoMessage()    {}
 func (*MsgUpdateValueOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{13}
+	return fileDescriptor_3a3a0892f91e3036, []int{17}
 }
 func (m *MsgUpdateValueOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +788,7 @@ func (m *MsgMigrateValueOwnerRequest) Reset()         { *m = MsgMigrateValueOwne
 func (m *MsgMigrateValueOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateValueOwnerRequest) ProtoMessage()    {}
 func (*MsgMigrateValueOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{14}
+	return fileDescriptor_3a3a0892f91e3036, []int{18}
 }
 func (m *MsgMigrateValueOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +825,7 @@ func (m *MsgMigrateValueOwnerResponse) Reset()         { *m = MsgMigrateValueOwn
 func (m *MsgMigrateValueOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateValueOwnerResponse) ProtoMessage()    {}
 func (*MsgMigrateValueOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{15}
+	return fileDescriptor_3a3a0892f91e3036, []int{19}
 }
 func (m *MsgMigrateValueOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,39 +854,28 @@ func (m *MsgMigrateValueOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMigrateValueOwnerResponse proto.InternalMessageInfo
 
-// MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
-type MsgWriteSessionRequest struct {
-	// session is the Session you want added or updated.
-	Session Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
-	// session. If provided, it must have both a scope and session_uuid. Those components will be used to create the
-	// MetadataAddress for the session which will override the session_id in the provided session. If not provided (or
-	// all empty), nothing special happens.
-	// If there is a value in session.session_id that is different from the one created from these components, an error is
-	// returned.
-	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty"`
-	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
-	// specification_id in the provided session. If not provided (or it is an empty string), nothing special happens.
-	// If there is a value in session.specification_id that is different from the one created from this uuid, an error is
-	// returned.
-	SpecUuid string `protobuf:"bytes,4,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
+// MsgMigrateScopeSpecificationRequest is the request to move one or more scopes to a newer scope specification.
+type MsgMigrateScopeSpecificationRequest struct {
+	// scope_ids are the scope metadata addresses of all scopes to be migrated.
+	ScopeIds []MetadataAddress `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids"`
+	// specification_id is the scope specification metadata address that the scopes will be migrated to.
+	SpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteSessionRequest) Reset()         { *m = MsgWriteSessionRequest{} }
-func (m *MsgWriteSessionRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteSessionRequest) ProtoMessage()    {}
-func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{16}
+func (m *MsgMigrateScopeSpecificationRequest) Reset()         { *m = MsgMigrateScopeSpecificationRequest{} }
+func (m *MsgMigrateScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopeSpecificationRequest) ProtoMessage()    {}
+func (*MsgMigrateScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{20}
 }
-func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteSessionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -729,42 +885,34 @@ func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteSessionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteSessionRequest.Merge(m, src)
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteSessionRequest) XXX_Size() int {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteSessionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteSessionRequest.DiscardUnknown(m)
+func (m *MsgMigrateScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteSessionRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMigrateScopeSpecificationRequest proto.InternalMessageInfo
 
-// SessionIDComponents contains fields for the components that make up a session id.
-type SessionIdComponents struct {
-	// scope is used to define the scope this session belongs to.
-	//
-	// Types that are valid to be assigned to ScopeIdentifier:
-	//	*SessionIdComponents_ScopeUuid
-	//	*SessionIdComponents_ScopeAddr
-	ScopeIdentifier isSessionIdComponents_ScopeIdentifier `protobuf_oneof:"scope_identifier"`
-	// session_uuid is a uuid string for identifying this session, e.g. "5803f8bc-6067-4eb5-951f-2121671c2ec0"
-	SessionUuid string `protobuf:"bytes,3,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+// MsgMigrateScopeSpecificationResponse is the response from migrating scopes to a newer scope specification.
+type MsgMigrateScopeSpecificationResponse struct {
 }
 
-func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
-func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
-func (*SessionIdComponents) ProtoMessage()    {}
-func (*SessionIdComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{17}
+func (m *MsgMigrateScopeSpecificationResponse) Reset()         { *m = MsgMigrateScopeSpecificationResponse{} }
+func (m *MsgMigrateScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgMigrateScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{21}
 }
-func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SessionIdComponents.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -774,88 +922,75 @@ func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SessionIdComponents) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SessionIdComponents.Merge(m, src)
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecificationResponse.Merge(m, src)
 }
-func (m *SessionIdComponents) XXX_Size() int {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SessionIdComponents) XXX_DiscardUnknown() {
-	xxx_messageInfo_SessionIdComponents.DiscardUnknown(m)
+func (m *MsgMigrateScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SessionIdComponents proto.InternalMessageInfo
+var xxx_messageInfo_MsgMigrateScopeSpecificationResponse proto.InternalMessageInfo
 
-type isSessionIdComponents_ScopeIdentifier interface {
-	isSessionIdComponents_ScopeIdentifier()
-	MarshalTo([]byte) (int, error)
-	Size() int
+// MsgAddScopeLienRequest is the request to put a lien on a scope.
+type MsgAddScopeLienRequest struct {
+	// lien is the lien to put on the scope.
+	Lien ScopeLien `protobuf:"bytes,1,opt,name=lien,proto3" json:"lien"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-type SessionIdComponents_ScopeUuid struct {
-	ScopeUuid string `protobuf:"bytes,1,opt,name=scope_uuid,json=scopeUuid,proto3,oneof" json:"scope_uuid,omitempty"`
+func (m *MsgAddScopeLienRequest) Reset()         { *m = MsgAddScopeLienRequest{} }
+func (m *MsgAddScopeLienRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddScopeLienRequest) ProtoMessage()    {}
+func (*MsgAddScopeLienRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{22}
 }
-type SessionIdComponents_ScopeAddr struct {
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3,oneof" json:"scope_addr,omitempty"`
+func (m *MsgAddScopeLienRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*SessionIdComponents_ScopeUuid) isSessionIdComponents_ScopeIdentifier() {}
-func (*SessionIdComponents_ScopeAddr) isSessionIdComponents_ScopeIdentifier() {}
-
-func (m *SessionIdComponents) GetScopeIdentifier() isSessionIdComponents_ScopeIdentifier {
-	if m != nil {
-		return m.ScopeIdentifier
+func (m *MsgAddScopeLienRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddScopeLienRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *SessionIdComponents) GetScopeUuid() string {
-	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeUuid); ok {
-		return x.ScopeUuid
-	}
-	return ""
+func (m *MsgAddScopeLienRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddScopeLienRequest.Merge(m, src)
 }
-
-func (m *SessionIdComponents) GetScopeAddr() string {
-	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeAddr); ok {
-		return x.ScopeAddr
-	}
-	return ""
+func (m *MsgAddScopeLienRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SessionIdComponents) GetSessionUuid() string {
-	if m != nil {
-		return m.SessionUuid
-	}
-	return ""
+func (m *MsgAddScopeLienRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddScopeLienRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*SessionIdComponents) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*SessionIdComponents_ScopeUuid)(nil),
-		(*SessionIdComponents_ScopeAddr)(nil),
-	}
-}
+var xxx_messageInfo_MsgAddScopeLienRequest proto.InternalMessageInfo
 
-// MsgWriteSessionResponse is the response type for the Msg/WriteSession RPC method.
-type MsgWriteSessionResponse struct {
-	// session_id_info contains information about the id/address of the session that was added or updated.
-	SessionIdInfo *SessionIdInfo `protobuf:"bytes,1,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty"`
+// MsgAddScopeLienResponse is the response from putting a lien on a scope.
+type MsgAddScopeLienResponse struct {
 }
 
-func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse{} }
-func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteSessionResponse) ProtoMessage()    {}
-func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{18}
+func (m *MsgAddScopeLienResponse) Reset()         { *m = MsgAddScopeLienResponse{} }
+func (m *MsgAddScopeLienResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddScopeLienResponse) ProtoMessage()    {}
+func (*MsgAddScopeLienResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
 }
-func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddScopeLienResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddScopeLienResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteSessionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddScopeLienResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -865,62 +1000,38 @@ func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteSessionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteSessionResponse.Merge(m, src)
+func (m *MsgAddScopeLienResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddScopeLienResponse.Merge(m, src)
 }
-func (m *MsgWriteSessionResponse) XXX_Size() int {
+func (m *MsgAddScopeLienResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteSessionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteSessionResponse.DiscardUnknown(m)
+func (m *MsgAddScopeLienResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddScopeLienResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteSessionResponse proto.InternalMessageInfo
-
-func (m *MsgWriteSessionResponse) GetSessionIdInfo() *SessionIdInfo {
-	if m != nil {
-		return m.SessionIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgAddScopeLienResponse proto.InternalMessageInfo
 
-// MsgWriteRecordRequest is the request type for the Msg/WriteRecord RPC method.
-type MsgWriteRecordRequest struct {
-	// record is the Record you want added or updated.
-	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
-	// signers is the list of address of those signing this request.
+// MsgReleaseScopeLienRequest is the request to release the lien on a scope.
+type MsgReleaseScopeLienRequest struct {
+	// scope_id is the scope metadata address of the scope with the lien.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// signers is the list of addresses of those signing this request.
 	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
-	// record. If provided, it must have both a scope and session_uuid. Those components will be used to create the
-	// MetadataAddress for the session which will override the session_id in the provided record. If not provided (or
-	// all empty), nothing special happens.
-	// If there is a value in record.session_id that is different from the one created from these components, an error is
-	// returned.
-	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty"`
-	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be combined with the record name to generate the MetadataAddress for the record specification
-	// which will override the specification_id in the provided record. If not provided (or it is an empty string),
-	// nothing special happens.
-	// If there is a value in record.specification_id that is different from the one created from this uuid and
-	// record.name, an error is returned.
-	ContractSpecUuid string `protobuf:"bytes,4,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty"`
-	// parties is the list of parties involved with this record.
-	// Deprecated: This field is ignored. The parties are identified in the session and as signers.
-	Parties []Party `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties"`
 }
 
-func (m *MsgWriteRecordRequest) Reset()         { *m = MsgWriteRecordRequest{} }
-func (m *MsgWriteRecordRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordRequest) ProtoMessage()    {}
-func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{19}
+func (m *MsgReleaseScopeLienRequest) Reset()         { *m = MsgReleaseScopeLienRequest{} }
+func (m *MsgReleaseScopeLienRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseScopeLienRequest) ProtoMessage()    {}
+func (*MsgReleaseScopeLienRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
 }
-func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseScopeLienRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseScopeLienRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseScopeLienRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -930,36 +1041,34 @@ func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordRequest.Merge(m, src)
+func (m *MsgReleaseScopeLienRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseScopeLienRequest.Merge(m, src)
 }
-func (m *MsgWriteRecordRequest) XXX_Size() int {
+func (m *MsgReleaseScopeLienRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordRequest.DiscardUnknown(m)
+func (m *MsgReleaseScopeLienRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseScopeLienRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgReleaseScopeLienRequest proto.InternalMessageInfo
 
-// MsgWriteRecordResponse is the response type for the Msg/WriteRecord RPC method.
-type MsgWriteRecordResponse struct {
-	// record_id_info contains information about the id/address of the record that was added or updated.
-	RecordIdInfo *RecordIdInfo `protobuf:"bytes,1,opt,name=record_id_info,json=recordIdInfo,proto3" json:"record_id_info,omitempty"`
+// MsgReleaseScopeLienResponse is the response from releasing the lien on a scope.
+type MsgReleaseScopeLienResponse struct {
 }
 
-func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{} }
-func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordResponse) ProtoMessage()    {}
-func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{20}
+func (m *MsgReleaseScopeLienResponse) Reset()         { *m = MsgReleaseScopeLienResponse{} }
+func (m *MsgReleaseScopeLienResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseScopeLienResponse) ProtoMessage()    {}
+func (*MsgReleaseScopeLienResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
 }
-func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReleaseScopeLienResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReleaseScopeLienResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReleaseScopeLienResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -969,43 +1078,40 @@ func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordResponse.Merge(m, src)
+func (m *MsgReleaseScopeLienResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseScopeLienResponse.Merge(m, src)
 }
-func (m *MsgWriteRecordResponse) XXX_Size() int {
+func (m *MsgReleaseScopeLienResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordResponse.DiscardUnknown(m)
+func (m *MsgReleaseScopeLienResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseScopeLienResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordResponse proto.InternalMessageInfo
-
-func (m *MsgWriteRecordResponse) GetRecordIdInfo() *RecordIdInfo {
-	if m != nil {
-		return m.RecordIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgReleaseScopeLienResponse proto.InternalMessageInfo
 
-// MsgDeleteRecordRequest is the request type for the Msg/DeleteRecord RPC method.
-type MsgDeleteRecordRequest struct {
-	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id"`
-	Signers  []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgFractionalizeScopeValueRequest is the request to split the value of a scope into multiple units.
+type MsgFractionalizeScopeValueRequest struct {
+	// scope_id is the scope metadata address of the scope to split the value of.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// units is the total number of value units that the scope should have. It must be more than one.
+	Units uint64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteRecordRequest) Reset()         { *m = MsgDeleteRecordRequest{} }
-func (m *MsgDeleteRecordRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordRequest) ProtoMessage()    {}
-func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{21}
+func (m *MsgFractionalizeScopeValueRequest) Reset()         { *m = MsgFractionalizeScopeValueRequest{} }
+func (m *MsgFractionalizeScopeValueRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFractionalizeScopeValueRequest) ProtoMessage()    {}
+func (*MsgFractionalizeScopeValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
 }
-func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgFractionalizeScopeValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFractionalizeScopeValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFractionalizeScopeValueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1015,34 +1121,34 @@ func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordRequest.Merge(m, src)
+func (m *MsgFractionalizeScopeValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFractionalizeScopeValueRequest.Merge(m, src)
 }
-func (m *MsgDeleteRecordRequest) XXX_Size() int {
+func (m *MsgFractionalizeScopeValueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordRequest.DiscardUnknown(m)
+func (m *MsgFractionalizeScopeValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFractionalizeScopeValueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgFractionalizeScopeValueRequest proto.InternalMessageInfo
 
-// MsgDeleteRecordResponse is the response type for the Msg/DeleteRecord RPC method.
-type MsgDeleteRecordResponse struct {
+// MsgFractionalizeScopeValueResponse is the response from splitting the value of a scope into multiple units.
+type MsgFractionalizeScopeValueResponse struct {
 }
 
-func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse{} }
-func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordResponse) ProtoMessage()    {}
-func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{22}
+func (m *MsgFractionalizeScopeValueResponse) Reset()         { *m = MsgFractionalizeScopeValueResponse{} }
+func (m *MsgFractionalizeScopeValueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFractionalizeScopeValueResponse) ProtoMessage()    {}
+func (*MsgFractionalizeScopeValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
 }
-func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFractionalizeScopeValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFractionalizeScopeValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFractionalizeScopeValueResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,45 +1158,51 @@ func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordResponse.Merge(m, src)
+func (m *MsgFractionalizeScopeValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFractionalizeScopeValueResponse.Merge(m, src)
 }
-func (m *MsgDeleteRecordResponse) XXX_Size() int {
+func (m *MsgFractionalizeScopeValueResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordResponse.DiscardUnknown(m)
+func (m *MsgFractionalizeScopeValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFractionalizeScopeValueResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFractionalizeScopeValueResponse proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationRequest struct {
-	// specification is the ScopeSpecification you want added or updated.
-	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+// MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
+type MsgWriteSessionRequest struct {
+	// session is the Session you want added or updated.
+	Session Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session"`
 	// signers is the list of address of those signing this request.
 	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
-	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
+	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
+	// session. If provided, it must have both a scope and session_uuid. Those components will be used to create the
+	// MetadataAddress for the session which will override the session_id in the provided session. If not provided (or
+	// all empty), nothing special happens.
+	// If there is a value in session.session_id that is different from the one created from these components, an error is
+	// returned.
+	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
+	// specification_id in the provided session. If not provided (or it is an empty string), nothing special happens.
+	// If there is a value in session.specification_id that is different from the one created from this uuid, an error is
+	// returned.
+	SpecUuid string `protobuf:"bytes,4,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Reset()         { *m = MsgWriteScopeSpecificationRequest{} }
-func (m *MsgWriteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteScopeSpecificationRequest) ProtoMessage()    {}
-func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+func (m *MsgWriteSessionRequest) Reset()         { *m = MsgWriteSessionRequest{} }
+func (m *MsgWriteSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteSessionRequest) ProtoMessage()    {}
+func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteSessionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1100,36 +1212,42 @@ func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
+func (m *MsgWriteSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionRequest.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteSessionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteSessionRequest proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationResponse struct {
-	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
-	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty"`
+// SessionIDComponents contains fields for the components that make up a session id.
+type SessionIdComponents struct {
+	// scope is used to define the scope this session belongs to.
+	//
+	// Types that are valid to be assigned to ScopeIdentifier:
+	//	*SessionIdComponents_ScopeUuid
+	//	*SessionIdComponents_ScopeAddr
+	ScopeIdentifier isSessionIdComponents_ScopeIdentifier `protobuf_oneof:"scope_identifier"`
+	// session_uuid is a uuid string for identifying this session, e.g. "5803f8bc-6067-4eb5-951f-2121671c2ec0"
+	SessionUuid string `protobuf:"bytes,3,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
-func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
+func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
+func (*SessionIdComponents) ProtoMessage()    {}
+func (*SessionIdComponents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SessionIdComponents.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1139,81 +1257,88 @@ func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
+func (m *SessionIdComponents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionIdComponents.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
+func (m *SessionIdComponents) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *SessionIdComponents) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionIdComponents.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_SessionIdComponents proto.InternalMessageInfo
 
-func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
+type isSessionIdComponents_ScopeIdentifier interface {
+	isSessionIdComponents_ScopeIdentifier()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SessionIdComponents_ScopeUuid struct {
+	ScopeUuid string `protobuf:"bytes,1,opt,name=scope_uuid,json=scopeUuid,proto3,oneof" json:"scope_uuid,omitempty"`
+}
+type SessionIdComponents_ScopeAddr struct {
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3,oneof" json:"scope_addr,omitempty"`
+}
+
+func (*SessionIdComponents_ScopeUuid) isSessionIdComponents_ScopeIdentifier() {}
+func (*SessionIdComponents_ScopeAddr) isSessionIdComponents_ScopeIdentifier() {}
+
+func (m *SessionIdComponents) GetScopeIdentifier() isSessionIdComponents_ScopeIdentifier {
 	if m != nil {
-		return m.ScopeSpecIdInfo
+		return m.ScopeIdentifier
 	}
 	return nil
 }
 
-// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationRequest struct {
-	// MetadataAddress for the scope specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *SessionIdComponents) GetScopeUuid() string {
+	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeUuid); ok {
+		return x.ScopeUuid
+	}
+	return ""
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Reset()         { *m = MsgDeleteScopeSpecificationRequest{} }
-func (m *MsgDeleteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteScopeSpecificationRequest) ProtoMessage()    {}
-func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
-}
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *SessionIdComponents) GetScopeAddr() string {
+	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeAddr); ok {
+		return x.ScopeAddr
+	}
+	return ""
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *SessionIdComponents) GetSessionUuid() string {
+	if m != nil {
+		return m.SessionUuid
 	}
-}
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
-}
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SessionIdComponents) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SessionIdComponents_ScopeUuid)(nil),
+		(*SessionIdComponents_ScopeAddr)(nil),
+	}
+}
 
-// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationResponse struct {
+// MsgWriteSessionResponse is the response type for the Msg/WriteSession RPC method.
+type MsgWriteSessionResponse struct {
+	// session_id_info contains information about the id/address of the session that was added or updated.
+	SessionIdInfo *SessionIdInfo `protobuf:"bytes,1,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty"`
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
-func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse{} }
+func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteSessionResponse) ProtoMessage()    {}
+func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteSessionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1223,45 +1348,62 @@ func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
+func (m *MsgWriteSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionResponse.Merge(m, src)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteSessionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteSessionResponse proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationRequest struct {
-	// specification is the ContractSpecification you want added or updated.
-	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+func (m *MsgWriteSessionResponse) GetSessionIdInfo() *SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfo
+	}
+	return nil
+}
+
+// MsgWriteRecordRequest is the request type for the Msg/WriteRecord RPC method.
+type MsgWriteRecordRequest struct {
+	// record is the Record you want added or updated.
+	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 	// signers is the list of address of those signing this request.
 	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
+	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
+	// record. If provided, it must have both a scope and session_uuid. Those components will be used to create the
+	// MetadataAddress for the session which will override the session_id in the provided record. If not provided (or
+	// all empty), nothing special happens.
+	// If there is a value in record.session_id that is different from the one created from these components, an error is
+	// returned.
+	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty"`
+	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be combined with the record name to generate the MetadataAddress for the record specification
+	// which will override the specification_id in the provided record. If not provided (or it is an empty string),
+	// nothing special happens.
+	// If there is a value in record.specification_id that is different from the one created from this uuid and
+	// record.name, an error is returned.
+	ContractSpecUuid string `protobuf:"bytes,4,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty"`
+	// parties is the list of parties involved with this record.
+	// Deprecated: This field is ignored. The parties are identified in the session and as signers.
+	Parties []Party `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties"`
 }
 
-func (m *MsgWriteContractSpecificationRequest) Reset()         { *m = MsgWriteContractSpecificationRequest{} }
-func (m *MsgWriteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteContractSpecificationRequest) ProtoMessage()    {}
-func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+func (m *MsgWriteRecordRequest) Reset()         { *m = MsgWriteRecordRequest{} }
+func (m *MsgWriteRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordRequest) ProtoMessage()    {}
+func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1271,37 +1413,36 @@ func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
+func (m *MsgWriteRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordRequest.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordRequest proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationResponse struct {
-	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
-	// updated.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty"`
+// MsgWriteRecordResponse is the response type for the Msg/WriteRecord RPC method.
+type MsgWriteRecordResponse struct {
+	// record_id_info contains information about the id/address of the record that was added or updated.
+	RecordIdInfo *RecordIdInfo `protobuf:"bytes,1,opt,name=record_id_info,json=recordIdInfo,proto3" json:"record_id_info,omitempty"`
 }
 
-func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
-func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{} }
+func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordResponse) ProtoMessage()    {}
+func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1311,46 +1452,43 @@ func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
+func (m *MsgWriteRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordResponse.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordResponse proto.InternalMessageInfo
 
-func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+func (m *MsgWriteRecordResponse) GetRecordIdInfo() *RecordIdInfo {
 	if m != nil {
-		return m.ContractSpecIdInfo
+		return m.RecordIdInfo
 	}
 	return nil
 }
 
-// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgDeleteRecordRequest is the request type for the Msg/DeleteRecord RPC method.
+type MsgDeleteRecordRequest struct {
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id"`
+	Signers  []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Reset()         { *m = MsgAddContractSpecToScopeSpecRequest{} }
-func (m *MsgAddContractSpecToScopeSpecRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage()    {}
-func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+func (m *MsgDeleteRecordRequest) Reset()         { *m = MsgDeleteRecordRequest{} }
+func (m *MsgDeleteRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordRequest) ProtoMessage()    {}
+func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1360,34 +1498,34 @@ func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
+func (m *MsgDeleteRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordRequest.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
+func (m *MsgDeleteRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordRequest proto.InternalMessageInfo
 
-// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecResponse struct {
+// MsgDeleteRecordResponse is the response type for the Msg/DeleteRecord RPC method.
+type MsgDeleteRecordResponse struct {
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
-func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
-func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse{} }
+func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1397,43 +1535,38 @@ func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
+func (m *MsgDeleteRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordResponse.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
+func (m *MsgDeleteRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
-type MsgDeleteContractSpecFromScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteRecordsRequest is the request type for the Msg/WriteRecords RPC method.
+type MsgWriteRecordsRequest struct {
+	// records are the Records you want added or updated.
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
+func (m *MsgWriteRecordsRequest) Reset()         { *m = MsgWriteRecordsRequest{} }
+func (m *MsgWriteRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordsRequest) ProtoMessage()    {}
+func (*MsgWriteRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
-}
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1443,39 +1576,37 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
+func (m *MsgWriteRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordsRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
+func (m *MsgWriteRecordsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordsRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
-// method.
-type MsgDeleteContractSpecFromScopeSpecResponse struct {
+// MsgWriteRecordsResponse is the response type for the Msg/WriteRecords RPC method.
+type MsgWriteRecordsResponse struct {
+	// record_id_infos contains information about the id/address of each record that was added or updated.
+	// They are in the same order as the records in the request.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,1,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty"`
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
-}
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+func (m *MsgWriteRecordsResponse) Reset()         { *m = MsgWriteRecordsResponse{} }
+func (m *MsgWriteRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordsResponse) ProtoMessage()    {}
+func (*MsgWriteRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1485,37 +1616,45 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
+func (m *MsgWriteRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordsResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
+func (m *MsgWriteRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordsResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationRequest struct {
-	// MetadataAddress for the contract specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgWriteRecordsResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
+	}
+	return nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Reset()         { *m = MsgDeleteContractSpecificationRequest{} }
-func (m *MsgDeleteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContractSpecificationRequest) ProtoMessage()    {}
-func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+// MsgDeleteRecordsRequest is the request type for the Msg/DeleteRecords RPC method.
+type MsgDeleteRecordsRequest struct {
+	// record_ids are the ids of the records to delete.
+	RecordIds []MetadataAddress `protobuf:"bytes,1,rep,name=record_ids,json=recordIds,proto3,customtype=MetadataAddress" json:"record_ids"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgDeleteRecordsRequest) Reset()         { *m = MsgDeleteRecordsRequest{} }
+func (m *MsgDeleteRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordsRequest) ProtoMessage()    {}
+func (*MsgDeleteRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
+}
+func (m *MsgDeleteRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1525,36 +1664,34 @@ func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
+func (m *MsgDeleteRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordsRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
+func (m *MsgDeleteRecordsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordsRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationResponse struct {
+// MsgDeleteRecordsResponse is the response type for the Msg/DeleteRecords RPC method.
+type MsgDeleteRecordsResponse struct {
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Reset() {
-	*m = MsgDeleteContractSpecificationResponse{}
-}
-func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+func (m *MsgDeleteRecordsResponse) Reset()         { *m = MsgDeleteRecordsResponse{} }
+func (m *MsgDeleteRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordsResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1564,45 +1701,45 @@ func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
+func (m *MsgDeleteRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordsResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgDeleteRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordsResponse proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationRequest struct {
-	// specification is the RecordSpecification you want added or updated.
-	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationRequest struct {
+	// specification is the ScopeSpecification you want added or updated.
+	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
 	// signers is the list of address of those signing this request.
 	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
-	// specification which will override the specification_id in the provided specification. If not provided (or it is an
-	// empty string), nothing special happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid and
-	// specification.name, an error is returned.
-	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty"`
+	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
+	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Reset()         { *m = MsgWriteRecordSpecificationRequest{} }
-func (m *MsgWriteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordSpecificationRequest) ProtoMessage()    {}
-func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+func (m *MsgWriteScopeSpecificationRequest) Reset()         { *m = MsgWriteScopeSpecificationRequest{} }
+func (m *MsgWriteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeSpecificationRequest) ProtoMessage()    {}
+func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1612,37 +1749,36 @@ func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationResponse struct {
-	// record_spec_id_info contains information about the id/address of the record specification that was added or
-	// updated.
-	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty"`
+// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationResponse struct {
+	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
+	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty"`
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
-func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
+func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,44 +1788,44 @@ func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
+func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
 	if m != nil {
-		return m.RecordSpecIdInfo
+		return m.ScopeSpecIdInfo
 	}
 	return nil
 }
 
-// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationRequest struct {
-	// MetadataAddress for the record specification to delete.
+// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationRequest struct {
+	// MetadataAddress for the scope specification to delete.
 	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
 	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Reset()         { *m = MsgDeleteRecordSpecificationRequest{} }
-func (m *MsgDeleteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordSpecificationRequest) ProtoMessage()    {}
-func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+func (m *MsgDeleteScopeSpecificationRequest) Reset()         { *m = MsgDeleteScopeSpecificationRequest{} }
+func (m *MsgDeleteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopeSpecificationRequest) ProtoMessage()    {}
+func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1699,34 +1835,34 @@ func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationResponse struct {
+// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationResponse struct {
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
-func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
+func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1736,36 +1872,45 @@ func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
 
-// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationRequest struct {
+	// specification is the ContractSpecification you want added or updated.
+	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty"`
 }
 
-func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
-func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
-func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+func (m *MsgWriteContractSpecificationRequest) Reset()         { *m = MsgWriteContractSpecificationRequest{} }
+func (m *MsgWriteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteContractSpecificationRequest) ProtoMessage()    {}
+func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1775,35 +1920,37 @@ func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
+func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Size() int {
+func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty"`
 }
 
-func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
-func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
-func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
+func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1813,43 +1960,46 @@ func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
+func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgBindOSLocatorResponse) XXX_Size() int {
+func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
+func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
 	if m != nil {
-		return m.Locator
+		return m.ContractSpecIdInfo
 	}
-	return ObjectStoreLocator{}
+	return nil
 }
 
-// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorRequest struct {
-	// The record being removed
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
-func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+func (m *MsgAddContractSpecToScopeSpecRequest) Reset()         { *m = MsgAddContractSpecToScopeSpecRequest{} }
+func (m *MsgAddContractSpecToScopeSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage()    {}
+func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1859,35 +2009,34 @@ func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
 
-// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecResponse struct {
 }
 
-func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
-func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
+func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
+func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1897,43 +2046,43 @@ func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
 
-func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
+// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
+type MsgDeleteContractSpecFromScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
 }
-
-func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
-func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
-func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
+}
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1943,35 +2092,39 @@ func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
 
-// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
+// method.
+type MsgDeleteContractSpecFromScopeSpecResponse struct {
 }
 
-func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
-func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
-func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
+}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1981,48 +2134,37 @@ func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
 
-func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
+// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationRequest struct {
+	// MetadataAddress for the contract specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-// MsgSetAccountDataRequest is the request to set/update/delete a scope's account data.
-type MsgSetAccountDataRequest struct {
-	// The identifier to associate the data with.
-	// Currently, only scope ids are supported.
-	MetadataAddr MetadataAddress `protobuf:"bytes,1,opt,name=metadata_addr,json=metadataAddr,proto3,customtype=MetadataAddress" json:"metadata_addr"`
-	// The desired accountdata value.
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	// The signers of this message. Must fulfill owner requirements of the scope.
-	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgDeleteContractSpecificationRequest) Reset()         { *m = MsgDeleteContractSpecificationRequest{} }
+func (m *MsgDeleteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteContractSpecificationRequest) ProtoMessage()    {}
+func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
-
-func (m *MsgSetAccountDataRequest) Reset()         { *m = MsgSetAccountDataRequest{} }
-func (m *MsgSetAccountDataRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgSetAccountDataRequest) ProtoMessage()    {}
-func (*MsgSetAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{45}
-}
-func (m *MsgSetAccountDataRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetAccountDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetAccountDataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2032,34 +2174,36 @@ func (m *MsgSetAccountDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSetAccountDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetAccountDataRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgSetAccountDataRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetAccountDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetAccountDataRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetAccountDataRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgSetAccountDataResponse is the response from setting/updating/deleting a scope's account data.
-type MsgSetAccountDataResponse struct {
+// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationResponse struct {
 }
 
-func (m *MsgSetAccountDataResponse) Reset()         { *m = MsgSetAccountDataResponse{} }
-func (m *MsgSetAccountDataResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetAccountDataResponse) ProtoMessage()    {}
-func (*MsgSetAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{46}
+func (m *MsgDeleteContractSpecificationResponse) Reset() {
+	*m = MsgDeleteContractSpecificationResponse{}
 }
-func (m *MsgSetAccountDataResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
+}
+func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetAccountDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetAccountDataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2069,39 +2213,45 @@ func (m *MsgSetAccountDataResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSetAccountDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetAccountDataResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgSetAccountDataResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetAccountDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetAccountDataResponse.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetAccountDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
 
-// MsgWriteP8eContractSpecRequest has been deprecated and is no longer usable.
-// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
-//
-// Deprecated: Do not use.
-type MsgWriteP8EContractSpecRequest struct {
-	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
-	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationRequest struct {
+	// specification is the RecordSpecification you want added or updated.
+	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
+	// specification which will override the specification_id in the provided specification. If not provided (or it is an
+	// empty string), nothing special happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid and
+	// specification.name, an error is returned.
+	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty"`
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Reset()         { *m = MsgWriteP8EContractSpecRequest{} }
-func (m *MsgWriteP8EContractSpecRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteP8EContractSpecRequest) ProtoMessage()    {}
-func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{47}
+func (m *MsgWriteRecordSpecificationRequest) Reset()         { *m = MsgWriteRecordSpecificationRequest{} }
+func (m *MsgWriteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordSpecificationRequest) ProtoMessage()    {}
+func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2111,39 +2261,37 @@ func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
+func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgWriteP8eContractSpecResponse  has been deprecated and is no longer usable.
-// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
-//
-// Deprecated: Do not use.
-type MsgWriteP8EContractSpecResponse struct {
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty"`
-	RecordSpecIdInfos  []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty"`
+// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationResponse struct {
+	// record_spec_id_info contains information about the id/address of the record specification that was added or
+	// updated.
+	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty"`
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
-func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
-func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{48}
+func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
+func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2153,44 +2301,44 @@ func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
+func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
 
-// MsgP8eMemorializeContractRequest  has been deprecated and is no longer usable.
-// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
-//
-// Deprecated: Do not use.
-type MsgP8EMemorializeContractRequest struct {
-	ScopeId              string            `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	GroupId              string            `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ScopeSpecificationId string            `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
-	Recitals             *p8e.Recitals     `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
-	Contract             *p8e.Contract     `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
-	Signatures           *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
-	Invoker              string            `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
+func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
+	if m != nil {
+		return m.RecordSpecIdInfo
+	}
+	return nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Reset()         { *m = MsgP8EMemorializeContractRequest{} }
-func (m *MsgP8EMemorializeContractRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgP8EMemorializeContractRequest) ProtoMessage()    {}
-func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{49}
+// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationRequest struct {
+	// MetadataAddress for the record specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgDeleteRecordSpecificationRequest) Reset()         { *m = MsgDeleteRecordSpecificationRequest{} }
+func (m *MsgDeleteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordSpecificationRequest) ProtoMessage()    {}
+func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
+}
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2200,40 +2348,34 @@ func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgP8eMemorializeContractResponse  has been deprecated and is no longer usable.
-// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
-//
-// Deprecated: Do not use.
-type MsgP8EMemorializeContractResponse struct {
-	ScopeIdInfo   *ScopeIdInfo    `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty"`
-	SessionIdInfo *SessionIdInfo  `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty"`
-	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty"`
+// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationResponse struct {
 }
 
-func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
-func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
-func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{50}
+func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
+func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2243,37 +2385,36 @@ func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
 
-// MsgAddNetAssetValuesRequest defines the Msg/AddNetAssetValues request type
-type MsgAddNetAssetValuesRequest struct {
-	ScopeId        string          `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	Signers        []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	NetAssetValues []NetAssetValue `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValuesRequest{} }
-func (m *MsgAddNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgAddNetAssetValuesRequest) ProtoMessage()    {}
-func (*MsgAddNetAssetValuesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{51}
+func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
+func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
+func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddNetAssetValuesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2283,34 +2424,35 @@ func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddNetAssetValuesRequest.Merge(m, src)
+func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_Size() int {
+func (m *MsgBindOSLocatorRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddNetAssetValuesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddNetAssetValuesRequest.DiscardUnknown(m)
+func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddNetAssetValuesRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
 
-// MsgAddNetAssetValuesResponse defines the Msg/AddNetAssetValue response type
-type MsgAddNetAssetValuesResponse struct {
+// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func (m *MsgAddNetAssetValuesResponse) Reset()         { *m = MsgAddNetAssetValuesResponse{} }
-func (m *MsgAddNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddNetAssetValuesResponse) ProtoMessage()    {}
-func (*MsgAddNetAssetValuesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{52}
+func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
+func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
+func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddNetAssetValuesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2320,1944 +2462,2168 @@ func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddNetAssetValuesResponse.Merge(m, src)
+func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_Size() int {
+func (m *MsgBindOSLocatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddNetAssetValuesResponse.DiscardUnknown(m)
+func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
-	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
-	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
-	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
-	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
-	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
-	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
-	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
-	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
-	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
-	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
-	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
-	proto.RegisterType((*MsgUpdateValueOwnersRequest)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersRequest")
-	proto.RegisterType((*MsgUpdateValueOwnersResponse)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersResponse")
-	proto.RegisterType((*MsgMigrateValueOwnerRequest)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerRequest")
-	proto.RegisterType((*MsgMigrateValueOwnerResponse)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerResponse")
-	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
-	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
-	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
-	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
-	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
-	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
-	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
-	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
-	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
-	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
-	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
-	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
-	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
-	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
-	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
-	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
-	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
-	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
-	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
-	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
-	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
-	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
-	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
-	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
-	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
-	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
-	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
-	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
-	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
-	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
-	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesRequest")
-	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesResponse")
+func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
+	}
+	return ObjectStoreLocator{}
 }
 
-func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
-
-var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5f, 0x6c, 0x1c, 0x47,
-	0x19, 0xf7, 0xda, 0x89, 0xed, 0xfb, 0x6c, 0xc7, 0xce, 0xc4, 0x89, 0xcf, 0x1b, 0x72, 0xe7, 0x5e,
-	0x93, 0xd6, 0xb8, 0xc9, 0x1d, 0x71, 0x8d, 0x70, 0xf3, 0x07, 0xb0, 0x1b, 0x41, 0x5d, 0xf5, 0x48,
-	0x74, 0xd7, 0x34, 0x2a, 0x12, 0x3a, 0x36, 0xbb, 0xe3, 0xcb, 0x52, 0xdf, 0xce, 0xb1, 0xb3, 0xe7,
-	0x26, 0x8d, 0x88, 0x00, 0x89, 0x3f, 0xe2, 0x01, 0x15, 0x21, 0x55, 0x54, 0x20, 0x54, 0x09, 0x09,
-	0xf1, 0x58, 0x89, 0x37, 0x5e, 0x78, 0xcd, 0x13, 0xaa, 0xc4, 0x0b, 0x2a, 0x52, 0x85, 0x92, 0x87,
-	0xf2, 0xc8, 0x33, 0x0f, 0x80, 0x76, 0x76, 0xf6, 0xcf, 0xdc, 0xed, 0xcc, 0xee, 0x9d, 0x4b, 0x5c,
-	0xa9, 0x0f, 0x96, 0x3c, 0xb3, 0xdf, 0xbf, 0xdf, 0x37, 0xdf, 0x7c, 0xf3, 0xcd, 0x37, 0x07, 0xe5,
-	0xae, 0x4b, 0xf6, 0xb1, 0x63, 0x38, 0x26, 0xae, 0x75, 0xb0, 0x67, 0x58, 0x86, 0x67, 0xd4, 0xf6,
-	0x2f, 0xd6, 0xbc, 0xbb, 0xd5, 0xae, 0x4b, 0x3c, 0x82, 0x4e, 0xc5, 0x04, 0xd5, 0x90, 0xa0, 0xba,
-	0x7f, 0x51, 0x5f, 0x32, 0x09, 0xed, 0x10, 0x5a, 0xeb, 0xd0, 0xb6, 0x4f, 0xdf, 0xa1, 0xed, 0x80,
-	0x41, 0x5f, 0x6c, 0x93, 0x36, 0x61, 0xff, 0xd6, 0xfc, 0xff, 0xf8, 0xec, 0x39, 0x89, 0x9e, 0x48,
-	0x64, 0x40, 0xb6, 0x2a, 0x21, 0x23, 0xb7, 0xbf, 0x83, 0x4d, 0x8f, 0x7a, 0xc4, 0xc5, 0x9c, 0xf2,
-	0xac, 0x84, 0xb2, 0xbb, 0x89, 0xfd, 0x3f, 0x4e, 0x55, 0x91, 0x50, 0x51, 0x93, 0x74, 0x43, 0x9a,
-	0x35, 0x19, 0x4d, 0x17, 0x9b, 0xf6, 0xae, 0x6d, 0x1a, 0x9e, 0x4d, 0x9c, 0x80, 0xb6, 0xf2, 0xa1,
-	0x06, 0x8b, 0x75, 0xda, 0xbe, 0xe5, 0xda, 0x1e, 0x6e, 0xfa, 0x32, 0x1a, 0xf8, 0xbb, 0x3d, 0x4c,
-	0x3d, 0xf4, 0x02, 0x1c, 0x65, 0x32, 0x8b, 0xda, 0x8a, 0xb6, 0x3a, 0xb3, 0x7e, 0xa6, 0x9a, 0xee,
-	0xb6, 0x2a, 0x63, 0xda, 0x3e, 0xf2, 0xf0, 0xa3, 0xf2, 0x58, 0x23, 0xe0, 0x40, 0x45, 0x98, 0xa2,
-	0x76, 0xdb, 0xc1, 0x2e, 0x2d, 0x8e, 0xaf, 0x4c, 0xac, 0x16, 0x1a, 0xe1, 0x10, 0x9d, 0x01, 0x60,
-	0x24, 0xad, 0x5e, 0xcf, 0xb6, 0x8a, 0x13, 0x2b, 0xda, 0x6a, 0xa1, 0x51, 0x60, 0x33, 0x37, 0x7b,
-	0xb6, 0x85, 0x4e, 0x43, 0xc1, 0xb7, 0x31, 0xf8, 0x7a, 0x84, 0x7d, 0x9d, 0xf6, 0x27, 0xc2, 0x8f,
-	0x3d, 0x6a, 0xb5, 0x3a, 0xf6, 0xde, 0x1e, 0x2d, 0x1e, 0x5d, 0xd1, 0x56, 0x8f, 0x34, 0xa6, 0x7b,
-	0xd4, 0xaa, 0xfb, 0xe3, 0x4b, 0x8b, 0x3f, 0x7d, 0xaf, 0x3c, 0xf6, 0xcf, 0xf7, 0xca, 0x63, 0x3f,
-	0xfc, 0xf8, 0xfd, 0xb5, 0x50, 0x5d, 0xe5, 0xdb, 0x70, 0xb2, 0x0f, 0x1b, 0xed, 0x12, 0x87, 0x62,
-	0xf4, 0x75, 0x98, 0x0b, 0xec, 0xb0, 0xad, 0x96, 0xed, 0xec, 0x12, 0x0e, 0xf2, 0x69, 0x25, 0xc8,
-	0x1d, 0x6b, 0xc7, 0xd9, 0x25, 0x8d, 0x19, 0x1a, 0x0f, 0x2a, 0xf7, 0x99, 0x86, 0x6b, 0x78, 0x0f,
-	0xf7, 0xb9, 0x6f, 0x1d, 0xa6, 0x43, 0x0d, 0x4c, 0xf8, 0xec, 0xf6, 0x92, 0xef, 0xa2, 0x0f, 0x3f,
-	0x2a, 0xcf, 0xd7, 0xb9, 0xe0, 0x2d, 0xcb, 0x72, 0x31, 0xa5, 0x8d, 0x29, 0x2e, 0x50, 0xee, 0x37,
-	0x09, 0xbc, 0x22, 0x9c, 0xea, 0x57, 0x1e, 0xe0, 0xab, 0xfc, 0x4e, 0x83, 0xcf, 0xd5, 0x69, 0x7b,
-	0xcb, 0xb2, 0xd8, 0xfc, 0x35, 0x5f, 0x9b, 0x69, 0xfa, 0xca, 0x0e, 0x60, 0x5e, 0x19, 0x66, 0xfc,
-	0xf9, 0x96, 0xc1, 0x24, 0x71, 0x13, 0xc1, 0x8a, 0x64, 0x27, 0xed, 0x9f, 0xc8, 0x63, 0x7f, 0x19,
-	0xce, 0x48, 0x8c, 0xe4, 0x30, 0x7e, 0xaf, 0x41, 0x59, 0x44, 0xf8, 0x29, 0x45, 0x52, 0x81, 0x15,
-	0xb9, 0x9d, 0x1c, 0xcc, 0x9f, 0x34, 0x58, 0x4a, 0xc0, 0xbd, 0xfe, 0xa6, 0x83, 0xdd, 0x83, 0x80,
-	0xb8, 0x0c, 0x93, 0xe4, 0xcd, 0x28, 0x58, 0x14, 0x3b, 0xf4, 0x86, 0xe1, 0x7a, 0xf7, 0xf8, 0x0e,
-	0xe5, 0x2c, 0x43, 0x03, 0xd4, 0xa1, 0x38, 0x68, 0x3b, 0x07, 0xf6, 0x2b, 0x0d, 0x74, 0x11, 0xfd,
-	0x81, 0xb1, 0x9d, 0x12, 0xb0, 0x15, 0x46, 0x36, 0xfb, 0x0c, 0x9c, 0x4e, 0xb5, 0x8c, 0x5b, 0xfe,
-	0x47, 0x8d, 0x7d, 0xbf, 0xd9, 0xb5, 0x0c, 0x0f, 0xbf, 0x66, 0xec, 0xf5, 0x82, 0xef, 0x51, 0x6c,
-	0x6d, 0x40, 0x21, 0x34, 0x9d, 0x16, 0xb5, 0x95, 0x09, 0x95, 0xed, 0xd3, 0xdc, 0x76, 0x8a, 0xaa,
-	0x70, 0x62, 0xdf, 0x97, 0xd5, 0x62, 0x46, 0xb7, 0x8c, 0x80, 0xa0, 0x38, 0xce, 0xf2, 0xd9, 0xf1,
-	0xfd, 0x48, 0x0d, 0xe7, 0x1c, 0x1a, 0x54, 0x89, 0xed, 0xed, 0x14, 0xa3, 0x39, 0xaa, 0x1f, 0x05,
-	0xa8, 0xea, 0x76, 0xdb, 0x15, 0x28, 0x42, 0x54, 0x3a, 0x4c, 0xe3, 0xbb, 0x36, 0xf5, 0x6c, 0xa7,
-	0xcd, 0x16, 0xa4, 0xd0, 0x88, 0xc6, 0xfe, 0xb7, 0xae, 0x4b, 0xba, 0x84, 0x62, 0x8b, 0x1b, 0x1c,
-	0x8d, 0x47, 0xb4, 0x33, 0xc5, 0x0c, 0x6e, 0xe7, 0x4f, 0xc6, 0x59, 0xfe, 0x0a, 0xd2, 0x33, 0xa6,
-	0xd4, 0x26, 0x4e, 0x68, 0xe2, 0x57, 0x60, 0x8a, 0x06, 0x33, 0x3c, 0x33, 0x97, 0xa5, 0x99, 0x39,
-	0x20, 0xe3, 0xe1, 0x1d, 0x72, 0x29, 0x8e, 0xa0, 0x16, 0x9c, 0xe4, 0x44, 0x7e, 0xf2, 0x37, 0x49,
-	0xa7, 0x4b, 0x1c, 0xec, 0x78, 0x94, 0x9d, 0x46, 0x33, 0xeb, 0xcf, 0x65, 0x28, 0xda, 0xb1, 0x5e,
-	0x8c, 0x58, 0x1a, 0x27, 0xe8, 0xe0, 0xa4, 0xf2, 0x10, 0x93, 0x78, 0xea, 0xe7, 0x1a, 0x9c, 0x48,
-	0x91, 0x8f, 0xca, 0xc2, 0x71, 0xc9, 0xd6, 0xea, 0xa5, 0xb1, 0xe4, 0x81, 0x19, 0x11, 0xf8, 0x41,
-	0x16, 0x2c, 0x58, 0x44, 0xe0, 0x87, 0x17, 0x7a, 0x0a, 0x66, 0x43, 0xb4, 0x89, 0x23, 0x77, 0x86,
-	0xcf, 0xf9, 0x32, 0xb6, 0x11, 0x2c, 0x84, 0x41, 0x8e, 0x1d, 0xcf, 0xde, 0xb5, 0xb1, 0x5b, 0xb9,
-	0xc3, 0x52, 0x95, 0xb8, 0x32, 0xfc, 0xe8, 0xac, 0xc3, 0x7c, 0xc2, 0x7f, 0x89, 0xc3, 0xf3, 0x5c,
-	0xa6, 0xe7, 0xd8, 0xf1, 0x39, 0x47, 0x93, 0xc3, 0xca, 0x5f, 0xc7, 0xe3, 0x33, 0xba, 0x81, 0x4d,
-	0xe2, 0x5a, 0x61, 0x0c, 0x5c, 0x81, 0x49, 0x97, 0x4d, 0x70, 0xf9, 0x25, 0x99, 0xfc, 0x80, 0x2d,
-	0x4c, 0x70, 0x01, 0xcf, 0x61, 0x06, 0xc0, 0x79, 0x40, 0x26, 0x71, 0x3c, 0xd7, 0x30, 0xbd, 0x56,
-	0x7f, 0x24, 0x2c, 0x84, 0x5f, 0x9a, 0x61, 0x59, 0x73, 0x15, 0xa6, 0xba, 0x86, 0xeb, 0xd9, 0xd8,
-	0x2f, 0x6a, 0x72, 0xe7, 0xf1, 0x90, 0x47, 0x12, 0x50, 0x56, 0xbc, 0xb3, 0x42, 0xa7, 0xf2, 0xe5,
-	0x7b, 0x19, 0x8e, 0x05, 0x1e, 0xea, 0x5b, 0xbd, 0xb3, 0x6a, 0xef, 0xf2, 0xc5, 0x9b, 0x75, 0x13,
-	0xa3, 0xca, 0x83, 0x44, 0xfd, 0x21, 0xae, 0xdd, 0x06, 0x14, 0x22, 0x2d, 0x59, 0x49, 0x7f, 0x3a,
-	0x94, 0x39, 0x74, 0xfd, 0xb3, 0xcc, 0xa2, 0x54, 0xd4, 0xcf, 0x73, 0xcb, 0x43, 0x0d, 0x9e, 0x12,
-	0x4a, 0xbf, 0x66, 0xb2, 0xf6, 0x0d, 0xcd, 0x7c, 0x0d, 0xe6, 0x84, 0x9a, 0x98, 0xfb, 0x62, 0x4d,
-	0x59, 0x06, 0x0a, 0x92, 0xf8, 0x72, 0x88, 0x62, 0x14, 0xc1, 0x27, 0x24, 0x87, 0x89, 0x5c, 0xc9,
-	0xe1, 0x2d, 0xa8, 0xa8, 0x90, 0xf0, 0x75, 0x7d, 0x15, 0x50, 0xb0, 0x8b, 0x99, 0x78, 0x71, 0x6d,
-	0x9f, 0xcd, 0xc4, 0xc3, 0x97, 0x77, 0x9e, 0x8a, 0x13, 0xfe, 0xd1, 0x5e, 0x11, 0x0f, 0xd0, 0x54,
-	0x3f, 0x6e, 0xc3, 0x82, 0xe0, 0x80, 0x1c, 0xab, 0x3e, 0x2f, 0x30, 0x8c, 0xb0, 0xf8, 0xe7, 0xe0,
-	0x69, 0xa5, 0x65, 0x3c, 0x10, 0xfe, 0xa2, 0xc1, 0xd9, 0xd0, 0x7d, 0x2f, 0x26, 0xf6, 0xde, 0x00,
-	0x86, 0xd7, 0xd3, 0x63, 0xe1, 0x82, 0xcc, 0x77, 0xa9, 0xc2, 0x9e, 0x40, 0x38, 0xfc, 0x58, 0x83,
-	0x73, 0x19, 0x80, 0x78, 0x48, 0x7c, 0x0b, 0x4e, 0x8a, 0x79, 0x48, 0x8c, 0x8a, 0xb5, 0x3c, 0xc8,
-	0x78, 0x60, 0x20, 0x73, 0x60, 0xae, 0xf2, 0xef, 0xc0, 0xb3, 0x5b, 0x96, 0x95, 0x64, 0x78, 0x95,
-	0x44, 0x8b, 0x11, 0x7a, 0xb6, 0x09, 0xcb, 0x82, 0x1d, 0xc3, 0x84, 0xc9, 0x92, 0x99, 0x06, 0x71,
-	0xc7, 0x42, 0x75, 0x38, 0x15, 0xc7, 0xbb, 0x20, 0x71, 0x5c, 0x2d, 0x71, 0x91, 0x0e, 0x04, 0xcb,
-	0xce, 0xf0, 0xb5, 0xcd, 0xb3, 0x6c, 0x11, 0x54, 0xd8, 0x79, 0xfc, 0xfd, 0x57, 0x83, 0xcf, 0x47,
-	0x71, 0x9a, 0x24, 0xfe, 0x9a, 0x4b, 0x3a, 0x9f, 0x09, 0x57, 0x9d, 0x87, 0xb5, 0x3c, 0x0e, 0xe0,
-	0xfe, 0xfa, 0x75, 0x10, 0xde, 0x83, 0xe4, 0x9f, 0x8a, 0xa4, 0xb3, 0x0a, 0xcf, 0x64, 0x19, 0xc7,
-	0x71, 0xfc, 0x5d, 0x8b, 0xd3, 0x76, 0x70, 0x36, 0xa5, 0x82, 0xb8, 0x95, 0x9e, 0x75, 0x9e, 0x53,
-	0x9f, 0xc6, 0x07, 0xca, 0x39, 0xe9, 0xe5, 0xc9, 0x44, 0x7a, 0x79, 0x22, 0xf1, 0xc3, 0x03, 0x96,
-	0x7c, 0xe5, 0xe0, 0x78, 0x06, 0xba, 0x05, 0x27, 0x78, 0x19, 0x90, 0x92, 0x7f, 0x56, 0xb3, 0x31,
-	0xf2, 0xec, 0xb3, 0xe0, 0xf6, 0xcd, 0x54, 0xde, 0xd5, 0x12, 0xd9, 0x5f, 0xe1, 0xde, 0xc3, 0x88,
-	0x91, 0x67, 0x58, 0x5a, 0x54, 0x98, 0xc6, 0x23, 0xe4, 0x3e, 0xab, 0x5e, 0xb6, 0x6d, 0xc7, 0xba,
-	0xde, 0x7c, 0x85, 0x98, 0x86, 0x47, 0xa2, 0x1b, 0xda, 0xcb, 0x30, 0xb5, 0x17, 0xcc, 0x64, 0xe5,
-	0xea, 0xeb, 0xac, 0x8d, 0xd8, 0xf4, 0x88, 0x8b, 0xb9, 0x8c, 0xb0, 0x40, 0xe4, 0x02, 0xfa, 0x8c,
-	0xe4, 0xb3, 0x95, 0x5d, 0x76, 0x9f, 0xef, 0x53, 0x1e, 0x95, 0x88, 0x9f, 0x98, 0xf6, 0xca, 0xf7,
-	0x60, 0x39, 0x72, 0xc6, 0x21, 0xc0, 0xbc, 0x93, 0xe8, 0x4c, 0x3c, 0x09, 0xa0, 0x75, 0x62, 0xd9,
-	0xbb, 0xf7, 0x0e, 0x0d, 0xe8, 0x80, 0xfa, 0xff, 0x03, 0xd0, 0xdf, 0x6a, 0x2c, 0x74, 0x9a, 0xd8,
-	0xdb, 0x32, 0x4d, 0xd2, 0x73, 0xbc, 0x6b, 0x86, 0x67, 0xc4, 0x77, 0xb6, 0xb9, 0x50, 0x5a, 0x70,
-	0x25, 0xcd, 0xd8, 0x6c, 0xb3, 0x9d, 0xc4, 0x04, 0x5a, 0x84, 0xa3, 0xac, 0x3b, 0xc2, 0x3b, 0x0f,
-	0xc1, 0x60, 0xe8, 0xf3, 0xe6, 0x34, 0x5b, 0x89, 0x7e, 0xfb, 0xf8, 0xa6, 0x7b, 0x47, 0x83, 0x52,
-	0x98, 0xb9, 0x6e, 0x6c, 0x0a, 0x39, 0x3c, 0xc4, 0xd0, 0x80, 0xd9, 0x30, 0x0b, 0xfa, 0xa9, 0x20,
-	0x2b, 0x5b, 0x75, 0x37, 0xb1, 0x50, 0x31, 0x71, 0x7f, 0x09, 0x32, 0x14, 0x39, 0x64, 0xd2, 0xc7,
-	0x50, 0xd4, 0x2a, 0x8f, 0x83, 0x56, 0x67, 0xba, 0x61, 0x4f, 0xa4, 0xa0, 0x43, 0xaf, 0xc3, 0x62,
-	0x4a, 0xb6, 0x0e, 0xdb, 0x8b, 0xf9, 0xd3, 0xf5, 0xf1, 0xfe, 0x74, 0x1d, 0xa3, 0xfc, 0xcf, 0x38,
-	0x6b, 0x94, 0xde, 0xd8, 0xc4, 0x75, 0xdc, 0x21, 0xae, 0x6d, 0xec, 0xd9, 0x6f, 0x45, 0x58, 0xc3,
-	0x05, 0x58, 0xee, 0x6b, 0x18, 0x16, 0xe2, 0xbe, 0xe0, 0x32, 0x4c, 0xb7, 0x5d, 0xd2, 0xeb, 0x86,
-	0xc5, 0x4b, 0xa1, 0x31, 0xc5, 0xc6, 0x3b, 0x16, 0xda, 0x90, 0x56, 0x39, 0xc1, 0xd1, 0x96, 0x5e,
-	0xcc, 0x7c, 0x15, 0xfc, 0xeb, 0xa7, 0xed, 0x19, 0x7b, 0x94, 0xdd, 0xd0, 0x15, 0x17, 0x61, 0x7f,
-	0xa1, 0x1b, 0x9c, 0xb6, 0x11, 0x71, 0xf9, 0x12, 0x42, 0x5f, 0xb2, 0x57, 0x89, 0x0c, 0x09, 0x11,
-	0xd8, 0x88, 0x0b, 0xbd, 0x04, 0xe0, 0x47, 0x83, 0xe1, 0xf5, 0x5c, 0x4c, 0x8b, 0x93, 0xd9, 0xe1,
-	0xd6, 0x0c, 0xa9, 0x9b, 0xd8, 0x6b, 0x24, 0x78, 0xfd, 0x30, 0xb3, 0x9d, 0x7d, 0xf2, 0x06, 0x76,
-	0x8b, 0x53, 0x81, 0x77, 0xf8, 0x30, 0x5a, 0x80, 0x5f, 0x8c, 0xb3, 0x7b, 0xb1, 0x6c, 0x01, 0x3e,
-	0xe1, 0xe7, 0x91, 0xb4, 0x66, 0xd1, 0xf8, 0xe8, 0xcd, 0x22, 0xf4, 0x0a, 0xcc, 0x8b, 0xcd, 0x8b,
-	0x20, 0x25, 0xe4, 0xed, 0x5e, 0xcc, 0x25, 0xbb, 0x17, 0x71, 0x50, 0xfe, 0x39, 0xe8, 0x97, 0x6e,
-	0x59, 0xd6, 0x37, 0xb0, 0xb7, 0x45, 0x29, 0xf6, 0x58, 0xb3, 0x92, 0xe6, 0x88, 0x47, 0x79, 0x95,
-	0x75, 0x13, 0x16, 0x1c, 0xec, 0xb5, 0x0c, 0x5f, 0x5c, 0x8b, 0x25, 0xb2, 0xd0, 0x56, 0x29, 0x74,
-	0x41, 0x3b, 0x4f, 0x23, 0xc7, 0x1c, 0xc1, 0x24, 0x65, 0xa7, 0x35, 0x05, 0x40, 0xb0, 0x9e, 0xeb,
-	0xff, 0x2a, 0xc2, 0x44, 0x9d, 0xb6, 0x91, 0x0d, 0x10, 0xf7, 0x11, 0xd0, 0x79, 0x99, 0x21, 0x69,
-	0xef, 0x81, 0xfa, 0x85, 0x9c, 0xd4, 0x3c, 0x84, 0xf6, 0x60, 0x26, 0x71, 0x37, 0x47, 0x2a, 0xee,
-	0xc1, 0xd7, 0x33, 0xbd, 0x9a, 0x97, 0x9c, 0x6b, 0xfb, 0x81, 0x06, 0x68, 0xf0, 0x1d, 0x09, 0x6d,
-	0x28, 0xc4, 0x48, 0xdf, 0xc6, 0xf4, 0x2f, 0x0e, 0xc9, 0xc5, 0x6d, 0xf8, 0x99, 0x06, 0x27, 0x53,
-	0x5f, 0x80, 0xd0, 0x97, 0xf2, 0xa1, 0x19, 0xb4, 0x64, 0x73, 0x78, 0x46, 0x6e, 0x8c, 0x0b, 0x73,
-	0xc2, 0x63, 0x0d, 0xaa, 0xe5, 0x00, 0x95, 0x7c, 0x25, 0xd0, 0xbf, 0x90, 0x9f, 0x81, 0xeb, 0xbc,
-	0x0f, 0x0b, 0xfd, 0x2f, 0x2d, 0x68, 0x3d, 0x1f, 0x02, 0x41, 0xf3, 0xf3, 0x43, 0xf1, 0x70, 0xe5,
-	0x0f, 0xe0, 0xf8, 0xc0, 0x8b, 0x08, 0x52, 0x49, 0x92, 0x3d, 0xfa, 0xe8, 0x1b, 0xc3, 0x31, 0xc5,
-	0xfa, 0x07, 0x5e, 0x3a, 0x94, 0xfa, 0x65, 0xcf, 0x33, 0x4a, 0xfd, 0xd2, 0xc7, 0x14, 0x44, 0x60,
-	0x36, 0xd9, 0xae, 0x47, 0xd5, 0xcc, 0xed, 0x2a, 0xbc, 0xb8, 0xe8, 0xb5, 0xdc, 0xf4, 0xf1, 0x06,
-	0x4f, 0xdc, 0xff, 0x50, 0x66, 0x7a, 0x10, 0x1a, 0xc4, 0x7a, 0x35, 0x2f, 0x79, 0x0c, 0x2f, 0x79,
-	0xa3, 0x42, 0xd9, 0x09, 0x42, 0xd4, 0x57, 0xcb, 0x4d, 0xcf, 0x15, 0xbe, 0xad, 0xc1, 0x92, 0xa4,
-	0xe7, 0x8a, 0x5e, 0xc8, 0x95, 0x0a, 0xd3, 0x2e, 0xa4, 0xfa, 0xa5, 0x51, 0x58, 0xb9, 0x49, 0xbf,
-	0xd4, 0xa0, 0x28, 0xeb, 0x77, 0xa2, 0x4b, 0xf9, 0x36, 0x4d, 0xaa, 0x51, 0x97, 0x47, 0xe2, 0xe5,
-	0x56, 0xbd, 0xab, 0x81, 0x2e, 0x6f, 0x46, 0xa2, 0x2b, 0x59, 0x80, 0x55, 0x3d, 0x1e, 0xfd, 0xea,
-	0x88, 0xdc, 0xdc, 0xb6, 0xdf, 0x68, 0x70, 0x5a, 0xd1, 0xac, 0x41, 0x57, 0x33, 0x81, 0x2b, 0xad,
-	0xfb, 0xf2, 0xa8, 0xec, 0x09, 0xd7, 0xc9, 0x5b, 0x88, 0x4a, 0xd7, 0x65, 0x76, 0x5d, 0x95, 0xae,
-	0xcb, 0xee, 0x5b, 0xa2, 0x3f, 0x68, 0x50, 0xce, 0xe8, 0xd9, 0xa1, 0xad, 0xa1, 0xf0, 0xa7, 0x35,
-	0x3c, 0xf5, 0xed, 0x83, 0x88, 0x48, 0xec, 0x0b, 0x59, 0x2b, 0x0a, 0x5d, 0xca, 0x97, 0x68, 0x86,
-	0xde, 0x17, 0x99, 0xbd, 0xaf, 0x77, 0x34, 0x58, 0x96, 0x36, 0x81, 0xd0, 0xe5, 0x9c, 0xf9, 0x28,
-	0xd5, 0xae, 0x2b, 0xa3, 0x31, 0xc7, 0xa5, 0x81, 0xd0, 0xf7, 0x51, 0x96, 0x06, 0x69, 0xed, 0x29,
-	0x65, 0x69, 0x90, 0xde, 0x52, 0xba, 0x0b, 0xf3, 0x7d, 0x4d, 0x18, 0x74, 0x31, 0x13, 0xc4, 0x80,
-	0xde, 0xf5, 0x61, 0x58, 0x62, 0xcd, 0x7d, 0x5d, 0x11, 0xa5, 0xe6, 0xf4, 0x06, 0x8e, 0x52, 0xb3,
-	0xac, 0xe9, 0xd2, 0x83, 0x63, 0x62, 0x13, 0x02, 0xa9, 0xfc, 0x96, 0xda, 0x4f, 0xd1, 0x2f, 0x0e,
-	0xc1, 0x11, 0x17, 0x22, 0x03, 0x17, 0x01, 0x65, 0x21, 0x22, 0xbb, 0xf7, 0xe8, 0x1b, 0xc3, 0x31,
-	0x05, 0xfa, 0xf5, 0xa3, 0xdf, 0xff, 0xf8, 0xfd, 0x35, 0x6d, 0xfb, 0x8d, 0x87, 0x8f, 0x4a, 0xda,
-	0x07, 0x8f, 0x4a, 0xda, 0x3f, 0x1e, 0x95, 0xb4, 0xb7, 0x1f, 0x97, 0xc6, 0x3e, 0x78, 0x5c, 0x1a,
-	0xfb, 0xdb, 0xe3, 0xd2, 0x18, 0x2c, 0xdb, 0x44, 0x22, 0xf8, 0x86, 0xf6, 0xcd, 0x8d, 0xb6, 0xed,
-	0xdd, 0xe9, 0xdd, 0xae, 0x9a, 0xa4, 0x53, 0x8b, 0x89, 0x2e, 0xd8, 0x24, 0x31, 0xaa, 0xdd, 0x8d,
-	0x7f, 0xd5, 0xe8, 0xdd, 0xeb, 0x62, 0x7a, 0x7b, 0x92, 0xfd, 0x96, 0xf1, 0xf9, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x34, 0xe1, 0xb8, 0xfc, 0x29, 0x00, 0x00,
+// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorRequest struct {
+	// The record being removed
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
+func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner parties to a scope
-	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes owner parties (by addresses) from a scope
-	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
-	// UpdateValueOwners sets the value owner of one or more scopes.
-	UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error)
-	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
-	MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
-	// SetAccountData associates some basic data with a metadata address.
-	// Currently, only scope ids are supported.
-	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
-	// AddNetAssetValues set the net asset value for a scope
-	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
+func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{58}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
-	out := new(MsgWriteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
-	out := new(MsgDeleteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
-	out := new(MsgAddScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return out, nil
+	return ObjectStoreLocator{}
 }
 
-func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
-	out := new(MsgDeleteScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+	// key_activation_height is the block height at which a new encryption key takes effect.
+	// If zero, it takes effect at the current block height. The previous key stays in effect until then.
+	KeyActivationHeight int64 `protobuf:"varint,2,opt,name=key_activation_height,json=keyActivationHeight,proto3" json:"key_activation_height,omitempty"`
 }
 
-func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
-	out := new(MsgAddScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
+func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
+func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{59}
 }
-
-func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
-	out := new(MsgDeleteScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error) {
-	out := new(MsgUpdateValueOwnersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateValueOwners", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error) {
-	out := new(MsgMigrateValueOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateValueOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
 }
-
-func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
-	out := new(MsgWriteSessionResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
-	out := new(MsgWriteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
-	out := new(MsgDeleteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
-	out := new(MsgDeleteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
+func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
+func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{60}
 }
-
-func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
-	out := new(MsgWriteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
-	out := new(MsgDeleteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	out := new(MsgAddContractSpecToScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
 }
-
-func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
-	out := new(MsgWriteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
-	out := new(MsgDeleteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
 
-func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
-	out := new(MsgBindOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return out, nil
+	return ObjectStoreLocator{}
 }
 
-func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
-	out := new(MsgDeleteOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetAccountDataRequest is the request to set/update/delete a scope's account data.
+type MsgSetAccountDataRequest struct {
+	// The identifier to associate the data with.
+	// Currently, only scope ids are supported.
+	MetadataAddr MetadataAddress `protobuf:"bytes,1,opt,name=metadata_addr,json=metadataAddr,proto3,customtype=MetadataAddress" json:"metadata_addr"`
+	// The desired accountdata value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The signers of this message. Must fulfill owner requirements of the scope.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
-	out := new(MsgModifyOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetAccountDataRequest) Reset()         { *m = MsgSetAccountDataRequest{} }
+func (m *MsgSetAccountDataRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountDataRequest) ProtoMessage()    {}
+func (*MsgSetAccountDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{61}
 }
-
-func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
-	out := new(MsgSetAccountDataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetAccountData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAccountDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAccountDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountDataRequest.Merge(m, src)
+}
+func (m *MsgSetAccountDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountDataRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
-	out := new(MsgAddNetAssetValuesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddNetAssetValues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetAccountDataRequest proto.InternalMessageInfo
+
+// MsgSetAccountDataResponse is the response from setting/updating/deleting a scope's account data.
+type MsgSetAccountDataResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner parties to a scope
-	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes owner parties (by addresses) from a scope
-	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
-	// UpdateValueOwners sets the value owner of one or more scopes.
-	UpdateValueOwners(context.Context, *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error)
-	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
-	MigrateValueOwner(context.Context, *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
-	// SetAccountData associates some basic data with a metadata address.
-	// Currently, only scope ids are supported.
-	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
-	// AddNetAssetValues set the net asset value for a scope
-	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+func (m *MsgSetAccountDataResponse) Reset()         { *m = MsgSetAccountDataResponse{} }
+func (m *MsgSetAccountDataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountDataResponse) ProtoMessage()    {}
+func (*MsgSetAccountDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{62}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetAccountDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
+func (m *MsgSetAccountDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+func (m *MsgSetAccountDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountDataResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
+func (m *MsgSetAccountDataResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+func (m *MsgSetAccountDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountDataResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+
+var xxx_messageInfo_MsgSetAccountDataResponse proto.InternalMessageInfo
+
+// MsgWriteP8eContractSpecRequest has been deprecated and is no longer usable.
+// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
+//
+// Deprecated: Do not use.
+type MsgWriteP8EContractSpecRequest struct {
+	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
+	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+
+func (m *MsgWriteP8EContractSpecRequest) Reset()         { *m = MsgWriteP8EContractSpecRequest{} }
+func (m *MsgWriteP8EContractSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteP8EContractSpecRequest) ProtoMessage()    {}
+func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{63}
 }
-func (*UnimplementedMsgServer) UpdateValueOwners(ctx context.Context, req *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateValueOwners not implemented")
+func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MigrateValueOwner(ctx context.Context, req *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateValueOwner not implemented")
+func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+
+var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
+
+// MsgWriteP8eContractSpecResponse  has been deprecated and is no longer usable.
+// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
+//
+// Deprecated: Do not use.
+type MsgWriteP8EContractSpecResponse struct {
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty"`
+	RecordSpecIdInfos  []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty"`
 }
-func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+
+func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
+func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
+func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{64}
 }
-func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+
+var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
+
+// MsgP8eMemorializeContractRequest  has been deprecated and is no longer usable.
+// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
+//
+// Deprecated: Do not use.
+type MsgP8EMemorializeContractRequest struct {
+	ScopeId              string            `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	GroupId              string            `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ScopeSpecificationId string            `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
+	Recitals             *p8e.Recitals     `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
+	Contract             *p8e.Contract     `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
+	Signatures           *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	Invoker              string            `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
 }
-func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+
+func (m *MsgP8EMemorializeContractRequest) Reset()         { *m = MsgP8EMemorializeContractRequest{} }
+func (m *MsgP8EMemorializeContractRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgP8EMemorializeContractRequest) ProtoMessage()    {}
+func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{65}
 }
-func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
 }
 
-func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
 
-func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgP8eMemorializeContractResponse  has been deprecated and is no longer usable.
+// Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
+//
+// Deprecated: Do not use.
+type MsgP8EMemorializeContractResponse struct {
+	ScopeIdInfo   *ScopeIdInfo    `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty"`
+	SessionIdInfo *SessionIdInfo  `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty"`
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty"`
 }
 
-func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
+func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
+func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{66}
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+
+// MsgAddNetAssetValuesRequest defines the Msg/AddNetAssetValues request type
+type MsgAddNetAssetValuesRequest struct {
+	ScopeId        string          `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Signers        []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	NetAssetValues []NetAssetValue `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 }
 
-func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValuesRequest{} }
+func (m *MsgAddNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNetAssetValuesRequest) ProtoMessage()    {}
+func (*MsgAddNetAssetValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{67}
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNetAssetValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNetAssetValuesRequest.Merge(m, src)
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNetAssetValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNetAssetValuesRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddNetAssetValuesRequest proto.InternalMessageInfo
+
+// MsgAddNetAssetValuesResponse defines the Msg/AddNetAssetValue response type
+type MsgAddNetAssetValuesResponse struct {
 }
 
-func _Msg_UpdateValueOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateValueOwnersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateValueOwners(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/UpdateValueOwners",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateValueOwners(ctx, req.(*MsgUpdateValueOwnersRequest))
+func (m *MsgAddNetAssetValuesResponse) Reset()         { *m = MsgAddNetAssetValuesResponse{} }
+func (m *MsgAddNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNetAssetValuesResponse) ProtoMessage()    {}
+func (*MsgAddNetAssetValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{68}
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNetAssetValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNetAssetValuesResponse.Merge(m, src)
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNetAssetValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNetAssetValuesResponse.DiscardUnknown(m)
 }
 
-func _Msg_MigrateValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateValueOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateValueOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/MigrateValueOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateValueOwner(ctx, req.(*MsgMigrateValueOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddNetAssetValuesResponse proto.InternalMessageInfo
+
+// MsgUpdateParamsRequest is a request message for the UpdateParams endpoint.
+type MsgUpdateParamsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new param values to set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
+func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{69}
+}
+func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
+}
+func (m *MsgUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
 }
 
-func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	return ""
+}
+
+func (m *MsgUpdateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateParamsResponse is a response message for the UpdateParams endpoint.
+type MsgUpdateParamsResponse struct {
 }
 
-func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{70}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
+	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
+	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
+	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
+	proto.RegisterType((*MsgWriteScopesRequest)(nil), "provenance.metadata.v1.MsgWriteScopesRequest")
+	proto.RegisterType((*MsgWriteScopesResponse)(nil), "provenance.metadata.v1.MsgWriteScopesResponse")
+	proto.RegisterType((*MsgDeleteScopesRequest)(nil), "provenance.metadata.v1.MsgDeleteScopesRequest")
+	proto.RegisterType((*MsgDeleteScopesResponse)(nil), "provenance.metadata.v1.MsgDeleteScopesResponse")
+	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
+	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
+	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
+	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
+	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
+	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
+	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
+	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
+	proto.RegisterType((*MsgUpdateValueOwnersRequest)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersRequest")
+	proto.RegisterType((*MsgUpdateValueOwnersResponse)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersResponse")
+	proto.RegisterType((*MsgMigrateValueOwnerRequest)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerRequest")
+	proto.RegisterType((*MsgMigrateValueOwnerResponse)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerResponse")
+	proto.RegisterType((*MsgMigrateScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecificationRequest")
+	proto.RegisterType((*MsgMigrateScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecificationResponse")
+	proto.RegisterType((*MsgAddScopeLienRequest)(nil), "provenance.metadata.v1.MsgAddScopeLienRequest")
+	proto.RegisterType((*MsgAddScopeLienResponse)(nil), "provenance.metadata.v1.MsgAddScopeLienResponse")
+	proto.RegisterType((*MsgReleaseScopeLienRequest)(nil), "provenance.metadata.v1.MsgReleaseScopeLienRequest")
+	proto.RegisterType((*MsgReleaseScopeLienResponse)(nil), "provenance.metadata.v1.MsgReleaseScopeLienResponse")
+	proto.RegisterType((*MsgFractionalizeScopeValueRequest)(nil), "provenance.metadata.v1.MsgFractionalizeScopeValueRequest")
+	proto.RegisterType((*MsgFractionalizeScopeValueResponse)(nil), "provenance.metadata.v1.MsgFractionalizeScopeValueResponse")
+	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
+	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
+	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
+	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
+	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
+	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
+	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgWriteRecordsRequest)(nil), "provenance.metadata.v1.MsgWriteRecordsRequest")
+	proto.RegisterType((*MsgWriteRecordsResponse)(nil), "provenance.metadata.v1.MsgWriteRecordsResponse")
+	proto.RegisterType((*MsgDeleteRecordsRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordsRequest")
+	proto.RegisterType((*MsgDeleteRecordsResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordsResponse")
+	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
+	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
+	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
+	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
+	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
+	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
+	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
+	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
+	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
+	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
+	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
+	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
+	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
+	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
+	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
+	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
+	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
+	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
+	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
+	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
+	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
+	proto.RegisterType((*MsgAddNetAssetValuesRequest)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesRequest")
+	proto.RegisterType((*MsgAddNetAssetValuesResponse)(nil), "provenance.metadata.v1.MsgAddNetAssetValuesResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.metadata.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.metadata.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
+
+var fileDescriptor_3a3a0892f91e3036 = []byte{
+	// 2609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xd8, 0x89, 0xed, 0x3d, 0xb6, 0x63, 0xe7, 0xc6, 0xb1, 0xd7, 0x93, 0xc6, 0xeb, 0x6c,
+	0x92, 0xd6, 0xb8, 0x89, 0xb7, 0x71, 0x0c, 0xa4, 0x4e, 0x52, 0xb0, 0x5b, 0x95, 0xb8, 0xca, 0x12,
+	0x6b, 0xdd, 0x34, 0x2a, 0x12, 0x5a, 0x26, 0x33, 0xd7, 0xeb, 0x21, 0xde, 0x99, 0x65, 0xee, 0xac,
+	0x1b, 0x37, 0x22, 0x6a, 0x11, 0x1f, 0x15, 0x0f, 0xa8, 0x08, 0xa9, 0xa2, 0x02, 0xaa, 0x4a, 0x48,
+	0x88, 0xc7, 0x22, 0x78, 0xe2, 0xa5, 0xaf, 0x79, 0x82, 0x0a, 0x5e, 0x50, 0x91, 0x2a, 0x94, 0x3c,
+	0x94, 0xbf, 0x81, 0x07, 0x40, 0x33, 0xf7, 0xde, 0xf9, 0x9e, 0x3b, 0x33, 0xeb, 0x26, 0xa9, 0xd4,
+	0x87, 0x48, 0x99, 0x99, 0xf3, 0xf5, 0x3b, 0xe7, 0xcc, 0xb9, 0x67, 0xce, 0x59, 0x43, 0xa5, 0x63,
+	0x99, 0xbb, 0xd8, 0x50, 0x0c, 0x15, 0xd7, 0xda, 0xd8, 0x56, 0x34, 0xc5, 0x56, 0x6a, 0xbb, 0xe7,
+	0x6a, 0xf6, 0xed, 0xc5, 0x8e, 0x65, 0xda, 0x26, 0x9a, 0xf2, 0x09, 0x16, 0x39, 0xc1, 0xe2, 0xee,
+	0x39, 0x79, 0x5a, 0x35, 0x49, 0xdb, 0x24, 0xb5, 0x36, 0x69, 0x39, 0xf4, 0x6d, 0xd2, 0xa2, 0x0c,
+	0xf2, 0x0c, 0x7d, 0xd0, 0x74, 0xaf, 0x6a, 0xf4, 0x82, 0x3d, 0x9a, 0x6c, 0x99, 0x2d, 0x93, 0xde,
+	0x77, 0xfe, 0xc7, 0xee, 0x9e, 0x4e, 0x31, 0xc1, 0xd3, 0x46, 0xc9, 0xe6, 0x53, 0xc8, 0xcc, 0x9b,
+	0xdf, 0xc5, 0xaa, 0x4d, 0x6c, 0xd3, 0xc2, 0x8c, 0xf2, 0x54, 0x0a, 0x65, 0xe7, 0x02, 0x76, 0xfe,
+	0x31, 0xaa, 0x6a, 0x0a, 0x15, 0x51, 0xcd, 0x0e, 0xa7, 0x59, 0x48, 0xa3, 0xe9, 0x60, 0x55, 0xdf,
+	0xd2, 0x55, 0xc5, 0xd6, 0x4d, 0x83, 0xd2, 0x56, 0x3f, 0x96, 0x60, 0xb2, 0x4e, 0x5a, 0x37, 0x2c,
+	0xdd, 0xc6, 0x9b, 0x8e, 0x8c, 0x06, 0xfe, 0x5e, 0x17, 0x13, 0x1b, 0x3d, 0x0b, 0x07, 0x5d, 0x99,
+	0x65, 0x69, 0x4e, 0x9a, 0x1f, 0x59, 0x3a, 0xbe, 0x98, 0xec, 0xd1, 0x45, 0x97, 0x69, 0xed, 0xc0,
+	0xbd, 0x4f, 0x2a, 0x7d, 0x0d, 0xca, 0x81, 0xca, 0x30, 0x44, 0xf4, 0x96, 0x81, 0x2d, 0x52, 0xee,
+	0x9f, 0x1b, 0x98, 0x2f, 0x35, 0xf8, 0x25, 0x3a, 0x0e, 0xe0, 0x92, 0x34, 0xbb, 0x5d, 0x5d, 0x2b,
+	0x0f, 0xcc, 0x49, 0xf3, 0xa5, 0x46, 0xc9, 0xbd, 0x73, 0xbd, 0xab, 0x6b, 0xe8, 0x18, 0x94, 0x1c,
+	0x1b, 0xe9, 0xd3, 0x03, 0xee, 0xd3, 0x61, 0xe7, 0x06, 0x7f, 0xd8, 0x25, 0x5a, 0xb3, 0xad, 0xef,
+	0xec, 0x90, 0xf2, 0xc1, 0x39, 0x69, 0xfe, 0x40, 0x63, 0xb8, 0x4b, 0xb4, 0xba, 0x73, 0xbd, 0x32,
+	0xf9, 0xd6, 0xfb, 0x95, 0xbe, 0x7f, 0xbf, 0x5f, 0xe9, 0xfb, 0xc1, 0xa7, 0x1f, 0x2c, 0x70, 0x75,
+	0xd5, 0xef, 0xc0, 0xd1, 0x08, 0x36, 0xd2, 0x31, 0x0d, 0x82, 0xd1, 0x37, 0x60, 0x8c, 0xda, 0xa1,
+	0x6b, 0x4d, 0xdd, 0xd8, 0x32, 0x19, 0xc8, 0x93, 0x42, 0x90, 0xeb, 0xda, 0xba, 0xb1, 0x65, 0x36,
+	0x46, 0x88, 0x7f, 0x51, 0xbd, 0xe3, 0x6a, 0x78, 0x01, 0xef, 0xe0, 0x88, 0xfb, 0x96, 0x60, 0x98,
+	0x6b, 0x70, 0x85, 0x8f, 0xae, 0x4d, 0x3b, 0x2e, 0xfa, 0xf8, 0x93, 0xca, 0x78, 0x9d, 0x09, 0x5e,
+	0xd5, 0x34, 0x0b, 0x13, 0xd2, 0x18, 0x62, 0x02, 0xd3, 0xfd, 0x96, 0x02, 0xaf, 0x0c, 0x53, 0x51,
+	0xe5, 0x14, 0x5f, 0xf5, 0x87, 0x52, 0x04, 0x39, 0xe1, 0x76, 0x5d, 0x84, 0x41, 0x57, 0x1d, 0x29,
+	0x4b, 0x73, 0x03, 0x79, 0xe3, 0xca, 0x58, 0x0a, 0x1b, 0xa8, 0xc2, 0x54, 0xd4, 0x0a, 0x16, 0x80,
+	0x75, 0x38, 0x14, 0x0a, 0x00, 0x37, 0x27, 0x57, 0x04, 0x46, 0x03, 0x11, 0x20, 0xd5, 0xbb, 0x51,
+	0x2f, 0x78, 0x58, 0x97, 0xa1, 0xc4, 0x95, 0x50, 0xf9, 0x82, 0x20, 0x0c, 0x33, 0x99, 0xc5, 0x41,
+	0xce, 0xc0, 0x74, 0x4c, 0x3f, 0x0b, 0xc3, 0x6f, 0x25, 0x78, 0xa2, 0x4e, 0x5a, 0xab, 0x9a, 0xe6,
+	0x3e, 0x78, 0xc1, 0xd1, 0xa7, 0xaa, 0x98, 0x78, 0x16, 0xf6, 0x92, 0x25, 0x15, 0x18, 0x71, 0xee,
+	0x37, 0x15, 0x57, 0x12, 0xb3, 0x11, 0x34, 0x4f, 0x76, 0x10, 0xc0, 0x40, 0x1e, 0x00, 0x15, 0x38,
+	0x9e, 0x62, 0x24, 0x83, 0xf1, 0x3b, 0x09, 0x2a, 0x61, 0x88, 0x9f, 0x53, 0x24, 0x55, 0x98, 0x4b,
+	0xb7, 0x93, 0x81, 0xf9, 0xb3, 0x04, 0xd3, 0x01, 0xb8, 0xd7, 0x5e, 0x33, 0xb0, 0xb5, 0x1f, 0x10,
+	0x17, 0x61, 0xd0, 0x7c, 0xcd, 0xcb, 0x16, 0xc1, 0x0b, 0xb5, 0xa1, 0x58, 0xf6, 0x1e, 0x7f, 0xa1,
+	0x28, 0x4b, 0x61, 0x80, 0x32, 0x94, 0xe3, 0xb6, 0x33, 0x60, 0xbf, 0x94, 0x40, 0x0e, 0xa3, 0xdf,
+	0x37, 0xb6, 0xa9, 0x10, 0xb6, 0x52, 0xcf, 0x66, 0x1f, 0x87, 0x63, 0x89, 0x96, 0x31, 0xcb, 0xff,
+	0x28, 0xb9, 0xcf, 0xaf, 0x77, 0x34, 0xc5, 0xc6, 0xaf, 0x28, 0x3b, 0x5d, 0xfa, 0x7c, 0x9f, 0xef,
+	0xf1, 0x22, 0x1c, 0xd9, 0x75, 0x64, 0x35, 0x5d, 0xa3, 0x9b, 0x0a, 0x25, 0x28, 0xf7, 0xbb, 0xc7,
+	0xca, 0xe1, 0x5d, 0x4f, 0x0d, 0xe3, 0x2c, 0x0c, 0x6a, 0x16, 0x9e, 0x48, 0x36, 0x9a, 0xa1, 0xfa,
+	0x11, 0x45, 0x55, 0xd7, 0x5b, 0x56, 0x88, 0x82, 0xa3, 0x92, 0x61, 0x18, 0xdf, 0xd6, 0x89, 0xad,
+	0x1b, 0x2d, 0x37, 0x20, 0xa5, 0x86, 0x77, 0xed, 0x3c, 0xeb, 0x58, 0x66, 0xc7, 0x24, 0x58, 0x63,
+	0x06, 0x7b, 0xd7, 0x3d, 0xda, 0x99, 0x60, 0x06, 0xb3, 0xf3, 0xaf, 0x12, 0x9c, 0xf4, 0x09, 0xdc,
+	0xf0, 0x6c, 0x06, 0x1b, 0x85, 0xfd, 0x45, 0x61, 0x0d, 0x26, 0x42, 0x6d, 0x47, 0x53, 0xa7, 0x88,
+	0x04, 0xcc, 0xe3, 0x21, 0x86, 0xf5, 0xe2, 0x88, 0x9f, 0x84, 0x53, 0x62, 0x40, 0x7e, 0x84, 0xa6,
+	0x02, 0xaf, 0xd3, 0x55, 0x1d, 0x1b, 0xfe, 0x31, 0x79, 0x60, 0x47, 0xc7, 0x06, 0xeb, 0x0b, 0x4e,
+	0x08, 0x4f, 0x25, 0x87, 0x8f, 0xbd, 0xd7, 0x2e, 0x53, 0x8f, 0x27, 0x48, 0xd8, 0x0c, 0x66, 0xe2,
+	0x1b, 0xf4, 0xa5, 0x6e, 0xe0, 0x1d, 0xac, 0x10, 0x1c, 0x33, 0xf3, 0x51, 0x74, 0x19, 0xf4, 0xe5,
+	0x8d, 0x5b, 0xc0, 0x2c, 0xfc, 0x8d, 0x04, 0x27, 0xea, 0xa4, 0xf5, 0xa2, 0xa5, 0xa8, 0x8e, 0x73,
+	0x95, 0x1d, 0xfd, 0x75, 0x4a, 0xe5, 0xa6, 0xda, 0x7e, 0x0c, 0x9d, 0x84, 0x83, 0x5d, 0x43, 0xb7,
+	0xe9, 0x2b, 0x7b, 0xa0, 0x41, 0x2f, 0x0a, 0x27, 0xc3, 0x29, 0xa8, 0x8a, 0xcc, 0x63, 0x28, 0x7e,
+	0xd2, 0x1f, 0x68, 0x55, 0x30, 0x21, 0x81, 0xbc, 0xff, 0x1a, 0x0c, 0x11, 0x7a, 0x87, 0x65, 0x43,
+	0x25, 0x35, 0x1b, 0x28, 0x19, 0xcb, 0x05, 0xce, 0x25, 0x68, 0x87, 0x9b, 0x70, 0x94, 0x11, 0x39,
+	0x7d, 0x90, 0x6a, 0xb6, 0x3b, 0xa6, 0x81, 0x0d, 0x9b, 0xb8, 0x9d, 0xf1, 0xc8, 0xd2, 0xd3, 0x19,
+	0x8a, 0xd6, 0xb5, 0xe7, 0x3d, 0x96, 0xc6, 0x11, 0x12, 0xbf, 0x29, 0x6c, 0xa8, 0x53, 0xfc, 0xf5,
+	0x33, 0x09, 0x8e, 0x24, 0xc8, 0x47, 0x95, 0x50, 0xeb, 0xee, 0x16, 0xac, 0x2b, 0x7d, 0xc1, 0xe6,
+	0xdd, 0x23, 0x70, 0x2a, 0x6d, 0xb9, 0x3f, 0x44, 0xe0, 0xc4, 0x16, 0x9d, 0x80, 0x51, 0x8e, 0x36,
+	0xd0, 0xfe, 0x8f, 0xb0, 0x7b, 0x8e, 0x8c, 0x35, 0x04, 0x13, 0x3c, 0x4d, 0xb0, 0x61, 0xeb, 0x5b,
+	0x3a, 0xb6, 0xaa, 0xdb, 0x30, 0x1d, 0x8b, 0x0c, 0xeb, 0x22, 0xeb, 0x30, 0x1e, 0xf0, 0x5f, 0xa0,
+	0x91, 0x3f, 0x9d, 0xe9, 0x39, 0xb7, 0x91, 0x1c, 0x23, 0xc1, 0xcb, 0xea, 0xdf, 0xfb, 0xfd, 0xae,
+	0xb9, 0x81, 0x55, 0xd3, 0xd2, 0x78, 0x0e, 0x5c, 0x82, 0x41, 0xcb, 0xbd, 0xc1, 0xe4, 0xcf, 0xa6,
+	0xc9, 0xa7, 0x6c, 0xfc, 0x94, 0xa7, 0x3c, 0x8f, 0x33, 0x01, 0xce, 0x00, 0x52, 0x4d, 0xc3, 0x76,
+	0xb2, 0xbf, 0x19, 0xcd, 0x84, 0x09, 0xfe, 0x64, 0x93, 0x7f, 0x62, 0x5d, 0x86, 0xa1, 0x8e, 0x62,
+	0xd9, 0x3a, 0x76, 0x3e, 0xb0, 0x72, 0x37, 0x33, 0x9c, 0x27, 0x25, 0xa1, 0x34, 0xff, 0xcd, 0xe2,
+	0x4e, 0x65, 0xe1, 0x7b, 0x09, 0x0e, 0x51, 0x0f, 0x45, 0xa2, 0x77, 0x4a, 0xec, 0x5d, 0xfe, 0x15,
+	0x60, 0x05, 0xae, 0x42, 0x5f, 0x01, 0xe1, 0xd8, 0x2d, 0x43, 0xc9, 0xd3, 0x92, 0x55, 0x7b, 0x86,
+	0xb9, 0xcc, 0x7d, 0x7d, 0x05, 0x84, 0x61, 0x56, 0xdf, 0x92, 0xa2, 0x1e, 0xf0, 0x3a, 0x9b, 0xe7,
+	0x60, 0x88, 0x6a, 0xe4, 0xdf, 0x3f, 0xf9, 0x12, 0x8b, 0x33, 0x15, 0xb6, 0xb2, 0xe5, 0xbf, 0x4b,
+	0x9e, 0x25, 0x2c, 0x18, 0x57, 0x61, 0x3c, 0x1c, 0x0c, 0x6e, 0x52, 0xbe, 0x68, 0x8c, 0x05, 0xa3,
+	0x41, 0xaa, 0x6f, 0x4a, 0x31, 0x7f, 0x78, 0xa0, 0xbf, 0x02, 0xe0, 0x69, 0xca, 0xec, 0x24, 0x4a,
+	0x5c, 0x6e, 0x71, 0xb0, 0xb4, 0x59, 0x8e, 0x98, 0xc0, 0x62, 0x72, 0x8f, 0x9e, 0x5a, 0xfe, 0xa7,
+	0x69, 0x62, 0xcb, 0xf3, 0x0a, 0x8c, 0x85, 0x7a, 0x11, 0x96, 0x9f, 0x0b, 0xc2, 0x76, 0x20, 0x24,
+	0x89, 0x05, 0x2c, 0x2c, 0x46, 0x50, 0x10, 0x42, 0x05, 0x7b, 0x20, 0x57, 0xc1, 0x7e, 0x1d, 0xaa,
+	0x22, 0x24, 0x2c, 0xbc, 0x2f, 0x03, 0xa2, 0x95, 0xd5, 0x15, 0x1f, 0x7e, 0xdf, 0x9e, 0xca, 0xc4,
+	0xc3, 0x82, 0x3c, 0x4e, 0xc2, 0x37, 0x9c, 0x6f, 0x8e, 0x6a, 0xb8, 0xb3, 0x4f, 0xf4, 0x63, 0x52,
+	0x13, 0x28, 0xf5, 0xde, 0x04, 0xe6, 0x8a, 0xfe, 0x69, 0x38, 0x29, 0xb4, 0x8c, 0x25, 0xc2, 0x5f,
+	0x24, 0x38, 0xc5, 0xdd, 0xf7, 0x7c, 0xa0, 0x1e, 0xc6, 0x30, 0xbc, 0x9a, 0x9c, 0x0b, 0x67, 0xd3,
+	0x7c, 0x97, 0x28, 0xec, 0x11, 0xa4, 0xc3, 0x8f, 0x25, 0x38, 0x9d, 0x01, 0x88, 0xa5, 0xc4, 0xb7,
+	0xe1, 0x68, 0xf8, 0x6c, 0x08, 0x67, 0xc5, 0x42, 0x1e, 0x64, 0x2c, 0x31, 0x90, 0x1a, 0xbb, 0x57,
+	0xfd, 0x0f, 0xf5, 0xec, 0xaa, 0xa6, 0x05, 0x19, 0x5e, 0x36, 0xbd, 0x60, 0x70, 0xcf, 0x6e, 0xc2,
+	0x4c, 0xc8, 0x8e, 0x22, 0x69, 0x32, 0xad, 0x26, 0x41, 0x5c, 0xd7, 0x50, 0x1d, 0xa6, 0xfc, 0x7c,
+	0x2f, 0xf2, 0xf5, 0x31, 0x49, 0x62, 0xc9, 0xd2, 0xc3, 0x27, 0xc8, 0x53, 0x70, 0x3a, 0x03, 0x3b,
+	0xcb, 0xbf, 0xff, 0x49, 0xf0, 0x25, 0x2f, 0x4f, 0x83, 0xc4, 0x2f, 0x5a, 0x66, 0xfb, 0x0b, 0xe1,
+	0xaa, 0x33, 0xb0, 0x90, 0xc7, 0x01, 0xcc, 0x5f, 0xbf, 0xa2, 0xe9, 0x1d, 0x27, 0xff, 0x5c, 0x14,
+	0x9d, 0x79, 0x78, 0x32, 0xcb, 0x38, 0x86, 0xe3, 0x9f, 0x92, 0x5f, 0xb6, 0xe9, 0xe1, 0x94, 0x08,
+	0xe2, 0x46, 0x72, 0xd5, 0x79, 0x5a, 0x7c, 0x26, 0xef, 0xab, 0xe6, 0x24, 0xb7, 0x8c, 0x03, 0xc9,
+	0x2d, 0x63, 0x8a, 0x1f, 0xee, 0xc2, 0x49, 0x21, 0x38, 0x56, 0x81, 0x6e, 0xc0, 0x11, 0xd6, 0x09,
+	0x24, 0xd4, 0x9f, 0xf9, 0x6c, 0x8c, 0xac, 0xfa, 0x4c, 0x58, 0x91, 0x3b, 0xd5, 0x77, 0xa5, 0x40,
+	0xf5, 0x17, 0xb8, 0xf7, 0x71, 0xe4, 0x08, 0x9d, 0x4e, 0x08, 0x4c, 0x63, 0x19, 0x72, 0xc7, 0xed,
+	0xa0, 0xd6, 0x74, 0x43, 0xbb, 0xb6, 0x79, 0xd5, 0x54, 0x15, 0xdb, 0xf4, 0x46, 0x47, 0x2f, 0xc1,
+	0xd0, 0x0e, 0xbd, 0x93, 0x55, 0xab, 0xaf, 0xb9, 0x6b, 0xa6, 0x4d, 0xdb, 0xb4, 0x30, 0x93, 0xc1,
+	0x5b, 0x48, 0x26, 0x20, 0x62, 0x24, 0xbb, 0x5b, 0xdd, 0x82, 0x72, 0x5c, 0xb9, 0xd7, 0xb6, 0x7f,
+	0x66, 0xda, 0xab, 0xdf, 0x87, 0x19, 0xcf, 0x19, 0x8f, 0x01, 0xe6, 0x76, 0x60, 0x64, 0xfa, 0x70,
+	0x81, 0xfe, 0x41, 0x72, 0x91, 0xd6, 0x4d, 0x4d, 0xdf, 0xda, 0x7b, 0x98, 0x48, 0xd1, 0x12, 0x1c,
+	0xbd, 0x85, 0xf7, 0x9a, 0x8a, 0x6a, 0xeb, 0xbb, 0x34, 0xa9, 0xb7, 0xb1, 0xde, 0xda, 0xb6, 0xdd,
+	0x6a, 0x3e, 0xd0, 0x38, 0x72, 0x0b, 0xef, 0xad, 0x7a, 0xcf, 0xae, 0xb8, 0x8f, 0x84, 0xde, 0x89,
+	0x99, 0xfc, 0x10, 0xbc, 0xf3, 0x9e, 0xe4, 0xe6, 0xdb, 0x26, 0xb6, 0x57, 0x55, 0xd5, 0xec, 0x1a,
+	0xb6, 0x33, 0xb8, 0xf7, 0x3f, 0xbe, 0xc7, 0xb8, 0x34, 0x3a, 0x5b, 0xc8, 0x78, 0x43, 0x47, 0xdb,
+	0x81, 0x1b, 0xce, 0x14, 0xc9, 0x9d, 0xf5, 0xb2, 0x39, 0x2a, 0xbd, 0x28, 0x7c, 0x48, 0x1d, 0x83,
+	0x99, 0x04, 0xfb, 0xd8, 0x9b, 0xfa, 0x8e, 0x04, 0xb3, 0xbc, 0xdc, 0x6d, 0x5c, 0x08, 0x15, 0x7e,
+	0x8e, 0xa1, 0x01, 0xa3, 0xbc, 0x74, 0x92, 0x0e, 0x56, 0xb3, 0x4a, 0x9c, 0xb3, 0xef, 0x0d, 0x8a,
+	0x61, 0xfe, 0x0a, 0xc9, 0x10, 0x14, 0x9e, 0x41, 0x07, 0x43, 0x59, 0xaa, 0x3e, 0xa0, 0x8b, 0x9b,
+	0x64, 0xc3, 0x1e, 0x49, 0x17, 0x88, 0x5e, 0x85, 0xc9, 0x84, 0x12, 0xcf, 0x97, 0x25, 0xf9, 0x6b,
+	0xfc, 0xe1, 0x68, 0x8d, 0xf7, 0x51, 0xfe, 0xb7, 0xdf, 0x5d, 0xfb, 0x6c, 0x5c, 0xc0, 0x75, 0xdc,
+	0x36, 0x2d, 0xdd, 0x9d, 0xf1, 0x71, 0xf3, 0x78, 0x00, 0x66, 0x22, 0x03, 0xc8, 0x92, 0x3f, 0x67,
+	0x9c, 0x81, 0xe1, 0x96, 0x65, 0x76, 0x3b, 0xbc, 0xe3, 0x29, 0x35, 0x86, 0xdc, 0xeb, 0x75, 0x0d,
+	0x2d, 0xa7, 0xb6, 0x46, 0xf4, 0x3c, 0x4c, 0xee, 0x80, 0xbe, 0x0e, 0xce, 0x1c, 0x41, 0xb7, 0x95,
+	0x1d, 0xe2, 0x8e, 0x5a, 0x04, 0xdf, 0xd0, 0x4e, 0xa0, 0x1b, 0x8c, 0xb6, 0xe1, 0x71, 0x39, 0x12,
+	0xb8, 0x2f, 0xcb, 0x07, 0xb3, 0x25, 0x78, 0x60, 0x3d, 0x2e, 0x74, 0x05, 0xc0, 0xc9, 0x06, 0xc5,
+	0xee, 0x5a, 0x98, 0x94, 0x07, 0xb3, 0xd3, 0x6d, 0x93, 0x53, 0x6f, 0x62, 0xbb, 0x11, 0xe0, 0x75,
+	0xd2, 0x4c, 0x37, 0x76, 0xcd, 0x5b, 0xd8, 0x2a, 0x0f, 0x51, 0xef, 0xb0, 0x4b, 0x2f, 0x00, 0x3f,
+	0xef, 0x87, 0x13, 0x82, 0x00, 0x7c, 0xc6, 0x3b, 0xf7, 0xa4, 0xa9, 0x5f, 0x7f, 0xef, 0x53, 0xbf,
+	0xa4, 0xc1, 0xc7, 0x40, 0xcf, 0x83, 0x0f, 0xcf, 0x27, 0x1f, 0xd2, 0xed, 0xcf, 0xaa, 0xa6, 0x7d,
+	0x13, 0xdb, 0xab, 0x84, 0x60, 0xdb, 0x1d, 0x38, 0x93, 0x1c, 0xf9, 0x98, 0xde, 0x9a, 0x5d, 0x87,
+	0x09, 0x03, 0xdb, 0x4d, 0xc5, 0x11, 0xd7, 0x74, 0x0b, 0x19, 0xb7, 0x35, 0x15, 0x7a, 0x48, 0x3b,
+	0x2b, 0x23, 0x87, 0x8c, 0x90, 0x49, 0xc2, 0xbd, 0x51, 0x02, 0x00, 0x56, 0xf5, 0xde, 0xa3, 0x63,
+	0x2d, 0xba, 0x00, 0xdb, 0x50, 0x2c, 0xa5, 0x1d, 0x98, 0xf0, 0x94, 0x94, 0xae, 0xbd, 0x6d, 0x5a,
+	0xba, 0xbd, 0x47, 0xd1, 0xad, 0x95, 0xff, 0xf6, 0xa7, 0xb3, 0x93, 0xec, 0x67, 0x35, 0xac, 0x50,
+	0x6f, 0xda, 0x96, 0x6e, 0xb4, 0x1a, 0x3e, 0xa9, 0x33, 0x66, 0xed, 0xb8, 0x82, 0x58, 0x40, 0x67,
+	0x05, 0xe3, 0x47, 0xa5, 0x4d, 0xf8, 0x98, 0x95, 0xf2, 0xac, 0x1c, 0x72, 0xcc, 0xf7, 0xa5, 0xb1,
+	0x91, 0x5c, 0xd8, 0x3e, 0x6a, 0xfb, 0xd2, 0x87, 0x27, 0x60, 0xa0, 0x4e, 0x5a, 0x48, 0x07, 0xf0,
+	0x07, 0x27, 0xe8, 0x4c, 0x9a, 0xba, 0xa4, 0x1f, 0xc8, 0xc8, 0x67, 0x73, 0x52, 0xb3, 0xf4, 0xdf,
+	0x81, 0x91, 0xc0, 0x30, 0x02, 0x89, 0xb8, 0xe3, 0x3f, 0x27, 0x91, 0x17, 0xf3, 0x92, 0xfb, 0xda,
+	0x7c, 0x1b, 0x08, 0xca, 0x67, 0x2b, 0xc9, 0xa3, 0x2d, 0xe9, 0xd7, 0x1c, 0x26, 0x8c, 0x06, 0x8c,
+	0x20, 0x28, 0xa7, 0xb5, 0x9e, 0xbe, 0x5a, 0x6e, 0x7a, 0xa6, 0xf0, 0x4d, 0x09, 0x50, 0xfc, 0x07,
+	0x0b, 0x68, 0x59, 0x20, 0x27, 0xf5, 0x47, 0x18, 0xf2, 0x97, 0x0b, 0x72, 0x31, 0x1b, 0x7e, 0x2a,
+	0xc1, 0xd1, 0xc4, 0x9f, 0x1a, 0xa0, 0xaf, 0xe6, 0x83, 0x13, 0xb7, 0xe4, 0x42, 0x71, 0x46, 0x66,
+	0x8c, 0x05, 0x63, 0xa1, 0x5f, 0x05, 0xa0, 0x5a, 0x0e, 0x50, 0xc1, 0x75, 0xb4, 0xfc, 0x4c, 0x7e,
+	0x06, 0xa6, 0xf3, 0x0e, 0x4c, 0x44, 0x57, 0xfa, 0x68, 0x29, 0x1f, 0x82, 0x90, 0xe6, 0xf3, 0x85,
+	0x78, 0x98, 0xf2, 0xbb, 0x70, 0x38, 0xb6, 0x7a, 0x47, 0x22, 0x49, 0x69, 0xbf, 0x2e, 0x90, 0x97,
+	0x8b, 0x31, 0xf9, 0xfa, 0x63, 0x2b, 0x75, 0xa1, 0xfe, 0xb4, 0xdf, 0x01, 0xc8, 0xcb, 0xc5, 0x98,
+	0x98, 0xfe, 0x77, 0x9c, 0xef, 0x89, 0xb4, 0x0d, 0x37, 0xba, 0x98, 0x2d, 0x33, 0x75, 0x5a, 0x2b,
+	0x5f, 0xea, 0x8d, 0xd9, 0xaf, 0x05, 0xc1, 0x4d, 0xb6, 0xb0, 0x16, 0x24, 0x6c, 0xde, 0xe5, 0x5a,
+	0x6e, 0x7a, 0x3f, 0x0d, 0xa3, 0xcb, 0x69, 0x61, 0x1a, 0xa6, 0xec, 0xd2, 0xe5, 0xf3, 0x85, 0x78,
+	0x98, 0xf2, 0xb7, 0x25, 0x98, 0x4e, 0xd9, 0x2d, 0xa3, 0x67, 0x05, 0x02, 0xc5, 0xeb, 0x72, 0x79,
+	0xa5, 0x17, 0x56, 0x3f, 0x00, 0xc1, 0x65, 0x29, 0xca, 0x2e, 0xe6, 0xa1, 0x7d, 0xb7, 0x5c, 0xcb,
+	0x4d, 0x1f, 0x39, 0x6b, 0x68, 0x5b, 0x94, 0x7d, 0xd6, 0x84, 0xd6, 0x73, 0xf2, 0x62, 0x5e, 0xf2,
+	0xe8, 0x59, 0xc3, 0xd4, 0x65, 0x9f, 0x35, 0x61, 0x7d, 0xb5, 0xdc, 0xf4, 0x11, 0x7f, 0x36, 0xd8,
+	0xce, 0x2d, 0xa7, 0xc1, 0x24, 0xb7, 0x3f, 0xa3, 0x9b, 0x38, 0x0b, 0xc6, 0x82, 0x86, 0x10, 0x94,
+	0xd7, 0x64, 0x92, 0xa7, 0x96, 0x27, 0xee, 0xc3, 0xdc, 0x3c, 0x4e, 0x59, 0x21, 0x09, 0xf3, 0x58,
+	0xbc, 0x40, 0x93, 0x57, 0x7a, 0x61, 0x65, 0x26, 0xfd, 0x42, 0x82, 0x72, 0xda, 0xfa, 0x06, 0xad,
+	0xe4, 0x3b, 0x33, 0x12, 0x8d, 0xba, 0xd8, 0x13, 0x2f, 0xb3, 0xea, 0x5d, 0x09, 0xe4, 0xf4, 0xdd,
+	0x0a, 0xba, 0x94, 0x05, 0x58, 0x34, 0xb2, 0x96, 0x2f, 0xf7, 0xc8, 0xcd, 0x6c, 0xfb, 0xb5, 0x04,
+	0xc7, 0x04, 0xb3, 0x67, 0x74, 0x39, 0x13, 0xb8, 0xd0, 0xba, 0xe7, 0x7a, 0x65, 0x0f, 0xb8, 0x2e,
+	0x7d, 0x23, 0x22, 0x74, 0x5d, 0xe6, 0x12, 0x49, 0xbe, 0xdc, 0x23, 0x37, 0xb3, 0xed, 0xf7, 0x12,
+	0x54, 0x32, 0x56, 0x10, 0x68, 0xb5, 0x10, 0xfe, 0xa4, 0xfd, 0x8d, 0xbc, 0xb6, 0x1f, 0x11, 0x81,
+	0xf7, 0x22, 0x6d, 0xb2, 0x8e, 0x56, 0xf2, 0x15, 0x9b, 0xc2, 0xef, 0x45, 0xe6, 0x28, 0xdf, 0xe9,
+	0x47, 0x52, 0x67, 0xda, 0xe8, 0x62, 0xce, 0x82, 0x54, 0xb8, 0x1f, 0xc9, 0x1c, 0xa3, 0x3b, 0xd5,
+	0x34, 0x34, 0xc6, 0x16, 0x56, 0xd3, 0xa4, 0x69, 0xbb, 0xfc, 0x4c, 0x7e, 0x06, 0xa6, 0xf3, 0x36,
+	0x8c, 0x47, 0x66, 0xca, 0xe8, 0x5c, 0x26, 0x88, 0x98, 0xde, 0xa5, 0x22, 0x2c, 0xbe, 0xe6, 0xc8,
+	0xbc, 0x56, 0xa8, 0x39, 0x79, 0x1c, 0x2d, 0x2f, 0x15, 0x61, 0x61, 0x9a, 0xbb, 0x70, 0x28, 0x3c,
+	0x1e, 0x45, 0x22, 0xbf, 0x25, 0x4e, 0x7a, 0xe5, 0x73, 0x05, 0x38, 0xfc, 0x3e, 0x3c, 0x36, 0xa2,
+	0x10, 0xf6, 0xe1, 0x69, 0x13, 0x19, 0x79, 0xb9, 0x18, 0x93, 0xdf, 0x1d, 0x04, 0x27, 0x0c, 0xc2,
+	0xee, 0x20, 0x61, 0x54, 0x22, 0xd7, 0x72, 0xd3, 0x53, 0x85, 0xf2, 0xc1, 0x37, 0x3e, 0xfd, 0x60,
+	0x41, 0x5a, 0xbb, 0x75, 0xef, 0xfe, 0xac, 0xf4, 0xd1, 0xfd, 0x59, 0xe9, 0x5f, 0xf7, 0x67, 0xa5,
+	0xb7, 0x1f, 0xcc, 0xf6, 0x7d, 0xf4, 0x60, 0xb6, 0xef, 0x1f, 0x0f, 0x66, 0xfb, 0x60, 0x46, 0x37,
+	0x53, 0x64, 0x6e, 0x48, 0xdf, 0x5a, 0x6e, 0xe9, 0xf6, 0x76, 0xf7, 0xe6, 0xa2, 0x6a, 0xb6, 0x6b,
+	0x3e, 0xd1, 0x59, 0xdd, 0x0c, 0x5c, 0xd5, 0x6e, 0xfb, 0x7f, 0x35, 0x64, 0xef, 0x75, 0x30, 0xb9,
+	0x39, 0xe8, 0xfe, 0xad, 0xd0, 0xf9, 0xff, 0x0f, 0x00, 0x01, 0x2b, 0x53, 0xb9, 0x77, 0x35, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
+	// WriteScopes adds or updates several scopes at once.
+	WriteScopes(ctx context.Context, in *MsgWriteScopesRequest, opts ...grpc.CallOption) (*MsgWriteScopesResponse, error)
+	// DeleteScopes deletes several scopes (and all associated Records, Sessions) at once.
+	DeleteScopes(ctx context.Context, in *MsgDeleteScopesRequest, opts ...grpc.CallOption) (*MsgDeleteScopesResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner parties to a scope
+	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes owner parties (by addresses) from a scope
+	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
+	// UpdateValueOwners sets the value owner of one or more scopes.
+	UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error)
+	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
+	MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error)
+	// MigrateScopeSpecification moves one or more scopes to a newer version of their scope specification.
+	MigrateScopeSpecification(ctx context.Context, in *MsgMigrateScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecificationResponse, error)
+	// AddScopeLien puts a lien on a scope. The signers must be able to delete the scope.
+	AddScopeLien(ctx context.Context, in *MsgAddScopeLienRequest, opts ...grpc.CallOption) (*MsgAddScopeLienResponse, error)
+	// ReleaseScopeLien removes the lien from a scope. The lienholder must be a signer.
+	ReleaseScopeLien(ctx context.Context, in *MsgReleaseScopeLienRequest, opts ...grpc.CallOption) (*MsgReleaseScopeLienResponse, error)
+	// FractionalizeScopeValue splits the value of a scope into multiple units, all of which go to the scope's current
+	// value owner. The value owner must be a signer. Once split, the units can be sent to other accounts like any coin.
+	FractionalizeScopeValue(ctx context.Context, in *MsgFractionalizeScopeValueRequest, opts ...grpc.CallOption) (*MsgFractionalizeScopeValueResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// WriteRecords adds or updates several records at once.
+	WriteRecords(ctx context.Context, in *MsgWriteRecordsRequest, opts ...grpc.CallOption) (*MsgWriteRecordsResponse, error)
+	// DeleteRecords deletes several records at once.
+	DeleteRecords(ctx context.Context, in *MsgDeleteRecordsRequest, opts ...grpc.CallOption) (*MsgDeleteRecordsResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
+	// BindOSLocator binds an owner address to a uri. An owner can have multiple locators with different names.
+	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+	// SetAccountData associates some basic data with a metadata address.
+	// Currently, only scope ids are supported.
+	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// AddNetAssetValues set the net asset value for a scope
+	AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
+	out := new(MsgWriteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteContractSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
+	out := new(MsgDeleteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) WriteScopes(ctx context.Context, in *MsgWriteScopesRequest, opts ...grpc.CallOption) (*MsgWriteScopesResponse, error) {
+	out := new(MsgWriteScopesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddContractSpecToScopeSpecRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScopes(ctx context.Context, in *MsgDeleteScopesRequest, opts ...grpc.CallOption) (*MsgDeleteScopesResponse, error) {
+	out := new(MsgDeleteScopesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
+	out := new(MsgAddScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
+	out := new(MsgDeleteScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
+	out := new(MsgAddScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
+	out := new(MsgDeleteScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
+	return out, nil
+}
+
+func (c *msgClient) UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error) {
+	out := new(MsgUpdateValueOwnersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateValueOwners", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
+	return out, nil
+}
+
+func (c *msgClient) MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error) {
+	out := new(MsgMigrateValueOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateValueOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindOSLocatorRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) MigrateScopeSpecification(ctx context.Context, in *MsgMigrateScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecificationResponse, error) {
+	out := new(MsgMigrateScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateScopeSpecification", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) AddScopeLien(ctx context.Context, in *MsgAddScopeLienRequest, opts ...grpc.CallOption) (*MsgAddScopeLienResponse, error) {
+	out := new(MsgAddScopeLienResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeLien", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) ReleaseScopeLien(ctx context.Context, in *MsgReleaseScopeLienRequest, opts ...grpc.CallOption) (*MsgReleaseScopeLienResponse, error) {
+	out := new(MsgReleaseScopeLienResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ReleaseScopeLien", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	return out, nil
+}
+
+func (c *msgClient) FractionalizeScopeValue(ctx context.Context, in *MsgFractionalizeScopeValueRequest, opts ...grpc.CallOption) (*MsgFractionalizeScopeValueResponse, error) {
+	out := new(MsgFractionalizeScopeValueResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/FractionalizeScopeValue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOSLocatorRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
+	out := new(MsgWriteSessionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
+	out := new(MsgWriteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	return out, nil
+}
+
+func (c *msgClient) WriteRecords(ctx context.Context, in *MsgWriteRecordsRequest, opts ...grpc.CallOption) (*MsgWriteRecordsResponse, error) {
+	out := new(MsgWriteRecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyOSLocatorRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteRecords(ctx context.Context, in *MsgDeleteRecordsRequest, opts ...grpc.CallOption) (*MsgDeleteRecordsResponse, error) {
+	out := new(MsgDeleteRecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
+	out := new(MsgDeleteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	return out, nil
+}
+
+func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
+	out := new(MsgWriteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAccountDataRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
+	out := new(MsgDeleteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAccountData(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	out := new(MsgAddContractSpecToScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/SetAccountData",
+	return out, nil
+}
+
+func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	return out, nil
+}
+
+func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
+	out := new(MsgWriteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddNetAssetValuesRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
+	out := new(MsgDeleteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
+	out := new(MsgBindOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddNetAssetValues",
+	return out, nil
+}
+
+func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
+	out := new(MsgDeleteOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	return out, nil
+}
+
+func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
+	out := new(MsgModifyOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WriteScope",
-			Handler:    _Msg_WriteScope_Handler,
-		},
-		{
-			MethodName: "DeleteScope",
-			Handler:    _Msg_DeleteScope_Handler,
-		},
-		{
-			MethodName: "AddScopeDataAccess",
-			Handler:    _Msg_AddScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "DeleteScopeDataAccess",
-			Handler:    _Msg_DeleteScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "AddScopeOwner",
-			Handler:    _Msg_AddScopeOwner_Handler,
-		},
-		{
-			MethodName: "DeleteScopeOwner",
-			Handler:    _Msg_DeleteScopeOwner_Handler,
-		},
-		{
-			MethodName: "UpdateValueOwners",
-			Handler:    _Msg_UpdateValueOwners_Handler,
-		},
-		{
-			MethodName: "MigrateValueOwner",
-			Handler:    _Msg_MigrateValueOwner_Handler,
-		},
-		{
-			MethodName: "WriteSession",
-			Handler:    _Msg_WriteSession_Handler,
-		},
-		{
-			MethodName: "WriteRecord",
-			Handler:    _Msg_WriteRecord_Handler,
-		},
-		{
-			MethodName: "DeleteRecord",
-			Handler:    _Msg_DeleteRecord_Handler,
-		},
-		{
-			MethodName: "WriteScopeSpecification",
-			Handler:    _Msg_WriteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteScopeSpecification",
-			Handler:    _Msg_DeleteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "WriteContractSpecification",
-			Handler:    _Msg_WriteContractSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecification",
-			Handler:    _Msg_DeleteContractSpecification_Handler,
-		},
-		{
-			MethodName: "AddContractSpecToScopeSpec",
-			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecFromScopeSpec",
-			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
-		},
-		{
-			MethodName: "WriteRecordSpecification",
-			Handler:    _Msg_WriteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteRecordSpecification",
-			Handler:    _Msg_DeleteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "BindOSLocator",
-			Handler:    _Msg_BindOSLocator_Handler,
-		},
-		{
-			MethodName: "DeleteOSLocator",
-			Handler:    _Msg_DeleteOSLocator_Handler,
-		},
-		{
-			MethodName: "ModifyOSLocator",
-			Handler:    _Msg_ModifyOSLocator_Handler,
-		},
-		{
-			MethodName: "SetAccountData",
-			Handler:    _Msg_SetAccountData_Handler,
-		},
-		{
-			MethodName: "AddNetAssetValues",
-			Handler:    _Msg_AddNetAssetValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/tx.proto",
-}
-
-func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
+	out := new(MsgSetAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetAccountData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UsdMills != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeUuid) > 0 {
-		i -= len(m.ScopeUuid)
-		copy(dAtA[i:], m.ScopeUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AddNetAssetValues(ctx context.Context, in *MsgAddNetAssetValuesRequest, opts ...grpc.CallOption) (*MsgAddNetAssetValuesResponse, error) {
+	out := new(MsgAddNetAssetValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddNetAssetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
+	// WriteScopes adds or updates several scopes at once.
+	WriteScopes(context.Context, *MsgWriteScopesRequest) (*MsgWriteScopesResponse, error)
+	// DeleteScopes deletes several scopes (and all associated Records, Sessions) at once.
+	DeleteScopes(context.Context, *MsgDeleteScopesRequest) (*MsgDeleteScopesResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner parties to a scope
+	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes owner parties (by addresses) from a scope
+	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
+	// UpdateValueOwners sets the value owner of one or more scopes.
+	UpdateValueOwners(context.Context, *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error)
+	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
+	MigrateValueOwner(context.Context, *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error)
+	// MigrateScopeSpecification moves one or more scopes to a newer version of their scope specification.
+	MigrateScopeSpecification(context.Context, *MsgMigrateScopeSpecificationRequest) (*MsgMigrateScopeSpecificationResponse, error)
+	// AddScopeLien puts a lien on a scope. The signers must be able to delete the scope.
+	AddScopeLien(context.Context, *MsgAddScopeLienRequest) (*MsgAddScopeLienResponse, error)
+	// ReleaseScopeLien removes the lien from a scope. The lienholder must be a signer.
+	ReleaseScopeLien(context.Context, *MsgReleaseScopeLienRequest) (*MsgReleaseScopeLienResponse, error)
+	// FractionalizeScopeValue splits the value of a scope into multiple units, all of which go to the scope's current
+	// value owner. The value owner must be a signer. Once split, the units can be sent to other accounts like any coin.
+	FractionalizeScopeValue(context.Context, *MsgFractionalizeScopeValueRequest) (*MsgFractionalizeScopeValueResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// WriteRecords adds or updates several records at once.
+	WriteRecords(context.Context, *MsgWriteRecordsRequest) (*MsgWriteRecordsResponse, error)
+	// DeleteRecords deletes several records at once.
+	DeleteRecords(context.Context, *MsgDeleteRecordsRequest) (*MsgDeleteRecordsResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
+	// BindOSLocator binds an owner address to a uri. An owner can have multiple locators with different names.
+	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+	// SetAccountData associates some basic data with a metadata address.
+	// Currently, only scope ids are supported.
+	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// AddNetAssetValues set the net asset value for a scope
+	AddNetAssetValues(context.Context, *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
 }
-
-func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
-
-func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) WriteScopes(ctx context.Context, req *MsgWriteScopesRequest) (*MsgWriteScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopes not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DeleteScopes(ctx context.Context, req *MsgDeleteScopesRequest) (*MsgDeleteScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopes not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
 }
-
-func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValueOwners(ctx context.Context, req *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValueOwners not implemented")
+}
+func (*UnimplementedMsgServer) MigrateValueOwner(ctx context.Context, req *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateValueOwner not implemented")
+}
+func (*UnimplementedMsgServer) MigrateScopeSpecification(ctx context.Context, req *MsgMigrateScopeSpecificationRequest) (*MsgMigrateScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) AddScopeLien(ctx context.Context, req *MsgAddScopeLienRequest) (*MsgAddScopeLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeLien not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseScopeLien(ctx context.Context, req *MsgReleaseScopeLienRequest) (*MsgReleaseScopeLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseScopeLien not implemented")
+}
+func (*UnimplementedMsgServer) FractionalizeScopeValue(ctx context.Context, req *MsgFractionalizeScopeValueRequest) (*MsgFractionalizeScopeValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionalizeScopeValue not implemented")
+}
+func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecords(ctx context.Context, req *MsgWriteRecordsRequest) (*MsgWriteRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecords not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecords(ctx context.Context, req *MsgDeleteRecordsRequest) (*MsgDeleteRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecords not implemented")
+}
+func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
+}
+func (*UnimplementedMsgServer) AddNetAssetValues(ctx context.Context, req *MsgAddNetAssetValuesRequest) (*MsgAddNetAssetValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetAssetValues not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScope(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_WriteScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopes(ctx, req.(*MsgWriteScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopes(ctx, req.(*MsgDeleteScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateValueOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValueOwnersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValueOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/UpdateValueOwners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValueOwners(ctx, req.(*MsgUpdateValueOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MigrateValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateValueOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateValueOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateValueOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateValueOwner(ctx, req.(*MsgMigrateValueOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_MigrateScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateScopeSpecification(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateScopeSpecification",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateScopeSpecification(ctx, req.(*MsgMigrateScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeLien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeLienRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeLien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeLien",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeLien(ctx, req.(*MsgAddScopeLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ReleaseScopeLien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseScopeLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseScopeLien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ReleaseScopeLien",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseScopeLien(ctx, req.(*MsgReleaseScopeLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_FractionalizeScopeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFractionalizeScopeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FractionalizeScopeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/FractionalizeScopeValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FractionalizeScopeValue(ctx, req.(*MsgFractionalizeScopeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Owners[iNdEx])
-			copy(dAtA[i:], m.Owners[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecords(ctx, req.(*MsgWriteRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateValueOwnersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateValueOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateValueOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecords(ctx, in)
 	}
-	if len(m.ValueOwnerAddress) > 0 {
-		i -= len(m.ValueOwnerAddress)
-		copy(dAtA[i:], m.ValueOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValueOwnerAddress)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecords",
 	}
-	if len(m.ScopeIds) > 0 {
-		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ScopeIds[iNdEx].Size()
-				i -= size
-				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecords(ctx, req.(*MsgDeleteRecordsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateValueOwnersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateValueOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateValueOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Proposed) > 0 {
-		i -= len(m.Proposed)
-		copy(dAtA[i:], m.Proposed)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposed)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
 	}
-	if len(m.Existing) > 0 {
-		i -= len(m.Existing)
-		copy(dAtA[i:], m.Existing)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Existing)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddContractSpecToScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMigrateValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
 	}
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionUuid) > 0 {
-		i -= len(m.SessionUuid)
-		copy(dAtA[i:], m.SessionUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ScopeIdentifier != nil {
-		{
-			size := m.ScopeIdentifier.Size()
-			i -= size
-			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOSLocator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeUuid)
-	copy(dAtA[i:], m.ScopeUuid)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/SetAccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountData(ctx, req.(*MsgSetAccountDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeAddr)
-	copy(dAtA[i:], m.ScopeAddr)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddNetAssetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNetAssetValuesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddNetAssetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddNetAssetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNetAssetValues(ctx, req.(*MsgAddNetAssetValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "WriteScope",
+			Handler:    _Msg_WriteScope_Handler,
+		},
+		{
+			MethodName: "DeleteScope",
+			Handler:    _Msg_DeleteScope_Handler,
+		},
+		{
+			MethodName: "WriteScopes",
+			Handler:    _Msg_WriteScopes_Handler,
+		},
+		{
+			MethodName: "DeleteScopes",
+			Handler:    _Msg_DeleteScopes_Handler,
+		},
+		{
+			MethodName: "AddScopeDataAccess",
+			Handler:    _Msg_AddScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "DeleteScopeDataAccess",
+			Handler:    _Msg_DeleteScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "AddScopeOwner",
+			Handler:    _Msg_AddScopeOwner_Handler,
+		},
+		{
+			MethodName: "DeleteScopeOwner",
+			Handler:    _Msg_DeleteScopeOwner_Handler,
+		},
+		{
+			MethodName: "UpdateValueOwners",
+			Handler:    _Msg_UpdateValueOwners_Handler,
+		},
+		{
+			MethodName: "MigrateValueOwner",
+			Handler:    _Msg_MigrateValueOwner_Handler,
+		},
+		{
+			MethodName: "MigrateScopeSpecification",
+			Handler:    _Msg_MigrateScopeSpecification_Handler,
+		},
+		{
+			MethodName: "AddScopeLien",
+			Handler:    _Msg_AddScopeLien_Handler,
+		},
+		{
+			MethodName: "ReleaseScopeLien",
+			Handler:    _Msg_ReleaseScopeLien_Handler,
+		},
+		{
+			MethodName: "FractionalizeScopeValue",
+			Handler:    _Msg_FractionalizeScopeValue_Handler,
+		},
+		{
+			MethodName: "WriteSession",
+			Handler:    _Msg_WriteSession_Handler,
+		},
+		{
+			MethodName: "WriteRecord",
+			Handler:    _Msg_WriteRecord_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "WriteRecords",
+			Handler:    _Msg_WriteRecords_Handler,
+		},
+		{
+			MethodName: "DeleteRecords",
+			Handler:    _Msg_DeleteRecords_Handler,
+		},
+		{
+			MethodName: "WriteScopeSpecification",
+			Handler:    _Msg_WriteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteScopeSpecification",
+			Handler:    _Msg_DeleteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "WriteContractSpecification",
+			Handler:    _Msg_WriteContractSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecification",
+			Handler:    _Msg_DeleteContractSpecification_Handler,
+		},
+		{
+			MethodName: "AddContractSpecToScopeSpec",
+			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecFromScopeSpec",
+			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
+		},
+		{
+			MethodName: "WriteRecordSpecification",
+			Handler:    _Msg_WriteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteRecordSpecification",
+			Handler:    _Msg_DeleteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "BindOSLocator",
+			Handler:    _Msg_BindOSLocator_Handler,
+		},
+		{
+			MethodName: "DeleteOSLocator",
+			Handler:    _Msg_DeleteOSLocator_Handler,
+		},
+		{
+			MethodName: "ModifyOSLocator",
+			Handler:    _Msg_ModifyOSLocator_Handler,
+		},
+		{
+			MethodName: "SetAccountData",
+			Handler:    _Msg_SetAccountData_Handler,
+		},
+		{
+			MethodName: "AddNetAssetValues",
+			Handler:    _Msg_AddNetAssetValues_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/tx.proto",
 }
 
-func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4267,46 +4633,32 @@ func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.UsdMills != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UsdMills))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ScopeUuid) > 0 {
+		i -= len(m.ScopeUuid)
+		copy(dAtA[i:], m.ScopeUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4320,7 +4672,7 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4332,7 +4684,7 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4342,19 +4694,19 @@ func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordIdInfo != nil {
+	if m.ScopeIdInfo != nil {
 		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4367,7 +4719,7 @@ func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4377,12 +4729,12 @@ func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4397,9 +4749,9 @@ func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	{
-		size := m.RecordId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4409,7 +4761,7 @@ func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4419,12 +4771,12 @@ func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4432,7 +4784,7 @@ func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4442,23 +4794,16 @@ func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4468,20 +4813,24 @@ func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (i
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,32 +4840,34 @@ func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ScopeIdInfos) > 0 {
+		for iNdEx := len(m.ScopeIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4526,12 +4877,12 @@ func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4545,20 +4896,24 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4568,12 +4923,12 @@ func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4581,7 +4936,7 @@ func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4591,38 +4946,40 @@ func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4630,7 +4987,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4640,32 +4997,20 @@ func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4675,12 +5020,12 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4694,20 +5039,19 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4717,7 +5061,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4727,12 +5071,12 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4740,7 +5084,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4750,12 +5094,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4769,20 +5113,24 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4792,7 +5140,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4802,12 +5150,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4815,7 +5163,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4825,12 +5173,12 @@ func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4841,13 +5189,22 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4857,7 +5214,7 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4867,12 +5224,12 @@ func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4880,7 +5237,7 @@ func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateValueOwnersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4890,46 +5247,50 @@ func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateValueOwnersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4939,32 +5300,20 @@ func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateValueOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateValueOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4974,12 +5323,12 @@ func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4990,23 +5339,27 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Proposed) > 0 {
+		i -= len(m.Proposed)
+		copy(dAtA[i:], m.Proposed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Existing) > 0 {
+		i -= len(m.Existing)
+		copy(dAtA[i:], m.Existing)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Existing)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateValueOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5016,12 +5369,12 @@ func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5029,7 +5382,7 @@ func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5039,30 +5392,53 @@ func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5072,30 +5448,20 @@ func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeLienRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5105,18 +5471,27 @@ func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeLienRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeLienRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Lien.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5128,7 +5503,7 @@ func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeLienResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5138,30 +5513,20 @@ func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeLienResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeLienResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgReleaseScopeLienRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5171,22 +5536,31 @@ func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReleaseScopeLienRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReleaseScopeLienRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -5194,7 +5568,7 @@ func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReleaseScopeLienResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5204,30 +5578,20 @@ func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReleaseScopeLienResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReleaseScopeLienResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgFractionalizeScopeValueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5237,12 +5601,12 @@ func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFractionalizeScopeValueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFractionalizeScopeValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5256,17 +5620,15 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+	if m.Units != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Units))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	{
-		size := m.MetadataAddr.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -5276,7 +5638,7 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFractionalizeScopeValueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5286,12 +5648,12 @@ func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFractionalizeScopeValueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFractionalizeScopeValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5299,7 +5661,7 @@ func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5309,16 +5671,35 @@ func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -5329,7 +5710,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	{
-		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5341,7 +5722,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5351,46 +5732,64 @@ func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecIdInfos) > 0 {
-		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.SessionUuid) > 0 {
+		i -= len(m.SessionUuid)
+		copy(dAtA[i:], m.SessionUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ContractSpecIdInfo != nil {
+	if m.ScopeIdentifier != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.ScopeIdentifier.Size()
+			i -= size
+			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeUuid)
+	copy(dAtA[i:], m.ScopeUuid)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeAddr)
+	copy(dAtA[i:], m.ScopeAddr)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5400,26 +5799,19 @@ func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Invoker) > 0 {
-		i -= len(m.Invoker)
-		copy(dAtA[i:], m.Invoker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Signatures != nil {
+	if m.SessionIdInfo != nil {
 		{
-			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5427,80 +5819,35 @@ func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Recitals != nil {
-		{
-			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeSpecificationId) > 0 {
-		i -= len(m.ScopeSpecificationId)
-		copy(dAtA[i:], m.ScopeSpecificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordIdInfos) > 0 {
-		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5508,12 +5855,19 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.SessionIdInfo != nil {
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
 		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5521,11 +5875,53 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.ScopeIdInfo != nil {
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
 		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5538,7 +5934,7 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5548,30 +5944,16 @@ func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -5581,17 +5963,20 @@ func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5601,12 +5986,12 @@ func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5614,891 +5999,3775 @@ func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWriteRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWriteRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UsdMills != 0 {
-		n += 1 + sovTx(uint64(m.UsdMills))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordIds) > 0 {
+		for iNdEx := len(m.RecordIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RecordIds[iNdEx].Size()
+				i -= size
+				if _, err := m.RecordIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ScopeIds) > 0 {
-		for _, e := range m.ScopeIds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Existing)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Proposed)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
-	}
-	l = len(m.SessionUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeUuid)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgWriteSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.KeyActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyActivationHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MetadataAddr.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.MetadataAddr.Size()
+		i -= size
+		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Contractspec.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.RecordSpecIdInfos) > 0 {
-		for _, e := range m.RecordSpecIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Signatures != nil {
+		{
+			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ScopeSpecificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.Recitals != nil {
-		l = m.Recitals.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Signatures != nil {
-		l = m.Signatures.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Invoker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RecordIdInfos) > 0 {
-		for _, e := range m.RecordIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddNetAssetValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNetAssetValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UsdMills != 0 {
+		n += 1 + sovTx(uint64(m.UsdMills))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteScopesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIdInfos) > 0 {
+		for _, e := range m.ScopeIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Existing)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proposed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeLienRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lien.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeLienResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseScopeLienRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReleaseScopeLienResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFractionalizeScopeValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Units != 0 {
+		n += 1 + sovTx(uint64(m.Units))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFractionalizeScopeValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeUuid)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgWriteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordIds) > 0 {
+		for _, e := range m.RecordIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.KeyActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeyActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contractspec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Signatures != nil {
+		l = m.Signatures.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
+			}
+			m.UsdMills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsdMills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, Scope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIdInfos = append(m.ScopeIdInfos, &ScopeIdInfo{})
+			if err := m.ScopeIdInfos[len(m.ScopeIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgAddNetAssetValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6521,15 +9790,48 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6556,11 +9858,12 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6592,9 +9895,142 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6622,11 +10058,11 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6654,27 +10090,8 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdMills", wireType)
-			}
-			m.UsdMills = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsdMills |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6696,7 +10113,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6719,48 +10136,12 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
-			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6782,7 +10163,7 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6805,15 +10186,15 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6840,11 +10221,45 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6897,7 +10312,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6920,10 +10335,10 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6947,7 +10362,7 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6970,17 +10385,17 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6990,28 +10405,27 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Existing = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7039,7 +10453,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Proposed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7094,7 +10508,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7117,10 +10531,10 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7144,7 +10558,7 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7167,15 +10581,15 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7202,15 +10616,17 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7220,23 +10636,24 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7291,7 +10708,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7314,10 +10731,10 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7341,7 +10758,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddScopeLienRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7364,48 +10781,15 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddScopeLienRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddScopeLienRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lien", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7432,12 +10816,11 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lien.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -7490,7 +10873,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddScopeLienResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7513,10 +10896,10 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddScopeLienResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddScopeLienResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7540,7 +10923,7 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseScopeLienRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7563,10 +10946,10 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseScopeLienRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseScopeLienRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7591,50 +10974,18 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -7687,7 +11038,7 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseScopeLienResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7710,10 +11061,10 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseScopeLienResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseScopeLienResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7737,7 +11088,7 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgFractionalizeScopeValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7760,15 +11111,15 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFractionalizeScopeValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFractionalizeScopeValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7795,17 +11146,15 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v MetadataAddress
-			m.ScopeIds = append(m.ScopeIds, v)
-			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			var stringLen uint64
+			m.Units = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7815,24 +11164,11 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Units |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
@@ -7886,7 +11222,7 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFractionalizeScopeValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7909,10 +11245,10 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFractionalizeScopeValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFractionalizeScopeValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7936,7 +11272,7 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7959,17 +11295,17 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7979,27 +11315,28 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Existing = string(dAtA[iNdEx:postIndex])
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8027,11 +11364,47 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposed = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8059,7 +11432,7 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8082,7 +11455,7 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8105,67 +11478,49 @@ func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8175,28 +11530,27 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8224,11 +11578,61 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SessionUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8255,45 +11659,13 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
 			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8315,7 +11687,7 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8338,17 +11710,17 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8358,27 +11730,28 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8406,11 +11779,47 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8438,7 +11847,41 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionUuid = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8461,7 +11904,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8484,15 +11927,15 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8519,10 +11962,10 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8547,7 +11990,7 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8570,17 +12013,17 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8590,22 +12033,22 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8636,14 +12079,114 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8670,16 +12213,14 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8707,41 +12248,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8764,7 +12271,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8787,15 +12294,15 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8822,10 +12329,8 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
-			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8850,7 +12355,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8873,15 +12378,15 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8908,7 +12413,9 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v MetadataAddress
+			m.RecordIds = append(m.RecordIds, v)
+			if err := m.RecordIds[len(m.RecordIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8965,7 +12472,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8988,10 +12495,10 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10999,6 +14506,25 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyActivationHeight", wireType)
+			}
+			m.KeyActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12175,6 +15701,171 @@ func (m *MsgAddNetAssetValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0